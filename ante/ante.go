@@ -10,15 +10,16 @@ import (
 	ibcante "github.com/cosmos/ibc-go/v4/modules/core/ante"
 	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
 
+	"github.com/cosmos/gaia/v9/x/globalfee"
 	gaiafeeante "github.com/cosmos/gaia/v9/x/globalfee/ante"
 )
 
-// maxTotalBypassMinFeeMsgGasUsage is the allowed maximum gas usage
+// MaxTotalBypassMinFeeMsgGasUsage is the allowed maximum gas usage
 // for all the bypass msgs in a transactions.
 // A transaction that contains only bypass message types and the gas usage does not
-// exceed maxTotalBypassMinFeeMsgGasUsage can be accepted with a zero fee.
+// exceed MaxTotalBypassMinFeeMsgGasUsage can be accepted with a zero fee.
 // For details, see gaiafeeante.NewFeeDecorator()
-var maxTotalBypassMinFeeMsgGasUsage uint64 = 1_000_000
+var MaxTotalBypassMinFeeMsgGasUsage uint64 = 1_000_000
 
 // HandlerOptions extend the SDK's AnteHandler options by requiring the IBC
 // channel keeper.
@@ -29,7 +30,26 @@ type HandlerOptions struct {
 	IBCkeeper            *ibckeeper.Keeper
 	BypassMinFeeMsgTypes []string
 	GlobalFeeSubspace    paramtypes.Subspace
+	PolicySubspace       paramtypes.Subspace
 	StakingSubspace      paramtypes.Subspace
+	FeeRejectionRecorder globalfee.FeeRejectionRecorder
+	GasPriceRecorder     globalfee.GasPriceRecorder
+	BypassRecorder       globalfee.BypassRecorder
+	// DynamicFees is optional, the global fees are not scaled when unset
+	DynamicFees globalfee.DynamicFeeSource
+	// FeePayerValidator is optional, all fee payers are allowed when unset
+	FeePayerValidator FeePayerValidator
+	UpgradeKeeper     UpgradeKeeper
+	SanctionKeeper    SanctionKeeper
+	SpendCapKeeper    SpendCapKeeper
+	SpendLimitKeeper  SpendLimitKeeper
+	DelegationKeeper  DelegationKeeper
+	// Mempool is optional, the number of txs pending in the mempool is not
+	// capped when unset
+	Mempool *MempoolIndex
+	// AnteProfiler is optional, the overhead of the decorators is not
+	// recorded when unset
+	AnteProfiler *AnteProfileIndex
 }
 
 func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
@@ -48,12 +68,30 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 	if opts.GlobalFeeSubspace.Name() == "" {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "globalfee param store is required for AnteHandler")
 	}
+	if opts.PolicySubspace.Name() == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "policy param store is required for AnteHandler")
+	}
 	if opts.StakingSubspace.Name() == "" {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "staking param store is required for AnteHandler")
 	}
 	if opts.GovKeeper == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "gov keeper is required for AnteHandler")
 	}
+	if opts.UpgradeKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "upgrade keeper is required for AnteHandler")
+	}
+	if opts.SanctionKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sanction keeper is required for AnteHandler")
+	}
+	if opts.SpendCapKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "spend cap keeper is required for AnteHandler")
+	}
+	if opts.SpendLimitKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "spend limit keeper is required for AnteHandler")
+	}
+	if opts.DelegationKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "delegation keeper is required for AnteHandler")
+	}
 
 	sigGasConsumer := opts.SigGasConsumer
 	if sigGasConsumer == nil {
@@ -62,13 +100,30 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		NewMempoolDecorator(opts.Mempool),
 		ante.NewRejectExtensionOptionsDecorator(),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(opts.AccountKeeper),
+		NewMemoLabelDecorator(),
+		NewMaxTxBytesDecorator(opts.PolicySubspace, opts.BypassMinFeeMsgTypes, MaxTotalBypassMinFeeMsgGasUsage),
+		NewMaxSignaturesDecorator(opts.PolicySubspace),
 		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
 		NewGovPreventSpamDecorator(opts.Codec, opts.GovKeeper),
-		gaiafeeante.NewFeeDecorator(opts.BypassMinFeeMsgTypes, opts.GlobalFeeSubspace, opts.StakingSubspace, maxTotalBypassMinFeeMsgGasUsage),
+		NewProposalCapDecorator(opts.GovKeeper, opts.PolicySubspace),
+		NewHaltedMsgDecorator(opts.PolicySubspace),
+		NewSpendCapDecorator(opts.SpendCapKeeper),
+		NewSanctionDecorator(opts.SanctionKeeper),
+		NewSpendLimitDecorator(opts.SpendLimitKeeper),
+		NewUpgradeFreezeDecorator(opts.UpgradeKeeper, opts.PolicySubspace, opts.BypassMinFeeMsgTypes),
+		NewMsgGasFloorDecorator(opts.GlobalFeeSubspace),
+		NewMemoRequiredDecorator(opts.PolicySubspace),
+		NewTransferCapDecorator(opts.PolicySubspace),
+		NewHighValueSignersDecorator(opts.PolicySubspace),
+		NewDelegationCapDecorator(opts.DelegationKeeper, opts.PolicySubspace),
+		NewFeeDecorator(opts),
+		NewFeePayerDecorator(opts.FeePayerValidator),
+		NewFeeSponsorDecorator(opts.GlobalFeeSubspace),
 		ante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper),
 		ante.NewSetPubKeyDecorator(opts.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(opts.AccountKeeper),
@@ -77,6 +132,20 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewIncrementSequenceDecorator(opts.AccountKeeper),
 		ibcante.NewAnteDecorator(opts.IBCkeeper),
 	}
+	if opts.AnteProfiler != nil {
+		anteDecorators = opts.AnteProfiler.Instrument(anteDecorators)
+	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
+
+// NewFeeDecorator returns the globalfee FeeDecorator of the ante handler
+// built with the given options.
+func NewFeeDecorator(opts HandlerOptions) gaiafeeante.FeeDecorator {
+	feeDecorator := gaiafeeante.NewFeeDecorator(opts.BypassMinFeeMsgTypes, opts.GlobalFeeSubspace, opts.StakingSubspace, MaxTotalBypassMinFeeMsgGasUsage)
+	feeDecorator.RejectionRecorder = opts.FeeRejectionRecorder
+	feeDecorator.GasPriceRecorder = opts.GasPriceRecorder
+	feeDecorator.BypassRecorder = opts.BypassRecorder
+	feeDecorator.DynamicFees = opts.DynamicFees
+	return feeDecorator
+}
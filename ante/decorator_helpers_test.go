@@ -0,0 +1,54 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+)
+
+// testTxConfig builds the txs run through the decorators under test.
+var testTxConfig = gaiaapp.MakeTestEncodingConfig().TxConfig
+
+// newTestTxBuilder returns a tx builder holding the msgs.
+func newTestTxBuilder(t *testing.T, msgs ...sdk.Msg) client.TxBuilder {
+	t.Helper()
+	txBuilder := testTxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	return txBuilder
+}
+
+// newTestTx returns an unsigned tx of the msgs.
+func newTestTx(t *testing.T, msgs ...sdk.Msg) sdk.Tx {
+	t.Helper()
+	return newTestTxBuilder(t, msgs...).GetTx()
+}
+
+// anteRejection is how a decorator rejects the txs failing its check: with an
+// error wrapping err, whose message contains msg when set.
+type anteRejection struct {
+	err error
+	msg string
+}
+
+// requireAnteHandle runs the decorator over the tx in both CheckTx and
+// DeliverTx, and requires the tx to be rejected as expected when rejected is
+// true, or to pass otherwise.
+func (r anteRejection) requireAnteHandle(t *testing.T, decorator sdk.AnteDecorator, tx sdk.Tx, simulate, rejected bool) {
+	t.Helper()
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	for _, checkTx := range []bool{true, false} {
+		_, err := decorator.AnteHandle(sdk.Context{}.WithIsCheckTx(checkTx), tx, simulate, next)
+		if !rejected {
+			require.NoError(t, err)
+			continue
+		}
+		require.ErrorIs(t, err, r.err)
+		if r.msg != "" {
+			require.Contains(t, err.Error(), r.msg)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package ante
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// DelegationKeeper defines the expected staking keeper
+type DelegationKeeper interface {
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool)
+	GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) []stakingtypes.Delegation
+}
+
+// DelegationCapDecorator rejects the transactions delegating to more distinct
+// validators than the MaxDelegationsPerDelegator policy param allows for a
+// delegator, counting its existing delegations and the delegations to new
+// validators of the transaction, i.e. delegations, redelegations and the
+// self-delegation of a created validator. The messages executed through authz
+// are checked as well. The delegators already above the cap can still top up
+// or reduce their existing delegations. It only rejects them early: the
+// staking msg server enforces the cap over every executed message, including
+// those of the interchain accounts.
+type DelegationCapDecorator struct {
+	delegationKeeper DelegationKeeper
+	policyParam      policy.ParamSource
+}
+
+func NewDelegationCapDecorator(delegationKeeper DelegationKeeper, policyParam policy.ParamSource) DelegationCapDecorator {
+	return DelegationCapDecorator{
+		delegationKeeper: delegationKeeper,
+		policyParam:      policyParam,
+	}
+}
+
+func (d DelegationCapDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var maxDelegations uint64
+	if d.policyParam.Has(ctx, policytypes.ParamStoreKeyMaxDelegationsPerDelegator) {
+		d.policyParam.Get(ctx, policytypes.ParamStoreKeyMaxDelegationsPerDelegator, &maxDelegations)
+	}
+	if maxDelegations == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	// the new validators delegated to by each delegator, in order of appearance
+	var delegators []string
+	newValidators := make(map[string]map[string]bool)
+	if err := d.collectNewDelegations(ctx, tx.GetMsgs(), &delegators, newValidators); err != nil {
+		return ctx, err
+	}
+
+	maxRetrieve := uint16(math.MaxUint16)
+	if maxDelegations < math.MaxUint16 {
+		maxRetrieve = uint16(maxDelegations)
+	}
+	for _, delegator := range delegators {
+		delAddr := sdk.MustAccAddressFromBech32(delegator)
+		existing := len(d.delegationKeeper.GetDelegatorDelegations(ctx, delAddr, maxRetrieve))
+		if uint64(existing+len(newValidators[delegator])) > maxDelegations {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "delegator %s cannot delegate to more than %d validators", delegator, maxDelegations)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// collectNewDelegations adds the validators the msgs delegate to for the
+// first time to the new validators of their delegator.
+func (d DelegationCapDecorator) collectNewDelegations(ctx sdk.Context, msgs []sdk.Msg, delegators *[]string, newValidators map[string]map[string]bool) error {
+	for _, m := range msgs {
+		var delegator, validator string
+		switch msg := m.(type) {
+		case *stakingtypes.MsgDelegate:
+			delegator, validator = msg.DelegatorAddress, msg.ValidatorAddress
+
+		case *stakingtypes.MsgBeginRedelegate:
+			delegator, validator = msg.DelegatorAddress, msg.ValidatorDstAddress
+
+		case *stakingtypes.MsgCreateValidator:
+			delegator, validator = msg.DelegatorAddress, msg.ValidatorAddress
+
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			if err := d.collectNewDelegations(ctx, innerMsgs, delegators, newValidators); err != nil {
+				return err
+			}
+			continue
+
+		default:
+			continue
+		}
+
+		delAddr, err := sdk.AccAddressFromBech32(delegator)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address: %s", err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", err)
+		}
+		if _, found := d.delegationKeeper.GetDelegation(ctx, delAddr, valAddr); found {
+			continue
+		}
+
+		if _, ok := newValidators[delegator]; !ok {
+			*delegators = append(*delegators, delegator)
+			newValidators[delegator] = make(map[string]bool)
+		}
+		newValidators[delegator][validator] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v9/ante"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// mockDelegationKeeper holds the validators each delegator delegates to
+type mockDelegationKeeper map[string][]sdk.ValAddress
+
+func (k mockDelegationKeeper) GetDelegation(_ sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool) {
+	for _, v := range k[delAddr.String()] {
+		if v.Equals(valAddr) {
+			return stakingtypes.NewDelegation(delAddr, valAddr, sdk.OneDec()), true
+		}
+	}
+	return stakingtypes.Delegation{}, false
+}
+
+func (k mockDelegationKeeper) GetDelegatorDelegations(_ sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) []stakingtypes.Delegation {
+	var delegations []stakingtypes.Delegation
+	for _, v := range k[delegator.String()] {
+		if len(delegations) == int(maxRetrieve) {
+			break
+		}
+		delegations = append(delegations, stakingtypes.NewDelegation(delegator, v, sdk.OneDec()))
+	}
+	return delegations
+}
+
+func TestDelegationCapDecorator(t *testing.T) {
+	delegator := sdk.AccAddress("delegator___________")
+	grantee := sdk.AccAddress("grantee_____________")
+	val1 := sdk.ValAddress("validator1__________")
+	val2 := sdk.ValAddress("validator2__________")
+	val3 := sdk.ValAddress("validator3__________")
+	val4 := sdk.ValAddress("validator4__________")
+	amount := sdk.NewInt64Coin("uatom", 1000)
+
+	atCap := mockDelegationKeeper{delegator.String(): {val1, val2}}
+	belowCap := mockDelegationKeeper{delegator.String(): {val1}}
+	overCap := mockDelegationKeeper{delegator.String(): {val1, val2, val3}}
+
+	specs := map[string]struct {
+		keeper         mockDelegationKeeper
+		maxDelegations uint64
+		tx             sdk.Tx
+		expErr         bool
+	}{
+		"at the cap, delegation to a new validator": {
+			keeper:         atCap,
+			maxDelegations: 2,
+			tx:             newTestTx(t, stakingtypes.NewMsgDelegate(delegator, val3, amount)),
+			expErr:         true,
+		},
+		"at the cap, top up of an existing delegation": {
+			keeper:         atCap,
+			maxDelegations: 2,
+			tx:             newTestTx(t, stakingtypes.NewMsgDelegate(delegator, val2, amount)),
+		},
+		"at the cap, redelegation to a new validator": {
+			keeper:         atCap,
+			maxDelegations: 2,
+			tx:             newTestTx(t, stakingtypes.NewMsgBeginRedelegate(delegator, val1, val3, amount)),
+			expErr:         true,
+		},
+		"at the cap, redelegation to an existing delegation": {
+			keeper:         atCap,
+			maxDelegations: 2,
+			tx:             newTestTx(t, stakingtypes.NewMsgBeginRedelegate(delegator, val1, val2, amount)),
+		},
+		"at the cap, undelegation": {
+			keeper:         atCap,
+			maxDelegations: 2,
+			tx:             newTestTx(t, stakingtypes.NewMsgUndelegate(delegator, val1, amount)),
+		},
+		"at the cap, delegation to a new validator through authz": {
+			keeper:         atCap,
+			maxDelegations: 2,
+			tx: func() sdk.Tx {
+				exec := authz.NewMsgExec(grantee, []sdk.Msg{stakingtypes.NewMsgDelegate(delegator, val3, amount)})
+				return newTestTx(t, &exec)
+			}(),
+			expErr: true,
+		},
+		"below the cap, delegation to a new validator": {
+			keeper:         belowCap,
+			maxDelegations: 2,
+			tx:             newTestTx(t, stakingtypes.NewMsgDelegate(delegator, val2, amount)),
+		},
+		"below the cap, delegations to new validators over the cap": {
+			keeper:         belowCap,
+			maxDelegations: 2,
+			tx:             newTestTx(t, stakingtypes.NewMsgDelegate(delegator, val2, amount), stakingtypes.NewMsgDelegate(delegator, val3, amount)),
+			expErr:         true,
+		},
+		"below the cap, repeated delegations to a new validator": {
+			keeper:         belowCap,
+			maxDelegations: 2,
+			tx:             newTestTx(t, stakingtypes.NewMsgDelegate(delegator, val2, amount), stakingtypes.NewMsgDelegate(delegator, val2, amount)),
+		},
+		"over the cap, top up of an existing delegation": {
+			keeper:         overCap,
+			maxDelegations: 2,
+			tx:             newTestTx(t, stakingtypes.NewMsgDelegate(delegator, val3, amount)),
+		},
+		"over the cap, delegation to a new validator": {
+			keeper:         overCap,
+			maxDelegations: 2,
+			tx:             newTestTx(t, stakingtypes.NewMsgDelegate(delegator, val4, amount)),
+			expErr:         true,
+		},
+		"no cap set": {
+			keeper: overCap,
+			tx:     newTestTx(t, stakingtypes.NewMsgDelegate(delegator, val4, amount)),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			decorator := ante.NewDelegationCapDecorator(spec.keeper, mockParamSource{string(policytypes.ParamStoreKeyMaxDelegationsPerDelegator): spec.maxDelegations})
+			anteRejection{err: sdkerrors.ErrInvalidRequest}.requireAnteHandle(t, decorator, spec.tx, false, spec.expErr)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package ante
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeePayerValidator validates the fee payer of a transaction, e.g. against an
+// external compliance system.
+type FeePayerValidator interface {
+	ValidateFeePayer(ctx sdk.Context, feePayer sdk.AccAddress) error
+}
+
+// AllowAllFeePayers is the default FeePayerValidator, it accepts any fee payer.
+type AllowAllFeePayers struct{}
+
+func (AllowAllFeePayers) ValidateFeePayer(_ sdk.Context, _ sdk.AccAddress) error {
+	return nil
+}
+
+// FeePayerAllowlist is a FeePayerValidator only accepting the listed fee payers.
+type FeePayerAllowlist map[string]struct{}
+
+// NewFeePayerAllowlist returns a FeePayerAllowlist accepting the given addresses.
+func NewFeePayerAllowlist(addrs ...sdk.AccAddress) FeePayerAllowlist {
+	allowlist := make(FeePayerAllowlist, len(addrs))
+	for _, addr := range addrs {
+		allowlist[addr.String()] = struct{}{}
+	}
+	return allowlist
+}
+
+// LoadFeePayerAllowlist reads a FeePayerAllowlist from a file holding one
+// bech32 account address per line. Empty lines and lines starting with # are
+// ignored.
+func LoadFeePayerAllowlist(path string) (FeePayerAllowlist, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var addrs []sdk.AccAddress
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee payer address on line %d: %w", lineNum, err)
+		}
+		addrs = append(addrs, addr)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewFeePayerAllowlist(addrs...), nil
+}
+
+func (l FeePayerAllowlist) ValidateFeePayer(_ sdk.Context, feePayer sdk.AccAddress) error {
+	if _, ok := l[feePayer.String()]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "fee payer %s is not allowed", feePayer)
+	}
+	return nil
+}
+
+// FeePayerDecorator rejects the transactions whose fee payer is refused by the
+// FeePayerValidator.
+//
+// The validator holds node local state, so the check only applies in CheckTx:
+// running it in DeliverTx could make validators disagree on the block results.
+type FeePayerDecorator struct {
+	validator FeePayerValidator
+}
+
+func NewFeePayerDecorator(validator FeePayerValidator) FeePayerDecorator {
+	if validator == nil {
+		validator = AllowAllFeePayers{}
+	}
+
+	return FeePayerDecorator{
+		validator: validator,
+	}
+}
+
+func (fpd FeePayerDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	// run checks only on CheckTx or simulate
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must implement the sdk.FeeTx interface")
+	}
+
+	if err := fpd.validator.ValidateFeePayer(ctx, feeTx.FeePayer()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
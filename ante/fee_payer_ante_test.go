@@ -0,0 +1,84 @@
+package ante_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/ante"
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+)
+
+func TestFeePayerDecorator(t *testing.T) {
+	allowedPayer := sdk.AccAddress("allowed_payer_______")
+	deniedPayer := sdk.AccAddress("denied_payer________")
+	txConfig := gaiaapp.MakeTestEncodingConfig().TxConfig
+
+	newTx := func(feePayer sdk.AccAddress) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(feePayer)))
+		return txBuilder.GetTx()
+	}
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	specs := map[string]struct {
+		validator ante.FeePayerValidator
+		feePayer  sdk.AccAddress
+		checkTx   bool
+		expErr    bool
+	}{
+		"allowed payer": {
+			validator: ante.NewFeePayerAllowlist(allowedPayer),
+			feePayer:  allowedPayer,
+			checkTx:   true,
+		},
+		"denied payer": {
+			validator: ante.NewFeePayerAllowlist(allowedPayer),
+			feePayer:  deniedPayer,
+			checkTx:   true,
+			expErr:    true,
+		},
+		"denied payer in deliver tx": {
+			validator: ante.NewFeePayerAllowlist(allowedPayer),
+			feePayer:  deniedPayer,
+		},
+		"no validator allows all": {
+			feePayer: deniedPayer,
+			checkTx:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithIsCheckTx(spec.checkTx)
+			decorator := ante.NewFeePayerDecorator(spec.validator)
+
+			_, err := decorator.AnteHandle(ctx, newTx(spec.feePayer), false, next)
+			if spec.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLoadFeePayerAllowlist(t *testing.T) {
+	allowedPayer := sdk.AccAddress("allowed_payer_______")
+	path := filepath.Join(t.TempDir(), "allowlist.txt")
+	content := "# compliance allowlist\n\n" + allowedPayer.String() + "\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	allowlist, err := ante.LoadFeePayerAllowlist(path)
+	require.NoError(t, err)
+	require.NoError(t, allowlist.ValidateFeePayer(sdk.Context{}, allowedPayer))
+	require.Error(t, allowlist.ValidateFeePayer(sdk.Context{}, sdk.AccAddress("denied_payer________")))
+
+	require.NoError(t, os.WriteFile(path, []byte("not-an-address\n"), 0o600))
+	_, err = ante.LoadFeePayerAllowlist(path)
+	require.Error(t, err)
+}
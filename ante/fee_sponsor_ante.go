@@ -0,0 +1,67 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+// FeeSponsorDecorator rejects the sponsored transactions whose sponsor is not
+// one of the AllowedFeeSponsors globalfee param. A transaction is sponsored
+// when the account paying its fees, i.e. its fee granter if any or else its
+// fee payer, signs none of its messages. The self paid transactions are not
+// affected.
+type FeeSponsorDecorator struct {
+	globalFeeParam globalfee.ParamSource
+}
+
+func NewFeeSponsorDecorator(globalFeeParam globalfee.ParamSource) FeeSponsorDecorator {
+	return FeeSponsorDecorator{
+		globalFeeParam: globalFeeParam,
+	}
+}
+
+func (d FeeSponsorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var allowed []string
+	if d.globalFeeParam.Has(ctx, globalfeetypes.ParamStoreKeyAllowedFeeSponsors) {
+		d.globalFeeParam.Get(ctx, globalfeetypes.ParamStoreKeyAllowedFeeSponsors, &allowed)
+	}
+	if len(allowed) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must implement the sdk.FeeTx interface")
+	}
+
+	sponsor := feeTx.FeeGranter()
+	if sponsor.Empty() {
+		sponsor = feeTx.FeePayer()
+	}
+	if signsMsg(sponsor, tx.GetMsgs()) {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, addr := range allowed {
+		if canonicalAddress(addr) == sponsor.String() {
+			return next(ctx, tx, simulate)
+		}
+	}
+
+	return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "fee sponsor %s is not allowed", sponsor)
+}
+
+// signsMsg returns whether addr is a signer of one of the msgs.
+func signsMsg(addr sdk.AccAddress, msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if signer.Equals(addr) {
+				return true
+			}
+		}
+	}
+	return false
+}
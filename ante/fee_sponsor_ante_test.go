@@ -0,0 +1,77 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/ante"
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+func TestFeeSponsorDecorator(t *testing.T) {
+	txConfig := gaiaapp.MakeTestEncodingConfig().TxConfig
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	_, _, signer := testdata.KeyTestPubAddr()
+	_, _, paymaster := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+
+	specs := map[string]struct {
+		sponsors []string
+		payer    sdk.AccAddress
+		granter  sdk.AccAddress
+		expErr   bool
+	}{
+		"self paid": {
+			sponsors: []string{paymaster.String()},
+		},
+		"self paid with an explicit fee payer": {
+			sponsors: []string{paymaster.String()},
+			payer:    signer,
+		},
+		"whitelisted fee payer": {
+			sponsors: []string{paymaster.String()},
+			payer:    paymaster,
+		},
+		"non whitelisted fee payer": {
+			sponsors: []string{paymaster.String()},
+			payer:    other,
+			expErr:   true,
+		},
+		"whitelisted fee granter": {
+			sponsors: []string{paymaster.String()},
+			granter:  paymaster,
+		},
+		"non whitelisted fee granter": {
+			sponsors: []string{paymaster.String()},
+			granter:  other,
+			expErr:   true,
+		},
+		"empty whitelist": {
+			payer: other,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(signer)))
+			// the fee payer setter is not part of the client.TxBuilder interface
+			// of this SDK version
+			txBuilder.(interface{ SetFeePayer(sdk.AccAddress) }).SetFeePayer(spec.payer)
+			txBuilder.SetFeeGranter(spec.granter)
+			decorator := ante.NewFeeSponsorDecorator(mockParamSource{string(globalfeetypes.ParamStoreKeyAllowedFeeSponsors): spec.sponsors})
+
+			_, err := decorator.AnteHandle(sdk.Context{}, txBuilder.GetTx(), false, next)
+			if spec.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
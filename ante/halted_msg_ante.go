@@ -0,0 +1,59 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+)
+
+// HaltedMsgDecorator rejects the transactions with a message of a type of the
+// HaltedMsgTypes policy param, so that governance can pause the messages
+// of a module in an emergency without an upgrade. The messages executed
+// through authz are checked as well. It only rejects them early: the
+// policy.CircuitBreaker wrapping the msg service router fails every executed
+// message of a halted type, including those of the interchain accounts.
+type HaltedMsgDecorator struct {
+	policyParam policy.ParamSource
+}
+
+func NewHaltedMsgDecorator(policyParam policy.ParamSource) HaltedMsgDecorator {
+	return HaltedMsgDecorator{
+		policyParam: policyParam,
+	}
+}
+
+func (d HaltedMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	halted := policy.HaltedMsgTypes(ctx, d.policyParam)
+	if len(halted) == 0 {
+		return next(ctx, tx, simulate)
+	}
+	if err := validateMsgsNotHalted(halted, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validateMsgsNotHalted returns an error if one of the msgs is of a halted
+// type.
+func validateMsgsNotHalted(halted map[string]bool, msgs []sdk.Msg) error {
+	for _, m := range msgs {
+		if err := policy.ValidateMsgNotHalted(halted, m); err != nil {
+			return err
+		}
+
+		if msg, ok := m.(*authz.MsgExec); ok {
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			if err := validateMsgsNotHalted(halted, innerMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v9/ante"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+func TestHaltedMsgDecorator(t *testing.T) {
+	sender := sdk.AccAddress("sender______________")
+	recipient := sdk.AccAddress("recipient___________")
+	valAddr := sdk.ValAddress("validator___________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	haltedSend := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+
+	specs := map[string]struct {
+		msgTypes []string
+		tx       sdk.Tx
+		expErr   bool
+	}{
+		"halted msg type": {
+			msgTypes: haltedSend,
+			tx:       newTestTx(t, banktypes.NewMsgSend(sender, recipient, coins)),
+			expErr:   true,
+		},
+		"halted msg type among other msgs": {
+			msgTypes: haltedSend,
+			tx: newTestTx(t,
+				stakingtypes.NewMsgDelegate(sender, valAddr, sdk.NewInt64Coin("uatom", 100)),
+				banktypes.NewMsgSend(sender, recipient, coins),
+			),
+			expErr: true,
+		},
+		"halted msg type through authz": {
+			msgTypes: haltedSend,
+			tx: func() sdk.Tx {
+				msg := authz.NewMsgExec(recipient, []sdk.Msg{banktypes.NewMsgSend(sender, recipient, coins)})
+				return newTestTx(t, &msg)
+			}(),
+			expErr: true,
+		},
+		"msg type not halted": {
+			msgTypes: haltedSend,
+			tx:       newTestTx(t, stakingtypes.NewMsgDelegate(sender, valAddr, sdk.NewInt64Coin("uatom", 100))),
+		},
+		"msg type not halted through authz": {
+			msgTypes: haltedSend,
+			tx: func() sdk.Tx {
+				msg := authz.NewMsgExec(recipient, []sdk.Msg{stakingtypes.NewMsgDelegate(sender, valAddr, sdk.NewInt64Coin("uatom", 100))})
+				return newTestTx(t, &msg)
+			}(),
+		},
+		"no halted msg type set": {
+			tx: newTestTx(t, banktypes.NewMsgSend(sender, recipient, coins)),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			decorator := ante.NewHaltedMsgDecorator(mockParamSource{string(policytypes.ParamStoreKeyHaltedMsgTypes): spec.msgTypes})
+			anteRejection{err: sdkerrors.ErrUnauthorized, msg: "is halted by governance"}.requireAnteHandle(t, decorator, spec.tx, false, spec.expErr)
+		})
+	}
+}
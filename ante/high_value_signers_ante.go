@@ -0,0 +1,139 @@
+package ante
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// HighValueSignersDecorator rejects the transactions making high value bank
+// sends with fewer signatures than the MinHighValueSigners policy param.
+// The bank sends are high value when the amount of a denom they send, summed
+// across the bank sends and the inputs of the bank multi sends of the
+// transaction, exceeds its threshold of the HighValueTransferThresholds
+// policy param. The messages executed through authz are summed as well,
+// and the keys signing through a multisig count individually, so that a high
+// value treasury can be moved by a multisig but not by a single key. A key
+// signing several times, directly or through several slots of a multisig,
+// counts once.
+type HighValueSignersDecorator struct {
+	policyParam policy.ParamSource
+}
+
+func NewHighValueSignersDecorator(policyParam policy.ParamSource) HighValueSignersDecorator {
+	return HighValueSignersDecorator{policyParam: policyParam}
+}
+
+func (d HighValueSignersDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var minSigners uint64
+	if d.policyParam.Has(ctx, policytypes.ParamStoreKeyMinHighValueSigners) {
+		d.policyParam.Get(ctx, policytypes.ParamStoreKeyMinHighValueSigners, &minSigners)
+	}
+	var thresholds sdk.Coins
+	if d.policyParam.Has(ctx, policytypes.ParamStoreKeyHighValueTransferThresholds) {
+		d.policyParam.Get(ctx, policytypes.ParamStoreKeyHighValueTransferThresholds, &thresholds)
+	}
+	if minSigners == 0 || thresholds.Empty() {
+		return next(ctx, tx, simulate)
+	}
+
+	sent, err := bankSentAmount(tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	highValue := false
+	for _, threshold := range thresholds {
+		if sent.AmountOf(threshold.Denom).GT(threshold.Amount) {
+			highValue = true
+			break
+		}
+	}
+	if !highValue {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signers := make(map[string]struct{})
+	for _, sig := range sigs {
+		addSigners(signers, sig.PubKey, sig.Data)
+	}
+	if count := uint64(len(signers)); count < minSigners {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "high value transfer of %s requires %d distinct signers, got %d", sent, minSigners, count)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// bankSentAmount returns the amount sent by the bank sends and the inputs of
+// the bank multi sends of the msgs, including the msgs executed through
+// authz.
+func bankSentAmount(msgs []sdk.Msg) (sdk.Coins, error) {
+	sent := sdk.NewCoins()
+	for _, m := range msgs {
+		switch msg := m.(type) {
+		case *banktypes.MsgSend:
+			sent = sent.Add(msg.Amount...)
+
+		case *banktypes.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				sent = sent.Add(input.Coins...)
+			}
+
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			innerSent, err := bankSentAmount(innerMsgs)
+			if err != nil {
+				return nil, err
+			}
+			sent = sent.Add(innerSent...)
+		}
+	}
+
+	return sent, nil
+}
+
+// addSigners adds to signers the addresses of the keys signing the data, the
+// keys of a multisig being found through the bit array of its signature.
+func addSigners(signers map[string]struct{}, pubKey cryptotypes.PubKey, data signing.SignatureData) {
+	multi, ok := data.(*signing.MultiSignatureData)
+	if !ok {
+		signers[string(pubKey.Address())] = struct{}{}
+		return
+	}
+	multiPubKey, ok := pubKey.(multisig.PubKey)
+	if !ok || multi.BitArray == nil {
+		return
+	}
+
+	pubKeys := multiPubKey.GetPubKeys()
+	sigIndex := 0
+	for i := 0; i < multi.BitArray.Count() && i < len(pubKeys); i++ {
+		if !multi.BitArray.GetIndex(i) {
+			continue
+		}
+		if sigIndex >= len(multi.Signatures) {
+			return
+		}
+		addSigners(signers, pubKeys[i], multi.Signatures[sigIndex])
+		sigIndex++
+	}
+}
@@ -0,0 +1,123 @@
+package ante_test
+
+import (
+	"testing"
+
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/ante"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+func TestHighValueSignersDecorator(t *testing.T) {
+	sender := sdk.AccAddress("sender______________")
+	recipient := sdk.AccAddress("recipient___________")
+	thresholds := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+	params := mockParamSource{
+		string(policytypes.ParamStoreKeyHighValueTransferThresholds): thresholds,
+		string(policytypes.ParamStoreKeyMinHighValueSigners):         uint64(2),
+	}
+	atThreshold := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+	overThreshold := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1001))
+
+	specs := map[string]struct {
+		params mockParamSource
+		msgs   []sdk.Msg
+		sigs   []signing.SignatureV2
+		expErr bool
+	}{
+		"high value send with a single signature": {
+			params: params,
+			msgs:   []sdk.Msg{banktypes.NewMsgSend(sender, recipient, overThreshold)},
+			sigs:   []signing.SignatureV2{singleSig()},
+			expErr: true,
+		},
+		"high value send with a multisig": {
+			params: params,
+			msgs:   []sdk.Msg{banktypes.NewMsgSend(sender, recipient, overThreshold)},
+			sigs:   []signing.SignatureV2{multiSig(2, 3)},
+		},
+		"high value send with a multisig of a duplicate key": {
+			params: params,
+			msgs:   []sdk.Msg{banktypes.NewMsgSend(sender, recipient, overThreshold)},
+			sigs:   []signing.SignatureV2{duplicateKeyMultiSig()},
+			expErr: true,
+		},
+		"high value send with two signatures of a key": {
+			params: params,
+			msgs:   []sdk.Msg{banktypes.NewMsgSend(sender, recipient, overThreshold)},
+			sigs: func() []signing.SignatureV2 {
+				sig := singleSig()
+				return []signing.SignatureV2{sig, sig}
+			}(),
+			expErr: true,
+		},
+		"low value send with a single signature": {
+			params: params,
+			msgs:   []sdk.Msg{banktypes.NewMsgSend(sender, recipient, atThreshold)},
+			sigs:   []signing.SignatureV2{singleSig()},
+		},
+		"low value sends summed over the threshold": {
+			params: params,
+			msgs: []sdk.Msg{
+				banktypes.NewMsgSend(sender, recipient, atThreshold),
+				banktypes.NewMsgMultiSend(
+					[]banktypes.Input{banktypes.NewInput(sender, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))},
+					[]banktypes.Output{banktypes.NewOutput(recipient, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))},
+				),
+			},
+			sigs:   []signing.SignatureV2{singleSig()},
+			expErr: true,
+		},
+		"authz high value send with a single signature": {
+			params: params,
+			msgs: func() []sdk.Msg {
+				msg := authz.NewMsgExec(recipient, []sdk.Msg{banktypes.NewMsgSend(sender, recipient, overThreshold)})
+				return []sdk.Msg{&msg}
+			}(),
+			sigs:   []signing.SignatureV2{singleSig()},
+			expErr: true,
+		},
+		"send of a denom without a threshold": {
+			params: params,
+			msgs:   []sdk.Msg{banktypes.NewMsgSend(sender, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000_000)))},
+			sigs:   []signing.SignatureV2{singleSig()},
+		},
+		"check disabled": {
+			params: mockParamSource{string(policytypes.ParamStoreKeyHighValueTransferThresholds): thresholds},
+			msgs:   []sdk.Msg{banktypes.NewMsgSend(sender, recipient, overThreshold)},
+			sigs:   []signing.SignatureV2{singleSig()},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			txBuilder := newTestTxBuilder(t, spec.msgs...)
+			require.NoError(t, txBuilder.SetSignatures(spec.sigs...))
+			decorator := ante.NewHighValueSignersDecorator(spec.params)
+			anteRejection{err: sdkerrors.ErrUnauthorized}.requireAnteHandle(t, decorator, txBuilder.GetTx(), false, spec.expErr)
+		})
+	}
+}
+
+// duplicateKeyMultiSig returns the signature of a 2 of 2 multisig listing a
+// new key twice, signed through both of its slots.
+func duplicateKeyMultiSig() signing.SignatureV2 {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	data := &signing.MultiSignatureData{BitArray: cryptotypes.NewCompactBitArray(2)}
+	for i := 0; i < 2; i++ {
+		data.BitArray.SetIndex(i, true)
+		data.Signatures = append(data.Signatures, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: []byte("sig")})
+	}
+	return signing.SignatureV2{
+		PubKey: kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{pubKey, pubKey}),
+		Data:   data,
+	}
+}
@@ -0,0 +1,70 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// MaxSignaturesDecorator rejects the transactions with more signatures than
+// the MaxSignaturesPerTx policy param, bounding the cost of verifying the
+// signatures of a transaction. The signatures of a multisig count
+// individually, nested multisigs included.
+//
+// Unlike the TxSigLimit auth param, which bounds the public keys of the
+// signers, it bounds the signatures actually verified.
+type MaxSignaturesDecorator struct {
+	policyParam policy.ParamSource
+}
+
+func NewMaxSignaturesDecorator(policyParam policy.ParamSource) MaxSignaturesDecorator {
+	return MaxSignaturesDecorator{policyParam: policyParam}
+}
+
+func (d MaxSignaturesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	maxSignatures := policytypes.DefaultMaxSignaturesPerTx
+	if d.policyParam.Has(ctx, policytypes.ParamStoreKeyMaxSignaturesPerTx) {
+		d.policyParam.Get(ctx, policytypes.ParamStoreKeyMaxSignaturesPerTx, &maxSignatures)
+	}
+	if maxSignatures == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	var count uint64
+	for _, sig := range sigs {
+		count += CountSignatures(sig.Data)
+	}
+	if count > maxSignatures {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrTooManySignatures, "%d signatures exceed the limit of %d signatures", count, maxSignatures)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// CountSignatures returns the number of signatures of the signature data,
+// counting the signatures of a multisig individually.
+func CountSignatures(data signing.SignatureData) uint64 {
+	multi, ok := data.(*signing.MultiSignatureData)
+	if !ok {
+		return 1
+	}
+
+	var count uint64
+	for _, sig := range multi.Signatures {
+		count += CountSignatures(sig)
+	}
+	return count
+}
@@ -0,0 +1,104 @@
+package ante_test
+
+import (
+	"testing"
+
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/ante"
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// singleSig returns the signature of a new key.
+func singleSig() signing.SignatureV2 {
+	return signing.SignatureV2{
+		PubKey: secp256k1.GenPrivKey().PubKey(),
+		Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("sig")},
+	}
+}
+
+// multiSig returns the signature of a new threshold multisig of n keys signed
+// by threshold of its keys.
+func multiSig(threshold, n int) signing.SignatureV2 {
+	pubKeys := make([]cryptotypes.PubKey, n)
+	for i := range pubKeys {
+		pubKeys[i] = secp256k1.GenPrivKey().PubKey()
+	}
+	data := &signing.MultiSignatureData{BitArray: cryptotypes.NewCompactBitArray(n)}
+	for i := 0; i < threshold; i++ {
+		data.BitArray.SetIndex(i, true)
+		data.Signatures = append(data.Signatures, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: []byte("sig")})
+	}
+	return signing.SignatureV2{
+		PubKey: kmultisig.NewLegacyAminoPubKey(threshold, pubKeys),
+		Data:   data,
+	}
+}
+
+func TestMaxSignaturesDecorator(t *testing.T) {
+	txConfig := gaiaapp.MakeTestEncodingConfig().TxConfig
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	limit := func(n uint64) mockParamSource {
+		return mockParamSource{string(policytypes.ParamStoreKeyMaxSignaturesPerTx): n}
+	}
+
+	specs := map[string]struct {
+		params mockParamSource
+		sigs   []signing.SignatureV2
+		expErr bool
+	}{
+		"at the limit": {
+			params: limit(2),
+			sigs:   []signing.SignatureV2{singleSig(), singleSig()},
+		},
+		"over the limit": {
+			params: limit(2),
+			sigs:   []signing.SignatureV2{singleSig(), singleSig(), singleSig()},
+			expErr: true,
+		},
+		"multisig at the limit": {
+			params: limit(5),
+			sigs:   []signing.SignatureV2{singleSig(), multiSig(4, 7)},
+		},
+		"multisig expanding over the limit": {
+			params: limit(5),
+			sigs:   []signing.SignatureV2{singleSig(), multiSig(5, 7)},
+			expErr: true,
+		},
+		"limit disabled": {
+			params: limit(0),
+			sigs:   []signing.SignatureV2{multiSig(50, 50), multiSig(60, 60)},
+		},
+		"default limit": {
+			sigs:   []signing.SignatureV2{multiSig(50, 50), multiSig(60, 60)},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			msgs := make([]sdk.Msg, len(spec.sigs))
+			for i, sig := range spec.sigs {
+				msgs[i] = testdata.NewTestMsg(sdk.AccAddress(sig.PubKey.Address()))
+			}
+			require.NoError(t, txBuilder.SetMsgs(msgs...))
+			require.NoError(t, txBuilder.SetSignatures(spec.sigs...))
+			decorator := ante.NewMaxSignaturesDecorator(spec.params)
+
+			_, err := decorator.AnteHandle(sdk.Context{}, txBuilder.GetTx(), false, next)
+			if spec.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrTooManySignatures)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
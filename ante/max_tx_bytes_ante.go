@@ -0,0 +1,71 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmstrings "github.com/tendermint/tendermint/libs/strings"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// MaxTxBytesDecorator rejects the transactions whose serialized size exceeds
+// the MaxTxBytes policy param, bounding each transaction below the block
+// size limit of the consensus params.
+//
+// With the MaxTxBytesBypassExempt policy param, the transactions bypassing
+// the fees, i.e. made only of bypass message types within the bypass gas
+// limit, are exempt. The bypass message types are node local config, so the
+// check then only applies in CheckTx, otherwise it applies in both CheckTx and
+// DeliverTx.
+type MaxTxBytesDecorator struct {
+	policyParam            policy.ParamSource
+	bypassMsgTypes         []string
+	maxTotalBypassGasUsage uint64
+}
+
+func NewMaxTxBytesDecorator(policyParam policy.ParamSource, bypassMsgTypes []string, maxTotalBypassGasUsage uint64) MaxTxBytesDecorator {
+	return MaxTxBytesDecorator{
+		policyParam:            policyParam,
+		bypassMsgTypes:         bypassMsgTypes,
+		maxTotalBypassGasUsage: maxTotalBypassGasUsage,
+	}
+}
+
+func (d MaxTxBytesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var maxTxBytes uint64
+	if d.policyParam.Has(ctx, policytypes.ParamStoreKeyMaxTxBytes) {
+		d.policyParam.Get(ctx, policytypes.ParamStoreKeyMaxTxBytes, &maxTxBytes)
+	}
+	if maxTxBytes == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	var bypassExempt bool
+	if d.policyParam.Has(ctx, policytypes.ParamStoreKeyMaxTxBytesBypassExempt) {
+		d.policyParam.Get(ctx, policytypes.ParamStoreKeyMaxTxBytesBypassExempt, &bypassExempt)
+	}
+	if bypassExempt && (!ctx.IsCheckTx() || d.bypassesFees(tx)) {
+		return next(ctx, tx, simulate)
+	}
+
+	if txBytes := uint64(len(ctx.TxBytes())); txBytes > maxTxBytes {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrTxTooLarge, "tx size %d bytes exceeds the limit of %d bytes", txBytes, maxTxBytes)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// bypassesFees returns whether the tx is made only of bypass message types
+// within the bypass gas limit, as the fee decorator accepts with zero fees.
+func (d MaxTxBytesDecorator) bypassesFees(tx sdk.Tx) bool {
+	if feeTx, ok := tx.(sdk.FeeTx); !ok || feeTx.GetGas() > d.maxTotalBypassGasUsage {
+		return false
+	}
+	for _, msg := range tx.GetMsgs() {
+		if !tmstrings.StringInSlice(sdk.MsgTypeURL(msg), d.bypassMsgTypes) {
+			return false
+		}
+	}
+	return true
+}
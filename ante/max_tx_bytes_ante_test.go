@@ -0,0 +1,118 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/ante"
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+func TestMaxTxBytesDecorator(t *testing.T) {
+	signer := sdk.AccAddress("signer______________")
+	txConfig := gaiaapp.MakeTestEncodingConfig().TxConfig
+	bypassMsgTypes := []string{sdk.MsgTypeURL(&testdata.TestMsg{})}
+	maxBypassGas := uint64(1_000_000)
+
+	newTx := func(msg sdk.Msg, gas uint64) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetGasLimit(gas)
+		return txBuilder.GetTx()
+	}
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	dog := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+
+	limited := mockParamSource{string(policytypes.ParamStoreKeyMaxTxBytes): uint64(1000)}
+	bypassExempt := mockParamSource{
+		string(policytypes.ParamStoreKeyMaxTxBytes):             uint64(1000),
+		string(policytypes.ParamStoreKeyMaxTxBytesBypassExempt): true,
+	}
+
+	specs := map[string]struct {
+		params  mockParamSource
+		txBytes int
+		msg     sdk.Msg
+		gas     uint64
+		checkTx bool
+		expErr  bool
+	}{
+		"at the limit": {
+			params:  limited,
+			txBytes: 1000,
+			msg:     dog,
+			checkTx: true,
+		},
+		"over the limit": {
+			params:  limited,
+			txBytes: 1001,
+			msg:     dog,
+			checkTx: true,
+			expErr:  true,
+		},
+		"over the limit in deliver tx": {
+			params:  limited,
+			txBytes: 1001,
+			msg:     dog,
+			expErr:  true,
+		},
+		"limit disabled": {
+			txBytes: 1_000_000,
+			msg:     dog,
+			checkTx: true,
+		},
+		"bypassed tx over the limit, not exempt": {
+			params:  limited,
+			txBytes: 1001,
+			msg:     testdata.NewTestMsg(signer),
+			gas:     maxBypassGas,
+			checkTx: true,
+			expErr:  true,
+		},
+		"bypassed tx over the limit, exempt": {
+			params:  bypassExempt,
+			txBytes: 1001,
+			msg:     testdata.NewTestMsg(signer),
+			gas:     maxBypassGas,
+			checkTx: true,
+		},
+		"bypass msg above the bypass gas limit over the limit, exempt": {
+			params:  bypassExempt,
+			txBytes: 1001,
+			msg:     testdata.NewTestMsg(signer),
+			gas:     maxBypassGas + 1,
+			checkTx: true,
+			expErr:  true,
+		},
+		"tx over the limit, exempt": {
+			params:  bypassExempt,
+			txBytes: 1001,
+			msg:     dog,
+			checkTx: true,
+			expErr:  true,
+		},
+		"tx over the limit in deliver tx, exempt": {
+			params:  bypassExempt,
+			txBytes: 1001,
+			msg:     dog,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithIsCheckTx(spec.checkTx).WithTxBytes(make([]byte, spec.txBytes))
+			decorator := ante.NewMaxTxBytesDecorator(spec.params, bypassMsgTypes, maxBypassGas)
+
+			_, err := decorator.AnteHandle(ctx, newTx(spec.msg, spec.gas), false, next)
+			if spec.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrTxTooLarge)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
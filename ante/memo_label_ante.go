@@ -0,0 +1,70 @@
+package ante
+
+import (
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeMemoLabel is emitted for the txs with a labeled memo, with a
+	// label attribute per label of the memo.
+	EventTypeMemoLabel = "memo_label"
+	// AttributeKeyLabel is the attribute holding a key:value label.
+	AttributeKeyLabel = "label"
+)
+
+// memoLabelsRegex matches the labeled memos, made of semicolon separated
+// key:value labels, e.g. app:myapp;action:swap. The charset of the values is
+// restricted so that the labels can be used as is in tx search queries.
+var memoLabelsRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]*:[A-Za-z0-9._/-]+(;[a-z][a-z0-9_-]*:[A-Za-z0-9._/-]+)*$`)
+
+// ParseMemoLabels returns the distinct key:value labels of a labeled memo, in
+// order of appearance, and false for a memo not following the convention.
+func ParseMemoLabels(memo string) ([]string, bool) {
+	if !memoLabelsRegex.MatchString(memo) {
+		return nil, false
+	}
+
+	var labels []string
+	seen := make(map[string]bool)
+	for _, label := range strings.Split(memo, ";") {
+		if seen[label] {
+			continue
+		}
+		seen[label] = true
+		labels = append(labels, label)
+	}
+	return labels, true
+}
+
+// MemoLabelDecorator emits a memo_label event for the transactions with a
+// labeled memo, so that the tx indexer of the node indexes them by label and
+// they can be searched with the memo_label.label event. The memos not
+// following the convention are ignored, the transactions are never rejected.
+type MemoLabelDecorator struct{}
+
+func NewMemoLabelDecorator() MemoLabelDecorator {
+	return MemoLabelDecorator{}
+}
+
+func (d MemoLabelDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	labels, ok := ParseMemoLabels(memoTx.GetMemo())
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	attrs := make([]sdk.Attribute, len(labels))
+	for i, label := range labels {
+		attrs[i] = sdk.NewAttribute(AttributeKeyLabel, label)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeMemoLabel, attrs...))
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,103 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/ante"
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+)
+
+func TestParseMemoLabels(t *testing.T) {
+	specs := map[string]struct {
+		memo      string
+		expLabels []string
+		expOk     bool
+	}{
+		"single label": {
+			memo:      "app:myapp",
+			expLabels: []string{"app:myapp"},
+			expOk:     true,
+		},
+		"multiple labels": {
+			memo:      "app:myapp;action:swap;pool:gamm/pool/1",
+			expLabels: []string{"app:myapp", "action:swap", "pool:gamm/pool/1"},
+			expOk:     true,
+		},
+		"duplicate labels": {
+			memo:      "app:myapp;app:myapp",
+			expLabels: []string{"app:myapp"},
+			expOk:     true,
+		},
+		"free text memo": {
+			memo: "thanks for the coffee",
+		},
+		"empty memo": {
+			memo: "",
+		},
+		"trailing separator": {
+			memo: "app:myapp;",
+		},
+		"empty value": {
+			memo: "app:",
+		},
+		"uppercase key": {
+			memo: "App:myapp",
+		},
+		"quote in value": {
+			memo: "app:my'app",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			labels, ok := ante.ParseMemoLabels(spec.memo)
+			require.Equal(t, spec.expOk, ok)
+			require.Equal(t, spec.expLabels, labels)
+		})
+	}
+}
+
+func TestMemoLabelDecorator(t *testing.T) {
+	signer := sdk.AccAddress("signer______________")
+	txConfig := gaiaapp.MakeTestEncodingConfig().TxConfig
+	decorator := ante.NewMemoLabelDecorator()
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	memos := []string{
+		"app:myapp;action:swap",
+		"app:myapp;action:send",
+		"app:otherapp;action:swap",
+		"not a labeled memo",
+		"",
+	}
+
+	// index the txs by the labels of their events, as the tx indexer does
+	index := make(map[string][]int)
+	for i, memo := range memos {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(signer)))
+		txBuilder.SetMemo(memo)
+
+		ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+		_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, next)
+		require.NoError(t, err)
+
+		for _, event := range ctx.EventManager().Events() {
+			require.Equal(t, ante.EventTypeMemoLabel, event.Type)
+			for _, attr := range event.Attributes {
+				require.Equal(t, ante.AttributeKeyLabel, string(attr.Key))
+				index[string(attr.Value)] = append(index[string(attr.Value)], i)
+			}
+		}
+	}
+
+	require.Equal(t, map[string][]int{
+		"app:myapp":    {0, 1},
+		"app:otherapp": {2},
+		"action:swap":  {0, 2},
+		"action:send":  {1},
+	}, index)
+}
@@ -0,0 +1,96 @@
+package ante
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// MemoRequiredDecorator rejects the transactions with an empty memo sending
+// funds through the bank messages to an address of the MemoRequiredAddresses
+// policy param, e.g. the deposit address of an exchange telling apart its
+// users by memo. The messages executed through authz are checked as well.
+type MemoRequiredDecorator struct {
+	policyParam policy.ParamSource
+}
+
+func NewMemoRequiredDecorator(policyParam policy.ParamSource) MemoRequiredDecorator {
+	return MemoRequiredDecorator{
+		policyParam: policyParam,
+	}
+}
+
+func (d MemoRequiredDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var addrs []string
+	if d.policyParam.Has(ctx, policytypes.ParamStoreKeyMemoRequiredAddresses) {
+		d.policyParam.Get(ctx, policytypes.ParamStoreKeyMemoRequiredAddresses, &addrs)
+	}
+	if len(addrs) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a TxWithMemo")
+	}
+	if strings.TrimSpace(memoTx.GetMemo()) != "" {
+		return next(ctx, tx, simulate)
+	}
+
+	memoRequired := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		memoRequired[canonicalAddress(addr)] = true
+	}
+	if err := validateMemoNotRequired(memoRequired, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validateMemoNotRequired returns an error if one of the msgs sends funds to
+// a memo required address.
+func validateMemoNotRequired(memoRequired map[string]bool, msgs []sdk.Msg) error {
+	for _, m := range msgs {
+		switch msg := m.(type) {
+		case *banktypes.MsgSend:
+			if memoRequired[canonicalAddress(msg.ToAddress)] {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "a memo is required to send funds to %s", msg.ToAddress)
+			}
+
+		case *banktypes.MsgMultiSend:
+			for _, output := range msg.Outputs {
+				if memoRequired[canonicalAddress(output.Address)] {
+					return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "a memo is required to send funds to %s", output.Address)
+				}
+			}
+
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			if err := validateMemoNotRequired(memoRequired, innerMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// canonicalAddress returns the lower case encoding of a bech32 address, as
+// bech32 addresses may also be encoded in upper case.
+func canonicalAddress(addr string) string {
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return addr
+	}
+	return accAddr.String()
+}
@@ -0,0 +1,87 @@
+package ante_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v9/ante"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+func TestMemoRequiredDecorator(t *testing.T) {
+	sender := sdk.AccAddress("sender______________")
+	exchange := sdk.AccAddress("exchange____________")
+	other := sdk.AccAddress("other_______________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	memoRequired := []string{exchange.String()}
+
+	newTx := func(memo string, msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := newTestTxBuilder(t, msgs...)
+		txBuilder.SetMemo(memo)
+		return txBuilder.GetTx()
+	}
+
+	specs := map[string]struct {
+		addrs  []string
+		tx     sdk.Tx
+		expErr bool
+	}{
+		"send to a memo required address without memo": {
+			addrs:  memoRequired,
+			tx:     newTx("", banktypes.NewMsgSend(sender, exchange, coins)),
+			expErr: true,
+		},
+		"send to a memo required address with memo": {
+			addrs: memoRequired,
+			tx:    newTx("104729", banktypes.NewMsgSend(sender, exchange, coins)),
+		},
+		"send to a memo required address with a blank memo": {
+			addrs:  memoRequired,
+			tx:     newTx("  ", banktypes.NewMsgSend(sender, exchange, coins)),
+			expErr: true,
+		},
+		"send to a memo required address in upper case without memo": {
+			addrs:  memoRequired,
+			tx:     newTx("", &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: strings.ToUpper(exchange.String()), Amount: coins}),
+			expErr: true,
+		},
+		"send to a normal address without memo": {
+			addrs: memoRequired,
+			tx:    newTx("", banktypes.NewMsgSend(sender, other, coins)),
+		},
+		"send to a normal address with memo": {
+			addrs: memoRequired,
+			tx:    newTx("hello", banktypes.NewMsgSend(sender, other, coins)),
+		},
+		"multi send to a memo required address without memo": {
+			addrs: memoRequired,
+			tx: newTx("", banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(sender, coins.Add(coins...))},
+				[]banktypes.Output{banktypes.NewOutput(other, coins), banktypes.NewOutput(exchange, coins)},
+			)),
+			expErr: true,
+		},
+		"authz send to a memo required address without memo": {
+			addrs: memoRequired,
+			tx: func() sdk.Tx {
+				msg := authz.NewMsgExec(other, []sdk.Msg{banktypes.NewMsgSend(sender, exchange, coins)})
+				return newTx("", &msg)
+			}(),
+			expErr: true,
+		},
+		"no memo required address set": {
+			tx: newTx("", banktypes.NewMsgSend(sender, exchange, coins)),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			decorator := ante.NewMemoRequiredDecorator(mockParamSource{string(policytypes.ParamStoreKeyMemoRequiredAddresses): spec.addrs})
+			anteRejection{err: sdkerrors.ErrInvalidRequest, msg: "memo is required"}.requireAnteHandle(t, decorator, spec.tx, false, spec.expErr)
+		})
+	}
+}
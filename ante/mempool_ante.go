@@ -0,0 +1,332 @@
+package ante
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"sync"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// MempoolEvictionPolicy defines which tx gives way when the MempoolIndex is
+// full.
+type MempoolEvictionPolicy string
+
+const (
+	// EvictLowestFee evicts the pending tx with the lowest gas price when a tx
+	// paying a higher gas price comes in, the incoming tx being rejected
+	// otherwise.
+	EvictLowestFee MempoolEvictionPolicy = "lowest-fee"
+	// EvictNone rejects the incoming txs while the mempool is full.
+	EvictNone MempoolEvictionPolicy = "none"
+)
+
+// ParseMempoolEvictionPolicy returns the MempoolEvictionPolicy of the given
+// name, EvictLowestFee when empty.
+func ParseMempoolEvictionPolicy(name string) (MempoolEvictionPolicy, error) {
+	switch policy := MempoolEvictionPolicy(name); policy {
+	case "":
+		return EvictLowestFee, nil
+	case EvictLowestFee, EvictNone:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown mempool eviction policy %q, expected %q or %q", name, EvictLowestFee, EvictNone)
+	}
+}
+
+// MempoolIndex keeps track of the txs accepted in CheckTx by this node and
+// not included in a block yet, to cap their number. When full, the index
+// applies its eviction policy to the incoming txs.
+//
+// The SDK leaves the mempool to Tendermint, so the index can't remove a
+// pending tx by itself: an evicted tx is instead rejected when it is next
+// rechecked, after the following block, which requires the recheck option of
+// the Tendermint mempool to be set. Likewise, the index is not told of the txs
+// Tendermint drops without rechecking them, e.g. once their mempool TTL
+// expires: as the recheck runs right after each commit, a tx which was neither
+// added nor rechecked between two commits is no longer pending and is removed
+// from the index at the second commit. The index is node local, it is not part
+// of the consensus state.
+type MempoolIndex struct {
+	mtx    sync.Mutex
+	maxTxs int
+	policy MempoolEvictionPolicy
+	// txs maps the hash of a pending tx to its queue entry
+	txs map[string]*pendingTx
+	// queue orders the pending txs by ascending gas price
+	queue pendingTxQueue
+	// evicted maps the hashes of the evicted txs not rechecked yet to the
+	// number of commits at their eviction
+	evicted map[string]uint64
+	// seq is the number of txs added to the index, used to order the txs with
+	// the same gas price
+	seq uint64
+	// commits is the number of commits since the index was created
+	commits uint64
+}
+
+// NewMempoolIndex returns a MempoolIndex holding at most maxTxs pending txs.
+func NewMempoolIndex(maxTxs int, policy MempoolEvictionPolicy) *MempoolIndex {
+	return &MempoolIndex{
+		maxTxs:  maxTxs,
+		policy:  policy,
+		txs:     make(map[string]*pendingTx),
+		evicted: make(map[string]uint64),
+	}
+}
+
+// Len returns the number of pending txs in the index.
+func (idx *MempoolIndex) Len() int {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	return len(idx.txs)
+}
+
+// admit returns an error if a tx of the given gas price can't enter the
+// index.
+func (idx *MempoolIndex) admit(gasPrice sdk.Dec) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if len(idx.txs) < idx.maxTxs || len(idx.queue) == 0 {
+		return nil
+	}
+	if idx.policy == EvictLowestFee && gasPrice.GT(idx.queue[0].gasPrice) {
+		return nil
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrMempoolIsFull, "%d pending txs, a higher gas price than %s is required", len(idx.txs), idx.queue[0].gasPrice)
+}
+
+// add adds a pending tx to the index, evicting the pending tx with the lowest
+// gas price if the index is full. Among the txs with the same gas price, the
+// most recent one is evicted.
+func (idx *MempoolIndex) add(hash string, gasPrice sdk.Dec) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if _, ok := idx.txs[hash]; ok {
+		return
+	}
+	for len(idx.txs) >= idx.maxTxs && len(idx.queue) > 0 {
+		lowest := heap.Pop(&idx.queue).(*pendingTx)
+		delete(idx.txs, lowest.hash)
+		idx.evicted[lowest.hash] = idx.commits
+	}
+	idx.seq++
+	tx := &pendingTx{hash: hash, gasPrice: gasPrice, seq: idx.seq, seenAt: idx.commits}
+	heap.Push(&idx.queue, tx)
+	idx.txs[hash] = tx
+}
+
+// remove removes a pending tx from the index.
+func (idx *MempoolIndex) remove(hash string) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	delete(idx.evicted, hash)
+	if tx, ok := idx.txs[hash]; ok {
+		heap.Remove(&idx.queue, tx.index)
+		delete(idx.txs, hash)
+	}
+}
+
+// touch records that a pending tx was rechecked, so it is still pending.
+func (idx *MempoolIndex) touch(hash string) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if tx, ok := idx.txs[hash]; ok {
+		tx.seenAt = idx.commits
+	}
+}
+
+// takeEvicted returns true if the tx was evicted, forgetting it.
+func (idx *MempoolIndex) takeEvicted(hash string) bool {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	_, ok := idx.evicted[hash]
+	delete(idx.evicted, hash)
+	return ok
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (idx *MempoolIndex) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener, the delivered txs are
+// removed by the MempoolDecorator.
+func (idx *MempoolIndex) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (idx *MempoolIndex) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener, it removes the txs which were
+// neither added nor rechecked since the previous commit, and the evicted txs
+// which were not rechecked since then, as Tendermint dropped them.
+func (idx *MempoolIndex) ListenCommit(context.Context, abci.ResponseCommit) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	for hash, tx := range idx.txs {
+		if tx.seenAt < idx.commits {
+			heap.Remove(&idx.queue, tx.index)
+			delete(idx.txs, hash)
+		}
+	}
+	for hash, evictedAt := range idx.evicted {
+		if evictedAt < idx.commits {
+			delete(idx.evicted, hash)
+		}
+	}
+	idx.commits++
+	return nil
+}
+
+// Stream implements baseapp.StreamingService, the index does not stream the
+// store writes.
+func (idx *MempoolIndex) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService, the index does not listen
+// to the store writes.
+func (idx *MempoolIndex) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Close implements baseapp.StreamingService.
+func (idx *MempoolIndex) Close() error {
+	return nil
+}
+
+// pendingTx is a tx of the MempoolIndex.
+type pendingTx struct {
+	hash     string
+	gasPrice sdk.Dec
+	seq      uint64
+	// seenAt is the number of commits when the tx was last added or rechecked
+	seenAt uint64
+	// index is the position of the tx in the queue
+	index int
+}
+
+// pendingTxQueue is a heap of the pending txs, the tx with the lowest gas
+// price first.
+type pendingTxQueue []*pendingTx
+
+func (q pendingTxQueue) Len() int { return len(q) }
+
+func (q pendingTxQueue) Less(i, j int) bool {
+	if !q[i].gasPrice.Equal(q[j].gasPrice) {
+		return q[i].gasPrice.LT(q[j].gasPrice)
+	}
+	return q[i].seq > q[j].seq
+}
+
+func (q pendingTxQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *pendingTxQueue) Push(x interface{}) {
+	tx := x.(*pendingTx)
+	tx.index = len(*q)
+	*q = append(*q, tx)
+}
+
+func (q *pendingTxQueue) Pop() interface{} {
+	old := *q
+	tx := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	return tx
+}
+
+// MempoolDecorator caps the number of txs pending in the mempool of this node
+// with the MempoolIndex: a tx accepted in CheckTx is added to the index, a tx
+// included in a block is removed from it, and an evicted tx is rejected when
+// rechecked.
+//
+// The index is node local state, so the decorator never rejects a tx in
+// DeliverTx: it could make validators disagree on the block results.
+type MempoolDecorator struct {
+	index *MempoolIndex
+}
+
+// NewMempoolDecorator returns a MempoolDecorator, the number of pending txs is
+// not capped when the index is nil.
+func NewMempoolDecorator(index *MempoolIndex) MempoolDecorator {
+	return MempoolDecorator{
+		index: index,
+	}
+}
+
+func (md MempoolDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if md.index == nil || simulate {
+		return next(ctx, tx, simulate)
+	}
+	hash := string(tmtypes.Tx(ctx.TxBytes()).Hash())
+
+	switch {
+	case ctx.IsReCheckTx():
+		if md.index.takeEvicted(hash) {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrMempoolIsFull, "tx evicted by a tx with a higher gas price")
+		}
+		newCtx, err := next(ctx, tx, simulate)
+		if err != nil {
+			// the tx is dropped by the mempool
+			md.index.remove(hash)
+		} else {
+			md.index.touch(hash)
+		}
+		return newCtx, err
+
+	case ctx.IsCheckTx():
+		gasPrice := txGasPrice(tx)
+		if err := md.index.admit(gasPrice); err != nil {
+			return ctx, err
+		}
+		newCtx, err := next(ctx, tx, simulate)
+		if err == nil {
+			md.index.add(hash, gasPrice)
+		}
+		return newCtx, err
+
+	default:
+		md.index.remove(hash)
+		return next(ctx, tx, simulate)
+	}
+}
+
+// txGasPrice returns the gas price paid by a tx, the lowest gas price of its
+// fee denoms as for the SDK tx priority. A tx without fee or gas has a zero gas
+// price.
+func txGasPrice(tx sdk.Tx) sdk.Dec {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 || feeTx.GetFee().IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	gas := sdk.NewDecFromInt(sdk.NewIntFromUint64(feeTx.GetGas()))
+	var gasPrice sdk.Dec
+	for i, fee := range feeTx.GetFee() {
+		price := sdk.NewDecFromInt(fee.Amount).Quo(gas)
+		if i == 0 || price.LT(gasPrice) {
+			gasPrice = price
+		}
+	}
+	return gasPrice
+}
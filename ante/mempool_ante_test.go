@@ -0,0 +1,141 @@
+package ante_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/gaia/v9/ante"
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+)
+
+func TestMempoolDecorator(t *testing.T) {
+	txConfig := gaiaapp.MakeTestEncodingConfig().TxConfig
+
+	// newTx returns a tx paying the given fee for 100_000 gas, with its bytes
+	newTx := func(memo string, fee int64) (sdk.Tx, []byte) {
+		txBuilder := txConfig.NewTxBuilder()
+		txBuilder.SetMemo(memo)
+		txBuilder.SetGasLimit(100_000)
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", fee)))
+		bz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return txBuilder.GetTx(), bz
+	}
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	checkTx := func(decorator ante.MempoolDecorator, memo string, fee int64) error {
+		tx, bz := newTx(memo, fee)
+		_, err := decorator.AnteHandle(sdk.Context{}.WithIsCheckTx(true).WithTxBytes(bz), tx, false, next)
+		return err
+	}
+	recheckTx := func(decorator ante.MempoolDecorator, memo string, fee int64) error {
+		tx, bz := newTx(memo, fee)
+		_, err := decorator.AnteHandle(sdk.Context{}.WithIsReCheckTx(true).WithTxBytes(bz), tx, false, next)
+		return err
+	}
+	deliverTx := func(decorator ante.MempoolDecorator, memo string, fee int64) error {
+		tx, bz := newTx(memo, fee)
+		_, err := decorator.AnteHandle(sdk.Context{}.WithTxBytes(bz), tx, false, next)
+		return err
+	}
+
+	t.Run("lowest fee eviction", func(t *testing.T) {
+		index := ante.NewMempoolIndex(2, ante.EvictLowestFee)
+		decorator := ante.NewMempoolDecorator(index)
+		require.NoError(t, checkTx(decorator, "low", 100_000))
+		require.NoError(t, checkTx(decorator, "mid", 200_000))
+		require.Equal(t, 2, index.Len())
+
+		// the mempool is full, a tx paying a lower or the same gas price as the
+		// lowest one is rejected
+		require.ErrorIs(t, checkTx(decorator, "lower", 50_000), sdkerrors.ErrMempoolIsFull)
+		require.ErrorIs(t, checkTx(decorator, "same", 100_000), sdkerrors.ErrMempoolIsFull)
+
+		// a tx paying a higher gas price evicts the lowest one
+		require.NoError(t, checkTx(decorator, "high", 300_000))
+		require.Equal(t, 2, index.Len())
+		require.ErrorIs(t, recheckTx(decorator, "low", 100_000), sdkerrors.ErrMempoolIsFull)
+		require.NoError(t, recheckTx(decorator, "mid", 200_000))
+		require.NoError(t, recheckTx(decorator, "high", 300_000))
+
+		// a tx included in a block frees its place, even at a lower gas price
+		require.NoError(t, deliverTx(decorator, "high", 300_000))
+		require.Equal(t, 1, index.Len())
+		require.NoError(t, checkTx(decorator, "lower", 50_000))
+		require.Equal(t, 2, index.Len())
+	})
+
+	t.Run("no eviction", func(t *testing.T) {
+		index := ante.NewMempoolIndex(1, ante.EvictNone)
+		decorator := ante.NewMempoolDecorator(index)
+		require.NoError(t, checkTx(decorator, "low", 100_000))
+		require.ErrorIs(t, checkTx(decorator, "high", 300_000), sdkerrors.ErrMempoolIsFull)
+		require.NoError(t, recheckTx(decorator, "low", 100_000))
+	})
+
+	t.Run("rejected txs are not pending", func(t *testing.T) {
+		index := ante.NewMempoolIndex(1, ante.EvictLowestFee)
+		decorator := ante.NewMempoolDecorator(index)
+		failingNext := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			return ctx, errors.New("failure")
+		}
+
+		tx, bz := newTx("failing", 100_000)
+		_, err := decorator.AnteHandle(sdk.Context{}.WithIsCheckTx(true).WithTxBytes(bz), tx, false, failingNext)
+		require.Error(t, err)
+		require.Zero(t, index.Len())
+
+		// a pending tx failing its recheck is dropped by the mempool
+		require.NoError(t, checkTx(decorator, "failing", 100_000))
+		_, err = decorator.AnteHandle(sdk.Context{}.WithIsReCheckTx(true).WithTxBytes(bz), tx, false, failingNext)
+		require.Error(t, err)
+		require.Zero(t, index.Len())
+	})
+
+	t.Run("txs dropped without a recheck are not pending", func(t *testing.T) {
+		index := ante.NewMempoolIndex(2, ante.EvictNone)
+		decorator := ante.NewMempoolDecorator(index)
+		commit := func() {
+			require.NoError(t, index.ListenCommit(context.Background(), abci.ResponseCommit{}))
+		}
+		require.NoError(t, checkTx(decorator, "kept", 100_000))
+		require.NoError(t, checkTx(decorator, "dropped", 100_000))
+
+		// the txs added since the previous commit are pending
+		commit()
+		require.Equal(t, 2, index.Len())
+
+		// the tx Tendermint dropped is not rechecked and is forgotten at the
+		// next commit, freeing its place
+		require.NoError(t, recheckTx(decorator, "kept", 100_000))
+		require.ErrorIs(t, checkTx(decorator, "new", 100_000), sdkerrors.ErrMempoolIsFull)
+		commit()
+		require.Equal(t, 1, index.Len())
+		require.NoError(t, checkTx(decorator, "new", 100_000))
+	})
+
+	t.Run("no index", func(t *testing.T) {
+		decorator := ante.NewMempoolDecorator(nil)
+		require.NoError(t, checkTx(decorator, "low", 100_000))
+	})
+}
+
+func TestParseMempoolEvictionPolicy(t *testing.T) {
+	for name, expPolicy := range map[string]ante.MempoolEvictionPolicy{
+		"":           ante.EvictLowestFee,
+		"lowest-fee": ante.EvictLowestFee,
+		"none":       ante.EvictNone,
+	} {
+		policy, err := ante.ParseMempoolEvictionPolicy(name)
+		require.NoError(t, err)
+		require.Equal(t, expPolicy, policy)
+	}
+
+	_, err := ante.ParseMempoolEvictionPolicy("highest-fee")
+	require.Error(t, err)
+}
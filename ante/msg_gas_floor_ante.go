@@ -0,0 +1,74 @@
+package ante
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+// MsgGasFloorDecorator rejects the transactions declaring a gas limit below
+// the sum of the gas floors of their messages, as set by the MsgGasFloors
+// globalfee param. It protects the chain from under-gassed transactions of
+// message types that are cheap to declare but expensive to execute.
+//
+// The check is skipped in simulation, which is used to estimate the gas of a
+// transaction.
+type MsgGasFloorDecorator struct {
+	globalFeeParam globalfee.ParamSource
+}
+
+func NewMsgGasFloorDecorator(globalFeeParam globalfee.ParamSource) MsgGasFloorDecorator {
+	return MsgGasFloorDecorator{
+		globalFeeParam: globalFeeParam,
+	}
+}
+
+func (d MsgGasFloorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	var floors []globalfeetypes.MsgGasFloor
+	if d.globalFeeParam.Has(ctx, globalfeetypes.ParamStoreKeyMsgGasFloors) {
+		d.globalFeeParam.Get(ctx, globalfeetypes.ParamStoreKeyMsgGasFloors, &floors)
+	}
+	if len(floors) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	minGas := MsgsGasFloor(floors, tx.GetMsgs())
+	if feeTx.GetGas() < minGas {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "gas limit %d is below the gas floor %d of the tx messages", feeTx.GetGas(), minGas)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// MsgsGasFloor returns the sum of the gas floors of the msgs. The msgs whose
+// type has no gas floor don't add to the sum.
+func MsgsGasFloor(floors []globalfeetypes.MsgGasFloor, msgs []sdk.Msg) uint64 {
+	floorByType := make(map[string]uint64, len(floors))
+	for _, floor := range floors {
+		floorByType[floor.MsgTypeUrl] = floor.MinGas
+	}
+
+	var sum uint64
+	for _, msg := range msgs {
+		floor := floorByType[sdk.MsgTypeURL(msg)]
+		// saturate rather than wrap around, which would lower the floor
+		if sum+floor < sum {
+			return math.MaxUint64
+		}
+		sum += floor
+	}
+	return sum
+}
@@ -0,0 +1,72 @@
+package ante_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/gaia/v9/ante"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+func TestMsgGasFloorDecorator(t *testing.T) {
+	signer := sdk.AccAddress("signer______________")
+	dogMsg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	floors := []globalfeetypes.MsgGasFloor{
+		{MsgTypeUrl: sdk.MsgTypeURL(dogMsg), MinGas: 300_000},
+		{MsgTypeUrl: sdk.MsgTypeURL(&testdata.TestMsg{}), MinGas: 50_000},
+	}
+
+	newTx := func(gas uint64, msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := newTestTxBuilder(t, msgs...)
+		txBuilder.SetGasLimit(gas)
+		return txBuilder.GetTx()
+	}
+
+	specs := map[string]struct {
+		floors   []globalfeetypes.MsgGasFloor
+		tx       sdk.Tx
+		simulate bool
+		expErr   bool
+	}{
+		"gas above the floor": {
+			floors: floors,
+			tx:     newTx(400_000, dogMsg),
+		},
+		"gas at the summed floor": {
+			floors: floors,
+			tx:     newTx(400_000, dogMsg, testdata.NewTestMsg(signer), testdata.NewTestMsg(signer)),
+		},
+		"gas below the summed floor": {
+			floors: floors,
+			tx:     newTx(399_999, dogMsg, testdata.NewTestMsg(signer), testdata.NewTestMsg(signer)),
+			expErr: true,
+		},
+		"msg type without floor": {
+			floors: []globalfeetypes.MsgGasFloor{{MsgTypeUrl: sdk.MsgTypeURL(dogMsg), MinGas: 300_000}},
+			tx:     newTx(1, testdata.NewTestMsg(signer)),
+		},
+		"no floors set": {
+			tx: newTx(1, dogMsg),
+		},
+		"gas below the floor in simulation": {
+			floors:   floors,
+			tx:       newTx(0, dogMsg),
+			simulate: true,
+		},
+		"summed floor saturated": {
+			floors: []globalfeetypes.MsgGasFloor{{MsgTypeUrl: sdk.MsgTypeURL(dogMsg), MinGas: math.MaxUint64 / 2}},
+			tx:     newTx(math.MaxUint64-1, dogMsg, dogMsg, dogMsg),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			decorator := ante.NewMsgGasFloorDecorator(mockParamSource{string(globalfeetypes.ParamStoreKeyMsgGasFloors): spec.floors})
+			anteRejection{err: sdkerrors.ErrOutOfGas, msg: "gas floor"}.requireAnteHandle(t, decorator, spec.tx, spec.simulate, spec.expErr)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package ante_test
+
+import (
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockParamSource is a globalfee param source holding the param values by
+// key. A key missing from the map, or holding a nil value, is unset.
+type mockParamSource map[string]interface{}
+
+func (p mockParamSource) Get(_ sdk.Context, key []byte, ptr interface{}) {
+	if p.Has(sdk.Context{}, key) {
+		reflect.ValueOf(ptr).Elem().Set(reflect.ValueOf(p[string(key)]))
+	}
+}
+
+func (p mockParamSource) Has(_ sdk.Context, key []byte) bool {
+	value, ok := p[string(key)]
+	if !ok || value == nil {
+		return false
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Ptr:
+		return !v.IsNil()
+	default:
+		return true
+	}
+}
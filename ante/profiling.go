@@ -0,0 +1,178 @@
+package ante
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultAnteProfileRetention is the default number of most recent txs whose
+// ante decorator overhead is kept by the AnteProfileIndex.
+const DefaultAnteProfileRetention = 1_000
+
+// DecoratorProfile is the average overhead of an ante decorator over the most
+// recent txs it handled.
+type DecoratorProfile struct {
+	// Decorator is the type name of the decorator, with its package path
+	Decorator string
+	// Txs is the number of txs the averages are computed over
+	Txs uint64
+	// AvgDuration is the average time spent in the decorator per tx
+	AvgDuration time.Duration
+	// AvgGas is the average gas consumed by the decorator per tx
+	AvgGas uint64
+}
+
+// anteSample is the overhead of a decorator for a single tx.
+type anteSample struct {
+	duration time.Duration
+	gas      uint64
+}
+
+// AnteProfileIndex keeps in memory the time spent and the gas consumed by
+// each ante decorator for the most recent txs handled by this node, in
+// CheckTx and DeliverTx.
+type AnteProfileIndex struct {
+	mtx       sync.RWMutex
+	retention int
+	// decorators are the names of the instrumented decorators, in the order
+	// of the ante handler
+	decorators []string
+	// samples maps a decorator name to a ring of its most recent samples
+	samples map[string][]anteSample
+	// next maps a decorator name to the ring position of its next sample
+	next map[string]int
+}
+
+// NewAnteProfileIndex returns an AnteProfileIndex keeping the overhead of
+// the given number of most recent txs per decorator.
+func NewAnteProfileIndex(retention int) *AnteProfileIndex {
+	if retention <= 0 {
+		retention = DefaultAnteProfileRetention
+	}
+
+	return &AnteProfileIndex{
+		retention: retention,
+		samples:   make(map[string][]anteSample),
+		next:      make(map[string]int),
+	}
+}
+
+// Retention returns the number of txs kept per decorator by the index.
+func (idx *AnteProfileIndex) Retention() int {
+	return idx.retention
+}
+
+// Instrument wraps each decorator so that its overhead is recorded in the
+// index. The time and gas of a decorator are measured from its call until
+// it calls the next decorator or returns, so that they exclude the rest of
+// the chain.
+func (idx *AnteProfileIndex) Instrument(decorators []sdk.AnteDecorator) []sdk.AnteDecorator {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	instrumented := make([]sdk.AnteDecorator, len(decorators))
+	for i, decorator := range decorators {
+		name := decoratorName(decorator)
+		if _, ok := idx.samples[name]; !ok {
+			idx.decorators = append(idx.decorators, name)
+			idx.samples[name] = nil
+		}
+		instrumented[i] = profiledDecorator{name: name, decorator: decorator, index: idx}
+	}
+	return instrumented
+}
+
+// Profiles returns the average overhead of each instrumented decorator, in
+// the order of the ante handler. The decorators that handled no tx yet are
+// reported with zero averages.
+func (idx *AnteProfileIndex) Profiles() []DecoratorProfile {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	profiles := make([]DecoratorProfile, 0, len(idx.decorators))
+	for _, name := range idx.decorators {
+		profile := DecoratorProfile{Decorator: name}
+		samples := idx.samples[name]
+		if len(samples) > 0 {
+			var duration time.Duration
+			var gas uint64
+			for _, sample := range samples {
+				duration += sample.duration
+				gas += sample.gas
+			}
+			profile.Txs = uint64(len(samples))
+			profile.AvgDuration = duration / time.Duration(len(samples))
+			profile.AvgGas = gas / uint64(len(samples))
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles
+}
+
+func (idx *AnteProfileIndex) record(name string, sample anteSample) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	samples := idx.samples[name]
+	if len(samples) < idx.retention {
+		idx.samples[name] = append(samples, sample)
+		return
+	}
+	samples[idx.next[name]] = sample
+	idx.next[name] = (idx.next[name] + 1) % idx.retention
+}
+
+// decoratorName returns the type name of the decorator with its package
+// path, e.g. github.com/cosmos/gaia/v9/x/globalfee/ante.FeeDecorator.
+func decoratorName(decorator sdk.AnteDecorator) string {
+	t := reflect.TypeOf(decorator)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.PkgPath() + "." + t.Name()
+}
+
+// profiledDecorator records the overhead of the wrapped decorator in the
+// AnteProfileIndex.
+type profiledDecorator struct {
+	name      string
+	decorator sdk.AnteDecorator
+	index     *AnteProfileIndex
+}
+
+func (d profiledDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	start := time.Now()
+	recorded := false
+	measure := func(endCtx sdk.Context) {
+		recorded = true
+		d.index.record(d.name, anteSample{
+			duration: time.Since(start),
+			gas:      gasConsumedSince(ctx, endCtx),
+		})
+	}
+
+	newCtx, err := d.decorator.AnteHandle(ctx, tx, simulate, func(nextCtx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		measure(nextCtx)
+		return next(nextCtx, tx, simulate)
+	})
+	if !recorded {
+		measure(newCtx)
+	}
+	return newCtx, err
+}
+
+// gasConsumedSince returns the gas consumed between the start and the end
+// contexts of a decorator. A decorator setting up a new gas meter, e.g. the
+// SetUpContextDecorator, consumed all the gas of the new meter.
+func gasConsumedSince(startCtx, endCtx sdk.Context) uint64 {
+	if endCtx.GasMeter() == nil {
+		return 0
+	}
+	if startCtx.GasMeter() != endCtx.GasMeter() {
+		return endCtx.GasMeter().GasConsumed()
+	}
+	return endCtx.GasMeter().GasConsumed() - startCtx.GasMeter().GasConsumed()
+}
@@ -0,0 +1,59 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/gaia/v9/ante"
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/globalfee"
+)
+
+func TestAnteProfileIndex(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{Height: 1}).
+		WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	feeDecorator := ante.NewFeeDecorator(ante.HandlerOptions{
+		BypassMinFeeMsgTypes: gaiaapp.GetDefaultBypassFeeMessages(),
+		GlobalFeeSubspace:    app.GetSubspace(globalfee.ModuleName),
+		StakingSubspace:      app.GetSubspace(stakingtypes.ModuleName),
+	})
+	index := ante.NewAnteProfileIndex(2)
+	anteHandler := sdk.ChainAnteDecorators(index.Instrument([]sdk.AnteDecorator{
+		ante.NewMemoLabelDecorator(),
+		feeDecorator,
+	})...)
+
+	// no profile is recorded before the first tx
+	profiles := index.Profiles()
+	require.Len(t, profiles, 2)
+	require.Equal(t, "github.com/cosmos/gaia/v9/ante.MemoLabelDecorator", profiles[0].Decorator)
+	require.Equal(t, "github.com/cosmos/gaia/v9/x/globalfee/ante.FeeDecorator", profiles[1].Decorator)
+	require.Zero(t, profiles[1].Txs)
+
+	encodingConfig := gaiaapp.MakeTestEncodingConfig()
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(sdk.AccAddress("addr1"))))
+	txBuilder.SetGasLimit(100_000)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)))
+
+	// the oldest txs are dropped beyond the retention
+	for i := 0; i < 3; i++ {
+		_, err := anteHandler(ctx, txBuilder.GetTx(), false)
+		require.NoError(t, err)
+	}
+
+	profiles = index.Profiles()
+	require.Len(t, profiles, 2)
+	for _, profile := range profiles {
+		require.Equal(t, uint64(2), profile.Txs)
+	}
+	require.Positive(t, profiles[1].AvgDuration)
+}
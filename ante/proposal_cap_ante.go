@@ -0,0 +1,75 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	gaiagov "github.com/cosmos/gaia/v9/x/gov"
+	"github.com/cosmos/gaia/v9/x/policy"
+)
+
+// ProposalCapDecorator rejects the transactions submitting proposals once the
+// number of proposals in their deposit or voting period reaches the
+// MaxActiveProposals policy param, counting the proposals submitted by the
+// transaction. The messages executed through authz are checked as well. It
+// only rejects them early: the gov msg server enforces the cap over every
+// executed message, including those of the interchain accounts.
+type ProposalCapDecorator struct {
+	govKeeper   gaiagov.ProposalQueueKeeper
+	policyParam policy.ParamSource
+}
+
+func NewProposalCapDecorator(govKeeper gaiagov.ProposalQueueKeeper, policyParam policy.ParamSource) ProposalCapDecorator {
+	return ProposalCapDecorator{
+		govKeeper:   govKeeper,
+		policyParam: policyParam,
+	}
+}
+
+func (d ProposalCapDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	maxProposals := gaiagov.MaxActiveProposals(ctx, d.policyParam)
+	if maxProposals == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	submitted, err := countSubmittedProposals(tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	if submitted == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	active := gaiagov.CountActiveProposals(ctx, d.govKeeper, maxProposals)
+	if active+submitted > maxProposals {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot submit proposals, %d proposals are already in their deposit or voting period, the limit is %d", active, maxProposals)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// countSubmittedProposals returns the number of proposals submitted by the
+// msgs.
+func countSubmittedProposals(msgs []sdk.Msg) (uint64, error) {
+	var count uint64
+	for _, m := range msgs {
+		switch msg := m.(type) {
+		case *govtypes.MsgSubmitProposal:
+			count++
+
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			innerCount, err := countSubmittedProposals(innerMsgs)
+			if err != nil {
+				return 0, err
+			}
+			count += innerCount
+		}
+	}
+	return count, nil
+}
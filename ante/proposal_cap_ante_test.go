@@ -0,0 +1,108 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/ante"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// mockProposalQueueKeeper holds the number of proposals in their deposit and
+// voting periods
+type mockProposalQueueKeeper struct {
+	inDeposit, inVoting int
+}
+
+func (k mockProposalQueueKeeper) IterateInactiveProposalsQueue(_ sdk.Context, _ time.Time, cb func(govtypes.Proposal) bool) {
+	iterateProposals(k.inDeposit, govtypes.StatusDepositPeriod, cb)
+}
+
+func (k mockProposalQueueKeeper) IterateActiveProposalsQueue(_ sdk.Context, _ time.Time, cb func(govtypes.Proposal) bool) {
+	iterateProposals(k.inVoting, govtypes.StatusVotingPeriod, cb)
+}
+
+func iterateProposals(n int, status govtypes.ProposalStatus, cb func(govtypes.Proposal) bool) {
+	for i := 0; i < n; i++ {
+		if cb(govtypes.Proposal{ProposalId: uint64(i + 1), Status: status}) {
+			return
+		}
+	}
+}
+
+func TestProposalCapDecorator(t *testing.T) {
+	proposer := sdk.AccAddress("proposer____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+
+	newProposalMsg := func() sdk.Msg {
+		msg, err := govtypes.NewMsgSubmitProposal(govtypes.NewTextProposal("title", "description"), deposit, proposer)
+		require.NoError(t, err)
+		return msg
+	}
+
+	atCap := mockProposalQueueKeeper{inDeposit: 2, inVoting: 1}
+	belowCap := mockProposalQueueKeeper{inDeposit: 1, inVoting: 1}
+
+	specs := map[string]struct {
+		keeper       mockProposalQueueKeeper
+		maxProposals uint64
+		tx           sdk.Tx
+		expErr       bool
+	}{
+		"at the cap, proposal": {
+			keeper:       atCap,
+			maxProposals: 3,
+			tx:           newTestTx(t, newProposalMsg()),
+			expErr:       true,
+		},
+		"at the cap, proposal through authz": {
+			keeper:       atCap,
+			maxProposals: 3,
+			tx: func() sdk.Tx {
+				exec := authz.NewMsgExec(grantee, []sdk.Msg{newProposalMsg()})
+				return newTestTx(t, &exec)
+			}(),
+			expErr: true,
+		},
+		"at the cap, other msg": {
+			keeper:       atCap,
+			maxProposals: 3,
+			tx:           newTestTx(t, banktypes.NewMsgSend(proposer, grantee, deposit)),
+		},
+		"at the cap, proposals in deposit period only": {
+			keeper:       mockProposalQueueKeeper{inDeposit: 3},
+			maxProposals: 3,
+			tx:           newTestTx(t, newProposalMsg()),
+			expErr:       true,
+		},
+		"below the cap, proposal": {
+			keeper:       belowCap,
+			maxProposals: 3,
+			tx:           newTestTx(t, newProposalMsg()),
+		},
+		"below the cap, proposals over the cap": {
+			keeper:       belowCap,
+			maxProposals: 3,
+			tx:           newTestTx(t, newProposalMsg(), newProposalMsg()),
+			expErr:       true,
+		},
+		"cap disabled": {
+			keeper: atCap,
+			tx:     newTestTx(t, newProposalMsg()),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			decorator := ante.NewProposalCapDecorator(spec.keeper, mockParamSource{string(policytypes.ParamStoreKeyMaxActiveProposals): spec.maxProposals})
+			anteRejection{err: sdkerrors.ErrInvalidRequest}.requireAnteHandle(t, decorator, spec.tx, false, spec.expErr)
+		})
+	}
+}
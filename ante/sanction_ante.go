@@ -0,0 +1,107 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// SanctionKeeper defines the expected sanction keeper
+type SanctionKeeper interface {
+	SendRestriction(ctx sdk.Context, from, to sdk.AccAddress) error
+}
+
+// SanctionDecorator rejects the transactions signed by a sanctioned address,
+// paid for by a sanctioned fee granter, or sending funds to a sanctioned
+// address through the bank messages, a vesting account creation or a withdraw
+// address. The messages executed through authz are checked as well.
+//
+// The sanctioned addresses are part of the state, so the check applies in
+// both CheckTx and DeliverTx.
+type SanctionDecorator struct {
+	sanctionKeeper SanctionKeeper
+}
+
+func NewSanctionDecorator(sanctionKeeper SanctionKeeper) SanctionDecorator {
+	return SanctionDecorator{
+		sanctionKeeper: sanctionKeeper,
+	}
+}
+
+func (d SanctionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		if err := d.sanctionKeeper.SendRestriction(ctx, feeTx.FeeGranter(), nil); err != nil {
+			return ctx, err
+		}
+	}
+
+	if err := d.validateMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d SanctionDecorator) validateMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, m := range msgs {
+		for _, signer := range m.GetSigners() {
+			if err := d.sanctionKeeper.SendRestriction(ctx, signer, nil); err != nil {
+				return err
+			}
+		}
+
+		switch msg := m.(type) {
+		case *banktypes.MsgSend:
+			to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+			if err != nil {
+				return err
+			}
+			if err := d.sanctionKeeper.SendRestriction(ctx, nil, to); err != nil {
+				return err
+			}
+
+		case *banktypes.MsgMultiSend:
+			for _, output := range msg.Outputs {
+				to, err := sdk.AccAddressFromBech32(output.Address)
+				if err != nil {
+					return err
+				}
+				if err := d.sanctionKeeper.SendRestriction(ctx, nil, to); err != nil {
+					return err
+				}
+			}
+
+		case *vestingtypes.MsgCreateVestingAccount:
+			to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+			if err != nil {
+				return err
+			}
+			if err := d.sanctionKeeper.SendRestriction(ctx, nil, to); err != nil {
+				return err
+			}
+
+		case *distrtypes.MsgSetWithdrawAddress:
+			withdrawAddr, err := sdk.AccAddressFromBech32(msg.WithdrawAddress)
+			if err != nil {
+				return err
+			}
+			if err := d.sanctionKeeper.SendRestriction(ctx, nil, withdrawAddr); err != nil {
+				return err
+			}
+
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			if err := d.validateMsgs(ctx, innerMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/gaia/v9/ante"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	sanctiontypes "github.com/cosmos/gaia/v9/x/sanction/types"
+)
+
+func TestSanctionDecorator(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	txConfig := app.GetTxConfig()
+
+	sanctioned := sdk.AccAddress("sanctioned__________")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	app.SanctionKeeper.SetSanctioned(ctx, sanctioned)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	newTx := func(feeGranter sdk.AccAddress, msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		txBuilder.SetFeeGranter(feeGranter)
+		return txBuilder.GetTx()
+	}
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	specs := map[string]struct {
+		tx     sdk.Tx
+		expErr bool
+	}{
+		"normal send": {
+			tx: newTx(nil, banktypes.NewMsgSend(alice, bob, coins)),
+		},
+		"normal multi send": {
+			tx: newTx(nil, banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(alice, coins)},
+				[]banktypes.Output{banktypes.NewOutput(bob, coins)},
+			)),
+		},
+		"sanctioned sender": {
+			tx:     newTx(nil, banktypes.NewMsgSend(sanctioned, bob, coins)),
+			expErr: true,
+		},
+		"sanctioned recipient": {
+			tx:     newTx(nil, banktypes.NewMsgSend(alice, sanctioned, coins)),
+			expErr: true,
+		},
+		"sanctioned multi send recipient": {
+			tx: newTx(nil, banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(alice, coins.Add(coins...))},
+				[]banktypes.Output{banktypes.NewOutput(bob, coins), banktypes.NewOutput(sanctioned, coins)},
+			)),
+			expErr: true,
+		},
+		"normal vesting account": {
+			tx: newTx(nil, vestingtypes.NewMsgCreateVestingAccount(alice, bob, coins, 1_000, false)),
+		},
+		"sanctioned vesting account": {
+			tx:     newTx(nil, vestingtypes.NewMsgCreateVestingAccount(alice, sanctioned, coins, 1_000, false)),
+			expErr: true,
+		},
+		"normal withdraw address": {
+			tx: newTx(nil, distrtypes.NewMsgSetWithdrawAddress(alice, bob)),
+		},
+		"sanctioned withdraw address": {
+			tx:     newTx(nil, distrtypes.NewMsgSetWithdrawAddress(alice, sanctioned)),
+			expErr: true,
+		},
+		"sanctioned fee granter": {
+			tx:     newTx(sanctioned, banktypes.NewMsgSend(alice, bob, coins)),
+			expErr: true,
+		},
+		"sanctioned authz granter": {
+			tx: func() sdk.Tx {
+				msg := authz.NewMsgExec(bob, []sdk.Msg{banktypes.NewMsgSend(sanctioned, bob, coins)})
+				return newTx(nil, &msg)
+			}(),
+			expErr: true,
+		},
+		"sanctioned authz withdraw address": {
+			tx: func() sdk.Tx {
+				msg := authz.NewMsgExec(bob, []sdk.Msg{distrtypes.NewMsgSetWithdrawAddress(alice, sanctioned)})
+				return newTx(nil, &msg)
+			}(),
+			expErr: true,
+		},
+		"sanctioned authz recipient": {
+			tx: func() sdk.Tx {
+				msg := authz.NewMsgExec(bob, []sdk.Msg{banktypes.NewMsgSend(alice, sanctioned, coins)})
+				return newTx(nil, &msg)
+			}(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			decorator := ante.NewSanctionDecorator(app.SanctionKeeper)
+
+			// the sanctioned addresses are part of the state, both modes apply
+			for _, checkTx := range []bool{true, false} {
+				_, err := decorator.AnteHandle(ctx.WithIsCheckTx(checkTx), spec.tx, false, next)
+				if spec.expErr {
+					require.ErrorIs(t, err, sanctiontypes.ErrSanctionedAddress)
+					continue
+				}
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	gaiagov "github.com/cosmos/gaia/v9/x/gov"
+)
+
+// SpendCapKeeper defines the expected recurring spend keeper
+type SpendCapKeeper interface {
+	ValidateSpendCap(ctx sdk.Context, amount sdk.Coins) error
+}
+
+// SpendCapDecorator rejects the submission of the community pool spend
+// proposals requesting more than the max spend fraction of the community
+// pool. For the recurring spends, the total amount of their payments is
+// capped. The content of the scheduled proposals and the proposals submitted
+// through authz are checked as well. It only rejects them early: the gov msg server enforces
+// the cap over every executed message, including those of the interchain
+// accounts.
+type SpendCapDecorator struct {
+	spendCapKeeper SpendCapKeeper
+}
+
+func NewSpendCapDecorator(spendCapKeeper SpendCapKeeper) SpendCapDecorator {
+	return SpendCapDecorator{
+		spendCapKeeper: spendCapKeeper,
+	}
+}
+
+func (d SpendCapDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.validateMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d SpendCapDecorator) validateMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, m := range msgs {
+		switch msg := m.(type) {
+		case *govtypes.MsgSubmitProposal:
+			amount, ok := gaiagov.SpendAmount(msg.GetContent(), ctx.BlockHeight())
+			if !ok {
+				continue
+			}
+			if err := d.spendCapKeeper.ValidateSpendCap(ctx, amount); err != nil {
+				return err
+			}
+
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			if err := d.validateMsgs(ctx, innerMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/gaia/v9/ante"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	govscheduletypes "github.com/cosmos/gaia/v9/x/govschedule/types"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+func TestSpendCapDecorator(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	txConfig := app.GetTxConfig()
+
+	// fund the community pool with 1000stake and cap the spends to half of it
+	poolCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, poolCoins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, poolCoins))
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(poolCoins...)
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+	app.RecurringSpendKeeper.SetParams(ctx, recurringspendtypes.Params{MaxSpendFraction: sdk.NewDecWithPrec(5, 1)})
+
+	proposer := sdk.AccAddress("proposer____________")
+	recipient := sdk.AccAddress("recipient___________")
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	newProposalMsg := func(content govtypes.Content) *govtypes.MsgSubmitProposal {
+		msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+		require.NoError(t, err)
+		return msg
+	}
+	spendProposal := func(amount int64) *govtypes.MsgSubmitProposal {
+		return newProposalMsg(distrtypes.NewCommunityPoolSpendProposal("title", "description", recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", amount))))
+	}
+	scheduledSpendProposal := func(amount int64) *govtypes.MsgSubmitProposal {
+		content, err := govscheduletypes.NewScheduledProposal("title", "description", 100, distrtypes.NewCommunityPoolSpendProposal("title", "description", recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", amount))))
+		require.NoError(t, err)
+		return newProposalMsg(content)
+	}
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		return txBuilder.GetTx()
+	}
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	specs := map[string]struct {
+		tx     sdk.Tx
+		expErr bool
+	}{
+		"spend within the cap": {
+			tx: newTx(spendProposal(500)),
+		},
+		"spend above the cap": {
+			tx:     newTx(spendProposal(501)),
+			expErr: true,
+		},
+		"recurring spend payments within the cap": {
+			tx: newTx(newProposalMsg(recurringspendtypes.NewRecurringCommunityPoolSpendProposal("title", "description", recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 10, 100))),
+		},
+		"recurring spend payment above the cap": {
+			tx:     newTx(newProposalMsg(recurringspendtypes.NewRecurringCommunityPoolSpendProposal("title", "description", recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 501)), 10, 10))),
+			expErr: true,
+		},
+		"recurring spend payments above the cap": {
+			tx:     newTx(newProposalMsg(recurringspendtypes.NewRecurringCommunityPoolSpendProposal("title", "description", recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 1, 1000))),
+			expErr: true,
+		},
+		"scheduled spend within the cap": {
+			tx: newTx(scheduledSpendProposal(500)),
+		},
+		"scheduled spend above the cap": {
+			tx:     newTx(scheduledSpendProposal(501)),
+			expErr: true,
+		},
+		"other proposal": {
+			tx: newTx(newProposalMsg(govtypes.NewTextProposal("title", "description"))),
+		},
+		"authz spend above the cap": {
+			tx: func() sdk.Tx {
+				msg := authz.NewMsgExec(proposer, []sdk.Msg{spendProposal(501)})
+				return newTx(&msg)
+			}(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			decorator := ante.NewSpendCapDecorator(app.RecurringSpendKeeper)
+
+			// the cap depends on the community pool, both modes apply
+			for _, checkTx := range []bool{true, false} {
+				_, err := decorator.AnteHandle(ctx.WithIsCheckTx(checkTx), spec.tx, false, next)
+				if spec.expErr {
+					require.ErrorIs(t, err, recurringspendtypes.ErrSpendCapExceeded)
+					continue
+				}
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+// SpendLimitKeeper defines the expected spend limit keeper
+type SpendLimitKeeper interface {
+	SendRestriction(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins) error
+}
+
+// SpendLimitDecorator rejects the transactions sending more than the spending
+// limit of an account over its window, through the bank sends, the multi-send
+// inputs, the vesting accounts created, the IBC transfers and the ICS-29
+// packet fees. The messages
+// executed through authz are checked as well, against the limit of the
+// granter.
+//
+// The amounts of the messages of a transaction are summed by sender. The
+// decorator only rejects the transactions early: the limits are enforced by
+// the bank keeper, which checks the sends again and records them once they
+// succeed, whichever message triggers them.
+type SpendLimitDecorator struct {
+	spendLimitKeeper SpendLimitKeeper
+}
+
+func NewSpendLimitDecorator(spendLimitKeeper SpendLimitKeeper) SpendLimitDecorator {
+	return SpendLimitDecorator{
+		spendLimitKeeper: spendLimitKeeper,
+	}
+}
+
+func (d SpendLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.validateMsgs(ctx, tx.GetMsgs(), make(map[string]sdk.Coins)); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validateMsgs checks the messages, adding their amounts to the amounts sent
+// by sender.
+func (d SpendLimitDecorator) validateMsgs(ctx sdk.Context, msgs []sdk.Msg, sent map[string]sdk.Coins) error {
+	for _, m := range msgs {
+		switch msg := m.(type) {
+		case *banktypes.MsgSend:
+			if err := d.sendRestriction(ctx, sent, msg.FromAddress, msg.Amount); err != nil {
+				return err
+			}
+
+		case *banktypes.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				if err := d.sendRestriction(ctx, sent, input.Address, input.Coins); err != nil {
+					return err
+				}
+			}
+
+		case *vestingtypes.MsgCreateVestingAccount:
+			if err := d.sendRestriction(ctx, sent, msg.FromAddress, msg.Amount); err != nil {
+				return err
+			}
+
+		case *ibctransfertypes.MsgTransfer:
+			if err := d.sendRestriction(ctx, sent, msg.Sender, sdk.NewCoins(msg.Token)); err != nil {
+				return err
+			}
+
+		case *ibcfeetypes.MsgPayPacketFee:
+			if err := d.sendRestriction(ctx, sent, msg.Signer, msg.Fee.Total()); err != nil {
+				return err
+			}
+
+		case *ibcfeetypes.MsgPayPacketFeeAsync:
+			if err := d.sendRestriction(ctx, sent, msg.PacketFee.RefundAddress, msg.PacketFee.Fee.Total()); err != nil {
+				return err
+			}
+
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			if err := d.validateMsgs(ctx, innerMsgs, sent); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (d SpendLimitDecorator) sendRestriction(ctx sdk.Context, sent map[string]sdk.Coins, sender string, amount sdk.Coins) error {
+	from, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+	sent[sender] = sent[sender].Add(amount...)
+	return d.spendLimitKeeper.SendRestriction(ctx, from, sent[sender])
+}
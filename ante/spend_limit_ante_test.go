@@ -0,0 +1,117 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/gaia/v9/ante"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	spendlimittypes "github.com/cosmos/gaia/v9/x/spendlimit/types"
+)
+
+func TestSpendLimitDecorator(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	txConfig := app.GetTxConfig()
+
+	limited := sdk.AccAddress("limited_____________")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	app.SpendLimitKeeper.SetSpendLimit(ctx, spendlimittypes.NewSpendLimit(limited, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 10))
+
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		return txBuilder.GetTx()
+	}
+	transfer := func(sender sdk.AccAddress, amount int64) sdk.Msg {
+		return ibctransfertypes.NewMsgTransfer("transfer", "channel-0", sdk.NewInt64Coin("stake", amount), sender.String(), "cosmos1recipient", clienttypes.NewHeight(0, 100), 0)
+	}
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	specs := map[string]struct {
+		tx     sdk.Tx
+		expErr bool
+	}{
+		"unlimited send": {
+			tx: newTx(banktypes.NewMsgSend(alice, bob, coins(1_000))),
+		},
+		"send within the limit": {
+			tx: newTx(banktypes.NewMsgSend(limited, bob, coins(100))),
+		},
+		"send over the limit": {
+			tx:     newTx(banktypes.NewMsgSend(limited, bob, coins(101))),
+			expErr: true,
+		},
+		"sends over the limit in a tx": {
+			tx:     newTx(banktypes.NewMsgSend(limited, bob, coins(60)), banktypes.NewMsgSend(limited, alice, coins(60))),
+			expErr: true,
+		},
+		"multi send input over the limit": {
+			tx: newTx(banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(alice, coins(1_000)), banktypes.NewInput(limited, coins(101))},
+				[]banktypes.Output{banktypes.NewOutput(bob, coins(1_101))},
+			)),
+			expErr: true,
+		},
+		"transfer within the limit": {
+			tx: newTx(transfer(limited, 100)),
+		},
+		"transfer over the limit": {
+			tx:     newTx(transfer(limited, 101)),
+			expErr: true,
+		},
+		"packet fee within the limit": {
+			tx: newTx(ibcfeetypes.NewMsgPayPacketFee(ibcfeetypes.NewFee(coins(40), coins(30), coins(30)), "transfer", "channel-0", limited.String(), nil)),
+		},
+		"packet fee over the limit": {
+			tx:     newTx(ibcfeetypes.NewMsgPayPacketFee(ibcfeetypes.NewFee(coins(40), coins(30), coins(31)), "transfer", "channel-0", limited.String(), nil)),
+			expErr: true,
+		},
+		"async packet fee over the limit": {
+			tx: newTx(ibcfeetypes.NewMsgPayPacketFeeAsync(
+				channeltypes.NewPacketId("transfer", "channel-0", 1),
+				ibcfeetypes.NewPacketFee(ibcfeetypes.NewFee(coins(101), nil, nil), limited.String(), nil),
+			)),
+			expErr: true,
+		},
+		"vesting account over the limit": {
+			tx:     newTx(vestingtypes.NewMsgCreateVestingAccount(limited, bob, coins(101), 1, false)),
+			expErr: true,
+		},
+		"authz send over the limit of the granter": {
+			tx: func() sdk.Tx {
+				msg := authz.NewMsgExec(bob, []sdk.Msg{banktypes.NewMsgSend(limited, bob, coins(101))})
+				return newTx(&msg)
+			}(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			decorator := ante.NewSpendLimitDecorator(app.SpendLimitKeeper)
+
+			// the spending limits are part of the state, both modes apply
+			for _, checkTx := range []bool{true, false} {
+				cacheCtx, _ := ctx.WithIsCheckTx(checkTx).CacheContext()
+				_, err := decorator.AnteHandle(cacheCtx, spec.tx, false, next)
+				if spec.expErr {
+					require.ErrorIs(t, err, spendlimittypes.ErrSpendLimitExceeded)
+					continue
+				}
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+)
+
+// TransferCapDecorator rejects the transactions moving more than the cap of
+// a denom of the TransferCaps policy param in a single transfer, i.e. a
+// bank send, an input or output of a bank multi send, or an outgoing IBC
+// transfer. The messages executed through authz are checked as well. It only
+// rejects them early: the bank and transfer msg servers enforce the caps over
+// every executed message, including those of the interchain accounts. The
+// incoming IBC transfers are capped by the policy.TransferCapMiddleware.
+type TransferCapDecorator struct {
+	policyParam policy.ParamSource
+}
+
+func NewTransferCapDecorator(policyParam policy.ParamSource) TransferCapDecorator {
+	return TransferCapDecorator{
+		policyParam: policyParam,
+	}
+}
+
+func (d TransferCapDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	caps := policy.TransferCaps(ctx, d.policyParam)
+	if caps.Empty() {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := validateTransferCaps(caps, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validateTransferCaps returns an error if one of the msgs transfers more
+// than the cap of a denom.
+func validateTransferCaps(caps sdk.Coins, msgs []sdk.Msg) error {
+	for _, m := range msgs {
+		switch msg := m.(type) {
+		case *banktypes.MsgSend:
+			if err := policy.ValidateTransferCaps(caps, msg.Amount); err != nil {
+				return err
+			}
+
+		case *banktypes.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				if err := policy.ValidateTransferCaps(caps, input.Coins); err != nil {
+					return err
+				}
+			}
+			for _, output := range msg.Outputs {
+				if err := policy.ValidateTransferCaps(caps, output.Coins); err != nil {
+					return err
+				}
+			}
+
+		case *ibctransfertypes.MsgTransfer:
+			if err := policy.ValidateTransferCaps(caps, sdk.Coins{msg.Token}); err != nil {
+				return err
+			}
+
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			if err := validateTransferCaps(caps, innerMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+
+	"github.com/cosmos/gaia/v9/ante"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+func TestTransferCapDecorator(t *testing.T) {
+	sender := sdk.AccAddress("sender______________")
+	recipient := sdk.AccAddress("recipient___________")
+	caps := sdk.NewCoins(sdk.NewInt64Coin("ubridged", 1000))
+	atCap := sdk.NewCoins(sdk.NewInt64Coin("ubridged", 1000))
+	overCap := sdk.NewCoins(sdk.NewInt64Coin("ubridged", 1001))
+	uncapped := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000))
+
+	newTransfer := func(token sdk.Coin) sdk.Msg {
+		return ibctransfertypes.NewMsgTransfer("transfer", "channel-0", token, sender.String(), "cosmos1recipient", clienttypes.NewHeight(0, 100), 0)
+	}
+
+	specs := map[string]struct {
+		caps   sdk.Coins
+		tx     sdk.Tx
+		expErr bool
+	}{
+		"send of a capped denom at the cap": {
+			caps: caps,
+			tx:   newTestTx(t, banktypes.NewMsgSend(sender, recipient, atCap)),
+		},
+		"send of a capped denom over the cap": {
+			caps:   caps,
+			tx:     newTestTx(t, banktypes.NewMsgSend(sender, recipient, overCap.Add(uncapped...))),
+			expErr: true,
+		},
+		"send of an uncapped denom": {
+			caps: caps,
+			tx:   newTestTx(t, banktypes.NewMsgSend(sender, recipient, uncapped)),
+		},
+		"multi send of a capped denom at the cap per output": {
+			caps: caps,
+			tx: newTestTx(t, banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(sender, atCap)},
+				[]banktypes.Output{banktypes.NewOutput(recipient, atCap)},
+			)),
+		},
+		"multi send of a capped denom over the cap in an input": {
+			caps: caps,
+			tx: newTestTx(t, banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(sender, atCap.Add(atCap...))},
+				[]banktypes.Output{banktypes.NewOutput(recipient, atCap), banktypes.NewOutput(sender, atCap)},
+			)),
+			expErr: true,
+		},
+		"IBC transfer of a capped denom at the cap": {
+			caps: caps,
+			tx:   newTestTx(t, newTransfer(atCap[0])),
+		},
+		"IBC transfer of a capped denom over the cap": {
+			caps:   caps,
+			tx:     newTestTx(t, newTransfer(overCap[0])),
+			expErr: true,
+		},
+		"authz send of a capped denom over the cap": {
+			caps: caps,
+			tx: func() sdk.Tx {
+				msg := authz.NewMsgExec(recipient, []sdk.Msg{banktypes.NewMsgSend(sender, recipient, overCap)})
+				return newTestTx(t, &msg)
+			}(),
+			expErr: true,
+		},
+		"no transfer cap set": {
+			tx: newTestTx(t, banktypes.NewMsgSend(sender, recipient, overCap)),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			decorator := ante.NewTransferCapDecorator(mockParamSource{string(policytypes.ParamStoreKeyTransferCaps): spec.caps})
+			anteRejection{err: sdkerrors.ErrInvalidRequest, msg: "exceeds the cap"}.requireAnteHandle(t, decorator, spec.tx, false, spec.expErr)
+		})
+	}
+}
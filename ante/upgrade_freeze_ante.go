@@ -0,0 +1,72 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	tmstrings "github.com/tendermint/tendermint/libs/strings"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// UpgradeKeeper defines the expected upgrade keeper
+type UpgradeKeeper interface {
+	GetUpgradePlan(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool)
+}
+
+// UpgradeFreezeDecorator rejects the transactions in the blocks right before
+// the height of a scheduled upgrade, as set by the UpgradeFreezeBlocks
+// policy param. Transactions made only of bypass message types, e.g. IBC
+// relaying, are still accepted.
+//
+// The bypass message types are node local config, so the check only applies
+// in CheckTx.
+type UpgradeFreezeDecorator struct {
+	upgradeKeeper  UpgradeKeeper
+	policyParam    policy.ParamSource
+	bypassMsgTypes []string
+}
+
+func NewUpgradeFreezeDecorator(upgradeKeeper UpgradeKeeper, policyParam policy.ParamSource, bypassMsgTypes []string) UpgradeFreezeDecorator {
+	return UpgradeFreezeDecorator{
+		upgradeKeeper:  upgradeKeeper,
+		policyParam:    policyParam,
+		bypassMsgTypes: bypassMsgTypes,
+	}
+}
+
+func (d UpgradeFreezeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// run checks only on CheckTx
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	if d.containsOnlyBypassMsgs(tx.GetMsgs()) {
+		return next(ctx, tx, simulate)
+	}
+
+	var freezeBlocks uint64
+	if d.policyParam.Has(ctx, policytypes.ParamStoreKeyUpgradeFreezeBlocks) {
+		d.policyParam.Get(ctx, policytypes.ParamStoreKeyUpgradeFreezeBlocks, &freezeBlocks)
+	}
+	if freezeBlocks == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	plan, found := d.upgradeKeeper.GetUpgradePlan(ctx)
+	if found && plan.Height-ctx.BlockHeight() <= int64(freezeBlocks) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "upgrade pending: %s is scheduled at height %d, only bypass messages are accepted", plan.Name, plan.Height)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d UpgradeFreezeDecorator) containsOnlyBypassMsgs(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		if !tmstrings.StringInSlice(sdk.MsgTypeURL(msg), d.bypassMsgTypes) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/ante"
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+type mockUpgradeKeeper struct {
+	plan *upgradetypes.Plan
+}
+
+func (k mockUpgradeKeeper) GetUpgradePlan(_ sdk.Context) (upgradetypes.Plan, bool) {
+	if k.plan == nil {
+		return upgradetypes.Plan{}, false
+	}
+	return *k.plan, true
+}
+
+func TestUpgradeFreezeDecorator(t *testing.T) {
+	signer := sdk.AccAddress("signer______________")
+	txConfig := gaiaapp.MakeTestEncodingConfig().TxConfig
+	bypassMsgTypes := []string{sdk.MsgTypeURL(&testdata.TestMsg{})}
+
+	newTx := func(msg sdk.Msg) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		return txBuilder.GetTx()
+	}
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	plan := &upgradetypes.Plan{Name: "v10", Height: 100}
+
+	specs := map[string]struct {
+		plan         *upgradetypes.Plan
+		freezeBlocks uint64
+		height       int64
+		msg          sdk.Msg
+		checkTx      bool
+		expErr       bool
+	}{
+		"within the freeze window": {
+			plan:         plan,
+			freezeBlocks: 10,
+			height:       90,
+			msg:          &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}},
+			checkTx:      true,
+			expErr:       true,
+		},
+		"before the freeze window": {
+			plan:         plan,
+			freezeBlocks: 10,
+			height:       89,
+			msg:          &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}},
+			checkTx:      true,
+		},
+		"bypass msg within the freeze window": {
+			plan:         plan,
+			freezeBlocks: 10,
+			height:       95,
+			msg:          testdata.NewTestMsg(signer),
+			checkTx:      true,
+		},
+		"no scheduled upgrade": {
+			freezeBlocks: 10,
+			height:       95,
+			msg:          &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}},
+			checkTx:      true,
+		},
+		"freeze window disabled": {
+			plan:    plan,
+			height:  95,
+			msg:     &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}},
+			checkTx: true,
+		},
+		"within the freeze window in deliver tx": {
+			plan:         plan,
+			freezeBlocks: 10,
+			height:       95,
+			msg:          &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithIsCheckTx(spec.checkTx).WithBlockHeight(spec.height)
+			decorator := ante.NewUpgradeFreezeDecorator(
+				mockUpgradeKeeper{plan: spec.plan},
+				mockParamSource{string(policytypes.ParamStoreKeyUpgradeFreezeBlocks): spec.freezeBlocks},
+				bypassMsgTypes,
+			)
+
+			_, err := decorator.AnteHandle(ctx, newTx(spec.msg), false, next)
+			if spec.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				require.Contains(t, err.Error(), "upgrade pending")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -44,8 +44,14 @@ import (
 	"github.com/cosmos/gaia/v9/app/keepers"
 	gaiaappparams "github.com/cosmos/gaia/v9/app/params"
 	"github.com/cosmos/gaia/v9/app/upgrades"
+	v10 "github.com/cosmos/gaia/v9/app/upgrades/v10"
 	v9 "github.com/cosmos/gaia/v9/app/upgrades/v9"
 	"github.com/cosmos/gaia/v9/x/globalfee"
+	gaiafeeante "github.com/cosmos/gaia/v9/x/globalfee/ante"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	"github.com/cosmos/gaia/v9/x/policy"
+	"github.com/cosmos/gaia/v9/x/query"
+	querytypes "github.com/cosmos/gaia/v9/x/query/types"
 
 	// unnamed import of statik for swagger UI support
 	_ "github.com/cosmos/cosmos-sdk/client/docs/statik"
@@ -55,7 +61,7 @@ var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
 
-	Upgrades = []upgrades.Upgrade{v9.Upgrade}
+	Upgrades = []upgrades.Upgrade{v9.Upgrade, v10.Upgrade}
 )
 
 var (
@@ -81,6 +87,42 @@ type GaiaApp struct { //nolint: revive
 	// simulation manager
 	sm           *module.SimulationManager
 	configurator module.Configurator
+
+	// FeeRejectionIndex keeps the txs rejected by this node for insufficient
+	// fees, it is nil unless enabled in query-indexes
+	FeeRejectionIndex *globalfee.FeeRejectionIndex
+	// GasPriceIndex keeps the gas prices paid by the txs delivered by this
+	// node, it is nil unless enabled in query-indexes
+	GasPriceIndex *globalfee.GasPriceIndex
+	// MinGasPriceTimelineIndex keeps the steps of the effective minimum gas
+	// prices at the end of the blocks delivered by this node, it is nil unless
+	// enabled in query-indexes
+	MinGasPriceTimelineIndex *globalfee.MinGasPriceTimelineIndex
+	// BypassRateIndex keeps the txs delivered by this node which bypassed the
+	// minimum fees, it is nil unless enabled in query-indexes
+	BypassRateIndex *globalfee.BypassRateIndex
+	// RewardIndex keeps the delegation rewards withdrawn in the blocks
+	// delivered by this node, it is nil unless enabled in query-indexes
+	RewardIndex *query.RewardIndex
+	// RelayIndex keeps the IBC packets relayed in the blocks delivered by
+	// this node, it is nil unless enabled in query-indexes
+	RelayIndex *query.RelayIndex
+	// TransferIndex keeps the channels each denom was transferred over in the
+	// blocks delivered by this node, it is nil unless enabled in query-indexes
+	TransferIndex *query.TransferIndex
+	// FeesPaidIndex keeps the sum of the fees paid by each address in the
+	// blocks delivered by this node, it is nil unless enabled in query-indexes
+	FeesPaidIndex *query.FeesPaidIndex
+	// AnteProfileIndex keeps the overhead of each ante decorator for the most
+	// recent txs handled by this node, it is nil unless ante-profiling is set
+	AnteProfileIndex *gaiaante.AnteProfileIndex
+	// MempoolIndex caps the number of txs pending in the mempool of this
+	// node, it is nil unless mempool-max-txs is set
+	MempoolIndex *gaiaante.MempoolIndex
+
+	// feeDecorator computes the minimum fee of the simulated txs with the
+	// same rules as the ante handler
+	feeDecorator gaiafeeante.FeeDecorator
 }
 
 func init() {
@@ -125,6 +167,32 @@ func NewGaiaApp(
 		interfaceRegistry: interfaceRegistry,
 		invCheckPeriod:    invCheckPeriod,
 	}
+	for _, index := range cast.ToStringSlice(appOpts.Get(gaiaappparams.QueryIndexesKey)) {
+		switch index {
+		case "rewards":
+			app.RewardIndex = query.NewRewardIndex(query.DefaultRewardHistoryRetention)
+			bApp.SetStreamingService(app.RewardIndex)
+		case "relays":
+			app.RelayIndex = query.NewRelayIndex(encodingConfig.TxConfig.TxDecoder(), query.DefaultRelayActivityRetention)
+			bApp.SetStreamingService(app.RelayIndex)
+		case "transfers":
+			app.TransferIndex = query.NewTransferIndex()
+			bApp.SetStreamingService(app.TransferIndex)
+		case "fees-paid":
+			app.FeesPaidIndex = query.NewFeesPaidIndex()
+			bApp.SetStreamingService(app.FeesPaidIndex)
+		case "fee-rejections":
+			app.FeeRejectionIndex = globalfee.NewFeeRejectionIndex(globalfee.DefaultFeeRejectionRetention)
+		case "gas-prices":
+			app.GasPriceIndex = globalfee.NewGasPriceIndex(globalfee.DefaultGasPriceRetention)
+		case "min-gas-price-timeline":
+			app.MinGasPriceTimelineIndex = globalfee.NewMinGasPriceTimelineIndex(globalfee.DefaultMinGasPriceTimelineRetention)
+		case "bypass-rate":
+			app.BypassRateIndex = globalfee.NewBypassRateIndex(globalfee.DefaultBypassRateRetention)
+		default:
+			panic(fmt.Sprintf("invalid 'query-indexes' config option: unknown index %q", index))
+		}
+	}
 
 	moduleAccountAddresses := app.ModuleAccountAddrs()
 
@@ -172,7 +240,10 @@ func NewGaiaApp(
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 
-	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	// the msg services are registered through the circuit breaker, so that
+	// the halted msg types fail however the msgs are executed
+	msgServer := policy.NewCircuitBreaker(app.MsgServiceRouter(), app.GetSubspace(policy.ModuleName))
+	app.configurator = module.NewConfigurator(app.appCodec, msgServer, app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
@@ -200,27 +271,78 @@ func NewGaiaApp(
 		app.Logger().Error("invalid 'bypass-min-fee-msg-types' config option", "error", err)
 		panic(fmt.Sprintf("invalid 'bypass-min-fee-msg-types' config option: %s", err))
 	}
+	// the bypass min fee msg types are node local config, so they are served
+	// by the node rather than by the globalfee module
+	globalfeetypes.RegisterNodeConfigServer(app.BaseApp.GRPCQueryRouter(), globalfee.NewNodeConfigServer(
+		bypassMinFeeMsgTypes,
+		gaiaante.MaxTotalBypassMinFeeMsgGasUsage,
+		app.GetSubspace(globalfee.ModuleName),
+		app.GetSubspace(policy.ModuleName),
+		app.GetSubspace(stakingtypes.ModuleName),
+		app.DynamicFeeKeeper,
+	))
+
+	var feePayerValidator gaiaante.FeePayerValidator
+	if allowlistFile := cast.ToString(appOpts.Get(gaiaappparams.FeePayerAllowlistFileKey)); allowlistFile != "" {
+		allowlist, err := gaiaante.LoadFeePayerAllowlist(allowlistFile)
+		if err != nil {
+			panic(fmt.Sprintf("invalid 'fee-payer-allowlist-file' config option: %s", err))
+		}
+		feePayerValidator = allowlist
+	}
+	if maxTxs := cast.ToInt(appOpts.Get(gaiaappparams.MempoolMaxTxsKey)); maxTxs > 0 {
+		policy, err := gaiaante.ParseMempoolEvictionPolicy(cast.ToString(appOpts.Get(gaiaappparams.MempoolEvictionPolicyKey)))
+		if err != nil {
+			panic(fmt.Sprintf("invalid 'mempool-eviction-policy' config option: %s", err))
+		}
+		app.MempoolIndex = gaiaante.NewMempoolIndex(maxTxs, policy)
+		bApp.SetStreamingService(app.MempoolIndex)
+	}
+	if cast.ToBool(appOpts.Get(gaiaappparams.AnteProfilingKey)) {
+		app.AnteProfileIndex = gaiaante.NewAnteProfileIndex(gaiaante.DefaultAnteProfileRetention)
+	}
 
-	anteHandler, err := gaiaante.NewAnteHandler(
-		gaiaante.HandlerOptions{
-			HandlerOptions: ante.HandlerOptions{
-				AccountKeeper:   app.AccountKeeper,
-				BankKeeper:      app.BankKeeper,
-				FeegrantKeeper:  app.FeeGrantKeeper,
-				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
-			},
-			Codec:                appCodec,
-			IBCkeeper:            app.IBCKeeper,
-			GovKeeper:            &app.GovKeeper,
-			BypassMinFeeMsgTypes: bypassMinFeeMsgTypes,
-			GlobalFeeSubspace:    app.GetSubspace(globalfee.ModuleName),
-			StakingSubspace:      app.GetSubspace(stakingtypes.ModuleName),
+	anteOpts := gaiaante.HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			FeegrantKeeper:  app.FeeGrantKeeper,
+			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 		},
-	)
+		Codec:                appCodec,
+		IBCkeeper:            app.IBCKeeper,
+		GovKeeper:            &app.GovKeeper,
+		BypassMinFeeMsgTypes: bypassMinFeeMsgTypes,
+		GlobalFeeSubspace:    app.GetSubspace(globalfee.ModuleName),
+		PolicySubspace:       app.GetSubspace(policy.ModuleName),
+		StakingSubspace:      app.GetSubspace(stakingtypes.ModuleName),
+		DynamicFees:          app.DynamicFeeKeeper,
+		FeePayerValidator:    feePayerValidator,
+		UpgradeKeeper:        app.UpgradeKeeper,
+		SanctionKeeper:       app.SanctionKeeper,
+		SpendCapKeeper:       app.RecurringSpendKeeper,
+		SpendLimitKeeper:     app.SpendLimitKeeper,
+		DelegationKeeper:     app.StakingKeeper,
+		Mempool:              app.MempoolIndex,
+		AnteProfiler:         app.AnteProfileIndex,
+	}
+	// the recorders are only set when their index is enabled, as a nil index
+	// does not make a nil recorder
+	if app.FeeRejectionIndex != nil {
+		anteOpts.FeeRejectionRecorder = app.FeeRejectionIndex
+	}
+	if app.GasPriceIndex != nil {
+		anteOpts.GasPriceRecorder = app.GasPriceIndex
+	}
+	if app.BypassRateIndex != nil {
+		anteOpts.BypassRecorder = app.BypassRateIndex
+	}
+	anteHandler, err := gaiaante.NewAnteHandler(anteOpts)
 	if err != nil {
 		panic(fmt.Errorf("failed to create AnteHandler: %s", err))
 	}
+	app.feeDecorator = gaiaante.NewFeeDecorator(anteOpts)
 
 	app.SetAnteHandler(anteHandler)
 	app.SetInitChainer(app.InitChainer)
@@ -365,11 +487,29 @@ func (app *GaiaApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *GaiaApp) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	// the gaia simulate endpoint wraps the SDK one to add the minimum fee of
+	// the simulated tx
+	querytypes.RegisterTxServer(
+		app.BaseApp.GRPCQueryRouter(),
+		query.NewTxServer(
+			app.BaseApp.Simulate,
+			clientCtx.TxConfig.TxDecoder(),
+			app.feeDecorator,
+			globalfee.NewGrpcQuerier(app.GetSubspace(globalfee.ModuleName), app.FeeRejectionIndex, app.GasPriceIndex, &app.DynamicFeeKeeper, app.MinGasPriceTimelineIndex, app.BypassRateIndex),
+		),
+	)
+	querytypes.RegisterAnteProfileServer(app.BaseApp.GRPCQueryRouter(), query.NewAnteProfileServer(app.AnteProfileIndex))
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *GaiaApp) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry)
+	// the globalfee params stream needs the Tendermint client to subscribe to
+	// the params changes, so it is registered here rather than by the module
+	globalfeetypes.RegisterWatchServer(app.BaseApp.GRPCQueryRouter(), globalfee.NewWatchServer(clientCtx))
+	// the mempool fees are read from the mempool of the node through the
+	// Tendermint client as well
+	globalfeetypes.RegisterMempoolServer(app.BaseApp.GRPCQueryRouter(), globalfee.NewMempoolServer(clientCtx))
 }
 
 // configure store loader that checks if version == upgradeHeight and applies store upgrades
@@ -403,6 +543,18 @@ func (app *GaiaApp) setupUpgradeHandlers() {
 	}
 }
 
+// RegisteredUpgradeHandlers returns the names of the upgrades the upgrade
+// keeper has a handler for.
+func (app *GaiaApp) RegisteredUpgradeHandlers() []string {
+	var names []string
+	for _, upgrade := range Upgrades {
+		if app.UpgradeKeeper.HasHandler(upgrade.UpgradeName) {
+			names = append(names, upgrade.UpgradeName)
+		}
+	}
+	return names
+}
+
 // RegisterSwaggerAPI registers swagger route with API Server
 func RegisterSwaggerAPI(rtr *mux.Router) {
 	statikFS, err := fs.New()
@@ -3,10 +3,12 @@ package gaia_test
 import (
 	"testing"
 
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	gaia "github.com/cosmos/gaia/v9/app"
 	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	gaiaappparams "github.com/cosmos/gaia/v9/app/params"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	db "github.com/tendermint/tm-db"
@@ -41,3 +43,50 @@ func TestGaiaApp_Export(t *testing.T) {
 	_, err := app.ExportAppStateAndValidators(true, []string{})
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
+
+type mapAppOptions map[string]interface{}
+
+func (ao mapAppOptions) Get(key string) interface{} {
+	return ao[key]
+}
+
+func TestGaiaApp_QueryIndexes(t *testing.T) {
+	newApp := func(appOpts servertypes.AppOptions) *gaia.GaiaApp {
+		return gaia.NewGaiaApp(
+			log.NewNopLogger(),
+			db.NewMemDB(),
+			nil,
+			true,
+			map[int64]bool{},
+			gaia.DefaultNodeHome,
+			0,
+			gaia.MakeTestEncodingConfig(),
+			appOpts,
+		)
+	}
+
+	// the indexes are opt-in
+	app := newApp(EmptyAppOptions{})
+	require.Nil(t, app.RewardIndex)
+	require.Nil(t, app.RelayIndex)
+	require.Nil(t, app.TransferIndex)
+	require.Nil(t, app.FeesPaidIndex)
+	require.Nil(t, app.FeeRejectionIndex)
+	require.Nil(t, app.GasPriceIndex)
+	require.Nil(t, app.MinGasPriceTimelineIndex)
+	require.Nil(t, app.BypassRateIndex)
+
+	app = newApp(mapAppOptions{gaiaappparams.QueryIndexesKey: []string{"rewards", "fees-paid", "gas-prices", "bypass-rate"}})
+	require.NotNil(t, app.RewardIndex)
+	require.Nil(t, app.RelayIndex)
+	require.Nil(t, app.TransferIndex)
+	require.NotNil(t, app.FeesPaidIndex)
+	require.Nil(t, app.FeeRejectionIndex)
+	require.NotNil(t, app.GasPriceIndex)
+	require.Nil(t, app.MinGasPriceTimelineIndex)
+	require.NotNil(t, app.BypassRateIndex)
+
+	require.Panics(t, func() {
+		newApp(mapAppOptions{gaiaappparams.QueryIndexesKey: []string{"unknown"}})
+	})
+}
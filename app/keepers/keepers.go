@@ -41,11 +41,39 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	autocompoundkeeper "github.com/cosmos/gaia/v9/x/autocompound/keeper"
+	autocompoundtypes "github.com/cosmos/gaia/v9/x/autocompound/types"
+	gaiabank "github.com/cosmos/gaia/v9/x/bank"
+	"github.com/cosmos/gaia/v9/x/denommigration"
+	denommigrationkeeper "github.com/cosmos/gaia/v9/x/denommigration/keeper"
+	denommigrationtypes "github.com/cosmos/gaia/v9/x/denommigration/types"
+	downtimegracekeeper "github.com/cosmos/gaia/v9/x/downtimegrace/keeper"
+	downtimegracetypes "github.com/cosmos/gaia/v9/x/downtimegrace/types"
 	"github.com/cosmos/gaia/v9/x/globalfee"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	"github.com/cosmos/gaia/v9/x/govschedule"
+	govschedulekeeper "github.com/cosmos/gaia/v9/x/govschedule/keeper"
+	govscheduletypes "github.com/cosmos/gaia/v9/x/govschedule/types"
+	"github.com/cosmos/gaia/v9/x/grantspool"
+	grantspoolkeeper "github.com/cosmos/gaia/v9/x/grantspool/keeper"
+	grantspooltypes "github.com/cosmos/gaia/v9/x/grantspool/types"
+	"github.com/cosmos/gaia/v9/x/policy"
+	"github.com/cosmos/gaia/v9/x/recurringspend"
+	recurringspendkeeper "github.com/cosmos/gaia/v9/x/recurringspend/keeper"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
+	"github.com/cosmos/gaia/v9/x/sanction"
+	sanctionkeeper "github.com/cosmos/gaia/v9/x/sanction/keeper"
+	sanctiontypes "github.com/cosmos/gaia/v9/x/sanction/types"
+	spendlimitkeeper "github.com/cosmos/gaia/v9/x/spendlimit/keeper"
+	spendlimittypes "github.com/cosmos/gaia/v9/x/spendlimit/types"
+	gaiatransfer "github.com/cosmos/gaia/v9/x/transfer"
 	ica "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts"
 	icahost "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	ibcfee "github.com/cosmos/ibc-go/v4/modules/apps/29-fee"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/keeper"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
@@ -74,7 +102,7 @@ type AppKeepers struct {
 
 	// keepers
 	AccountKeeper    authkeeper.AccountKeeper
-	BankKeeper       bankkeeper.Keeper
+	BankKeeper       gaiabank.Keeper
 	CapabilityKeeper *capabilitykeeper.Keeper
 	StakingKeeper    stakingkeeper.Keeper
 	SlashingKeeper   slashingkeeper.Keeper
@@ -87,12 +115,23 @@ type AppKeepers struct {
 	// IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	IBCKeeper       *ibckeeper.Keeper
 	ICAHostKeeper   icahostkeeper.Keeper
+	IBCFeeKeeper    ibcfeekeeper.Keeper
 	EvidenceKeeper  evidencekeeper.Keeper
 	TransferKeeper  ibctransferkeeper.Keeper
 	FeeGrantKeeper  feegrantkeeper.Keeper
 	AuthzKeeper     authzkeeper.Keeper
 	LiquidityKeeper liquiditykeeper.Keeper
 
+	RecurringSpendKeeper recurringspendkeeper.Keeper
+	SanctionKeeper       sanctionkeeper.Keeper
+	DenomMigrationKeeper denommigrationkeeper.Keeper
+	DowntimeGraceKeeper  downtimegracekeeper.Keeper
+	GrantsPoolKeeper     grantspoolkeeper.Keeper
+	GovScheduleKeeper    govschedulekeeper.Keeper
+	AutoCompoundKeeper   autocompoundkeeper.Keeper
+	SpendLimitKeeper     spendlimitkeeper.Keeper
+	DynamicFeeKeeper     globalfee.DynamicFeeKeeper
+
 	// ICS
 	ProviderKeeper ibcproviderkeeper.Keeper
 
@@ -100,7 +139,7 @@ type AppKeepers struct {
 
 	// Modules
 	ICAModule      ica.AppModule
-	TransferModule transfer.AppModule
+	TransferModule gaiatransfer.AppModule
 	RouterModule   router.AppModule
 	ProviderModule ibcprovider.AppModule
 
@@ -172,12 +211,23 @@ func NewAppKeeper(
 		authtypes.ProtoBaseAccount,
 		maccPerms,
 	)
-	appKeepers.BankKeeper = bankkeeper.NewBaseKeeper(
+	// the spending limits are enforced by the bank keeper, which is copied
+	// into the other keepers
+	appKeepers.SpendLimitKeeper = spendlimitkeeper.NewKeeper(appCodec, appKeepers.keys[spendlimittypes.StoreKey])
+	appKeepers.DynamicFeeKeeper = globalfee.NewDynamicFeeKeeper(appCodec, appKeepers.keys[globalfeetypes.StoreKey])
+
+	appKeepers.BankKeeper = gaiabank.NewKeeper(
+		bankkeeper.NewBaseKeeper(
+			appCodec,
+			appKeepers.keys[banktypes.StoreKey],
+			appKeepers.AccountKeeper,
+			appKeepers.GetSubspace(banktypes.ModuleName),
+			blockedAddress,
+		),
 		appCodec,
 		appKeepers.keys[banktypes.StoreKey],
-		appKeepers.AccountKeeper,
-		appKeepers.GetSubspace(banktypes.ModuleName),
-		blockedAddress,
+		appKeepers.SpendLimitKeeper,
+		appKeepers.GetSubspace(policy.ModuleName),
 	)
 
 	appKeepers.AuthzKeeper = authzkeeper.NewKeeper(
@@ -219,12 +269,49 @@ func NewAppKeeper(
 		modAccAddrs,
 	)
 
+	appKeepers.RecurringSpendKeeper = recurringspendkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[recurringspendtypes.StoreKey],
+		appKeepers.GetSubspace(recurringspendtypes.ModuleName),
+		appKeepers.DistrKeeper,
+	)
+
+	// the staking keeper is passed by reference, so that the compounding
+	// delegations call the staking hooks
+	appKeepers.AutoCompoundKeeper = autocompoundkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[autocompoundtypes.StoreKey],
+		appKeepers.GetSubspace(autocompoundtypes.ModuleName),
+		&stakingKeeper,
+		appKeepers.DistrKeeper,
+	)
+
+	appKeepers.GrantsPoolKeeper = grantspoolkeeper.NewKeeper(
+		appKeepers.GetSubspace(grantspooltypes.ModuleName),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		authtypes.FeeCollectorName,
+	)
+
+	appKeepers.SanctionKeeper = sanctionkeeper.NewKeeper(appKeepers.keys[sanctiontypes.StoreKey])
+
+	appKeepers.DenomMigrationKeeper = denommigrationkeeper.NewKeeper(
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		&stakingKeeper,
+		appKeepers.GetSubspace(policy.ModuleName),
+	)
+
 	appKeepers.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[slashingtypes.StoreKey],
 		&stakingKeeper,
 		appKeepers.GetSubspace(slashingtypes.ModuleName),
 	)
+	appKeepers.DowntimeGraceKeeper = downtimegracekeeper.NewKeeper(
+		appKeepers.GetSubspace(downtimegracetypes.ModuleName),
+		appKeepers.SlashingKeeper,
+	)
 	appKeepers.LiquidityKeeper = liquiditykeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[liquiditytypes.StoreKey],
@@ -240,6 +327,8 @@ func NewAppKeeper(
 		stakingtypes.NewMultiStakingHooks(
 			appKeepers.DistrKeeper.Hooks(),
 			appKeepers.SlashingKeeper.Hooks(),
+			// must come after the slashing hooks, which create the signing infos
+			appKeepers.DowntimeGraceKeeper.Hooks(),
 			appKeepers.ProviderKeeper.Hooks(),
 		),
 	)
@@ -290,13 +379,27 @@ func NewAppKeeper(
 	appKeepers.ProviderModule = ibcprovider.NewAppModule(&appKeepers.ProviderKeeper)
 
 	govRouter := govtypes.NewRouter()
+
+	// GovScheduleKeeper executes the content of the scheduled proposals
+	// through the gov router once their execution height is reached
+	appKeepers.GovScheduleKeeper = govschedulekeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[govscheduletypes.StoreKey],
+		govRouter,
+	)
+
 	govRouter.
 		AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(appKeepers.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(appKeepers.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(appKeepers.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(appKeepers.IBCKeeper.ClientKeeper)).
-		AddRoute(providertypes.RouterKey, ibcprovider.NewProviderProposalHandler(appKeepers.ProviderKeeper))
+		AddRoute(providertypes.RouterKey, ibcprovider.NewProviderProposalHandler(appKeepers.ProviderKeeper)).
+		AddRoute(recurringspendtypes.RouterKey, recurringspend.NewRecurringSpendProposalHandler(appKeepers.RecurringSpendKeeper)).
+		AddRoute(grantspooltypes.RouterKey, grantspool.NewGrantsPoolSpendProposalHandler(appKeepers.GrantsPoolKeeper)).
+		AddRoute(sanctiontypes.RouterKey, sanction.NewSanctionProposalHandler(appKeepers.SanctionKeeper)).
+		AddRoute(denommigrationtypes.RouterKey, denommigration.NewMigrateDenomProposalHandler(appKeepers.DenomMigrationKeeper)).
+		AddRoute(govscheduletypes.RouterKey, govschedule.NewScheduledProposalHandler(appKeepers.GovScheduleKeeper))
 
 	/*
 		Example of setting gov params:
@@ -313,6 +416,19 @@ func NewAppKeeper(
 		govRouter,
 	)
 
+	// IBCFeeKeeper escrows the ICS-29 relayer incentives, it wraps the channel
+	// keeper to send the packets of the fee enabled channels
+	appKeepers.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[ibcfeetypes.StoreKey],
+		appKeepers.GetSubspace(ibcfeetypes.ModuleName),
+		appKeepers.IBCKeeper.ChannelKeeper,
+		appKeepers.IBCKeeper.ChannelKeeper,
+		&appKeepers.IBCKeeper.PortKeeper,
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+	)
+
 	// RouterKeeper must be created before TransferKeeper
 	appKeepers.RouterKeeper = routerkeeper.NewKeeper(
 		appCodec,
@@ -322,14 +438,15 @@ func NewAppKeeper(
 		appKeepers.IBCKeeper.ChannelKeeper,
 		appKeepers.DistrKeeper,
 		appKeepers.BankKeeper,
-		appKeepers.IBCKeeper.ChannelKeeper,
+		appKeepers.IBCFeeKeeper,
 	)
 
+	// the packets sent with data above the max packet data size are rejected
 	appKeepers.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[ibctransfertypes.StoreKey],
 		appKeepers.GetSubspace(ibctransfertypes.ModuleName),
-		appKeepers.RouterKeeper,
+		policy.NewPacketSizeICS4Wrapper(appKeepers.RouterKeeper, appKeepers.GetSubspace(policy.ModuleName)),
 		appKeepers.IBCKeeper.ChannelKeeper,
 		&appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.AccountKeeper,
@@ -339,7 +456,7 @@ func NewAppKeeper(
 
 	appKeepers.RouterKeeper.SetTransferKeeper(appKeepers.TransferKeeper)
 
-	appKeepers.TransferModule = transfer.NewAppModule(appKeepers.TransferKeeper)
+	appKeepers.TransferModule = gaiatransfer.NewAppModule(appKeepers.TransferKeeper, appKeepers.GetSubspace(policy.ModuleName))
 
 	appKeepers.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec, appKeepers.keys[icahosttypes.StoreKey],
@@ -365,6 +482,18 @@ func NewAppKeeper(
 		routerkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
 		routerkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
 	)
+	// around the forward middleware, so that the packets for a sanctioned
+	// recipient are rejected before being forwarded
+	ibcStack = sanction.NewIBCMiddleware(ibcStack, appKeepers.SanctionKeeper)
+	// the packets above the transfer cap or the supply cap of their denom, or
+	// larger than the max packet data size, are rejected before being
+	// forwarded as well
+	ibcStack = policy.NewTransferCapMiddleware(ibcStack, appKeepers.GetSubspace(policy.ModuleName), appKeepers.BankKeeper)
+	ibcStack = policy.NewPacketSizeMiddleware(ibcStack, appKeepers.GetSubspace(policy.ModuleName))
+	// the fee middleware wraps the acknowledgements of the fee enabled
+	// channels, so it must also wrap the error acknowledgements of the
+	// rejected packets
+	ibcStack = ibcfee.NewIBCMiddleware(ibcStack, appKeepers.IBCFeeKeeper)
 
 	// create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter().
@@ -401,7 +530,13 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 
 	paramsKeeper.Subspace(routertypes.ModuleName).WithKeyTable(routertypes.ParamKeyTable())
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(ibcfeetypes.ModuleName)
 	paramsKeeper.Subspace(globalfee.ModuleName)
+	paramsKeeper.Subspace(policy.ModuleName)
+	paramsKeeper.Subspace(recurringspendtypes.ModuleName)
+	paramsKeeper.Subspace(downtimegracetypes.ModuleName)
+	paramsKeeper.Subspace(grantspooltypes.ModuleName)
+	paramsKeeper.Subspace(autocompoundtypes.ModuleName)
 	paramsKeeper.Subspace(providertypes.ModuleName)
 
 	return paramsKeeper
@@ -17,11 +17,20 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibchost "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
 	liquiditytypes "github.com/gravity-devs/liquidity/x/liquidity/types"
 	routertypes "github.com/strangelove-ventures/packet-forward-middleware/v4/router/types"
+
+	autocompoundtypes "github.com/cosmos/gaia/v9/x/autocompound/types"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	govscheduletypes "github.com/cosmos/gaia/v9/x/govschedule/types"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
+	sanctiontypes "github.com/cosmos/gaia/v9/x/sanction/types"
+	spendlimittypes "github.com/cosmos/gaia/v9/x/spendlimit/types"
+	gaiastaking "github.com/cosmos/gaia/v9/x/staking"
 )
 
 func (appKeepers *AppKeepers) GenerateKeys() {
@@ -33,11 +42,13 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, liquiditytypes.StoreKey, ibctransfertypes.StoreKey,
 		capabilitytypes.StoreKey, feegrant.StoreKey, authzkeeper.StoreKey, routertypes.StoreKey,
-		icahosttypes.StoreKey, providertypes.StoreKey,
+		icahosttypes.StoreKey, providertypes.StoreKey, recurringspendtypes.StoreKey,
+		sanctiontypes.StoreKey, ibcfeetypes.StoreKey, govscheduletypes.StoreKey,
+		autocompoundtypes.StoreKey, spendlimittypes.StoreKey, globalfeetypes.StoreKey,
 	)
 
 	// Define transient store keys
-	appKeepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	appKeepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey, gaiastaking.TStoreKey)
 
 	// MemKeys are for information that is stored only in RAM.
 	appKeepers.memKeys = sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -39,11 +39,16 @@ import (
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	ica "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts"
+	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	ibcfee "github.com/cosmos/ibc-go/v4/modules/apps/29-fee"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v4/modules/core"
 	ibcclientclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/client"
+	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcconnectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
 	ibchost "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibcprovider "github.com/cosmos/interchain-security/x/ccv/provider"
 	ibcproviderclient "github.com/cosmos/interchain-security/x/ccv/provider/client"
@@ -54,19 +59,49 @@ import (
 	routertypes "github.com/strangelove-ventures/packet-forward-middleware/v4/router/types"
 
 	gaiaappparams "github.com/cosmos/gaia/v9/app/params"
+	"github.com/cosmos/gaia/v9/x/autocompound"
+	autocompoundtypes "github.com/cosmos/gaia/v9/x/autocompound/types"
+	gaiabank "github.com/cosmos/gaia/v9/x/bank"
+	"github.com/cosmos/gaia/v9/x/denommigration"
+	denommigrationclient "github.com/cosmos/gaia/v9/x/denommigration/client"
+	gaiadistr "github.com/cosmos/gaia/v9/x/distribution"
+	"github.com/cosmos/gaia/v9/x/downtimegrace"
+	downtimegracetypes "github.com/cosmos/gaia/v9/x/downtimegrace/types"
 	"github.com/cosmos/gaia/v9/x/globalfee"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	gaiagov "github.com/cosmos/gaia/v9/x/gov"
+	"github.com/cosmos/gaia/v9/x/govschedule"
+	govscheduleclient "github.com/cosmos/gaia/v9/x/govschedule/client"
+	"github.com/cosmos/gaia/v9/x/grantspool"
+	grantspoolclient "github.com/cosmos/gaia/v9/x/grantspool/client"
+	grantspooltypes "github.com/cosmos/gaia/v9/x/grantspool/types"
+	gaiamint "github.com/cosmos/gaia/v9/x/mint"
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+	"github.com/cosmos/gaia/v9/x/query"
+	"github.com/cosmos/gaia/v9/x/recurringspend"
+	recurringspendclient "github.com/cosmos/gaia/v9/x/recurringspend/client"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
+	"github.com/cosmos/gaia/v9/x/sanction"
+	sanctionclient "github.com/cosmos/gaia/v9/x/sanction/client"
+	"github.com/cosmos/gaia/v9/x/spendlimit"
+	gaiastaking "github.com/cosmos/gaia/v9/x/staking"
+	gaiavesting "github.com/cosmos/gaia/v9/x/vesting"
 )
 
 var maccPerms = map[string][]string{
 	authtypes.FeeCollectorName:     nil,
 	distrtypes.ModuleName:          nil,
 	icatypes.ModuleName:            nil,
+	ibcfeetypes.ModuleName:         nil,
 	minttypes.ModuleName:           {authtypes.Minter},
 	stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 	govtypes.ModuleName:            {authtypes.Burner},
 	liquiditytypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
 	ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+	denommigration.ModuleName:      {authtypes.Minter, authtypes.Burner},
+	grantspool.ModuleName:          nil,
 }
 
 // ModuleBasics defines the module BasicManager is in charge of setting up basic,
@@ -90,6 +125,13 @@ var ModuleBasics = module.NewBasicManager(
 		ibcproviderclient.ConsumerAdditionProposalHandler,
 		ibcproviderclient.ConsumerRemovalProposalHandler,
 		ibcproviderclient.EquivocationProposalHandler,
+		recurringspendclient.RecurringSpendProposalHandler,
+		recurringspendclient.CancelRecurringSpendProposalHandler,
+		sanctionclient.AddSanctionedAddressesProposalHandler,
+		sanctionclient.RemoveSanctionedAddressesProposalHandler,
+		denommigrationclient.MigrateDenomProposalHandler,
+		grantspoolclient.GrantsPoolSpendProposalHandler,
+		govscheduleclient.ScheduledProposalHandler,
 	),
 	params.AppModuleBasic{},
 	crisis.AppModuleBasic{},
@@ -104,7 +146,18 @@ var ModuleBasics = module.NewBasicManager(
 	liquidity.AppModuleBasic{},
 	router.AppModuleBasic{},
 	ica.AppModuleBasic{},
+	ibcfee.AppModuleBasic{},
 	globalfee.AppModule{},
+	policy.AppModuleBasic{},
+	query.AppModuleBasic{},
+	recurringspend.AppModuleBasic{},
+	sanction.AppModuleBasic{},
+	govschedule.AppModuleBasic{},
+	autocompound.AppModuleBasic{},
+	spendlimit.AppModuleBasic{},
+	denommigration.AppModuleBasic{},
+	downtimegrace.AppModuleBasic{},
+	grantspool.AppModuleBasic{},
 	ibcprovider.AppModuleBasic{},
 )
 
@@ -123,15 +176,15 @@ func appModules(
 			encodingConfig.TxConfig,
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
-		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		gaiavesting.NewAppModule(app.AccountKeeper, app.BankKeeper, app.SanctionKeeper),
+		gaiabank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.SanctionKeeper, app.GetSubspace(policy.ModuleName)),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
-		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
-		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
+		gaiagov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper, app.RecurringSpendKeeper, app.GetSubspace(policy.ModuleName)),
+		gaiamint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
+		gaiadistr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.SanctionKeeper),
+		gaiastaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(policy.ModuleName), app.GetTKey(gaiastaking.TStoreKey)),
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
@@ -139,9 +192,41 @@ func appModules(
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		liquidity.NewAppModule(appCodec, app.LiquidityKeeper, app.AccountKeeper, app.BankKeeper, app.DistrKeeper),
-		globalfee.NewAppModule(app.GetSubspace(globalfee.ModuleName)),
+		globalfee.NewAppModule(app.GetSubspace(globalfee.ModuleName), app.FeeRejectionIndex, app.GasPriceIndex, &app.DynamicFeeKeeper, app.MinGasPriceTimelineIndex, app.BypassRateIndex),
+		policy.NewAppModule(app.GetSubspace(policy.ModuleName)),
+		query.NewAppModule(query.QuerierOptions{
+			StakingKeeper:  app.StakingKeeper,
+			BankKeeper:     app.BankKeeper,
+			MintKeeper:     app.MintKeeper,
+			DistrKeeper:    app.DistrKeeper,
+			ClientKeeper:   app.IBCKeeper.ClientKeeper,
+			GovKeeper:      app.GovKeeper,
+			FeeKeeper:      app.IBCFeeKeeper,
+			GlobalFee:      globalfee.NewGrpcQuerier(app.GetSubspace(globalfee.ModuleName), app.FeeRejectionIndex, app.GasPriceIndex, &app.DynamicFeeKeeper, app.MinGasPriceTimelineIndex, app.BypassRateIndex),
+			Policy:         policy.NewGrpcQuerier(app.GetSubspace(policy.ModuleName)),
+			RecurringSpend: app.RecurringSpendKeeper,
+			DowntimeGrace:  app.DowntimeGraceKeeper,
+			GrantsPool:     app.GrantsPoolKeeper,
+			AutoCompound:   app.AutoCompoundKeeper,
+			Rewards:        app.RewardIndex,
+			DefaultParams:  app.DefaultParamSets(),
+			Relays:         app.RelayIndex,
+			TransferKeeper: app.TransferKeeper,
+			Transfers:      app.TransferIndex,
+			ParamsKeeper:   app.ParamsKeeper,
+			FeesPaid:       app.FeesPaidIndex,
+		}),
+		recurringspend.NewAppModule(app.RecurringSpendKeeper),
+		sanction.NewAppModule(app.SanctionKeeper),
+		govschedule.NewAppModule(app.GovScheduleKeeper),
+		autocompound.NewAppModule(app.AutoCompoundKeeper),
+		spendlimit.NewAppModule(app.SpendLimitKeeper),
+		denommigration.NewAppModule(),
+		downtimegrace.NewAppModule(app.DowntimeGraceKeeper),
+		grantspool.NewAppModule(app.GrantsPoolKeeper),
 		app.TransferModule,
 		app.ICAModule,
+		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		app.RouterModule,
 		app.ProviderModule,
 	}
@@ -193,6 +278,9 @@ func orderBeginBlockers() []string {
 		// upgrades should be run first
 		upgradetypes.ModuleName,
 		capabilitytypes.ModuleName,
+		// the fee share is sent to the grants pool before the minted coins
+		// are added to the fees and before the fees are distributed
+		grantspool.ModuleName,
 		minttypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
@@ -206,6 +294,7 @@ func orderBeginBlockers() []string {
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
 		routertypes.ModuleName,
 		genutiltypes.ModuleName,
 		authz.ModuleName,
@@ -213,6 +302,15 @@ func orderBeginBlockers() []string {
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		globalfee.ModuleName,
+		policy.ModuleName,
+		query.ModuleName,
+		recurringspend.ModuleName,
+		sanction.ModuleName,
+		govschedule.ModuleName,
+		autocompound.ModuleName,
+		spendlimit.ModuleName,
+		denommigration.ModuleName,
+		downtimegrace.ModuleName,
 		providertypes.ModuleName,
 	}
 }
@@ -234,6 +332,7 @@ func orderEndBlockers() []string {
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
 		routertypes.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
@@ -249,6 +348,16 @@ func orderEndBlockers() []string {
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		globalfee.ModuleName,
+		policy.ModuleName,
+		query.ModuleName,
+		recurringspend.ModuleName,
+		sanction.ModuleName,
+		govschedule.ModuleName,
+		autocompound.ModuleName,
+		spendlimit.ModuleName,
+		denommigration.ModuleName,
+		downtimegrace.ModuleName,
+		grantspool.ModuleName,
 		providertypes.ModuleName,
 	}
 }
@@ -276,6 +385,7 @@ func orderInitBlockers() []string {
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
 		evidencetypes.ModuleName,
 		liquiditytypes.ModuleName,
 		authz.ModuleName,
@@ -285,6 +395,85 @@ func orderInitBlockers() []string {
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		globalfee.ModuleName,
+		policy.ModuleName,
+		query.ModuleName,
+		recurringspend.ModuleName,
+		sanction.ModuleName,
+		govschedule.ModuleName,
+		autocompound.ModuleName,
+		spendlimit.ModuleName,
+		denommigration.ModuleName,
+		downtimegrace.ModuleName,
+		grantspool.ModuleName,
 		providertypes.ModuleName,
 	}
 }
+
+// DefaultParamSets returns the params subspaces of the modules with the
+// default params of the modules, the modules without a params type being
+// listed with the pairs of their default params.
+func (app *GaiaApp) DefaultParamSets() []query.DefaultParamSet {
+	var (
+		authParams           = authtypes.DefaultParams()
+		bankParams           = banktypes.DefaultParams()
+		stakingParams        = stakingtypes.DefaultParams()
+		mintParams           = minttypes.DefaultParams()
+		distrParams          = distrtypes.DefaultParams()
+		slashingParams       = slashingtypes.DefaultParams()
+		govDepositParams     = govtypes.DefaultDepositParams()
+		govVotingParams      = govtypes.DefaultVotingParams()
+		govTallyParams       = govtypes.DefaultTallyParams()
+		crisisConstantFee    = crisistypes.DefaultGenesisState().ConstantFee
+		liquidityParams      = liquiditytypes.DefaultParams()
+		transferParams       = ibctransfertypes.DefaultParams()
+		clientParams         = ibcclienttypes.DefaultParams()
+		connectionParams     = ibcconnectiontypes.DefaultParams()
+		routerParams         = routertypes.DefaultParams()
+		icaHostParams        = icahosttypes.DefaultParams()
+		globalFeeParams      = globalfeetypes.DefaultParams()
+		policyParams         = policytypes.DefaultParams()
+		recurringSpendParams = recurringspendtypes.DefaultParams()
+		downtimeGraceParams  = downtimegracetypes.DefaultParams()
+		grantsPoolParams     = grantspooltypes.DefaultParams()
+		autoCompoundParams   = autocompoundtypes.DefaultParams()
+		providerParams       = providertypes.DefaultParams()
+	)
+
+	return []query.DefaultParamSet{
+		{Subspace: app.GetSubspace(authtypes.ModuleName), Defaults: &authParams},
+		{Subspace: app.GetSubspace(banktypes.ModuleName), Defaults: &bankParams},
+		{Subspace: app.GetSubspace(stakingtypes.ModuleName), Defaults: &stakingParams},
+		{Subspace: app.GetSubspace(minttypes.ModuleName), Defaults: &mintParams},
+		{Subspace: app.GetSubspace(distrtypes.ModuleName), Defaults: &distrParams},
+		{Subspace: app.GetSubspace(slashingtypes.ModuleName), Defaults: &slashingParams},
+		{Subspace: app.GetSubspace(govtypes.ModuleName), Defaults: paramSetPairs{
+			paramstypes.NewParamSetPair(govtypes.ParamStoreKeyDepositParams, &govDepositParams, nil),
+			paramstypes.NewParamSetPair(govtypes.ParamStoreKeyVotingParams, &govVotingParams, nil),
+			paramstypes.NewParamSetPair(govtypes.ParamStoreKeyTallyParams, &govTallyParams, nil),
+		}},
+		{Subspace: app.GetSubspace(crisistypes.ModuleName), Defaults: paramSetPairs{
+			paramstypes.NewParamSetPair(crisistypes.ParamStoreKeyConstantFee, &crisisConstantFee, nil),
+		}},
+		{Subspace: app.GetSubspace(liquiditytypes.ModuleName), Defaults: &liquidityParams},
+		{Subspace: app.GetSubspace(ibctransfertypes.ModuleName), Defaults: &transferParams},
+		{Subspace: app.GetSubspace(ibchost.ModuleName), Defaults: &clientParams},
+		{Subspace: app.GetSubspace(ibchost.ModuleName), Defaults: &connectionParams},
+		{Subspace: app.GetSubspace(routertypes.ModuleName), Defaults: &routerParams},
+		{Subspace: app.GetSubspace(icahosttypes.SubModuleName), Defaults: &icaHostParams},
+		{Subspace: app.GetSubspace(globalfee.ModuleName), Defaults: &globalFeeParams},
+		{Subspace: app.GetSubspace(policy.ModuleName), Defaults: &policyParams},
+		{Subspace: app.GetSubspace(recurringspendtypes.ModuleName), Defaults: &recurringSpendParams},
+		{Subspace: app.GetSubspace(downtimegracetypes.ModuleName), Defaults: &downtimeGraceParams},
+		{Subspace: app.GetSubspace(grantspooltypes.ModuleName), Defaults: &grantsPoolParams},
+		{Subspace: app.GetSubspace(autocompoundtypes.ModuleName), Defaults: &autoCompoundParams},
+		{Subspace: app.GetSubspace(providertypes.ModuleName), Defaults: &providerParams},
+	}
+}
+
+// paramSetPairs is the ParamSet of the params of a module without a params
+// type.
+type paramSetPairs paramstypes.ParamSetPairs
+
+func (p paramSetPairs) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs(p)
+}
@@ -12,6 +12,26 @@ var (
 	//nolint: gosec
 	BypassMinFeeMsgTypesKey = "bypass-min-fee-msg-types"
 
+	// FeePayerAllowlistFileKey defines the configuration key for the
+	// FeePayerAllowlistFile value.
+	FeePayerAllowlistFileKey = "fee-payer-allowlist-file"
+
+	// AnteProfilingKey defines the configuration key for the AnteProfiling
+	// value.
+	AnteProfilingKey = "ante-profiling"
+
+	// MempoolMaxTxsKey defines the configuration key for the MempoolMaxTxs
+	// value.
+	MempoolMaxTxsKey = "mempool-max-txs"
+
+	// MempoolEvictionPolicyKey defines the configuration key for the
+	// MempoolEvictionPolicy value.
+	MempoolEvictionPolicyKey = "mempool-eviction-policy"
+
+	// QueryIndexesKey defines the configuration key for the QueryIndexes
+	// value.
+	QueryIndexesKey = "query-indexes"
+
 	// customGaiaConfigTemplate defines Gaia's custom application configuration TOML template.
 	customGaiaConfigTemplate = `
 ###############################################################################
@@ -28,6 +48,39 @@ var (
 # Example:
 # bypass-min-fee-msg-types = ["/ibc.core.channel.v1.MsgRecvPacket", "/ibc.core.channel.v1.MsgAcknowledgement", "/ibc.core.client.v1.MsgUpdateClient"]
 bypass-min-fee-msg-types = [{{ range .BypassMinFeeMsgTypes }}{{ printf "%q, " . }}{{end}}]
+
+# fee-payer-allowlist-file defines the path to a file listing the account addresses,
+# one per line, allowed to pay the fees of the txs accepted during CheckTx.
+# Leave empty to allow any fee payer.
+fee-payer-allowlist-file = "{{ .FeePayerAllowlistFile }}"
+
+# ante-profiling enables the recording of the time spent and the gas consumed by
+# each ante decorator for the most recent txs, reported by the ante profile query.
+# It adds a small overhead to every tx, so it is disabled by default.
+ante-profiling = {{ .AnteProfiling }}
+
+# mempool-max-txs defines the maximum number of txs accepted by this node and pending
+# in its mempool. 0 leaves the mempool to the size set in config.toml.
+mempool-max-txs = {{ .MempoolMaxTxs }}
+
+# mempool-eviction-policy defines which tx gives way once mempool-max-txs txs are pending:
+# "lowest-fee" evicts the pending tx with the lowest gas price when a tx paying a higher
+# gas price comes in, "none" rejects the incoming txs. The evicted txs are dropped when
+# rechecked after the next block, so the recheck option of config.toml must be set.
+mempool-eviction-policy = "{{ .MempoolEvictionPolicy }}"
+
+# query-indexes defines the indexes this node keeps in memory of the blocks it delivers,
+# each serving a query: "rewards" the reward history, "relays" the validator relay activity,
+# "transfers" the denom channel history, "fees-paid" the fees paid by an address,
+# "fee-rejections" the fee rejection stats, "gas-prices" the observed and time weighted
+# average gas prices, "min-gas-price-timeline" the min gas price timeline and "bypass-rate"
+# the bypass rate of the txs. The indexes are node local: they are not part of the consensus
+# state and start again empty when the node restarts. They use memory and add work to every
+# block, so they are disabled by default and the queries of a disabled index fail.
+#
+# Example:
+# query-indexes = ["rewards", "relays", "transfers", "fees-paid", "fee-rejections", "gas-prices", "min-gas-price-timeline", "bypass-rate"]
+query-indexes = [{{ range .QueryIndexes }}{{ printf "%q, " . }}{{end}}]
 `
 )
 
@@ -52,4 +105,28 @@ type CustomAppConfig struct {
 	// bypass-min-fee-msg-types = [<some_msg_type>] will allow messages of specified type to bypass the minimum fee check
 	// omitting bypass-min-fee-msg-types from the config file will use the default values: ["/ibc.core.channel.v1.MsgRecvPacket", "/ibc.core.channel.v1.MsgAcknowledgement", "/ibc.core.client.v1.MsgUpdateClient"]
 	BypassMinFeeMsgTypes []string `mapstructure:"bypass-min-fee-msg-types"`
+
+	// FeePayerAllowlistFile defines the path to a file listing the account
+	// addresses allowed to pay the fees of the txs accepted during CheckTx.
+	// An empty path allows any fee payer.
+	FeePayerAllowlistFile string `mapstructure:"fee-payer-allowlist-file"`
+
+	// AnteProfiling enables the recording of the overhead of each ante
+	// decorator for the most recent txs.
+	AnteProfiling bool `mapstructure:"ante-profiling"`
+
+	// MempoolMaxTxs defines the maximum number of txs accepted by this node
+	// and pending in its mempool, 0 for no maximum.
+	MempoolMaxTxs int `mapstructure:"mempool-max-txs"`
+
+	// MempoolEvictionPolicy defines which tx gives way once MempoolMaxTxs txs
+	// are pending: "lowest-fee" or "none".
+	MempoolEvictionPolicy string `mapstructure:"mempool-eviction-policy"`
+
+	// QueryIndexes defines the indexes this node keeps in memory of the
+	// blocks it delivers: "rewards", "relays", "transfers", "fees-paid",
+	// "fee-rejections", "gas-prices", "min-gas-price-timeline" and
+	// "bypass-rate". The indexes are node local: they are not part of the
+	// consensus state and start again empty when the node restarts.
+	QueryIndexes []string `mapstructure:"query-indexes"`
 }
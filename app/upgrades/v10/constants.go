@@ -0,0 +1,35 @@
+package v10
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+
+	"github.com/cosmos/gaia/v9/app/upgrades"
+	autocompoundtypes "github.com/cosmos/gaia/v9/x/autocompound/types"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	govscheduletypes "github.com/cosmos/gaia/v9/x/govschedule/types"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
+	sanctiontypes "github.com/cosmos/gaia/v9/x/sanction/types"
+	spendlimittypes "github.com/cosmos/gaia/v9/x/spendlimit/types"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name.
+	UpgradeName = "v10"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{
+			recurringspendtypes.StoreKey,
+			sanctiontypes.StoreKey,
+			ibcfeetypes.StoreKey,
+			govscheduletypes.StoreKey,
+			autocompoundtypes.StoreKey,
+			spendlimittypes.StoreKey,
+			globalfeetypes.StoreKey,
+		},
+	},
+}
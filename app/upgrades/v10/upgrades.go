@@ -0,0 +1,76 @@
+package v10
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/cosmos/gaia/v9/app/keepers"
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		// the policy params are set below as the checks apply them while
+		// unset, rather than to their defaults by the module InitGenesis
+		vm[policy.ModuleName] = policy.AppModule{}.ConsensusVersion()
+
+		ctx.Logger().Info("Starting module migrations...")
+
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		ctx.Logger().Info("Setting the new globalfee params...")
+		SetMissingGlobalFeeParams(ctx, keepers.GetSubspace(globalfee.ModuleName))
+
+		ctx.Logger().Info("Setting the policy params...")
+		SetMissingPolicyParams(ctx, keepers.GetSubspace(policy.ModuleName))
+
+		ctx.Logger().Info("Upgrade complete")
+		return vm, err
+	}
+}
+
+// SetMissingGlobalFeeParams sets the globalfee params added in v10 which are
+// not set yet, so that the whole param set can be read and exported. They are
+// set to their defaults, which disable most of them, so the behavior of the
+// chain is unchanged.
+func SetMissingGlobalFeeParams(ctx sdk.Context, subspace paramstypes.Subspace) {
+	if !subspace.HasKeyTable() {
+		subspace = subspace.WithKeyTable(globalfeetypes.ParamKeyTable())
+	}
+
+	params := globalfeetypes.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !subspace.Has(ctx, pair.Key) {
+			subspace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// SetMissingPolicyParams sets the policy params which are not set yet, so that
+// the whole param set can be read and exported. They are set to the values the
+// checks apply while the params are unset, which disables most of them, so the
+// behavior of the chain is unchanged.
+func SetMissingPolicyParams(ctx sdk.Context, subspace paramstypes.Subspace) {
+	if !subspace.HasKeyTable() {
+		subspace = subspace.WithKeyTable(policytypes.ParamKeyTable())
+	}
+
+	params := policytypes.UnsetParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !subspace.Has(ctx, pair.Key) {
+			subspace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
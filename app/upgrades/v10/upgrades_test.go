@@ -0,0 +1,80 @@
+package v10_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	v10 "github.com/cosmos/gaia/v9/app/upgrades/v10"
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+func TestUpgradeSetsGlobalFeeParams(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	// only the min gas prices are set before v10
+	subspace := app.GetSubspace(globalfee.ModuleName)
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(globalfee.ModuleName+"/"))
+	var params globalfeetypes.Params
+	for _, pair := range params.ParamSetPairs() {
+		if string(pair.Key) != string(globalfeetypes.ParamStoreKeyMinGasPrices) {
+			store.Delete(pair.Key)
+		}
+	}
+	require.False(t, subspace.Has(ctx, globalfeetypes.ParamStoreKeyMinFlatFee))
+
+	// the policy module is added in v10
+	policyStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(policy.ModuleName+"/"))
+	var policyParams policytypes.Params
+	for _, pair := range policyParams.ParamSetPairs() {
+		policyStore.Delete(pair.Key)
+	}
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: v10.UpgradeName, Height: header.Height})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// the checks apply the same values as while the params were unset
+	ctx = app.BaseApp.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	subspace.GetParamSet(ctx, &params)
+	require.True(t, params.MinFlatFee.Empty())
+	require.True(t, params.DynamicFeeSensitivity.IsZero())
+
+	// the policy params are set as the checks apply them while unset rather
+	// than to their defaults
+	app.GetSubspace(policy.ModuleName).GetParamSet(ctx, &policyParams)
+	require.Equal(t, policytypes.DefaultMaxActiveProposals, policyParams.MaxActiveProposals)
+	require.Zero(t, policyParams.MaxValidatorCreationsPerBlock)
+	require.True(t, policyParams.SupplyCaps.Empty())
+	require.Equal(t, policytypes.DefaultMaxSignaturesPerTx, policyParams.MaxSignaturesPerTx)
+	require.Zero(t, policyParams.MaxTxBytes)
+
+	_, err := app.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+}
+
+func TestSetMissingPolicyParamsKeepsSetParams(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	subspace := app.GetSubspace(policy.ModuleName)
+
+	subspace.Set(ctx, policytypes.ParamStoreKeyMaxTxBytes, uint64(1000))
+	v10.SetMissingPolicyParams(ctx, subspace)
+
+	var maxTxBytes uint64
+	subspace.Get(ctx, policytypes.ParamStoreKeyMaxTxBytes, &maxTxBytes)
+	require.Equal(t, uint64(1000), maxTxBytes)
+	require.True(t, subspace.Has(ctx, policytypes.ParamStoreKeyMaxPacketDataBytes))
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+)
+
+// ActiveSetThreshold is the bonding threshold of the active validator set.
+type ActiveSetThreshold struct {
+	// Power is the consensus power of the lowest-ranked active validator.
+	Power int64 `json:"power" yaml:"power"`
+	// Tokens are the bonded tokens of the lowest-ranked active validator.
+	Tokens sdk.Int `json:"tokens" yaml:"tokens"`
+	// Validator is the operator address of the lowest-ranked active validator.
+	Validator string `json:"validator" yaml:"validator"`
+	// ActiveValidators is the number of validators in the active set.
+	ActiveValidators uint32 `json:"active_validators" yaml:"active_validators"`
+	// MaxValidators is the maximum size of the active set.
+	MaxValidators uint32 `json:"max_validators" yaml:"max_validators"`
+}
+
+// GetActiveSetThresholdCmd returns the active-set-threshold cobra Command.
+func GetActiveSetThresholdCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "active-set-threshold",
+		Short: "Query the power of the lowest-ranked validator of the active set",
+		Long: `Query the power of the lowest-ranked validator of the active set.
+
+The bonded validators are ranked by power and the lowest one within the
+max_validators staking param is returned. While the active set is full, a
+validator needs more power than this one to enter or remain in the active set.
+While the active set is not full, any bonded validator is active.
+
+Example:
+	gaiad query staking active-set-threshold
+	`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			stakingClient := stakingtypes.NewQueryClient(clientCtx)
+
+			paramsRes, err := stakingClient.Params(ctx, &stakingtypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			var validators []stakingtypes.Validator
+			pageReq := &query.PageRequest{}
+			for {
+				res, err := stakingClient.Validators(ctx, &stakingtypes.QueryValidatorsRequest{
+					Status:     stakingtypes.BondStatusBonded,
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+				validators = append(validators, res.Validators...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			threshold, err := ComputeActiveSetThreshold(validators, paramsRes.Params.MaxValidators, sdk.DefaultPowerReduction)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(threshold)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ComputeActiveSetThreshold ranks the bonded validators by power, as the
+// staking module does, and returns the power of the lowest-ranked validator
+// within the first maxValidators.
+func ComputeActiveSetThreshold(validators []stakingtypes.Validator, maxValidators uint32, powerReduction sdk.Int) (ActiveSetThreshold, error) {
+	bonded := make([]stakingtypes.Validator, 0, len(validators))
+	for _, val := range validators {
+		if val.IsBonded() && val.GetConsensusPower(powerReduction) > 0 {
+			bonded = append(bonded, val)
+		}
+	}
+	if len(bonded) == 0 || maxValidators == 0 {
+		return ActiveSetThreshold{}, fmt.Errorf("no active validator")
+	}
+
+	sort.SliceStable(bonded, func(i, j int) bool {
+		return bonded[i].Tokens.GT(bonded[j].Tokens)
+	})
+	if uint32(len(bonded)) > maxValidators {
+		bonded = bonded[:maxValidators]
+	}
+
+	lowest := bonded[len(bonded)-1]
+	return ActiveSetThreshold{
+		Power:            lowest.GetConsensusPower(powerReduction),
+		Tokens:           lowest.Tokens,
+		Validator:        lowest.OperatorAddress,
+		ActiveValidators: uint32(len(bonded)),
+		MaxValidators:    maxValidators,
+	}, nil
+}
+
+// addStakingQueryCommands injects custom staking query commands into the
+// staking query command of another command.
+func addStakingQueryCommands(cmd *cobra.Command) *cobra.Command {
+	for _, c := range cmd.Commands() {
+		if c.Name() == stakingtypes.ModuleName {
+			c.AddCommand(GetActiveSetThresholdCmd())
+		}
+	}
+	return cmd
+}
@@ -0,0 +1,89 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/cmd/gaiad/cmd"
+)
+
+func TestComputeActiveSetThreshold(t *testing.T) {
+	newValidator := func(power int64, status stakingtypes.BondStatus) stakingtypes.Validator {
+		pk := ed25519.GenPrivKey().PubKey()
+		val, err := stakingtypes.NewValidator(sdk.ValAddress(pk.Address()), pk, stakingtypes.Description{})
+		require.NoError(t, err)
+		val.Status = status
+		val.Tokens = sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+		val.DelegatorShares = val.Tokens.ToDec()
+		return val
+	}
+
+	// fabricated validator set, unordered, with an unbonded validator holding
+	// more power than the active ones
+	validators := []stakingtypes.Validator{
+		newValidator(30, stakingtypes.Bonded),
+		newValidator(100, stakingtypes.Bonded),
+		newValidator(10, stakingtypes.Bonded),
+		newValidator(500, stakingtypes.Unbonded),
+		newValidator(50, stakingtypes.Bonded),
+	}
+
+	testCases := []struct {
+		name          string
+		maxValidators uint32
+		power         int64
+		validator     string
+		active        uint32
+		expErr        bool
+	}{
+		{
+			name:          "full active set",
+			maxValidators: 4,
+			power:         10,
+			validator:     validators[2].OperatorAddress,
+			active:        4,
+		},
+		{
+			name:          "active set smaller than the bonded validators",
+			maxValidators: 2,
+			power:         50,
+			validator:     validators[4].OperatorAddress,
+			active:        2,
+		},
+		{
+			name:          "active set not full",
+			maxValidators: 10,
+			power:         10,
+			validator:     validators[2].OperatorAddress,
+			active:        4,
+		},
+		{
+			name:          "no active validator",
+			maxValidators: 0,
+			expErr:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			threshold, err := cmd.ComputeActiveSetThreshold(validators, tc.maxValidators, sdk.DefaultPowerReduction)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.power, threshold.Power)
+			require.Equal(t, sdk.TokensFromConsensusPower(tc.power, sdk.DefaultPowerReduction), threshold.Tokens)
+			require.Equal(t, tc.validator, threshold.Validator)
+			require.Equal(t, tc.active, threshold.ActiveValidators)
+			require.Equal(t, tc.maxValidators, threshold.MaxValidators)
+		})
+	}
+
+	_, err := cmd.ComputeActiveSetThreshold(validators[3:4], 10, sdk.DefaultPowerReduction)
+	require.Error(t, err, "an unbonded validator is not active")
+}
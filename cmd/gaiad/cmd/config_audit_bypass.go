@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+const (
+	flagAuditNodes   = "nodes"
+	flagAuditTimeout = "timeout"
+)
+
+// BypassFetcher returns the bypass min fee msg types of a node.
+type BypassFetcher func(ctx context.Context, node string) (*globalfeetypes.QueryBypassMinFeeMsgTypesResponse, error)
+
+// NodeBypassReport holds the bypass min fee msg types of a node compared to
+// the reference msg types of the audit.
+type NodeBypassReport struct {
+	Node string `json:"node" yaml:"node"`
+	// Hash is the hash of the msg types of the node.
+	Hash string `json:"hash,omitempty" yaml:"hash,omitempty"`
+	// Missing are the reference msg types the node does not bypass.
+	Missing []string `json:"missing,omitempty" yaml:"missing,omitempty"`
+	// Extra are the msg types the node bypasses beyond the reference.
+	Extra []string `json:"extra,omitempty" yaml:"extra,omitempty"`
+	// Duplicates are the msg types listed more than once in the config of
+	// the node.
+	Duplicates []string `json:"duplicates,omitempty" yaml:"duplicates,omitempty"`
+	// Error is why the node could not be queried.
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// BypassAuditReport holds the bypass min fee msg types of a set of nodes. The
+// reference msg types are the ones of the most nodes, the nodes with other msg
+// types diverge from the reference.
+type BypassAuditReport struct {
+	ReferenceHash     string             `json:"reference_hash" yaml:"reference_hash"`
+	ReferenceMsgTypes []string           `json:"reference_msg_types" yaml:"reference_msg_types"`
+	Nodes             []NodeBypassReport `json:"nodes" yaml:"nodes"`
+}
+
+// Divergent returns the reports of the reachable nodes whose msg types differ
+// from the reference.
+func (r BypassAuditReport) Divergent() []NodeBypassReport {
+	var divergent []NodeBypassReport
+	for _, node := range r.Nodes {
+		if node.Error == "" && node.Hash != r.ReferenceHash {
+			divergent = append(divergent, node)
+		}
+	}
+	return divergent
+}
+
+// Unreachable returns the reports of the nodes which could not be queried.
+func (r BypassAuditReport) Unreachable() []NodeBypassReport {
+	var unreachable []NodeBypassReport
+	for _, node := range r.Nodes {
+		if node.Error != "" {
+			unreachable = append(unreachable, node)
+		}
+	}
+	return unreachable
+}
+
+// GetAuditBypassCmd returns the audit-bypass cobra Command.
+func GetAuditBypassCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit-bypass",
+		Short: "Compare the bypass-min-fee-msg-types of a set of nodes",
+		Long: `Compare the bypass-min-fee-msg-types of a set of nodes.
+
+The bypass min fee msg types of each node are queried through its Tendermint
+RPC and compared by hash. The msg types of the most nodes are the reference,
+and the following issues are reported:
+- the nodes whose msg types diverge from the reference, with the missing and
+  extra msg types
+- the msg types listed more than once in the config of a node
+- the nodes which could not be queried
+
+The command fails if any reachable node diverges from the reference.
+
+Example:
+	gaiad config audit-bypass --nodes tcp://node1:26657,tcp://node2:26657
+	`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			nodes, err := cmd.Flags().GetStringSlice(flagAuditNodes)
+			if err != nil {
+				return err
+			}
+			if len(nodes) == 0 {
+				return fmt.Errorf("no node given with --%s", flagAuditNodes)
+			}
+			timeout, err := cmd.Flags().GetDuration(flagAuditTimeout)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			report := AuditBypassMinFeeMsgTypes(cmd.Context(), nodes, newRPCBypassFetcher(clientCtx, timeout))
+
+			cmd.Printf("reference %s: %s\n", report.ReferenceHash, strings.Join(report.ReferenceMsgTypes, ", "))
+			for _, node := range report.Nodes {
+				switch {
+				case node.Error != "":
+					cmd.Printf("UNREACHABLE %s: %s\n", node.Node, node.Error)
+				case node.Hash != report.ReferenceHash:
+					cmd.Printf("DIVERGENT %s %s\n", node.Node, node.Hash)
+					for _, msgType := range node.Missing {
+						cmd.Printf("  - %s\n", msgType)
+					}
+					for _, msgType := range node.Extra {
+						cmd.Printf("  + %s\n", msgType)
+					}
+				default:
+					cmd.Printf("OK %s\n", node.Node)
+				}
+				for _, msgType := range node.Duplicates {
+					cmd.Printf("  duplicate %s\n", msgType)
+				}
+			}
+
+			if divergent := report.Divergent(); len(divergent) > 0 {
+				return fmt.Errorf("%d of %d node(s) diverge from the reference", len(divergent), len(nodes))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSlice(flagAuditNodes, nil, "The comma separated Tendermint RPC addresses of the nodes to audit")
+	cmd.Flags().Duration(flagAuditTimeout, 10*time.Second, "The timeout of the query of each node")
+
+	return cmd
+}
+
+// newRPCBypassFetcher returns a BypassFetcher querying the nodes through their
+// Tendermint RPC, each within the given timeout.
+func newRPCBypassFetcher(clientCtx client.Context, timeout time.Duration) BypassFetcher {
+	return func(ctx context.Context, node string) (*globalfeetypes.QueryBypassMinFeeMsgTypesResponse, error) {
+		rpcClient, err := rpchttp.NewWithTimeout(node, "/websocket", uint(timeout.Seconds()))
+		if err != nil {
+			return nil, err
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		nodeCtx := clientCtx.WithClient(rpcClient).WithNodeURI(node)
+		return globalfeetypes.NewNodeConfigClient(nodeCtx).BypassMinFeeMsgTypes(ctx, &globalfeetypes.QueryBypassMinFeeMsgTypesRequest{})
+	}
+}
+
+// AuditBypassMinFeeMsgTypes fetches the bypass min fee msg types of the nodes
+// and compares them to the msg types of the most nodes. On a tie, the msg
+// types of the first node win. The nodes which can't be fetched are reported
+// with their error.
+func AuditBypassMinFeeMsgTypes(ctx context.Context, nodes []string, fetch BypassFetcher) BypassAuditReport {
+	var report BypassAuditReport
+	msgTypesByHash := make(map[string][]string)
+	nodesByHash := make(map[string]int)
+	var hashes []string
+
+	for _, node := range nodes {
+		res, err := fetch(ctx, node)
+		if err != nil {
+			report.Nodes = append(report.Nodes, NodeBypassReport{Node: node, Error: err.Error()})
+			continue
+		}
+
+		msgTypes := globalfee.SortedMsgTypes(res.MsgTypes)
+		// the hash is recomputed from the msg types, so that the report
+		// doesn't rely on the hash served by the node
+		hash := globalfee.BypassMinFeeMsgTypesHash(msgTypes)
+		if _, ok := msgTypesByHash[hash]; !ok {
+			msgTypesByHash[hash] = msgTypes
+			hashes = append(hashes, hash)
+		}
+		nodesByHash[hash]++
+		report.Nodes = append(report.Nodes, NodeBypassReport{
+			Node:       node,
+			Hash:       hash,
+			Duplicates: duplicateMsgTypes(res.MsgTypes),
+		})
+	}
+
+	for _, hash := range hashes {
+		if nodesByHash[hash] > nodesByHash[report.ReferenceHash] {
+			report.ReferenceHash = hash
+		}
+	}
+	report.ReferenceMsgTypes = msgTypesByHash[report.ReferenceHash]
+
+	for i, node := range report.Nodes {
+		if node.Error != "" || node.Hash == report.ReferenceHash {
+			continue
+		}
+		report.Nodes[i].Missing = subtractMsgTypes(report.ReferenceMsgTypes, msgTypesByHash[node.Hash])
+		report.Nodes[i].Extra = subtractMsgTypes(msgTypesByHash[node.Hash], report.ReferenceMsgTypes)
+	}
+
+	return report
+}
+
+// subtractMsgTypes returns the msg types of a which are not in b.
+func subtractMsgTypes(a, b []string) []string {
+	inB := make(map[string]bool, len(b))
+	for _, msgType := range b {
+		inB[msgType] = true
+	}
+	var diff []string
+	for _, msgType := range a {
+		if !inB[msgType] {
+			diff = append(diff, msgType)
+		}
+	}
+	return diff
+}
+
+// duplicateMsgTypes returns the msg types listed more than once, in the order
+// of their first duplicate.
+func duplicateMsgTypes(msgTypes []string) []string {
+	count := make(map[string]int, len(msgTypes))
+	var duplicates []string
+	for _, msgType := range msgTypes {
+		count[msgType]++
+		if count[msgType] == 2 {
+			duplicates = append(duplicates, msgType)
+		}
+	}
+	return duplicates
+}
@@ -0,0 +1,77 @@
+package cmd_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/cmd/gaiad/cmd"
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+func TestAuditBypassMinFeeMsgTypes(t *testing.T) {
+	const (
+		recvPacket = "/ibc.core.channel.v1.MsgRecvPacket"
+		ack        = "/ibc.core.channel.v1.MsgAcknowledgement"
+		timeout    = "/ibc.core.channel.v1.MsgTimeout"
+		send       = "/cosmos.bank.v1beta1.MsgSend"
+	)
+
+	// mocked nodes, the odd node out bypasses MsgSend instead of MsgTimeout
+	// and lists MsgRecvPacket twice
+	configs := map[string][]string{
+		"tcp://node0:26657": {recvPacket, ack, timeout},
+		"tcp://node1:26657": {timeout, recvPacket, ack},
+		"tcp://node2:26657": {recvPacket, ack, send, recvPacket},
+		"tcp://node3:26657": {ack, timeout, recvPacket},
+	}
+	fetch := func(_ context.Context, node string) (*globalfeetypes.QueryBypassMinFeeMsgTypesResponse, error) {
+		msgTypes, ok := configs[node]
+		if !ok {
+			return nil, errors.New("connection refused")
+		}
+		return globalfee.NewNodeConfigServer(msgTypes, 0, nil, nil, nil, nil).BypassMinFeeMsgTypes(context.Background(), &globalfeetypes.QueryBypassMinFeeMsgTypesRequest{})
+	}
+
+	nodes := []string{"tcp://node0:26657", "tcp://node1:26657", "tcp://node2:26657", "tcp://down:26657", "tcp://node3:26657"}
+	report := cmd.AuditBypassMinFeeMsgTypes(context.Background(), nodes, fetch)
+
+	require.Equal(t, globalfee.BypassMinFeeMsgTypesHash(configs["tcp://node0:26657"]), report.ReferenceHash)
+	require.Equal(t, globalfee.SortedMsgTypes([]string{recvPacket, ack, timeout}), report.ReferenceMsgTypes)
+
+	divergent := report.Divergent()
+	require.Len(t, divergent, 1)
+	require.Equal(t, "tcp://node2:26657", divergent[0].Node)
+	require.Equal(t, []string{timeout}, divergent[0].Missing)
+	require.Equal(t, []string{send}, divergent[0].Extra)
+	require.Equal(t, []string{recvPacket}, divergent[0].Duplicates)
+
+	unreachable := report.Unreachable()
+	require.Len(t, unreachable, 1)
+	require.Equal(t, "tcp://down:26657", unreachable[0].Node)
+	require.Contains(t, unreachable[0].Error, "connection refused")
+
+	// the order of the msg types does not matter
+	require.Len(t, report.Nodes, len(nodes))
+	for _, node := range report.Nodes {
+		if node.Node != "tcp://node2:26657" && node.Error == "" {
+			require.Equal(t, report.ReferenceHash, node.Hash, node.Node)
+			require.Empty(t, node.Missing)
+			require.Empty(t, node.Extra)
+		}
+	}
+}
+
+func TestAuditBypassMinFeeMsgTypesAllUnreachable(t *testing.T) {
+	fetch := func(_ context.Context, _ string) (*globalfeetypes.QueryBypassMinFeeMsgTypesResponse, error) {
+		return nil, errors.New("connection refused")
+	}
+
+	report := cmd.AuditBypassMinFeeMsgTypes(context.Background(), []string{"tcp://a:26657", "tcp://b:26657"}, fetch)
+	require.Empty(t, report.Divergent())
+	require.Len(t, report.Unreachable(), 2)
+	require.Empty(t, report.ReferenceMsgTypes)
+}
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/gaia/v9/app/params"
+)
+
+const flagAppConfigFile = "file"
+
+// deprecatedAppConfigKeys are the app.toml keys that are still accepted but
+// should not be relied upon anymore, with the reason to report. They are only
+// reported when set to a non-zero value as the default template still writes
+// them.
+var deprecatedAppConfigKeys = map[string]string{
+	"pruning-keep-every": "it is removed in Cosmos SDK v0.46, snapshots are kept according to state-sync.snapshot-interval",
+}
+
+// AppConfigReport holds the issues found in an app.toml.
+type AppConfigReport struct {
+	// Errors are the issues preventing the config from being loaded.
+	Errors []string
+	// Warnings are the issues that do not prevent the config from being
+	// loaded but are likely misconfigurations.
+	Warnings []string
+}
+
+// GetValidateCustomConfigCmd returns the validate-custom cobra Command.
+func GetValidateCustomConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-custom",
+		Short: "Validate the app.toml against the Gaia custom app config",
+		Long: `Validate the app.toml against the Gaia custom app config.
+
+The app.toml of the node home is read, or the file given with --file, and the
+following issues are reported:
+- errors for values that cannot be parsed into the expected type and for
+  invalid settings, e.g. empty minimum-gas-prices
+- warnings for unknown keys, deprecated keys and missing keys, which take
+  their default value
+
+The command fails if any error is found.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, err := cmd.Flags().GetString(flagAppConfigFile)
+			if err != nil {
+				return err
+			}
+			if path == "" {
+				clientCtx := client.GetClientContextFromCmd(cmd)
+				path = filepath.Join(clientCtx.HomeDir, "config", "app.toml")
+			}
+
+			report, err := ValidateAppConfigFile(path)
+			if err != nil {
+				return err
+			}
+
+			for _, warning := range report.Warnings {
+				cmd.Printf("WARNING: %s\n", warning)
+			}
+			for _, e := range report.Errors {
+				cmd.Printf("ERROR: %s\n", e)
+			}
+			if len(report.Errors) > 0 {
+				return fmt.Errorf("%s is invalid: %d error(s) found", path, len(report.Errors))
+			}
+
+			cmd.Printf("%s is valid\n", path)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagAppConfigFile, "", "The app.toml file to validate, defaults to the one of the node home")
+
+	return cmd
+}
+
+// ValidateAppConfigFile validates the given app.toml against
+// params.CustomAppConfig.
+func ValidateAppConfigFile(path string) (AppConfigReport, error) {
+	var report AppConfigReport
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("toml")
+	if err := v.ReadInConfig(); err != nil {
+		return report, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var cfg params.CustomAppConfig
+	// the server config is embedded without being squashed, decode it apart
+	if err := v.Unmarshal(&cfg.Config); err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	}
+	if err := v.Unmarshal(&cfg); err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	}
+	if len(report.Errors) == 0 {
+		if err := cfg.Config.ValidateBasic(); err != nil {
+			report.Errors = append(report.Errors, err.Error())
+		}
+	}
+
+	knownKeys := make(map[string]bool)
+	collectConfigKeys(reflect.TypeOf(cfg), "", knownKeys)
+
+	fileKeys := make(map[string]bool)
+	for _, key := range v.AllKeys() {
+		fileKeys[key] = true
+		if !knownKeys[key] {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("unknown key %q", key))
+		}
+		if reason, ok := deprecatedAppConfigKeys[key]; ok && !isZeroConfigValue(v.Get(key)) {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("deprecated key %q: %s", key, reason))
+		}
+	}
+
+	missing := make([]string, 0)
+	for key := range knownKeys {
+		if !fileKeys[key] {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+	for _, key := range missing {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("missing key %q, the default value is used", key))
+	}
+
+	return report, nil
+}
+
+// collectConfigKeys adds the keys of the config leaves of the given struct
+// type, as read by viper, to keys. Embedded structs are flattened.
+func collectConfigKeys(t reflect.Type, prefix string, keys map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("mapstructure"), ",")[0]
+
+		if field.Anonymous && name == "" {
+			collectConfigKeys(field.Type, prefix, keys)
+			continue
+		}
+		if name == "" {
+			continue
+		}
+
+		key := strings.ToLower(prefix + name)
+		if field.Type.Kind() == reflect.Struct {
+			collectConfigKeys(field.Type, key+".", keys)
+			continue
+		}
+		keys[key] = true
+	}
+}
+
+func isZeroConfigValue(value interface{}) bool {
+	s := fmt.Sprint(value)
+	return s == "" || s == "0" || s == "false" || s == "[]"
+}
+
+// addConfigCommands injects custom config commands into the config command.
+func addConfigCommands(cmd *cobra.Command) *cobra.Command {
+	cmd.AddCommand(
+		GetValidateCustomConfigCmd(),
+		GetAuditBypassCmd(),
+	)
+	return cmd
+}
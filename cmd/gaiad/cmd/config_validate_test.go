@@ -0,0 +1,90 @@
+package cmd_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/stretchr/testify/require"
+
+	gaia "github.com/cosmos/gaia/v9/app"
+	"github.com/cosmos/gaia/v9/app/params"
+	"github.com/cosmos/gaia/v9/cmd/gaiad/cmd"
+)
+
+func writeAppConfig(t *testing.T, replace map[string]string, extra string) string {
+	t.Helper()
+
+	cfg := params.CustomAppConfig{
+		Config:               *serverconfig.DefaultConfig(),
+		BypassMinFeeMsgTypes: gaia.GetDefaultBypassFeeMessages(),
+	}
+	cfg.MinGasPrices = "0.0025uatom"
+
+	tmpl, err := template.New("appConfig").Parse(params.CustomConfigTemplate())
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, cfg))
+
+	content := buf.String()
+	for old, repl := range replace {
+		require.Contains(t, content, old)
+		content = strings.Replace(content, old, repl, 1)
+	}
+
+	path := filepath.Join(t.TempDir(), "app.toml")
+	require.NoError(t, os.WriteFile(path, []byte(extra+content), 0o600))
+	return path
+}
+
+func TestValidateAppConfigFile(t *testing.T) {
+	specs := map[string]struct {
+		replace     map[string]string
+		extra       string
+		expWarnings []string
+		expErrors   []string
+	}{
+		"default config": {},
+		"unknown custom key": {
+			extra:       "bypass-min-fee-msg-typez = []\n",
+			expWarnings: []string{`unknown key "bypass-min-fee-msg-typez"`},
+		},
+		"missing key": {
+			replace:     map[string]string{"halt-height = 0": ""},
+			expWarnings: []string{`missing key "halt-height", the default value is used`},
+		},
+		"deprecated key": {
+			replace:     map[string]string{`pruning-keep-every = "0"`: `pruning-keep-every = "100"`},
+			expWarnings: []string{`deprecated key "pruning-keep-every"`},
+		},
+		"type mismatch": {
+			replace:   map[string]string{"halt-height = 0": `halt-height = "tomorrow"`},
+			expErrors: []string{"halt-height"},
+		},
+		"empty min gas prices": {
+			replace:   map[string]string{`minimum-gas-prices = "0.0025uatom"`: `minimum-gas-prices = ""`},
+			expErrors: []string{"set min gas price"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			path := writeAppConfig(t, spec.replace, spec.extra)
+
+			report, err := cmd.ValidateAppConfigFile(path)
+			require.NoError(t, err)
+
+			require.Len(t, report.Warnings, len(spec.expWarnings), report.Warnings)
+			for i, exp := range spec.expWarnings {
+				require.Contains(t, report.Warnings[i], exp)
+			}
+			require.Len(t, report.Errors, len(spec.expErrors), report.Errors)
+			for i, exp := range spec.expErrors {
+				require.Contains(t, report.Errors[i], exp)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/spf13/cobra"
+)
+
+// DenomTracesMap maps the IBC denoms in supply to their denom trace.
+type DenomTracesMap struct {
+	DenomTraces map[string]ibctransfertypes.DenomTrace `json:"denom_traces" yaml:"denom_traces"`
+	Pagination  *query.PageResponse                    `json:"pagination,omitempty" yaml:"pagination,omitempty"`
+}
+
+// GetDenomTracesMapCmd returns the denom-traces-map cobra Command.
+func GetDenomTracesMapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-traces-map",
+		Short: "Query the denom trace of each IBC denom in supply",
+		Long: `Query the denom trace of each IBC denom in supply.
+
+The total supply is paginated with the pagination flags, and the trace, i.e. the
+path and the base denom, of each ibc/<hash> denom of the page is returned keyed
+by the denom. The next page is requested with the returned next key.
+
+Example:
+	gaiad query ibc-transfer denom-traces-map
+	gaiad query ibc-transfer denom-traces-map --limit=50 --page-key=<next-key>
+	`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := QueryDenomTracesMap(
+				cmd.Context(),
+				banktypes.NewQueryClient(clientCtx),
+				ibctransfertypes.NewQueryClient(clientCtx),
+				pageReq,
+			)
+			if err != nil {
+				return err
+			}
+
+			// maps are not supported by the legacy amino JSON codec
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom-traces-map")
+
+	return cmd
+}
+
+// QueryDenomTracesMap queries a page of the total supply and the denom trace
+// of each of its IBC denoms from the transfer module.
+func QueryDenomTracesMap(
+	ctx context.Context,
+	bankClient banktypes.QueryClient,
+	transferClient ibctransfertypes.QueryClient,
+	pageReq *query.PageRequest,
+) (DenomTracesMap, error) {
+	supplyRes, err := bankClient.TotalSupply(ctx, &banktypes.QueryTotalSupplyRequest{Pagination: pageReq})
+	if err != nil {
+		return DenomTracesMap{}, err
+	}
+
+	res := DenomTracesMap{
+		DenomTraces: make(map[string]ibctransfertypes.DenomTrace),
+		Pagination:  supplyRes.Pagination,
+	}
+	for _, coin := range supplyRes.Supply {
+		if !strings.HasPrefix(coin.Denom, ibctransfertypes.DenomPrefix+"/") {
+			continue
+		}
+		traceRes, err := transferClient.DenomTrace(ctx, &ibctransfertypes.QueryDenomTraceRequest{Hash: coin.Denom})
+		if err != nil {
+			return DenomTracesMap{}, err
+		}
+		res.DenomTraces[coin.Denom] = *traceRes.DenomTrace
+	}
+
+	return res, nil
+}
+
+// addIBCTransferQueryCommands injects custom transfer query commands into the
+// ibc-transfer query command of another command.
+func addIBCTransferQueryCommands(cmd *cobra.Command) *cobra.Command {
+	for _, c := range cmd.Commands() {
+		if c.Name() == "ibc-transfer" {
+			c.AddCommand(GetDenomTracesMapCmd())
+		}
+	}
+	return cmd
+}
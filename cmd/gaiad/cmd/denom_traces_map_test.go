@@ -0,0 +1,75 @@
+package cmd_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v9/cmd/gaiad/cmd"
+)
+
+type mockBankQueryClient struct {
+	banktypes.QueryClient
+	supply sdk.Coins
+}
+
+func (m mockBankQueryClient) TotalSupply(_ context.Context, req *banktypes.QueryTotalSupplyRequest, _ ...grpc.CallOption) (*banktypes.QueryTotalSupplyResponse, error) {
+	return &banktypes.QueryTotalSupplyResponse{
+		Supply:     m.supply,
+		Pagination: &query.PageResponse{Total: uint64(len(m.supply))},
+	}, nil
+}
+
+type mockTransferQueryClient struct {
+	ibctransfertypes.QueryClient
+	traces []ibctransfertypes.DenomTrace
+}
+
+func (m mockTransferQueryClient) DenomTrace(_ context.Context, req *ibctransfertypes.QueryDenomTraceRequest, _ ...grpc.CallOption) (*ibctransfertypes.QueryDenomTraceResponse, error) {
+	for _, trace := range m.traces {
+		if trace.IBCDenom() == req.Hash {
+			trace := trace
+			return &ibctransfertypes.QueryDenomTraceResponse{DenomTrace: &trace}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, req.Hash)
+}
+
+func TestQueryDenomTracesMap(t *testing.T) {
+	osmoTrace := ibctransfertypes.ParseDenomTrace("transfer/channel-141/uosmo")
+	atomTrace := ibctransfertypes.ParseDenomTrace("transfer/channel-0/transfer/channel-1/uatom")
+
+	bankClient := mockBankQueryClient{
+		supply: sdk.NewCoins(
+			sdk.NewInt64Coin("uatom", 1000),
+			sdk.NewInt64Coin(osmoTrace.IBCDenom(), 10),
+			sdk.NewInt64Coin(atomTrace.IBCDenom(), 20),
+		),
+	}
+	transferClient := mockTransferQueryClient{
+		traces: []ibctransfertypes.DenomTrace{osmoTrace, atomTrace},
+	}
+
+	res, err := cmd.QueryDenomTracesMap(context.Background(), bankClient, transferClient, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.DenomTraces, 2)
+	require.Equal(t, osmoTrace, res.DenomTraces[osmoTrace.IBCDenom()])
+	require.Equal(t, "transfer/channel-141", res.DenomTraces[osmoTrace.IBCDenom()].Path)
+	require.Equal(t, "uosmo", res.DenomTraces[osmoTrace.IBCDenom()].BaseDenom)
+	require.Equal(t, atomTrace, res.DenomTraces[atomTrace.IBCDenom()])
+	require.Equal(t, "transfer/channel-0/transfer/channel-1", res.DenomTraces[atomTrace.IBCDenom()].Path)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	// a denom without trace fails the query
+	transferClient.traces = transferClient.traces[:1]
+	_, err = cmd.QueryDenomTracesMap(context.Background(), bankClient, transferClient, &query.PageRequest{})
+	require.Error(t, err)
+}
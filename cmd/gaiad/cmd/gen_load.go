@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	gfante "github.com/cosmos/gaia/v9/x/globalfee/ante"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+const (
+	flagCount      = "count"
+	flagRecipients = "recipients"
+	flagAmount     = "amount"
+	flagBroadcast  = "broadcast"
+)
+
+// GetGenLoadCmd returns the gen-load cobra Command.
+func GetGenLoadCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gen-load",
+		Short: "Generate signed bank send txs paying the current minimum fees, for load testing",
+		Long: `Generate signed bank send txs paying the current minimum fees, for load testing.
+
+The globalfee params of the node are queried and --count bank send txs of
+--amount are signed by the --from key, with consecutive sequences starting at
+the current sequence of the account. The recipients are rotated over a set of
+--recipients deterministic addresses so that the txs are not trivially
+identical.
+
+Each tx pays the fee required by the globalfee params for the --gas limit. As
+the minimum-gas-prices of a node cannot be queried, set --gas-prices to them to
+also honor them, they are combined with the global fees as done by the fee
+ante handler.
+
+The signed txs are printed one per line, or broadcast with --broadcast.
+
+Example:
+	gaiad debug gen-load --count 100 --from mykey --chain-id cosmoshub-4 --broadcast
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			count, err := cmd.Flags().GetUint64(flagCount)
+			if err != nil {
+				return err
+			}
+			numRecipients, err := cmd.Flags().GetUint64(flagRecipients)
+			if err != nil {
+				return err
+			}
+			if numRecipients == 0 {
+				return fmt.Errorf("--%s must be positive", flagRecipients)
+			}
+			amountStr, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(amountStr)
+			if err != nil {
+				return err
+			}
+			broadcast, err := cmd.Flags().GetBool(flagBroadcast)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if txf.SimulateAndExecute() {
+				return errors.New("--gas=auto is not supported, the fees depend on the gas limit")
+			}
+			if !txf.Fees().IsZero() {
+				return fmt.Errorf("--%s cannot be set, the fees are computed from the globalfee params", flags.FlagFees)
+			}
+
+			params, err := globalfeetypes.NewQueryClient(clientCtx).Params(cmd.Context(), &globalfeetypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			stakingParams, err := stakingtypes.NewQueryClient(clientCtx).Params(cmd.Context(), &stakingtypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			fee := LoadTxFee(params.Params, txf.GasPrices(), stakingParams.Params.BondDenom, txf.Gas())
+
+			// fetch the account number and sequence of the sender
+			txf, err = txf.WithGasPrices("").Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			txs, err := GenLoadTxs(txf, clientCtx.GetFromName(), clientCtx.GetFromAddress(), LoadRecipients(numRecipients), amount, fee, count)
+			if err != nil {
+				return err
+			}
+
+			for _, loadTx := range txs {
+				if !broadcast {
+					bz, err := clientCtx.TxConfig.TxJSONEncoder()(loadTx)
+					if err != nil {
+						return err
+					}
+					cmd.Println(string(bz))
+					continue
+				}
+
+				bz, err := clientCtx.TxConfig.TxEncoder()(loadTx)
+				if err != nil {
+					return err
+				}
+				res, err := clientCtx.BroadcastTx(bz)
+				if err != nil {
+					return err
+				}
+				if err := clientCtx.PrintProto(res); err != nil {
+					return err
+				}
+				if res.Code != 0 {
+					return fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagCount, 10, "The number of txs to generate")
+	cmd.Flags().Uint64(flagRecipients, 10, "The number of recipients to rotate over")
+	cmd.Flags().String(flagAmount, "1uatom", "The amount sent by each tx")
+	cmd.Flags().Bool(flagBroadcast, false, "Broadcast the generated txs instead of printing them")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// LoadTxFee returns the fee a tx with the given gas limit must pay to pass the
+// fee ante handler under the given globalfee params and local min gas prices.
+// The fee is paid in the first denom of the combined fee requirement with a
+// non-zero amount, or is empty when a zero fee is accepted.
+func LoadTxFee(params globalfeetypes.Params, localGasPrices sdk.DecCoins, bondDenom string, gas uint64) sdk.Coins {
+	globalGasPrices := params.MinimumGasPrices
+	if len(globalGasPrices) == 0 {
+		// same default as the fee ante handler
+		globalGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec(bondDenom, sdk.ZeroDec())}
+	}
+	globalFees := gfante.ApplyMinFlatFee(gfante.RequiredFees(globalGasPrices, gas), params.MinFlatFee)
+
+	var localFees sdk.Coins
+	if !localGasPrices.IsZero() {
+		localFees = gfante.RequiredFees(localGasPrices, gas)
+	}
+
+	for _, fee := range gfante.CombinedFeeRequirement(globalFees, localFees) {
+		if fee.IsPositive() {
+			return sdk.NewCoins(fee)
+		}
+	}
+	return sdk.Coins{}
+}
+
+// LoadRecipients returns n deterministic recipient addresses.
+func LoadRecipients(n uint64) []sdk.AccAddress {
+	recipients := make([]sdk.AccAddress, n)
+	for i := range recipients {
+		recipients[i] = tmhash.SumTruncated([]byte(fmt.Sprintf("gen-load-recipient-%d", i)))
+	}
+	return recipients
+}
+
+// GenLoadTxs returns count bank send txs of amount from the given key, paying
+// fee and rotating over the recipients. The txs are signed with consecutive
+// sequences starting at the factory sequence.
+func GenLoadTxs(
+	txf tx.Factory,
+	fromName string,
+	from sdk.AccAddress,
+	recipients []sdk.AccAddress,
+	amount, fee sdk.Coins,
+	count uint64,
+) ([]sdk.Tx, error) {
+	txf = txf.WithFees(fee.String())
+	sequence := txf.Sequence()
+
+	txs := make([]sdk.Tx, count)
+	for i := uint64(0); i < count; i++ {
+		msg := banktypes.NewMsgSend(from, recipients[i%uint64(len(recipients))], amount)
+		txf = txf.WithSequence(sequence + i)
+		txBuilder, err := txf.BuildUnsignedTx(msg)
+		if err != nil {
+			return nil, err
+		}
+		if err := tx.Sign(txf, fromName, txBuilder, true); err != nil {
+			return nil, err
+		}
+		txs[i] = txBuilder.GetTx()
+	}
+
+	return txs, nil
+}
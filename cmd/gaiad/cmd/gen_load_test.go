@@ -0,0 +1,114 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/cmd/gaiad/cmd"
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	gfante "github.com/cosmos/gaia/v9/x/globalfee/ante"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+func TestLoadTxFee(t *testing.T) {
+	params := globalfeetypes.Params{
+		MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 4))),
+		MinFlatFee:       sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	}
+
+	specs := map[string]struct {
+		params         globalfeetypes.Params
+		localGasPrices sdk.DecCoins
+		expFee         sdk.Coins
+	}{
+		"global fee": {
+			params: globalfeetypes.Params{MinimumGasPrices: params.MinimumGasPrices},
+			expFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+		},
+		"min flat fee": {
+			params: params,
+			expFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+		},
+		"higher local min gas prices": {
+			params:         params,
+			localGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))),
+			expFee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 2000)),
+		},
+		"local min gas prices without global fee": {
+			localGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))),
+			expFee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 2000)),
+		},
+		"no fee required": {
+			expFee: sdk.Coins{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, spec.expFee, cmd.LoadTxFee(spec.params, spec.localGasPrices, "stake", 200_000))
+		})
+	}
+}
+
+func TestGenLoadTxs(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	localGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2)))
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{}).WithMinGasPrices(localGasPrices)
+
+	params := globalfeetypes.Params{
+		MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 4))),
+		MinFlatFee:       sdk.NewCoins(sdk.NewInt64Coin("stake", 3000)),
+	}
+	app.GetSubspace(globalfee.ModuleName).SetParamSet(ctx, &params)
+
+	encCfg := gaiaapp.MakeTestEncodingConfig()
+	kr := keyring.NewInMemory()
+	info, _, err := kr.NewMnemonic("sender", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	gas := uint64(200_000)
+	txf := tx.Factory{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithKeybase(kr).
+		WithChainID("testchain").
+		WithGas(gas).
+		WithSequence(7).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+	fee := cmd.LoadTxFee(params, localGasPrices, "stake", gas)
+	recipients := cmd.LoadRecipients(3)
+
+	txs, err := cmd.GenLoadTxs(txf, "sender", info.GetAddress(), recipients, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), fee, 5)
+	require.NoError(t, err)
+	require.Len(t, txs, 5)
+
+	decorator := gfante.NewFeeDecorator(nil, app.GetSubspace(globalfee.ModuleName), app.GetSubspace(stakingtypes.ModuleName), 0)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	for i, loadTx := range txs {
+		feeTx := loadTx.(sdk.FeeTx)
+		require.True(t, feeTx.GetFee().IsAllGTE(sdk.NewCoins(sdk.NewInt64Coin("stake", 3000))), feeTx.GetFee())
+		_, err := decorator.AnteHandle(ctx, loadTx, false, next)
+		require.NoError(t, err)
+
+		sigs, err := loadTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+		require.NoError(t, err)
+		require.Equal(t, uint64(7+i), sigs[0].Sequence)
+		require.Equal(t, recipients[i%3].String(), loadTx.GetMsgs()[0].(*banktypes.MsgSend).ToAddress)
+	}
+
+	// a fee below the minimum is rejected by the same decorator
+	txs, err = cmd.GenLoadTxs(txf, "sender", info.GetAddress(), recipients, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), sdk.NewCoins(sdk.NewInt64Coin("stake", 2999)), 1)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, txs[0], false, next)
+	require.Error(t, err)
+}
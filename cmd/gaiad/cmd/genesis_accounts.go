@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// GenesisAccountMismatches are the addresses of a genesis whose auth account
+// and bank balance do not match, each sorted.
+type GenesisAccountMismatches struct {
+	// AccountsWithoutBalance are the auth accounts with no bank balance entry.
+	AccountsWithoutBalance []string
+	// BalancesWithoutAccount are the bank balance entries with no auth account.
+	BalancesWithoutAccount []string
+}
+
+// Empty returns true if the genesis accounts and balances match.
+func (m GenesisAccountMismatches) Empty() bool {
+	return len(m.AccountsWithoutBalance) == 0 && len(m.BalancesWithoutAccount) == 0
+}
+
+// GetValidateGenesisAccountsCmd returns the validate-accounts cobra Command.
+func GetValidateGenesisAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-accounts [genesis-file]",
+		Short: "Validate that the auth accounts and the bank balances of the genesis match",
+		Long: `Validate that the auth accounts and the bank balances of the genesis match.
+
+Every auth account of the genesis must have a bank balance entry, and every bank
+balance entry must have an auth account. The mismatched addresses are reported
+and the command fails if there is any. The module accounts are not required to
+have a balance entry, since the module accounts without funds have none.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genDoc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return err
+			}
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to unmarshal the app state: %w", err)
+			}
+
+			mismatches, err := ValidateGenesisAccounts(clientCtx.Codec, appState)
+			if err != nil {
+				return err
+			}
+			if mismatches.Empty() {
+				cmd.Println("the genesis accounts and balances match")
+				return nil
+			}
+
+			for _, addr := range mismatches.AccountsWithoutBalance {
+				cmd.Printf("account %s has no balance entry\n", addr)
+			}
+			for _, addr := range mismatches.BalancesWithoutAccount {
+				cmd.Printf("balance %s has no account\n", addr)
+			}
+			return fmt.Errorf("%d accounts without balance and %d balances without account",
+				len(mismatches.AccountsWithoutBalance), len(mismatches.BalancesWithoutAccount))
+		},
+	}
+
+	return cmd
+}
+
+// ValidateGenesisAccounts cross-checks the auth accounts and the bank balances
+// of appState. The module accounts are not required to have a balance entry.
+func ValidateGenesisAccounts(cdc codec.Codec, appState map[string]json.RawMessage) (GenesisAccountMismatches, error) {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return GenesisAccountMismatches{}, fmt.Errorf("failed to unpack the genesis accounts: %w", err)
+	}
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	balances := make(map[string]bool, len(bankGenState.Balances))
+	for _, balance := range bankGenState.Balances {
+		balances[balance.Address] = true
+	}
+
+	var mismatches GenesisAccountMismatches
+	accountAddrs := make(map[string]bool, len(accounts))
+	for _, acc := range accounts {
+		addr := acc.GetAddress().String()
+		accountAddrs[addr] = true
+		if _, isModuleAcc := acc.(authtypes.ModuleAccountI); isModuleAcc {
+			continue
+		}
+		if !balances[addr] {
+			mismatches.AccountsWithoutBalance = append(mismatches.AccountsWithoutBalance, addr)
+		}
+	}
+	for addr := range balances {
+		if !accountAddrs[addr] {
+			mismatches.BalancesWithoutAccount = append(mismatches.BalancesWithoutAccount, addr)
+		}
+	}
+
+	sort.Strings(mismatches.AccountsWithoutBalance)
+	sort.Strings(mismatches.BalancesWithoutAccount)
+	return mismatches, nil
+}
@@ -0,0 +1,69 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	"github.com/cosmos/gaia/v9/cmd/gaiad/cmd"
+)
+
+func TestValidateGenesisAccounts(t *testing.T) {
+	encCfg := gaiaapp.MakeTestEncodingConfig()
+	funded := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	unfunded := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	orphan := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+
+	genesis := func(accAddrs, balanceAddrs []sdk.AccAddress) map[string]json.RawMessage {
+		accounts := authtypes.GenesisAccounts{
+			authtypes.NewEmptyModuleAccount(stakingtypes.NotBondedPoolName, authtypes.Burner, authtypes.Staking),
+		}
+		for _, addr := range accAddrs {
+			accounts = append(accounts, authtypes.NewBaseAccountWithAddress(addr))
+		}
+		var balances []banktypes.Balance
+		for _, addr := range balanceAddrs {
+			balances = append(balances, banktypes.Balance{Address: addr.String(), Coins: coins})
+		}
+
+		authGenState := authtypes.NewGenesisState(authtypes.DefaultParams(), accounts)
+		bankGenState := banktypes.DefaultGenesisState()
+		bankGenState.Balances = balances
+		return map[string]json.RawMessage{
+			authtypes.ModuleName: encCfg.Codec.MustMarshalJSON(authGenState),
+			banktypes.ModuleName: encCfg.Codec.MustMarshalJSON(bankGenState),
+		}
+	}
+
+	mismatches, err := cmd.ValidateGenesisAccounts(encCfg.Codec, genesis(
+		[]sdk.AccAddress{funded, unfunded},
+		[]sdk.AccAddress{funded},
+	))
+	require.NoError(t, err)
+	require.False(t, mismatches.Empty())
+	require.Equal(t, []string{unfunded.String()}, mismatches.AccountsWithoutBalance)
+	require.Empty(t, mismatches.BalancesWithoutAccount)
+
+	mismatches, err = cmd.ValidateGenesisAccounts(encCfg.Codec, genesis(
+		[]sdk.AccAddress{funded},
+		[]sdk.AccAddress{funded, orphan},
+	))
+	require.NoError(t, err)
+	require.Empty(t, mismatches.AccountsWithoutBalance)
+	require.Equal(t, []string{orphan.String()}, mismatches.BalancesWithoutAccount)
+
+	mismatches, err = cmd.ValidateGenesisAccounts(encCfg.Codec, genesis(
+		[]sdk.AccAddress{funded},
+		[]sdk.AccAddress{funded},
+	))
+	require.NoError(t, err)
+	require.True(t, mismatches.Empty())
+}
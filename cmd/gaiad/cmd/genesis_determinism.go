@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	gaia "github.com/cosmos/gaia/v9/app"
+)
+
+const flagRounds = "rounds"
+
+// GenesisExporter imports a genesis in a fresh app and returns the exported
+// genesis of each module.
+type GenesisExporter func(genDoc *tmtypes.GenesisDoc) (map[string]json.RawMessage, error)
+
+// GenesisDifference is a module whose exported genesis is not the same in all
+// the rounds of VerifyGenesisDeterminism.
+type GenesisDifference struct {
+	Module string
+	// Path is the JSON path of the first difference within the module
+	// genesis, or empty if the module genesis only differs in its formatting.
+	Path string
+}
+
+// GetVerifyGenesisDeterminismCmd returns the verify-determinism cobra Command.
+func GetVerifyGenesisDeterminismCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-determinism [genesis-file]",
+		Short: "Verify that the genesis is imported and exported deterministically",
+		Long: `Verify that the genesis is imported and exported deterministically.
+
+The genesis file is imported in a fresh in-memory app and the resulting state
+is exported, --rounds times. The exported genesis of each module must be byte
+identical in all the rounds, otherwise the modules whose genesis differ are
+reported along with the JSON path of their first difference. Such modules
+usually iterate over a Go map when initializing or exporting their genesis,
+which breaks the agreement of the validators on the genesis.
+
+The genesis invariants are not checked, the genesis should be validated with
+validate-genesis first.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rounds, err := cmd.Flags().GetInt(flagRounds)
+			if err != nil {
+				return err
+			}
+			if rounds < 2 {
+				return fmt.Errorf("--%s must be at least 2", flagRounds)
+			}
+
+			genDoc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			diffs, err := VerifyGenesisDeterminism(genDoc, ExportFreshAppGenesis, rounds)
+			if err != nil {
+				return err
+			}
+			if len(diffs) == 0 {
+				cmd.Printf("the genesis was exported identically in %d rounds\n", rounds)
+				return nil
+			}
+
+			modules := make([]string, len(diffs))
+			for i, diff := range diffs {
+				modules[i] = diff.Module
+				cmd.Printf("module %s: non-deterministic genesis", diff.Module)
+				if diff.Path != "" {
+					cmd.Printf(", first difference at %s", diff.Path)
+				}
+				cmd.Println()
+			}
+			return fmt.Errorf("non-deterministic genesis of the modules: %s", strings.Join(modules, ", "))
+		},
+	}
+
+	cmd.Flags().Int(flagRounds, 3, "The number of times the genesis is imported and exported")
+
+	return cmd
+}
+
+// VerifyGenesisDeterminism exports genDoc rounds times and returns the modules
+// whose exported genesis is not the same in all the rounds, sorted by name.
+func VerifyGenesisDeterminism(genDoc *tmtypes.GenesisDoc, export GenesisExporter, rounds int) ([]GenesisDifference, error) {
+	var first map[string]json.RawMessage
+	diffs := make(map[string]GenesisDifference)
+	for i := 0; i < rounds; i++ {
+		exported, err := export(genDoc)
+		if err != nil {
+			return nil, fmt.Errorf("round %d: %w", i+1, err)
+		}
+		if first == nil {
+			first = exported
+			continue
+		}
+
+		for _, module := range moduleNames(first, exported) {
+			if _, found := diffs[module]; found || bytes.Equal(first[module], exported[module]) {
+				continue
+			}
+			diffs[module] = GenesisDifference{Module: module, Path: firstJSONDifference(module, first[module], exported[module])}
+		}
+	}
+
+	res := make([]GenesisDifference, 0, len(diffs))
+	for _, diff := range diffs {
+		res = append(res, diff)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Module < res[j].Module })
+	return res, nil
+}
+
+// ExportFreshAppGenesis is the GenesisExporter of the Gaia app. The genesis is
+// imported with InitChain in an app with an in-memory database, without
+// checking the genesis invariants.
+func ExportFreshAppGenesis(genDoc *tmtypes.GenesisDoc) (map[string]json.RawMessage, error) {
+	home, err := os.MkdirTemp("", "gaiad-verify-determinism-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(home)
+
+	appOpts := server.NewDefaultContext().Viper
+	appOpts.Set(crisis.FlagSkipGenesisInvariants, true)
+	app := gaia.NewGaiaApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		home,
+		0,
+		gaia.MakeTestEncodingConfig(),
+		appOpts,
+	)
+
+	app.InitChain(abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+		AppStateBytes:   genDoc.AppState,
+		InitialHeight:   genDoc.InitialHeight,
+	})
+	app.Commit()
+
+	exported, err := app.ExportAppStateAndValidators(false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(exported.AppState, &appState); err != nil {
+		return nil, err
+	}
+	return appState, nil
+}
+
+// moduleNames returns the sorted names of the modules of both app states.
+func moduleNames(a, b map[string]json.RawMessage) []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	for name := range b {
+		if _, found := a[name]; !found {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// firstJSONDifference returns the path of the first difference between the
+// JSON documents a and b, starting at path. An empty path is returned if they
+// only differ in their formatting.
+func firstJSONDifference(path string, a, b json.RawMessage) string {
+	var aValue, bValue interface{}
+	if err := json.Unmarshal(a, &aValue); err != nil {
+		return path
+	}
+	if err := json.Unmarshal(b, &bValue); err != nil {
+		return path
+	}
+
+	diffPath, found := firstValueDifference(path, aValue, bValue)
+	if !found {
+		return ""
+	}
+	return diffPath
+}
+
+func firstValueDifference(path string, a, b interface{}) (string, bool) {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok {
+			return path, true
+		}
+		keys := make([]string, 0, len(a))
+		for key := range a {
+			keys = append(keys, key)
+		}
+		for key := range b {
+			if _, found := a[key]; !found {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if diffPath, found := firstValueDifference(path+"."+key, a[key], b[key]); found {
+				return diffPath, true
+			}
+		}
+		return "", false
+
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return path, true
+		}
+		for i := range a {
+			if diffPath, found := firstValueDifference(fmt.Sprintf("%s[%d]", path, i), a[i], b[i]); found {
+				return diffPath, true
+			}
+		}
+		return "", false
+
+	default:
+		if !reflect.DeepEqual(a, b) {
+			return path, true
+		}
+		return "", false
+	}
+}
@@ -0,0 +1,75 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/cmd/gaiad/cmd"
+)
+
+// mapBackedExporter exports the genesis of two modules holding their state in
+// a Go map: "sorted" sorts the map keys on export, "unsorted" does not.
+func mapBackedExporter(*tmtypes.GenesisDoc) (map[string]json.RawMessage, error) {
+	state := make(map[string]uint64)
+	for i := 0; i < 20; i++ {
+		state[fmt.Sprintf("key%02d", i)] = uint64(i)
+	}
+
+	var unsorted, sorted []string
+	for key := range state {
+		unsorted = append(unsorted, key)
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	appState := map[string]json.RawMessage{"static": json.RawMessage(`{"params":{}}`)}
+	for name, keys := range map[string][]string{"sorted": sorted, "unsorted": unsorted} {
+		bz, err := json.Marshal(map[string][]string{"keys": keys})
+		if err != nil {
+			return nil, err
+		}
+		appState[name] = bz
+	}
+	return appState, nil
+}
+
+func TestVerifyGenesisDeterminism(t *testing.T) {
+	diffs, err := cmd.VerifyGenesisDeterminism(&tmtypes.GenesisDoc{}, mapBackedExporter, 10)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.Equal(t, "unsorted", diffs[0].Module)
+	require.Regexp(t, `^unsorted\.keys\[\d+\]$`, diffs[0].Path)
+
+	expErr := errors.New("invalid genesis")
+	failing := func(*tmtypes.GenesisDoc) (map[string]json.RawMessage, error) { return nil, expErr }
+	_, err = cmd.VerifyGenesisDeterminism(&tmtypes.GenesisDoc{}, failing, 2)
+	require.ErrorIs(t, err, expErr)
+}
+
+func TestVerifyGenesisDeterminismGaiaApp(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	exported, err := app.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+
+	genDoc := &tmtypes.GenesisDoc{
+		ChainID:         "testchain",
+		ConsensusParams: tmtypes.DefaultConsensusParams(),
+		AppState:        exported.AppState,
+	}
+	appState, err := cmd.ExportFreshAppGenesis(genDoc)
+	require.NoError(t, err)
+	var expAppState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &expAppState))
+	require.JSONEq(t, string(expAppState["bank"]), string(appState["bank"]))
+
+	diffs, err := cmd.VerifyGenesisDeterminism(genDoc, cmd.ExportFreshAppGenesis, 2)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+}
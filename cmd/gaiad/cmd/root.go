@@ -30,6 +30,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
+	gaiaante "github.com/cosmos/gaia/v9/ante"
 	gaia "github.com/cosmos/gaia/v9/app"
 	"github.com/cosmos/gaia/v9/app/params"
 )
@@ -82,8 +83,9 @@ func initAppConfig() (string, interface{}) {
 	srvCfg.StateSync.SnapshotKeepRecent = 10
 
 	return params.CustomConfigTemplate(), params.CustomAppConfig{
-		Config:               *srvCfg,
-		BypassMinFeeMsgTypes: gaia.GetDefaultBypassFeeMessages(),
+		Config:                *srvCfg,
+		BypassMinFeeMsgTypes:  gaia.GetDefaultBypassFeeMessages(),
+		MempoolEvictionPolicy: string(gaiaante.EvictLowestFee),
 	}
 }
 
@@ -98,10 +100,11 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		genutilcli.GenTxCmd(gaia.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, gaia.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(gaia.ModuleBasics),
 		AddGenesisAccountCmd(gaia.DefaultNodeHome),
+		genesisCommand(),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(gaia.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		addDebugCommands(debug.Cmd()),
-		config.Cmd(),
+		addConfigCommands(config.Cmd()),
 	)
 
 	ac := appCreator{
@@ -124,6 +127,23 @@ func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 }
 
+func genesisCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "genesis",
+		Short:                      "Genesis file subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetVerifyGenesisDeterminismCmd(),
+		GetValidateGenesisAccountsCmd(),
+	)
+
+	return cmd
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
@@ -140,9 +160,14 @@ func queryCommand() *cobra.Command {
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
+		GetTxsByLabelCmd(),
 	)
 
 	gaia.ModuleBasics.AddQueryCommands(cmd)
+	addGovQueryCommands(cmd)
+	addUpgradeQueryCommands(cmd)
+	addStakingQueryCommands(cmd)
+	addIBCTransferQueryCommands(cmd)
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return cmd
@@ -0,0 +1,253 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagProposalID = "proposal-id"
+	flagVotes      = "votes"
+)
+
+// TallySimulation is the outcome of a simulated proposal tally.
+type TallySimulation struct {
+	Passes       bool                 `json:"passes" yaml:"passes"`
+	BurnDeposits bool                 `json:"burn_deposits" yaml:"burn_deposits"`
+	Reason       string               `json:"reason" yaml:"reason"`
+	Tally        govtypes.TallyResult `json:"tally" yaml:"tally"`
+}
+
+// GetSimulateTallyCmd returns the simulate-tally cobra Command.
+func GetSimulateTallyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-tally",
+		Short: "Simulate the outcome of a proposal given hypothetical validator votes",
+		Long: `Simulate the outcome of a proposal given hypothetical validator votes.
+
+The current bonded validator set is fetched and the hypothetical votes are overlaid
+on top of the votes already cast on the proposal. Validators that are not part of
+the hypothetical votes keep their existing vote, or abstain if they have not voted.
+Votes cast by delegators are not modelled, all delegated stake follows the vote
+of its validator.
+
+Voters are given either as validator operator addresses or as the account address
+of the validator operator.
+
+Example:
+	gaiad query gov simulate-tally --proposal-id=1 --votes=cosmosvaloper1...:yes,cosmos1...:no_with_veto
+	`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := cmd.Flags().GetUint64(flagProposalID)
+			if err != nil {
+				return err
+			}
+
+			votesStr, err := cmd.Flags().GetString(flagVotes)
+			if err != nil {
+				return err
+			}
+
+			hypothetical, err := ParseHypotheticalVotes(votesStr)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			govClient := govtypes.NewQueryClient(clientCtx)
+			stakingClient := stakingtypes.NewQueryClient(clientCtx)
+
+			if _, err := govClient.Proposal(ctx, &govtypes.QueryProposalRequest{ProposalId: proposalID}); err != nil {
+				return fmt.Errorf("failed to fetch proposal %d: %w", proposalID, err)
+			}
+
+			paramsRes, err := govClient.Params(ctx, &govtypes.QueryParamsRequest{ParamsType: govtypes.ParamTallying})
+			if err != nil {
+				return err
+			}
+
+			poolRes, err := stakingClient.Pool(ctx, &stakingtypes.QueryPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			var validators []stakingtypes.Validator
+			pageReq := &query.PageRequest{}
+			for {
+				res, err := stakingClient.Validators(ctx, &stakingtypes.QueryValidatorsRequest{
+					Status:     stakingtypes.BondStatusBonded,
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+				validators = append(validators, res.Validators...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			var votes []govtypes.Vote
+			pageReq = &query.PageRequest{}
+			for {
+				res, err := govClient.Votes(ctx, &govtypes.QueryVotesRequest{ProposalId: proposalID, Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+				votes = append(votes, res.Votes...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			existing := make(map[string]govtypes.WeightedVoteOptions, len(votes))
+			for _, vote := range votes {
+				voter, err := sdk.AccAddressFromBech32(vote.Voter)
+				if err != nil {
+					return err
+				}
+				existing[sdk.ValAddress(voter).String()] = vote.Options
+			}
+
+			result := SimulateTally(validators, existing, hypothetical, poolRes.Pool.BondedTokens, paramsRes.TallyParams)
+
+			return clientCtx.PrintObjectLegacy(result)
+		},
+	}
+
+	cmd.Flags().Uint64(flagProposalID, 0, "The proposal to simulate the tally of")
+	cmd.Flags().String(flagVotes, "", "Comma separated list of hypothetical votes in the form voter:option")
+	_ = cmd.MarkFlagRequired(flagProposalID)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ParseHypotheticalVotes parses a comma separated list of voter:option pairs
+// into a map from validator operator address to vote option. Voters can be
+// given as either operator or account addresses.
+func ParseHypotheticalVotes(votesStr string) (map[string]govtypes.VoteOption, error) {
+	votes := make(map[string]govtypes.VoteOption)
+	if strings.TrimSpace(votesStr) == "" {
+		return votes, nil
+	}
+
+	for _, entry := range strings.Split(votesStr, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid vote %q, expected voter:option", entry)
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(parts[0])
+		if err != nil {
+			accAddr, accErr := sdk.AccAddressFromBech32(parts[0])
+			if accErr != nil {
+				return nil, fmt.Errorf("invalid voter address %s", parts[0])
+			}
+			valAddr = sdk.ValAddress(accAddr)
+		}
+
+		option, err := govtypes.VoteOptionFromString(govutils.NormalizeVoteOption(parts[1]))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := votes[valAddr.String()]; ok {
+			return nil, fmt.Errorf("duplicate vote for voter %s", parts[0])
+		}
+		votes[valAddr.String()] = option
+	}
+
+	return votes, nil
+}
+
+// SimulateTally tallies the voting power of the given bonded validators using
+// the hypothetical votes, falling back to the existing votes and then to
+// abstain for validators without a hypothetical vote. The pass/fail rules
+// mirror the gov module tally.
+func SimulateTally(
+	validators []stakingtypes.Validator,
+	existing map[string]govtypes.WeightedVoteOptions,
+	hypothetical map[string]govtypes.VoteOption,
+	totalBonded sdk.Int,
+	params govtypes.TallyParams,
+) TallySimulation {
+	results := map[govtypes.VoteOption]sdk.Dec{
+		govtypes.OptionYes:        sdk.ZeroDec(),
+		govtypes.OptionAbstain:    sdk.ZeroDec(),
+		govtypes.OptionNo:         sdk.ZeroDec(),
+		govtypes.OptionNoWithVeto: sdk.ZeroDec(),
+	}
+	totalVotingPower := sdk.ZeroDec()
+
+	for _, val := range validators {
+		if !val.IsBonded() {
+			continue
+		}
+
+		var options govtypes.WeightedVoteOptions
+		if option, ok := hypothetical[val.OperatorAddress]; ok {
+			options = govtypes.NewNonSplitVoteOption(option)
+		} else if opts, ok := existing[val.OperatorAddress]; ok {
+			options = opts
+		} else {
+			options = govtypes.NewNonSplitVoteOption(govtypes.OptionAbstain)
+		}
+
+		votingPower := val.GetBondedTokens().ToDec()
+		for _, option := range options {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+
+	sim := TallySimulation{Tally: govtypes.NewTallyResultFromMap(results)}
+
+	switch {
+	case totalBonded.IsZero():
+		sim.Reason = "no bonded tokens"
+	case totalVotingPower.Quo(totalBonded.ToDec()).LT(params.Quorum):
+		sim.BurnDeposits = true
+		sim.Reason = "quorum not reached"
+	case totalVotingPower.Sub(results[govtypes.OptionAbstain]).IsZero():
+		sim.Reason = "all voters abstained"
+	case results[govtypes.OptionNoWithVeto].Quo(totalVotingPower).GT(params.VetoThreshold):
+		sim.BurnDeposits = true
+		sim.Reason = "veto threshold exceeded"
+	case results[govtypes.OptionYes].Quo(totalVotingPower.Sub(results[govtypes.OptionAbstain])).GT(params.Threshold):
+		sim.Passes = true
+		sim.Reason = "threshold reached"
+	default:
+		sim.Reason = "threshold not reached"
+	}
+
+	return sim
+}
+
+// addGovQueryCommands injects custom gov query commands into the gov query
+// command of another command.
+func addGovQueryCommands(cmd *cobra.Command) *cobra.Command {
+	for _, c := range cmd.Commands() {
+		if c.Name() == govtypes.ModuleName {
+			c.AddCommand(GetSimulateTallyCmd())
+		}
+	}
+	return cmd
+}
@@ -0,0 +1,110 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/cmd/gaiad/cmd"
+)
+
+func TestSimulateTally(t *testing.T) {
+	// three bonded validators holding 50%, 30% and 20% of the bonded tokens
+	powers := []int64{50, 30, 20}
+	validators := make([]stakingtypes.Validator, len(powers))
+	for i, power := range powers {
+		pk := ed25519.GenPrivKey().PubKey()
+		val, err := stakingtypes.NewValidator(sdk.ValAddress(pk.Address()), pk, stakingtypes.Description{})
+		require.NoError(t, err)
+		val.Status = stakingtypes.Bonded
+		val.Tokens = sdk.NewInt(power)
+		val.DelegatorShares = sdk.NewDec(power)
+		validators[i] = val
+	}
+	totalBonded := sdk.NewInt(100)
+	params := govtypes.DefaultTallyParams()
+
+	voterAcc := func(i int) string {
+		valAddr, err := sdk.ValAddressFromBech32(validators[i].OperatorAddress)
+		require.NoError(t, err)
+		return sdk.AccAddress(valAddr).String()
+	}
+
+	testCases := []struct {
+		name         string
+		votes        string
+		existing     map[string]govtypes.WeightedVoteOptions
+		passes       bool
+		burnDeposits bool
+		yes          sdk.Int
+	}{
+		{
+			name:  "majority yes passes",
+			votes: validators[0].OperatorAddress + ":yes," + voterAcc(1) + ":no",
+			// the third validator does not vote and is counted as abstain
+			passes: true,
+			yes:    sdk.NewInt(50),
+		},
+		{
+			name:  "existing vote is kept for unspecified voters",
+			votes: validators[0].OperatorAddress + ":yes",
+			existing: map[string]govtypes.WeightedVoteOptions{
+				validators[1].OperatorAddress: govtypes.NewNonSplitVoteOption(govtypes.OptionNo),
+				validators[2].OperatorAddress: govtypes.NewNonSplitVoteOption(govtypes.OptionNo),
+			},
+			passes: false,
+			yes:    sdk.NewInt(50),
+		},
+		{
+			name:  "hypothetical vote overrides existing vote",
+			votes: validators[1].OperatorAddress + ":yes",
+			existing: map[string]govtypes.WeightedVoteOptions{
+				validators[0].OperatorAddress: govtypes.NewNonSplitVoteOption(govtypes.OptionNo),
+				validators[1].OperatorAddress: govtypes.NewNonSplitVoteOption(govtypes.OptionNo),
+				validators[2].OperatorAddress: govtypes.NewNonSplitVoteOption(govtypes.OptionYes),
+			},
+			passes: false,
+			yes:    sdk.NewInt(50),
+		},
+		{
+			name:         "veto burns deposits",
+			votes:        validators[0].OperatorAddress + ":no_with_veto," + validators[1].OperatorAddress + ":yes," + validators[2].OperatorAddress + ":yes",
+			passes:       false,
+			burnDeposits: true,
+			yes:          sdk.NewInt(50),
+		},
+		{
+			name:   "everyone abstains fails",
+			votes:  "",
+			passes: false,
+			yes:    sdk.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			votes, err := cmd.ParseHypotheticalVotes(tc.votes)
+			require.NoError(t, err)
+
+			res := cmd.SimulateTally(validators, tc.existing, votes, totalBonded, params)
+			require.Equal(t, tc.passes, res.Passes)
+			require.Equal(t, tc.burnDeposits, res.BurnDeposits)
+			require.Equal(t, tc.yes, res.Tally.Yes)
+		})
+	}
+}
+
+func TestParseHypotheticalVotesInvalid(t *testing.T) {
+	for _, votes := range []string{
+		"cosmos1invalid:yes",
+		"no-separator",
+		sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String() + ":maybe",
+	} {
+		_, err := cmd.ParseHypotheticalVotes(votes)
+		require.Error(t, err, votes)
+	}
+}
@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// StateUsageProfile is the current usage of the state the state impact
+// estimates are based on.
+type StateUsageProfile struct {
+	// Validators is the number of validators, which bounds the number of
+	// delegations of a delegator.
+	Validators uint64
+	// DelegationCounts are the numbers of delegations of each delegator.
+	DelegationCounts []uint64
+	// DelegationBytes is the average number of state bytes of a delegation,
+	// including its distribution starting info.
+	DelegationBytes uint64
+	// MaxDelegationsPerDelegator is the current delegation cap, 0 if disabled.
+	MaxDelegationsPerDelegator uint64
+	// EntryCounts are the numbers of entries of each unbonding delegation and
+	// redelegation.
+	EntryCounts []uint64
+	// EntryBytes is the average number of state bytes of an unbonding
+	// delegation or redelegation entry.
+	EntryBytes uint64
+	// MaxEntries is the current max entries staking param.
+	MaxEntries uint32
+}
+
+// StateImpactEstimate is the estimated state growth of a param change.
+type StateImpactEstimate struct {
+	// Param is the subspace and key of the changed param.
+	Param         string `json:"param" yaml:"param"`
+	CurrentValue  uint64 `json:"current_value" yaml:"current_value"`
+	ProposedValue uint64 `json:"proposed_value" yaml:"proposed_value"`
+	// Saturated is the number of records at the current limit, which are
+	// assumed to grow up to the proposed limit.
+	Saturated uint64 `json:"saturated" yaml:"saturated"`
+	// AdditionalEntries is the number of state entries added by the saturated
+	// records.
+	AdditionalEntries uint64 `json:"additional_entries" yaml:"additional_entries"`
+	BytesPerEntry     uint64 `json:"bytes_per_entry" yaml:"bytes_per_entry"`
+	AdditionalBytes   uint64 `json:"additional_bytes" yaml:"additional_bytes"`
+}
+
+// GetEstimateStateImpactCmd returns the estimate-state-impact cobra Command.
+func GetEstimateStateImpactCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-state-impact [exported-genesis-file] [subspace] [key] [value]",
+		Short: "Estimate the state growth of a param change from the current usage",
+		Long: `Estimate the state growth of a param change from the current usage.
+
+The current usage is read from a genesis exported with gaiad export and the
+value is given as in a param change proposal. The records at the current limit
+are assumed to grow up to the proposed limit while the others keep their size,
+and the additional state bytes are projected from the average size of the
+existing entries. This is a heuristic planning tool, the actual growth depends
+on how the increased allowance is used. A tightened limit adds no state.
+
+The supported params are:
+	policy MaxDelegationsPerDelegator: the delegations of each delegator
+	staking MaxEntries: the entries of each unbonding delegation and redelegation
+
+Example:
+	gaiad export > export.json
+	gaiad debug estimate-state-impact export.json policy MaxDelegationsPerDelegator '"20"'
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genDoc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return err
+			}
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+				return err
+			}
+
+			profile, err := NewStateUsageProfile(clientCtx.Codec, appState)
+			if err != nil {
+				return err
+			}
+			estimate, err := EstimateStateImpact(profile, args[1], args[2], args[3])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(estimate)
+		},
+	}
+
+	return cmd
+}
+
+// NewStateUsageProfile builds the usage profile of the state of an exported
+// app genesis.
+func NewStateUsageProfile(cdc codec.Codec, appState map[string]json.RawMessage) (StateUsageProfile, error) {
+	var stakingGenesis stakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenesis); err != nil {
+		return StateUsageProfile{}, fmt.Errorf("invalid staking genesis: %w", err)
+	}
+	var distrGenesis distrtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[distrtypes.ModuleName], &distrGenesis); err != nil {
+		return StateUsageProfile{}, fmt.Errorf("invalid distribution genesis: %w", err)
+	}
+	var policyGenesis policytypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[policytypes.ModuleName], &policyGenesis); err != nil {
+		return StateUsageProfile{}, fmt.Errorf("invalid policy genesis: %w", err)
+	}
+
+	profile := StateUsageProfile{
+		Validators:                 uint64(len(stakingGenesis.Validators)),
+		MaxDelegationsPerDelegator: policyGenesis.Params.MaxDelegationsPerDelegator,
+		MaxEntries:                 stakingGenesis.Params.MaxEntries,
+	}
+
+	// the delegations of each delegator, in order of appearance
+	var delegators []string
+	delegationCounts := make(map[string]uint64)
+	var delegationBytes uint64
+	for _, delegation := range stakingGenesis.Delegations {
+		if _, ok := delegationCounts[delegation.DelegatorAddress]; !ok {
+			delegators = append(delegators, delegation.DelegatorAddress)
+		}
+		delegationCounts[delegation.DelegatorAddress]++
+		delegationBytes += uint64(len(stakingtypes.GetDelegationKey(delegation.GetDelegatorAddr(), delegation.GetValidatorAddr())) + len(cdc.MustMarshal(&delegation)))
+	}
+	for _, info := range distrGenesis.DelegatorStartingInfos {
+		delAddr, err := sdk.AccAddressFromBech32(info.DelegatorAddress)
+		if err != nil {
+			return StateUsageProfile{}, err
+		}
+		valAddr, err := sdk.ValAddressFromBech32(info.ValidatorAddress)
+		if err != nil {
+			return StateUsageProfile{}, err
+		}
+		delegationBytes += uint64(len(distrtypes.GetDelegatorStartingInfoKey(valAddr, delAddr)) + len(cdc.MustMarshal(&info.StartingInfo)))
+	}
+	for _, delegator := range delegators {
+		profile.DelegationCounts = append(profile.DelegationCounts, delegationCounts[delegator])
+	}
+	if len(stakingGenesis.Delegations) > 0 {
+		profile.DelegationBytes = delegationBytes / uint64(len(stakingGenesis.Delegations))
+	}
+
+	var entries, entryBytes uint64
+	for _, ubd := range stakingGenesis.UnbondingDelegations {
+		delAddr, err := sdk.AccAddressFromBech32(ubd.DelegatorAddress)
+		if err != nil {
+			return StateUsageProfile{}, err
+		}
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return StateUsageProfile{}, err
+		}
+		profile.EntryCounts = append(profile.EntryCounts, uint64(len(ubd.Entries)))
+		entries += uint64(len(ubd.Entries))
+		entryBytes += uint64(len(stakingtypes.GetUBDKey(delAddr, valAddr)) + len(cdc.MustMarshal(&ubd)))
+	}
+	for _, red := range stakingGenesis.Redelegations {
+		delAddr, err := sdk.AccAddressFromBech32(red.DelegatorAddress)
+		if err != nil {
+			return StateUsageProfile{}, err
+		}
+		valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+		if err != nil {
+			return StateUsageProfile{}, err
+		}
+		valDstAddr, err := sdk.ValAddressFromBech32(red.ValidatorDstAddress)
+		if err != nil {
+			return StateUsageProfile{}, err
+		}
+		profile.EntryCounts = append(profile.EntryCounts, uint64(len(red.Entries)))
+		entries += uint64(len(red.Entries))
+		entryBytes += uint64(len(stakingtypes.GetREDKey(delAddr, valSrcAddr, valDstAddr)) + len(cdc.MustMarshal(&red)))
+	}
+	if entries > 0 {
+		profile.EntryBytes = entryBytes / entries
+	}
+
+	return profile, nil
+}
+
+// EstimateStateImpact estimates the state growth of setting the param of the
+// given subspace and key to value from the usage profile. The records at the
+// current limit are assumed to grow up to the proposed limit.
+func EstimateStateImpact(profile StateUsageProfile, subspace, key, value string) (StateImpactEstimate, error) {
+	proposed, err := strconv.ParseUint(strings.Trim(strings.TrimSpace(value), `"`), 10, 64)
+	if err != nil {
+		return StateImpactEstimate{}, fmt.Errorf("invalid value %s: %w", value, err)
+	}
+
+	estimate := StateImpactEstimate{
+		Param:         subspace + "/" + key,
+		ProposedValue: proposed,
+	}
+	var counts []uint64
+	var current, limit uint64
+	switch {
+	case subspace == policytypes.ModuleName && key == string(policytypes.ParamStoreKeyMaxDelegationsPerDelegator):
+		if profile.DelegationBytes == 0 {
+			return StateImpactEstimate{}, fmt.Errorf("no delegation to base the estimate on")
+		}
+		// a disabled cap is bounded by the number of validators
+		estimate.CurrentValue = profile.MaxDelegationsPerDelegator
+		current, limit = profile.MaxDelegationsPerDelegator, proposed
+		if current == 0 || current > profile.Validators {
+			current = profile.Validators
+		}
+		if limit == 0 || limit > profile.Validators {
+			limit = profile.Validators
+		}
+		counts = profile.DelegationCounts
+		estimate.BytesPerEntry = profile.DelegationBytes
+
+	case subspace == stakingtypes.ModuleName && key == string(stakingtypes.KeyMaxEntries):
+		if proposed == 0 {
+			return StateImpactEstimate{}, fmt.Errorf("max entries must be positive")
+		}
+		if profile.EntryBytes == 0 {
+			return StateImpactEstimate{}, fmt.Errorf("no unbonding delegation or redelegation entry to base the estimate on")
+		}
+		estimate.CurrentValue = uint64(profile.MaxEntries)
+		current, limit = uint64(profile.MaxEntries), proposed
+		counts = profile.EntryCounts
+		estimate.BytesPerEntry = profile.EntryBytes
+
+	default:
+		return StateImpactEstimate{}, fmt.Errorf("no state impact estimate for the param %s %s", subspace, key)
+	}
+
+	if limit <= current {
+		return estimate, nil
+	}
+	for _, count := range counts {
+		if count >= current {
+			estimate.Saturated++
+		}
+	}
+	estimate.AdditionalEntries = estimate.Saturated * (limit - current)
+	estimate.AdditionalBytes = estimate.AdditionalEntries * estimate.BytesPerEntry
+
+	return estimate, nil
+}
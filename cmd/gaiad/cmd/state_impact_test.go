@@ -0,0 +1,143 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/cmd/gaiad/cmd"
+)
+
+func TestEstimateStateImpact(t *testing.T) {
+	// fabricated usage: 6 delegators, 3 of them at the cap of 5 delegations,
+	// and 4 unbonding delegations or redelegations, 1 of them at max entries
+	profile := cmd.StateUsageProfile{
+		Validators:                 50,
+		DelegationCounts:           []uint64{1, 5, 2, 5, 4, 5},
+		DelegationBytes:            200,
+		MaxDelegationsPerDelegator: 5,
+		EntryCounts:                []uint64{1, 7, 3, 2},
+		EntryBytes:                 60,
+		MaxEntries:                 7,
+	}
+
+	testCases := []struct {
+		name     string
+		subspace string
+		key      string
+		value    string
+		expEst   cmd.StateImpactEstimate
+		expErr   bool
+	}{
+		{
+			name:     "loosened delegation cap",
+			subspace: "policy",
+			key:      "MaxDelegationsPerDelegator",
+			value:    `"20"`,
+			expEst: cmd.StateImpactEstimate{
+				Param:             "policy/MaxDelegationsPerDelegator",
+				CurrentValue:      5,
+				ProposedValue:     20,
+				Saturated:         3,
+				AdditionalEntries: 45,
+				BytesPerEntry:     200,
+				AdditionalBytes:   9_000,
+			},
+		},
+		{
+			name:     "disabled delegation cap is bounded by the validators",
+			subspace: "policy",
+			key:      "MaxDelegationsPerDelegator",
+			value:    `"0"`,
+			expEst: cmd.StateImpactEstimate{
+				Param:             "policy/MaxDelegationsPerDelegator",
+				CurrentValue:      5,
+				ProposedValue:     0,
+				Saturated:         3,
+				AdditionalEntries: 135,
+				BytesPerEntry:     200,
+				AdditionalBytes:   27_000,
+			},
+		},
+		{
+			name:     "tightened delegation cap",
+			subspace: "policy",
+			key:      "MaxDelegationsPerDelegator",
+			value:    `"3"`,
+			expEst: cmd.StateImpactEstimate{
+				Param:         "policy/MaxDelegationsPerDelegator",
+				CurrentValue:  5,
+				ProposedValue: 3,
+				BytesPerEntry: 200,
+			},
+		},
+		{
+			name:     "loosened max entries",
+			subspace: "staking",
+			key:      "MaxEntries",
+			value:    "10",
+			expEst: cmd.StateImpactEstimate{
+				Param:             "staking/MaxEntries",
+				CurrentValue:      7,
+				ProposedValue:     10,
+				Saturated:         1,
+				AdditionalEntries: 3,
+				BytesPerEntry:     60,
+				AdditionalBytes:   180,
+			},
+		},
+		{
+			name:     "invalid value",
+			subspace: "staking",
+			key:      "MaxEntries",
+			value:    "ten",
+			expErr:   true,
+		},
+		{
+			name:     "unsupported param",
+			subspace: "staking",
+			key:      "MaxValidators",
+			value:    "200",
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			estimate, err := cmd.EstimateStateImpact(profile, tc.subspace, tc.key, tc.value)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expEst, estimate)
+		})
+	}
+}
+
+func TestNewStateUsageProfileGaiaApp(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	exported, err := app.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+
+	profile, err := cmd.NewStateUsageProfile(gaiaapp.MakeTestEncodingConfig().Codec, appState)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), profile.Validators)
+	require.Equal(t, []uint64{1}, profile.DelegationCounts)
+	require.Positive(t, profile.DelegationBytes)
+	require.Empty(t, profile.EntryCounts)
+
+	// the genesis delegator is at a cap of a single delegation
+	profile.MaxDelegationsPerDelegator = 1
+	profile.Validators = 10
+	estimate, err := cmd.EstimateStateImpact(profile, "policy", "MaxDelegationsPerDelegator", `"3"`)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), estimate.AdditionalEntries)
+	require.Equal(t, 2*profile.DelegationBytes, estimate.AdditionalBytes)
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v9/ante"
+)
+
+// GetTxsByLabelCmd returns the txs-by-label cobra Command.
+func GetTxsByLabelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "txs-by-label [label]",
+		Short: "Query for paginated transactions with a label in their memo",
+		Long: `Query for paginated transactions with a label in their memo.
+
+A labeled memo is made of semicolon separated key:value labels, e.g.
+app:myapp;action:swap, the keys being lowercase alphanumeric and the values
+alphanumeric or one of . _ / -. The transactions with such a memo are indexed by
+the tx indexer of the nodes under each of their labels. Labeled memos are
+opt-in, other memos are not indexed.
+
+Example:
+	gaiad query txs-by-label app:myapp --page 1 --limit 30
+	`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			labels, ok := ante.ParseMemoLabels(args[0])
+			if !ok || len(labels) != 1 {
+				return fmt.Errorf("invalid label %s, expected a single key:value label", args[0])
+			}
+
+			page, _ := cmd.Flags().GetInt(flags.FlagPage)
+			limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
+
+			txs, err := authtx.QueryTxsByEvents(clientCtx, []string{MemoLabelQuery(labels[0])}, page, limit, "")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(txs)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Int(flags.FlagPage, rest.DefaultPage, "Query a specific page of paginated results")
+	cmd.Flags().Int(flags.FlagLimit, rest.DefaultLimit, "Query number of transactions results per page returned")
+
+	return cmd
+}
+
+// MemoLabelQuery returns the tx search query of the txs with the given label
+// in their memo.
+func MemoLabelQuery(label string) string {
+	return fmt.Sprintf("%s.%s='%s'", ante.EventTypeMemoLabel, ante.AttributeKeyLabel, label)
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	gaia "github.com/cosmos/gaia/v9/app"
+)
+
+const flagCheckPlan = "check-plan"
+
+// UpgradeHandlers lists the upgrade handlers registered in the binary, along
+// with the upgrade plan scheduled on chain when it was checked.
+type UpgradeHandlers struct {
+	Handlers []string `json:"handlers" yaml:"handlers"`
+	Plan     string   `json:"plan,omitempty" yaml:"plan,omitempty"`
+}
+
+// GetRegisteredUpgradeHandlersCmd returns the registered-handlers cobra Command.
+func GetRegisteredUpgradeHandlersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registered-handlers",
+		Short: "List the upgrades this binary has a handler for",
+		Long: `List the upgrades this binary has a handler for.
+
+The handlers are read from the upgrade keeper of an in-memory instance of the
+app, no node is queried. With --check-plan, the upgrade plan scheduled on chain
+is also queried from the node, and the command fails if this binary has no
+handler for it, as the chain would halt at the upgrade height when running it.
+
+Example:
+	gaiad query upgrade registered-handlers
+	gaiad query upgrade registered-handlers --check-plan --node=tcp://localhost:26657
+	`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			handlers, err := RegisteredUpgradeHandlers()
+			if err != nil {
+				return err
+			}
+			res := UpgradeHandlers{Handlers: handlers}
+
+			checkPlan, err := cmd.Flags().GetBool(flagCheckPlan)
+			if err != nil {
+				return err
+			}
+			if checkPlan {
+				planRes, err := upgradetypes.NewQueryClient(clientCtx).CurrentPlan(cmd.Context(), &upgradetypes.QueryCurrentPlanRequest{})
+				if err != nil {
+					return err
+				}
+				if planRes.Plan != nil {
+					res.Plan = planRes.Plan.Name
+					if !HasUpgradeHandler(handlers, res.Plan) {
+						return fmt.Errorf("this binary has no upgrade handler for the plan %s scheduled at height %d", res.Plan, planRes.Plan.Height)
+					}
+				}
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagCheckPlan, false, "Query the upgrade plan scheduled on chain and fail if this binary has no handler for it")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RegisteredUpgradeHandlers returns the names of the upgrades the upgrade
+// keeper of the Gaia app has a handler for. The app is created with an
+// in-memory database.
+func RegisteredUpgradeHandlers() ([]string, error) {
+	home, err := os.MkdirTemp("", "gaiad-upgrade-handlers-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(home)
+
+	app := gaia.NewGaiaApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		home,
+		0,
+		gaia.MakeTestEncodingConfig(),
+		server.NewDefaultContext().Viper,
+	)
+	return app.RegisteredUpgradeHandlers(), nil
+}
+
+// HasUpgradeHandler returns whether the given upgrade is among the handlers.
+func HasUpgradeHandler(handlers []string, name string) bool {
+	for _, handler := range handlers {
+		if handler == name {
+			return true
+		}
+	}
+	return false
+}
+
+// addUpgradeQueryCommands injects custom upgrade query commands into the
+// upgrade query command of another command.
+func addUpgradeQueryCommands(cmd *cobra.Command) *cobra.Command {
+	for _, c := range cmd.Commands() {
+		if c.Name() == upgradetypes.ModuleName {
+			c.AddCommand(GetRegisteredUpgradeHandlersCmd())
+		}
+	}
+	return cmd
+}
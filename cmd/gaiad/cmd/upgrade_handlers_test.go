@@ -0,0 +1,28 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	app "github.com/cosmos/gaia/v9/app"
+	"github.com/cosmos/gaia/v9/cmd/gaiad/cmd"
+)
+
+func TestRegisteredUpgradeHandlers(t *testing.T) {
+	handlers, err := cmd.RegisteredUpgradeHandlers()
+	require.NoError(t, err)
+	require.Contains(t, handlers, "v10")
+	for _, upgrade := range app.Upgrades {
+		require.Contains(t, handlers, upgrade.UpgradeName)
+	}
+
+	require.True(t, cmd.HasUpgradeHandler(handlers, "v10"))
+	require.False(t, cmd.HasUpgradeHandler(handlers, "v0-unknown"))
+
+	// the command is injected in the upgrade query commands
+	rootCmd, _ := cmd.NewRootCmd()
+	found, _, err := rootCmd.Find([]string{"query", "upgrade", "registered-handlers"})
+	require.NoError(t, err)
+	require.Equal(t, "registered-handlers", found.Name())
+}
@@ -3,13 +3,18 @@ package e2e
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 
 	gaia "github.com/cosmos/gaia/v9/app"
@@ -44,6 +49,79 @@ type chain struct {
 	// initial accounts in genesis
 	genesisAccounts        []*account
 	genesisVestingAccounts map[string]sdk.AccAddress
+	// multisig accounts funded in genesis
+	multisigAccounts []*multisigAccount
+	// commission rates per validator index, validators not present use
+	// defaultCommissionRates
+	commissions map[int]stakingtypes.CommissionRates
+	// staking descriptions per validator index, validators not present use
+	// their generated moniker only
+	descriptions map[int]stakingtypes.Description
+	// uatom self delegations per validator index, validators not present use
+	// stakingAmount
+	stakingAmounts map[int]sdk.Int
+	// uatom min gas prices per validator index, validators not present use
+	// minGasPrice
+	minGasPrices map[int]string
+	// log level and format per validator index, validators not present use
+	// the info level and the plain format
+	logLevels  map[int]string
+	logFormats map[int]string
+	// API and gRPC enablement per validator index, validators not present
+	// enable both
+	apiEnabled  map[int]bool
+	grpcEnabled map[int]bool
+	// number of observers, full nodes syncing the chain without a gentx, none
+	// when zero
+	observerCount int
+	// observer nodes of the chain, they have no voting power
+	observers []*validator
+	// staking unbonding time set in genesis, the default is kept when zero
+	unbondingTime time.Duration
+	// distribution params set in genesis, the defaults are kept when nil
+	distributionParams *distrtypes.Params
+	// max fraction of the community pool a spend proposal can request set in
+	// genesis, the default is kept when nil
+	maxSpendFraction *sdk.Dec
+	// fraction of the fees sent to the grants pool set in genesis, the
+	// default is kept when nil
+	grantsPoolFeeShare *sdk.Dec
+	// coins the community pool starts with in genesis, the pool starts empty
+	// when empty
+	communityPool sdk.Coins
+	// IBC vouchers preloaded in genesis and held by every e2e account
+	ibcDenoms []ibcDenom
+	// gov deposit params set in genesis, the e2e defaults are kept when nil
+	govDepositParams *govtypes.DepositParams
+	// gov tally params set in genesis, the e2e defaults are kept when nil
+	govTallyParams *govtypes.TallyParams
+	// gov voting period set in genesis, the e2e default is kept when zero
+	govVotingPeriod time.Duration
+	// max number of proposals in deposit or voting period set in genesis, the
+	// default is kept when zero
+	maxActiveProposals uint64
+	// max size of the data of the IBC transfer packets set in genesis, the
+	// size is unlimited when zero
+	maxPacketDataBytes uint64
+	// denoms the gov proposal deposits can be paid in set in genesis, the
+	// denoms of the min deposit are allowed when empty
+	depositDenoms []string
+	// max total supplies of denoms set in genesis, no denom is capped when
+	// empty
+	supplyCaps sdk.Coins
+	// slashing params set in genesis, the defaults are kept when nil
+	slashingParams *slashingtypes.Params
+	// max bytes of a block set in the genesis consensus params, the default
+	// is kept when zero
+	maxBlockBytes int64
+	// max gas of a block set in the genesis consensus params, the gas of a
+	// block is unlimited when zero
+	maxBlockGas int64
+	// timeout commit of the validators, the config default is kept when zero
+	timeoutCommit time.Duration
+	// min time between blocks set in the genesis consensus params, the
+	// default is kept when zero
+	timeIota time.Duration
 }
 
 func newChain() (*chain, error) {
@@ -115,10 +193,324 @@ func (c *chain) createAndInitValidatorsWithMnemonics(count int, mnemonics []stri
 	return nil
 }
 
+// createAndInitObservers creates the observer nodes of the chain, with their
+// node and consensus keys. They sign nothing, so they have no account key.
+func (c *chain) createAndInitObservers() error {
+	for i := 0; i < c.observerCount; i++ {
+		node := c.createObserver(i)
+
+		// generate genesis files
+		if err := node.init(); err != nil {
+			return err
+		}
+
+		c.observers = append(c.observers, node)
+
+		if err := node.createNodeKey(); err != nil {
+			return err
+		}
+		if err := node.createConsensusKey(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createObserver returns the observer node of the given index, named apart
+// from the validators of the chain.
+func (c *chain) createObserver(index int) *validator {
+	moniker := fmt.Sprintf("%s-gaia-observer", c.id)
+
+	return &validator{
+		chain:       c,
+		index:       index,
+		moniker:     moniker,
+		description: stakingtypes.NewDescription(moniker, "", "", "", ""),
+	}
+}
+
 func (c *chain) createValidator(index int) *validator {
+	commission := defaultCommissionRates()
+	if rates, ok := c.commissions[index]; ok {
+		commission = rates
+	}
+
+	moniker := fmt.Sprintf("%s-gaia-%d", c.id, index)
+	description := stakingtypes.NewDescription(moniker, "", "", "", "")
+	if desc, ok := c.descriptions[index]; ok {
+		description = desc
+	}
+
 	return &validator{
-		chain:   c,
-		index:   index,
-		moniker: fmt.Sprintf("%s-gaia-%d", c.id, index),
+		chain:       c,
+		index:       index,
+		moniker:     moniker,
+		description: description,
+		commission:  commission,
+	}
+}
+
+// setValidatorDescription configures the moniker, identity and website of the
+// validator with the given index, used in its gentx and as the moniker of its
+// node. The instance name of the validator is still derived from its
+// generated moniker. It must be called before the validators of the chain are
+// created.
+func (c *chain) setValidatorDescription(index int, moniker, identity, website string) {
+	if c.descriptions == nil {
+		c.descriptions = make(map[int]stakingtypes.Description)
+	}
+	c.descriptions[index] = stakingtypes.NewDescription(moniker, identity, website, "", "")
+}
+
+// setValidatorCommission configures the commission rates used in the gentx of
+// the validator with the given index. It must be called before the validators
+// of the chain are created.
+func (c *chain) setValidatorCommission(index int, rate, maxRate, maxChangeRate string) {
+	if c.commissions == nil {
+		c.commissions = make(map[int]stakingtypes.CommissionRates)
+	}
+	c.commissions[index] = stakingtypes.CommissionRates{
+		Rate:          sdk.MustNewDecFromStr(rate),
+		MaxRate:       sdk.MustNewDecFromStr(maxRate),
+		MaxChangeRate: sdk.MustNewDecFromStr(maxChangeRate),
+	}
+}
+
+// setValidatorStakingAmount configures the uatom self delegation of the gentx
+// of the validator with the given index, and so its initial voting power. It
+// must be called before the genesis of the chain is initialized.
+func (c *chain) setValidatorStakingAmount(index int, amount sdk.Int) {
+	if c.stakingAmounts == nil {
+		c.stakingAmounts = make(map[int]sdk.Int)
+	}
+	c.stakingAmounts[index] = amount
+}
+
+// setValidatorMinGasPrice configures the uatom min gas price set in the
+// app.toml of the validator with the given index.
+func (c *chain) setValidatorMinGasPrice(index int, price string) {
+	if c.minGasPrices == nil {
+		c.minGasPrices = make(map[int]string)
+	}
+	c.minGasPrices[index] = price
+}
+
+// setValidatorLogConfig configures the log level and format set in the
+// config.toml of the validator with the given index, e.g. the json format for
+// tests parsing the logs of the validator.
+func (c *chain) setValidatorLogConfig(index int, level, format string) {
+	if c.logLevels == nil {
+		c.logLevels = make(map[int]string)
+	}
+	if c.logFormats == nil {
+		c.logFormats = make(map[int]string)
+	}
+	c.logLevels[index] = level
+	c.logFormats[index] = format
+}
+
+// setValidatorAPIConfig configures whether the REST API and the gRPC server
+// are enabled in the app.toml of the validator with the given index, e.g. to
+// test the clients of a node not serving them.
+func (c *chain) setValidatorAPIConfig(index int, api, grpc bool) {
+	if c.apiEnabled == nil {
+		c.apiEnabled = make(map[int]bool)
+	}
+	if c.grpcEnabled == nil {
+		c.grpcEnabled = make(map[int]bool)
+	}
+	c.apiEnabled[index] = api
+	c.grpcEnabled[index] = grpc
+}
+
+// setObserverCount configures the number of observers of the chain: full nodes
+// syncing the blocks from the validators without a gentx, thus without voting
+// power. It must be called before the nodes of the chain are created.
+func (c *chain) setObserverCount(count int) {
+	c.observerCount = count
+}
+
+// setDistributionParams configures how the collected fees split between the
+// block proposer, the validators and the community pool.
+func (c *chain) setDistributionParams(communityTax, baseProposerReward, bonusProposerReward string) {
+	params := distrtypes.DefaultParams()
+	params.CommunityTax = sdk.MustNewDecFromStr(communityTax)
+	params.BaseProposerReward = sdk.MustNewDecFromStr(baseProposerReward)
+	params.BonusProposerReward = sdk.MustNewDecFromStr(bonusProposerReward)
+	c.distributionParams = &params
+}
+
+// setMaxSpendFraction caps the community pool spend proposals to the given
+// fraction of the community pool.
+func (c *chain) setMaxSpendFraction(maxSpendFraction string) {
+	fraction := sdk.MustNewDecFromStr(maxSpendFraction)
+	c.maxSpendFraction = &fraction
+}
+
+// setGrantsPoolFeeShare sends the given fraction of the fees of each block to
+// the grants pool.
+func (c *chain) setGrantsPoolFeeShare(feeShare string) {
+	share := sdk.MustNewDecFromStr(feeShare)
+	c.grantsPoolFeeShare = &share
+}
+
+// setCommunityPool preloads the community pool with the given coins in
+// genesis, so that spend tests don't have to wait for it to be funded.
+func (c *chain) setCommunityPool(pool sdk.Coins) {
+	c.communityPool = pool
+}
+
+// preloadIBCDenom credits every e2e account of the chain with the given
+// amount of the IBC voucher of the given denom trace, e.g.
+// "transfer/channel-0/uosmo", and registers its denom trace in genesis.
+func (c *chain) preloadIBCDenom(denomTrace string, amount sdk.Int) {
+	c.ibcDenoms = append(c.ibcDenoms, ibcDenom{
+		trace:  ibctransfertypes.ParseDenomTrace(denomTrace),
+		amount: amount,
+	})
+}
+
+// setGovDepositParams configures the minimum deposit of the proposals and how
+// long they can stay in deposit period before being dropped.
+func (c *chain) setGovDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) {
+	params := govtypes.NewDepositParams(minDeposit, maxDepositPeriod)
+	c.govDepositParams = &params
+}
+
+// setGovTallyParams configures the share of the voting power that must vote
+// on a proposal, and the share of the non abstaining votes that must be yes,
+// for the proposal to pass.
+func (c *chain) setGovTallyParams(quorum, threshold string) {
+	params := govtypes.NewTallyParams(sdk.MustNewDecFromStr(quorum), sdk.MustNewDecFromStr(threshold), govtypes.DefaultVetoThreshold)
+	c.govTallyParams = &params
+}
+
+// setGovVotingPeriod configures how long the proposals stay in voting period,
+// so that gov tests can wait for the end of the voting period quickly.
+func (c *chain) setGovVotingPeriod(votingPeriod time.Duration) {
+	c.govVotingPeriod = votingPeriod
+}
+
+// setMaxActiveProposals caps the number of proposals in their deposit or
+// voting period, so that gov tests can reach the cap with a few proposals.
+func (c *chain) setMaxActiveProposals(maxProposals uint64) {
+	c.maxActiveProposals = maxProposals
+}
+
+// setMaxPacketDataBytes limits the size of the data of the IBC transfer
+// packets, so that IBC tests can verify the larger packets are rejected.
+func (c *chain) setMaxPacketDataBytes(maxBytes uint64) {
+	c.maxPacketDataBytes = maxBytes
+}
+
+// setDepositDenoms restricts the gov proposal deposits to the given denoms.
+func (c *chain) setDepositDenoms(denoms ...string) {
+	c.depositDenoms = denoms
+}
+
+// setSupplyCaps caps the total supply of the given denoms.
+func (c *chain) setSupplyCaps(caps sdk.Coins) {
+	c.supplyCaps = caps
+}
+
+// setSlashingParams configures the downtime slashing of the validators: they
+// are jailed for downtimeJailDuration and slashed by slashFractionDowntime
+// when they signed less than minSignedPerWindow of the last
+// signedBlocksWindow blocks. The double sign fraction is kept.
+func (c *chain) setSlashingParams(signedBlocksWindow int64, minSignedPerWindow string, downtimeJailDuration time.Duration, slashFractionDowntime string) {
+	params := slashingtypes.DefaultParams()
+	params.SignedBlocksWindow = signedBlocksWindow
+	params.MinSignedPerWindow = sdk.MustNewDecFromStr(minSignedPerWindow)
+	params.DowntimeJailDuration = downtimeJailDuration
+	params.SlashFractionDowntime = sdk.MustNewDecFromStr(slashFractionDowntime)
+	c.slashingParams = &params
+}
+
+// setBlockParams configures the max bytes of a block, and its max gas, which
+// the fullness of the blocks adjusting the dynamic global fees is relative to.
+func (c *chain) setBlockParams(maxBytes, maxGas int64) {
+	c.maxBlockBytes = maxBytes
+	c.maxBlockGas = maxGas
+}
+
+// setFastChainTime makes the chain produce a block every timeoutCommit and
+// advance its time by at least timeIota per block, so that the chain time
+// runs faster than the wall clock when timeIota exceeds timeoutCommit. The
+// chain must not be relayed over IBC, as its counterparties reject the
+// headers from the future.
+func (c *chain) setFastChainTime(timeoutCommit, timeIota time.Duration) {
+	c.timeoutCommit = timeoutCommit
+	c.timeIota = timeIota
+}
+
+// genesisMutators returns the changes to apply to the genesis of the chain.
+func (c *chain) genesisMutators() []genesisMutator {
+	var mutators []genesisMutator
+	if c.unbondingTime > 0 {
+		mutators = append(mutators, withUnbondingTime(c.unbondingTime))
+	}
+	if c.distributionParams != nil {
+		mutators = append(mutators, withDistributionParams(
+			c.distributionParams.CommunityTax,
+			c.distributionParams.BaseProposerReward,
+			c.distributionParams.BonusProposerReward,
+		))
+	}
+	if c.maxSpendFraction != nil {
+		mutators = append(mutators, withMaxSpendFraction(*c.maxSpendFraction))
+	}
+	if c.grantsPoolFeeShare != nil {
+		mutators = append(mutators, withGrantsPoolFeeShare(*c.grantsPoolFeeShare))
+	}
+	if !c.communityPool.Empty() {
+		mutators = append(mutators, withCommunityPool(c.communityPool))
+	}
+	if len(c.ibcDenoms) > 0 {
+		var holders []sdk.AccAddress
+		for _, val := range c.validators {
+			holders = append(holders, val.keyInfo.GetAddress())
+		}
+		for _, acc := range c.genesisAccounts {
+			holders = append(holders, acc.keyInfo.GetAddress())
+		}
+		mutators = append(mutators, withIBCDenoms(c.ibcDenoms, holders))
+	}
+	if c.govDepositParams != nil {
+		mutators = append(mutators, withGovDepositParams(c.govDepositParams.MinDeposit, c.govDepositParams.MaxDepositPeriod))
+	}
+	if c.govTallyParams != nil {
+		mutators = append(mutators, withGovTallyParams(c.govTallyParams.Quorum, c.govTallyParams.Threshold))
+	}
+	if c.govVotingPeriod > 0 {
+		mutators = append(mutators, withGovVotingPeriod(c.govVotingPeriod))
+	}
+	if c.maxActiveProposals > 0 {
+		mutators = append(mutators, withMaxActiveProposals(c.maxActiveProposals))
+	}
+	if c.maxPacketDataBytes > 0 {
+		mutators = append(mutators, withMaxPacketDataBytes(c.maxPacketDataBytes))
+	}
+	if len(c.depositDenoms) > 0 {
+		mutators = append(mutators, withDepositDenoms(c.depositDenoms))
+	}
+	if !c.supplyCaps.Empty() {
+		mutators = append(mutators, withSupplyCaps(c.supplyCaps))
+	}
+	if c.slashingParams != nil {
+		mutators = append(mutators, withSlashingParams(*c.slashingParams))
+	}
+	if c.maxBlockBytes > 0 || c.maxBlockGas > 0 || c.timeIota > 0 {
+		mutators = append(mutators, withBlockParams(c.maxBlockBytes, c.maxBlockGas, c.timeIota))
+	}
+	return mutators
+}
+
+// relayerTrustingPeriod returns the trusting period of the light clients of
+// the chain, which must be shorter than its unbonding time.
+func (c *chain) relayerTrustingPeriod() string {
+	if c.unbondingTime > 0 {
+		return fmt.Sprintf("%ds", int64((c.unbondingTime * 2 / 3).Seconds()))
 	}
+	return "14days"
 }
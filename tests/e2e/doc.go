@@ -7,8 +7,24 @@
 // containers:
 //
 // 1. Two independent Gaia networks
+// 2. An observer, a full node without voting power, syncing the first network
 // 3. A hermes relayer connecting the two Gaia networks over IBC
 //
 // The file e2e_test.go contains the actual end-to-end integration tests that
 // utilize the testing suite.
+//
+// Setting GAIA_E2E_GENESIS_FILE to the path of a genesis file, e.g. an
+// exported state without validators, starts both networks from it instead of
+// the default genesis. The e2e accounts, params and validators are added on
+// top of it, see make test-e2e-custom-genesis.
+//
+// Setting GAIA_E2E_LONG_RUNNING enables the tests taking several minutes on
+// top of the suite setup, e.g. the double sign test which starts a third
+// network and waits for a validator to be tombstoned, see make
+// test-e2e-long-running.
+//
+// Setting GAIA_E2E_TIMEOUT_MULTIPLIER scales the timeouts of the waits and of
+// the requests of the suite, e.g. 2 on a loaded CI runner or 0.5 for fast
+// local runs. The durations set on the chains, e.g. the gov voting period, are
+// not scaled, only the slack waited on top of them.
 package e2e
@@ -0,0 +1,78 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	"google.golang.org/grpc"
+)
+
+/*
+testValidatorAPIDisabled tests that a validator serving neither the REST API
+nor gRPC cannot be queried through them, while it still takes part in
+consensus.
+Test Benchmarks:
+1. Validation that the queries to the REST API and gRPC of the validator fail
+2. Validation that the chain progresses
+3. Validation that the validator keeps syncing and signing the blocks
+*/
+func (s *IntegrationTestSuite) testValidatorAPIDisabled() {
+	c := s.chainB
+	valIdx := 1
+	s.Require().False(c.validatorAPIEnabled(valIdx))
+	s.Require().False(c.validatorGRPCEnabled(valIdx))
+	val := c.validators[valIdx]
+	resource := s.valResources[c.id][valIdx]
+
+	_, _, err := queryLatestBlockTime(fmt.Sprintf("http://%s", resource.GetHostPort("1317/tcp")))
+	s.Require().Error(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(10*time.Second))
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, resource.GetHostPort("9090/tcp"), grpc.WithInsecure()) //nolint:staticcheck // grpc v1.33 has no insecure credentials package
+	s.Require().NoError(err)
+	defer conn.Close()
+	_, err = tmservice.NewServiceClient(conn).GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+	s.Require().Error(err)
+
+	// the other validators still serve the REST API
+	chainAPI := fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+	startHeight, _, err := queryLatestBlockTime(chainAPI)
+	s.Require().NoError(err)
+	s.Require().Eventually(
+		func() bool {
+			height, _, err := queryLatestBlockTime(chainAPI)
+			return err == nil && height >= startHeight+3
+		},
+		scaleTimeout(time.Minute),
+		time.Second,
+		"chain %s did not progress from height %d", c.id, startHeight,
+	)
+
+	rpcClient, err := rpchttp.New(fmt.Sprintf("tcp://%s", resource.GetHostPort("26657/tcp")), "/websocket")
+	s.Require().NoError(err)
+	s.Require().Eventually(
+		func() bool {
+			ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(5*time.Second))
+			defer cancel()
+
+			block, err := rpcClient.Block(ctx, nil)
+			if err != nil || block.Block.Height < startHeight+3 {
+				return false
+			}
+			for _, sig := range block.Block.LastCommit.Signatures {
+				if bytes.Equal(sig.ValidatorAddress, val.consensusKey.Address) {
+					return sig.ForBlock()
+				}
+			}
+			return false
+		},
+		scaleTimeout(time.Minute),
+		time.Second,
+		"validator %s did not sign the blocks of chain %s", val.description.Moniker, c.id,
+	)
+}
@@ -33,7 +33,7 @@ func (s *IntegrationTestSuite) testBankTokenTransfer() {
 
 				return beforeSenderUAtomBalance.IsValid() && beforeRecipientUAtomBalance.IsValid()
 			},
-			10*time.Second,
+			scaleTimeout(10*time.Second),
 			5*time.Second,
 		)
 
@@ -52,8 +52,145 @@ func (s *IntegrationTestSuite) testBankTokenTransfer() {
 
 				return decremented && incremented
 			},
-			time.Minute,
+			scaleTimeout(time.Minute),
 			5*time.Second,
 		)
 	})
 }
+
+func (s *IntegrationTestSuite) testBankSendBalanceDeltas() {
+	s.Run("send_multiple_denoms_between_accounts", func() {
+		sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+		recipient := s.chainA.validators[1].keyInfo.GetAddress().String()
+		chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+
+		sendAmount := sdk.NewCoins(tokenAmount, sdk.NewCoin(photonDenom, sdk.NewInt(1000)))
+
+		deltas := s.trackBalanceDeltas(chainAAPIEndpoint, []string{sender, recipient}, func() {
+			s.execBankSend(s.chainA, 0, sender, recipient, sendAmount.String(), standardFees.String(), false)
+		})
+
+		requireDelta := func(addr, denom string, expected sdk.Int) {
+			delta := deltas.get(addr, denom)
+			s.Require().True(delta.Equal(expected), "%s %s delta: got %s, expected %s", addr, denom, delta, expected)
+		}
+		requireDelta(sender, uatomDenom, tokenAmount.Amount.Add(standardFees.Amount).Neg())
+		requireDelta(sender, photonDenom, sdk.NewInt(-1000))
+		requireDelta(recipient, uatomDenom, tokenAmount.Amount)
+		requireDelta(recipient, photonDenom, sdk.NewInt(1000))
+	})
+}
+
+/*
+testAddressFeesPaid tests that the fees paid by an address over several txs add up in the
+fees paid query.
+Test Benchmarks:
+1. Bank sends from an address paying a different fee each
+2. Verification that the fees paid by the address grew by the sum of the fees and by the number of txs
+*/
+func (s *IntegrationTestSuite) testAddressFeesPaid() {
+	s.Run("fees_paid_by_address", func() {
+		sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+		recipient := s.chainA.validators[1].keyInfo.GetAddress().String()
+		chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+
+		before, err := queryAddressFeesPaid(chainAAPIEndpoint, sender)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(1), before.IndexedFromHeight)
+
+		fees := []sdk.Coin{
+			standardFees,
+			standardFees.Add(standardFees),
+			sdk.NewCoin(uatomDenom, standardFees.Amount.MulRaw(3)),
+		}
+		totalFees := sdk.ZeroInt()
+		for _, fee := range fees {
+			s.execBankSend(s.chainA, 0, sender, recipient, tokenAmount.String(), fee.String(), false)
+			totalFees = totalFees.Add(fee.Amount)
+		}
+
+		// the txs are committed, but the query may hit a node that didn't
+		// deliver the last block yet
+		s.Require().Eventually(
+			func() bool {
+				after, err := queryAddressFeesPaid(chainAAPIEndpoint, sender)
+				s.Require().NoError(err)
+				return after.Txs == before.Txs+uint64(len(fees)) &&
+					after.FeesPaid.AmountOf(uatomDenom).Equal(before.FeesPaid.AmountOf(uatomDenom).Add(totalFees))
+			},
+			scaleTimeout(time.Minute),
+			5*time.Second,
+		)
+	})
+}
+
+// balanceDeltas are the balance changes of accounts by address and denom.
+type balanceDeltas map[string]map[string]sdk.Int
+
+// get returns the balance change of addr in denom, zero if it did not change.
+func (d balanceDeltas) get(addr, denom string) sdk.Int {
+	if delta, ok := d[addr][denom]; ok {
+		return delta
+	}
+	return sdk.ZeroInt()
+}
+
+// trackBalanceDeltas snapshots the balances of the given addresses, runs
+// action and returns how the balances changed. The action must wait for its
+// txs to be committed.
+func (s *IntegrationTestSuite) trackBalanceDeltas(endpoint string, addrs []string, action func()) balanceDeltas {
+	snapshot := func() map[string]sdk.Coins {
+		balances := make(map[string]sdk.Coins, len(addrs))
+		for _, addr := range addrs {
+			coins, err := queryGaiaAllBalances(endpoint, addr)
+			s.Require().NoError(err)
+			balances[addr] = coins
+		}
+		return balances
+	}
+
+	before := snapshot()
+	action()
+	after := snapshot()
+
+	deltas := make(balanceDeltas, len(addrs))
+	for _, addr := range addrs {
+		deltas[addr] = make(map[string]sdk.Int)
+		for _, coin := range before[addr].Add(after[addr]...) {
+			delta := after[addr].AmountOf(coin.Denom).Sub(before[addr].AmountOf(coin.Denom))
+			if !delta.IsZero() {
+				deltas[addr][coin.Denom] = delta
+			}
+		}
+	}
+	return deltas
+}
+
+// requireModuleBalance asserts that the account of the module moduleName on
+// chain c eventually holds the expected amount of each denom of expected, the
+// other denoms being ignored. The module account is resolved by name through
+// the auth module, so it must exist on chain.
+func (s *IntegrationTestSuite) requireModuleBalance(c *chain, moduleName string, expected sdk.Coins) {
+	endpoint := fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+
+	acc, err := queryModuleAccount(endpoint, moduleName)
+	s.Require().NoError(err)
+	s.Require().Equal(moduleName, acc.GetName())
+
+	var balances sdk.Coins
+	s.Require().Eventuallyf(
+		func() bool {
+			balances, err = queryGaiaAllBalances(endpoint, acc.GetAddress().String())
+			s.Require().NoError(err)
+			for _, coin := range expected {
+				if !balances.AmountOf(coin.Denom).Equal(coin.Amount) {
+					return false
+				}
+			}
+			return true
+		},
+		scaleTimeout(20*time.Second),
+		5*time.Second,
+		"the %s module account does not hold %s", moduleName, expected,
+	)
+}
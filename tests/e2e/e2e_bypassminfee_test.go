@@ -1,7 +1,12 @@
 package e2e
 
 import (
+	"fmt"
+	"time"
+
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func (s *IntegrationTestSuite) testByPassMinFeeWithdrawReward() {
@@ -20,3 +25,55 @@ func (s *IntegrationTestSuite) testByPassMinFeeWithdrawReward() {
 	s.T().Logf("bypass-msg with non-zero coin not in the denom of global fee, fail")
 	s.execWithdrawAllRewards(s.chainA, 0, payee.String(), paidFeeAmt+photonDenom, true)
 }
+
+/*
+testBypassRate tests that the bypass rate query reports the txs which bypassed the minimum fees.
+Test Benchmarks:
+1. Submission of reward withdrawals without fee, a bypass message type of the validators, and of bank sends
+2. Verification that the bypassed txs reported since the submission include the withdrawals, under their message type
+3. Verification that the reported percentage is the share of the bypassed txs among the txs of the window
+*/
+func (s *IntegrationTestSuite) testBypassRate() {
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	recipient := s.chainA.validators[1].keyInfo.GetAddress().String()
+	withdrawMsgType := sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{})
+
+	startHeight, _, err := queryLatestBlockTime(chainAAPIEndpoint)
+	s.Require().NoError(err)
+
+	bypassedTxs, normalTxs := 3, 2
+	for i := 0; i < bypassedTxs; i++ {
+		s.execWithdrawAllRewards(s.chainA, 0, sender, "0"+uatomDenom, false)
+	}
+	for i := 0; i < normalTxs; i++ {
+		s.execBankSend(s.chainA, 0, sender, recipient, sdk.NewInt64Coin(uatomDenom, 100).String(), standardFees.String(), false)
+	}
+
+	s.Require().Eventually(
+		func() bool {
+			// the window starts at the latest height before the submission
+			height, _, err := queryLatestBlockTime(chainAAPIEndpoint)
+			s.Require().NoError(err)
+			res, err := queryBypassRate(chainAAPIEndpoint, uint64(height-startHeight+1))
+			s.Require().NoError(err)
+
+			if res.BypassedTxs < uint64(bypassedTxs) || res.TotalTxs < uint64(bypassedTxs+normalTxs) {
+				return false
+			}
+			var withdrawTxs uint64
+			for _, msgType := range res.MsgTypes {
+				if msgType.MsgTypeUrl == withdrawMsgType {
+					withdrawTxs = msgType.Txs
+				}
+			}
+			s.Require().GreaterOrEqual(withdrawTxs, uint64(bypassedTxs))
+
+			expPercentage := sdk.NewDec(int64(res.BypassedTxs)).MulInt64(100).Quo(sdk.NewDec(int64(res.TotalTxs)))
+			s.Require().Equal(expPercentage.String(), res.BypassPercentage.String())
+			return true
+		},
+		scaleTimeout(30*time.Second),
+		5*time.Second,
+	)
+}
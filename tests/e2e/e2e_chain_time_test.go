@@ -0,0 +1,107 @@
+package e2e
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// the fast chain produces a block about every second and advances its
+	// time by at least 5 seconds per block, which keeps its time before the
+	// start of the genesis vestings, at least 90 seconds after genesis, while
+	// the chain starts
+	fastChainTimeoutCommit = 500 * time.Millisecond
+	fastChainTimeIota      = 5 * time.Second
+	fastChainPortOffset    = 20
+)
+
+// advanceChainTime waits for the chain to produce blocks until its time
+// advanced by at least d, and returns the time of the block reached. The
+// chain time advances with the wall clock, or faster on a chain set up with
+// setFastChainTime.
+func (s *IntegrationTestSuite) advanceChainTime(c *chain, d time.Duration) time.Time {
+	endpoint := fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+	startHeight, startTime, err := queryLatestBlockTime(endpoint)
+	s.Require().NoError(err)
+	target := startTime.Add(d)
+	wallStart := time.Now()
+
+	var (
+		height  int64
+		reached time.Time
+	)
+	s.Require().Eventually(
+		func() bool {
+			height, reached, err = queryLatestBlockTime(endpoint)
+			if err != nil {
+				return false
+			}
+			return !reached.Before(target)
+		},
+		d+scaleTimeout(time.Minute),
+		time.Second,
+		"chain %s time did not reach %s", c.id, target,
+	)
+	s.T().Logf("chain %s time advanced by %s from height %d to %d, reaching %s in %s", c.id, reached.Sub(startTime), startHeight, height, reached, time.Since(wallStart))
+	return reached
+}
+
+// runFastChain starts a chain whose time runs faster than the wall clock,
+// with the genesis vesting accounts of the other chains.
+func (s *IntegrationTestSuite) runFastChain() *chain {
+	c, err := newChain()
+	s.Require().NoError(err)
+	s.tmpDirs = append(s.tmpDirs, c.dataDir)
+	c.setFastChainTime(fastChainTimeoutCommit, fastChainTimeIota)
+
+	vestingMnemonic, err := createMnemonic()
+	s.Require().NoError(err)
+	jailedValMnemonic, err := createMnemonic()
+	s.Require().NoError(err)
+
+	s.T().Logf("starting e2e infrastructure for the fast chain; chain-id: %s; datadir: %s", c.id, c.dataDir)
+	s.initNodes(c)
+	s.initGenesis(c, vestingMnemonic, jailedValMnemonic)
+	s.initValidatorConfigs(c)
+	s.runValidators(c, fastChainPortOffset)
+	return c
+}
+
+/*
+testAdvanceChainTime tests advancing the time of a chain faster than the
+wall clock past the start of a vesting.
+Test Benchmarks:
+1. Validation that the continuous vesting account has not started vesting
+2. Advance of the chain time past the start of the vesting
+3. Validation that the chain time advanced faster than the wall clock
+4. Validation that the spendable balance of the vesting account increased
+*/
+func (s *IntegrationTestSuite) testAdvanceChainTime() {
+	c := s.runFastChain()
+	endpoint := fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+	vestingAcc := c.genesisVestingAccounts[continuousVestingKey].String()
+
+	acc, err := queryContinuousVestingAccount(endpoint, vestingAcc)
+	s.Require().NoError(err)
+	startTime := time.Unix(acc.StartTime, 0)
+	_, chainTime, err := queryLatestBlockTime(endpoint)
+	s.Require().NoError(err)
+	s.Require().True(chainTime.Before(startTime), "chain time %s is past the vesting start %s", chainTime, startTime)
+
+	spendable, err := queryGaiaSpendableBalances(endpoint, vestingAcc)
+	s.Require().NoError(err)
+
+	wallStart := time.Now()
+	reached := s.advanceChainTime(c, startTime.Sub(chainTime)+time.Minute)
+	s.Require().Less(time.Since(wallStart), reached.Sub(chainTime), "chain time advanced slower than the wall clock")
+
+	s.Require().Eventually(
+		func() bool {
+			vestedSpendable, err := queryGaiaSpendableBalances(endpoint, vestingAcc)
+			s.Require().NoError(err)
+			return vestedSpendable.AmountOf(uatomDenom).GT(spendable.AmountOf(uatomDenom))
+		},
+		scaleTimeout(20*time.Second),
+		5*time.Second,
+	)
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func (s *IntegrationTestSuite) testDistribution() {
@@ -36,7 +38,7 @@ func (s *IntegrationTestSuite) testDistribution() {
 
 			return res.WithdrawAddress == newWithdrawalAddress
 		},
-		10*time.Second,
+		scaleTimeout(10*time.Second),
 		5*time.Second,
 	)
 
@@ -48,11 +50,147 @@ func (s *IntegrationTestSuite) testDistribution() {
 
 			return afterBalance.IsGTE(beforeBalance)
 		},
-		10*time.Second,
+		scaleTimeout(10*time.Second),
 		5*time.Second,
 	)
 }
 
+/*
+testRewardHistory tests that the rewards withdrawn by a delegator across several blocks are
+reported by the reward history query.
+Test Benchmarks:
+1. Withdrawal of the rewards of the delegator in three different blocks
+2. Verification that the history has a delta for each of the withdrawals, by ascending height
+3. Verification that the deltas add up to the rewards received by the withdrawal address
+*/
+func (s *IntegrationTestSuite) testRewardHistory() {
+	chainEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+
+	valOperAddress := sdk.ValAddress(s.chainA.validators[1].keyInfo.GetAddress()).String()
+	delegatorAddress := s.chainA.genesisAccounts[2].keyInfo.GetAddress().String()
+
+	// the rewards are sent to the withdrawal address of the delegator, which
+	// does not pay the fees of the withdrawals
+	withdrawal, err := queryDelegatorWithdrawalAddress(chainEndpoint, delegatorAddress)
+	s.Require().NoError(err)
+
+	before, err := queryRewardHistory(chainEndpoint, delegatorAddress, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), before.IndexedFromHeight)
+	beforeBalances, err := queryGaiaAllBalances(chainEndpoint, withdrawal.WithdrawAddress)
+	s.Require().NoError(err)
+
+	// each withdrawal waits for its tx to be committed, so they are delivered
+	// in different blocks
+	const withdrawals = 3
+	for i := 0; i < withdrawals; i++ {
+		s.execWithdrawReward(s.chainA, 0, delegatorAddress, valOperAddress, gaiaHomePath)
+	}
+
+	after, err := queryRewardHistory(chainEndpoint, delegatorAddress, before.ToHeight+1)
+	s.Require().NoError(err)
+	s.Require().Len(after.Rewards, withdrawals)
+	for i, delta := range after.Rewards {
+		s.Require().Greater(delta.Height, before.ToHeight)
+		if i > 0 {
+			s.Require().Greater(delta.Height, after.Rewards[i-1].Height)
+		}
+		s.Require().False(delta.Amount.IsZero())
+	}
+
+	afterBalances, err := queryGaiaAllBalances(chainEndpoint, withdrawal.WithdrawAddress)
+	s.Require().NoError(err)
+	s.Require().Equal(afterBalances.Sub(beforeBalances).String(), after.Total.String())
+}
+
+/*
+testValidatorCommission tests that validators created with different commission rates
+in their gentx accrue different commission.
+Test Benchmarks:
+1. Validation that the commission rates set in the gentxs are applied to the validators
+2. Verification that the validator with the higher commission rate accrues more commission
+*/
+func (s *IntegrationTestSuite) testValidatorCommission() {
+	chainEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+
+	valA := s.chainA.validators[0]
+	valB := s.chainA.validators[1]
+	valAOperAddr := sdk.ValAddress(valA.keyInfo.GetAddress()).String()
+	valBOperAddr := sdk.ValAddress(valB.keyInfo.GetAddress()).String()
+
+	s.Require().True(valB.commission.Rate.GT(valA.commission.Rate))
+
+	for _, val := range []*validator{valA, valB} {
+		res, err := queryValidator(chainEndpoint, sdk.ValAddress(val.keyInfo.GetAddress()).String())
+		s.Require().NoError(err)
+		s.Require().Equal(val.commission.Rate, res.Commission.Rate)
+		s.Require().Equal(val.commission.MaxRate, res.Commission.MaxRate)
+		s.Require().Equal(val.commission.MaxChangeRate, res.Commission.MaxChangeRate)
+	}
+
+	// the rewards of a validator, thus its commission, are proportional to its
+	// stake, which differs between the validators of chain A. So after
+	// producing some blocks, the validator with the higher rate must have
+	// accrued more commission per token of stake
+	commissionPerToken := func(operAddr string) sdk.Dec {
+		commission, err := queryValidatorCommission(chainEndpoint, operAddr)
+		s.Require().NoError(err)
+		val, err := queryValidator(chainEndpoint, operAddr)
+		s.Require().NoError(err)
+		return commission.AmountOf(uatomDenom).QuoInt(val.Tokens)
+	}
+	s.Require().Eventually(
+		func() bool {
+			return commissionPerToken(valBOperAddr).GT(commissionPerToken(valAOperAddr))
+		},
+		scaleTimeout(20*time.Second),
+		5*time.Second,
+	)
+}
+
+/*
+testCommunityPoolFeeShare tests that the community pool receives the community
+tax of the fee of a tx. The block rewards are minted in the stake denom, so the
+uatom of the community pool only grows with the tx fees.
+Test Benchmarks:
+1. Execution of a bank send paying a known fee
+2. Verification that the community pool increased by the community tax of the fee
+*/
+func (s *IntegrationTestSuite) testCommunityPoolFeeShare() {
+	chainBAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainB.id][0].GetHostPort("1317/tcp"))
+	s.Require().NotNil(s.chainB.distributionParams)
+
+	sender := s.chainB.validators[0].keyInfo.GetAddress().String()
+	recipient := s.chainB.genesisAccounts[2].keyInfo.GetAddress().String()
+	fees := sdk.NewCoin(uatomDenom, sdk.NewInt(10000000))
+
+	communityPool := func() sdk.Dec {
+		pool, err := queryCommunityPool(chainBAPIEndpoint)
+		s.Require().NoError(err)
+		return pool.AmountOf(uatomDenom)
+	}
+	beforePool := communityPool()
+
+	s.execBankSend(s.chainB, 0, sender, recipient, tokenAmount.String(), fees.String(), false)
+
+	// the fee is allocated at the beginning of the next block. Both validators
+	// of chain B are needed to commit a block, so no share of a missed vote
+	// goes to the community pool on top of the community tax
+	expShare := fees.Amount.ToDec().Mul(s.chainB.distributionParams.CommunityTax)
+	s.Require().Eventually(
+		func() bool {
+			return communityPool().Sub(beforePool).GTE(expShare)
+		},
+		scaleTimeout(20*time.Second),
+		5*time.Second,
+	)
+
+	// the validators shares are truncated, the remainder goes to the
+	// community pool
+	dust := communityPool().Sub(beforePool).Sub(expShare)
+	s.Require().True(dust.LT(sdk.OneDec()), "community pool received %s more than expected", dust)
+}
+
 /*
 fundCommunityPool tests the funding of the community pool on behalf of the distribution module.
 Test Benchmarks:
@@ -82,7 +220,44 @@ func (s *IntegrationTestSuite) fundCommunityPool() {
 
 			return afterDistPhotonBalance.Sub(beforeDistUatomBalance.Add(tokenAmount.Add(standardFees))).IsLT(marginOfErrorForBlockReward)
 		},
-		15*time.Second,
+		scaleTimeout(15*time.Second),
 		5*time.Second,
 	)
 }
+
+/*
+testDistributionModuleBalance tests that the distribution module account holds the community pool along with the fees allocated to the validators.
+Test Benchmarks:
+1. Execution of a bank send paying a known fee
+2. Verification that the whole fee moved from the fee collector to the distribution module account
+3. Verification that the distribution module account holds the community pool
+*/
+func (s *IntegrationTestSuite) testDistributionModuleBalance() {
+	chainBAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainB.id][0].GetHostPort("1317/tcp"))
+
+	sender := s.chainB.validators[0].keyInfo.GetAddress().String()
+	recipient := s.chainB.genesisAccounts[2].keyInfo.GetAddress().String()
+	fees := sdk.NewCoin(uatomDenom, sdk.NewInt(10000000))
+
+	distrAddress := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	before, err := queryGaiaAllBalances(chainBAPIEndpoint, distrAddress)
+	s.Require().NoError(err)
+
+	s.execBankSend(s.chainB, 0, sender, recipient, tokenAmount.String(), fees.String(), false)
+
+	// the block rewards are minted in the stake denom, so the uatom of the
+	// distribution module account only grows with the tx fees, which are all
+	// moved from the fee collector at the beginning of the next block
+	s.requireModuleBalance(s.chainB, distrtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(uatomDenom, before.AmountOf(uatomDenom).Add(fees.Amount))))
+	s.requireModuleBalance(s.chainB, authtypes.FeeCollectorName, sdk.Coins{sdk.NewCoin(uatomDenom, sdk.ZeroInt())})
+
+	// the community tax of the fee stays in the distribution module account
+	// along with the unwithdrawn rewards of the validators
+	communityPool, err := queryCommunityPool(chainBAPIEndpoint)
+	s.Require().NoError(err)
+	balance, err := getSpecificBalance(chainBAPIEndpoint, distrAddress, uatomDenom)
+	s.Require().NoError(err)
+	s.Require().True(communityPool.AmountOf(uatomDenom).IsPositive())
+	s.Require().True(balance.Amount.ToDec().GTE(communityPool.AmountOf(uatomDenom)),
+		"distribution module account holds %s, less than the community pool %s", balance, communityPool)
+}
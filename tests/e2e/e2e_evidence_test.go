@@ -31,7 +31,7 @@ func (s *IntegrationTestSuite) testEvidence() {
 }
 
 func (s *IntegrationTestSuite) execQueryEvidence(c *chain, valIdx int, hash string) (res evidencetypes.Equivocation) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("querying evidence %s on chain %s", hash, c.id)
@@ -35,6 +35,7 @@ const (
 	flagBroadcastMode   = "broadcast-mode"
 	flagKeyringBackend  = "keyring-backend"
 	flagAllowedMessages = "allowed-messages"
+	flagNode            = "node"
 )
 
 type flagOption func(map[string]interface{})
@@ -70,7 +71,7 @@ func (s *IntegrationTestSuite) execEncode(
 	opt ...flagOption,
 ) string {
 	opts := applyOptions(c.id, opt)
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("%s - Executing gaiad encoding with %v", c.id, txPath)
@@ -102,7 +103,7 @@ func (s *IntegrationTestSuite) execDecode(
 	opt ...flagOption,
 ) string {
 	opts := applyOptions(c.id, opt)
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("%s - Executing gaiad decoding with %v", c.id, txPath)
@@ -135,7 +136,7 @@ func (s *IntegrationTestSuite) execVestingTx(
 	opt ...flagOption,
 ) {
 	opts := applyOptions(c.id, opt)
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("%s - Executing gaiad %s with %v", c.id, method, args)
@@ -172,7 +173,7 @@ func (s *IntegrationTestSuite) execUnjail(
 	opt ...flagOption,
 ) {
 	opts := applyOptions(c.id, opt)
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("Executing gaiad slashing unjail %s with options: %v", c.id, opt)
@@ -197,7 +198,7 @@ func (s *IntegrationTestSuite) execFeeGrant(c *chain, valIdx int, granter, grant
 	opt = append(opt, withKeyValue(flagSpendLimit, spendLimit))
 	opts := applyOptions(c.id, opt)
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("granting %s fee from %s on chain %s", grantee, granter, c.id)
@@ -222,7 +223,7 @@ func (s *IntegrationTestSuite) execFeeGrantRevoke(c *chain, valIdx int, granter,
 	opt = append(opt, withKeyValue(flagFrom, granter))
 	opts := applyOptions(c.id, opt)
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("revoking %s fee grant from %s on chain %s", grantee, granter, c.id)
@@ -258,7 +259,7 @@ func (s *IntegrationTestSuite) execBankSend(
 	opt = append(opt, withKeyValue(flagFrom, from))
 	opts := applyOptions(c.id, opt)
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("sending %s tokens from %s to %s on chain %s", amt, from, to, c.id)
@@ -280,6 +281,42 @@ func (s *IntegrationTestSuite) execBankSend(
 	s.executeGaiaTxCommand(ctx, c, gaiaCommand, valIdx, s.expectErrExecValidation(c, valIdx, expectErr))
 }
 
+// execBankSendToNode broadcasts a bank send from the first validator container
+// to the node of the given validator and checks the CheckTx result code.
+func (s *IntegrationTestSuite) execBankSendToNode(c *chain, node *validator, from, to, amt, fees string, expectedCode uint32) {
+	opts := applyOptions(c.id, []flagOption{
+		withKeyValue(flagFees, fees),
+		withKeyValue(flagFrom, from),
+		withKeyValue(flagGas, gas),
+		withKeyValue(flagNode, fmt.Sprintf("tcp://%s:26657", node.instanceName())),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
+	defer cancel()
+
+	gaiaCommand := []string{
+		gaiadBinary,
+		txCommand,
+		banktypes.ModuleName,
+		"send",
+		from,
+		to,
+		amt,
+		"-y",
+	}
+	for flag, value := range opts {
+		gaiaCommand = append(gaiaCommand, fmt.Sprintf("--%s=%v", flag, value))
+	}
+
+	s.executeGaiaTxCommand(ctx, c, gaiaCommand, 0, func(stdOut []byte, stdErr []byte) bool {
+		var txResp sdk.TxResponse
+		if err := cdc.UnmarshalJSON(stdOut, &txResp); err != nil {
+			return false
+		}
+		return txResp.Code == expectedCode
+	})
+}
+
 type txBankSend struct {
 	from      string
 	to        string
@@ -311,7 +348,7 @@ func (s *IntegrationTestSuite) execBankSendBatch(
 }
 
 func (s *IntegrationTestSuite) execWithdrawAllRewards(c *chain, valIdx int, payee, fees string, expectErr bool) { //nolint:unparam
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	gaiaCommand := []string{
@@ -330,8 +367,38 @@ func (s *IntegrationTestSuite) execWithdrawAllRewards(c *chain, valIdx int, paye
 	s.executeGaiaTxCommand(ctx, c, gaiaCommand, valIdx, s.expectErrExecValidation(c, valIdx, expectErr))
 }
 
+// execPayPacketFee incentivizes the relaying of the packet sent on the
+// transfer port of the given channel with the given sequence.
+func (s *IntegrationTestSuite) execPayPacketFee(c *chain, valIdx int, payer, channelID string, sequence uint64, recvFee, ackFee, timeoutFee sdk.Coin) {
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
+	defer cancel()
+
+	s.T().Logf("Incentivizing packet %d of %s on chain %s", sequence, channelID, c.id)
+	gaiaCommand := []string{
+		gaiadBinary,
+		txCommand,
+		"ibc-fee",
+		"pay-packet-fee",
+		"transfer",
+		channelID,
+		strconv.FormatUint(sequence, 10),
+		fmt.Sprintf("--recv-fee=%s", recvFee),
+		fmt.Sprintf("--ack-fee=%s", ackFee),
+		fmt.Sprintf("--timeout-fee=%s", timeoutFee),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, payer),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, standardFees.String()),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, c.id),
+		"--keyring-backend=test",
+		"--output=json",
+		"-y",
+	}
+
+	s.executeGaiaTxCommand(ctx, c, gaiaCommand, valIdx, s.defaultExecValidation(c, valIdx))
+	s.T().Logf("Successfully incentivized packet %d of %s", sequence, channelID)
+}
+
 func (s *IntegrationTestSuite) execDistributionFundCommunityPool(c *chain, valIdx int, from, amt, fees string) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("Executing gaiad tx distribution fund-community-pool on chain %s", c.id)
@@ -355,7 +422,11 @@ func (s *IntegrationTestSuite) execDistributionFundCommunityPool(c *chain, valId
 }
 
 func (s *IntegrationTestSuite) runGovExec(c *chain, valIdx int, submitterAddr, govCommand string, proposalFlags []string, fees string) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	s.runGovExecWithValidation(c, valIdx, submitterAddr, govCommand, proposalFlags, fees, s.defaultExecValidation(c, valIdx))
+}
+
+func (s *IntegrationTestSuite) runGovExecWithValidation(c *chain, valIdx int, submitterAddr, govCommand string, proposalFlags []string, fees string, validation func([]byte, []byte) bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	gaiaCommand := []string{
@@ -377,12 +448,12 @@ func (s *IntegrationTestSuite) runGovExec(c *chain, valIdx int, submitterAddr, g
 	gaiaCommand = concatFlags(gaiaCommand, proposalFlags, generalFlags)
 
 	s.T().Logf("Executing gaiad tx gov %s on chain %s", govCommand, c.id)
-	s.executeGaiaTxCommand(ctx, c, gaiaCommand, valIdx, s.defaultExecValidation(c, valIdx))
+	s.executeGaiaTxCommand(ctx, c, gaiaCommand, valIdx, validation)
 	s.T().Logf("Successfully executed %s", govCommand)
 }
 
 func (s *IntegrationTestSuite) executeGKeysAddCommand(c *chain, valIdx int, name string, home string) string {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	gaiaCommand := []string{
@@ -407,7 +478,7 @@ func (s *IntegrationTestSuite) executeGKeysAddCommand(c *chain, valIdx int, name
 }
 
 func (s *IntegrationTestSuite) executeKeysList(c *chain, valIdx int, home string) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	gaiaCommand := []string{
@@ -425,7 +496,7 @@ func (s *IntegrationTestSuite) executeKeysList(c *chain, valIdx int, home string
 }
 
 func (s *IntegrationTestSuite) executeDelegate(c *chain, valIdx int, amount, valOperAddress, delegatorAddr, home, delegateFees string) { //nolint:unparam
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("Executing gaiad tx staking delegate %s", c.id)
@@ -454,7 +525,7 @@ func (s *IntegrationTestSuite) executeDelegate(c *chain, valIdx int, amount, val
 func (s *IntegrationTestSuite) executeRedelegate(c *chain, valIdx int, amount, originalValOperAddress,
 	newValOperAddress, delegatorAddr, home, delegateFees string,
 ) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("Executing gaiad tx staking redelegate %s", c.id)
@@ -481,8 +552,35 @@ func (s *IntegrationTestSuite) executeRedelegate(c *chain, valIdx int, amount, o
 	s.T().Logf("%s successfully redelegated %s from %s to %s", delegatorAddr, amount, originalValOperAddress, newValOperAddress)
 }
 
+func (s *IntegrationTestSuite) executeUnbond(c *chain, valIdx int, amount, valOperAddress, delegatorAddr, home, delegateFees string) {
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
+	defer cancel()
+
+	s.T().Logf("Executing gaiad tx staking unbond %s", c.id)
+
+	gaiaCommand := []string{
+		gaiadBinary,
+		txCommand,
+		stakingtypes.ModuleName,
+		"unbond",
+		valOperAddress,
+		amount,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, delegatorAddr),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, c.id),
+		fmt.Sprintf("--%s=%s", flags.FlagGas, "auto"),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, delegateFees),
+		"--keyring-backend=test",
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+		"--output=json",
+		"-y",
+	}
+
+	s.executeGaiaTxCommand(ctx, c, gaiaCommand, valIdx, s.defaultExecValidation(c, valIdx))
+	s.T().Logf("%s successfully unbonded %s from %s", delegatorAddr, amount, valOperAddress)
+}
+
 func (s *IntegrationTestSuite) getLatestBlockHeight(c *chain, valIdx int) int {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	type syncInfo struct {
@@ -514,7 +612,7 @@ func (s *IntegrationTestSuite) verifyBalanceChange(endpoint string, expectedAmou
 
 			return afterAtomBalance.IsEqual(expectedAmount)
 		},
-		20*time.Second,
+		scaleTimeout(20*time.Second),
 		5*time.Second,
 	)
 }
@@ -527,7 +625,7 @@ func (s *IntegrationTestSuite) execSetWithdrawAddress(
 	newWithdrawalAddress,
 	homePath string,
 ) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("Setting distribution withdrawal address on chain %s for %s to %s", c.id, delegatorAddress, newWithdrawalAddress)
@@ -557,7 +655,7 @@ func (s *IntegrationTestSuite) execWithdrawReward(
 	validatorAddress,
 	homePath string,
 ) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("Withdrawing distribution rewards on chain %s for delegator %s from %s validator", c.id, delegatorAddress, validatorAddress)
@@ -631,7 +729,7 @@ func (s *IntegrationTestSuite) expectErrExecValidation(chain *chain, valIdx int,
 				gotErr := queryGaiaTx(endpoint, txResp.TxHash) != nil
 				return gotErr == expectErr
 			},
-			time.Minute,
+			scaleTimeout(time.Minute),
 			5*time.Second,
 			"stdOut: %s, stdErr: %s",
 			string(stdOut), string(stdErr),
@@ -652,7 +750,7 @@ func (s *IntegrationTestSuite) defaultExecValidation(chain *chain, valIdx int) f
 				func() bool {
 					return queryGaiaTx(endpoint, txResp.TxHash) == nil
 				},
-				time.Minute,
+				scaleTimeout(time.Minute),
 				5*time.Second,
 				"stdOut: %s, stdErr: %s",
 				string(stdOut), string(stdErr),
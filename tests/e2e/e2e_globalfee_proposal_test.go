@@ -2,37 +2,18 @@ package e2e
 
 import (
 	"fmt"
-	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
-)
 
-func (s *IntegrationTestSuite) govProposeNewGlobalfee(newGlobalfee sdk.DecCoins, proposalCounter int, submitter string, _ string) {
-	s.writeGovParamChangeProposalGlobalFees(s.chainA, newGlobalfee)
-	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
-	submitGovFlags := []string{"param-change", configFile(proposalGlobalFeeFilename)}
-	depositGovFlags := []string{strconv.Itoa(proposalCounter), depositAmount.String()}
-	voteGovFlags := []string{strconv.Itoa(proposalCounter), "yes"}
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
 
-	// gov proposing new fees
-	s.T().Logf("Proposal number: %d", proposalCounter)
-	s.T().Logf("Submitting, deposit and vote legacy Gov Proposal: change global fee to %s", newGlobalfee.String())
-	s.runGovProcess(chainAAPIEndpoint, submitter, proposalCounter, paramtypes.ProposalTypeChange, submitGovFlags, depositGovFlags, voteGovFlags, "vote", false)
-
-	// query the proposal status and new fee
-	s.Require().Eventually(
-		func() bool {
-			proposal, err := queryGovProposal(chainAAPIEndpoint, proposalCounter)
-			s.Require().NoError(err)
-			return proposal.GetProposal().Status == gov.StatusPassed
-		},
-		15*time.Second,
-		5*time.Second,
-	)
+func (s *IntegrationTestSuite) govProposeNewGlobalfee(newGlobalfee sdk.DecCoins) {
+	s.T().Logf("Proposing to change global fee to %s", newGlobalfee.String())
+	s.changeParamAndVerify(s.chainA, types.ModuleName, string(types.ParamStoreKeyMinGasPrices), newGlobalfee)
 
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
 	s.Require().Eventually(
 		func() bool {
 			globalFees, err := queryGlobalFees(chainAAPIEndpoint)
@@ -42,7 +23,7 @@ func (s *IntegrationTestSuite) govProposeNewGlobalfee(newGlobalfee sdk.DecCoins,
 			// attention: if global fee is empty, when query globalfee, it shows empty rather than default ante.DefaultZeroGlobalFee() = 0uatom.
 			return globalFees.IsEqual(newGlobalfee)
 		},
-		15*time.Second,
+		scaleTimeout(15*time.Second),
 		5*time.Second,
 	)
 }
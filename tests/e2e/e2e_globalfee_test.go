@@ -6,6 +6,10 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	globalfee "github.com/cosmos/gaia/v9/x/globalfee/types"
 )
 
 // globalfee in genesis is set to be "0.00001uatom"
@@ -21,7 +25,7 @@ func (s *IntegrationTestSuite) testQueryGlobalFeesInGenesis() {
 
 			return fees.IsEqual(feeInGenesis)
 		},
-		15*time.Second,
+		scaleTimeout(15*time.Second),
 		5*time.Second,
 	)
 }
@@ -73,7 +77,7 @@ func (s *IntegrationTestSuite) testGlobalFees() {
 
 			return beforeRecipientPhotonBalance.IsValid()
 		},
-		10*time.Second,
+		scaleTimeout(10*time.Second),
 		5*time.Second,
 	)
 	if beforeRecipientPhotonBalance.Equal(sdk.Coin{}) {
@@ -87,8 +91,7 @@ func (s *IntegrationTestSuite) testGlobalFees() {
 	// ---------------------------- test1: globalfee empty --------------------------------------------
 	// prepare gov globalfee proposal
 	emptyGlobalFee := sdk.DecCoins{}
-	proposalCounter++
-	s.govProposeNewGlobalfee(emptyGlobalFee, proposalCounter, submitter, standardFees.String())
+	s.govProposeNewGlobalfee(emptyGlobalFee)
 	paidFeeAmt := math.LegacyMustNewDecFromStr(minGasPrice).Mul(math.LegacyNewDec(gas)).String()
 
 	s.T().Logf("test case: empty global fee, globalfee=%s, min_gas_price=%s", emptyGlobalFee.String(), minGasPrice+uatomDenom)
@@ -139,8 +142,7 @@ func (s *IntegrationTestSuite) testGlobalFees() {
 	// ------------------ test2: globalfee lower than min_gas_price -----------------------------------
 	// prepare gov globalfee proposal
 	lowGlobalFee := sdk.DecCoins{sdk.NewDecCoinFromDec(uatomDenom, sdk.MustNewDecFromStr(lowGlobalFeesAmt))}
-	proposalCounter++
-	s.govProposeNewGlobalfee(lowGlobalFee, proposalCounter, submitter, standardFees.String())
+	s.govProposeNewGlobalfee(lowGlobalFee)
 
 	paidFeeAmt = math.LegacyMustNewDecFromStr(minGasPrice).Mul(math.LegacyNewDec(gas)).String()
 	paidFeeAmtLowMinGasHighGlobalFee := math.LegacyMustNewDecFromStr(lowGlobalFeesAmt).
@@ -189,8 +191,7 @@ func (s *IntegrationTestSuite) testGlobalFees() {
 	// ------------------ test3: globalfee higher than min_gas_price ----------------------------------
 	// prepare gov globalfee proposal
 	highGlobalFee := sdk.DecCoins{sdk.NewDecCoinFromDec(uatomDenom, sdk.MustNewDecFromStr(highGlobalFeeAmt))}
-	proposalCounter++
-	s.govProposeNewGlobalfee(highGlobalFee, proposalCounter, submitter, paidFeeAmt+uatomDenom)
+	s.govProposeNewGlobalfee(highGlobalFee)
 
 	paidFeeAmt = math.LegacyMustNewDecFromStr(highGlobalFeeAmt).Mul(math.LegacyNewDec(gas)).String()
 	paidFeeAmtHigherMinGasLowerGalobalFee := math.LegacyMustNewDecFromStr(minGasPrice).
@@ -223,8 +224,7 @@ func (s *IntegrationTestSuite) testGlobalFees() {
 		sdk.NewDecCoinFromDec(photonDenom, sdk.NewDec(0)),
 		sdk.NewDecCoinFromDec(uatomDenom, sdk.MustNewDecFromStr(lowGlobalFeesAmt)),
 	}.Sort()
-	proposalCounter++
-	s.govProposeNewGlobalfee(mixGlobalFee, proposalCounter, submitter, paidFeeAmt+uatomDenom)
+	s.govProposeNewGlobalfee(mixGlobalFee)
 
 	// equal to min_gas_price
 	paidFeeAmt = math.LegacyMustNewDecFromStr(minGasPrice).Mul(math.LegacyNewDec(gas)).String()
@@ -321,7 +321,7 @@ func (s *IntegrationTestSuite) testGlobalFees() {
 			photonSent := sdk.NewInt64Coin(photonDenom, sendAmt*int64(sucessBankSendCount))
 			return IncrementedPhoton.IsEqual(photonSent)
 		},
-		time.Minute,
+		scaleTimeout(time.Minute),
 		5*time.Second,
 	)
 
@@ -329,6 +329,161 @@ func (s *IntegrationTestSuite) testGlobalFees() {
 	s.T().Logf("Propose to change back to original global fees: %s", initialGlobalFeeAmt+uatomDenom)
 	oldfees, err := sdk.ParseDecCoins(initialGlobalFeeAmt + uatomDenom)
 	s.Require().NoError(err)
-	proposalCounter++
-	s.govProposeNewGlobalfee(oldfees, proposalCounter, submitter, paidFeeAmt+photonDenom)
+	s.govProposeNewGlobalfee(oldfees)
+}
+
+/*
+testDivergentMinGasPrices tests that validators with different min gas prices admit
+different txs into their mempool.
+chain B: validator 0 min_gas_price = 0.00001uatom, validator 1 min_gas_price = 0.0001uatom
+- tx with fee 0.00001uatom per gas sent to validator 0, pass
+- tx with fee 0.00001uatom per gas sent to validator 1, fail with insufficient fee
+*/
+func (s *IntegrationTestSuite) testDivergentMinGasPrices() {
+	lowPriceVal := s.chainB.validators[0]
+	highPriceVal := s.chainB.validators[1]
+	s.Require().NotEqual(s.chainB.validatorMinGasPrice(0), s.chainB.validatorMinGasPrice(1))
+
+	minPrice, err := sdk.NewDecFromStr(s.chainB.validatorMinGasPrice(0))
+	s.Require().NoError(err)
+	fees := sdk.NewCoin(uatomDenom, minPrice.MulInt64(gas).Ceil().TruncateInt())
+
+	sender := lowPriceVal.keyInfo.GetAddress().String()
+	recipient := highPriceVal.keyInfo.GetAddress().String()
+	token := sdk.NewInt64Coin(uatomDenom, 100)
+
+	s.T().Logf("sending tx with fees %s to the low min gas price validator", fees)
+	s.execBankSendToNode(s.chainB, lowPriceVal, sender, recipient, token.String(), fees.String(), 0)
+
+	s.T().Logf("sending tx with fees %s to the high min gas price validator", fees)
+	s.execBankSendToNode(s.chainB, highPriceVal, sender, recipient, token.String(), fees.String(), sdkerrors.ErrInsufficientFee.ABCICode())
+}
+
+/*
+testFeeRejectionStats tests that the txs rejected for insufficient fees are reported
+by the fee rejection stats of the node they were sent to.
+Test Benchmarks:
+1. Execution of bank sends paying half of the required fees
+2. Verification that the rejections are counted in the fee rejection stats of the node
+3. Verification that the rejections fall in the histogram bucket of their shortfall
+*/
+func (s *IntegrationTestSuite) testFeeRejectionStats() {
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	window := uint64(1000)
+
+	before, err := queryFeeRejectionStats(chainAAPIEndpoint, window)
+	s.Require().NoError(err)
+
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	recipient := s.chainA.validators[1].keyInfo.GetAddress().String()
+	token := sdk.NewInt64Coin(uatomDenom, 100)
+	// half of the fees required by the genesis global fee
+	underpaidFees := sdk.NewCoin(uatomDenom, sdk.MustNewDecFromStr(initialGlobalFeeAmt).MulInt64(gas/2).Ceil().TruncateInt())
+
+	rejectedTxs := 3
+	for i := 0; i < rejectedTxs; i++ {
+		s.execBankSend(s.chainA, 0, sender, recipient, token.String(), underpaidFees.String(), true)
+	}
+
+	s.Require().Eventually(
+		func() bool {
+			after, err := queryFeeRejectionStats(chainAAPIEndpoint, window)
+			s.Require().NoError(err)
+
+			return after.TotalRejections >= before.TotalRejections+uint64(rejectedTxs) &&
+				shortfallBucketCount(after, sdk.NewDecWithPrec(5, 1)) >= shortfallBucketCount(before, sdk.NewDecWithPrec(5, 1))+uint64(rejectedTxs)
+		},
+		scaleTimeout(30*time.Second),
+		5*time.Second,
+	)
+}
+
+func shortfallBucketCount(stats globalfee.QueryFeeRejectionStatsResponse, maxShortfall sdk.Dec) uint64 {
+	for _, bucket := range stats.ShortfallHistogram {
+		if bucket.MaxShortfall.Equal(maxShortfall) {
+			return bucket.Count
+		}
+	}
+	return 0
+}
+
+/*
+testDynamicMinimumGasPrices tests that the global fees rise when the blocks are full.
+Test Benchmarks:
+1. Gov proposal enabling the dynamic global fees
+2. Execution of a bank multi-send filling each block, under the small max gas of the blocks, until the dynamic
+multiplier rises with the fullness of the blocks
+3. Verification that the dynamic minimum gas prices are the global fees scaled by the multiplier
+4. Gov proposal disabling the dynamic global fees and verification that the multiplier is reset
+*/
+func (s *IntegrationTestSuite) testDynamicMinimumGasPrices() {
+	c := s.chainA
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+
+	s.changeParamAndVerify(c, globalfee.ModuleName, string(globalfee.ParamStoreKeyDynamicFeeSensitivity), sdk.NewDecWithPrec(5, 1))
+
+	res, err := queryDynamicMinimumGasPrices(chainAAPIEndpoint)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec().String(), res.Multiplier.String())
+
+	val := c.validators[0]
+	sender := val.keyInfo.GetAddress()
+	// a multi-send to new accounts using most of the max gas of a block, the
+	// block is full even if the send runs out of gas as the gas of a tx is
+	// consumed up to its limit
+	const outputs = 120
+	msg := banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{banktypes.NewInput(sender, sdk.NewCoins(sdk.NewInt64Coin(uatomDenom, outputs)))},
+	}
+	for i := 0; i < outputs; i++ {
+		msg.Outputs = append(msg.Outputs, banktypes.NewOutput(AccAddress(), sdk.NewCoins(sdk.NewInt64Coin(uatomDenom, 1))))
+	}
+	localMinGasPrice := sdk.MustNewDecFromStr(minGasPrice)
+
+	// the multiplier rises once the average fullness of the recent blocks is
+	// above half, each iteration sending a tx in the next block
+	s.Require().Eventually(
+		func() bool {
+			res, err = queryDynamicMinimumGasPrices(chainAAPIEndpoint)
+			s.Require().NoError(err)
+			if res.Multiplier.GT(sdk.OneDec()) {
+				return true
+			}
+
+			gasPrice := sdk.MaxDec(res.MinimumGasPrices.AmountOf(uatomDenom), localMinGasPrice)
+			fees := sdk.NewCoins(sdk.NewCoin(uatomDenom, gasPrice.MulInt64(maxBlockGas).Ceil().TruncateInt()))
+			acc, err := queryAccount(chainAAPIEndpoint, sender.String())
+			s.Require().NoError(err)
+			txBytes, err := val.signTx(acc.GetAccountNumber(), acc.GetSequence(), fees, uint64(maxBlockGas), &msg)
+			s.Require().NoError(err)
+			txRes, err := broadcastTx(chainAAPIEndpoint, txBytes)
+			s.Require().NoError(err)
+			s.T().Logf("filling block %d: code %d, gas used %d, multiplier %s", txRes.Height, txRes.Code, txRes.GasUsed, res.Multiplier)
+			return false
+		},
+		scaleTimeout(3*time.Minute),
+		time.Second,
+	)
+
+	// the multiplier was adjusted with the fullness of the filled blocks
+	s.Require().True(res.Fullness.IsPositive(), "fullness %s", res.Fullness)
+
+	globalFees, err := queryGlobalFees(chainAAPIEndpoint)
+	s.Require().NoError(err)
+	s.Require().True(res.MinimumGasPrices.AmountOf(uatomDenom).GT(globalFees.AmountOf(uatomDenom)))
+	s.Require().Equal(
+		globalFees.AmountOf(uatomDenom).Mul(res.Multiplier).String(),
+		res.MinimumGasPrices.AmountOf(uatomDenom).String(),
+	)
+
+	s.changeParamAndVerify(c, globalfee.ModuleName, string(globalfee.ParamStoreKeyDynamicFeeSensitivity), sdk.ZeroDec())
+	s.Require().Eventually(
+		func() bool {
+			res, err := queryDynamicMinimumGasPrices(chainAAPIEndpoint)
+			s.Require().NoError(err)
+			return res.Multiplier.Equal(sdk.OneDec())
+		},
+		scaleTimeout(30*time.Second),
+		5*time.Second,
+	)
 }
@@ -2,6 +2,7 @@ package e2e
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -10,9 +11,17 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	govscheduletypes "github.com/cosmos/gaia/v9/x/govschedule/types"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
+	sanctiontypes "github.com/cosmos/gaia/v9/x/sanction/types"
 )
 
 /*
@@ -21,7 +30,8 @@ Test Benchmarks:
 1. Submission, deposit and vote of message based proposal to upgrade the chain at a height (current height + buffer)
 2. Validation that chain halted at upgrade height
 3. Teardown & restart chains
-4. Reset proposalCounter so subsequent tests have the correct last effective proposal id for chainA
+4. Run the registered post-upgrade assertions
+5. Reset proposalCounter so subsequent tests have the correct last effective proposal id for chainA
 TODO: Perform upgrade in place of chain restart
 */
 func (s *IntegrationTestSuite) GovSoftwareUpgrade() {
@@ -37,6 +47,8 @@ func (s *IntegrationTestSuite) GovSoftwareUpgrade() {
 	voteGovFlags := []string{strconv.Itoa(proposalCounter), "yes=0.8,no=0.1,abstain=0.05,no_with_veto=0.05"}
 	s.runGovProcess(chainAAPIEndpoint, sender, proposalCounter, upgradetypes.ProposalTypeSoftwareUpgrade, submitGovFlags, depositGovFlags, voteGovFlags, "weighted-vote", true)
 
+	s.registerGlobalFeeUpgradeAssertions(s.chainA)
+
 	s.verifyChainHaltedAtUpgradeHeight(s.chainA, 0, proposalHeight)
 	s.T().Logf("Successfully halted chain at  height %d", proposalHeight)
 
@@ -50,10 +62,12 @@ func (s *IntegrationTestSuite) GovSoftwareUpgrade() {
 			h := s.getLatestBlockHeight(s.chainA, 0)
 			return h > 0
 		},
-		30*time.Second,
+		scaleTimeout(30*time.Second),
 		5*time.Second,
 	)
 
+	s.runPostUpgradeAssertions(s.chainA)
+
 	proposalCounter = 0
 }
 
@@ -88,6 +102,278 @@ func (s *IntegrationTestSuite) GovCancelSoftwareUpgrade() {
 	s.T().Logf("Successfully canceled upgrade at height %d", proposalHeight)
 }
 
+/*
+GovProposalDroppedAfterDepositPeriod tests that a proposal which does not reach the min deposit within the deposit period is dropped.
+Test Benchmarks:
+1. Submission of a text proposal with a deposit below the min deposit
+2. Validation that the proposal is in deposit period
+3. Validation that the proposal is removed once the deposit period ended
+*/
+func (s *IntegrationTestSuite) GovProposalDroppedAfterDepositPeriod() {
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	s.Require().NotNil(s.chainA.govDepositParams)
+	minDeposit := s.chainA.govDepositParams.MinDeposit
+	s.Require().True(minDeposit.IsAllGT(sdk.NewCoins(initialDepositAmount)))
+
+	proposalCounter++
+	submitGovFlags := []string{
+		"--title=Dropped Proposal",
+		"--description=Never reaches the min deposit",
+		"--type=Text",
+		"--deposit=" + initialDepositAmount.String(),
+	}
+	s.submitGovCommand(chainAAPIEndpoint, sender, proposalCounter, "submit-proposal", submitGovFlags, govtypes.StatusDepositPeriod)
+
+	proposal, err := queryGovProposal(chainAAPIEndpoint, proposalCounter)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(initialDepositAmount), proposal.Proposal.TotalDeposit)
+
+	s.Require().Eventually(
+		func() bool {
+			_, err := queryGovProposal(chainAAPIEndpoint, proposalCounter)
+			return err != nil
+		},
+		s.chainA.govDepositParams.MaxDepositPeriod+scaleTimeout(time.Minute),
+		5*time.Second,
+	)
+}
+
+/*
+GovParamChange tests changing a param through a param change proposal.
+Test Benchmarks:
+1. Submission, deposit and vote of a param change proposal of the globalfee minimum gas prices
+2. Validation that the new minimum gas prices are returned by the globalfee query
+3. Restoration of the initial minimum gas prices
+*/
+func (s *IntegrationTestSuite) GovParamChange() {
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+
+	newGlobalFee, err := sdk.ParseDecCoins("0.0003" + uatomDenom)
+	s.Require().NoError(err)
+	s.changeParamAndVerify(s.chainA, globalfeetypes.ModuleName, string(globalfeetypes.ParamStoreKeyMinGasPrices), newGlobalFee)
+
+	globalFees, err := queryGlobalFees(chainAAPIEndpoint)
+	s.Require().NoError(err)
+	s.Require().True(globalFees.IsEqual(newGlobalFee), "expected global fees %s, got %s", newGlobalFee, globalFees)
+
+	initialGlobalFee, err := sdk.ParseDecCoins(initialGlobalFeeAmt + uatomDenom)
+	s.Require().NoError(err)
+	s.changeParamAndVerify(s.chainA, globalfeetypes.ModuleName, string(globalfeetypes.ParamStoreKeyMinGasPrices), initialGlobalFee)
+}
+
+/*
+GovMajorityValidatorVote tests that a validator holding the majority of the voting power decides the outcome of a proposal.
+Test Benchmarks:
+1. Validation that the first validator holds the majority of the bonded tokens
+2. Submission of a text proposal with the min deposit
+3. Vote no by the minority validator and yes by the majority validator
+4. Validation that the proposal passed with the no vote counted in its tally
+*/
+func (s *IntegrationTestSuite) GovMajorityValidatorVote() {
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	majorityVal := s.chainA.validators[0]
+	minorityVal := s.chainA.validators[1]
+	s.Require().NotNil(s.chainA.govTallyParams)
+
+	validators, err := queryValidators(chainAAPIEndpoint)
+	s.Require().NoError(err)
+	bondedTokens, majorityTokens := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, val := range validators {
+		if !val.IsBonded() {
+			continue
+		}
+		bondedTokens = bondedTokens.Add(val.Tokens)
+		if val.OperatorAddress == sdk.ValAddress(majorityVal.keyInfo.GetAddress()).String() {
+			majorityTokens = val.Tokens
+		}
+	}
+	s.Require().True(majorityTokens.ToDec().QuoInt(bondedTokens).GT(s.chainA.govTallyParams.Threshold),
+		"%s of %s bonded tokens", majorityTokens, bondedTokens)
+
+	proposalCounter++
+	submitGovFlags := []string{
+		"--title=Majority Vote",
+		"--description=Passes on the vote of the majority validator",
+		"--type=Text",
+		"--deposit=" + sdk.NewCoin(uatomDenom, govMinDepositAmount).String(),
+	}
+	s.submitGovCommand(chainAAPIEndpoint, majorityVal.keyInfo.GetAddress().String(), proposalCounter, "submit-proposal", submitGovFlags, govtypes.StatusVotingPeriod)
+
+	// the no vote is not waited for, so that both votes are cast within the
+	// short voting period of the e2e chains
+	s.runGovExecWithValidation(s.chainA, 1, minorityVal.keyInfo.GetAddress().String(), "vote",
+		[]string{strconv.Itoa(proposalCounter), "no"}, standardFees.String(),
+		func(stdOut []byte, _ []byte) bool {
+			var txResp sdk.TxResponse
+			return cdc.UnmarshalJSON(stdOut, &txResp) == nil && txResp.Code == 0
+		},
+	)
+	s.submitGovCommand(chainAAPIEndpoint, majorityVal.keyInfo.GetAddress().String(), proposalCounter, "vote",
+		[]string{strconv.Itoa(proposalCounter), "yes"}, govtypes.StatusPassed)
+
+	proposal, err := queryGovProposal(chainAAPIEndpoint, proposalCounter)
+	s.Require().NoError(err)
+	tally := proposal.Proposal.FinalTallyResult
+	s.Require().True(tally.No.IsPositive(), "the no vote of the minority validator is not in the tally %s", tally.String())
+	s.Require().True(tally.Yes.GT(tally.No), tally.String())
+}
+
+/*
+GovQuorumNotReached tests that a proposal not voted by the quorum of the voting power is rejected.
+Test Benchmarks:
+1. Validation that a single validator of chain B holds less voting power than the quorum
+2. Submission of a text proposal with the min deposit on chain B
+3. Vote yes by the single validator
+4. Validation that the proposal is rejected at the end of the short voting period
+*/
+func (s *IntegrationTestSuite) GovQuorumNotReached() {
+	c := s.chainB
+	chainBAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+	voter := c.validators[0].keyInfo.GetAddress()
+	s.Require().NotNil(c.govTallyParams)
+	s.Require().Positive(c.govVotingPeriod)
+
+	validators, err := queryValidators(chainBAPIEndpoint)
+	s.Require().NoError(err)
+	bondedTokens, voterTokens := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, val := range validators {
+		if !val.IsBonded() {
+			continue
+		}
+		bondedTokens = bondedTokens.Add(val.Tokens)
+		if val.OperatorAddress == sdk.ValAddress(voter).String() {
+			voterTokens = val.Tokens
+		}
+	}
+	s.Require().True(voterTokens.ToDec().QuoInt(bondedTokens).LT(c.govTallyParams.Quorum),
+		"%s of %s bonded tokens", voterTokens, bondedTokens)
+
+	submitGovFlags := []string{
+		"--title=Quorum Not Reached",
+		"--description=Voted by a single validator",
+		"--type=Text",
+		"--deposit=" + sdk.NewCoin(uatomDenom, govMinDepositAmount).String(),
+	}
+	s.runGovExec(c, 0, voter.String(), "submit-proposal", submitGovFlags, standardFees.String())
+	proposalID, err := queryLatestGovProposalID(chainBAPIEndpoint)
+	s.Require().NoError(err)
+
+	// the vote is cast right away, as the voting period of chain B is short
+	s.runGovExec(c, 0, voter.String(), "vote", []string{strconv.FormatUint(proposalID, 10), "yes"}, standardFees.String())
+
+	s.Require().Eventually(
+		func() bool {
+			proposal, err := queryGovProposal(chainBAPIEndpoint, int(proposalID))
+			s.Require().NoError(err)
+			return proposal.GetProposal().Status == govtypes.StatusRejected
+		},
+		c.govVotingPeriod+scaleTimeout(30*time.Second),
+		5*time.Second,
+	)
+
+	proposal, err := queryGovProposal(chainBAPIEndpoint, int(proposalID))
+	s.Require().NoError(err)
+	tally := proposal.Proposal.FinalTallyResult
+	s.Require().True(tally.Yes.IsPositive(), "the yes vote is not in the tally %s", tally.String())
+	s.Require().True(tally.No.IsZero(), tally.String())
+}
+
+/*
+GovMaxActiveProposals tests that the proposals submitted once the number of active proposals reaches the cap are rejected.
+Test Benchmarks:
+1. Submission of text proposals below the min deposit on chain B until its cap of active proposals is reached
+2. Validation that a proposal submitted at the cap is rejected
+3. Deposit of the min deposit on a proposal, which ends at the end of the short voting period
+4. Validation that a proposal submitted below the cap is accepted
+*/
+func (s *IntegrationTestSuite) GovMaxActiveProposals() {
+	c := s.chainB
+	chainBAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+	sender := c.validators[0].keyInfo.GetAddress().String()
+	s.Require().Positive(c.maxActiveProposals)
+
+	// the proposals stay in deposit period as their deposit is below the min
+	// deposit
+	submitGovFlags := []string{
+		"--title=Active Proposal",
+		"--description=Counts toward the cap of active proposals",
+		"--type=Text",
+		"--deposit=" + initialDepositAmount.String(),
+	}
+	var proposalIDs []uint64
+	for i := uint64(0); i < c.maxActiveProposals; i++ {
+		s.runGovExec(c, 0, sender, "submit-proposal", submitGovFlags, standardFees.String())
+		proposalID, err := queryLatestGovProposalID(chainBAPIEndpoint)
+		s.Require().NoError(err)
+		proposal, err := queryGovProposal(chainBAPIEndpoint, int(proposalID))
+		s.Require().NoError(err)
+		s.Require().Equal(govtypes.StatusDepositPeriod, proposal.Proposal.Status)
+		proposalIDs = append(proposalIDs, proposalID)
+	}
+
+	s.runGovExecWithValidation(c, 0, sender, "submit-proposal", submitGovFlags, standardFees.String(), s.expectErrExecValidation(c, 0, true))
+	latestID, err := queryLatestGovProposalID(chainBAPIEndpoint)
+	s.Require().NoError(err)
+	s.Require().Equal(proposalIDs[len(proposalIDs)-1], latestID)
+
+	// the first proposal enters its voting period and ends without votes
+	depositFlags := []string{strconv.FormatUint(proposalIDs[0], 10), sdk.NewCoin(uatomDenom, govMinDepositAmount).String()}
+	s.runGovExec(c, 0, sender, "deposit", depositFlags, standardFees.String())
+	s.Require().Eventually(
+		func() bool {
+			proposal, err := queryGovProposal(chainBAPIEndpoint, int(proposalIDs[0]))
+			s.Require().NoError(err)
+			return proposal.GetProposal().Status == govtypes.StatusRejected
+		},
+		c.govVotingPeriod+scaleTimeout(30*time.Second),
+		5*time.Second,
+	)
+
+	s.runGovExec(c, 0, sender, "submit-proposal", submitGovFlags, standardFees.String())
+	latestID, err = queryLatestGovProposalID(chainBAPIEndpoint)
+	s.Require().NoError(err)
+	s.Require().Greater(latestID, proposalIDs[len(proposalIDs)-1])
+}
+
+/*
+GovDepositDenoms tests that the gov deposits are restricted to the allowlisted denoms.
+Test Benchmarks:
+1. Submission of a text proposal with a uatom deposit below the min deposit
+2. Deposit in the preloaded IBC voucher, which is allowlisted on chain A
+3. Validation that a deposit in photon, which is not allowlisted, is rejected
+4. Validation that the total deposit of the proposal only holds the allowlisted deposits
+*/
+func (s *IntegrationTestSuite) GovDepositDenoms() {
+	c := s.chainA
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+	sender := c.validators[0].keyInfo.GetAddress().String()
+	ibcDeposit := sdk.NewCoin(ibctransfertypes.ParseDenomTrace(preloadedIBCDenomTrace).IBCDenom(), sdk.NewInt(1000))
+	s.Require().Contains(c.depositDenoms, ibcDeposit.Denom)
+	s.Require().NotContains(c.depositDenoms, photonDenom)
+
+	// the proposal stays in deposit period as its deposit is below the min
+	// deposit, and is dropped at the end of the deposit period
+	proposalCounter++
+	submitGovFlags := []string{
+		"--title=Deposit Denoms",
+		"--description=Accepts the deposits in the allowlisted denoms only",
+		"--type=Text",
+		"--deposit=" + initialDepositAmount.String(),
+	}
+	s.submitGovCommand(chainAAPIEndpoint, sender, proposalCounter, "submit-proposal", submitGovFlags, govtypes.StatusDepositPeriod)
+
+	depositFlags := []string{strconv.Itoa(proposalCounter), ibcDeposit.String()}
+	s.runGovExec(c, 0, sender, "deposit", depositFlags, standardFees.String())
+
+	depositFlags = []string{strconv.Itoa(proposalCounter), sdk.NewInt64Coin(photonDenom, 1000).String()}
+	s.runGovExecWithValidation(c, 0, sender, "deposit", depositFlags, standardFees.String(), s.expectErrExecValidation(c, 0, true))
+
+	proposal, err := queryGovProposal(chainAAPIEndpoint, proposalCounter)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(initialDepositAmount, ibcDeposit), proposal.Proposal.TotalDeposit)
+}
+
 /*
 GovCommunityPoolSpend tests passing a community spend proposal.
 Test Benchmarks:
@@ -122,7 +408,296 @@ func (s *IntegrationTestSuite) GovCommunityPoolSpend() {
 
 			return afterRecipientBalance.Sub(sendAmount).IsEqual(beforeRecipientBalance)
 		},
-		10*time.Second,
+		scaleTimeout(10*time.Second),
+		5*time.Second,
+	)
+}
+
+/*
+GovPreloadedCommunityPoolSpend tests spending from the community pool preloaded in genesis, without funding it first.
+Test Benchmarks:
+1. Validation that the community pool holds at least the preloaded amount
+2. Submission, deposit and vote of proposal to spend from the community pool an amount only the preloaded pool covers
+3. Validation that the recipient balance has increased by proposal amount
+*/
+func (s *IntegrationTestSuite) GovPreloadedCommunityPoolSpend() {
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	// a fresh recipient whose balance only changes through the spend
+	recipient := sdk.AccAddress("preloaded_recipient_").String()
+
+	pool, err := queryCommunityPool(chainAAPIEndpoint)
+	s.Require().NoError(err)
+	s.Require().True(pool.AmountOf(uatomDenom).GTE(preloadedCommunityPool.Amount.ToDec()), "community pool %s is below the preloaded %s", pool, preloadedCommunityPool)
+
+	// a third of the preloaded pool, within the max spend fraction and above
+	// what the fees and the block rewards bring to the pool
+	sendAmount := sdk.NewCoin(uatomDenom, preloadedCommunityPool.Amount.QuoRaw(3))
+	s.writeGovCommunitySpendProposal(s.chainA, sendAmount.String(), recipient)
+
+	proposalCounter++
+	submitGovFlags := []string{"community-pool-spend", configFile(proposalCommunitySpendFilename)}
+	depositGovFlags := []string{strconv.Itoa(proposalCounter), depositAmount.String()}
+	voteGovFlags := []string{strconv.Itoa(proposalCounter), "yes"}
+	s.runGovProcess(chainAAPIEndpoint, sender, proposalCounter, distrtypes.ProposalTypeCommunityPoolSpend, submitGovFlags, depositGovFlags, voteGovFlags, "vote", false)
+
+	s.Require().Eventually(
+		func() bool {
+			balances, err := queryGaiaAllBalances(chainAAPIEndpoint, recipient)
+			s.Require().NoError(err)
+			return balances.AmountOf(uatomDenom).Equal(sendAmount.Amount)
+		},
+		scaleTimeout(10*time.Second),
+		5*time.Second,
+	)
+}
+
+/*
+GovScheduledCommunityPoolSpend tests passing a scheduled proposal whose community spend is only executed at a given height.
+Test Benchmarks:
+1. Fund Community Pool
+2. Submission, deposit and vote of a scheduled proposal to send atoms from the community pool to a recipient after a height (current height + buffer)
+3. Validation that the spend is scheduled and the recipient balance is unchanged once the proposal passed
+4. Validation that the recipient balance has increased by proposal amount once the height is reached
+*/
+func (s *IntegrationTestSuite) GovScheduledCommunityPoolSpend() {
+	s.fundCommunityPool()
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	// a fresh recipient whose balance only changes through the scheduled spend
+	recipientAddress := sdk.AccAddress("scheduled_recipient_")
+	recipient := recipientAddress.String()
+	sendAmount := sdk.NewCoin(uatomDenom, sdk.NewInt(1000000)) // 1atom
+	executeAfterHeight := int64(s.getLatestBlockHeight(s.chainA, 0) + govProposalBlockBuffer)
+	s.writeGovScheduledCommunitySpendProposal(s.chainA, sdk.NewCoins(sendAmount), recipientAddress, executeAfterHeight)
+
+	recipientBalance := func() sdk.Int {
+		balances, err := queryGaiaAllBalances(chainAAPIEndpoint, recipient)
+		s.Require().NoError(err)
+		return balances.AmountOf(uatomDenom)
+	}
+	s.Require().True(recipientBalance().IsZero())
+
+	proposalCounter++
+	submitGovFlags := []string{"scheduled-proposal", configFile(proposalScheduledSpendFilename)}
+	depositGovFlags := []string{strconv.Itoa(proposalCounter), depositAmount.String()}
+	voteGovFlags := []string{strconv.Itoa(proposalCounter), "yes"}
+	s.runGovProcess(chainAAPIEndpoint, sender, proposalCounter, govscheduletypes.ProposalTypeScheduled, submitGovFlags, depositGovFlags, voteGovFlags, "vote", false)
+
+	// the proposal passed before the height, the spend waits for it
+	s.Require().Less(int64(s.getLatestBlockHeight(s.chainA, 0)), executeAfterHeight)
+	executions, err := queryScheduledExecutions(chainAAPIEndpoint)
+	s.Require().NoError(err)
+	s.Require().Len(executions, 1)
+	s.Require().Equal(executeAfterHeight, executions[0].ExecuteAfterHeight)
+	s.Require().True(recipientBalance().IsZero())
+
+	s.Require().Eventually(
+		func() bool {
+			return recipientBalance().Equal(sendAmount.Amount)
+		},
+		scaleTimeout(time.Minute),
+		5*time.Second,
+	)
+	s.Require().GreaterOrEqual(int64(s.getLatestBlockHeight(s.chainA, 0)), executeAfterHeight)
+
+	executions, err = queryScheduledExecutions(chainAAPIEndpoint)
+	s.Require().NoError(err)
+	s.Require().Empty(executions)
+}
+
+/*
+GovCommunityPoolSpendAboveCap tests that a community spend proposal requesting more than the max spend fraction of the community pool is rejected at submission.
+Test Benchmarks:
+1. Fund Community Pool
+2. Submission of a proposal to spend more than the community pool from the community pool, which fails
+3. Validation that no proposal was created
+*/
+func (s *IntegrationTestSuite) GovCommunityPoolSpendAboveCap() {
+	s.fundCommunityPool()
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	recipient := s.chainA.validators[1].keyInfo.GetAddress().String()
+	s.Require().NotNil(s.chainA.maxSpendFraction)
+
+	pool, err := queryCommunityPool(chainAAPIEndpoint)
+	s.Require().NoError(err)
+	sendAmount := sdk.NewCoin(uatomDenom, pool.AmountOf(uatomDenom).TruncateInt().AddRaw(1))
+	s.writeGovCommunitySpendProposal(s.chainA, sendAmount.String(), recipient)
+
+	// the proposal is rejected by the ante handler, so no proposal id is used
+	s.Run("Running tx gov submit-proposal above the spend cap", func() {
+		submitGovFlags := []string{"community-pool-spend", configFile(proposalCommunitySpendFilename)}
+		s.runGovExecWithValidation(s.chainA, 0, sender, "submit-proposal", submitGovFlags, standardFees.String(), s.expectErrExecValidation(s.chainA, 0, true))
+	})
+	_, err = queryGovProposal(chainAAPIEndpoint, proposalCounter+1)
+	s.Require().Error(err)
+}
+
+/*
+GovRecurringCommunityPoolSpend tests passing a gov proposal that sets up a recurring spend from the community pool.
+Test Benchmarks:
+1. Fund Community Pool
+2. Submission, deposit and vote of proposal to send atoms from the community pool to a recipient every few blocks
+3. Validation that the recipient received two disbursements
+4. Submission, deposit and vote of proposal to cancel the recurring spend
+5. Validation that the recurring spend is removed and no more disbursements happen
+*/
+func (s *IntegrationTestSuite) GovRecurringCommunityPoolSpend() {
+	s.fundCommunityPool()
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	// a fresh recipient whose balance only changes through the recurring spend
+	recipient := sdk.AccAddress("recurring_recipient_").String()
+	spendAmount := sdk.NewCoin(uatomDenom, sdk.NewInt(1000000)) // 1atom
+	interval := uint64(5)
+	endHeight := int64(s.getLatestBlockHeight(s.chainA, 0) + 1000)
+	s.writeGovRecurringCommunitySpendProposal(s.chainA, spendAmount.String(), recipient, interval, endHeight)
+
+	recipientBalance := func() sdk.Int {
+		balances, err := queryGaiaAllBalances(chainAAPIEndpoint, recipient)
+		s.Require().NoError(err)
+		return balances.AmountOf(uatomDenom)
+	}
+	s.Require().True(recipientBalance().IsZero())
+
+	proposalCounter++
+	submitGovFlags := []string{"recurring-community-pool-spend", configFile(proposalRecurringSpendFilename)}
+	depositGovFlags := []string{strconv.Itoa(proposalCounter), depositAmount.String()}
+	voteGovFlags := []string{strconv.Itoa(proposalCounter), "yes"}
+	s.runGovProcess(chainAAPIEndpoint, sender, proposalCounter, recurringspendtypes.ProposalTypeRecurringCommunityPoolSpend, submitGovFlags, depositGovFlags, voteGovFlags, "vote", false)
+
+	var spendID uint64
+	s.Require().Eventually(
+		func() bool {
+			spends, err := queryRecurringSpends(chainAAPIEndpoint)
+			s.Require().NoError(err)
+			for _, spend := range spends {
+				if spend.Recipient == recipient {
+					spendID = spend.Id
+					return true
+				}
+			}
+			return false
+		},
+		scaleTimeout(15*time.Second),
+		5*time.Second,
+	)
+
+	// each disbursement adds the spend amount, wait for the second one
+	s.Require().Eventually(
+		func() bool {
+			return recipientBalance().GTE(spendAmount.Amount.MulRaw(2))
+		},
+		scaleTimeout(time.Minute),
+		5*time.Second,
+	)
+	s.Require().True(recipientBalance().Mod(spendAmount.Amount).IsZero())
+
+	proposalCounter++
+	submitGovFlags = []string{
+		"cancel-recurring-community-pool-spend",
+		strconv.FormatUint(spendID, 10),
+		"--title=Cancel Recurring Community Pool Spend",
+		"--description=Stop funding Team",
+	}
+	depositGovFlags = []string{strconv.Itoa(proposalCounter), depositAmount.String()}
+	voteGovFlags = []string{strconv.Itoa(proposalCounter), "yes"}
+	s.runGovProcess(chainAAPIEndpoint, sender, proposalCounter, recurringspendtypes.ProposalTypeCancelRecurringCommunityPoolSpend, submitGovFlags, depositGovFlags, voteGovFlags, "vote", true)
+
+	spends, err := queryRecurringSpends(chainAAPIEndpoint)
+	s.Require().NoError(err)
+	for _, spend := range spends {
+		s.Require().NotEqual(spendID, spend.Id)
+	}
+
+	// no disbursement happens once the spend is cancelled
+	cancelledBalance := recipientBalance()
+	s.Require().Never(
+		func() bool {
+			return !recipientBalance().Equal(cancelledBalance)
+		},
+		15*time.Second,
+		5*time.Second,
+	)
+}
+
+/*
+GovSanctionAddress tests passing a gov proposal that sanctions an address.
+Test Benchmarks:
+1. Submission, deposit and vote of proposal to sanction a fresh address
+2. Validation that the address is sanctioned
+3. Validation that a bank send to the sanctioned address is rejected
+*/
+func (s *IntegrationTestSuite) GovSanctionAddress() {
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	// a fresh address no other test sends to
+	sanctioned := sdk.AccAddress("sanctioned_recipient").String()
+
+	isSanctioned, err := queryIsSanctioned(chainAAPIEndpoint, sanctioned)
+	s.Require().NoError(err)
+	s.Require().False(isSanctioned)
+
+	proposalCounter++
+	submitGovFlags := []string{
+		"add-sanctioned-addresses",
+		sanctioned,
+		"--title=Sanction Address",
+		"--description=Block the address from transacting",
+	}
+	depositGovFlags := []string{strconv.Itoa(proposalCounter), depositAmount.String()}
+	voteGovFlags := []string{strconv.Itoa(proposalCounter), "yes"}
+	s.runGovProcess(chainAAPIEndpoint, sender, proposalCounter, sanctiontypes.ProposalTypeAddSanctionedAddresses, submitGovFlags, depositGovFlags, voteGovFlags, "vote", true)
+
+	s.Require().Eventually(
+		func() bool {
+			isSanctioned, err := queryIsSanctioned(chainAAPIEndpoint, sanctioned)
+			s.Require().NoError(err)
+			return isSanctioned
+		},
+		scaleTimeout(15*time.Second),
+		5*time.Second,
+	)
+
+	s.execBankSend(s.chainA, 0, sender, sanctioned, tokenAmount.String(), standardFees.String(), true)
+
+	balances, err := queryGaiaAllBalances(chainAAPIEndpoint, sanctioned)
+	s.Require().NoError(err)
+	s.Require().True(balances.IsZero())
+}
+
+/*
+GovHaltMsgType tests pausing a message type by governance.
+Test Benchmarks:
+1. Submission, deposit and vote of a param change proposal halting the bank sends
+2. Validation that a bank send is rejected
+3. Submission, deposit and vote of a param change proposal lifting the halt
+4. Validation that a bank send succeeds
+*/
+func (s *IntegrationTestSuite) GovHaltMsgType() {
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	// a fresh address no other test sends to
+	recipient := sdk.AccAddress("halted_msg_recipient").String()
+
+	s.changeParamAndVerify(s.chainA, policytypes.ModuleName, string(policytypes.ParamStoreKeyHaltedMsgTypes), []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+
+	s.execBankSend(s.chainA, 0, sender, recipient, tokenAmount.String(), standardFees.String(), true)
+	balances, err := queryGaiaAllBalances(chainAAPIEndpoint, recipient)
+	s.Require().NoError(err)
+	s.Require().True(balances.IsZero())
+
+	s.changeParamAndVerify(s.chainA, policytypes.ModuleName, string(policytypes.ParamStoreKeyHaltedMsgTypes), []string{})
+
+	s.execBankSend(s.chainA, 0, sender, recipient, tokenAmount.String(), standardFees.String(), false)
+	s.Require().Eventually(
+		func() bool {
+			balances, err := queryGaiaAllBalances(chainAAPIEndpoint, recipient)
+			s.Require().NoError(err)
+			return balances.AmountOf(uatomDenom).Equal(tokenAmount.Amount)
+		},
+		scaleTimeout(15*time.Second),
 		5*time.Second,
 	)
 }
@@ -187,10 +762,10 @@ func validateConsumerRemoval(res ccvtypes.QueryConsumerChainsResponse, consumerC
 
 func (s *IntegrationTestSuite) runGovProcess(chainAAPIEndpoint, sender string, proposalID int, proposalType string, submitFlags []string, depositFlags []string, voteFlags []string, voteCommand string, withDeposit bool) {
 	s.T().Logf("Submitting Gov Proposal: %s", proposalType)
-	// min deposit of 1000uatom is required in e2e tests, otherwise the gov antehandler causes the proposal to be dropped
+	// an initial deposit is required in e2e tests, otherwise the gov antehandler causes the proposal to be dropped
 	sflags := submitFlags
 	if withDeposit {
-		sflags = append(sflags, "--deposit=1000uatom")
+		sflags = append(sflags, "--deposit="+initialDepositAmount.String())
 	}
 	s.submitGovCommand(chainAAPIEndpoint, sender, proposalID, "submit-proposal", sflags, govtypes.StatusDepositPeriod)
 	s.T().Logf("Depositing Gov Proposal: %s", proposalType)
@@ -199,6 +774,76 @@ func (s *IntegrationTestSuite) runGovProcess(chainAAPIEndpoint, sender string, p
 	s.submitGovCommand(chainAAPIEndpoint, sender, proposalID, voteCommand, voteFlags, govtypes.StatusPassed)
 }
 
+// changeParamAndVerify submits a param change proposal setting the param of
+// the given subspace and key to value on chain c, deposits, votes it through
+// with the first validator and waits until the params of the module, queried
+// from the params module, hold the new value. The value is amino JSON encoded
+// as the params module does.
+func (s *IntegrationTestSuite) changeParamAndVerify(c *chain, subspace, key string, value interface{}) {
+	apiEndpoint := fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+	sender := c.validators[0].keyInfo.GetAddress().String()
+
+	valueJSON, err := encodingConfig.Amino.MarshalJSON(value)
+	s.Require().NoError(err)
+	s.writeGovParamChangeProposal(c, subspace, key, valueJSON)
+
+	s.T().Logf("Submitting, deposit and vote param change proposal: set %s %s to %s", subspace, key, valueJSON)
+	s.runGovExec(c, 0, sender, "submit-proposal", []string{"param-change", configFile(proposalParamChangeFilename)}, standardFees.String())
+
+	proposalID, err := queryLatestGovProposalID(apiEndpoint)
+	s.Require().NoError(err)
+	if c.id == s.chainA.id {
+		proposalCounter = int(proposalID)
+	}
+	s.T().Logf("Proposal number: %d", proposalID)
+
+	s.runGovExec(c, 0, sender, "deposit", []string{strconv.FormatUint(proposalID, 10), depositAmount.String()}, standardFees.String())
+	s.Require().Eventually(
+		func() bool {
+			proposal, err := queryGovProposal(apiEndpoint, int(proposalID))
+			s.Require().NoError(err)
+			return proposal.GetProposal().Status == govtypes.StatusVotingPeriod
+		},
+		scaleTimeout(15*time.Second),
+		5*time.Second,
+	)
+
+	s.runGovExec(c, 0, sender, "vote", []string{strconv.FormatUint(proposalID, 10), "yes"}, standardFees.String())
+	s.Require().Eventually(
+		func() bool {
+			proposal, err := queryGovProposal(apiEndpoint, int(proposalID))
+			s.Require().NoError(err)
+			return proposal.GetProposal().Status == govtypes.StatusPassed
+		},
+		scaleTimeout(30*time.Second),
+		5*time.Second,
+	)
+
+	// both values are decoded into the type of value and encoded again, as the
+	// amino JSON of a value is not unique, e.g. an empty slice is read as null
+	expected := s.normalizeAminoJSON(value, valueJSON)
+	s.Require().Eventually(
+		func() bool {
+			res, err := queryParam(apiEndpoint, subspace, key)
+			s.Require().NoError(err)
+			s.T().Logf("After param change proposal: %s %s is %s", subspace, key, res)
+			return s.normalizeAminoJSON(value, []byte(res)) == expected
+		},
+		scaleTimeout(15*time.Second),
+		5*time.Second,
+	)
+}
+
+// normalizeAminoJSON decodes the amino JSON bz into a new instance of the
+// type of value and returns its amino JSON encoding.
+func (s *IntegrationTestSuite) normalizeAminoJSON(value interface{}, bz []byte) string {
+	ptr := reflect.New(reflect.TypeOf(value))
+	s.Require().NoError(encodingConfig.Amino.UnmarshalJSON(bz, ptr.Interface()))
+	res, err := encodingConfig.Amino.MarshalJSON(ptr.Elem().Interface())
+	s.Require().NoError(err)
+	return string(res)
+}
+
 func (s *IntegrationTestSuite) verifyChainHaltedAtUpgradeHeight(c *chain, valIdx, upgradeHeight int) {
 	s.Require().Eventually(
 		func() bool {
@@ -206,7 +851,7 @@ func (s *IntegrationTestSuite) verifyChainHaltedAtUpgradeHeight(c *chain, valIdx
 
 			return currentHeight == upgradeHeight
 		},
-		30*time.Second,
+		scaleTimeout(30*time.Second),
 		5*time.Second,
 	)
 
@@ -223,7 +868,7 @@ func (s *IntegrationTestSuite) verifyChainHaltedAtUpgradeHeight(c *chain, valIdx
 			}
 			return counter >= 2
 		},
-		8*time.Second,
+		scaleTimeout(8*time.Second),
 		2*time.Second,
 	)
 }
@@ -235,7 +880,7 @@ func (s *IntegrationTestSuite) verifyChainPassesUpgradeHeight(c *chain, valIdx,
 
 			return currentHeight > upgradeHeight
 		},
-		30*time.Second,
+		scaleTimeout(30*time.Second),
 		5*time.Second,
 	)
 }
@@ -251,7 +896,7 @@ func (s *IntegrationTestSuite) submitGovCommand(chainAAPIEndpoint, sender string
 
 				return proposal.GetProposal().Status == expectedSuccessStatus
 			},
-			15*time.Second,
+			scaleTimeout(15*time.Second),
 			5*time.Second,
 		)
 	})
@@ -0,0 +1,47 @@
+package e2e
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+testGrantsPoolFeeShare tests that the grants pool receives the fee share of
+the fee of a tx. The block rewards are minted in the stake denom and are not
+shared with the grants pool anyway, so the uatom of the grants pool only grows
+with the tx fees.
+Test Benchmarks:
+1. Execution of a bank send paying a known fee
+2. Verification that the grants pool increased by the fee share of the fee
+*/
+func (s *IntegrationTestSuite) testGrantsPoolFeeShare() {
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	s.Require().NotNil(s.chainA.grantsPoolFeeShare)
+
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	recipient := s.chainA.validators[1].keyInfo.GetAddress().String()
+	fees := sdk.NewCoin(uatomDenom, sdk.NewInt(10000000))
+
+	grantsPool := func() sdk.Int {
+		pool, err := queryGrantsPool(chainAAPIEndpoint)
+		s.Require().NoError(err)
+		return pool.AmountOf(uatomDenom)
+	}
+	beforePool := grantsPool()
+
+	s.execBankSend(s.chainA, 0, sender, recipient, tokenAmount.String(), fees.String(), false)
+
+	// the fee share is sent to the grants pool at the beginning of the next
+	// block. Other txs may pay fees in the meantime, so the pool grows by at
+	// least the share of the fee
+	expShare := fees.Amount.ToDec().Mul(*s.chainA.grantsPoolFeeShare).TruncateInt()
+	s.Require().Eventually(
+		func() bool {
+			return grantsPool().Sub(beforePool).GTE(expShare)
+		},
+		scaleTimeout(20*time.Second),
+		5*time.Second,
+	)
+}
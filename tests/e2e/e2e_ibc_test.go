@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,10 +17,29 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"github.com/ory/dockertest/v3"
 	"github.com/ory/dockertest/v3/docker"
+
+	gaiaquerytypes "github.com/cosmos/gaia/v9/x/query/types"
 )
 
+// feeChannelVersion is the version of the transfer channels with the ICS-29
+// fee middleware enabled.
+const feeChannelVersion = `{"fee_version":"ics29-1","app_version":"ics20-1"}`
+
+// preloadedIBCDenomTrace is the denom trace of the IBC voucher held by the
+// accounts of chain A at genesis.
+const preloadedIBCDenomTrace = "transfer/channel-99/uosmo"
+
+// preloadedIBCDenomAmount is the amount of the preloaded IBC voucher held by
+// each account of chain A at genesis.
+var preloadedIBCDenomAmount = sdk.NewInt(1_000_000)
+
 type ForwardMetadata struct {
 	Receiver string `json:"receiver"`
 	Port     string `json:"port"`
@@ -77,6 +97,8 @@ func (s *IntegrationTestSuite) runIBCRelayer() {
 				fmt.Sprintf("GAIA_B_E2E_RLY_MNEMONIC=%s", gaiaBRly.mnemonic),
 				fmt.Sprintf("GAIA_A_E2E_VAL_HOST=%s", s.valResources[s.chainA.id][0].Container.Name[1:]),
 				fmt.Sprintf("GAIA_B_E2E_VAL_HOST=%s", s.valResources[s.chainB.id][0].Container.Name[1:]),
+				fmt.Sprintf("GAIA_A_E2E_TRUSTING_PERIOD=%s", s.chainA.relayerTrustingPeriod()),
+				fmt.Sprintf("GAIA_B_E2E_TRUSTING_PERIOD=%s", s.chainB.relayerTrustingPeriod()),
 			},
 			Entrypoint: []string{
 				"sh",
@@ -113,7 +135,7 @@ func (s *IntegrationTestSuite) runIBCRelayer() {
 
 			return status == "success" && len(result["chains"].([]interface{})) == 2
 		},
-		5*time.Minute,
+		scaleTimeout(5*time.Minute),
 		time.Second,
 		"hermes relayer not healthy",
 	)
@@ -130,8 +152,16 @@ func (s *IntegrationTestSuite) runIBCRelayer() {
 	s.createChannel()
 }
 
-func (s *IntegrationTestSuite) sendIBC(c *chain, valIdx int, sender, recipient, token, fees, note string) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+// sendIBC sends an ICS-20 transfer over channel-0 and returns the sequence of
+// the sent packet.
+func (s *IntegrationTestSuite) sendIBC(c *chain, valIdx int, sender, recipient, token, fees, note string) uint64 {
+	return s.sendIBCOverChannel(c, valIdx, "channel-0", sender, recipient, token, fees, note)
+}
+
+// sendIBCOverChannel sends an ICS-20 transfer over the given channel and
+// returns the sequence of the sent packet.
+func (s *IntegrationTestSuite) sendIBCOverChannel(c *chain, valIdx int, channelID, sender, recipient, token, fees, note string) uint64 {
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	ibcCmd := []string{
@@ -140,7 +170,7 @@ func (s *IntegrationTestSuite) sendIBC(c *chain, valIdx int, sender, recipient,
 		"ibc-transfer",
 		"transfer",
 		"transfer",
-		"channel-0",
+		channelID,
 		recipient,
 		token,
 		fmt.Sprintf("--from=%s", sender),
@@ -154,14 +184,149 @@ func (s *IntegrationTestSuite) sendIBC(c *chain, valIdx int, sender, recipient,
 		"-y",
 	}
 	s.T().Logf("sending %s from %s (%s) to %s (%s) with memo %s", token, s.chainA.id, sender, s.chainB.id, recipient, note)
-	s.executeGaiaTxCommand(ctx, c, ibcCmd, valIdx, s.defaultExecValidation(c, valIdx))
+	var txHash string
+	validation := s.defaultExecValidation(c, valIdx)
+	s.executeGaiaTxCommand(ctx, c, ibcCmd, valIdx, func(stdOut []byte, stdErr []byte) bool {
+		var txResp sdk.TxResponse
+		if err := cdc.UnmarshalJSON(stdOut, &txResp); err == nil {
+			txHash = txResp.TxHash
+		}
+		return validation(stdOut, stdErr)
+	})
 	s.T().Log("successfully sent IBC tokens")
+
+	endpoint := fmt.Sprintf("http://%s", s.valResources[c.id][valIdx].GetHostPort("1317/tcp"))
+	sequence, err := querySentPacketSequence(endpoint, txHash)
+	s.Require().NoError(err)
+	return sequence
+}
+
+// requireAckSuccess waits for the acknowledgement of the packet of the given
+// sequence received by c on channelID, and requires it to be a success.
+func (s *IntegrationTestSuite) requireAckSuccess(c *chain, sequence uint64, channelID string) {
+	ack := s.waitForAck(c, sequence, channelID)
+	s.Require().True(ack.Success(), "packet %d acknowledged with error: %s", sequence, ack.GetError())
+}
+
+// requireAckError waits for the acknowledgement of the packet of the given
+// sequence received by c on channelID, and requires it to be an error
+// containing expErr. Note that the error acks only hold the ABCI code of the
+// error, the error message is redacted to keep the acks deterministic.
+func (s *IntegrationTestSuite) requireAckError(c *chain, sequence uint64, channelID, expErr string) {
+	ack := s.waitForAck(c, sequence, channelID)
+	s.Require().False(ack.Success(), "packet %d acknowledged with success", sequence)
+	s.Require().Contains(ack.GetError(), expErr)
+}
+
+// waitForAck polls c until the acknowledgement of the packet of the given
+// sequence received on channelID is written, and returns it.
+func (s *IntegrationTestSuite) waitForAck(c *chain, sequence uint64, channelID string) channeltypes.Acknowledgement {
+	endpoint := fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+
+	var ack channeltypes.Acknowledgement
+	s.Require().Eventuallyf(
+		func() bool {
+			var (
+				found bool
+				err   error
+			)
+			ack, found, err = queryPacketAck(endpoint, channelID, sequence)
+			s.Require().NoError(err)
+			return found
+		},
+		scaleTimeout(2*time.Minute),
+		5*time.Second,
+		"no acknowledgement of packet %d on %s of %s", sequence, channelID, c.id,
+	)
+	return ack
+}
+
+// packetRelayStage is how far the relaying of a packet went.
+type packetRelayStage string
+
+const (
+	// packetSent is the stage of a packet sent and not received yet
+	packetSent packetRelayStage = "sent, not received by the destination"
+	// packetReceived is the stage of a packet received and not acknowledged
+	// yet, e.g. with an async acknowledgement
+	packetReceived packetRelayStage = "received, not acknowledged by the destination"
+	// packetAckWritten is the stage of a packet acknowledged by the
+	// destination, the acknowledgement not being relayed back yet
+	packetAckWritten packetRelayStage = "acknowledged, the acknowledgement not relayed back to the source"
+	// packetAcked is the stage of a packet fully relayed
+	packetAcked packetRelayStage = "acknowledged on the source"
+	// packetTimedOut is the stage of a packet timed out, or never sent
+	packetTimedOut packetRelayStage = "timed out or not sent"
+)
+
+// packetRelayTimeout is the time waitForPacketRelay waits for a packet to be
+// relayed.
+const packetRelayTimeout = 2 * time.Minute
+
+// waitForPacketRelay polls src and dst until the packet of the given sequence
+// sent by src on channelID is received by dst and its acknowledgement is
+// relayed back to src. It fails the test with the stage the packet is stuck
+// at when it is not relayed within packetRelayTimeout, or when it timed out.
+func (s *IntegrationTestSuite) waitForPacketRelay(src, dst *chain, channelID string, sequence uint64) {
+	srcEndpoint := fmt.Sprintf("http://%s", s.valResources[src.id][0].GetHostPort("1317/tcp"))
+	dstEndpoint := fmt.Sprintf("http://%s", s.valResources[dst.id][0].GetHostPort("1317/tcp"))
+	dstChannelID, err := queryCounterpartyChannel(srcEndpoint, channelID)
+	s.Require().NoError(err)
+
+	deadline := time.Now().Add(packetRelayTimeout)
+	for {
+		stage, err := queryPacketRelayStage(srcEndpoint, dstEndpoint, channelID, dstChannelID, sequence)
+		s.Require().NoError(err)
+		switch {
+		case stage == packetAcked:
+			s.T().Logf("packet %d of %s on %s relayed to %s on %s", sequence, channelID, src.id, dstChannelID, dst.id)
+			return
+		case stage == packetTimedOut:
+			s.FailNowf("packet not relayed", "packet %d of %s on %s to %s on %s %s", sequence, channelID, src.id, dstChannelID, dst.id, stage)
+		case time.Now().After(deadline):
+			s.FailNowf("packet not relayed", "packet %d of %s on %s to %s on %s stuck after %s: %s", sequence, channelID, src.id, dstChannelID, dst.id, packetRelayTimeout, stage)
+		}
+		time.Sleep(5 * time.Second)
+	}
+}
+
+// queryPacketRelayStage returns the stage of the packet of the given sequence
+// sent on srcChannelID to dstChannelID, from the packet commitment kept by
+// the source until the packet is acknowledged or timed out, and the receipt
+// and acknowledgement commitment written by the destination.
+func queryPacketRelayStage(srcEndpoint, dstEndpoint, srcChannelID, dstChannelID string, sequence uint64) (packetRelayStage, error) {
+	pending, err := queryPacketCommitment(srcEndpoint, srcChannelID, sequence)
+	if err != nil {
+		return "", err
+	}
+	received, err := queryPacketReceipt(dstEndpoint, dstChannelID, sequence)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case !pending && received:
+		return packetAcked, nil
+	case !pending:
+		return packetTimedOut, nil
+	case !received:
+		return packetSent, nil
+	}
+
+	acked, err := queryPacketAckCommitment(dstEndpoint, dstChannelID, sequence)
+	if err != nil {
+		return "", err
+	}
+	if !acked {
+		return packetReceived, nil
+	}
+	return packetAckWritten, nil
 }
 
 func (s *IntegrationTestSuite) createConnection() {
 	s.T().Logf("connecting %s and %s chains via IBC", s.chainA.id, s.chainB.id)
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	exec, err := s.dkrPool.Client.CreateExec(docker.CreateExecOptions{
@@ -202,30 +367,43 @@ func (s *IntegrationTestSuite) createConnection() {
 }
 
 func (s *IntegrationTestSuite) createChannel() {
+	s.openTransferChannel()
+}
+
+// createFeeChannel opens a transfer channel with the ICS-29 fee middleware
+// enabled on both ends.
+func (s *IntegrationTestSuite) createFeeChannel() {
+	s.openTransferChannel(fmt.Sprintf("--channel-version=%s", feeChannelVersion))
+}
+
+// openTransferChannel inits a transfer channel between the two chains, the
+// handshake is completed by the running relayer.
+func (s *IntegrationTestSuite) openTransferChannel(extraArgs ...string) {
 	s.T().Logf("connecting %s and %s chains via IBC", s.chainA.id, s.chainB.id)
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
+	cmd := []string{
+		"hermes",
+		txCommand,
+		"chan-open-init",
+		"--dst-chain",
+		s.chainA.id,
+		"--src-chain",
+		s.chainB.id,
+		"--dst-connection",
+		"connection-0",
+		"--src-port=transfer",
+		"--dst-port=transfer",
+	}
 	exec, err := s.dkrPool.Client.CreateExec(docker.CreateExecOptions{
 		Context:      ctx,
 		AttachStdout: true,
 		AttachStderr: true,
 		Container:    s.hermesResource.Container.ID,
 		User:         "root",
-		Cmd: []string{
-			"hermes",
-			txCommand,
-			"chan-open-init",
-			"--dst-chain",
-			s.chainA.id,
-			"--src-chain",
-			s.chainB.id,
-			"--dst-connection",
-			"connection-0",
-			"--src-port=transfer",
-			"--dst-port=transfer",
-		},
+		Cmd:          append(cmd, extraArgs...),
 	})
 	s.Require().NoError(err)
 
@@ -273,7 +451,7 @@ func (s *IntegrationTestSuite) testIBCTokenTransfer() {
 				s.Require().NoError(err)
 				return balances.Len() != 0
 			},
-			time.Minute,
+			scaleTimeout(time.Minute),
 			5*time.Second,
 		)
 		for _, c := range balances {
@@ -292,7 +470,7 @@ func (s *IntegrationTestSuite) testIBCTokenTransfer() {
 				s.Require().NoError(err)
 				return balances.Len() != 0
 			},
-			time.Minute,
+			scaleTimeout(time.Minute),
 			5*time.Second,
 		)
 		for _, c := range balances {
@@ -307,6 +485,234 @@ func (s *IntegrationTestSuite) testIBCTokenTransfer() {
 	})
 }
 
+/*
+testPreloadedIBCDenom tests that the accounts of chain A start with the IBC
+voucher preloaded in genesis and that its denom trace is registered.
+Test Benchmarks:
+1. Query of the balance of the preloaded IBC denom
+2. Query of its denom trace
+*/
+func (s *IntegrationTestSuite) testPreloadedIBCDenom() {
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+
+	expTrace := ibctransfertypes.ParseDenomTrace(preloadedIBCDenomTrace)
+	holder := s.chainA.genesisAccounts[1].keyInfo.GetAddress().String()
+
+	balance, err := getSpecificBalance(chainAAPIEndpoint, holder, expTrace.IBCDenom())
+	s.Require().NoError(err)
+	s.Require().Equal(preloadedIBCDenomAmount.String(), balance.Amount.String())
+
+	trace, err := queryDenomTrace(chainAAPIEndpoint, expTrace.Hash().String())
+	s.Require().NoError(err)
+	s.Require().Equal(expTrace, trace)
+}
+
+/*
+testIBCTransferAcks tests the acknowledgements of IBC transfers.
+
+Steps:
+1. Send uatom from chain A to chain B, require a success ack
+2. Send uatom from chain A to an invalid recipient on chain B, require an error ack
+*/
+func (s *IntegrationTestSuite) testIBCTransferAcks() {
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	recipient := s.chainB.validators[0].keyInfo.GetAddress().String()
+
+	s.Run("transfer_success_ack", func() {
+		sequence := s.sendIBC(s.chainA, 0, sender, recipient, tokenAmount.String(), standardFees.String(), "")
+		s.requireAckSuccess(s.chainB, sequence, "channel-0")
+	})
+
+	s.Run("transfer_error_ack", func() {
+		// the recipient address is not decoded by the sending chain
+		invalidRecipient := strings.Replace(recipient, "cosmos", "foobar", 1)
+		sequence := s.sendIBC(s.chainA, 0, sender, invalidRecipient, tokenAmount.String(), standardFees.String(), "")
+		// the bech32 decoding error is not registered, so it has the internal
+		// error ABCI code
+		expAck := channeltypes.NewErrorAcknowledgement(errors.New("invalid recipient"))
+		s.requireAckError(s.chainB, sequence, "channel-0", expAck.GetError())
+	})
+}
+
+/*
+testIBCPacketRelay tests waiting for the relay of a transfer packet.
+
+Steps:
+1. Send uatom from chain A to chain B
+2. Wait for the packet to be received by chain B and acknowledged on chain A
+3. Verify the recipient balance of the uatom voucher increased by the amount sent
+*/
+func (s *IntegrationTestSuite) testIBCPacketRelay() {
+	s.Run("wait_for_packet_relay", func() {
+		sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+		recipient := s.chainB.validators[0].keyInfo.GetAddress().String()
+		chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+		chainBAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainB.id][0].GetHostPort("1317/tcp"))
+
+		dstChannelID, err := queryCounterpartyChannel(chainAAPIEndpoint, "channel-0")
+		s.Require().NoError(err)
+		voucherDenom := ibctransfertypes.ParseDenomTrace(fmt.Sprintf("transfer/%s/%s", dstChannelID, uatomDenom)).IBCDenom()
+		before, err := queryGaiaAllBalances(chainBAPIEndpoint, recipient)
+		s.Require().NoError(err)
+
+		sequence := s.sendIBC(s.chainA, 0, sender, recipient, tokenAmount.String(), standardFees.String(), "")
+		s.waitForPacketRelay(s.chainA, s.chainB, "channel-0", sequence)
+
+		after, err := queryGaiaAllBalances(chainBAPIEndpoint, recipient)
+		s.Require().NoError(err)
+		s.Require().Equal(before.AmountOf(voucherDenom).Add(tokenAmount.Amount).String(), after.AmountOf(voucherDenom).String())
+	})
+}
+
+func (s *IntegrationTestSuite) testIBCPacketDataSize() {
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	recipient := s.chainB.validators[0].keyInfo.GetAddress().String()
+
+	s.Run("normal_sized_packet", func() {
+		sequence := s.sendIBC(s.chainA, 0, sender, recipient, tokenAmount.String(), standardFees.String(), "")
+		s.requireAckSuccess(s.chainB, sequence, "channel-0")
+	})
+
+	s.Run("oversized_packet", func() {
+		// chain A doesn't limit the packet data size, so the packet is sent
+		// and rejected by chain B
+		memo := strings.Repeat("x", maxPacketDataBytes)
+		sequence := s.sendIBC(s.chainA, 0, sender, recipient, tokenAmount.String(), standardFees.String(), memo)
+		expAck := channeltypes.NewErrorAcknowledgement(sdkerrors.ErrInvalidRequest)
+		s.requireAckError(s.chainB, sequence, "channel-0", expAck.GetError())
+	})
+}
+
+/*
+testIBCSupplyCap tests that the transfers minting vouchers above the supply cap of their denom are rejected.
+Test Benchmarks:
+1. Transfer of uatom from chain A taking the voucher supply on chain B above its cap, rejected with an error ack
+2. Transfer of uatom from chain A taking the voucher supply on chain B to its cap, accepted
+3. Transfer of the vouchers back to chain A, unescrowing the uatom there and bringing the voucher supply under its cap
+*/
+func (s *IntegrationTestSuite) testIBCSupplyCap() {
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	chainBAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainB.id][0].GetHostPort("1317/tcp"))
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	recipient := s.chainB.validators[0].keyInfo.GetAddress().String()
+
+	// the cap of chain B is set on the vouchers received over channel-0
+	dstChannelID, err := queryCounterpartyChannel(chainAAPIEndpoint, "channel-0")
+	s.Require().NoError(err)
+	s.Require().Equal("channel-0", dstChannelID)
+	voucherDenom := ibctransfertypes.ParseDenomTrace(fmt.Sprintf("transfer/%s/%s", dstChannelID, uatomDenom)).IBCDenom()
+
+	supply, err := querySupplyOf(chainBAPIEndpoint, voucherDenom)
+	s.Require().NoError(err)
+	headroom := sdk.NewInt(uatomVoucherSupplyCap).Sub(supply)
+	s.Require().True(headroom.IsPositive(), "voucher supply %s at the cap", supply)
+
+	s.Run("transfer_over_supply_cap", func() {
+		token := sdk.NewCoin(uatomDenom, headroom.AddRaw(1))
+		sequence := s.sendIBC(s.chainA, 0, sender, recipient, token.String(), standardFees.String(), "")
+		expAck := channeltypes.NewErrorAcknowledgement(sdkerrors.ErrInvalidRequest)
+		s.requireAckError(s.chainB, sequence, "channel-0", expAck.GetError())
+
+		after, err := querySupplyOf(chainBAPIEndpoint, voucherDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(supply.String(), after.String())
+	})
+
+	s.Run("transfer_to_supply_cap", func() {
+		token := sdk.NewCoin(uatomDenom, headroom)
+		sequence := s.sendIBC(s.chainA, 0, sender, recipient, token.String(), standardFees.String(), "")
+		s.requireAckSuccess(s.chainB, sequence, "channel-0")
+
+		after, err := querySupplyOf(chainBAPIEndpoint, voucherDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt(uatomVoucherSupplyCap).String(), after.String())
+	})
+
+	s.Run("transfer_back_under_supply_cap", func() {
+		// the vouchers sent back are burnt on chain B, so that the later tests
+		// can keep transferring uatom to chain B
+		token := sdk.NewCoin(voucherDenom, headroom)
+		sequence := s.sendIBC(s.chainB, 0, recipient, sender, token.String(), standardFees.String(), "")
+		s.requireAckSuccess(s.chainA, sequence, "channel-0")
+
+		after, err := querySupplyOf(chainBAPIEndpoint, voucherDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(supply.String(), after.String())
+	})
+}
+
+/*
+testIBCIncentivizedTransfer tests that the relayer incentives paid for a packet are escrowed until the packet is
+relayed, and reported by the channel incentive fees query.
+Test Benchmarks:
+1. Opening of a fee enabled transfer channel
+2. Transfer over the channel while the relayer is paused, incentivized afterwards
+3. Verification that the incentives of the packet are reported and held by the fee module account
+4. Verification that the escrow is released once the relayer resumes and relays the packet
+*/
+func (s *IntegrationTestSuite) testIBCIncentivizedTransfer() {
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	sender := s.chainA.validators[0].keyInfo.GetAddress().String()
+	recipient := s.chainB.validators[0].keyInfo.GetAddress().String()
+	escrowAddress := authtypes.NewModuleAddress(ibcfeetypes.ModuleName).String()
+
+	s.createFeeChannel()
+	var channelID string
+	s.Require().Eventually(
+		func() bool {
+			channels, err := queryIncentivizedChannels(chainAAPIEndpoint)
+			s.Require().NoError(err)
+			if len(channels) == 0 {
+				return false
+			}
+			channelID = channels[0].ChannelId
+			open, err := queryChannelOpen(chainAAPIEndpoint, channelID)
+			s.Require().NoError(err)
+			return open
+		},
+		scaleTimeout(time.Minute),
+		5*time.Second,
+	)
+
+	// the relayer is paused so that the packet stays incentivized until the
+	// escrow is checked
+	s.Require().NoError(s.dkrPool.Client.PauseContainer(s.hermesResource.Container.ID))
+	sequence := s.sendIBCOverChannel(s.chainA, 0, channelID, sender, recipient, tokenAmount.String(), standardFees.String(), "")
+	recvFee, ackFee, timeoutFee := sdk.NewInt64Coin(uatomDenom, 300), sdk.NewInt64Coin(uatomDenom, 200), sdk.NewInt64Coin(uatomDenom, 100)
+	s.execPayPacketFee(s.chainA, 0, sender, channelID, sequence, recvFee, ackFee, timeoutFee)
+
+	escrowed := sdk.NewCoins(recvFee.Add(ackFee).Add(timeoutFee))
+	res, err := queryChannelIncentiveFees(chainAAPIEndpoint, channelID)
+	s.Require().NoError(err)
+	s.Require().Equal([]gaiaquerytypes.PacketIncentive{{
+		Sequence:   sequence,
+		RecvFee:    sdk.NewCoins(recvFee),
+		AckFee:     sdk.NewCoins(ackFee),
+		TimeoutFee: sdk.NewCoins(timeoutFee),
+		Escrowed:   escrowed,
+		Payers:     1,
+	}}, res.Packets)
+	s.Require().Equal(escrowed.String(), res.Escrowed.String())
+	escrowBalance, err := getSpecificBalance(chainAAPIEndpoint, escrowAddress, uatomDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(escrowed.AmountOf(uatomDenom).String(), escrowBalance.Amount.String())
+
+	// the incentives are paid out or refunded once the ack is relayed back
+	s.Require().NoError(s.dkrPool.Client.UnpauseContainer(s.hermesResource.Container.ID))
+	s.Require().Eventually(
+		func() bool {
+			res, err := queryChannelIncentiveFees(chainAAPIEndpoint, channelID)
+			s.Require().NoError(err)
+			return len(res.Packets) == 0 && res.Escrowed.IsZero()
+		},
+		scaleTimeout(2*time.Minute),
+		5*time.Second,
+	)
+	escrowBalance, err = getSpecificBalance(chainAAPIEndpoint, escrowAddress, uatomDenom)
+	s.Require().NoError(err)
+	s.Require().True(escrowBalance.IsNil() || escrowBalance.IsZero())
+}
+
 /*
 TestMultihopIBCTokenTransfer tests that sending an IBC transfer using the IBC Packet Forward Middleware accepts a port, channel and account address
 
@@ -361,7 +767,7 @@ func (s *IntegrationTestSuite) testMultihopIBCTokenTransfer() {
 
 				return beforeSenderUAtomBalance.IsValid() && beforeRecipientUAtomBalance.IsValid()
 			},
-			1*time.Minute,
+			scaleTimeout(1*time.Minute),
 			5*time.Second,
 		)
 
@@ -391,7 +797,7 @@ func (s *IntegrationTestSuite) testMultihopIBCTokenTransfer() {
 
 				return decremented && incremented
 			},
-			1*time.Minute,
+			scaleTimeout(1*time.Minute),
 			5*time.Second,
 		)
 	})
@@ -433,7 +839,7 @@ func (s *IntegrationTestSuite) testFailedMultihopIBCTokenTransfer() {
 
 				return beforeSenderUAtomBalance.IsValid()
 			},
-			1*time.Minute,
+			scaleTimeout(1*time.Minute),
 			5*time.Second,
 		)
 
@@ -460,7 +866,7 @@ func (s *IntegrationTestSuite) testFailedMultihopIBCTokenTransfer() {
 
 				return returned
 			},
-			1*time.Minute,
+			scaleTimeout(1*time.Minute),
 			1*time.Second,
 		)
 
@@ -474,7 +880,7 @@ func (s *IntegrationTestSuite) testFailedMultihopIBCTokenTransfer() {
 
 				return returned
 			},
-			5*time.Minute,
+			scaleTimeout(5*time.Minute),
 			5*time.Second,
 		)
 	})
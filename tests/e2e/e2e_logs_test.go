@@ -0,0 +1,31 @@
+package e2e
+
+import (
+	"encoding/json"
+
+	tmconfig "github.com/tendermint/tendermint/config"
+)
+
+// testValidatorJSONLogs tests that the validators configured with the json
+// log format log json objects, and the other validators do not.
+func (s *IntegrationTestSuite) testValidatorJSONLogs() {
+	c := s.chainB
+	jsonValIdx := 1
+	s.Require().Equal(tmconfig.LogFormatJSON, c.validatorLogFormat(jsonValIdx))
+	s.Require().Equal(tmconfig.LogFormatPlain, c.validatorLogFormat(0))
+
+	lines, err := s.validatorLogs(c, jsonValIdx)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(lines)
+	for _, line := range lines {
+		var entry map[string]interface{}
+		s.Require().NoError(json.Unmarshal([]byte(line), &entry), "log line is not json: %s", line)
+		s.Require().Contains(entry, "level", "log line has no level: %s", line)
+	}
+
+	lines, err = s.validatorLogs(c, 0)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(lines)
+	var entry map[string]interface{}
+	s.Require().Error(json.Unmarshal([]byte(lines[len(lines)-1]), &entry))
+}
@@ -0,0 +1,58 @@
+package e2e
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// testMultisigSend sends tokens from the 2-of-3 multisig account of chain A,
+// signed by two of its keys then by a single one.
+func (s *IntegrationTestSuite) testMultisigSend() {
+	s.Run("send_from_2_of_3_multisig", func() {
+		c := s.chainA
+		msig := c.multisigAccounts[0]
+		recipient := AccAddress()
+		chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+
+		sendFromMultisig := func(signers ...int) *sdk.TxResponse {
+			acc, err := queryAccount(chainAAPIEndpoint, msig.address().String())
+			s.Require().NoError(err)
+
+			txBytes, err := signMultisigTx(
+				c.id,
+				msig,
+				signers,
+				acc.GetAccountNumber(),
+				acc.GetSequence(),
+				sdk.NewCoins(standardFees),
+				200_000,
+				banktypes.NewMsgSend(msig.address(), recipient, sdk.NewCoins(tokenAmount)),
+			)
+			s.Require().NoError(err)
+
+			res, err := broadcastTx(chainAAPIEndpoint, txBytes)
+			s.Require().NoError(err)
+			return res
+		}
+
+		// two signatures reach the threshold
+		res := sendFromMultisig(0, 2)
+		s.Require().Zero(res.Code, res.RawLog)
+
+		// the tx is included in a block once broadcast
+		balance, err := getSpecificBalance(chainAAPIEndpoint, recipient.String(), uatomDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(tokenAmount.String(), balance.String())
+
+		// a single signature is under the threshold
+		res = sendFromMultisig(1)
+		s.Require().Equal(sdkerrors.ErrUnauthorized.ABCICode(), res.Code, res.RawLog)
+
+		balance, err = getSpecificBalance(chainAAPIEndpoint, recipient.String(), uatomDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(tokenAmount.String(), balance.String())
+	})
+}
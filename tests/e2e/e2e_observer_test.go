@@ -0,0 +1,98 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+)
+
+/*
+testObserverQueries tests that an observer, a full node syncing the chain without a gentx, takes no
+part in consensus and serves the same state as the validators.
+Test Benchmarks:
+1. Validation that the observer has no voting power and is not in the validator set
+2. Validation that the observer reaches the height of the validators
+3. Verification that the observer and a validator have the same block and app hash at that height
+4. Verification that the queries to the observer return the same state as the queries to a validator
+*/
+func (s *IntegrationTestSuite) testObserverQueries() {
+	c := s.chainA
+	s.Require().NotEmpty(c.observers)
+	observer := c.observers[0]
+	observerResource := s.observerResources[c.id][0]
+	validatorResource := s.valResources[c.id][0]
+
+	observerAPI := fmt.Sprintf("http://%s", observerResource.GetHostPort("1317/tcp"))
+	validatorAPI := fmt.Sprintf("http://%s", validatorResource.GetHostPort("1317/tcp"))
+	observerRPC, err := rpchttp.New(fmt.Sprintf("tcp://%s", observerResource.GetHostPort("26657/tcp")), "/websocket")
+	s.Require().NoError(err)
+	validatorRPC, err := rpchttp.New(fmt.Sprintf("tcp://%s", validatorResource.GetHostPort("26657/tcp")), "/websocket")
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
+	defer cancel()
+
+	status, err := observerRPC.Status(ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(status.ValidatorInfo.VotingPower)
+	valSet, err := validatorRPC.Validators(ctx, nil, nil, nil)
+	s.Require().NoError(err)
+	for _, val := range valSet.Validators {
+		s.Require().False(bytes.Equal(val.Address, observer.consensusKey.Address), "observer %s is a validator", observer.instanceName())
+	}
+
+	// the observer reaches the height of the validators
+	height, _, err := queryLatestBlockTime(validatorAPI)
+	s.Require().NoError(err)
+	s.Require().Eventually(
+		func() bool {
+			observerHeight, _, err := queryLatestBlockTime(observerAPI)
+			return err == nil && observerHeight >= height
+		},
+		scaleTimeout(time.Minute),
+		time.Second,
+		"observer %s did not reach height %d", observer.instanceName(), height,
+	)
+
+	// the block of the height, and so the app hash of the previous one, is
+	// the same on both nodes
+	observerBlock, err := observerRPC.Block(ctx, &height)
+	s.Require().NoError(err)
+	validatorBlock, err := validatorRPC.Block(ctx, &height)
+	s.Require().NoError(err)
+	s.Require().Equal(validatorBlock.BlockID.Hash, observerBlock.BlockID.Hash)
+	s.Require().Equal(validatorBlock.Block.AppHash, observerBlock.Block.AppHash)
+
+	// the queries return the same state, the observer possibly trailing the
+	// validator by a block
+	account := c.genesisAccounts[3].keyInfo.GetAddress().String()
+	s.Require().Eventually(
+		func() bool {
+			validatorBalances, err := queryGaiaAllBalances(validatorAPI, account)
+			s.Require().NoError(err)
+			observerBalances, err := queryGaiaAllBalances(observerAPI, account)
+			if err != nil || observerBalances.String() != validatorBalances.String() {
+				return false
+			}
+
+			validatorVals, err := queryValidators(validatorAPI)
+			s.Require().NoError(err)
+			observerVals, err := queryValidators(observerAPI)
+			if err != nil || len(observerVals) != len(validatorVals) {
+				return false
+			}
+			for i := range validatorVals {
+				if observerVals[i].OperatorAddress != validatorVals[i].OperatorAddress || !observerVals[i].Tokens.Equal(validatorVals[i].Tokens) {
+					return false
+				}
+			}
+			return true
+		},
+		scaleTimeout(time.Minute),
+		time.Second,
+		"observer %s and validator %s disagree on the state of chain %s", observer.instanceName(), c.validators[0].instanceName(), c.id,
+	)
+}
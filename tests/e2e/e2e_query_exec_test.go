@@ -17,7 +17,7 @@ func (s *IntegrationTestSuite) execQueryConsumerChains(
 	queryValidation func(res ccvtypes.QueryConsumerChainsResponse, consumerChainId string) bool,
 	consumerChainID string,
 ) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Minute))
 	defer cancel()
 
 	s.T().Logf("Querying consumer chains for chain: %s", c.id)
@@ -1,6 +1,7 @@
 package e2e
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -27,7 +28,10 @@ import (
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramsutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	ibcchanneltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"github.com/ory/dockertest/v3"
@@ -40,6 +44,8 @@ import (
 	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
 
 	"github.com/cosmos/gaia/v9/app/params"
+	govschedulecli "github.com/cosmos/gaia/v9/x/govschedule/client/cli"
+	recurringspendcli "github.com/cosmos/gaia/v9/x/recurringspend/client/cli"
 )
 
 const (
@@ -62,9 +68,21 @@ const (
 	relayerAccountIndex          = 0
 	numberOfEvidences            = 10
 	slashingShares         int64 = 10000
-
-	proposalGlobalFeeFilename           = "proposal_globalfee.json"
+	unbondingTime                = 2 * time.Minute
+	govDepositPeriod             = time.Minute
+	shortGovVotingPeriod         = 10 * time.Second
+	highGovQuorum                = "0.9"
+	lowMaxActiveProposals        = 2
+	maxPacketDataBytes           = 1024
+	uatomVoucherSupplyCap  int64 = 1_000_000_000_000_000
+	maxBlockGas            int64 = 2_000_000
+	maxBlockBytes          int64 = 2_097_152
+	defaultLogLevel              = "info"
+
+	proposalParamChangeFilename         = "proposal_param_change.json"
 	proposalCommunitySpendFilename      = "proposal_community_spend.json"
+	proposalRecurringSpendFilename      = "proposal_recurring_spend.json"
+	proposalScheduledSpendFilename      = "proposal_scheduled_spend.json"
 	proposalAddConsumerChainFilename    = "proposal_add_consumer.json"
 	proposalRemoveConsumerChainFilename = "proposal_remove_consumer.json"
 )
@@ -72,12 +90,16 @@ const (
 var (
 	gaiaConfigPath    = filepath.Join(gaiaHomePath, "config")
 	stakingAmount     = sdk.NewInt(100000000000)
-	stakingAmountCoin = sdk.NewCoin(uatomDenom, stakingAmount)
 	tokenAmount       = sdk.NewCoin(uatomDenom, sdk.NewInt(3300000000)) // 3,300uatom
 	standardFees      = sdk.NewCoin(uatomDenom, sdk.NewInt(330000))     // 0.33uatom
 	depositAmount     = sdk.NewCoin(uatomDenom, sdk.NewInt(330000000))  // 3,300uatom
 	distModuleAddress = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
 	proposalCounter   = 0
+	// initialDepositAmount is the deposit the proposals are submitted with,
+	// enough for the gov ante handler but below the min deposit
+	initialDepositAmount = sdk.NewCoin(uatomDenom, sdk.NewInt(1000))
+	// preloadedCommunityPool is the community pool of chain A in genesis
+	preloadedCommunityPool = sdk.NewCoin(uatomDenom, sdk.NewInt(1_000_000_000)) // 1,000atom
 )
 
 type IntegrationTestSuite struct {
@@ -90,6 +112,10 @@ type IntegrationTestSuite struct {
 	dkrNet         *dockertest.Network
 	hermesResource *dockertest.Resource
 	valResources   map[string][]*dockertest.Resource
+	// containers of the observers by chain id, in the order of the observers
+	observerResources map[string][]*dockertest.Resource
+	// assertions run once the chain restarted after an upgrade
+	postUpgradeAssertions []postUpgradeAssertion
 }
 
 type AddressResponse struct {
@@ -107,11 +133,78 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	s.T().Log("setting up e2e integration test suite...")
 
 	var err error
+	timeoutMultiplier, err = parseTimeoutMultiplier(os.Getenv(timeoutMultiplierEnv))
+	s.Require().NoError(err)
 	s.chainA, err = newChain()
 	s.Require().NoError(err)
+	// the second validator of chain A charges a higher commission than the
+	// default so that distribution tests can verify commission splits
+	s.chainA.setValidatorCommission(1, "0.5", "0.6", "0.05")
+	// the second validator of chain A has a custom description so that
+	// staking tests can verify it is set by its gentx
+	s.chainA.setValidatorDescription(1, customValidatorMoniker, customValidatorIdentity, customValidatorWebsite)
+	// a short unbonding time lets staking tests wait for unbondings to complete
+	s.chainA.unbondingTime = unbondingTime
+	// the community pool spend proposals of chain A are capped so that gov
+	// tests can verify the proposals above the cap are rejected
+	s.chainA.setMaxSpendFraction("0.5")
+	// a share of the fees of chain A goes to the grants pool so that the
+	// grants pool tests can verify it grows with the fees
+	s.chainA.setGrantsPoolFeeShare("0.1")
+	// chain A starts with a funded community pool so that gov tests can spend
+	// from it right away
+	s.chainA.setCommunityPool(sdk.NewCoins(preloadedCommunityPool))
+	// a short deposit period lets gov tests wait for the proposals without
+	// enough deposit to be dropped
+	s.chainA.setGovDepositParams(sdk.NewCoins(sdk.NewCoin(uatomDenom, govMinDepositAmount)), govDepositPeriod)
+	// the first validator of chain A holds 75% of the voting power and the
+	// proposals need a majority of yes votes, so that gov tests can verify the
+	// majority validator alone decides the outcome of a proposal
+	s.chainA.setValidatorStakingAmount(0, stakingAmount.MulRaw(3))
+	s.chainA.setGovTallyParams(govtypes.DefaultQuorum.String(), govtypes.DefaultThreshold.String())
+	// the blocks of chain A have a max gas so that globalfee tests can fill
+	// them and observe the dynamic global fees rise, and a max size below the
+	// default so that the e2e txs, relayed ones included, run under a block
+	// size limit
+	s.chainA.setBlockParams(maxBlockBytes, maxBlockGas)
+	// chain A starts with an IBC voucher so that tests can use an IBC denom
+	// without transferring it first
+	s.chainA.preloadIBCDenom(preloadedIBCDenomTrace, preloadedIBCDenomAmount)
+	// the gov deposits of chain A can be paid in the preloaded IBC voucher on
+	// top of uatom, so that gov tests can verify the deposits in the other
+	// denoms are rejected
+	s.chainA.setDepositDenoms(uatomDenom, ibctransfertypes.ParseDenomTrace(preloadedIBCDenomTrace).IBCDenom())
+	// chain A has an observer, so that tests can query a node taking no part
+	// in consensus
+	s.chainA.setObserverCount(1)
 
 	s.chainB, err = newChain()
 	s.Require().NoError(err)
+	// the second validator of chain B requires higher fees than the other
+	// validators so that tests can observe divergent tx admission
+	s.chainB.setValidatorMinGasPrice(1, highGlobalFeeAmt)
+	// the second validator of chain B logs in json so that tests can parse
+	// its logs
+	s.chainB.setValidatorLogConfig(1, defaultLogLevel, tmconfig.LogFormatJSON)
+	// the second validator of chain B serves neither the REST API nor gRPC so
+	// that tests can observe a chain progressing with such nodes
+	s.chainB.setValidatorAPIConfig(1, false, false)
+	// chain B uses a known fee split so that distribution tests can assert
+	// the exact allocation of a tx fee
+	s.chainB.setDistributionParams("0.5", "0.1", "0.04")
+	// the proposals of chain B end quickly and need 90% of the voting power to
+	// vote, so that gov tests can verify a proposal fails for lack of quorum
+	s.chainB.setGovVotingPeriod(shortGovVotingPeriod)
+	s.chainB.setGovTallyParams(highGovQuorum, govtypes.DefaultThreshold.String())
+	// chain B allows a couple of active proposals only, so that gov tests can
+	// verify the proposals over the cap are rejected
+	s.chainB.setMaxActiveProposals(lowMaxActiveProposals)
+	// chain B limits the size of the IBC packet data, unlike chain A, so that
+	// IBC tests can verify the larger packets sent by chain A are rejected
+	s.chainB.setMaxPacketDataBytes(maxPacketDataBytes)
+	// chain B caps the supply of the uatom vouchers of chain A, so that IBC
+	// tests can verify the transfers minting vouchers above it are rejected
+	s.chainB.setSupplyCaps(sdk.NewCoins(sdk.NewInt64Coin(ibctransfertypes.ParseDenomTrace(fmt.Sprintf("transfer/channel-0/%s", uatomDenom)).IBCDenom(), uatomVoucherSupplyCap)))
 
 	s.dkrPool, err = dockertest.NewPool("")
 	s.Require().NoError(err)
@@ -120,6 +213,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 
 	s.valResources = make(map[string][]*dockertest.Resource)
+	s.observerResources = make(map[string][]*dockertest.Resource)
 
 	vestingMnemonic, err := createMnemonic()
 	s.Require().NoError(err)
@@ -131,20 +225,24 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	//
 	// 1. Initialize Gaia validator nodes.
 	// 2. Create and initialize Gaia validator genesis files (both chains)
-	// 3. Start both networks.
+	// 3. Start both networks, then their observers.
 	// 4. Create and run IBC relayer (Hermes) containers.
 
 	s.T().Logf("starting e2e infrastructure for chain A; chain-id: %s; datadir: %s", s.chainA.id, s.chainA.dataDir)
 	s.initNodes(s.chainA)
 	s.initGenesis(s.chainA, vestingMnemonic, jailedValMnemonic)
 	s.initValidatorConfigs(s.chainA)
+	s.initObserverConfigs(s.chainA)
 	s.runValidators(s.chainA, 0)
+	s.runObservers(s.chainA, 0)
 
 	s.T().Logf("starting e2e infrastructure for chain B; chain-id: %s; datadir: %s", s.chainB.id, s.chainB.dataDir)
 	s.initNodes(s.chainB)
 	s.initGenesis(s.chainB, vestingMnemonic, jailedValMnemonic)
 	s.initValidatorConfigs(s.chainB)
+	s.initObserverConfigs(s.chainB)
 	s.runValidators(s.chainB, 10)
+	s.runObservers(s.chainB, 10)
 
 	time.Sleep(10 * time.Second)
 	s.runIBCRelayer()
@@ -169,6 +267,11 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 			s.Require().NoError(s.dkrPool.Purge(r))
 		}
 	}
+	for _, resources := range s.observerResources {
+		for _, r := range resources {
+			s.Require().NoError(s.dkrPool.Purge(r))
+		}
+	}
 
 	s.Require().NoError(s.dkrPool.RemoveNetwork(s.dkrNet))
 
@@ -182,6 +285,7 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 
 func (s *IntegrationTestSuite) initNodes(c *chain) {
 	s.Require().NoError(c.createAndInitValidators(2))
+	s.Require().NoError(c.createAndInitObservers())
 	/* Adding 4 accounts to val0 local directory
 	c.genesisAccounts[0]: Relayer Wallet
 	c.genesisAccounts[1]: ICA Owner
@@ -189,8 +293,15 @@ func (s *IntegrationTestSuite) initNodes(c *chain) {
 	c.genesisAccounts[3]: Test Account 2
 	*/
 	s.Require().NoError(c.addAccountFromMnemonic(4))
+	// a 2-of-3 multisig account, c.multisigAccounts[0]
+	_, err := c.addMultisigAccount("multisig", 2, 3)
+	s.Require().NoError(err)
 	// Initialize a genesis file for the first validator
 	val0ConfigDir := c.validators[0].configDir()
+	if genesisFile := os.Getenv(customGenesisFileEnv); len(genesisFile) > 0 {
+		s.T().Logf("starting chain %s from the custom genesis %s", c.id, genesisFile)
+		s.Require().NoError(useCustomGenesis(val0ConfigDir, genesisFile, c.id))
+	}
 	var addrAll []sdk.AccAddress
 	for _, val := range c.validators {
 		address := val.keyInfo.GetAddress()
@@ -202,8 +313,12 @@ func (s *IntegrationTestSuite) initNodes(c *chain) {
 		addrAll = append(addrAll, acctAddr)
 	}
 
+	for _, msig := range c.multisigAccounts {
+		addrAll = append(addrAll, msig.address())
+	}
+
 	s.Require().NoError(
-		modifyGenesis(val0ConfigDir, "", initBalanceStr, addrAll, initialGlobalFeeAmt+uatomDenom, uatomDenom),
+		modifyGenesis(val0ConfigDir, "", initBalanceStr, addrAll, initialGlobalFeeAmt+uatomDenom, uatomDenom, c.genesisMutators()...),
 	)
 	// copy the genesis file to the remaining validators
 	for _, val := range c.validators[1:] {
@@ -378,7 +493,7 @@ func (s *IntegrationTestSuite) initGenesis(c *chain, vestingMnemonic, jailedValM
 	)
 
 	config.SetRoot(validator.configDir())
-	config.Moniker = validator.moniker
+	config.Moniker = validator.description.Moniker
 
 	genFilePath := config.GenesisFile()
 	appGenState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFilePath)
@@ -417,7 +532,11 @@ func (s *IntegrationTestSuite) initGenesis(c *chain, vestingMnemonic, jailedValM
 	// generate genesis txs
 	genTxs := make([]json.RawMessage, len(c.validators))
 	for i, val := range c.validators {
-		createValmsg, err := val.buildCreateValidatorMsg(stakingAmountCoin)
+		amount := stakingAmount
+		if selfDelegation, ok := c.stakingAmounts[i]; ok {
+			amount = selfDelegation
+		}
+		createValmsg, err := val.buildCreateValidatorMsg(sdk.NewCoin(uatomDenom, amount))
 		s.Require().NoError(err)
 		signedTx, err := val.signMsg(createValmsg)
 
@@ -457,6 +576,12 @@ func (s *IntegrationTestSuite) initGenesis(c *chain, vestingMnemonic, jailedValM
 		err = writeFile(filepath.Join(val.configDir(), rawTxFile), rawTx)
 		s.Require().NoError(err)
 	}
+
+	// the observers sync the chain from the same genesis
+	for _, observer := range c.observers {
+		err = writeFile(filepath.Join(observer.configDir(), "config", "genesis.json"), bz)
+		s.Require().NoError(err)
+	}
 }
 
 // initValidatorConfigs initializes the validator configs for the given chain.
@@ -477,7 +602,11 @@ func (s *IntegrationTestSuite) initValidatorConfigs(c *chain) {
 		valConfig.P2P.ExternalAddress = fmt.Sprintf("%s:%d", val.instanceName(), 26656)
 		valConfig.RPC.ListenAddress = "tcp://0.0.0.0:26657"
 		valConfig.StateSync.Enable = false
-		valConfig.LogLevel = "info"
+		valConfig.LogLevel = c.validatorLogLevel(i)
+		valConfig.LogFormat = c.validatorLogFormat(i)
+		if c.timeoutCommit > 0 {
+			valConfig.Consensus.TimeoutCommit = c.timeoutCommit
+		}
 
 		var peers []string
 
@@ -499,8 +628,9 @@ func (s *IntegrationTestSuite) initValidatorConfigs(c *chain) {
 		appCfgPath := filepath.Join(val.configDir(), "config", "app.toml")
 
 		appConfig := srvconfig.DefaultConfig()
-		appConfig.API.Enable = true
-		appConfig.MinGasPrices = fmt.Sprintf("%s%s", minGasPrice, uatomDenom)
+		appConfig.API.Enable = c.validatorAPIEnabled(i)
+		appConfig.GRPC.Enable = c.validatorGRPCEnabled(i)
+		appConfig.MinGasPrices = fmt.Sprintf("%s%s", c.validatorMinGasPrice(i), uatomDenom)
 
 		//	 srvconfig.WriteConfigFile(appCfgPath, appConfig)
 		appCustomConfig := params.CustomAppConfig{
@@ -524,12 +654,57 @@ func (s *IntegrationTestSuite) initValidatorConfigs(c *chain) {
 # Example:
 # ["/ibc.core.channel.v1.MsgRecvPacket", "/ibc.core.channel.v1.MsgAcknowledgement", ...]
 bypass-min-fee-msg-types = ["/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward","/ibc.applications.transfer.v1.MsgTransfer"]
+
+# query-indexes defines the indexes the node keeps of the blocks it delivers.
+query-indexes = ["rewards", "relays", "transfers", "fees-paid", "fee-rejections", "gas-prices", "min-gas-price-timeline", "bypass-rate"]
 ` + srvconfig.DefaultConfigTemplate
 		srvconfig.SetConfigTemplate(customAppTemplate)
 		srvconfig.WriteConfigFile(appCfgPath, appCustomConfig)
 	}
 }
 
+// initObserverConfigs initializes the configs of the observers of the given
+// chain. The observers peer with all the validators and serve the REST API.
+func (s *IntegrationTestSuite) initObserverConfigs(c *chain) {
+	peers := make([]string, 0, len(c.validators))
+	for j, peer := range c.validators {
+		peers = append(peers, fmt.Sprintf("%s@%s%d:26656", peer.nodeKey.ID(), peer.moniker, j))
+	}
+
+	for _, observer := range c.observers {
+		tmCfgPath := filepath.Join(observer.configDir(), "config", "config.toml")
+
+		vpr := viper.New()
+		vpr.SetConfigFile(tmCfgPath)
+		s.Require().NoError(vpr.ReadInConfig())
+
+		observerConfig := tmconfig.DefaultConfig()
+
+		s.Require().NoError(vpr.Unmarshal(observerConfig))
+
+		observerConfig.P2P.ListenAddress = "tcp://0.0.0.0:26656"
+		observerConfig.P2P.AddrBookStrict = false
+		observerConfig.P2P.ExternalAddress = fmt.Sprintf("%s:%d", observer.instanceName(), 26656)
+		observerConfig.P2P.PersistentPeers = strings.Join(peers, ",")
+		observerConfig.RPC.ListenAddress = "tcp://0.0.0.0:26657"
+		observerConfig.StateSync.Enable = false
+		if c.timeoutCommit > 0 {
+			observerConfig.Consensus.TimeoutCommit = c.timeoutCommit
+		}
+
+		tmconfig.WriteConfigFile(tmCfgPath, observerConfig)
+
+		// the observers take no txs of their own, the app.toml defaults are
+		// enough
+		appConfig := srvconfig.DefaultConfig()
+		appConfig.API.Enable = true
+		appConfig.MinGasPrices = fmt.Sprintf("%s%s", minGasPrice, uatomDenom)
+
+		srvconfig.SetConfigTemplate(srvconfig.DefaultConfigTemplate)
+		srvconfig.WriteConfigFile(filepath.Join(observer.configDir(), "config", "app.toml"), appConfig)
+	}
+}
+
 // runValidators runs the validators in the chain
 func (s *IntegrationTestSuite) runValidators(c *chain, portOffset int) {
 	s.T().Logf("starting Gaia %s validator containers...", c.id)
@@ -570,12 +745,12 @@ func (s *IntegrationTestSuite) runValidators(c *chain, portOffset int) {
 		s.T().Logf("started Gaia %s validator container: %s", c.id, resource.Container.ID)
 	}
 
-	rpcClient, err := rpchttp.New("tcp://localhost:26657", "/websocket")
+	rpcClient, err := rpchttp.New(fmt.Sprintf("tcp://localhost:%d", 26657+portOffset), "/websocket")
 	s.Require().NoError(err)
 
 	s.Require().Eventually(
 		func() bool {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(time.Second*5))
 			defer cancel()
 
 			status, err := rpcClient.Status(ctx)
@@ -590,12 +765,76 @@ func (s *IntegrationTestSuite) runValidators(c *chain, portOffset int) {
 
 			return true
 		},
-		5*time.Minute,
+		scaleTimeout(5*time.Minute),
 		time.Second,
 		"Gaia node failed to produce blocks",
 	)
 }
 
+// runObservers runs the observers of the chain and waits for them to catch up
+// with the validators. The REST API and the RPC of the observers are exposed
+// on the ports following the ones of the first validator.
+func (s *IntegrationTestSuite) runObservers(c *chain, portOffset int) {
+	if len(c.observers) == 0 {
+		return
+	}
+	s.T().Logf("starting Gaia %s observer containers...", c.id)
+
+	s.observerResources[c.id] = make([]*dockertest.Resource, len(c.observers))
+	for i, observer := range c.observers {
+		runOpts := &dockertest.RunOptions{
+			Name:      observer.instanceName(),
+			NetworkID: s.dkrNet.Network.ID,
+			Mounts: []string{
+				fmt.Sprintf("%s/:%s", observer.configDir(), gaiaHomePath),
+			},
+			Repository: "cosmos/gaiad-e2e",
+			PortBindings: map[docker.Port][]docker.PortBinding{
+				"1317/tcp":  {{HostIP: "", HostPort: fmt.Sprintf("%d", 1318+portOffset+i)}},
+				"26657/tcp": {{HostIP: "", HostPort: fmt.Sprintf("%d", 26658+portOffset+i)}},
+			},
+		}
+
+		s.Require().NoError(exec.Command("chmod", "-R", "0777", observer.configDir()).Run()) //nolint:gosec // this is a test
+
+		resource, err := s.dkrPool.RunWithOptions(runOpts, noRestart)
+		s.Require().NoError(err)
+
+		s.observerResources[c.id][i] = resource
+		s.T().Logf("started Gaia %s observer container: %s", c.id, resource.Container.ID)
+	}
+
+	valClient, err := rpchttp.New(fmt.Sprintf("tcp://localhost:%d", 26657+portOffset), "/websocket")
+	s.Require().NoError(err)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(5*time.Second))
+	defer cancel()
+	valStatus, err := valClient.Status(ctx)
+	s.Require().NoError(err)
+
+	for i, resource := range s.observerResources[c.id] {
+		rpcClient, err := rpchttp.New(fmt.Sprintf("tcp://%s", resource.GetHostPort("26657/tcp")), "/websocket")
+		s.Require().NoError(err)
+
+		s.Require().Eventually(
+			func() bool {
+				ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(5*time.Second))
+				defer cancel()
+
+				status, err := rpcClient.Status(ctx)
+				if err != nil {
+					return false
+				}
+
+				// the observer syncs the blocks produced before it started
+				return !status.SyncInfo.CatchingUp && status.SyncInfo.LatestBlockHeight >= valStatus.SyncInfo.LatestBlockHeight
+			},
+			scaleTimeout(5*time.Minute),
+			time.Second,
+			"Gaia observer %d failed to catch up with chain %s", i, c.id,
+		)
+	}
+}
+
 func noRestart(config *docker.HostConfig) {
 	// in this case we don't want the nodes to restart on failure
 	config.RestartPolicy = docker.RestartPolicy{
@@ -603,35 +842,18 @@ func noRestart(config *docker.HostConfig) {
 	}
 }
 
-func (s *IntegrationTestSuite) writeGovParamChangeProposalGlobalFees(c *chain, coins sdk.DecCoins) {
-	type ParamInfo struct {
-		Subspace string       `json:"subspace"`
-		Key      string       `json:"key"`
-		Value    sdk.DecCoins `json:"value"`
-	}
-
-	type ParamChangeMessage struct {
-		Title       string      `json:"title"`
-		Description string      `json:"description"`
-		Changes     []ParamInfo `json:"changes"`
-		Deposit     string      `json:"deposit"`
-	}
-
-	paramChangeProposalBody, err := json.MarshalIndent(ParamChangeMessage{
-		Title:       "global fee test",
-		Description: "global fee change",
-		Changes: []ParamInfo{
-			{
-				Subspace: "globalfee",
-				Key:      "MinimumGasPricesParam",
-				Value:    coins,
-			},
-		},
-		Deposit: "1000uatom",
+// writeGovParamChangeProposal writes a proposal changing the param of the
+// given subspace and key to the given amino JSON value.
+func (s *IntegrationTestSuite) writeGovParamChangeProposal(c *chain, subspace, key string, value json.RawMessage) {
+	paramChangeProposalBody, err := json.MarshalIndent(paramsutils.ParamChangeProposalJSON{
+		Title:       fmt.Sprintf("change %s %s", subspace, key),
+		Description: fmt.Sprintf("set the %s param of %s to %s", key, subspace, value),
+		Changes:     paramsutils.ParamChangesJSON{paramsutils.NewParamChangeJSON(subspace, key, value)},
+		Deposit:     initialDepositAmount.String(),
 	}, "", " ")
 	s.Require().NoError(err)
 
-	err = writeFile(filepath.Join(c.validators[0].configDir(), "config", proposalGlobalFeeFilename), paramChangeProposalBody)
+	err = writeFile(filepath.Join(c.validators[0].configDir(), "config", proposalParamChangeFilename), paramChangeProposalBody)
 	s.Require().NoError(err)
 }
 
@@ -641,7 +863,7 @@ func (s *IntegrationTestSuite) writeGovCommunitySpendProposal(c *chain, amount s
 		Description: "Fund Team!",
 		Recipient:   recipient,
 		Amount:      amount,
-		Deposit:     "1000uatom",
+		Deposit:     initialDepositAmount.String(),
 	}
 	commSpendBody, err := json.MarshalIndent(proposalCommSpend, "", " ")
 	s.Require().NoError(err)
@@ -650,6 +872,43 @@ func (s *IntegrationTestSuite) writeGovCommunitySpendProposal(c *chain, amount s
 	s.Require().NoError(err)
 }
 
+func (s *IntegrationTestSuite) writeGovRecurringCommunitySpendProposal(c *chain, amount string, recipient string, interval uint64, endHeight int64) {
+	proposal := &recurringspendcli.RecurringCommunityPoolSpendProposalJSON{
+		Title:       "Recurring Community Pool Spend",
+		Description: "Fund Team every few blocks!",
+		Recipient:   recipient,
+		Amount:      amount,
+		Interval:    interval,
+		EndHeight:   endHeight,
+		Deposit:     initialDepositAmount.String(),
+	}
+	body, err := json.MarshalIndent(proposal, "", " ")
+	s.Require().NoError(err)
+
+	err = writeFile(filepath.Join(c.validators[0].configDir(), "config", proposalRecurringSpendFilename), body)
+	s.Require().NoError(err)
+}
+
+// writeGovScheduledCommunitySpendProposal writes a scheduled proposal whose
+// content is a community pool spend executed after the given height.
+func (s *IntegrationTestSuite) writeGovScheduledCommunitySpendProposal(c *chain, amount sdk.Coins, recipient sdk.AccAddress, executeAfterHeight int64) {
+	content, err := cdc.MarshalInterfaceJSON(distrtypes.NewCommunityPoolSpendProposal("Community Pool Spend", "Fund Team!", recipient, amount))
+	s.Require().NoError(err)
+
+	proposal := &govschedulecli.ScheduledProposalJSON{
+		Title:              "Scheduled Community Pool Spend",
+		Description:        "Fund Team once the height is reached!",
+		ExecuteAfterHeight: executeAfterHeight,
+		Content:            content,
+		Deposit:            initialDepositAmount.String(),
+	}
+	body, err := json.MarshalIndent(proposal, "", " ")
+	s.Require().NoError(err)
+
+	err = writeFile(filepath.Join(c.validators[0].configDir(), "config", proposalScheduledSpendFilename), body)
+	s.Require().NoError(err)
+}
+
 type ConsumerAdditionProposalWithDeposit struct {
 	ccvprovider.ConsumerAdditionProposal
 	Deposit string `json:"deposit"`
@@ -681,7 +940,7 @@ func (s *IntegrationTestSuite) writeAddRemoveConsumerProposals(c *chain, consume
 	}
 	addPropWithDeposit := ConsumerAdditionProposalWithDeposit{
 		ConsumerAdditionProposal: *addProp,
-		Deposit:                  "1000uatom",
+		Deposit:                  initialDepositAmount.String(),
 	}
 
 	removeProp := &ccvprovider.ConsumerRemovalProposal{
@@ -693,7 +952,7 @@ func (s *IntegrationTestSuite) writeAddRemoveConsumerProposals(c *chain, consume
 
 	removePropWithDeposit := ConsumerRemovalProposalWithDeposit{
 		ConsumerRemovalProposal: *removeProp,
-		Deposit:                 "1000uatom",
+		Deposit:                 initialDepositAmount.String(),
 	}
 
 	consumerAddBody, err := json.MarshalIndent(addPropWithDeposit, "", " ")
@@ -712,3 +971,72 @@ func configFile(filename string) string {
 	filepath := filepath.Join(gaiaConfigPath, filename)
 	return filepath
 }
+
+// validatorMinGasPrice returns the uatom min gas price of the validator with
+// the given index.
+func (c *chain) validatorMinGasPrice(index int) string {
+	if price, ok := c.minGasPrices[index]; ok {
+		return price
+	}
+	return minGasPrice
+}
+
+// validatorLogLevel returns the log level of the validator with the given
+// index.
+func (c *chain) validatorLogLevel(index int) string {
+	if level, ok := c.logLevels[index]; ok {
+		return level
+	}
+	return defaultLogLevel
+}
+
+// validatorLogFormat returns the log format of the validator with the given
+// index.
+func (c *chain) validatorLogFormat(index int) string {
+	if format, ok := c.logFormats[index]; ok {
+		return format
+	}
+	return tmconfig.LogFormatPlain
+}
+
+// validatorAPIEnabled returns whether the REST API of the validator with the
+// given index is enabled.
+func (c *chain) validatorAPIEnabled(index int) bool {
+	if enabled, ok := c.apiEnabled[index]; ok {
+		return enabled
+	}
+	return true
+}
+
+// validatorGRPCEnabled returns whether the gRPC server of the validator with
+// the given index is enabled.
+func (c *chain) validatorGRPCEnabled(index int) bool {
+	if enabled, ok := c.grpcEnabled[index]; ok {
+		return enabled
+	}
+	return true
+}
+
+// validatorLogs returns the lines logged so far by the container of the
+// validator with the given index.
+func (s *IntegrationTestSuite) validatorLogs(c *chain, valIdx int) ([]string, error) {
+	var buf bytes.Buffer
+	err := s.dkrPool.Client.Logs(docker.LogsOptions{
+		Container:    s.valResources[c.id][valIdx].Container.ID,
+		OutputStream: &buf,
+		ErrorStream:  &buf,
+		Stdout:       true,
+		Stderr:       true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if len(strings.TrimSpace(line)) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
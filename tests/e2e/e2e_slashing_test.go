@@ -1,6 +1,42 @@
 package e2e
 
-const jailedValidatorKey = "jailed"
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/ory/dockertest/v3"
+	"github.com/spf13/viper"
+	tmconfig "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/privval"
+)
+
+const (
+	jailedValidatorKey = "jailed"
+	// longRunningTestsEnv is the environment variable enabling the e2e tests
+	// taking several minutes on top of the suite setup.
+	longRunningTestsEnv = "GAIA_E2E_LONG_RUNNING"
+	// doubleSignChainPortOffset is the offset of the host ports of the chain
+	// started by the double sign test, after the ones of chains A and B and
+	// of the fast chain.
+	doubleSignChainPortOffset = 30
+	// downtimeChainPortOffset is the offset of the host ports of the chain
+	// started by the downtime slashing test.
+	downtimeChainPortOffset = 40
+
+	// the downtime chain jails the validators that signed less than half of
+	// the last 10 blocks
+	downtimeSignedBlocksWindow    = 10
+	downtimeMinSignedPerWindow    = "0.5"
+	downtimeJailDuration          = time.Minute
+	downtimeSlashFractionDowntime = "0.1"
+)
 
 func (s *IntegrationTestSuite) testSlashing(chainEndpoint string) {
 	s.Run("test unjail validator", func() {
@@ -21,3 +57,205 @@ func (s *IntegrationTestSuite) testSlashing(chainEndpoint string) {
 		}
 	})
 }
+
+// testDowntimeSlashing stops a validator of a chain with a short signed
+// blocks window, and checks it is then jailed for the downtime jail duration
+// and slashed by the configured downtime fraction. It runs on a dedicated
+// chain so that the stopped validator doesn't affect the other tests.
+func (s *IntegrationTestSuite) testDowntimeSlashing() {
+	s.Run("test downtime slashing", func() {
+		c, err := newChain()
+		s.Require().NoError(err)
+		s.tmpDirs = append(s.tmpDirs, c.dataDir)
+		// the first validator holds 75% of the voting power so that the chain
+		// keeps producing blocks once the second validator is stopped
+		c.setValidatorStakingAmount(0, stakingAmount.MulRaw(3))
+		c.setSlashingParams(downtimeSignedBlocksWindow, downtimeMinSignedPerWindow, downtimeJailDuration, downtimeSlashFractionDowntime)
+
+		vestingMnemonic, err := createMnemonic()
+		s.Require().NoError(err)
+		jailedValMnemonic, err := createMnemonic()
+		s.Require().NoError(err)
+
+		s.initNodes(c)
+		s.initGenesis(c, vestingMnemonic, jailedValMnemonic)
+		s.initValidatorConfigs(c)
+		s.runValidators(c, downtimeChainPortOffset)
+
+		var (
+			chainAPI = fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+			val      = c.validators[1]
+			valOper  = sdk.ValAddress(val.keyInfo.GetAddress()).String()
+			consAddr = sdk.ConsAddress(val.consensusKey.PubKey.Address()).String()
+		)
+
+		slashingParams, err := querySlashingParams(chainAPI)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(downtimeSignedBlocksWindow), slashingParams.SignedBlocksWindow)
+		s.Require().Equal(sdk.MustNewDecFromStr(downtimeMinSignedPerWindow), slashingParams.MinSignedPerWindow)
+		s.Require().Equal(downtimeJailDuration, slashingParams.DowntimeJailDuration)
+		s.Require().Equal(sdk.MustNewDecFromStr(downtimeSlashFractionDowntime), slashingParams.SlashFractionDowntime)
+
+		before, err := queryValidator(chainAPI, valOper)
+		s.Require().NoError(err)
+		s.Require().False(before.Jailed)
+
+		s.T().Logf("stopping the validator %s of chain %s", val.instanceName(), c.id)
+		s.Require().NoError(s.dkrPool.Client.StopContainer(s.valResources[c.id][1].Container.ID, 0))
+		stoppedAt := time.Now()
+
+		s.Require().Eventually(
+			func() bool {
+				valQ, err := queryValidator(chainAPI, valOper)
+				return err == nil && valQ.Jailed
+			},
+			scaleTimeout(5*time.Minute),
+			5*time.Second,
+			"the stopped validator was not jailed",
+		)
+
+		info, err := querySigningInfo(chainAPI, consAddr)
+		s.Require().NoError(err)
+		s.Require().False(info.Tombstoned)
+		s.Require().True(info.JailedUntil.After(stoppedAt))
+		s.Require().True(info.JailedUntil.Before(time.Now().Add(downtimeJailDuration)))
+
+		// the validator only has its self delegation, bonded since genesis,
+		// so it is slashed by the downtime fraction of all its tokens
+		after, err := queryValidator(chainAPI, valOper)
+		s.Require().NoError(err)
+		slashed := before.Tokens.ToDec().Mul(slashingParams.SlashFractionDowntime).TruncateInt()
+		s.Require().True(slashed.IsPositive())
+		s.Require().Equal(before.Tokens.Sub(slashed).String(), after.Tokens.String())
+	})
+}
+
+// testDoubleSignTombstoning runs a duplicate signer of a validator, sharing
+// its consensus key, until the validator double signs, and checks it is then
+// jailed, tombstoned and slashed by the double sign fraction. It runs on a
+// dedicated chain so that the tombstoned validator doesn't affect the other
+// tests.
+func (s *IntegrationTestSuite) testDoubleSignTombstoning() {
+	s.Run("test double sign tombstoning", func() {
+		c, err := newChain()
+		s.Require().NoError(err)
+		s.tmpDirs = append(s.tmpDirs, c.dataDir)
+		// the first validator holds 75% of the voting power so that the chain
+		// keeps producing blocks once the second validator is jailed
+		c.setValidatorStakingAmount(0, stakingAmount.MulRaw(3))
+
+		vestingMnemonic, err := createMnemonic()
+		s.Require().NoError(err)
+		jailedValMnemonic, err := createMnemonic()
+		s.Require().NoError(err)
+
+		s.initNodes(c)
+		s.initGenesis(c, vestingMnemonic, jailedValMnemonic)
+		s.initValidatorConfigs(c)
+		s.runValidators(c, doubleSignChainPortOffset)
+
+		var (
+			chainAPI = fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+			val      = c.validators[1]
+			valOper  = sdk.ValAddress(val.keyInfo.GetAddress()).String()
+			consAddr = sdk.ConsAddress(val.consensusKey.PubKey.Address()).String()
+		)
+
+		before, err := queryValidator(chainAPI, valOper)
+		s.Require().NoError(err)
+		s.Require().False(before.Jailed)
+		slashingParams, err := querySlashingParams(chainAPI)
+		s.Require().NoError(err)
+
+		doubleSigner := s.runDoubleSigner(c, val.index)
+		defer func() {
+			s.Require().NoError(s.dkrPool.Purge(doubleSigner))
+		}()
+
+		// the validator double signs once the duplicate signer caught up
+		// and both sign conflicting votes, e.g. for their own proposals
+		s.Require().Eventually(
+			func() bool {
+				valQ, err := queryValidator(chainAPI, valOper)
+				return err == nil && valQ.Jailed
+			},
+			scaleTimeout(10*time.Minute),
+			5*time.Second,
+			"the double signing validator was not jailed",
+		)
+
+		info, err := querySigningInfo(chainAPI, consAddr)
+		s.Require().NoError(err)
+		s.Require().True(info.Tombstoned)
+		s.Require().Equal(evidencetypes.DoubleSignJailEndTime, info.JailedUntil)
+
+		// the validator only has its self delegation, bonded since genesis,
+		// so it is slashed by the double sign fraction of all its tokens
+		after, err := queryValidator(chainAPI, valOper)
+		s.Require().NoError(err)
+		slashed := before.Tokens.ToDec().Mul(slashingParams.SlashFractionDoubleSign).TruncateInt()
+		s.Require().True(slashed.IsPositive())
+		s.Require().Equal(before.Tokens.Sub(slashed).String(), after.Tokens.String())
+
+		evidence, err := queryAllEvidence(chainAPI)
+		s.Require().NoError(err)
+		s.Require().Greater(len(evidence.Evidence), numberOfEvidences)
+	})
+}
+
+// runDoubleSigner runs a node of the chain signing with the consensus key of
+// the validator with the given index, without its signing state, so that it
+// signs votes conflicting with the ones of the validator. It returns the
+// container of the node.
+func (s *IntegrationTestSuite) runDoubleSigner(c *chain, valIdx int) *dockertest.Resource {
+	val := c.validators[valIdx]
+	name := fmt.Sprintf("%s-double-signer", val.instanceName())
+	homeDir := filepath.Join(c.configDir(), name)
+	s.T().Logf("starting a double signer of the validator %s of chain %s", val.instanceName(), c.id)
+
+	s.Require().NoError(os.MkdirAll(filepath.Join(homeDir, "config"), 0o755))
+	s.Require().NoError(os.MkdirAll(filepath.Join(homeDir, "data"), 0o755))
+	for _, file := range []string{"genesis.json", "config.toml", "app.toml", "priv_validator_key.json"} {
+		_, err := copyFile(filepath.Join(val.configDir(), "config", file), filepath.Join(homeDir, "config", file))
+		s.Require().NoError(err)
+	}
+	// an empty signing state lets the node sign the heights the validator
+	// already signed
+	privval.LoadFilePVEmptyState(
+		filepath.Join(homeDir, "config", "priv_validator_key.json"),
+		filepath.Join(homeDir, "data", "priv_validator_state.json"),
+	).Save()
+	_, err := p2p.LoadOrGenNodeKey(filepath.Join(homeDir, "config", "node_key.json"))
+	s.Require().NoError(err)
+
+	tmCfgPath := filepath.Join(homeDir, "config", "config.toml")
+	vpr := viper.New()
+	vpr.SetConfigFile(tmCfgPath)
+	s.Require().NoError(vpr.ReadInConfig())
+	valConfig := tmconfig.DefaultConfig()
+	s.Require().NoError(vpr.Unmarshal(valConfig))
+
+	var peers []string
+	for _, peer := range c.validators {
+		peers = append(peers, fmt.Sprintf("%s@%s:26656", peer.nodeKey.ID(), peer.instanceName()))
+	}
+	valConfig.P2P.ExternalAddress = fmt.Sprintf("%s:%d", name, 26656)
+	valConfig.P2P.PersistentPeers = strings.Join(peers, ",")
+	tmconfig.WriteConfigFile(tmCfgPath, valConfig)
+
+	s.Require().NoError(exec.Command("chmod", "-R", "0777", homeDir).Run()) //nolint:gosec // this is a test
+
+	resource, err := s.dkrPool.RunWithOptions(
+		&dockertest.RunOptions{
+			Name:       name,
+			NetworkID:  s.dkrNet.Network.ID,
+			Mounts:     []string{fmt.Sprintf("%s/:%s", homeDir, gaiaHomePath)},
+			Repository: "cosmos/gaiad-e2e",
+		},
+		noRestart,
+	)
+	s.Require().NoError(err)
+	s.T().Logf("started the double signer container: %s", resource.Container.ID)
+
+	return resource
+}
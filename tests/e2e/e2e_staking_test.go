@@ -37,7 +37,7 @@ func (s *IntegrationTestSuite) testStaking() {
 
 			return amt.Equal(sdk.NewDecFromInt(delegationAmount))
 		},
-		20*time.Second,
+		scaleTimeout(20*time.Second),
 		5*time.Second,
 	)
 
@@ -53,7 +53,80 @@ func (s *IntegrationTestSuite) testStaking() {
 
 			return amt.Equal(sdk.NewDecFromInt(delegationAmount))
 		},
-		20*time.Second,
+		scaleTimeout(20*time.Second),
 		5*time.Second,
 	)
 }
+
+/*
+testUnbonding tests that an unbonding completes after the unbonding time.
+Test Benchmarks:
+1. Delegation to a validator
+2. Unbonding of the delegation
+3. Validation that the unbonded tokens are returned once the unbonding time set in genesis elapsed
+*/
+func (s *IntegrationTestSuite) testUnbonding() {
+	chainEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+
+	validatorAddress := sdk.ValAddress(s.chainA.validators[0].keyInfo.GetAddress()).String()
+	delegatorAddress := s.chainA.genesisAccounts[2].keyInfo.GetAddress().String()
+
+	fees := sdk.NewCoin(uatomDenom, sdk.NewInt(10))
+	delegation := sdk.NewCoin(uatomDenom, sdk.NewInt(100000000)) // 100 atom
+
+	s.executeDelegate(s.chainA, 0, delegation.String(), validatorAddress, delegatorAddress, gaiaHomePath, fees.String())
+	s.Require().Eventually(
+		func() bool {
+			res, err := queryDelegation(chainEndpoint, validatorAddress, delegatorAddress)
+			s.Require().NoError(err)
+
+			return res.GetDelegationResponse().GetBalance().Amount.GTE(delegation.Amount)
+		},
+		scaleTimeout(20*time.Second),
+		5*time.Second,
+	)
+
+	s.executeUnbond(s.chainA, 0, delegation.String(), validatorAddress, delegatorAddress, gaiaHomePath, fees.String())
+	unbondingBalance, err := getSpecificBalance(chainEndpoint, delegatorAddress, uatomDenom)
+	s.Require().NoError(err)
+
+	// the unbonded tokens are locked until the unbonding completes
+	s.Require().Eventually(
+		func() bool {
+			balance, err := getSpecificBalance(chainEndpoint, delegatorAddress, uatomDenom)
+			s.Require().NoError(err)
+
+			return balance.IsEqual(unbondingBalance.Add(delegation))
+		},
+		unbondingTime+scaleTimeout(30*time.Second),
+		5*time.Second,
+	)
+}
+
+const (
+	customValidatorMoniker  = "custom-validator"
+	customValidatorIdentity = "0123456789ABCDEF"
+	customValidatorWebsite  = "https://validator.example.com"
+)
+
+/*
+testValidatorDescription tests that the validators are created with the
+description configured for them, and with their generated moniker otherwise.
+Test Benchmarks:
+1. Query of the validator with a custom description
+2. Query of a validator with the default description
+*/
+func (s *IntegrationTestSuite) testValidatorDescription() {
+	chainEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+
+	customVal, err := queryValidator(chainEndpoint, sdk.ValAddress(s.chainA.validators[1].keyInfo.GetAddress()).String())
+	s.Require().NoError(err)
+	s.Require().Equal(customValidatorMoniker, customVal.Description.Moniker)
+	s.Require().Equal(customValidatorIdentity, customVal.Description.Identity)
+	s.Require().Equal(customValidatorWebsite, customVal.Description.Website)
+
+	defaultVal, err := queryValidator(chainEndpoint, sdk.ValAddress(s.chainA.validators[0].keyInfo.GetAddress()).String())
+	s.Require().NoError(err)
+	s.Require().Equal(s.chainA.validators[0].moniker, defaultVal.Description.Moniker)
+	s.Require().Empty(defaultVal.Description.Website)
+}
@@ -2,6 +2,7 @@ package e2e
 
 import (
 	"fmt"
+	"os"
 )
 
 var (
@@ -14,9 +15,16 @@ var (
 	runGovTest                    = true
 	runIBCTest                    = true
 	runSlashingTest               = true
+	runDoubleSignTest             = true
+	runDowntimeSlashingTest       = true
 	runStakingAndDistributionTest = true
 	runVestingTest                = true
 	runRestInterfacesTest         = true
+	runValidatorLogsTest          = true
+	runChainTimeTest              = true
+	runValidatorAPITest           = true
+	runGrantsPoolTest             = true
+	runObserverTest               = true
 )
 
 func (s *IntegrationTestSuite) TestRestInterfaces() {
@@ -26,11 +34,49 @@ func (s *IntegrationTestSuite) TestRestInterfaces() {
 	s.testRestInterfaces()
 }
 
+func (s *IntegrationTestSuite) TestValidatorLogs() {
+	if !runValidatorLogsTest {
+		s.T().Skip()
+	}
+	s.testValidatorJSONLogs()
+}
+
+func (s *IntegrationTestSuite) TestValidatorAPI() {
+	if !runValidatorAPITest {
+		s.T().Skip()
+	}
+	s.testValidatorAPIDisabled()
+}
+
+func (s *IntegrationTestSuite) TestObserver() {
+	if !runObserverTest {
+		s.T().Skip()
+	}
+	s.testObserverQueries()
+}
+
+func (s *IntegrationTestSuite) TestGrantsPool() {
+	if !runGrantsPoolTest {
+		s.T().Skip()
+	}
+	s.testGrantsPoolFeeShare()
+}
+
+func (s *IntegrationTestSuite) TestChainTime() {
+	if !runChainTimeTest {
+		s.T().Skip()
+	}
+	s.testAdvanceChainTime()
+}
+
 func (s *IntegrationTestSuite) TestBank() {
 	if !runBankTest {
 		s.T().Skip()
 	}
 	s.testBankTokenTransfer()
+	s.testBankSendBalanceDeltas()
+	s.testAddressFeesPaid()
+	s.testMultisigSend()
 }
 
 func (s *IntegrationTestSuite) TestByPassMinFee() {
@@ -38,6 +84,15 @@ func (s *IntegrationTestSuite) TestByPassMinFee() {
 		s.T().Skip()
 	}
 	s.testByPassMinFeeWithdrawReward()
+	s.testBypassRate()
+}
+
+func (s *IntegrationTestSuite) TestCustomGenesis() {
+	genesisFile := os.Getenv(customGenesisFileEnv)
+	if len(genesisFile) == 0 {
+		s.T().Skipf("%s is not set", customGenesisFileEnv)
+	}
+	s.testCustomGenesisAccounts(genesisFile)
 }
 
 func (s *IntegrationTestSuite) TestEncode() {
@@ -68,6 +123,9 @@ func (s *IntegrationTestSuite) TestGlobalFees() {
 	}
 	s.testGlobalFees()
 	s.testQueryGlobalFeesInGenesis()
+	s.testDivergentMinGasPrices()
+	s.testFeeRejectionStats()
+	s.testDynamicMinimumGasPrices()
 }
 
 func (s *IntegrationTestSuite) TestGov() {
@@ -76,7 +134,19 @@ func (s *IntegrationTestSuite) TestGov() {
 	}
 	s.GovSoftwareUpgrade()
 	s.GovCancelSoftwareUpgrade()
+	s.GovProposalDroppedAfterDepositPeriod()
+	s.GovMajorityValidatorVote()
+	s.GovQuorumNotReached()
+	s.GovMaxActiveProposals()
+	s.GovDepositDenoms()
+	s.GovParamChange()
+	s.GovPreloadedCommunityPoolSpend()
 	s.GovCommunityPoolSpend()
+	s.GovScheduledCommunityPoolSpend()
+	s.GovCommunityPoolSpendAboveCap()
+	s.GovRecurringCommunityPoolSpend()
+	s.GovSanctionAddress()
+	s.GovHaltMsgType()
 	s.AddRemoveConsumerChain()
 }
 
@@ -84,7 +154,13 @@ func (s *IntegrationTestSuite) TestIBC() {
 	if !runIBCTest {
 		s.T().Skip()
 	}
+	s.testPreloadedIBCDenom()
 	s.testIBCTokenTransfer()
+	s.testIBCTransferAcks()
+	s.testIBCPacketRelay()
+	s.testIBCPacketDataSize()
+	s.testIBCSupplyCap()
+	s.testIBCIncentivizedTransfer()
 	s.testMultihopIBCTokenTransfer()
 	s.testFailedMultihopIBCTokenTransfer()
 }
@@ -97,13 +173,40 @@ func (s *IntegrationTestSuite) TestSlashing() {
 	s.testSlashing(chainAPI)
 }
 
+// TestDowntimeSlashing starts a chain of its own with a short signed blocks
+// window and stops one of its validators.
+func (s *IntegrationTestSuite) TestDowntimeSlashing() {
+	if !runDowntimeSlashingTest {
+		s.T().Skip()
+	}
+	s.testDowntimeSlashing()
+}
+
+// TestDoubleSign starts a chain of its own and waits for a validator to double
+// sign, so it only runs when long running tests are enabled.
+func (s *IntegrationTestSuite) TestDoubleSign() {
+	if !runDoubleSignTest {
+		s.T().Skip()
+	}
+	if len(os.Getenv(longRunningTestsEnv)) == 0 {
+		s.T().Skipf("%s is not set", longRunningTestsEnv)
+	}
+	s.testDoubleSignTombstoning()
+}
+
 // todo add fee test with wrong denom order
 func (s *IntegrationTestSuite) TestStakingAndDistribution() {
 	if !runStakingAndDistributionTest {
 		s.T().Skip()
 	}
 	s.testStaking()
+	s.testValidatorDescription()
 	s.testDistribution()
+	s.testRewardHistory()
+	s.testValidatorCommission()
+	s.testCommunityPoolFeeShare()
+	s.testDistributionModuleBalance()
+	s.testUnbonding()
 }
 
 func (s *IntegrationTestSuite) TestVesting() {
@@ -0,0 +1,106 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+// upgradeQueryClients are the query clients handed to post-upgrade assertions,
+// connected to the first validator of the upgraded chain.
+type upgradeQueryClients struct {
+	Bank      banktypes.QueryClient
+	Params    paramsproposal.QueryClient
+	Upgrade   upgradetypes.QueryClient
+	GlobalFee globalfeetypes.QueryClient
+}
+
+// postUpgradeAssertion verifies the state of a chain once it restarted after an upgrade.
+type postUpgradeAssertion struct {
+	name   string
+	assert func(ctx context.Context, clients upgradeQueryClients)
+}
+
+// registerPostUpgradeAssertion registers an assertion that is run by
+// runPostUpgradeAssertions once the chain restarted after an upgrade.
+func (s *IntegrationTestSuite) registerPostUpgradeAssertion(name string, assert func(ctx context.Context, clients upgradeQueryClients)) {
+	s.postUpgradeAssertions = append(s.postUpgradeAssertions, postUpgradeAssertion{name: name, assert: assert})
+}
+
+// runPostUpgradeAssertions runs all the registered post-upgrade assertions
+// against the given chain and clears them.
+func (s *IntegrationTestSuite) runPostUpgradeAssertions(c *chain) {
+	grpcAddr := s.valResources[c.id][0].GetHostPort("9090/tcp")
+	conn, err := grpc.Dial(grpcAddr, grpc.WithInsecure()) //nolint:staticcheck // grpc v1.33 has no insecure credentials package
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	clients := upgradeQueryClients{
+		Bank:      banktypes.NewQueryClient(conn),
+		Params:    paramsproposal.NewQueryClient(conn),
+		Upgrade:   upgradetypes.NewQueryClient(conn),
+		GlobalFee: globalfeetypes.NewQueryClient(conn),
+	}
+
+	for _, a := range s.postUpgradeAssertions {
+		s.Run(fmt.Sprintf("post-upgrade assertion: %s", a.name), func() {
+			ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout(30*time.Second))
+			defer cancel()
+
+			a.assert(ctx, clients)
+		})
+	}
+	s.postUpgradeAssertions = nil
+}
+
+// assertModuleConsensusVersion returns a post-upgrade assertion checking that
+// the stored consensus version of the given module is the one of the binary
+// and did not go backwards during the upgrade.
+func (s *IntegrationTestSuite) assertModuleConsensusVersion(module string, preUpgradeVersion, expectedVersion uint64) func(context.Context, upgradeQueryClients) {
+	return func(ctx context.Context, clients upgradeQueryClients) {
+		res, err := clients.Upgrade.ModuleVersions(ctx, &upgradetypes.QueryModuleVersionsRequest{ModuleName: module})
+		s.Require().NoError(err)
+		s.Require().Len(res.ModuleVersions, 1)
+		s.Require().Equal(expectedVersion, res.ModuleVersions[0].Version)
+		s.Require().GreaterOrEqual(res.ModuleVersions[0].Version, preUpgradeVersion)
+	}
+}
+
+// queryModuleVersion returns the stored consensus version of a module.
+func (s *IntegrationTestSuite) queryModuleVersion(c *chain, module string) uint64 {
+	grpcAddr := s.valResources[c.id][0].GetHostPort("9090/tcp")
+	conn, err := grpc.Dial(grpcAddr, grpc.WithInsecure()) //nolint:staticcheck // grpc v1.33 has no insecure credentials package
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	res, err := upgradetypes.NewQueryClient(conn).ModuleVersions(context.Background(), &upgradetypes.QueryModuleVersionsRequest{ModuleName: module})
+	s.Require().NoError(err)
+	s.Require().Len(res.ModuleVersions, 1)
+
+	return res.ModuleVersions[0].Version
+}
+
+// registerGlobalFeeUpgradeAssertions registers the post-upgrade assertions of
+// the globalfee module: its consensus version is the one of the new binary
+// and its params are still queryable.
+func (s *IntegrationTestSuite) registerGlobalFeeUpgradeAssertions(c *chain) {
+	preUpgradeVersion := s.queryModuleVersion(c, globalfeetypes.ModuleName)
+
+	s.registerPostUpgradeAssertion(
+		"globalfee consensus version",
+		s.assertModuleConsensusVersion(globalfeetypes.ModuleName, preUpgradeVersion, globalfee.AppModule{}.ConsensusVersion()),
+	)
+	s.registerPostUpgradeAssertion("globalfee params", func(ctx context.Context, clients upgradeQueryClients) {
+		res, err := clients.GlobalFee.MinimumGasPrices(ctx, &globalfeetypes.QueryMinimumGasPricesRequest{})
+		s.Require().NoError(err)
+		s.Require().False(res.MinimumGasPrices.IsZero())
+	})
+}
@@ -72,7 +72,7 @@ func (s *IntegrationTestSuite) testDelayedVestingAccount(api string) {
 
 				return amt.Equal(sdk.NewDecFromInt(vestingDelegationAmount.Amount))
 			},
-			20*time.Second,
+			scaleTimeout(20*time.Second),
 			5*time.Second,
 		)
 
@@ -141,7 +141,7 @@ func (s *IntegrationTestSuite) testContinuousVestingAccount(api string) {
 
 				return amt.Equal(sdk.NewDecFromInt(vestingDelegationAmount.Amount))
 			},
-			20*time.Second,
+			scaleTimeout(20*time.Second),
 			5*time.Second,
 		)
 
@@ -278,7 +278,7 @@ func (s *IntegrationTestSuite) testPeriodicVestingAccount(api string) { //nolint
 
 				return amt.Equal(sdk.NewDecFromInt(vestingDelegationAmount.Amount))
 			},
-			20*time.Second,
+			scaleTimeout(20*time.Second),
 			5*time.Second,
 		)
 
@@ -10,15 +10,27 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	globfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	grantspooltypes "github.com/cosmos/gaia/v9/x/grantspool/types"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	tmtypes "github.com/tendermint/tendermint/types"
+
+	gaia "github.com/cosmos/gaia/v9/app"
 )
 
+// customGenesisFileEnv is the environment variable pointing to a genesis file
+// the e2e chains start from instead of the default genesis.
+const customGenesisFileEnv = "GAIA_E2E_GENESIS_FILE"
+
 func getGenDoc(path string) (*tmtypes.GenesisDoc, error) {
 	serverCtx := server.NewDefaultContext()
 	config := serverCtx.Config
@@ -43,7 +55,456 @@ func getGenDoc(path string) (*tmtypes.GenesisDoc, error) {
 	return doc, nil
 }
 
-func modifyGenesis(path, moniker, amountStr string, addrAll []sdk.AccAddress, globfees string, denom string) error {
+// useCustomGenesis replaces the genesis of the node at path with the given
+// genesis file, e.g. an exported state, adjusted to be started by the e2e
+// validators: the chain ID is set, the genesis txs are dropped and the
+// missing modules get their default genesis. The genesis must not contain
+// validators as the e2e validators join through their genesis txs.
+func useCustomGenesis(path, genesisFile, chainID string) error {
+	serverCtx := server.NewDefaultContext()
+	config := serverCtx.Config
+	config.SetRoot(path)
+
+	genDoc, err := tmtypes.GenesisDocFromFile(genesisFile)
+	if err != nil {
+		return fmt.Errorf("failed to read custom genesis: %w", err)
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+		return fmt.Errorf("failed to unmarshal custom genesis state: %w", err)
+	}
+	for moduleName, moduleState := range gaia.ModuleBasics.DefaultGenesis(cdc) {
+		if _, ok := appState[moduleName]; !ok {
+			appState[moduleName] = moduleState
+		}
+	}
+
+	stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, appState)
+	if len(stakingGenState.Validators) > 0 {
+		return fmt.Errorf("custom genesis has %d validators, only the e2e validators can be started", len(stakingGenState.Validators))
+	}
+	genUtilGenStateBz, err := cdc.MarshalJSON(genutiltypes.DefaultGenesisState())
+	if err != nil {
+		return fmt.Errorf("failed to marshal genutil genesis state: %w", err)
+	}
+	appState[genutiltypes.ModuleName] = genUtilGenStateBz
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+	genDoc.ChainID = chainID
+	genDoc.Validators = nil
+	genDoc.AppState = appStateJSON
+
+	return genutil.ExportGenesisFile(genDoc, config.GenesisFile())
+}
+
+// govMinDepositAmount and govMaxDepositPeriod are the gov deposit params of
+// the e2e chains, unless changed by withGovDepositParams, and govVotingPeriod
+// is their voting period, unless changed by withGovVotingPeriod.
+var (
+	govMinDepositAmount = sdk.NewInt(10000)
+	govMaxDepositPeriod = 10 * time.Minute
+	govVotingPeriod     = 15 * time.Second
+)
+
+// genesisMutator applies a test specific change to the genesis, to its app
+// state or to the consensus params of the genesis doc.
+type genesisMutator func(genDoc *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error
+
+// withBlockParams sets the consensus params of the blocks: their max bytes,
+// their max gas, which the fullness of the blocks adjusting the dynamic
+// global fees is relative to, and the min time between blocks. The zero
+// values keep the default params. The max bytes of the evidence, which cannot
+// exceed the max bytes of a block, are lowered along with them.
+func withBlockParams(maxBytes, maxGas int64, timeIota time.Duration) genesisMutator {
+	return func(genDoc *tmtypes.GenesisDoc, _ map[string]json.RawMessage) error {
+		if genDoc.ConsensusParams == nil {
+			genDoc.ConsensusParams = tmtypes.DefaultConsensusParams()
+		}
+		params := genDoc.ConsensusParams
+		if maxBytes > 0 {
+			params.Block.MaxBytes = maxBytes
+			if params.Evidence.MaxBytes > maxBytes {
+				params.Evidence.MaxBytes = maxBytes
+			}
+		}
+		if maxGas > 0 {
+			params.Block.MaxGas = maxGas
+		}
+		if timeIota > 0 {
+			params.Block.TimeIotaMs = timeIota.Milliseconds()
+		}
+		return tmtypes.ValidateConsensusParams(*params)
+	}
+}
+
+// withUnbondingTime sets the staking unbonding time, so that tests can wait
+// for unbondings to complete.
+func withUnbondingTime(unbondingTime time.Duration) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, appState)
+		stakingGenState.Params.UnbondingTime = unbondingTime
+		stakingGenStateBz, err := cdc.MarshalJSON(stakingGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal staking genesis state: %w", err)
+		}
+		appState[stakingtypes.ModuleName] = stakingGenStateBz
+		return nil
+	}
+}
+
+// withDistributionParams sets how the collected fees and block rewards split
+// between the block proposer, the validators and the community pool.
+func withDistributionParams(communityTax, baseProposerReward, bonusProposerReward sdk.Dec) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var distrGenState distrtypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[distrtypes.ModuleName], &distrGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal distribution genesis state: %w", err)
+		}
+		distrGenState.Params.CommunityTax = communityTax
+		distrGenState.Params.BaseProposerReward = baseProposerReward
+		distrGenState.Params.BonusProposerReward = bonusProposerReward
+		if err := distrGenState.Params.ValidateBasic(); err != nil {
+			return err
+		}
+		distrGenStateBz, err := cdc.MarshalJSON(&distrGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal distribution genesis state: %w", err)
+		}
+		appState[distrtypes.ModuleName] = distrGenStateBz
+		return nil
+	}
+}
+
+// withMaxSpendFraction caps the amount a community pool spend proposal can
+// request to the given fraction of the community pool.
+func withMaxSpendFraction(maxSpendFraction sdk.Dec) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var recurringSpendGenState recurringspendtypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[recurringspendtypes.ModuleName], &recurringSpendGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal recurring spend genesis state: %w", err)
+		}
+		recurringSpendGenState.Params.MaxSpendFraction = maxSpendFraction
+		if err := recurringSpendGenState.Params.Validate(); err != nil {
+			return err
+		}
+		recurringSpendGenStateBz, err := cdc.MarshalJSON(&recurringSpendGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal recurring spend genesis state: %w", err)
+		}
+		appState[recurringspendtypes.ModuleName] = recurringSpendGenStateBz
+		return nil
+	}
+}
+
+// withGrantsPoolFeeShare sends the given fraction of the fees of each block to
+// the grants pool.
+func withGrantsPoolFeeShare(feeShare sdk.Dec) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var grantsPoolGenState grantspooltypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[grantspooltypes.ModuleName], &grantsPoolGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal grants pool genesis state: %w", err)
+		}
+		grantsPoolGenState.Params.FeeShare = feeShare
+		if err := grantsPoolGenState.Params.Validate(); err != nil {
+			return err
+		}
+		grantsPoolGenStateBz, err := cdc.MarshalJSON(&grantsPoolGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal grants pool genesis state: %w", err)
+		}
+		appState[grantspooltypes.ModuleName] = grantsPoolGenStateBz
+		return nil
+	}
+}
+
+// ibcDenom is an IBC voucher preloaded in genesis, as if it had been
+// transferred to the chain before it started.
+type ibcDenom struct {
+	trace  ibctransfertypes.DenomTrace
+	amount sdk.Int
+}
+
+// withIBCDenoms registers the denom traces of the given IBC vouchers and
+// credits each holder with their amount, so that tests start with the IBC
+// denoms present instead of transferring them first.
+func withIBCDenoms(denoms []ibcDenom, holders []sdk.AccAddress) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var transferGenState ibctransfertypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[ibctransfertypes.ModuleName], &transferGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal transfer genesis state: %w", err)
+		}
+		bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+		traces := make(map[string]bool)
+		for _, trace := range transferGenState.DenomTraces {
+			traces[trace.IBCDenom()] = true
+		}
+		var coins sdk.Coins
+		for _, denom := range denoms {
+			if !traces[denom.trace.IBCDenom()] {
+				traces[denom.trace.IBCDenom()] = true
+				transferGenState.DenomTraces = append(transferGenState.DenomTraces, denom.trace)
+			}
+			coins = coins.Add(sdk.NewCoin(denom.trace.IBCDenom(), denom.amount))
+		}
+		transferGenState.DenomTraces = transferGenState.DenomTraces.Sort()
+		if err := transferGenState.Validate(); err != nil {
+			return err
+		}
+
+		for _, holder := range holders {
+			credited := false
+			for i, balance := range bankGenState.Balances {
+				if balance.Address == holder.String() {
+					bankGenState.Balances[i].Coins = balance.Coins.Add(coins...)
+					credited = true
+					break
+				}
+			}
+			if !credited {
+				bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: holder.String(), Coins: coins})
+			}
+			// the supply is computed from the balances when it is not set
+			if !bankGenState.Supply.Empty() {
+				bankGenState.Supply = bankGenState.Supply.Add(coins...)
+			}
+		}
+		bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+		transferGenStateBz, err := cdc.MarshalJSON(&transferGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal transfer genesis state: %w", err)
+		}
+		appState[ibctransfertypes.ModuleName] = transferGenStateBz
+		bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+		}
+		appState[banktypes.ModuleName] = bankGenStateBz
+		return nil
+	}
+}
+
+// withGovDepositParams sets the minimum deposit of the proposals and their max
+// deposit period.
+func withGovDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var govGenState govtypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[govtypes.ModuleName], &govGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal gov genesis state: %w", err)
+		}
+		govGenState.DepositParams = govtypes.NewDepositParams(minDeposit, maxDepositPeriod)
+		if err := govtypes.ValidateGenesis(&govGenState); err != nil {
+			return err
+		}
+		govGenStateBz, err := cdc.MarshalJSON(&govGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal gov genesis state: %w", err)
+		}
+		appState[govtypes.ModuleName] = govGenStateBz
+		return nil
+	}
+}
+
+// withGovVotingPeriod sets how long the proposals stay in voting period.
+func withGovVotingPeriod(votingPeriod time.Duration) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var govGenState govtypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[govtypes.ModuleName], &govGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal gov genesis state: %w", err)
+		}
+		govGenState.VotingParams = govtypes.NewVotingParams(votingPeriod)
+		if err := govtypes.ValidateGenesis(&govGenState); err != nil {
+			return err
+		}
+		govGenStateBz, err := cdc.MarshalJSON(&govGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal gov genesis state: %w", err)
+		}
+		appState[govtypes.ModuleName] = govGenStateBz
+		return nil
+	}
+}
+
+// withGovTallyParams sets the quorum and the threshold of the proposals.
+func withGovTallyParams(quorum, threshold sdk.Dec) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var govGenState govtypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[govtypes.ModuleName], &govGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal gov genesis state: %w", err)
+		}
+		govGenState.TallyParams = govtypes.NewTallyParams(quorum, threshold, govGenState.TallyParams.VetoThreshold)
+		if err := govtypes.ValidateGenesis(&govGenState); err != nil {
+			return err
+		}
+		govGenStateBz, err := cdc.MarshalJSON(&govGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal gov genesis state: %w", err)
+		}
+		appState[govtypes.ModuleName] = govGenStateBz
+		return nil
+	}
+}
+
+// withMaxActiveProposals caps the number of proposals in their deposit or
+// voting period.
+func withMaxActiveProposals(maxProposals uint64) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var policyGenState policytypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[policytypes.ModuleName], &policyGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal policy genesis state: %w", err)
+		}
+		policyGenState.Params.MaxActiveProposals = maxProposals
+		if err := policytypes.ValidateGenesis(policyGenState); err != nil {
+			return err
+		}
+		policyGenStateBz, err := cdc.MarshalJSON(&policyGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal policy genesis state: %w", err)
+		}
+		appState[policytypes.ModuleName] = policyGenStateBz
+		return nil
+	}
+}
+
+// withMaxPacketDataBytes limits the size of the data of the IBC transfer
+// packets.
+func withMaxPacketDataBytes(maxBytes uint64) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var policyGenState policytypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[policytypes.ModuleName], &policyGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal policy genesis state: %w", err)
+		}
+		policyGenState.Params.MaxPacketDataBytes = maxBytes
+		if err := policytypes.ValidateGenesis(policyGenState); err != nil {
+			return err
+		}
+		policyGenStateBz, err := cdc.MarshalJSON(&policyGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal policy genesis state: %w", err)
+		}
+		appState[policytypes.ModuleName] = policyGenStateBz
+		return nil
+	}
+}
+
+// withDepositDenoms restricts the denoms the gov proposal deposits can be paid
+// in.
+func withDepositDenoms(denoms []string) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var policyGenState policytypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[policytypes.ModuleName], &policyGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal policy genesis state: %w", err)
+		}
+		policyGenState.Params.DepositDenoms = denoms
+		if err := policytypes.ValidateGenesis(policyGenState); err != nil {
+			return err
+		}
+		policyGenStateBz, err := cdc.MarshalJSON(&policyGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal policy genesis state: %w", err)
+		}
+		appState[policytypes.ModuleName] = policyGenStateBz
+		return nil
+	}
+}
+
+// withSupplyCaps caps the total supply of denoms.
+func withSupplyCaps(caps sdk.Coins) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var policyGenState policytypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[policytypes.ModuleName], &policyGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal policy genesis state: %w", err)
+		}
+		policyGenState.Params.SupplyCaps = caps
+		if err := policytypes.ValidateGenesis(policyGenState); err != nil {
+			return err
+		}
+		policyGenStateBz, err := cdc.MarshalJSON(&policyGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal policy genesis state: %w", err)
+		}
+		appState[policytypes.ModuleName] = policyGenStateBz
+		return nil
+	}
+}
+
+// withSlashingParams sets the slashing params, e.g. a short signed blocks
+// window so that tests can jail a validator for downtime within a few blocks.
+func withSlashingParams(params slashingtypes.Params) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var slashingGenState slashingtypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[slashingtypes.ModuleName], &slashingGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal slashing genesis state: %w", err)
+		}
+		slashingGenState.Params = params
+		if err := slashingtypes.ValidateGenesis(slashingGenState); err != nil {
+			return err
+		}
+		slashingGenStateBz, err := cdc.MarshalJSON(&slashingGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal slashing genesis state: %w", err)
+		}
+		appState[slashingtypes.ModuleName] = slashingGenStateBz
+		return nil
+	}
+}
+
+// withCommunityPool preloads the community pool with the given coins, crediting
+// the distribution module account with the same amount so that the pool is
+// backed, so that tests can spend from the pool without funding it first.
+func withCommunityPool(pool sdk.Coins) genesisMutator {
+	return func(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+		var distrGenState distrtypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[distrtypes.ModuleName], &distrGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal distribution genesis state: %w", err)
+		}
+		bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+		distrGenState.FeePool.CommunityPool = distrGenState.FeePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(pool...)...)
+		if err := distrtypes.ValidateGenesis(&distrGenState); err != nil {
+			return err
+		}
+
+		// the distribution module account must hold exactly the community
+		// pool and the outstanding rewards, which are empty at genesis
+		distrAddr := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+		credited := false
+		for i, balance := range bankGenState.Balances {
+			if balance.Address == distrAddr {
+				bankGenState.Balances[i].Coins = balance.Coins.Add(pool...)
+				credited = true
+				break
+			}
+		}
+		if !credited {
+			bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: distrAddr, Coins: pool})
+		}
+		// the supply is computed from the balances when it is not set
+		if !bankGenState.Supply.Empty() {
+			bankGenState.Supply = bankGenState.Supply.Add(pool...)
+		}
+		bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+		distrGenStateBz, err := cdc.MarshalJSON(&distrGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal distribution genesis state: %w", err)
+		}
+		appState[distrtypes.ModuleName] = distrGenStateBz
+		bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+		}
+		appState[banktypes.ModuleName] = bankGenStateBz
+		return nil
+	}
+}
+
+func modifyGenesis(path, moniker, amountStr string, addrAll []sdk.AccAddress, globfees string, denom string, mutators ...genesisMutator) error {
 	serverCtx := server.NewDefaultContext()
 	config := serverCtx.Config
 	config.SetRoot(path)
@@ -175,13 +636,13 @@ func modifyGenesis(path, moniker, amountStr string, addrAll []sdk.AccAddress, gl
 	appState[stakingtypes.ModuleName] = stakingGenStateBz
 
 	// Refactor to separate method
-	amnt := sdk.NewInt(10000)
+	amnt := govMinDepositAmount
 	quorum, _ := sdk.NewDecFromStr("0.000000000000000001")
 	threshold, _ := sdk.NewDecFromStr("0.000000000000000001")
 
 	govState := govtypes.NewGenesisState(1,
-		govtypes.NewDepositParams(sdk.NewCoins(sdk.NewCoin(denom, amnt)), 10*time.Minute),
-		govtypes.NewVotingParams(15*time.Second),
+		govtypes.NewDepositParams(sdk.NewCoins(sdk.NewCoin(denom, amnt)), govMaxDepositPeriod),
+		govtypes.NewVotingParams(govVotingPeriod),
 		govtypes.NewTallyParams(quorum, threshold, govtypes.DefaultVetoThreshold),
 	)
 
@@ -191,6 +652,12 @@ func modifyGenesis(path, moniker, amountStr string, addrAll []sdk.AccAddress, gl
 	}
 	appState[govtypes.ModuleName] = govGenStateBz
 
+	for _, mutate := range mutators {
+		if err := mutate(genDoc, appState); err != nil {
+			return err
+		}
+	}
+
 	appStateJSON, err := json.Marshal(appState)
 	if err != nil {
 		return fmt.Errorf("failed to marshal application genesis state: %w", err)
@@ -0,0 +1,143 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	gaia "github.com/cosmos/gaia/v9/app"
+)
+
+const customGenesisFile = "testdata/custom_genesis.json"
+
+// customGenesisAccount is the account of testdata/custom_genesis.json.
+var customGenesisAccount = sdk.AccAddress("custom_genesis_acct0")
+
+func TestUseCustomGenesis(t *testing.T) {
+	path := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(path, "config"), 0o755))
+
+	require.NoError(t, useCustomGenesis(path, customGenesisFile, "custom-chain"))
+	valAddr := sdk.AccAddress("e2e_validator_______")
+	require.NoError(t, modifyGenesis(path, "", initBalanceStr, []sdk.AccAddress{valAddr}, initialGlobalFeeAmt+uatomDenom, uatomDenom))
+
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(path, "config", "genesis.json"))
+	require.NoError(t, err)
+	require.Equal(t, "custom-chain", genDoc.ChainID)
+	require.NoError(t, gaia.ModuleBasics.ValidateGenesis(cdc, txConfig, appState))
+
+	// the accounts of the custom genesis are kept along with the e2e ones
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	require.True(t, accs.Contains(customGenesisAccount))
+	require.True(t, accs.Contains(valAddr))
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	balances := make(map[string]sdk.Coins)
+	for _, balance := range bankGenState.Balances {
+		balances[balance.Address] = balance.Coins
+	}
+	require.Equal(t, "5000000photon,1234567uatom", balances[customGenesisAccount.String()].String())
+	require.Contains(t, balances, valAddr.String())
+}
+
+func TestUseCustomGenesisWithValidators(t *testing.T) {
+	genesis, err := os.ReadFile(customGenesisFile)
+	require.NoError(t, err)
+	var genDoc map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(genesis, &genDoc))
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(genDoc["app_state"], &appState))
+	appState["staking"] = json.RawMessage(`{"validators":[{"operator_address":"cosmosvaloper1vd6hxar0d40kwetwv4ekju6lv93kxapsnx8dmq"}]}`)
+	genDoc["app_state"], err = json.Marshal(appState)
+	require.NoError(t, err)
+
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	genesis, err = json.Marshal(genDoc)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(genesisFile, genesis, 0o600))
+
+	path := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(path, "config"), 0o755))
+	require.ErrorContains(t, useCustomGenesis(path, genesisFile, "custom-chain"), "validators")
+}
+
+func TestWithIBCDenoms(t *testing.T) {
+	appState := gaia.ModuleBasics.DefaultGenesis(cdc)
+	funded := sdk.AccAddress("e2e_funded_account__")
+	unfunded := sdk.AccAddress("e2e_unfunded_account")
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	bankGenState.Balances = []banktypes.Balance{{Address: funded.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(uatomDenom, 100))}}
+	appState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenState)
+
+	trace := ibctransfertypes.ParseDenomTrace("transfer/channel-99/uosmo")
+	denoms := []ibcDenom{{trace: trace, amount: sdk.NewInt(1000)}}
+	require.NoError(t, withIBCDenoms(denoms, []sdk.AccAddress{funded, unfunded})(nil, appState))
+	require.NoError(t, gaia.ModuleBasics.ValidateGenesis(cdc, txConfig, appState))
+
+	balances := make(map[string]sdk.Coins)
+	for _, balance := range banktypes.GetGenesisStateFromAppState(cdc, appState).Balances {
+		balances[balance.Address] = balance.Coins
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(trace.IBCDenom(), 1000), sdk.NewInt64Coin(uatomDenom, 100)), balances[funded.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(trace.IBCDenom(), 1000)), balances[unfunded.String()])
+
+	var transferGenState ibctransfertypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[ibctransfertypes.ModuleName], &transferGenState))
+	require.Equal(t, ibctransfertypes.Traces{trace}, transferGenState.DenomTraces)
+}
+
+func TestWithBlockParams(t *testing.T) {
+	defaults := tmtypes.DefaultConsensusParams()
+
+	// the zero values keep the defaults
+	genDoc := &tmtypes.GenesisDoc{ConsensusParams: tmtypes.DefaultConsensusParams()}
+	require.NoError(t, withBlockParams(0, 0, 0)(genDoc, nil))
+	require.Equal(t, defaults, genDoc.ConsensusParams)
+
+	genDoc = &tmtypes.GenesisDoc{}
+	require.NoError(t, withBlockParams(500_000, 1_000_000, 2*time.Second)(genDoc, nil))
+	require.Equal(t, int64(500_000), genDoc.ConsensusParams.Block.MaxBytes)
+	require.Equal(t, int64(1_000_000), genDoc.ConsensusParams.Block.MaxGas)
+	require.Equal(t, int64(2000), genDoc.ConsensusParams.Block.TimeIotaMs)
+	// the evidence cannot exceed a block
+	require.Equal(t, int64(500_000), genDoc.ConsensusParams.Evidence.MaxBytes)
+	require.Equal(t, defaults.Evidence.MaxAgeNumBlocks, genDoc.ConsensusParams.Evidence.MaxAgeNumBlocks)
+}
+
+// maxCustomGenesisAccountsChecked bounds the accounts checked by
+// testCustomGenesisAccounts, as exported states hold too many to query.
+const maxCustomGenesisAccountsChecked = 10
+
+// testCustomGenesisAccounts checks that chain A started with the balances of
+// the custom genesis file.
+func (s *IntegrationTestSuite) testCustomGenesisAccounts(genesisFile string) {
+	genDoc, err := tmtypes.GenesisDocFromFile(genesisFile)
+	s.Require().NoError(err)
+	var appState map[string]json.RawMessage
+	s.Require().NoError(json.Unmarshal(genDoc.AppState, &appState))
+	balances := banktypes.GetGenesisStateFromAppState(cdc, appState).Balances
+	s.Require().NotEmpty(balances)
+	if len(balances) > maxCustomGenesisAccountsChecked {
+		balances = balances[:maxCustomGenesisAccountsChecked]
+	}
+
+	chainAAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+	for _, balance := range balances {
+		coins, err := queryGaiaAllBalances(chainAAPIEndpoint, balance.Address)
+		s.Require().NoError(err)
+		s.Require().Equal(balance.Coins.String(), coins.String(), balance.Address)
+	}
+}
@@ -1,6 +1,7 @@
 package e2e
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -22,6 +23,24 @@ func httpGet(endpoint string) ([]byte, error) {
 	return body, nil
 }
 
+func httpPost(endpoint string, body []byte) ([]byte, error) {
+	resp, err := http.Post(endpoint, "application/json", bytes.NewReader(body)) //nolint:gosec // this is only used during tests
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP request returned status %d: %s", resp.StatusCode, respBody)
+	}
+
+	return respBody, nil
+}
+
 func readJSON(resp *http.Response) (map[string]interface{}, error) {
 	defer resp.Body.Close()
 
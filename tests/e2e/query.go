@@ -5,9 +5,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -15,9 +20,19 @@ import (
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"google.golang.org/grpc/codes"
 
 	globalfee "github.com/cosmos/gaia/v9/x/globalfee/types"
+	govscheduletypes "github.com/cosmos/gaia/v9/x/govschedule/types"
+	grantspooltypes "github.com/cosmos/gaia/v9/x/grantspool/types"
+	gaiaquerytypes "github.com/cosmos/gaia/v9/x/query/types"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
+	sanctiontypes "github.com/cosmos/gaia/v9/x/sanction/types"
 )
 
 func queryGaiaTx(endpoint, txHash string) error {
@@ -45,6 +60,29 @@ func queryGaiaTx(endpoint, txHash string) error {
 	return nil
 }
 
+// broadcastTx broadcasts the encoded tx and returns its response once it is
+// included in a block, or once it is rejected by the check of the node.
+func broadcastTx(endpoint string, txBytes []byte) (*sdk.TxResponse, error) {
+	body, err := cdc.MarshalJSON(&sdktx.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    sdktx.BroadcastMode_BROADCAST_MODE_BLOCK,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := httpPost(fmt.Sprintf("%s/cosmos/tx/v1beta1/txs", endpoint), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res sdktx.BroadcastTxResponse
+	if err := cdc.UnmarshalJSON(bz, &res); err != nil {
+		return nil, err
+	}
+	return res.TxResponse, nil
+}
+
 // if coin is zero, return empty coin.
 func getSpecificBalance(endpoint, addr, denom string) (amt sdk.Coin, err error) {
 	balances, err := queryGaiaAllBalances(endpoint, addr)
@@ -74,6 +112,37 @@ func queryGaiaAllBalances(endpoint, addr string) (sdk.Coins, error) {
 	return balancesResp.Balances, nil
 }
 
+// querySupplyOf returns the total supply of a denom. The supply of all the
+// denoms is queried as the supply query of a single denom doesn't route the
+// IBC denoms.
+func querySupplyOf(endpoint, denom string) (sdk.Int, error) {
+	body, err := httpGet(fmt.Sprintf("%s/cosmos/bank/v1beta1/supply", endpoint))
+	if err != nil {
+		return sdk.Int{}, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	var supplyResp banktypes.QueryTotalSupplyResponse
+	if err := cdc.UnmarshalJSON(body, &supplyResp); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return supplyResp.Supply.AmountOf(denom), nil
+}
+
+func queryGaiaSpendableBalances(endpoint, addr string) (sdk.Coins, error) {
+	body, err := httpGet(fmt.Sprintf("%s/cosmos/bank/v1beta1/spendable_balances/%s", endpoint, addr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	var balancesResp banktypes.QuerySpendableBalancesResponse
+	if err := cdc.UnmarshalJSON(body, &balancesResp); err != nil {
+		return nil, err
+	}
+
+	return balancesResp.Balances, nil
+}
+
 func queryGlobalFees(endpoint string) (amt sdk.DecCoins, err error) {
 	body, err := httpGet(fmt.Sprintf("%s/gaia/globalfee/v1beta1/minimum_gas_prices", endpoint))
 	if err != nil {
@@ -88,6 +157,118 @@ func queryGlobalFees(endpoint string) (amt sdk.DecCoins, err error) {
 	return fees.MinimumGasPrices, nil
 }
 
+func queryBypassRate(endpoint string, window uint64) (globalfee.QueryBypassRateResponse, error) {
+	var res globalfee.QueryBypassRateResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/gaia/globalfee/v1beta1/bypass_rate?window=%d", endpoint, window))
+	if err != nil {
+		return res, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func queryFeeRejectionStats(endpoint string, window uint64) (globalfee.QueryFeeRejectionStatsResponse, error) {
+	var res globalfee.QueryFeeRejectionStatsResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/gaia/globalfee/v1beta1/fee_rejection_stats?window=%d", endpoint, window))
+	if err != nil {
+		return res, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func queryDynamicMinimumGasPrices(endpoint string) (globalfee.QueryDynamicMinimumGasPricesResponse, error) {
+	var res globalfee.QueryDynamicMinimumGasPricesResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/gaia/globalfee/v1beta1/dynamic_minimum_gas_prices", endpoint))
+	if err != nil {
+		return res, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func queryRecurringSpends(endpoint string) ([]recurringspendtypes.RecurringSpend, error) {
+	var res recurringspendtypes.QueryRecurringSpendsResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/gaia/recurringspend/v1beta1/spends", endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return nil, err
+	}
+	return res.Spends, nil
+}
+
+func queryScheduledExecutions(endpoint string) ([]govscheduletypes.ScheduledExecution, error) {
+	var res govscheduletypes.QueryScheduledExecutionsResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/gaia/govschedule/v1beta1/executions", endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return nil, err
+	}
+	return res.Executions, nil
+}
+
+func queryGrantsPool(endpoint string) (sdk.Coins, error) {
+	var res grantspooltypes.QueryPoolResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/gaia/grantspool/v1beta1/pool", endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return nil, err
+	}
+	return res.Pool, nil
+}
+
+func queryDenomTrace(endpoint, hash string) (ibctransfertypes.DenomTrace, error) {
+	var res ibctransfertypes.QueryDenomTraceResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/ibc/apps/transfer/v1/denom_traces/%s", endpoint, hash))
+	if err != nil {
+		return ibctransfertypes.DenomTrace{}, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return ibctransfertypes.DenomTrace{}, err
+	}
+	return *res.DenomTrace, nil
+}
+
+func queryIsSanctioned(endpoint, addr string) (bool, error) {
+	var res sanctiontypes.QueryIsSanctionedResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/gaia/sanction/v1beta1/addresses/%s", endpoint, addr))
+	if err != nil {
+		return false, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return false, err
+	}
+	return res.Sanctioned, nil
+}
+
 func queryDelegation(endpoint string, validatorAddr string, delegatorAddr string) (stakingtypes.QueryDelegationResponse, error) {
 	var res stakingtypes.QueryDelegationResponse
 
@@ -116,6 +297,62 @@ func queryDelegatorWithdrawalAddress(endpoint string, delegatorAddr string) (dis
 	return res, nil
 }
 
+func queryRewardHistory(endpoint, delegatorAddr string, fromHeight int64) (gaiaquerytypes.QueryRewardHistoryResponse, error) {
+	var res gaiaquerytypes.QueryRewardHistoryResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/gaia/query/v1beta1/delegators/%s/reward_history?from_height=%d", endpoint, delegatorAddr, fromHeight))
+	if err != nil {
+		return res, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func queryAddressFeesPaid(endpoint, addr string) (gaiaquerytypes.QueryAddressFeesPaidResponse, error) {
+	var res gaiaquerytypes.QueryAddressFeesPaidResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/gaia/query/v1beta1/accounts/%s/fees_paid", endpoint, addr))
+	if err != nil {
+		return res, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func queryValidatorCommission(endpoint, valAddr string) (sdk.DecCoins, error) {
+	var res disttypes.QueryValidatorCommissionResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/cosmos/distribution/v1beta1/validators/%s/commission", endpoint, valAddr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return nil, err
+	}
+	return res.Commission.Commission, nil
+}
+
+func queryCommunityPool(endpoint string) (sdk.DecCoins, error) {
+	var res disttypes.QueryCommunityPoolResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/cosmos/distribution/v1beta1/community_pool", endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return nil, err
+	}
+	return res.Pool, nil
+}
+
 func queryGovProposal(endpoint string, proposalID int) (govtypes.QueryProposalResponse, error) {
 	var govProposalResp govtypes.QueryProposalResponse
 
@@ -132,6 +369,37 @@ func queryGovProposal(endpoint string, proposalID int) (govtypes.QueryProposalRe
 	return govProposalResp, nil
 }
 
+// queryLatestGovProposalID returns the id of the last submitted proposal.
+func queryLatestGovProposalID(endpoint string) (uint64, error) {
+	var res govtypes.QueryProposalsResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/cosmos/gov/v1beta1/proposals?pagination.reverse=true&pagination.limit=1", endpoint))
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+	if err := cdc.UnmarshalJSON(body, &res); err != nil {
+		return 0, err
+	}
+	if len(res.Proposals) == 0 {
+		return 0, fmt.Errorf("no proposal found")
+	}
+	return res.Proposals[0].ProposalId, nil
+}
+
+// queryParam returns the amino JSON value of a param of a subspace.
+func queryParam(endpoint, subspace, key string) (string, error) {
+	var res paramsproposal.QueryParamsResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/cosmos/params/v1beta1/params?subspace=%s&key=%s", endpoint, subspace, key))
+	if err != nil {
+		return "", fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+	if err := cdc.UnmarshalJSON(body, &res); err != nil {
+		return "", err
+	}
+	return res.Param.Value, nil
+}
+
 func queryAccount(endpoint, address string) (acc authtypes.AccountI, err error) {
 	var res authtypes.QueryAccountResponse
 	resp, err := http.Get(fmt.Sprintf("%s/cosmos/auth/v1beta1/accounts/%s", endpoint, address))
@@ -150,6 +418,23 @@ func queryAccount(endpoint, address string) (acc authtypes.AccountI, err error)
 	return acc, cdc.UnpackAny(res.Account, &acc)
 }
 
+// queryModuleAccount resolves the account of a module by its name through the
+// module accounts of the auth module.
+func queryModuleAccount(endpoint, moduleName string) (authtypes.ModuleAccountI, error) {
+	var res authtypes.QueryModuleAccountByNameResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/cosmos/auth/v1beta1/module_accounts/%s", endpoint, moduleName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+	if err := cdc.UnmarshalJSON(body, &res); err != nil {
+		return nil, err
+	}
+
+	var acc authtypes.ModuleAccountI
+	return acc, cdc.UnpackAny(res.Account, &acc)
+}
+
 func queryDelayedVestingAccount(endpoint, address string) (authvesting.DelayedVestingAccount, error) {
 	baseAcc, err := queryAccount(endpoint, address)
 	if err != nil {
@@ -254,3 +539,245 @@ func queryAllEvidence(endpoint string) (evidencetypes.QueryAllEvidenceResponse,
 	}
 	return res, nil
 }
+
+func querySlashingParams(endpoint string) (slashingtypes.Params, error) {
+	var res slashingtypes.QueryParamsResponse
+	body, err := httpGet(fmt.Sprintf("%s/cosmos/slashing/v1beta1/params", endpoint))
+	if err != nil {
+		return slashingtypes.Params{}, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return slashingtypes.Params{}, err
+	}
+	return res.Params, nil
+}
+
+func querySigningInfo(endpoint, consAddr string) (slashingtypes.ValidatorSigningInfo, error) {
+	var res slashingtypes.QuerySigningInfoResponse
+	body, err := httpGet(fmt.Sprintf("%s/cosmos/slashing/v1beta1/signing_infos/%s", endpoint, consAddr))
+	if err != nil {
+		return slashingtypes.ValidatorSigningInfo{}, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return slashingtypes.ValidatorSigningInfo{}, err
+	}
+	return res.ValSigningInfo, nil
+}
+
+// txLogs is the part of the tx query responses holding the tx logs, decoded
+// without the txs as their messages do not need to be registered in cdc.
+type txLogs struct {
+	Logs sdk.ABCIMessageLogs `json:"logs"`
+}
+
+// eventsAttributes returns the attributes of each event of the given type in
+// the logs, one map per message emitting it.
+func eventsAttributes(logs sdk.ABCIMessageLogs, eventType string) []map[string]string {
+	var attributes []map[string]string
+	for _, log := range logs {
+		for _, event := range log.Events {
+			if event.Type != eventType {
+				continue
+			}
+			attrs := make(map[string]string, len(event.Attributes))
+			for _, attr := range event.Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+			attributes = append(attributes, attrs)
+		}
+	}
+	return attributes
+}
+
+// querySentPacketSequence returns the sequence of the IBC packet sent by the
+// given tx.
+func querySentPacketSequence(endpoint, txHash string) (uint64, error) {
+	body, err := httpGet(fmt.Sprintf("%s/cosmos/tx/v1beta1/txs/%s", endpoint, txHash))
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	var res struct {
+		TxResponse txLogs `json:"tx_response"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return 0, err
+	}
+
+	packets := eventsAttributes(res.TxResponse.Logs, channeltypes.EventTypeSendPacket)
+	if len(packets) != 1 {
+		return 0, fmt.Errorf("tx %s sent %d packets", txHash, len(packets))
+	}
+	return strconv.ParseUint(packets[0][channeltypes.AttributeKeySequence], 10, 64)
+}
+
+func queryChannelIncentiveFees(endpoint, channelID string) (gaiaquerytypes.QueryChannelIncentiveFeesResponse, error) {
+	var res gaiaquerytypes.QueryChannelIncentiveFeesResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/gaia/query/v1beta1/channels/%s/incentive_fees", endpoint, channelID))
+	if err != nil {
+		return res, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func queryIncentivizedChannels(endpoint string) ([]gaiaquerytypes.ChannelIncentives, error) {
+	var res gaiaquerytypes.QueryIncentivizedChannelsResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/gaia/query/v1beta1/channels/incentive_fees", endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return nil, err
+	}
+	return res.Channels, nil
+}
+
+// queryChannelOpen returns whether the handshake of the channel on the
+// transfer port is completed.
+func queryChannelOpen(endpoint, channelID string) (bool, error) {
+	var res channeltypes.QueryChannelResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/ibc/core/channel/v1/channels/%s/ports/transfer", endpoint, channelID))
+	if err != nil {
+		return false, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return false, err
+	}
+	return res.Channel != nil && res.Channel.State == channeltypes.OPEN, nil
+}
+
+// queryPacketAck returns the acknowledgement written for the packet received
+// on the given channel with the given sequence, found is false if it is not
+// written yet. The ack is read from the events of the relayer tx, as only its
+// commitment is kept in the state.
+func queryPacketAck(endpoint, channelID string, sequence uint64) (ack channeltypes.Acknowledgement, found bool, err error) {
+	query := url.Values{}
+	query.Add("events", fmt.Sprintf("%s.%s=%s", channeltypes.EventTypeWriteAck, channeltypes.AttributeKeyDstChannel, channelID))
+	query.Add("events", fmt.Sprintf("%s.%s=%d", channeltypes.EventTypeWriteAck, channeltypes.AttributeKeySequence, sequence))
+
+	body, err := httpGet(fmt.Sprintf("%s/cosmos/tx/v1beta1/txs?%s", endpoint, query.Encode()))
+	if err != nil {
+		return ack, false, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	var res struct {
+		TxResponses []txLogs `json:"tx_responses"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return ack, false, err
+	}
+
+	// the events filter matches the whole tx, which can relay several packets
+	for _, txResp := range res.TxResponses {
+		for _, attrs := range eventsAttributes(txResp.Logs, channeltypes.EventTypeWriteAck) {
+			if attrs[channeltypes.AttributeKeyDstChannel] != channelID || attrs[channeltypes.AttributeKeySequence] != strconv.FormatUint(sequence, 10) {
+				continue
+			}
+			if err := channeltypes.SubModuleCdc.UnmarshalJSON([]byte(attrs[channeltypes.AttributeKeyAck]), &ack); err != nil {
+				return ack, false, err
+			}
+			return ack, true, nil
+		}
+	}
+	return ack, false, nil
+}
+
+// queryCounterpartyChannel returns the ID of the counterparty of the channel
+// on the transfer port.
+func queryCounterpartyChannel(endpoint, channelID string) (string, error) {
+	var res channeltypes.QueryChannelResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/ibc/core/channel/v1/channels/%s/ports/transfer", endpoint, channelID))
+	if err != nil {
+		return "", fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return "", err
+	}
+	if res.Channel == nil {
+		return "", fmt.Errorf("channel %s not found", channelID)
+	}
+	return res.Channel.Counterparty.ChannelId, nil
+}
+
+// queryPacketCommitment returns whether the commitment of the packet sent on
+// the given channel with the given sequence is stored, i.e. the packet is
+// neither acknowledged nor timed out yet.
+func queryPacketCommitment(endpoint, channelID string, sequence uint64) (bool, error) {
+	return queryIBCStoreEntry(fmt.Sprintf("%s/ibc/core/channel/v1/channels/%s/ports/transfer/packet_commitments/%d", endpoint, channelID, sequence))
+}
+
+// queryPacketAckCommitment returns whether the commitment of the
+// acknowledgement of the packet received on the given channel with the given
+// sequence is stored, i.e. the acknowledgement is written.
+func queryPacketAckCommitment(endpoint, channelID string, sequence uint64) (bool, error) {
+	return queryIBCStoreEntry(fmt.Sprintf("%s/ibc/core/channel/v1/channels/%s/ports/transfer/packet_acks/%d", endpoint, channelID, sequence))
+}
+
+// queryIBCStoreEntry returns whether the IBC store entry served at the given
+// URL exists, its query failing with a not found error otherwise.
+func queryIBCStoreEntry(url string) (bool, error) {
+	body, err := httpGet(url)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	var res struct {
+		Code    codes.Code `json:"code"`
+		Message string     `json:"message"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return false, err
+	}
+	switch res.Code {
+	case codes.OK:
+		return true, nil
+	case codes.NotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("query failed with code %d: %s", res.Code, res.Message)
+	}
+}
+
+// queryPacketReceipt returns whether the packet of the given sequence was
+// received on the given channel.
+func queryPacketReceipt(endpoint, channelID string, sequence uint64) (bool, error) {
+	var res channeltypes.QueryPacketReceiptResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/ibc/core/channel/v1/channels/%s/ports/transfer/packet_receipts/%d", endpoint, channelID, sequence))
+	if err != nil {
+		return false, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err = cdc.UnmarshalJSON(body, &res); err != nil {
+		return false, err
+	}
+	return res.Received, nil
+}
+
+// queryLatestBlockTime returns the height and the time of the latest block of
+// the chain.
+func queryLatestBlockTime(endpoint string) (int64, time.Time, error) {
+	body, err := httpGet(fmt.Sprintf("%s/cosmos/base/tendermint/v1beta1/blocks/latest", endpoint))
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	var res tmservice.GetLatestBlockResponse
+	if err := cdc.UnmarshalJSON(body, &res); err != nil {
+		return 0, time.Time{}, err
+	}
+	return res.Block.Header.Height, res.Block.Header.Time, nil
+}
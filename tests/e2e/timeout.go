@@ -0,0 +1,38 @@
+package e2e
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// timeoutMultiplierEnv is the environment variable scaling the timeouts of the
+// e2e suite, e.g. 2 on a loaded CI runner or 0.5 for fast local runs.
+const timeoutMultiplierEnv = "GAIA_E2E_TIMEOUT_MULTIPLIER"
+
+// timeoutMultiplier scales the timeouts of the Eventually waits and of the
+// contexts of the suite. It is set from timeoutMultiplierEnv when the suite
+// is set up.
+var timeoutMultiplier = 1.0
+
+// parseTimeoutMultiplier parses the value of timeoutMultiplierEnv, the
+// timeouts are not scaled when it is empty.
+func parseTimeoutMultiplier(str string) (float64, error) {
+	if len(str) == 0 {
+		return 1, nil
+	}
+
+	multiplier, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", timeoutMultiplierEnv, str, err)
+	}
+	if multiplier <= 0 {
+		return 0, fmt.Errorf("%s must be positive, got %q", timeoutMultiplierEnv, str)
+	}
+	return multiplier, nil
+}
+
+// scaleTimeout returns the timeout scaled by the timeout multiplier.
+func scaleTimeout(timeout time.Duration) time.Duration {
+	return time.Duration(float64(timeout) * timeoutMultiplier)
+}
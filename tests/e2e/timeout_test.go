@@ -0,0 +1,43 @@
+package e2e
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeoutMultiplier(t *testing.T) {
+	for str, expMultiplier := range map[string]float64{
+		"":    1,
+		"2":   2,
+		"0.5": 0.5,
+	} {
+		multiplier, err := parseTimeoutMultiplier(str)
+		require.NoError(t, err)
+		require.Equal(t, expMultiplier, multiplier)
+	}
+
+	for _, str := range []string{"0", "-1", "fast"} {
+		_, err := parseTimeoutMultiplier(str)
+		require.Error(t, err, str)
+	}
+}
+
+func TestScaleTimeout(t *testing.T) {
+	defer func(multiplier float64) { timeoutMultiplier = multiplier }(timeoutMultiplier)
+
+	// the wait of the validators producing blocks in the suite setup
+	timeout := 5 * time.Minute
+	require.Equal(t, timeout, scaleTimeout(timeout))
+
+	t.Setenv(timeoutMultiplierEnv, "1.5")
+	var err error
+	timeoutMultiplier, err = parseTimeoutMultiplier(os.Getenv(timeoutMultiplierEnv))
+	require.NoError(t, err)
+	require.Equal(t, 7*time.Minute+30*time.Second, scaleTimeout(timeout))
+
+	timeoutMultiplier = 0.5
+	require.Equal(t, 150*time.Second, scaleTimeout(timeout))
+}
@@ -11,7 +11,9 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
@@ -28,15 +30,21 @@ import (
 
 //nolint:unused
 type validator struct {
-	chain            *chain
-	index            int
-	moniker          string
+	chain *chain
+	index int
+	// moniker is the generated name the instance name of the validator is
+	// derived from
+	moniker string
+	// description is the staking description of the validator, its moniker
+	// is also the moniker of the node
+	description      stakingtypes.Description
 	mnemonic         string
 	keyInfo          keyring.Info
 	privateKey       cryptotypes.PrivKey
 	consensusKey     privval.FilePVKey
 	consensusPrivKey cryptotypes.PrivKey
 	nodeKey          p2p.NodeKey
+	commission       stakingtypes.CommissionRates
 }
 
 type account struct {
@@ -46,6 +54,17 @@ type account struct {
 	privateKey cryptotypes.PrivKey
 }
 
+// multisigAccount is a multisig account whose signer keys are all known.
+type multisigAccount struct {
+	name    string
+	pubKey  *kmultisig.LegacyAminoPubKey
+	signers []*account
+}
+
+func (m *multisigAccount) address() sdk.AccAddress {
+	return sdk.AccAddress(m.pubKey.Address())
+}
+
 func (v *validator) instanceName() string {
 	return fmt.Sprintf("%s%d", v.moniker, v.index)
 }
@@ -68,7 +87,7 @@ func (v *validator) init() error {
 	config := serverCtx.Config
 
 	config.SetRoot(v.configDir())
-	config.Moniker = v.moniker
+	config.Moniker = v.description.Moniker
 
 	genDoc, err := getGenDoc(v.configDir())
 	if err != nil {
@@ -97,7 +116,7 @@ func (v *validator) createNodeKey() error {
 	config := serverCtx.Config
 
 	config.SetRoot(v.configDir())
-	config.Moniker = v.moniker
+	config.Moniker = v.description.Moniker
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
 	if err != nil {
@@ -113,7 +132,7 @@ func (v *validator) createConsensusKey() error {
 	config := serverCtx.Config
 
 	config.SetRoot(v.configDir())
-	config.Moniker = v.moniker
+	config.Moniker = v.description.Moniker
 
 	pvKeyFile := config.PrivValidatorKeyFile()
 	if err := tmos.EnsureDir(filepath.Dir(pvKeyFile), 0o777); err != nil {
@@ -167,46 +186,111 @@ func (v *validator) createKeyFromMnemonic(name, mnemonic string) error {
 }
 
 func (c *chain) addAccountFromMnemonic(counts int) error {
-	val0ConfigDir := c.validators[0].configDir()
-	kb, err := keyring.New(keyringAppName, keyring.BackendTest, val0ConfigDir, nil)
+	kb, algo, err := c.accountsKeyring()
 	if err != nil {
 		return err
 	}
 
+	for i := 0; i < counts; i++ {
+		acct, err := newAccount(kb, algo, fmt.Sprintf("acct-%d", i))
+		if err != nil {
+			return err
+		}
+		c.genesisAccounts = append(c.genesisAccounts, acct)
+	}
+
+	return nil
+}
+
+// addMultisigAccount creates a threshold-of-signers multisig account from
+// newly generated keys. The multisig and its signer keys are stored in the
+// keyring of the first validator and the multisig is funded in genesis along
+// with the genesis accounts.
+func (c *chain) addMultisigAccount(name string, threshold, signers int) (*multisigAccount, error) {
+	kb, algo, err := c.accountsKeyring()
+	if err != nil {
+		return nil, err
+	}
+
+	msig, err := newMultisigAccount(kb, algo, name, threshold, signers)
+	if err != nil {
+		return nil, err
+	}
+	c.multisigAccounts = append(c.multisigAccounts, msig)
+
+	return msig, nil
+}
+
+// accountsKeyring returns the keyring of the first validator, which holds the
+// keys of the accounts created in genesis.
+func (c *chain) accountsKeyring() (keyring.Keyring, keyring.SignatureAlgo, error) {
+	kb, err := keyring.New(keyringAppName, keyring.BackendTest, c.validators[0].configDir(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	keyringAlgos, _ := kb.SupportedAlgorithms()
 	algo, err := keyring.NewSigningAlgoFromString(string(hd.Secp256k1Type), keyringAlgos)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	for i := 0; i < counts; i++ {
-		name := fmt.Sprintf("acct-%d", i)
-		mnemonic, err := createMnemonic()
-		if err != nil {
-			return err
-		}
-		info, err := kb.NewAccount(name, mnemonic, "", sdk.FullFundraiserPath, algo)
-		if err != nil {
-			return err
-		}
+	return kb, algo, nil
+}
 
-		privKeyArmor, err := kb.ExportPrivKeyArmor(name, keyringPassphrase)
-		if err != nil {
-			return err
-		}
+// newAccount creates a key from a new mnemonic in the keyring.
+func newAccount(kb keyring.Keyring, algo keyring.SignatureAlgo, name string) (*account, error) {
+	mnemonic, err := createMnemonic()
+	if err != nil {
+		return nil, err
+	}
+	info, err := kb.NewAccount(name, mnemonic, "", sdk.FullFundraiserPath, algo)
+	if err != nil {
+		return nil, err
+	}
 
-		privKey, _, err := sdkcrypto.UnarmorDecryptPrivKey(privKeyArmor, keyringPassphrase)
+	privKeyArmor, err := kb.ExportPrivKeyArmor(name, keyringPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, _, err := sdkcrypto.UnarmorDecryptPrivKey(privKeyArmor, keyringPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account{
+		moniker:    name,
+		mnemonic:   mnemonic,
+		keyInfo:    info,
+		privateKey: privKey,
+	}, nil
+}
+
+// newMultisigAccount creates the keys of the signers of a threshold-of-signers
+// multisig and saves the multisig in the keyring.
+func newMultisigAccount(kb keyring.Keyring, algo keyring.SignatureAlgo, name string, threshold, signers int) (*multisigAccount, error) {
+	if threshold < 1 || threshold > signers {
+		return nil, fmt.Errorf("invalid multisig threshold %d of %d signers", threshold, signers)
+	}
+
+	msig := &multisigAccount{name: name}
+	pubKeys := make([]cryptotypes.PubKey, signers)
+	for i := 0; i < signers; i++ {
+		signer, err := newAccount(kb, algo, fmt.Sprintf("%s-signer-%d", name, i))
 		if err != nil {
-			return err
+			return nil, err
 		}
-		acct := account{}
-		acct.keyInfo = info
-		acct.mnemonic = mnemonic
-		acct.privateKey = privKey
-		c.genesisAccounts = append(c.genesisAccounts, &acct)
+		msig.signers = append(msig.signers, signer)
+		pubKeys[i] = signer.keyInfo.GetPubKey()
 	}
 
-	return nil
+	msig.pubKey = kmultisig.NewLegacyAminoPubKey(threshold, pubKeys)
+	if _, err := kb.SaveMultisig(name, msig.pubKey); err != nil {
+		return nil, err
+	}
+
+	return msig, nil
 }
 
 func (v *validator) createKey(name string) error {
@@ -219,13 +303,6 @@ func (v *validator) createKey(name string) error {
 }
 
 func (v *validator) buildCreateValidatorMsg(amount sdk.Coin) (sdk.Msg, error) {
-	description := stakingtypes.NewDescription(v.moniker, "", "", "", "")
-	commissionRates := stakingtypes.CommissionRates{
-		Rate:          sdk.MustNewDecFromStr("0.1"),
-		MaxRate:       sdk.MustNewDecFromStr("0.2"),
-		MaxChangeRate: sdk.MustNewDecFromStr("0.01"),
-	}
-
 	// get the initial validator min self delegation
 	minSelfDelegation := sdk.OneInt()
 
@@ -238,12 +315,22 @@ func (v *validator) buildCreateValidatorMsg(amount sdk.Coin) (sdk.Msg, error) {
 		sdk.ValAddress(v.keyInfo.GetAddress()),
 		valPubKey,
 		amount,
-		description,
-		commissionRates,
+		v.description,
+		v.commission,
 		minSelfDelegation,
 	)
 }
 
+// defaultCommissionRates returns the commission rates used by the gentx of a
+// validator that has no explicit commission configured.
+func defaultCommissionRates() stakingtypes.CommissionRates {
+	return stakingtypes.CommissionRates{
+		Rate:          sdk.MustNewDecFromStr("0.1"),
+		MaxRate:       sdk.MustNewDecFromStr("0.2"),
+		MaxChangeRate: sdk.MustNewDecFromStr("0.01"),
+	}
+}
+
 func (v *validator) signMsg(msgs ...sdk.Msg) (*sdktx.Tx, error) {
 	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
 
@@ -316,3 +403,118 @@ func (v *validator) signMsg(msgs ...sdk.Msg) (*sdktx.Tx, error) {
 
 	return decodeTx(bz)
 }
+
+// signMultisigTx returns the encoded tx of the msgs signed by the multisig
+// signers with the given indexes, whether or not they reach the threshold.
+// The legacy amino JSON sign mode is used as the direct sign bytes would
+// commit to the multisig signers before they are known.
+func signMultisigTx(
+	chainID string,
+	msig *multisigAccount,
+	signers []int,
+	accountNumber, sequence uint64,
+	fee sdk.Coins,
+	gas uint64,
+	msgs ...sdk.Msg,
+) ([]byte, error) {
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gas)
+
+	signMode := txsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	bytesToSign, err := encodingConfig.TxConfig.SignModeHandler().GetSignBytes(
+		signMode,
+		authsigning.SignerData{
+			ChainID:       chainID,
+			AccountNumber: accountNumber,
+			Sequence:      sequence,
+		},
+		txBuilder.GetTx(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeys := msig.pubKey.GetPubKeys()
+	multisigData := multisig.NewMultisig(len(pubKeys))
+	for _, i := range signers {
+		if i < 0 || i >= len(msig.signers) {
+			return nil, fmt.Errorf("multisig %s has no signer %d", msig.name, i)
+		}
+		signer := msig.signers[i]
+		sigBytes, err := signer.privateKey.Sign(bytesToSign)
+		if err != nil {
+			return nil, err
+		}
+		sigData := &txsigning.SingleSignatureData{SignMode: signMode, Signature: sigBytes}
+		if err := multisig.AddSignatureFromPubKey(multisigData, sigData, signer.keyInfo.GetPubKey(), pubKeys); err != nil {
+			return nil, err
+		}
+	}
+
+	sig := txsigning.SignatureV2{
+		PubKey:   msig.pubKey,
+		Data:     multisigData,
+		Sequence: sequence,
+	}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return nil, err
+	}
+
+	return encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+}
+
+// signTx returns the encoded tx of the msgs signed by the account of the
+// validator, with the given account number and sequence.
+func (v *validator) signTx(
+	accountNumber, sequence uint64,
+	fee sdk.Coins,
+	gas uint64,
+	msgs ...sdk.Msg,
+) ([]byte, error) {
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gas)
+
+	// the signer infos are part of the direct sign bytes, so the signature is
+	// first set empty, see signMsg
+	signMode := txsigning.SignMode_SIGN_MODE_DIRECT
+	sig := txsigning.SignatureV2{
+		PubKey:   v.keyInfo.GetPubKey(),
+		Data:     &txsigning.SingleSignatureData{SignMode: signMode},
+		Sequence: sequence,
+	}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return nil, err
+	}
+
+	bytesToSign, err := encodingConfig.TxConfig.SignModeHandler().GetSignBytes(
+		signMode,
+		authsigning.SignerData{
+			ChainID:       v.chain.id,
+			AccountNumber: accountNumber,
+			Sequence:      sequence,
+		},
+		txBuilder.GetTx(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	sigBytes, err := v.privateKey.Sign(bytesToSign)
+	if err != nil {
+		return nil, err
+	}
+
+	sig.Data = &txsigning.SingleSignatureData{SignMode: signMode, Signature: sigBytes}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return nil, err
+	}
+
+	return encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+}
@@ -0,0 +1,48 @@
+package e2e
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignMultisigTx(t *testing.T) {
+	kb := keyring.NewInMemory()
+	_, err := newMultisigAccount(kb, hd.Secp256k1, "multisig", 4, 3)
+	require.Error(t, err)
+
+	msig, err := newMultisigAccount(kb, hd.Secp256k1, "multisig", 2, 3)
+	require.NoError(t, err)
+	require.Len(t, msig.signers, 3)
+	info, err := kb.Key("multisig")
+	require.NoError(t, err)
+	require.Equal(t, msig.address(), info.GetAddress())
+
+	signerData := authsigning.SignerData{ChainID: "testchain", AccountNumber: 7, Sequence: 3}
+	msg := banktypes.NewMsgSend(msig.address(), AccAddress(), sdk.NewCoins(tokenAmount))
+	verify := func(signers ...int) error {
+		txBytes, err := signMultisigTx(signerData.ChainID, msig, signers, signerData.AccountNumber, signerData.Sequence, sdk.NewCoins(standardFees), 200_000, msg)
+		require.NoError(t, err)
+		tx, err := txConfig.TxDecoder()(txBytes)
+		require.NoError(t, err)
+		sigs, err := tx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+		require.Equal(t, signerData.Sequence, sigs[0].Sequence)
+
+		return authsigning.VerifySignature(msig.pubKey, signerData, sigs[0].Data, txConfig.SignModeHandler(), tx)
+	}
+
+	require.NoError(t, verify(0, 2))
+	require.NoError(t, verify(0, 1, 2))
+	require.Error(t, verify(1))
+	require.Error(t, verify())
+
+	_, err = signMultisigTx(signerData.ChainID, msig, []int{3}, signerData.AccountNumber, signerData.Sequence, nil, 200_000, msg)
+	require.Error(t, err)
+}
@@ -0,0 +1,9 @@
+package autocompound
+
+import (
+	"github.com/cosmos/gaia/v9/x/autocompound/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
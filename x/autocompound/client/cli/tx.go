@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v9/x/autocompound/types"
+)
+
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auto-compounding transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		NewOptInCmd(),
+		NewOptOutCmd(),
+	)
+	return txCmd
+}
+
+func NewOptInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opt-in",
+		Short: "Opt in to the auto-compounding of the staking rewards of the sender",
+		Long: `Opt in to the auto-compounding of the staking rewards of the sender.
+
+The rewards of each delegation of the sender are periodically withdrawn and
+delegated to the same validator, when they reach the min reward param. The
+rewards are compounded only while they are withdrawn to the sender address.
+
+Example:
+	gaiad tx autocompound opt-in --from mykey
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOptIn(clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewOptOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opt-out",
+		Short: "Opt out of the auto-compounding of the staking rewards of the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOptOut(clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/autocompound/types"
+)
+
+// InitGenesis initializes the params and the opted-in delegators from the
+// genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, delegator := range genState.Delegators {
+		ctx.KVStore(k.storeKey).Set(types.GetDelegatorKey(sdk.MustAccAddressFromBech32(delegator)), []byte{})
+	}
+}
+
+// ExportGenesis returns the params and the opted-in delegators as a genesis
+// state. A compounding round in progress is not exported, it restarts at the
+// next compound interval.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var delegators []string
+	k.IterateOptedIn(ctx, func(delAddr sdk.AccAddress) bool {
+		delegators = append(delegators, delAddr.String())
+		return false
+	})
+
+	return &types.GenesisState{
+		Params:     k.GetParams(ctx),
+		Delegators: delegators,
+	}
+}
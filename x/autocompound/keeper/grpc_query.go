@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v9/x/autocompound/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Delegators returns the opted-in delegators
+func (k Keeper) Delegators(stdCtx context.Context, req *types.QueryDelegatorsRequest) (*types.QueryDelegatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegatorKeyPrefix)
+
+	var delegators []string
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		delegators = append(delegators, delegatorFromKey(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorsResponse{Delegators: delegators, Pagination: pageRes}, nil
+}
+
+// Delegator returns whether a delegator opted in to auto-compounding
+func (k Keeper) Delegator(stdCtx context.Context, req *types.QueryDelegatorRequest) (*types.QueryDelegatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	return &types.QueryDelegatorResponse{OptedIn: k.IsOptedIn(ctx, delAddr)}, nil
+}
+
+// Params returns the module params
+func (k Keeper) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
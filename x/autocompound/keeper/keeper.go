@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/gaia/v9/x/autocompound/types"
+)
+
+// Keeper of the autocompound store
+type Keeper struct {
+	storeKey      storetypes.StoreKey
+	cdc           codec.BinaryCodec
+	paramSpace    paramstypes.Subspace
+	stakingKeeper types.StakingKeeper
+	distrKeeper   types.DistributionKeeper
+}
+
+// NewKeeper creates a new autocompound Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramstypes.Subspace, stakingKeeper types.StakingKeeper, distrKeeper types.DistributionKeeper) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		stakingKeeper: stakingKeeper,
+		distrKeeper:   distrKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the module params. The params that are not set yet take
+// their default value.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// IsOptedIn returns true if the delegator opted in to auto-compounding.
+func (k Keeper) IsOptedIn(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetDelegatorKey(delAddr))
+}
+
+// OptIn registers a delegator for auto-compounding.
+func (k Keeper) OptIn(ctx sdk.Context, delAddr sdk.AccAddress) error {
+	if k.IsOptedIn(ctx, delAddr) {
+		return sdkerrors.Wrap(types.ErrAlreadyOptedIn, delAddr.String())
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetDelegatorKey(delAddr), []byte{})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOptIn,
+		sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+	))
+
+	return nil
+}
+
+// OptOut unregisters a delegator from auto-compounding.
+func (k Keeper) OptOut(ctx sdk.Context, delAddr sdk.AccAddress) error {
+	if !k.IsOptedIn(ctx, delAddr) {
+		return sdkerrors.Wrap(types.ErrNotOptedIn, delAddr.String())
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetDelegatorKey(delAddr))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOptOut,
+		sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+	))
+
+	return nil
+}
+
+// IterateOptedIn iterates over the opted-in delegators by address. The
+// iteration stops when cb returns true.
+func (k Keeper) IterateOptedIn(ctx sdk.Context, cb func(delAddr sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegatorKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(delegatorFromKey(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// GetAllOptedIn returns all the opted-in delegators by address.
+func (k Keeper) GetAllOptedIn(ctx sdk.Context) []sdk.AccAddress {
+	var delegators []sdk.AccAddress
+	k.IterateOptedIn(ctx, func(delAddr sdk.AccAddress) bool {
+		delegators = append(delegators, delAddr)
+		return false
+	})
+	return delegators
+}
+
+// CompoundDueRewards compounds the rewards of the opted-in delegators. A
+// compounding round starts every compound interval blocks and goes over the
+// delegators by address, at most max delegators per block, so that a round
+// may span several blocks. A zero compound interval disables the
+// auto-compounding, pausing the round in progress if any.
+func (k Keeper) CompoundDueRewards(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.CompoundInterval == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.RoundCursorKey)
+	if cursor == nil && ctx.BlockHeight()%int64(params.CompoundInterval) != 0 {
+		return
+	}
+
+	// collect the batch first, the compounding must not write to the store
+	// under iteration
+	var (
+		batch []sdk.AccAddress
+		next  []byte
+	)
+	iterator := prefix.NewStore(store, types.DelegatorKeyPrefix).Iterator(cursor, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(batch)) == params.MaxDelegatorsPerBlock {
+			next = iterator.Key()
+			break
+		}
+		batch = append(batch, delegatorFromKey(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, delAddr := range batch {
+		k.CompoundRewards(ctx, delAddr, params.MinReward)
+	}
+
+	if next == nil {
+		store.Delete(types.RoundCursorKey)
+		return
+	}
+	store.Set(types.RoundCursorKey, next)
+}
+
+// CompoundRewards withdraws the rewards of each delegation of a delegator and
+// delegates them to the same validator. The rewards of a delegation are
+// compounded only if their bond denom amount is at least minReward, otherwise
+// they are left to accrue. A delegator whose rewards are withdrawn to another
+// address is skipped, the rewards are not theirs to delegate. It returns the
+// amount compounded.
+func (k Keeper) CompoundRewards(ctx sdk.Context, delAddr sdk.AccAddress, minReward sdk.Int) sdk.Int {
+	compounded := sdk.ZeroInt()
+	if !k.distrKeeper.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return compounded
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, delegation := range k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr) {
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		// the withdrawal is discarded along with a failed or skipped
+		// delegation
+		cacheCtx, write := ctx.CacheContext()
+		rewards, err := k.distrKeeper.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+		if err != nil {
+			k.Logger(ctx).Error("failed to withdraw rewards", "delegator", delAddr, "validator", valAddr, "err", err)
+			continue
+		}
+		amount := rewards.AmountOf(bondDenom)
+		if amount.LT(minReward) {
+			continue
+		}
+		if _, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			k.Logger(ctx).Error("failed to compound rewards", "delegator", delAddr, "validator", valAddr, "err", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRewardsCompounded,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+		))
+		compounded = compounded.Add(amount)
+	}
+
+	return compounded
+}
+
+// delegatorFromKey returns the delegator of a length prefixed delegator key,
+// without the key prefix.
+func delegatorFromKey(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[1:])
+}
@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/autocompound/keeper"
+	"github.com/cosmos/gaia/v9/x/autocompound/types"
+)
+
+func setupAutoCompound(t *testing.T) (*gaiaapp.GaiaApp, sdk.Context) {
+	t.Helper()
+
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+	return app, ctx
+}
+
+// delegate funds a new delegator with amount and delegates it to the genesis
+// validator.
+func delegate(t *testing.T, app *gaiaapp.GaiaApp, ctx sdk.Context, amount int64) sdk.AccAddress {
+	t.Helper()
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, delAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount))))
+	_, err := app.StakingKeeper.Delegate(ctx, delAddr, sdk.NewInt(amount), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	return delAddr
+}
+
+// allocateRewards allocates about one token of reward per token staked to
+// the genesis validator, funding the distribution module with them.
+func allocateRewards(t *testing.T, app *gaiaapp.GaiaApp, ctx sdk.Context) {
+	t.Helper()
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, validator.GetTokens()))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, coins))
+	app.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(coins...))
+}
+
+func delegationRewards(t *testing.T, app *gaiaapp.GaiaApp, ctx sdk.Context, delAddr sdk.AccAddress) sdk.Int {
+	t.Helper()
+
+	res, err := app.DistrKeeper.DelegationTotalRewards(sdk.WrapSDKContext(ctx), &distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: delAddr.String()})
+	require.NoError(t, err)
+	return res.Total.AmountOf(app.StakingKeeper.BondDenom(ctx)).TruncateInt()
+}
+
+func delegatedTokens(app *gaiaapp.GaiaApp, ctx sdk.Context, delAddr sdk.AccAddress) sdk.Int {
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	delegation, _ := app.StakingKeeper.GetDelegation(ctx, delAddr, validator.GetOperator())
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+func TestCompoundDueRewards(t *testing.T) {
+	app, ctx := setupAutoCompound(t)
+	k := app.AutoCompoundKeeper
+
+	above := delegate(t, app, ctx, 1_000_000)
+	below := delegate(t, app, ctx, 1_000)
+	notOptedIn := delegate(t, app, ctx, 1_000_000)
+	require.NoError(t, k.OptIn(ctx, above))
+	require.NoError(t, k.OptIn(ctx, below))
+
+	// the rewards of a delegation accrue from the block after it started
+	ctx = ctx.WithBlockHeight(3)
+	allocateRewards(t, app, ctx)
+	aboveRewards := delegationRewards(t, app, ctx, above)
+	belowRewards := delegationRewards(t, app, ctx, below)
+	require.True(t, belowRewards.IsPositive())
+	require.True(t, belowRewards.LT(aboveRewards))
+
+	params := types.DefaultParams()
+	params.CompoundInterval = 5
+	params.MinReward = aboveRewards
+	k.SetParams(ctx, params)
+
+	// nothing is compounded before the interval height
+	k.CompoundDueRewards(ctx)
+	require.Equal(t, sdk.NewInt(1_000_000), delegatedTokens(app, ctx, above))
+
+	ctx = ctx.WithBlockHeight(5)
+	k.CompoundDueRewards(ctx)
+	// the delegator above the min reward is compounded
+	require.Equal(t, sdk.NewInt(1_000_000).Add(aboveRewards), delegatedTokens(app, ctx, above))
+	require.True(t, delegationRewards(t, app, ctx, above).IsZero())
+	// the delegator below the min reward keeps accruing its rewards
+	require.Equal(t, sdk.NewInt(1_000), delegatedTokens(app, ctx, below))
+	require.Equal(t, belowRewards, delegationRewards(t, app, ctx, below))
+	// the delegator not opted in is untouched
+	require.Equal(t, sdk.NewInt(1_000_000), delegatedTokens(app, ctx, notOptedIn))
+	require.Equal(t, aboveRewards, delegationRewards(t, app, ctx, notOptedIn))
+}
+
+func TestCompoundRewardsOtherWithdrawAddress(t *testing.T) {
+	app, ctx := setupAutoCompound(t)
+	k := app.AutoCompoundKeeper
+
+	delAddr := delegate(t, app, ctx, 1_000_000)
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, delAddr, sdk.AccAddress("withdraw____________")))
+	ctx = ctx.WithBlockHeight(3)
+	allocateRewards(t, app, ctx)
+
+	// the rewards withdrawn to another address are not compounded
+	require.True(t, k.CompoundRewards(ctx, delAddr, sdk.OneInt()).IsZero())
+	require.Equal(t, sdk.NewInt(1_000_000), delegatedTokens(app, ctx, delAddr))
+}
+
+func TestCompoundDueRewardsBatches(t *testing.T) {
+	app, ctx := setupAutoCompound(t)
+	k := app.AutoCompoundKeeper
+
+	delegators := make([]sdk.AccAddress, 3)
+	for i := range delegators {
+		delegators[i] = delegate(t, app, ctx, 1_000_000)
+		require.NoError(t, k.OptIn(ctx, delegators[i]))
+	}
+	ctx = ctx.WithBlockHeight(3)
+	allocateRewards(t, app, ctx)
+
+	params := types.DefaultParams()
+	params.CompoundInterval = 4
+	params.MinReward = sdk.OneInt()
+	params.MaxDelegatorsPerBlock = 2
+	k.SetParams(ctx, params)
+
+	compounded := func() int {
+		n := 0
+		for _, delAddr := range delegators {
+			if delegatedTokens(app, ctx, delAddr).GT(sdk.NewInt(1_000_000)) {
+				n++
+			}
+		}
+		return n
+	}
+
+	// the round starting at height 4 spans two blocks
+	ctx = ctx.WithBlockHeight(4)
+	k.CompoundDueRewards(ctx)
+	require.Equal(t, 2, compounded())
+	ctx = ctx.WithBlockHeight(5)
+	k.CompoundDueRewards(ctx)
+	require.Equal(t, 3, compounded())
+
+	// the round is over until the next interval
+	total := app.StakingKeeper.GetAllValidators(ctx)[0].GetTokens()
+	ctx = ctx.WithBlockHeight(6)
+	allocateRewards(t, app, ctx)
+	ctx = ctx.WithBlockHeight(7)
+	k.CompoundDueRewards(ctx)
+	require.Equal(t, total, app.StakingKeeper.GetAllValidators(ctx)[0].GetTokens())
+}
+
+func TestMsgServerOptInOut(t *testing.T) {
+	app, ctx := setupAutoCompound(t)
+	msgServer := keeper.NewMsgServerImpl(app.AutoCompoundKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	delAddr := sdk.AccAddress("delegator___________")
+
+	_, err := msgServer.OptOut(goCtx, types.NewMsgOptOut(delAddr))
+	require.ErrorIs(t, err, types.ErrNotOptedIn)
+
+	_, err = msgServer.OptIn(goCtx, types.NewMsgOptIn(delAddr))
+	require.NoError(t, err)
+	_, err = msgServer.OptIn(goCtx, types.NewMsgOptIn(delAddr))
+	require.ErrorIs(t, err, types.ErrAlreadyOptedIn)
+
+	res, err := app.AutoCompoundKeeper.Delegator(goCtx, &types.QueryDelegatorRequest{DelegatorAddress: delAddr.String()})
+	require.NoError(t, err)
+	require.True(t, res.OptedIn)
+
+	_, err = msgServer.OptOut(goCtx, types.NewMsgOptOut(delAddr))
+	require.NoError(t, err)
+	require.False(t, app.AutoCompoundKeeper.IsOptedIn(ctx, delAddr))
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	app, ctx := setupAutoCompound(t)
+	k := app.AutoCompoundKeeper
+
+	require.NoError(t, k.OptIn(ctx, sdk.AccAddress("delegator1__________")))
+	require.NoError(t, k.OptIn(ctx, sdk.AccAddress("delegator2__________")))
+	params := types.DefaultParams()
+	params.CompoundInterval = 100
+	k.SetParams(ctx, params)
+
+	genState := k.ExportGenesis(ctx)
+	require.NoError(t, genState.Validate())
+	require.Len(t, genState.Delegators, 2)
+
+	app2, ctx2 := setupAutoCompound(t)
+	app2.AutoCompoundKeeper.InitGenesis(ctx2, *genState)
+	require.Equal(t, genState, app2.AutoCompoundKeeper.ExportGenesis(ctx2))
+}
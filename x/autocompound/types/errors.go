@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/autocompound module sentinel errors
+var (
+	ErrAlreadyOptedIn = sdkerrors.Register(ModuleName, 2, "delegator already opted in to auto-compounding")
+	ErrNotOptedIn     = sdkerrors.Register(ModuleName, 3, "delegator not opted in to auto-compounding")
+)
@@ -0,0 +1,12 @@
+package types
+
+// autocompound module event types
+const (
+	EventTypeOptIn             = "auto_compound_opt_in"
+	EventTypeOptOut            = "auto_compound_opt_out"
+	EventTypeRewardsCompounded = "rewards_compounded"
+
+	AttributeKeyDelegator = "delegator"
+	AttributeKeyValidator = "validator"
+	AttributeKeyAmount    = "amount"
+)
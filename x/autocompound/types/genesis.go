@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultGenesisState returns the default genesis state, without opted-in
+// delegators.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Delegators))
+	for _, delegator := range gs.Delegators {
+		if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %s: %s", delegator, err)
+		}
+		if seen[delegator] {
+			return fmt.Errorf("duplicate delegator %s", delegator)
+		}
+		seen[delegator] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,638 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/autocompound/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState - initial state of module
+type GenesisState struct {
+	// params are the module params.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// delegators are the delegators opted in to the auto-compounding of their
+	// staking rewards.
+	Delegators []string `protobuf:"bytes,2,rep,name=delegators,proto3" json:"delegators,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be64c3634d456239, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDelegators() []string {
+	if m != nil {
+		return m.Delegators
+	}
+	return nil
+}
+
+// Params defines the set of autocompound module params.
+type Params struct {
+	// compound_interval is the number of blocks between two compounding rounds
+	// of the rewards of the opted-in delegators. Zero disables the
+	// auto-compounding.
+	CompoundInterval uint64 `protobuf:"varint,1,opt,name=compound_interval,json=compoundInterval,proto3" json:"compound_interval,omitempty" yaml:"compound_interval"`
+	// min_reward is the minimum amount of bond denom rewards of a delegation
+	// to compound them, so that dust rewards are left to accumulate.
+	MinReward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_reward,json=minReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_reward" yaml:"min_reward"`
+	// max_delegators_per_block is the maximum number of delegators whose
+	// rewards are compounded in a block. A round with more opted-in delegators
+	// continues in the next blocks.
+	MaxDelegatorsPerBlock uint64 `protobuf:"varint,3,opt,name=max_delegators_per_block,json=maxDelegatorsPerBlock,proto3" json:"max_delegators_per_block,omitempty" yaml:"max_delegators_per_block"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be64c3634d456239, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetCompoundInterval() uint64 {
+	if m != nil {
+		return m.CompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDelegatorsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxDelegatorsPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "gaia.autocompound.v1beta1.GenesisState")
+	proto.RegisterType((*Params)(nil), "gaia.autocompound.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("gaia/autocompound/v1beta1/genesis.proto", fileDescriptor_be64c3634d456239)
+}
+
+var fileDescriptor_be64c3634d456239 = []byte{
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x93, 0xb6, 0x14, 0x3a, 0xf7, 0x2e, 0x6e, 0xc3, 0xbd, 0x90, 0x2b, 0x92, 0xd4, 0x08,
+	0x5a, 0x10, 0x27, 0x54, 0x77, 0x6e, 0x84, 0xa8, 0x48, 0x77, 0x25, 0xee, 0x44, 0x08, 0x93, 0x64,
+	0x88, 0xa1, 0x99, 0x4c, 0x98, 0x99, 0xd6, 0xf6, 0x2d, 0xdc, 0xf8, 0x4e, 0x5d, 0x76, 0x29, 0x2e,
+	0x82, 0xb4, 0x6f, 0xd0, 0x27, 0x90, 0x4c, 0x52, 0x5b, 0x29, 0x5d, 0xe5, 0xf0, 0xe7, 0x3b, 0xff,
+	0xf9, 0x0f, 0x73, 0xc0, 0x69, 0x84, 0x62, 0x64, 0xa3, 0x91, 0xa0, 0x01, 0x25, 0x19, 0x1d, 0xa5,
+	0xa1, 0x3d, 0xee, 0xf9, 0x58, 0xa0, 0x9e, 0x1d, 0xe1, 0x14, 0xf3, 0x98, 0xc3, 0x8c, 0x51, 0x41,
+	0xb5, 0xff, 0x05, 0x08, 0xb7, 0x41, 0x58, 0x81, 0x07, 0x7f, 0x23, 0x1a, 0x51, 0x49, 0xd9, 0x45,
+	0x55, 0x36, 0x58, 0x14, 0xfc, 0xbe, 0x2f, 0x1d, 0x1e, 0x04, 0x12, 0x58, 0xbb, 0x06, 0xcd, 0x0c,
+	0x31, 0x44, 0xb8, 0xae, 0x76, 0xd4, 0xee, 0xaf, 0x8b, 0x23, 0xb8, 0xd7, 0x11, 0x0e, 0x24, 0xe8,
+	0x34, 0x66, 0xb9, 0xa9, 0xb8, 0x55, 0x9b, 0x66, 0x00, 0x10, 0xe2, 0x04, 0x47, 0x48, 0x50, 0xc6,
+	0xf5, 0x5a, 0xa7, 0xde, 0x6d, 0xb9, 0x5b, 0x8a, 0xf5, 0x56, 0x03, 0xcd, 0xb2, 0x51, 0xeb, 0x83,
+	0xf6, 0xda, 0xd3, 0x8b, 0x53, 0x81, 0xd9, 0x18, 0x25, 0x72, 0x6c, 0xc3, 0x39, 0x5c, 0xe5, 0xa6,
+	0x3e, 0x45, 0x24, 0xb9, 0xb2, 0x76, 0x10, 0xcb, 0xfd, 0xb3, 0xd6, 0xfa, 0x95, 0xa4, 0xf9, 0x00,
+	0x90, 0x38, 0xf5, 0x18, 0x7e, 0x41, 0x2c, 0xd4, 0x6b, 0x1d, 0xb5, 0xdb, 0x72, 0x6e, 0x8a, 0x5c,
+	0x1f, 0xb9, 0x79, 0x12, 0xc5, 0xe2, 0x79, 0xe4, 0xc3, 0x80, 0x12, 0x3b, 0xa0, 0x9c, 0x50, 0x5e,
+	0x7d, 0xce, 0x79, 0x38, 0xb4, 0xc5, 0x34, 0xc3, 0x1c, 0xf6, 0x53, 0xb1, 0xca, 0xcd, 0x76, 0x39,
+	0x71, 0xe3, 0x64, 0xb9, 0x2d, 0x12, 0xa7, 0xae, 0xac, 0xb5, 0x27, 0xa0, 0x13, 0x34, 0xf1, 0x36,
+	0xbb, 0x78, 0x19, 0x66, 0x9e, 0x9f, 0xd0, 0x60, 0xa8, 0xd7, 0x65, 0xea, 0xe3, 0x55, 0x6e, 0x9a,
+	0x95, 0xc7, 0x1e, 0xd2, 0x72, 0xff, 0x11, 0x34, 0xb9, 0xfd, 0xfe, 0x33, 0xc0, 0xcc, 0x29, 0x74,
+	0xe7, 0x6e, 0xb6, 0x30, 0xd4, 0xf9, 0xc2, 0x50, 0x3f, 0x17, 0x86, 0xfa, 0xba, 0x34, 0x94, 0xf9,
+	0xd2, 0x50, 0xde, 0x97, 0x86, 0xf2, 0x78, 0xb6, 0x9b, 0x5f, 0x9e, 0xc3, 0xe4, 0xe7, 0x41, 0xc8,
+	0x45, 0xfc, 0xa6, 0x7c, 0xd6, 0xcb, 0xaf, 0x01, 0x00, 0x25, 0x92, 0x5a, 0x46, 0x32, 0x02, 0x00,
+	0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegators) > 0 {
+		for iNdEx := len(m.Delegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Delegators[iNdEx])
+			copy(dAtA[i:], m.Delegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDelegatorsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxDelegatorsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinReward.Size()
+		i -= size
+		if _, err := m.MinReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CompoundInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CompoundInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Delegators) > 0 {
+		for _, s := range m.Delegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompoundInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.CompoundInterval))
+	}
+	l = m.MinReward.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxDelegatorsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxDelegatorsPerBlock))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegators = append(m.Delegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundInterval", wireType)
+			}
+			m.CompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegatorsPerBlock", wireType)
+			}
+			m.MaxDelegatorsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegatorsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the this module
+	ModuleName = "autocompound"
+
+	// StoreKey is the default store key for the module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the module msgs
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// DelegatorKeyPrefix is the prefix of the opted-in delegators
+	DelegatorKeyPrefix = []byte{0x01}
+	// RoundCursorKey is the key of the next delegator of the compounding round
+	// in progress, unset when no round is in progress
+	RoundCursorKey = []byte{0x02}
+)
+
+// GetDelegatorKey returns the store key of an opted-in delegator.
+func GetDelegatorKey(delAddr sdk.AccAddress) []byte {
+	return append(append([]byte{}, DelegatorKeyPrefix...), address.MustLengthPrefix(delAddr)...)
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+// autocompound message types
+const (
+	TypeMsgOptIn  = "opt_in"
+	TypeMsgOptOut = "opt_out"
+)
+
+var (
+	_ sdk.Msg            = &MsgOptIn{}
+	_ legacytx.LegacyMsg = &MsgOptIn{}
+	_ sdk.Msg            = &MsgOptOut{}
+	_ legacytx.LegacyMsg = &MsgOptOut{}
+)
+
+// NewMsgOptIn creates a new MsgOptIn instance.
+func NewMsgOptIn(delAddr sdk.AccAddress) *MsgOptIn {
+	return &MsgOptIn{DelegatorAddress: delAddr.String()}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgOptIn) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgOptIn) Type() string { return TypeMsgOptIn }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgOptIn) GetSigners() []sdk.AccAddress {
+	delAddr, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgOptIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgOptIn) ValidateBasic() error {
+	return validateDelegatorAddress(msg.DelegatorAddress)
+}
+
+// NewMsgOptOut creates a new MsgOptOut instance.
+func NewMsgOptOut(delAddr sdk.AccAddress) *MsgOptOut {
+	return &MsgOptOut{DelegatorAddress: delAddr.String()}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgOptOut) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgOptOut) Type() string { return TypeMsgOptOut }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgOptOut) GetSigners() []sdk.AccAddress {
+	delAddr, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgOptOut) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgOptOut) ValidateBasic() error {
+	return validateDelegatorAddress(msg.DelegatorAddress)
+}
+
+func validateDelegatorAddress(delegator string) error {
+	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	// ParamStoreKeyCompoundInterval store key
+	ParamStoreKeyCompoundInterval = []byte("CompoundInterval")
+	// ParamStoreKeyMinReward store key
+	ParamStoreKeyMinReward = []byte("MinReward")
+	// ParamStoreKeyMaxDelegatorsPerBlock store key
+	ParamStoreKeyMaxDelegatorsPerBlock = []byte("MaxDelegatorsPerBlock")
+)
+
+const (
+	// DefaultMaxDelegatorsPerBlock is the default number of delegators whose
+	// rewards are compounded in a block.
+	DefaultMaxDelegatorsPerBlock uint64 = 100
+)
+
+// DefaultMinReward is the default minimum amount of rewards of a delegation
+// to compound them.
+var DefaultMinReward = sdk.NewInt(1_000_000)
+
+// DefaultParams returns default parameters, with the auto-compounding
+// disabled.
+func DefaultParams() Params {
+	return Params{
+		CompoundInterval:      0,
+		MinReward:             DefaultMinReward,
+		MaxDelegatorsPerBlock: DefaultMaxDelegatorsPerBlock,
+	}
+}
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Validate performs basic params validation.
+func (p Params) Validate() error {
+	if err := validateCompoundInterval(p.CompoundInterval); err != nil {
+		return err
+	}
+	if err := validateMinReward(p.MinReward); err != nil {
+		return err
+	}
+	return validateMaxDelegatorsPerBlock(p.MaxDelegatorsPerBlock)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyCompoundInterval, &p.CompoundInterval, validateCompoundInterval,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMinReward, &p.MinReward, validateMinReward,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxDelegatorsPerBlock, &p.MaxDelegatorsPerBlock, validateMaxDelegatorsPerBlock,
+		),
+	}
+}
+
+func validateCompoundInterval(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+
+	return nil
+}
+
+// this requires the min reward to be positive, so that no empty rewards are
+// compounded
+func validateMinReward(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Int", i)
+	}
+	if v.IsNil() || !v.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min reward must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxDelegatorsPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+	if v == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max delegators per block must be positive")
+	}
+
+	return nil
+}
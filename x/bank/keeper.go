@@ -0,0 +1,214 @@
+package bank
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+)
+
+// SpendLimitKeeper defines the expected spend limit keeper
+type SpendLimitKeeper interface {
+	SendRestriction(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins) error
+	RecordOutflow(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins)
+}
+
+var _ keeper.Keeper = Keeper{}
+
+// Keeper wraps the bank keeper of the SDK to enforce the chain policies on
+// the sends and the mints, whichever module or message triggers them:
+//
+//   - the sends of an account to another account, the multi-send inputs, the
+//     funds sent to the transfer module by an outgoing IBC transfer and the
+//     ICS-29 packet fees escrowed by the fee module are rejected when they
+//     exceed the spending limit of the sender, and are recorded as sent once
+//     they succeed. The other sends of an account to a module, such as the
+//     fees, the deposits or the delegations, are not limited.
+//   - the mints of the modules are capped to the SupplyCaps policy param. The
+//     mints of the transfer module are not checked here: the vouchers
+//     received are checked by the supply cap middleware, which rejects them
+//     with an error acknowledgement, while the refunds of the failed transfers
+//     only mint back the vouchers burnt when sending them, and must not fail
+//     so that the packets do not get stuck.
+type Keeper struct {
+	keeper.BaseKeeper
+	cdc              codec.BinaryCodec
+	storeKey         sdk.StoreKey
+	spendLimitKeeper SpendLimitKeeper
+	policyParam      policy.ParamSource
+}
+
+// NewKeeper returns a Keeper wrapping the given bank keeper, the codec and the
+// store key being the ones of the wrapped keeper.
+func NewKeeper(
+	k keeper.BaseKeeper,
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	spendLimitKeeper SpendLimitKeeper,
+	policyParam policy.ParamSource,
+) Keeper {
+	return Keeper{
+		BaseKeeper:       k,
+		cdc:              cdc,
+		storeKey:         storeKey,
+		spendLimitKeeper: spendLimitKeeper,
+		policyParam:      policyParam,
+	}
+}
+
+// IterateBalancesFrom iterates over the balances of the accounts in the order
+// of the bank store, i.e. by address length then by address, starting at the
+// balances of the given address, or at the first account when it is empty.
+// The iteration stops when the callback returns true.
+func (k Keeper) IterateBalancesFrom(ctx sdk.Context, start sdk.AccAddress, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool)) {
+	var startKey []byte
+	if len(start) > 0 {
+		startKey = address.MustLengthPrefix(start)
+	}
+
+	balancesStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BalancesPrefix)
+	iterator := balancesStore.Iterator(startKey, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr, err := types.AddressFromBalancesStore(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		var balance sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &balance)
+
+		if cb(addr, balance) {
+			break
+		}
+	}
+}
+
+// IterateDenomBalances iterates over the balances of the given denom in the
+// order of the bank store. The store is not indexed by denom, so the accounts
+// are all visited, but only their balance of the denom is read, the
+// iteration seeking to the next account once it is. The iteration stops when
+// the callback returns true.
+func (k Keeper) IterateDenomBalances(ctx sdk.Context, denom string, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool)) {
+	balancesStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BalancesPrefix)
+
+	var startKey []byte
+	for {
+		iterator := balancesStore.Iterator(startKey, nil)
+		if !iterator.Valid() {
+			iterator.Close()
+			return
+		}
+		addr, err := types.AddressFromBalancesStore(iterator.Key())
+		iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+
+		accountKey := address.MustLengthPrefix(addr)
+		if bz := balancesStore.Get(append(accountKey, []byte(denom)...)); bz != nil {
+			var balance sdk.Coin
+			k.cdc.MustUnmarshal(bz, &balance)
+
+			if cb(addr, balance) {
+				return
+			}
+		}
+		startKey = sdk.PrefixEndBytes(accountKey)
+	}
+}
+
+// DeleteDenomMetaData deletes the metadata of the given base denom, which the
+// bank keeper of the SDK only allows to set.
+func (k Keeper) DeleteDenomMetaData(ctx sdk.Context, base string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataKey(base))
+	store.Delete([]byte(base))
+}
+
+// SendCoins rejects the sends exceeding the spending limit of the sender,
+// recording them once they succeed.
+func (k Keeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.spendLimitKeeper.SendRestriction(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+	if err := k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	k.spendLimitKeeper.RecordOutflow(ctx, fromAddr, amt)
+	return nil
+}
+
+// InputOutputCoins rejects the inputs exceeding the spending limit of their
+// address, the inputs of an address being summed, recording them once the
+// sends succeed.
+func (k Keeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
+	sent := make(map[string]sdk.Coins, len(inputs))
+	for _, input := range inputs {
+		from, err := sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return err
+		}
+		sent[input.Address] = sent[input.Address].Add(input.Coins...)
+		if err := k.spendLimitKeeper.SendRestriction(ctx, from, sent[input.Address]); err != nil {
+			return err
+		}
+	}
+	if err := k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+	for _, input := range inputs {
+		k.spendLimitKeeper.RecordOutflow(ctx, sdk.MustAccAddressFromBech32(input.Address), input.Coins)
+	}
+	return nil
+}
+
+// spendLimitedModules are the modules whose funds received from an account
+// count towards its spending limit: the transfer module burns or escrows the
+// funds of the outgoing IBC transfers, and the fee module escrows the ICS-29
+// packet fees, which a relayer can claim back by relaying the packet.
+var spendLimitedModules = map[string]bool{
+	ibctransfertypes.ModuleName: true,
+	ibcfeetypes.ModuleName:      true,
+}
+
+// SendCoinsFromAccountToModule rejects the funds sent to the transfer module
+// by an outgoing IBC transfer, or escrowed by the fee module for the ICS-29
+// packet fees, exceeding the spending limit of the sender, recording them once
+// the send succeeds.
+func (k Keeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if !spendLimitedModules[recipientModule] {
+		return k.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	}
+
+	if err := k.spendLimitKeeper.SendRestriction(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+	if err := k.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+	k.spendLimitKeeper.RecordOutflow(ctx, senderAddr, amt)
+	return nil
+}
+
+// MintCoins rejects the mints taking the total supply of a denom above its
+// cap.
+func (k Keeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	if moduleName != ibctransfertypes.ModuleName {
+		if caps := policy.SupplyCaps(ctx, k.policyParam); !caps.Empty() {
+			for _, coin := range amounts {
+				if err := policy.ValidateSupplyCap(caps, k.GetSupply(ctx, coin.Denom), coin); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return k.BaseKeeper.MintCoins(ctx, moduleName, amounts)
+}
@@ -0,0 +1,154 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+	spendlimittypes "github.com/cosmos/gaia/v9/x/spendlimit/types"
+)
+
+func TestKeeperSpendLimits(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	k := app.BankKeeper
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+	limit := spendlimittypes.NewSpendLimit(alice, coins(100), 10)
+	app.SpendLimitKeeper.SetSpendLimit(ctx, limit)
+	require.NoError(t, simapp.FundAccount(k, ctx, alice, coins(1_000)))
+
+	// a vesting account created by the limited account counts towards its
+	// limit, whichever message sends the funds
+	vestingServer := vesting.NewMsgServerImpl(app.AccountKeeper, k)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := vestingServer.CreateVestingAccount(sdk.WrapSDKContext(cacheCtx), vestingtypes.NewMsgCreateVestingAccount(alice, bob, coins(101), ctx.BlockTime().Unix()+1, false))
+	require.ErrorIs(t, err, spendlimittypes.ErrSpendLimitExceeded)
+	_, err = vestingServer.CreateVestingAccount(sdk.WrapSDKContext(ctx), vestingtypes.NewMsgCreateVestingAccount(alice, bob, coins(40), ctx.BlockTime().Unix()+1, false))
+	require.NoError(t, err)
+	require.Equal(t, coins(40), app.SpendLimitKeeper.Spent(ctx, alice, limit))
+
+	// so do the vouchers sent to the transfer module to be burnt
+	require.ErrorIs(t, k.SendCoinsFromAccountToModule(ctx, alice, ibctransfertypes.ModuleName, coins(61)), spendlimittypes.ErrSpendLimitExceeded)
+	require.NoError(t, k.SendCoinsFromAccountToModule(ctx, alice, ibctransfertypes.ModuleName, coins(20)))
+	require.Equal(t, coins(60), app.SpendLimitKeeper.Spent(ctx, alice, limit))
+
+	// and the ICS-29 packet fees escrowed by the fee module
+	require.ErrorIs(t, k.SendCoinsFromAccountToModule(ctx, alice, ibcfeetypes.ModuleName, coins(41)), spendlimittypes.ErrSpendLimitExceeded)
+	require.NoError(t, k.SendCoinsFromAccountToModule(ctx, alice, ibcfeetypes.ModuleName, coins(10)))
+	require.Equal(t, coins(70), app.SpendLimitKeeper.Spent(ctx, alice, limit))
+
+	// while the other sends to a module are not limited
+	require.NoError(t, k.SendCoinsFromAccountToModule(ctx, alice, minttypes.ModuleName, coins(500)))
+	require.Equal(t, coins(70), app.SpendLimitKeeper.Spent(ctx, alice, limit))
+
+	require.ErrorIs(t, k.SendCoins(ctx, alice, bob, coins(31)), spendlimittypes.ErrSpendLimitExceeded)
+	require.NoError(t, k.SendCoins(ctx, alice, bob, coins(30)))
+	require.Equal(t, coins(100), app.SpendLimitKeeper.Spent(ctx, alice, limit))
+}
+
+func TestKeeperMintCoinsSupplyCaps(t *testing.T) {
+	specs := map[string]struct {
+		module string
+		amount int64
+		expErr bool
+	}{
+		"under the cap": {
+			module: minttypes.ModuleName,
+			amount: 999,
+		},
+		"at the cap": {
+			module: minttypes.ModuleName,
+			amount: 1000,
+		},
+		"over the cap": {
+			module: minttypes.ModuleName,
+			amount: 1001,
+			expErr: true,
+		},
+		"over the cap by the transfer module": {
+			module: ibctransfertypes.ModuleName,
+			amount: 1001,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			app := gaiahelpers.Setup(t)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			app.GetSubspace(policytypes.ModuleName).Set(ctx, policytypes.ParamStoreKeySupplyCaps, sdk.NewCoins(sdk.NewInt64Coin("ucapped", 1000)))
+
+			err := app.BankKeeper.MintCoins(ctx, spec.module, sdk.NewCoins(sdk.NewInt64Coin("ucapped", spec.amount)))
+			if spec.expErr {
+				require.ErrorIs(t, err, policytypes.ErrSupplyCapExceeded)
+				require.True(t, app.BankKeeper.GetSupply(ctx, "ucapped").IsZero())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewInt64Coin("ucapped", spec.amount), app.BankKeeper.GetSupply(ctx, "ucapped"))
+		})
+	}
+}
+
+func TestKeeperIterateDenomBalances(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.BankKeeper
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	carol := sdk.AccAddress("carol_______________")
+	require.NoError(t, simapp.FundAccount(k, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1), sdk.NewInt64Coin("ufoo", 10))))
+	require.NoError(t, simapp.FundAccount(k, ctx, bob, sdk.NewCoins(sdk.NewInt64Coin("ufoo", 20), sdk.NewInt64Coin("uzzz", 2))))
+	require.NoError(t, simapp.FundAccount(k, ctx, carol, sdk.NewCoins(sdk.NewInt64Coin("uatom", 3))))
+
+	var holders []sdk.AccAddress
+	var balances sdk.Coins
+	k.IterateDenomBalances(ctx, "ufoo", func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		holders = append(holders, addr)
+		balances = balances.Add(coin)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{alice, bob}, holders)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufoo", 30)), balances)
+
+	// the iteration stops when the callback returns true
+	holders = nil
+	k.IterateDenomBalances(ctx, "ufoo", func(addr sdk.AccAddress, _ sdk.Coin) bool {
+		holders = append(holders, addr)
+		return true
+	})
+	require.Equal(t, []sdk.AccAddress{alice}, holders)
+}
+
+func TestKeeperDeleteDenomMetaData(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.BankKeeper
+
+	for _, base := range []string{"ufoo", "ufoobar"} {
+		k.SetDenomMetaData(ctx, banktypes.Metadata{
+			Base:       base,
+			Display:    base,
+			DenomUnits: []*banktypes.DenomUnit{{Denom: base}},
+		})
+	}
+
+	k.DeleteDenomMetaData(ctx, "ufoo")
+	_, found := k.GetDenomMetaData(ctx, "ufoo")
+	require.False(t, found)
+	_, found = k.GetDenomMetaData(ctx, "ufoobar")
+	require.True(t, found)
+}
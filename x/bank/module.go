@@ -0,0 +1,52 @@
+package bank
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule wraps the bank module of the SDK to enforce the sanctions and the
+// transfer caps in its msg server, which also serves the messages executed by
+// the interchain accounts. The other services of the module are unchanged.
+type AppModule struct {
+	bank.AppModule
+	keeper         Keeper
+	sanctionKeeper SanctionKeeper
+	policyParam    policy.ParamSource
+}
+
+// NewAppModule creates a new AppModule object.
+func NewAppModule(
+	cdc codec.Codec,
+	k Keeper,
+	ak types.AccountKeeper,
+	sanctionKeeper SanctionKeeper,
+	policyParam policy.ParamSource,
+) AppModule {
+	return AppModule{
+		AppModule:      bank.NewAppModule(cdc, k, ak),
+		keeper:         k,
+		sanctionKeeper: sanctionKeeper,
+		policyParam:    policyParam,
+	}
+}
+
+// RegisterServices registers the wrapped msg server in place of the msg
+// server of the SDK, along with the query server and the migrations of the
+// SDK.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), NewMsgServerImpl(am.keeper, am.sanctionKeeper, am.policyParam))
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper.BaseKeeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,94 @@
+package bank
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+)
+
+// SanctionKeeper defines the expected sanction keeper
+type SanctionKeeper interface {
+	SendRestriction(ctx sdk.Context, from, to sdk.AccAddress) error
+}
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer wraps the bank msg server of the SDK to reject the sends from or
+// to a sanctioned address and the sends above the transfer cap of a denom.
+// The ante handler rejects them early, but the messages executed by the
+// interchain accounts skip it. The spending limits are enforced by the
+// Keeper.
+type msgServer struct {
+	types.MsgServer
+	sanctionKeeper SanctionKeeper
+	policyParam    policy.ParamSource
+}
+
+// NewMsgServerImpl returns an implementation of the bank MsgServer interface
+// enforcing the sanctions and the transfer caps.
+func NewMsgServerImpl(k keeper.Keeper, sanctionKeeper SanctionKeeper, policyParam policy.ParamSource) types.MsgServer {
+	return msgServer{
+		MsgServer:      keeper.NewMsgServerImpl(k),
+		sanctionKeeper: sanctionKeeper,
+		policyParam:    policyParam,
+	}
+}
+
+// Send rejects the sends from or to a sanctioned address and the sends above
+// the transfer cap of a denom.
+func (k msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.MsgSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.sanctionKeeper.SendRestriction(ctx, from, to); err != nil {
+		return nil, err
+	}
+	if err := policy.ValidateTransferCaps(policy.TransferCaps(ctx, k.policyParam), msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return k.MsgServer.Send(goCtx, msg)
+}
+
+// MultiSend rejects the sends from or to a sanctioned address and the inputs
+// or outputs above the transfer cap of a denom.
+func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*types.MsgMultiSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	caps := policy.TransferCaps(ctx, k.policyParam)
+	for _, input := range msg.Inputs {
+		from, err := sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.sanctionKeeper.SendRestriction(ctx, from, nil); err != nil {
+			return nil, err
+		}
+		if err := policy.ValidateTransferCaps(caps, input.Coins); err != nil {
+			return nil, err
+		}
+	}
+	for _, output := range msg.Outputs {
+		to, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.sanctionKeeper.SendRestriction(ctx, nil, to); err != nil {
+			return nil, err
+		}
+		if err := policy.ValidateTransferCaps(caps, output.Coins); err != nil {
+			return nil, err
+		}
+	}
+
+	return k.MsgServer.MultiSend(goCtx, msg)
+}
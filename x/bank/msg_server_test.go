@@ -0,0 +1,160 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/bank"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+	sanctiontypes "github.com/cosmos/gaia/v9/x/sanction/types"
+	spendlimittypes "github.com/cosmos/gaia/v9/x/spendlimit/types"
+)
+
+func TestMsgServerSanctions(t *testing.T) {
+	sanctioned := sdk.AccAddress("sanctioned__________")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	half := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+
+	specs := map[string]struct {
+		msg    sdk.Msg
+		expErr bool
+	}{
+		"normal send": {
+			msg: banktypes.NewMsgSend(alice, bob, coins),
+		},
+		"send from a sanctioned address": {
+			msg:    banktypes.NewMsgSend(sanctioned, bob, coins),
+			expErr: true,
+		},
+		"send to a sanctioned address": {
+			msg:    banktypes.NewMsgSend(alice, sanctioned, coins),
+			expErr: true,
+		},
+		"normal multisend": {
+			msg: banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(alice, coins)},
+				[]banktypes.Output{banktypes.NewOutput(bob, coins)},
+			),
+		},
+		"multisend from a sanctioned address": {
+			msg: banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(sanctioned, coins)},
+				[]banktypes.Output{banktypes.NewOutput(bob, coins)},
+			),
+			expErr: true,
+		},
+		"multisend to a sanctioned address": {
+			msg: banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(alice, coins)},
+				[]banktypes.Output{banktypes.NewOutput(bob, half), banktypes.NewOutput(sanctioned, half)},
+			),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			app := gaiahelpers.Setup(t)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			app.SanctionKeeper.SetSanctioned(ctx, sanctioned)
+			require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, alice, coins))
+			require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, sanctioned, coins))
+			msgServer := bank.NewMsgServerImpl(app.BankKeeper, app.SanctionKeeper, app.GetSubspace(policytypes.ModuleName))
+
+			var err error
+			switch msg := spec.msg.(type) {
+			case *banktypes.MsgSend:
+				_, err = msgServer.Send(sdk.WrapSDKContext(ctx), msg)
+			case *banktypes.MsgMultiSend:
+				_, err = msgServer.MultiSend(sdk.WrapSDKContext(ctx), msg)
+			}
+			if spec.expErr {
+				require.ErrorIs(t, err, sanctiontypes.ErrSanctionedAddress)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgServerSpendLimits(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	msgServer := bank.NewMsgServerImpl(app.BankKeeper, app.SanctionKeeper, app.GetSubspace(policytypes.ModuleName))
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+	limit := spendlimittypes.NewSpendLimit(alice, coins(100), 10)
+	app.SpendLimitKeeper.SetSpendLimit(ctx, limit)
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, alice, coins(50)))
+
+	// a send failing for lack of funds does not use up the allowance
+	_, err := msgServer.Send(sdk.WrapSDKContext(ctx), banktypes.NewMsgSend(alice, bob, coins(60)))
+	require.Error(t, err)
+	require.Empty(t, app.SpendLimitKeeper.Spent(ctx, alice, limit))
+
+	// the successful sends are recorded
+	_, err = msgServer.Send(sdk.WrapSDKContext(ctx), banktypes.NewMsgSend(alice, bob, coins(40)))
+	require.NoError(t, err)
+	require.Equal(t, coins(40), app.SpendLimitKeeper.Spent(ctx, alice, limit))
+
+	// the inputs of an address are summed against its limit
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, alice, coins(1_000)))
+	_, err = msgServer.MultiSend(sdk.WrapSDKContext(ctx), banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(alice, coins(40)), banktypes.NewInput(alice, coins(40))},
+		[]banktypes.Output{banktypes.NewOutput(bob, coins(80))},
+	))
+	require.ErrorIs(t, err, spendlimittypes.ErrSpendLimitExceeded)
+	_, err = msgServer.MultiSend(sdk.WrapSDKContext(ctx), banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(alice, coins(30)), banktypes.NewInput(alice, coins(30))},
+		[]banktypes.Output{banktypes.NewOutput(bob, coins(60))},
+	))
+	require.NoError(t, err)
+	require.Equal(t, coins(100), app.SpendLimitKeeper.Spent(ctx, alice, limit))
+
+	_, err = msgServer.Send(sdk.WrapSDKContext(ctx), banktypes.NewMsgSend(alice, bob, coins(1)))
+	require.ErrorIs(t, err, spendlimittypes.ErrSpendLimitExceeded)
+}
+
+func TestMsgServerTransferCaps(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.GetSubspace(policytypes.ModuleName).Set(ctx, policytypes.ParamStoreKeyTransferCaps, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	msgServer := bank.NewMsgServerImpl(app.BankKeeper, app.SanctionKeeper, app.GetSubspace(policytypes.ModuleName))
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, alice, coins(1_000)))
+
+	_, err := msgServer.Send(sdk.WrapSDKContext(ctx), banktypes.NewMsgSend(alice, bob, coins(101)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.Send(sdk.WrapSDKContext(ctx), banktypes.NewMsgSend(alice, bob, coins(100)))
+	require.NoError(t, err)
+
+	// the cap applies to each input and output of a multisend
+	_, err = msgServer.MultiSend(sdk.WrapSDKContext(ctx), banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(alice, coins(101))},
+		[]banktypes.Output{banktypes.NewOutput(bob, coins(50)), banktypes.NewOutput(bob, coins(51))},
+	))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.MultiSend(sdk.WrapSDKContext(ctx), banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(alice, coins(60)), banktypes.NewInput(alice, coins(60))},
+		[]banktypes.Output{banktypes.NewOutput(bob, coins(120))},
+	))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.MultiSend(sdk.WrapSDKContext(ctx), banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(alice, coins(60)), banktypes.NewInput(alice, coins(60))},
+		[]banktypes.Output{banktypes.NewOutput(bob, coins(60)), banktypes.NewOutput(bob, coins(60))},
+	))
+	require.NoError(t, err)
+}
@@ -0,0 +1,9 @@
+package denommigration
+
+import (
+	"github.com/cosmos/gaia/v9/x/denommigration/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v9/x/denommigration/types"
+)
+
+// GetCmdSubmitMigrateDenomProposal implements the command to submit a migrate
+// denom proposal.
+func GetCmdSubmitMigrateDenomProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-denom [old-denom] [new-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to rename a denom across all the balances",
+		Long: `Submit a proposal to rename a denom across all the account balances, the total
+supply and the denom metadata, along with an initial deposit.
+
+Example:
+$ gaiad tx gov submit-proposal migrate-denom uold unew --title="Rebrand" --description="Rename uold to unew" --deposit=1000uatom --from=<key_or_address>
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewMigrateDenomProposal(title, description, args[0], args[1])
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/cosmos/gaia/v9/x/denommigration/client/cli"
+)
+
+var MigrateDenomProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitMigrateDenomProposal, emptyRestHandler)
+
+// emptyRestHandler returns a handler rejecting the submission of the proposal
+// through the legacy REST routes, which are not supported.
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "migrate_denom",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for denom migration proposals")
+		},
+	}
+}
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/gaia/v9/x/denommigration/types"
+	"github.com/cosmos/gaia/v9/x/policy"
+)
+
+// Keeper migrates the denoms of the bank balances. It has no store of its own.
+type Keeper struct {
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+	// policyParam holds the supply caps
+	policyParam policy.ParamSource
+}
+
+// NewKeeper creates a new denom migration Keeper instance
+func NewKeeper(
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
+	policyParam policy.ParamSource,
+) Keeper {
+	return Keeper{
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		stakingKeeper: stakingKeeper,
+		policyParam:   policyParam,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// MigrateDenom renames oldDenom to newDenom across all the account balances,
+// the total supply and the denom metadata. Either the whole migration is done
+// or an error is returned and the state is left unchanged.
+//
+// The balances are burnt and minted again in the new denom, so that the
+// supply is kept consistent. The migration is rejected when it would leave
+// the state of another module referring to the old denom: oldDenom must not be
+// the bond denom nor be held by a module account. The locked coins of the
+// vesting accounts cannot be migrated either. The migration is also rejected
+// when the minted coins exceed the supply cap of newDenom in the SupplyCaps
+// policy param.
+func (k Keeper) MigrateDenom(ctx sdk.Context, oldDenom, newDenom string) error {
+	if err := types.ValidateDenoms(oldDenom, newDenom); err != nil {
+		return err
+	}
+	if strings.HasPrefix(oldDenom, "ibc/") || strings.HasPrefix(newDenom, "ibc/") {
+		return sdkerrors.Wrap(types.ErrInvalidMigration, "ibc denoms are derived from their trace and cannot be migrated")
+	}
+	if oldDenom == k.stakingKeeper.BondDenom(ctx) {
+		return sdkerrors.Wrapf(types.ErrInvalidMigration, "%s is the bond denom", oldDenom)
+	}
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, newDenom); found || k.bankKeeper.HasSupply(ctx, newDenom) {
+		return sdkerrors.Wrapf(types.ErrInvalidMigration, "denom %s already exists", newDenom)
+	}
+	metadata, hasMetadata := k.bankKeeper.GetDenomMetaData(ctx, oldDenom)
+	if !hasMetadata && !k.bankKeeper.HasSupply(ctx, oldDenom) {
+		return sdkerrors.Wrapf(types.ErrInvalidMigration, "denom %s does not exist", oldDenom)
+	}
+
+	holders, err := k.getHolders(ctx, oldDenom)
+	if err != nil {
+		return err
+	}
+	total := sdk.ZeroInt()
+	for _, holder := range holders {
+		total = total.Add(holder.amount)
+	}
+	caps := policy.SupplyCaps(ctx, k.policyParam)
+	if err := policy.ValidateSupplyCap(caps, k.bankKeeper.GetSupply(ctx, newDenom), sdk.NewCoin(newDenom, total)); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidMigration, err.Error())
+	}
+
+	// all the checks are done, but a failure is still possible in the bank
+	// keeper, so the changes are only written once all succeeded
+	cacheCtx, write := ctx.CacheContext()
+	for _, holder := range holders {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, holder.addr, types.ModuleName, sdk.NewCoins(sdk.NewCoin(oldDenom, holder.amount))); err != nil {
+			return err
+		}
+	}
+	if total.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(cacheCtx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(oldDenom, total))); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.MintCoins(cacheCtx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(newDenom, total))); err != nil {
+			return err
+		}
+	}
+	for _, holder := range holders {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, holder.addr, sdk.NewCoins(sdk.NewCoin(newDenom, holder.amount))); err != nil {
+			return err
+		}
+	}
+	if supply := k.bankKeeper.GetSupply(cacheCtx, oldDenom); !supply.IsZero() {
+		// the supply of a denom is the sum of its balances
+		return sdkerrors.Wrapf(types.ErrInvalidMigration, "%s left in supply after migration", supply)
+	}
+
+	if hasMetadata {
+		k.bankKeeper.SetDenomMetaData(cacheCtx, migrateMetadata(metadata, oldDenom, newDenom))
+		k.bankKeeper.DeleteDenomMetaData(cacheCtx, oldDenom)
+	}
+
+	write()
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDenomMigrated,
+		sdk.NewAttribute(types.AttributeKeyOldDenom, oldDenom),
+		sdk.NewAttribute(types.AttributeKeyNewDenom, newDenom),
+		sdk.NewAttribute(types.AttributeKeyAmount, total.String()),
+		sdk.NewAttribute(types.AttributeKeyHolders, strconv.Itoa(len(holders))),
+	))
+	k.Logger(ctx).Info("migrated denom", "old_denom", oldDenom, "new_denom", newDenom, "amount", total, "holders", len(holders))
+
+	return nil
+}
+
+// MigrateDenomWithGasLimit migrates the denom as MigrateDenom, on a gas meter
+// of its own limited to gasLimit, so that a migration run outside of a tx, e.g.
+// by a proposal in the end blocker, is bounded. The migration is rejected once
+// it exceeds the limit, and the state is left unchanged as MigrateDenom only
+// writes it once the migration is done.
+func (k Keeper) MigrateDenomWithGasLimit(ctx sdk.Context, oldDenom, newDenom string, gasLimit sdk.Gas) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(types.ErrInvalidMigration, "out of gas in %s, the migration exceeds %d gas", outOfGas.Descriptor, gasLimit)
+		}
+	}()
+
+	return k.MigrateDenom(ctx.WithGasMeter(sdk.NewGasMeter(gasLimit)), oldDenom, newDenom)
+}
+
+type holder struct {
+	addr   sdk.AccAddress
+	amount sdk.Int
+}
+
+// getHolders returns the accounts holding the denom, in the order of the bank
+// store. An error is returned if any of them cannot be migrated.
+func (k Keeper) getHolders(ctx sdk.Context, denom string) ([]holder, error) {
+	var (
+		holders []holder
+		err     error
+	)
+	k.bankKeeper.IterateDenomBalances(ctx, denom, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if !coin.IsPositive() {
+			return false
+		}
+		if len(holders) == types.MaxHolders {
+			err = sdkerrors.Wrapf(types.ErrInvalidMigration, "%s is held by more than %d accounts", denom, types.MaxHolders)
+			return true
+		}
+		if _, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI); ok {
+			err = sdkerrors.Wrapf(types.ErrInvalidMigration, "%s is held by the module account %s", denom, addr)
+			return true
+		}
+		if locked := k.bankKeeper.LockedCoins(ctx, addr).AmountOf(denom); locked.IsPositive() {
+			err = sdkerrors.Wrapf(types.ErrInvalidMigration, "%s%s of %s are locked", locked, denom, addr)
+			return true
+		}
+		holders = append(holders, holder{addr: addr, amount: coin.Amount})
+		return false
+	})
+	return holders, err
+}
+
+// migrateMetadata returns the metadata of the old denom renamed to the new
+// denom.
+func migrateMetadata(metadata banktypes.Metadata, oldDenom, newDenom string) banktypes.Metadata {
+	metadata.Base = newDenom
+	if metadata.Display == oldDenom {
+		metadata.Display = newDenom
+	}
+	denomUnits := make([]*banktypes.DenomUnit, len(metadata.DenomUnits))
+	for i, unit := range metadata.DenomUnits {
+		migrated := *unit
+		if migrated.Denom == oldDenom {
+			migrated.Denom = newDenom
+		}
+		denomUnits[i] = &migrated
+	}
+	metadata.DenomUnits = denomUnits
+	return metadata
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/denommigration/types"
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+const (
+	oldDenom = "uold"
+	newDenom = "unew"
+)
+
+var holders = []sdk.AccAddress{
+	sdk.AccAddress("holder1_____________"),
+	sdk.AccAddress("holder2_____________"),
+	sdk.AccAddress("holder3_____________"),
+}
+
+// setupDenom returns an app in which each holder got 100 * (index + 1) of the
+// old denom, which has metadata.
+func setupDenom(t *testing.T) (*gaiaapp.GaiaApp, sdk.Context) {
+	t.Helper()
+
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2, Time: time.Now()})
+
+	for i, holder := range holders {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, holder))
+		fund(t, app, ctx, holder, sdk.NewCoins(sdk.NewInt64Coin(oldDenom, int64(100*(i+1))), sdk.NewInt64Coin("stake", 10)))
+	}
+	app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: "The old token",
+		Base:        oldDenom,
+		Display:     "old",
+		Name:        "Old",
+		Symbol:      "OLD",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: oldDenom, Exponent: 0, Aliases: []string{"microold"}},
+			{Denom: "old", Exponent: 6},
+		},
+	})
+
+	return app, ctx
+}
+
+func fund(t *testing.T, app *gaiaapp.GaiaApp, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	t.Helper()
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+}
+
+func TestMigrateDenom(t *testing.T) {
+	app, ctx := setupDenom(t)
+	stakeSupply := app.BankKeeper.GetSupply(ctx, "stake")
+
+	require.NoError(t, app.DenomMigrationKeeper.MigrateDenom(ctx, oldDenom, newDenom))
+
+	for i, holder := range holders {
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(newDenom, int64(100*(i+1))), sdk.NewInt64Coin("stake", 10)), app.BankKeeper.GetAllBalances(ctx, holder))
+	}
+	require.False(t, app.BankKeeper.HasSupply(ctx, oldDenom))
+	require.Equal(t, sdk.NewInt64Coin(newDenom, 600), app.BankKeeper.GetSupply(ctx, newDenom))
+	require.Equal(t, stakeSupply, app.BankKeeper.GetSupply(ctx, "stake"))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+
+	_, found := app.BankKeeper.GetDenomMetaData(ctx, oldDenom)
+	require.False(t, found)
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, newDenom)
+	require.True(t, found)
+	require.Equal(t, banktypes.Metadata{
+		Description: "The old token",
+		Base:        newDenom,
+		Display:     "old",
+		Name:        "Old",
+		Symbol:      "OLD",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: newDenom, Exponent: 0, Aliases: []string{"microold"}},
+			{Denom: "old", Exponent: 6},
+		},
+	}, metadata)
+
+	// the old denom no more exists
+	require.ErrorIs(t, app.DenomMigrationKeeper.MigrateDenom(ctx, oldDenom, "uother"), types.ErrInvalidMigration)
+}
+
+func TestMigrateDenomWithGasLimit(t *testing.T) {
+	app, ctx := setupDenom(t)
+
+	// the migration of the 3 holders takes more than 10000 gas
+	require.ErrorIs(t, app.DenomMigrationKeeper.MigrateDenomWithGasLimit(ctx, oldDenom, newDenom, 10_000), types.ErrInvalidMigration)
+	for i, holder := range holders {
+		require.Equal(t, sdk.NewInt64Coin(oldDenom, int64(100*(i+1))), app.BankKeeper.GetBalance(ctx, holder, oldDenom))
+	}
+	require.True(t, app.BankKeeper.GetSupply(ctx, newDenom).IsZero())
+
+	require.NoError(t, app.DenomMigrationKeeper.MigrateDenomWithGasLimit(ctx, oldDenom, newDenom, types.MaxProposalGas))
+	for i, holder := range holders {
+		require.Equal(t, sdk.NewInt64Coin(newDenom, int64(100*(i+1))), app.BankKeeper.GetBalance(ctx, holder, newDenom))
+	}
+}
+
+func TestMigrateDenomRejected(t *testing.T) {
+	specs := map[string]struct {
+		setup    func(t *testing.T, app *gaiaapp.GaiaApp, ctx sdk.Context)
+		oldDenom string
+		newDenom string
+	}{
+		"same denoms": {
+			oldDenom: oldDenom,
+			newDenom: oldDenom,
+		},
+		"invalid new denom": {
+			oldDenom: oldDenom,
+			newDenom: "1",
+		},
+		"bond denom": {
+			oldDenom: "stake",
+			newDenom: newDenom,
+		},
+		"ibc denom": {
+			oldDenom: oldDenom,
+			newDenom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		},
+		"unknown old denom": {
+			oldDenom: "uunknown",
+			newDenom: newDenom,
+		},
+		"existing new denom": {
+			setup: func(t *testing.T, app *gaiaapp.GaiaApp, ctx sdk.Context) {
+				fund(t, app, ctx, holders[0], sdk.NewCoins(sdk.NewInt64Coin(newDenom, 1)))
+			},
+			oldDenom: oldDenom,
+			newDenom: newDenom,
+		},
+		"held by a module account": {
+			setup: func(t *testing.T, app *gaiaapp.GaiaApp, ctx sdk.Context) {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(oldDenom, 1))
+				require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+				require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, coins))
+			},
+			oldDenom: oldDenom,
+			newDenom: newDenom,
+		},
+		"above the supply cap of the new denom": {
+			setup: func(t *testing.T, app *gaiaapp.GaiaApp, ctx sdk.Context) {
+				// the holders hold 600 of the old denom
+				app.GetSubspace(policy.ModuleName).Set(ctx, policytypes.ParamStoreKeySupplyCaps, sdk.NewCoins(sdk.NewInt64Coin(newDenom, 599)))
+			},
+			oldDenom: oldDenom,
+			newDenom: newDenom,
+		},
+		"locked in a vesting account": {
+			setup: func(t *testing.T, app *gaiaapp.GaiaApp, ctx sdk.Context) {
+				addr := sdk.AccAddress("vesting_____________")
+				coins := sdk.NewCoins(sdk.NewInt64Coin(oldDenom, 100))
+				baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+				app.AccountKeeper.SetAccount(ctx, vestingtypes.NewContinuousVestingAccount(baseAcc, coins, ctx.BlockTime().Unix(), ctx.BlockTime().Add(time.Hour).Unix()))
+				fund(t, app, ctx, addr, coins)
+			},
+			oldDenom: oldDenom,
+			newDenom: newDenom,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			app, ctx := setupDenom(t)
+			if spec.setup != nil {
+				spec.setup(t, app, ctx)
+			}
+
+			require.ErrorIs(t, app.DenomMigrationKeeper.MigrateDenom(ctx, spec.oldDenom, spec.newDenom), types.ErrInvalidMigration)
+
+			// nothing was migrated
+			for i, holder := range holders {
+				require.Equal(t, sdk.NewInt64Coin(oldDenom, int64(100*(i+1))), app.BankKeeper.GetBalance(ctx, holder, oldDenom))
+			}
+			_, found := app.BankKeeper.GetDenomMetaData(ctx, oldDenom)
+			require.True(t, found)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package denommigration
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v9/x/denommigration/keeper"
+	"github.com/cosmos/gaia/v9/x/denommigration/types"
+)
+
+// NewMigrateDenomProposalHandler returns the gov handler of the denom
+// migration proposals.
+func NewMigrateDenomProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.MigrateDenomProposal:
+			// the gov end blocker executing the proposals is not metered
+			return k.MigrateDenomWithGasLimit(ctx, c.OldDenom, c.NewDenom, types.MaxProposalGas)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized denom migration proposal content type: %T", c)
+		}
+	}
+}
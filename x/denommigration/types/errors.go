@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/denommigration module sentinel errors
+var (
+	ErrInvalidMigration = sdkerrors.Register(ModuleName, 2, "invalid denom migration")
+)
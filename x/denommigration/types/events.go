@@ -0,0 +1,11 @@
+package types
+
+// denommigration module event types
+const (
+	EventTypeDenomMigrated = "denom_migrated"
+
+	AttributeKeyOldDenom = "old_denom"
+	AttributeKeyNewDenom = "new_denom"
+	AttributeKeyAmount   = "amount"
+	AttributeKeyHolders  = "holders"
+)
@@ -0,0 +1,19 @@
+package types
+
+const (
+	// ModuleName is the name of the this module
+	ModuleName = "denommigration"
+
+	// RouterKey is the message route for the module proposals
+	RouterKey = ModuleName
+)
+
+// MaxHolders is the maximum number of accounts holding the migrated denom,
+// so that a migration completes within the block of the proposal.
+const MaxHolders = 10_000
+
+// MaxProposalGas is the maximum gas consumed by the migration of a proposal,
+// from the scan of the accounts for the holders to the migration of their
+// balances, so that it completes within the block of the proposal. A larger
+// migration must be done by an upgrade handler.
+const MaxProposalGas = 300_000_000
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ProposalTypeMigrateDenom defines the type for a MigrateDenomProposal
+const ProposalTypeMigrateDenom = "MigrateDenom"
+
+var _ govtypes.Content = &MigrateDenomProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeMigrateDenom)
+	govtypes.RegisterProposalTypeCodec(&MigrateDenomProposal{}, "gaia/MigrateDenomProposal")
+}
+
+// ValidateDenoms checks that the denoms of a migration are valid and
+// different.
+func ValidateDenoms(oldDenom, newDenom string) error {
+	if err := sdk.ValidateDenom(oldDenom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMigration, "invalid old denom: %s", err)
+	}
+	if err := sdk.ValidateDenom(newDenom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMigration, "invalid new denom: %s", err)
+	}
+	if oldDenom == newDenom {
+		return sdkerrors.Wrapf(ErrInvalidMigration, "old and new denoms are both %s", oldDenom)
+	}
+
+	return nil
+}
+
+// NewMigrateDenomProposal creates a new migrate denom proposal.
+func NewMigrateDenomProposal(title, description, oldDenom, newDenom string) *MigrateDenomProposal {
+	return &MigrateDenomProposal{
+		Title:       title,
+		Description: description,
+		OldDenom:    oldDenom,
+		NewDenom:    newDenom,
+	}
+}
+
+// GetTitle returns the title of a migrate denom proposal.
+func (p *MigrateDenomProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a migrate denom proposal.
+func (p *MigrateDenomProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a migrate denom proposal.
+func (p *MigrateDenomProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a migrate denom proposal.
+func (p *MigrateDenomProposal) ProposalType() string { return ProposalTypeMigrateDenom }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *MigrateDenomProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return ValidateDenoms(p.OldDenom, p.NewDenom)
+}
+
+// String implements the Stringer interface.
+func (p MigrateDenomProposal) String() string {
+	return fmt.Sprintf(`Migrate Denom Proposal:
+  Title:       %s
+  Description: %s
+  Old Denom:   %s
+  New Denom:   %s
+`, p.Title, p.Description, p.OldDenom, p.NewDenom)
+}
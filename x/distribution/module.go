@@ -0,0 +1,49 @@
+package distribution
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule wraps the distribution module of the SDK to enforce the sanctions
+// in its msg server, which also serves the messages executed by the
+// interchain accounts. The other services of the module are unchanged.
+type AppModule struct {
+	distr.AppModule
+	keeper         keeper.Keeper
+	sanctionKeeper SanctionKeeper
+}
+
+// NewAppModule creates a new AppModule object.
+func NewAppModule(
+	cdc codec.Codec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	sanctionKeeper SanctionKeeper,
+) AppModule {
+	return AppModule{
+		AppModule:      distr.NewAppModule(cdc, k, ak, bk, sk),
+		keeper:         k,
+		sanctionKeeper: sanctionKeeper,
+	}
+}
+
+// RegisterServices registers the wrapped msg server in place of the msg
+// server of the SDK, along with the query server and the migrations of the
+// SDK.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), NewMsgServerImpl(am.keeper, am.sanctionKeeper))
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
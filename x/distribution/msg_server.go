@@ -0,0 +1,48 @@
+package distribution
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// SanctionKeeper defines the expected sanction keeper
+type SanctionKeeper interface {
+	SendRestriction(ctx sdk.Context, from, to sdk.AccAddress) error
+}
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer wraps the distribution msg server of the SDK to reject a
+// sanctioned withdraw address, which would otherwise receive the rewards of
+// the delegator. The ante handler rejects it early, but the messages executed
+// by the interchain accounts skip it.
+type msgServer struct {
+	types.MsgServer
+	sanctionKeeper SanctionKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the distribution MsgServer
+// interface enforcing the sanctions.
+func NewMsgServerImpl(k keeper.Keeper, sanctionKeeper SanctionKeeper) types.MsgServer {
+	return msgServer{
+		MsgServer:      keeper.NewMsgServerImpl(k),
+		sanctionKeeper: sanctionKeeper,
+	}
+}
+
+// SetWithdrawAddress rejects a sanctioned withdraw address.
+func (k msgServer) SetWithdrawAddress(goCtx context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	withdrawAddr, err := sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.sanctionKeeper.SendRestriction(ctx, nil, withdrawAddr); err != nil {
+		return nil, err
+	}
+
+	return k.MsgServer.SetWithdrawAddress(goCtx, msg)
+}
@@ -0,0 +1,50 @@
+package distribution_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/distribution"
+	sanctiontypes "github.com/cosmos/gaia/v9/x/sanction/types"
+)
+
+func TestMsgServerSanctions(t *testing.T) {
+	sanctioned := sdk.AccAddress("sanctioned__________")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+
+	specs := map[string]struct {
+		msg    *distrtypes.MsgSetWithdrawAddress
+		expErr bool
+	}{
+		"normal withdraw address": {
+			msg: distrtypes.NewMsgSetWithdrawAddress(alice, bob),
+		},
+		"sanctioned withdraw address": {
+			msg:    distrtypes.NewMsgSetWithdrawAddress(alice, sanctioned),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			app := gaiahelpers.Setup(t)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			app.SanctionKeeper.SetSanctioned(ctx, sanctioned)
+			msgServer := distribution.NewMsgServerImpl(app.DistrKeeper, app.SanctionKeeper)
+
+			_, err := msgServer.SetWithdrawAddress(sdk.WrapSDKContext(ctx), spec.msg)
+			if spec.expErr {
+				require.ErrorIs(t, err, sanctiontypes.ErrSanctionedAddress)
+				require.Equal(t, alice, app.DistrKeeper.GetDelegatorWithdrawAddr(ctx, alice))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, bob, app.DistrKeeper.GetDelegatorWithdrawAddr(ctx, alice))
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package downtimegrace
+
+import (
+	"github.com/cosmos/gaia/v9/x/downtimegrace/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/downtimegrace/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the module params
+func (k Keeper) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/gaia/v9/x/downtimegrace/types"
+)
+
+// Keeper of the downtime grace period. It has no store of its own: the grace
+// period is applied to the slashing signing infos of the bonded validators.
+type Keeper struct {
+	paramSpace     paramstypes.Subspace
+	slashingKeeper types.SlashingKeeper
+}
+
+// NewKeeper creates a new downtime grace Keeper instance
+func NewKeeper(paramSpace paramstypes.Subspace, slashingKeeper types.SlashingKeeper) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace:     paramSpace,
+		slashingKeeper: slashingKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the module params. The params that are not set yet take
+// their default value.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// ApplyGracePeriod delays the start of the downtime accounting of a validator
+// which just bonded by the grace period.
+//
+// The slashing module only slashes a validator for downtime once a full
+// signed blocks window passed since the start height of its signing info, and
+// the missed blocks counter only covers that window. Moving the start height
+// to the end of the grace period thus both suspends the downtime slashing
+// during the grace period and ignores the blocks missed within it.
+func (k Keeper) ApplyGracePeriod(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	gracePeriod := k.GetParams(ctx).GracePeriod
+	if gracePeriod == 0 {
+		return
+	}
+
+	// the signing info is created by the slashing hook, which runs first
+	info, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return
+	}
+
+	graceEnd := ctx.BlockHeight() + int64(gracePeriod)
+	if info.StartHeight >= graceEnd {
+		return
+	}
+	info.StartHeight = graceEnd
+	k.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	k.Logger(ctx).Info("downtime grace period started", "validator", consAddr.String(), "end_height", graceEnd)
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/downtimegrace/types"
+)
+
+const (
+	bondHeight   = 10
+	signedWindow = 10
+)
+
+func TestDowntimeGracePeriod(t *testing.T) {
+	specs := map[string]struct {
+		gracePeriod uint64
+		// signFrom is the height from which the validator signs again, it
+		// misses all the blocks when zero
+		signFrom int64
+		// expJailHeight is the height at which the validator is jailed for
+		// downtime, it is not jailed when zero
+		expJailHeight int64
+	}{
+		"no grace period": {
+			expJailHeight: bondHeight + signedWindow + 1,
+		},
+		"slashed after the grace period": {
+			gracePeriod:   100,
+			expJailHeight: bondHeight + 100 + signedWindow + 1,
+		},
+		"blocks missed within the grace period not counted": {
+			gracePeriod: 100,
+			signFrom:    bondHeight + 100 + 1,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			app := gaiahelpers.Setup(t)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: bondHeight, Time: time.Now()})
+
+			slashingParams := slashingtypes.DefaultParams()
+			slashingParams.SignedBlocksWindow = signedWindow
+			slashingParams.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+			app.SlashingKeeper.SetParams(ctx, slashingParams)
+			app.DowntimeGraceKeeper.SetParams(ctx, types.Params{GracePeriod: spec.gracePeriod})
+
+			validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+			consAddr, err := validator.GetConsAddr()
+			require.NoError(t, err)
+			power := validator.GetConsensusPower(app.StakingKeeper.PowerReduction(ctx))
+
+			// the validator bonds with a new signing info
+			app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, bondHeight, 0, time.Unix(0, 0), false, 0))
+			app.StakingKeeper.AfterValidatorBonded(ctx, consAddr, validator.GetOperator())
+			info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+			require.True(t, found)
+			require.Equal(t, bondHeight+int64(spec.gracePeriod), info.StartHeight)
+
+			lastHeight := spec.expJailHeight
+			if lastHeight == 0 {
+				lastHeight = bondHeight + int64(spec.gracePeriod) + 2*signedWindow
+			}
+			for height := int64(bondHeight + 1); height <= lastHeight; height++ {
+				signed := spec.signFrom != 0 && height >= spec.signFrom
+				app.SlashingKeeper.HandleValidatorSignature(ctx.WithBlockHeight(height), consAddr.Bytes(), power, signed)
+
+				jailed := app.StakingKeeper.Validator(ctx, validator.GetOperator()).IsJailed()
+				require.Equal(t, height == spec.expJailHeight, jailed, "height %d", height)
+				if jailed {
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestDowntimeGracePeriodNotShortened(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: bondHeight, Time: time.Now()})
+	app.DowntimeGraceKeeper.SetParams(ctx, types.Params{GracePeriod: 100})
+
+	consAddr := sdk.ConsAddress("validator___________")
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 1000, 0, time.Unix(0, 0), false, 0))
+	app.DowntimeGraceKeeper.ApplyGracePeriod(ctx, consAddr)
+
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(1000), info.StartHeight)
+}
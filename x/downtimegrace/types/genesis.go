@@ -0,0 +1,13 @@
+package types
+
+// DefaultGenesisState returns the default genesis state, without grace period.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// ParamStoreKeyGracePeriod store key
+var ParamStoreKeyGracePeriod = []byte("GracePeriod")
+
+// DefaultParams returns default parameters, without grace period.
+func DefaultParams() Params {
+	return Params{
+		GracePeriod: 0,
+	}
+}
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Validate performs basic params validation.
+func (p Params) Validate() error {
+	return validateGracePeriod(p.GracePeriod)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyGracePeriod, &p.GracePeriod, validateGracePeriod,
+		),
+	}
+}
+
+func validateGracePeriod(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+
+	return nil
+}
@@ -617,6 +617,154 @@ func (s *IntegrationTestSuite) TestGlobalFeeMinimumGasFeeAnteHandler() {
 	}
 }
 
+// Test the min flat fee floor enforced together with the gas-based global fee.
+func (s *IntegrationTestSuite) TestGlobalFeeMinFlatFee() {
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// 0.001uatom per gas unit with a flat floor of 1000uatom,
+	// the floor dominates below 1_000_000 gas. The flat fee in uquark is
+	// ignored, uquark missing from the global fees.
+	globalfeeParams := &globfeetypes.Params{
+		MinimumGasPrices: []sdk.DecCoin{
+			sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 3)),
+		},
+		MinFlatFee: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000)), sdk.NewCoin("uquark", sdk.NewInt(1000))),
+	}
+	var (
+		lowGasLimit  uint64 = 10_000
+		highGasLimit uint64 = 2_000_000
+	)
+
+	testCases := map[string]struct {
+		gasPrice sdk.Coins
+		gasLimit sdk.Gas
+		txMsg    sdk.Msg
+		expErr   bool
+	}{
+		"low gas, fee covers gas-based fee but not the flat fee": {
+			gasPrice: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(500))),
+			gasLimit: lowGasLimit,
+			txMsg:    testdata.NewTestMsg(addr1),
+			expErr:   true,
+		},
+		"low gas, fee equal to the flat fee": {
+			gasPrice: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000))),
+			gasLimit: lowGasLimit,
+			txMsg:    testdata.NewTestMsg(addr1),
+			expErr:   false,
+		},
+		"low gas, fee equal to the flat fee of a denom missing from the global fees": {
+			gasPrice: sdk.NewCoins(sdk.NewCoin("uquark", sdk.NewInt(1000))),
+			gasLimit: lowGasLimit,
+			txMsg:    testdata.NewTestMsg(addr1),
+			expErr:   true,
+		},
+		"high gas, fee covers the flat fee but not the gas-based fee": {
+			gasPrice: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1500))),
+			gasLimit: highGasLimit,
+			txMsg:    testdata.NewTestMsg(addr1),
+			expErr:   true,
+		},
+		"high gas, fee equal to the gas-based fee": {
+			gasPrice: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(2000))),
+			gasLimit: highGasLimit,
+			txMsg:    testdata.NewTestMsg(addr1),
+			expErr:   false,
+		},
+		"low gas, bypass msg type with empty fee": {
+			gasPrice: sdk.Coins{},
+			gasLimit: lowGasLimit,
+			txMsg: ibcchanneltypes.NewMsgRecvPacket(
+				ibcchanneltypes.Packet{}, nil, ibcclienttypes.Height{}, ""),
+			expErr: false,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			_, antehandler := s.SetupTestGlobalFeeStoreAndMinGasPrice([]sdk.DecCoin{}, globalfeeParams)
+
+			s.Require().NoError(s.txBuilder.SetMsgs(tc.txMsg))
+			s.txBuilder.SetFeeAmount(tc.gasPrice)
+			s.txBuilder.SetGasLimit(tc.gasLimit)
+			tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+			s.Require().NoError(err)
+
+			_, err = antehandler(s.ctx, tx, false)
+			if !tc.expErr {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+type fixedDynamicFees sdk.Dec
+
+func (f fixedDynamicFees) Multiplier(sdk.Context) sdk.Dec { return sdk.Dec(f) }
+
+// Test the global fee scaled by the dynamic multiplier.
+func (s *IntegrationTestSuite) TestGlobalFeeDynamicMultiplier() {
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// 0.001uatom per gas unit, i.e. 1000uatom for 1_000_000 gas
+	globalfeeParams := &globfeetypes.Params{
+		MinimumGasPrices: []sdk.DecCoin{
+			sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 3)),
+		},
+	}
+	const gasLimit uint64 = 1_000_000
+
+	testCases := map[string]struct {
+		multiplier sdk.Dec
+		fee        sdk.Coins
+		expErr     bool
+	}{
+		"no multiplier, fee equal to the global fee": {
+			fee: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000))),
+		},
+		"multiplier of one, fee equal to the global fee": {
+			multiplier: sdk.OneDec(),
+			fee:        sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000))),
+		},
+		"multiplier above one, fee equal to the static global fee": {
+			multiplier: sdk.NewDecWithPrec(15, 1),
+			fee:        sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000))),
+			expErr:     true,
+		},
+		"multiplier above one, fee equal to the scaled global fee": {
+			multiplier: sdk.NewDecWithPrec(15, 1),
+			fee:        sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1500))),
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			feeDecorator, _ := s.SetupTestGlobalFeeStoreAndMinGasPrice([]sdk.DecCoin{}, globalfeeParams)
+			if !tc.multiplier.IsNil() {
+				feeDecorator.DynamicFees = fixedDynamicFees(tc.multiplier)
+			}
+			antehandler := sdk.ChainAnteDecorators(feeDecorator)
+
+			s.Require().NoError(s.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			s.txBuilder.SetFeeAmount(tc.fee)
+			s.txBuilder.SetGasLimit(gasLimit)
+			tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+			s.Require().NoError(err)
+
+			_, err = antehandler(s.ctx, tx, false)
+			if !tc.expErr {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 // Test how the operator fees are determined using various min gas prices.
 //
 // Note that in a real Gaia deployment all zero coins can be removed from minGasPrice.
@@ -31,6 +31,16 @@ type FeeDecorator struct {
 	GlobalMinFee                    globalfee.ParamSource
 	StakingSubspace                 paramtypes.Subspace
 	MaxTotalBypassMinFeeMsgGasUsage uint64
+	// RejectionRecorder, if set, records the txs rejected for insufficient fees
+	RejectionRecorder globalfee.FeeRejectionRecorder
+	// GasPriceRecorder, if set, records the gas prices paid by the delivered txs
+	GasPriceRecorder globalfee.GasPriceRecorder
+	// BypassRecorder, if set, records whether the delivered txs bypassed the
+	// minimum fees
+	BypassRecorder globalfee.BypassRecorder
+	// DynamicFees, if set, scales the global minimum gas prices by the dynamic
+	// multiplier derived from the fullness of the recent blocks
+	DynamicFees globalfee.DynamicFeeSource
 }
 
 func NewFeeDecorator(bypassMsgTypes []string, globalfeeSubspace, stakingSubspace paramtypes.Subspace, maxTotalBypassMinFeeMsgGasUsage uint64) FeeDecorator {
@@ -59,6 +69,14 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 
 	// Only check for minimum fees and global fee if the execution mode is CheckTx
 	if !ctx.IsCheckTx() || simulate {
+		if !simulate && mfd.GasPriceRecorder != nil {
+			mfd.GasPriceRecorder.RecordGasPrices(ctx, feeTx.GetFee(), feeTx.GetGas())
+		}
+		if !simulate && mfd.BypassRecorder != nil {
+			msgs := feeTx.GetMsgs()
+			bypassed := mfd.ContainsOnlyBypassMinFeeMsgs(msgs) && feeTx.GetGas() <= mfd.MaxTotalBypassMinFeeMsgGasUsage
+			mfd.BypassRecorder.RecordBypass(ctx, msgs, bypassed)
+		}
 		return next(ctx, tx, simulate)
 	}
 
@@ -95,6 +113,7 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	// if feeCoinsNoZeroDenom=[], DenomsSubsetOf returns true
 	// if feeCoinsNoZeroDenom is not empty, but nonZeroCoinFeesReq empty, return false
 	if !feeCoinsNonZeroDenom.DenomsSubsetOf(nonZeroCoinFeesReq) {
+		mfd.recordRejection(ctx, nonZeroCoinFeesReq, feeCoins)
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "fee is not a subset of required fees; got %s, required: %s", feeCoins, combinedFeeRequirement)
 	}
 
@@ -132,6 +151,7 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		// because when nonZeroCoinFeesReq empty, and DenomsSubsetOf check passed,
 		// the tx should already passed before)
 		if !feeCoinsNonZeroDenom.IsAnyGTE(nonZeroCoinFeesReq) {
+			mfd.recordRejection(ctx, nonZeroCoinFeesReq, feeCoins)
 			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, combinedFeeRequirement)
 		}
 	}
@@ -139,11 +159,26 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	return next(ctx, tx, simulate)
 }
 
+// recordRejection records a tx rejected for insufficient fees. Rechecks of
+// txs already in the mempool are not recorded as they were accepted once.
+func (mfd FeeDecorator) recordRejection(ctx sdk.Context, required, paid sdk.Coins) {
+	if mfd.RejectionRecorder == nil || ctx.IsReCheckTx() {
+		return
+	}
+	mfd.RejectionRecorder.RecordFeeRejection(ctx, required, paid)
+}
+
 // GetGlobalFee returns the global fees for a given fee tx's gas
 // (might also return 0denom if globalMinGasPrice is 0)
-// sorted in ascending order.
+// sorted in ascending order. The global minimum gas prices are scaled by
+// the dynamic multiplier when set, and the fee of each denom is raised to the
+// minimum flat fee of that denom if the latter is higher.
 // Note that ParamStoreKeyMinGasPrices type requires coins sorted.
 func (mfd FeeDecorator) GetGlobalFee(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Coins, error) {
+	return mfd.globalFeeForGas(ctx, feeTx.GetGas())
+}
+
+func (mfd FeeDecorator) globalFeeForGas(ctx sdk.Context, gas uint64) (sdk.Coins, error) {
 	var (
 		globalMinGasPrices sdk.DecCoins
 		err                error
@@ -152,6 +187,9 @@ func (mfd FeeDecorator) GetGlobalFee(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Coin
 	if mfd.GlobalMinFee.Has(ctx, types.ParamStoreKeyMinGasPrices) {
 		mfd.GlobalMinFee.Get(ctx, types.ParamStoreKeyMinGasPrices, &globalMinGasPrices)
 	}
+	if mfd.DynamicFees != nil {
+		globalMinGasPrices = globalfee.ApplyDynamicFeeMultiplier(globalMinGasPrices, mfd.DynamicFees.Multiplier(ctx))
+	}
 	// global fee is empty set, set global fee to 0uatom
 	if len(globalMinGasPrices) == 0 {
 		globalMinGasPrices, err = mfd.DefaultZeroGlobalFee(ctx)
@@ -159,16 +197,38 @@ func (mfd FeeDecorator) GetGlobalFee(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Coin
 			return sdk.Coins{}, err
 		}
 	}
-	requiredGlobalFees := make(sdk.Coins, len(globalMinGasPrices))
-	// Determine the required fees by multiplying each required minimum gas
-	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-	glDec := sdk.NewDec(int64(feeTx.GetGas()))
-	for i, gp := range globalMinGasPrices {
-		fee := gp.Amount.Mul(glDec)
-		requiredGlobalFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	var minFlatFee sdk.Coins
+	if mfd.GlobalMinFee.Has(ctx, types.ParamStoreKeyMinFlatFee) {
+		mfd.GlobalMinFee.Get(ctx, types.ParamStoreKeyMinFlatFee, &minFlatFee)
 	}
 
-	return requiredGlobalFees.Sort(), nil
+	return ApplyMinFlatFee(RequiredFees(globalMinGasPrices, gas), minFlatFee), nil
+}
+
+// MinimumFee returns the minimum fee required in CheckTx from a tx with the
+// given msgs and gas limit, combining the global fees and the local minimum
+// gas prices as done by AnteHandle. The fee can be paid in any one of the
+// returned denoms. It is empty when the tx is accepted without fee, i.e. when
+// it can bypass the minimum fee or when a fee denom is required at zero.
+func (mfd FeeDecorator) MinimumFee(ctx sdk.Context, msgs []sdk.Msg, gas uint64) (sdk.Coins, error) {
+	if mfd.ContainsOnlyBypassMinFeeMsgs(msgs) && gas <= mfd.MaxTotalBypassMinFeeMsgGasUsage {
+		return sdk.Coins{}, nil
+	}
+
+	requiredGlobalFees, err := mfd.globalFeeForGas(ctx, gas)
+	if err != nil {
+		return nil, err
+	}
+	combinedFeeRequirement := CombinedFeeRequirement(requiredGlobalFees, GetMinGasPrice(ctx, int64(gas)))
+	if len(combinedFeeRequirement) == 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "required fees are not setup.")
+	}
+
+	nonZeroCoinFeesReq, zeroCoinFeesDenomReq := getNonZeroFees(combinedFeeRequirement)
+	if len(zeroCoinFeesDenomReq) != 0 {
+		return sdk.Coins{}, nil
+	}
+	return nonZeroCoinFeesReq, nil
 }
 
 func (mfd FeeDecorator) DefaultZeroGlobalFee(ctx sdk.Context) ([]sdk.DecCoin, error) {
@@ -211,14 +271,5 @@ func GetMinGasPrice(ctx sdk.Context, gasLimit int64) sdk.Coins {
 		return sdk.Coins{}
 	}
 
-	requiredFees := make(sdk.Coins, len(minGasPrices))
-	// Determine the required fees by multiplying each required minimum gas
-	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-	glDec := sdk.NewDec(gasLimit)
-	for i, gp := range minGasPrices {
-		fee := gp.Amount.Mul(glDec)
-		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-	}
-
-	return requiredFees.Sort()
+	return RequiredFees(minGasPrices, uint64(gasLimit))
 }
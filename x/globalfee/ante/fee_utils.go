@@ -48,6 +48,44 @@ func CombinedFeeRequirement(globalFees, minGasPrices sdk.Coins) sdk.Coins {
 	return allFees.Sort()
 }
 
+// RequiredFees returns the fees required for the given gas limit by the given
+// gas prices, sorted. The fee of each denom is ceil(gasPrice * gasLimit).
+func RequiredFees(gasPrices sdk.DecCoins, gasLimit uint64) sdk.Coins {
+	requiredFees := make(sdk.Coins, len(gasPrices))
+	glDec := sdk.NewDec(int64(gasLimit))
+	for i, gp := range gasPrices {
+		fee := gp.Amount.Mul(glDec)
+		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+
+	return requiredFees.Sort()
+}
+
+// ApplyMinFlatFee returns the given fees where the amount of each coin is
+// raised to the amount of the min flat fee of the same denom, if higher.
+// Denoms of minFlatFee that are not in fees are ignored: the fees list the
+// denoms a tx can pay its fee in, so a flat fee in another denom would only
+// add a denom to pay in rather than a floor. A flat fee is thus only enforced
+// in the denoms of the global minimum gas prices, or in the bond denom when
+// they are empty.
+func ApplyMinFlatFee(fees, minFlatFee sdk.Coins) sdk.Coins {
+	if len(minFlatFee) == 0 {
+		return fees
+	}
+
+	allFees := make(sdk.Coins, len(fees))
+	for i, fee := range fees {
+		ok, c := Find(minFlatFee, fee.Denom)
+		if ok && c.Amount.GT(fee.Amount) {
+			allFees[i] = c
+		} else {
+			allFees[i] = fee
+		}
+	}
+
+	return allFees
+}
+
 // Find replaces the functionality of Coins.Find from SDK v0.46.x
 func Find(coins sdk.Coins, denom string) (bool, sdk.Coin) {
 	switch len(coins) {
@@ -52,6 +52,42 @@ func TestContainZeroCoins(t *testing.T) {
 	}
 }
 
+func TestApplyMinFlatFee(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("photon", 100), sdk.NewInt64Coin("uatom", 50))
+
+	tests := map[string]struct {
+		minFlatFee sdk.Coins
+		expected   sdk.Coins
+	}{
+		"no flat fee": {
+			minFlatFee: sdk.Coins{},
+			expected:   fees,
+		},
+		"flat fee above the fee": {
+			minFlatFee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 80)),
+			expected:   sdk.NewCoins(sdk.NewInt64Coin("photon", 100), sdk.NewInt64Coin("uatom", 80)),
+		},
+		"flat fee below the fee": {
+			minFlatFee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 20)),
+			expected:   fees,
+		},
+		"flat fee in a denom missing from the fees is ignored": {
+			minFlatFee: sdk.NewCoins(sdk.NewInt64Coin("quark", 1_000)),
+			expected:   fees,
+		},
+		"flat fees in a fee denom and in a missing denom": {
+			minFlatFee: sdk.NewCoins(sdk.NewInt64Coin("photon", 200), sdk.NewInt64Coin("quark", 1_000)),
+			expected:   sdk.NewCoins(sdk.NewInt64Coin("photon", 200), sdk.NewInt64Coin("uatom", 50)),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, test.expected, ApplyMinFlatFee(fees, test.minFlatFee))
+		})
+	}
+}
+
 // Note that in a real Gaia deployment all zero coins can be removed from minGasPrice.
 // This sanitizing happens when the minGasPrice is set into the context.
 // (see baseapp.SetMinGasPrices in gaia/cmd/root.go line 221)
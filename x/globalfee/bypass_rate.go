@@ -0,0 +1,159 @@
+package globalfee
+
+import (
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+const (
+	// DefaultBypassRateWindow is the number of blocks aggregated by the
+	// BypassRate query when no window is given.
+	DefaultBypassRateWindow = 100
+	// DefaultBypassRateRetention is the number of blocks the txs are counted
+	// for by the BypassRateIndex.
+	DefaultBypassRateRetention = 10_000
+)
+
+// BypassRecorder records whether the delivered txs bypassed the minimum fees.
+type BypassRecorder interface {
+	RecordBypass(ctx sdk.Context, msgs []sdk.Msg, bypassed bool)
+}
+
+var _ BypassRecorder = &BypassRateIndex{}
+
+// BypassRateIndex keeps in memory the number of txs delivered by this node
+// and of those which bypassed the minimum fees, per block height and message
+// type.
+type BypassRateIndex struct {
+	mtx       sync.RWMutex
+	retention int64
+	// heights maps a block height to the counts of its txs
+	heights map[int64]*blockBypasses
+}
+
+// blockBypasses are the counts of the txs of a block.
+type blockBypasses struct {
+	txs      uint64
+	bypassed uint64
+	// msgTypes maps a message type URL to the counts of the bypassed txs
+	// containing it
+	msgTypes map[string]*types.BypassedMsgType
+}
+
+// NewBypassRateIndex returns a BypassRateIndex counting the txs of the given
+// number of most recent blocks.
+func NewBypassRateIndex(retention int64) *BypassRateIndex {
+	if retention <= 0 {
+		retention = DefaultBypassRateRetention
+	}
+
+	return &BypassRateIndex{
+		retention: retention,
+		heights:   make(map[int64]*blockBypasses),
+	}
+}
+
+// Retention returns the number of blocks the txs are counted for.
+func (idx *BypassRateIndex) Retention() int64 {
+	return idx.retention
+}
+
+// RecordBypass records a tx of the context block height with the given msgs,
+// which bypassed the minimum fees or not.
+func (idx *BypassRateIndex) RecordBypass(ctx sdk.Context, msgs []sdk.Msg, bypassed bool) {
+	height := ctx.BlockHeight()
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	block, ok := idx.heights[height]
+	if !ok {
+		block = &blockBypasses{msgTypes: make(map[string]*types.BypassedMsgType)}
+		idx.heights[height] = block
+		idx.prune(height)
+	}
+	block.txs++
+	if !bypassed {
+		return
+	}
+	block.bypassed++
+
+	seen := make(map[string]bool)
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		msgType, ok := block.msgTypes[typeURL]
+		if !ok {
+			msgType = &types.BypassedMsgType{MsgTypeUrl: typeURL}
+			block.msgTypes[typeURL] = msgType
+		}
+		msgType.Msgs++
+		if !seen[typeURL] {
+			seen[typeURL] = true
+			msgType.Txs++
+		}
+	}
+}
+
+// Stats aggregates the txs of the window blocks ending at toHeight.
+func (idx *BypassRateIndex) Stats(toHeight int64, window uint64) types.QueryBypassRateResponse {
+	fromHeight := toHeight - int64(window) + 1
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	var txs, bypassed uint64
+	msgTypes := make(map[string]*types.BypassedMsgType)
+	for height, block := range idx.heights {
+		if height < fromHeight || height > toHeight {
+			continue
+		}
+		txs += block.txs
+		bypassed += block.bypassed
+		for typeURL, blockMsgType := range block.msgTypes {
+			msgType, ok := msgTypes[typeURL]
+			if !ok {
+				msgType = &types.BypassedMsgType{MsgTypeUrl: typeURL}
+				msgTypes[typeURL] = msgType
+			}
+			msgType.Txs += blockMsgType.Txs
+			msgType.Msgs += blockMsgType.Msgs
+		}
+	}
+
+	res := types.QueryBypassRateResponse{
+		FromHeight:       fromHeight,
+		ToHeight:         toHeight,
+		TotalTxs:         txs,
+		BypassedTxs:      bypassed,
+		BypassPercentage: sdk.ZeroDec(),
+		MsgTypes:         make([]types.BypassedMsgType, 0, len(msgTypes)),
+	}
+	if txs > 0 {
+		res.BypassPercentage = sdk.NewDecFromInt(sdk.NewIntFromUint64(bypassed)).MulInt64(100).Quo(sdk.NewDecFromInt(sdk.NewIntFromUint64(txs)))
+	}
+	for _, msgType := range msgTypes {
+		res.MsgTypes = append(res.MsgTypes, *msgType)
+	}
+	sort.Slice(res.MsgTypes, func(i, j int) bool {
+		return res.MsgTypes[i].MsgTypeUrl < res.MsgTypes[j].MsgTypeUrl
+	})
+
+	return res
+}
+
+// prune drops the heights that fell out of the retention window.
+// It must be called with the lock held.
+func (idx *BypassRateIndex) prune(latestHeight int64) {
+	for height := range idx.heights {
+		if height <= latestHeight-idx.retention {
+			delete(idx.heights, height)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package globalfee
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+func TestBypassRateIndex(t *testing.T) {
+	ctx, _, _ := setupTestStore(t)
+	recv := &channeltypes.MsgRecvPacket{}
+	ack := &channeltypes.MsgAcknowledgement{}
+	send := &banktypes.MsgSend{}
+
+	idx := NewBypassRateIndex(10)
+	idx.RecordBypass(ctx.WithBlockHeight(5), []sdk.Msg{recv, recv, ack}, true)
+	idx.RecordBypass(ctx.WithBlockHeight(5), []sdk.Msg{send}, false)
+	idx.RecordBypass(ctx.WithBlockHeight(8), []sdk.Msg{recv}, true)
+	idx.RecordBypass(ctx.WithBlockHeight(10), []sdk.Msg{send}, false)
+
+	stats := idx.Stats(10, 10)
+	assert.Equal(t, int64(1), stats.FromHeight)
+	assert.Equal(t, int64(10), stats.ToHeight)
+	assert.Equal(t, uint64(4), stats.TotalTxs)
+	assert.Equal(t, uint64(2), stats.BypassedTxs)
+	assert.Equal(t, sdk.NewDec(50).String(), stats.BypassPercentage.String())
+	// the msg types are sorted by type URL, a tx counting once per msg type
+	assert.Equal(t, []types.BypassedMsgType{
+		{MsgTypeUrl: sdk.MsgTypeURL(ack), Txs: 1, Msgs: 1},
+		{MsgTypeUrl: sdk.MsgTypeURL(recv), Txs: 2, Msgs: 3},
+	}, stats.MsgTypes)
+
+	// only the most recent blocks are aggregated
+	stats = idx.Stats(10, 3)
+	assert.Equal(t, int64(8), stats.FromHeight)
+	assert.Equal(t, uint64(2), stats.TotalTxs)
+	assert.Equal(t, uint64(1), stats.BypassedTxs)
+	assert.Equal(t, sdk.NewDec(50).String(), stats.BypassPercentage.String())
+	assert.Equal(t, []types.BypassedMsgType{{MsgTypeUrl: sdk.MsgTypeURL(recv), Txs: 1, Msgs: 1}}, stats.MsgTypes)
+
+	// heights out of the retention window are pruned
+	idx.RecordBypass(ctx.WithBlockHeight(16), []sdk.Msg{send}, false)
+	stats = idx.Stats(16, 16)
+	assert.Equal(t, uint64(3), stats.TotalTxs)
+	assert.Equal(t, uint64(1), stats.BypassedTxs)
+
+	// a window without txs has a zero rate
+	stats = idx.Stats(30, 5)
+	assert.Zero(t, stats.TotalTxs)
+	assert.True(t, stats.BypassPercentage.IsZero())
+	assert.Empty(t, stats.MsgTypes)
+}
+
+func TestQueryBypassRate(t *testing.T) {
+	ctx, _, subspace := setupTestStore(t)
+	recv := &channeltypes.MsgRecvPacket{}
+
+	idx := NewBypassRateIndex(1000)
+	idx.RecordBypass(ctx, []sdk.Msg{recv}, true)
+	idx.RecordBypass(ctx, []sdk.Msg{&banktypes.MsgSend{}}, false)
+	idx.RecordBypass(ctx.WithBlockHeight(ctx.BlockHeight()-DefaultBypassRateWindow), []sdk.Msg{recv}, true)
+
+	q := NewGrpcQuerier(subspace, nil, nil, nil, nil, idx)
+	gotResp, gotErr := q.BypassRate(sdk.WrapSDKContext(ctx), &types.QueryBypassRateRequest{})
+	require.NoError(t, gotErr)
+	assert.Equal(t, ctx.BlockHeight(), gotResp.ToHeight)
+	assert.Equal(t, ctx.BlockHeight()-DefaultBypassRateWindow+1, gotResp.FromHeight)
+	assert.Equal(t, uint64(2), gotResp.TotalTxs)
+	assert.Equal(t, uint64(1), gotResp.BypassedTxs)
+
+	gotResp, gotErr = q.BypassRate(sdk.WrapSDKContext(ctx), &types.QueryBypassRateRequest{Window: DefaultBypassRateWindow + 1})
+	require.NoError(t, gotErr)
+	assert.Equal(t, uint64(3), gotResp.TotalTxs)
+	assert.Equal(t, sdk.MustNewDecFromStr("66.666666666666666667").String(), gotResp.BypassPercentage.String())
+
+	_, gotErr = q.BypassRate(sdk.WrapSDKContext(ctx), &types.QueryBypassRateRequest{Window: 1001})
+	require.Error(t, gotErr)
+
+	_, gotErr = NewGrpcQuerier(subspace, nil, nil, nil, nil, nil).BypassRate(sdk.WrapSDKContext(ctx), &types.QueryBypassRateRequest{})
+	require.Error(t, gotErr)
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
@@ -18,6 +20,14 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetCmdShowMinimumGasPrices(),
+		GetCmdFeeRejectionStats(),
+		GetCmdObservedGasPrices(),
+		GetCmdTimeWeightedAverageFee(),
+		GetCmdDynamicMinimumGasPrices(),
+		GetCmdMinGasPriceTimeline(),
+		GetCmdBypassRate(),
+		GetCmdMempoolFees(),
+		GetCmdClientConfig(),
 	)
 	return queryCmd
 }
@@ -46,3 +56,268 @@ func GetCmdShowMinimumGasPrices() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdFeeRejectionStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-rejection-stats [window]",
+		Short: "Show the txs rejected for insufficient fees",
+		Long: `Show the number of txs rejected by the queried node for insufficient fees over the
+given number of most recent blocks, along with a histogram of their fee shortfall.
+The window defaults to 100 blocks. The index is only kept when "fee-rejections" is set in
+the query-indexes option of the app.toml of the node.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var window uint64
+			if len(args) == 1 {
+				window, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeRejectionStats(cmd.Context(), &types.QueryFeeRejectionStatsRequest{Window: window})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdBypassRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bypass-rate [window]",
+		Short: "Show the txs which bypassed the minimum fees",
+		Long: `Show the number and the percentage of the txs delivered by the queried node over the
+given number of most recent blocks which bypassed the minimum fees, i.e. made only of
+bypass message types within the bypass gas limit, along with the number of bypassed txs
+per message type. The window defaults to 100 blocks. The index is only kept when "bypass-rate"
+is set in the query-indexes option of the app.toml of the node.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var window uint64
+			if len(args) == 1 {
+				window, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BypassRate(cmd.Context(), &types.QueryBypassRateRequest{Window: window})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdObservedGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "observed-gas-prices [window]",
+		Short: "Show the gas prices paid by the txs of the recent blocks",
+		Long: `Show the 10th, 50th and 90th percentiles of the gas prices paid per fee denom by the
+txs delivered by the queried node over the given number of most recent blocks. The gas
+price of a tx is its fee divided by its gas limit. The window defaults to 100 blocks. The index
+is only kept when "gas-prices" is set in the query-indexes option of the app.toml of the node.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var window uint64
+			if len(args) == 1 {
+				window, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ObservedGasPrices(cmd.Context(), &types.QueryObservedGasPricesRequest{Window: window})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdTimeWeightedAverageFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "time-weighted-average-fee [window]",
+		Short: "Show the average fee per gas of the recent blocks weighted by their block time",
+		Long: `Show the average fee per gas paid per fee denom by the blocks delivered by the queried
+node over the given number of most recent blocks, each block weighted by the time elapsed
+since the previous block. The fee per gas of a block is the sum of the fees of its txs
+divided by the sum of their gas limits. The window defaults to 100 blocks. The index is only
+kept when "gas-prices" is set in the query-indexes option of the app.toml of the node.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var window uint64
+			if len(args) == 1 {
+				window, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TimeWeightedAverageFee(cmd.Context(), &types.QueryTimeWeightedAverageFeeRequest{Window: window})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdDynamicMinimumGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dynamic-minimum-gas-prices",
+		Short: "Show the minimum gas prices scaled by the dynamic multiplier",
+		Long: `Show the global minimum gas prices scaled by the dynamic multiplier of the module state,
+along with the multiplier and the average fullness of the recent blocks it was adjusted with.
+The multiplier only differs from one when the dynamic fee sensitivity param is set.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DynamicMinimumGasPrices(cmd.Context(), &types.QueryDynamicMinimumGasPricesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdMinGasPriceTimeline() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-gas-price-timeline [from-height] [to-height]",
+		Short: "Show the steps of the effective minimum gas prices over a range of heights",
+		Long: `Show the effective minimum gas prices, i.e. the global minimum gas prices scaled by the
+dynamic multiplier, recorded by the queried node between the given heights. A step is only
+recorded when the minimum gas prices change, the first step returned is the one in effect at
+from-height. The to-height defaults to the latest height. The index is only kept when
+"min-gas-price-timeline" is set in the query-indexes option of the app.toml of the node.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			var toHeight int64
+			if len(args) == 2 {
+				toHeight, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MinGasPriceTimeline(cmd.Context(), &types.QueryMinGasPriceTimelineRequest{
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdMempoolFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mempool-fees",
+		Short: "Show the fees of the txs pending in the mempool",
+		Long: `Show the number of txs pending in the mempool of the queried node, along with a
+histogram of their gas prices per fee denom. The gas price of a tx is its fee divided by
+its gas limit. The histograms are computed from the first 100 pending txs.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			mempoolClient := types.NewMempoolClient(clientCtx)
+			res, err := mempoolClient.Fees(cmd.Context(), &types.MempoolFeesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdClientConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client-config",
+		Short: "Show the fee policy needed to construct a tx accepted by the node",
+		Long: `Show in a single query the fee policy a client needs to construct a tx accepted by the
+queried node: the global minimum gas prices scaled by the dynamic multiplier, the local
+minimum gas prices of the node, the fee denoms, the minimum flat fee, the bypass message
+types and gas limit, the message gas floors and the max tx size. The config is versioned,
+the version being bumped when the fee policy gains an element.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			nodeConfigClient := types.NewNodeConfigClient(clientCtx)
+			res, err := nodeConfigClient.ClientConfig(cmd.Context(), &types.QueryClientConfigRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
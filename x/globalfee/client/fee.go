@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+// ComputeFee returns the gas limit and the fee to attach to a tx which used
+// simulatedGas when simulated, given the globalfee minimum gas prices. The
+// gas limit is the simulated gas multiplied by the gas adjustment, as done by
+// the SDK tx factory, and the fee holds the minimum gas price of each denom
+// multiplied by the gas limit, rounded up. The globalfee check requires a
+// single one of these coins, so that the caller can pick the denom to pay in.
+//
+// The fee is empty when the minimum gas prices are empty or contain a zero
+// price, as a tx without fee is accepted in this case.
+func ComputeFee(minGasPrices sdk.DecCoins, simulatedGas uint64, adjustment float64) (uint64, sdk.Coins, error) {
+	if adjustment <= 0 {
+		return 0, nil, fmt.Errorf("gas adjustment must be positive, got %f", adjustment)
+	}
+	gasLimit := uint64(adjustment * float64(simulatedGas))
+
+	fee := sdk.Coins{}
+	for _, gp := range minGasPrices {
+		if gp.IsZero() {
+			return gasLimit, sdk.Coins{}, nil
+		}
+		fee = fee.Add(sdk.NewCoin(gp.Denom, gp.Amount.MulInt64(int64(gasLimit)).Ceil().RoundInt()))
+	}
+
+	return gasLimit, fee, nil
+}
+
+// QueryFee queries the current globalfee minimum gas prices and returns the
+// gas limit and the fee computed by ComputeFee.
+func QueryFee(ctx context.Context, queryClient types.QueryClient, simulatedGas uint64, adjustment float64) (uint64, sdk.Coins, error) {
+	res, err := queryClient.MinimumGasPrices(ctx, &types.QueryMinimumGasPricesRequest{})
+	if err != nil {
+		return 0, nil, err
+	}
+	return ComputeFee(res.MinimumGasPrices, simulatedGas, adjustment)
+}
@@ -0,0 +1,81 @@
+package client_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/client"
+)
+
+func TestComputeFee(t *testing.T) {
+	specs := map[string]struct {
+		minGasPrices sdk.DecCoins
+		simulatedGas uint64
+		adjustment   float64
+		expGasLimit  uint64
+		expFee       sdk.Coins
+		expErr       bool
+	}{
+		"adjusted gas times min gas price": {
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.0025"))),
+			simulatedGas: 100000,
+			adjustment:   1.5,
+			expGasLimit:  150000,
+			expFee:       sdk.NewCoins(sdk.NewInt64Coin("uatom", 375)),
+		},
+		"fee rounded up": {
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.0025"))),
+			simulatedGas: 1001,
+			adjustment:   1,
+			expGasLimit:  1001,
+			expFee:       sdk.NewCoins(sdk.NewInt64Coin("uatom", 3)),
+		},
+		"fee in each denom": {
+			minGasPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.0025")),
+				sdk.NewDecCoinFromDec("photon", sdk.MustNewDecFromStr("0.01")),
+			),
+			simulatedGas: 100000,
+			adjustment:   1.2,
+			expGasLimit:  120000,
+			expFee:       sdk.NewCoins(sdk.NewInt64Coin("uatom", 300), sdk.NewInt64Coin("photon", 1200)),
+		},
+		"zero min gas price": {
+			minGasPrices: sdk.DecCoins{
+				sdk.NewDecCoin("photon", sdk.ZeroInt()),
+				sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.0025")),
+			},
+			simulatedGas: 100000,
+			adjustment:   1.5,
+			expGasLimit:  150000,
+			expFee:       sdk.Coins{},
+		},
+		"empty min gas prices": {
+			minGasPrices: sdk.DecCoins{},
+			simulatedGas: 100000,
+			adjustment:   1.5,
+			expGasLimit:  150000,
+			expFee:       sdk.Coins{},
+		},
+		"non positive adjustment": {
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.0025"))),
+			simulatedGas: 100000,
+			adjustment:   0,
+			expErr:       true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gasLimit, fee, err := client.ComputeFee(spec.minGasPrices, spec.simulatedGas, spec.adjustment)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.expGasLimit, gasLimit)
+			require.True(t, spec.expFee.IsEqual(fee), "expected %s, got %s", spec.expFee, fee)
+		})
+	}
+}
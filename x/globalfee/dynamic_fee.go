@@ -0,0 +1,150 @@
+package globalfee
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+// DynamicFeeWindow is the number of most recent blocks the average fullness
+// of which adjusts the dynamic multiplier of the minimum gas prices.
+const DynamicFeeWindow = 10
+
+// targetFullness is the block fullness at which the dynamic multiplier is
+// stable, it rises above and decreases below.
+var targetFullness = sdk.NewDecWithPrec(5, 1)
+
+// DynamicFeeSource provides the dynamic multiplier of the minimum gas prices.
+type DynamicFeeSource interface {
+	Multiplier(ctx sdk.Context) sdk.Dec
+}
+
+var _ DynamicFeeSource = DynamicFeeKeeper{}
+
+// DynamicFeeKeeper keeps in the module state the fullness of the recent
+// blocks and the dynamic multiplier of the minimum gas prices adjusted with
+// it at the end of each block, EIP-1559 style. As the multiplier scales the
+// global fees set by governance, it is part of the consensus state rather
+// than node local: all the nodes require the same global fees, including
+// after a restart, and the multiplier is exported with the genesis.
+type DynamicFeeKeeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+}
+
+// NewDynamicFeeKeeper returns a DynamicFeeKeeper keeping its state in the
+// given store.
+func NewDynamicFeeKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey) DynamicFeeKeeper {
+	return DynamicFeeKeeper{cdc: cdc, storeKey: storeKey}
+}
+
+// GetState returns the state of the dynamic multiplier, with a multiplier of
+// one when unset.
+func (k DynamicFeeKeeper) GetState(ctx sdk.Context) types.DynamicFeeState {
+	bz := ctx.KVStore(k.storeKey).Get(types.DynamicFeeStateKey)
+	if bz == nil {
+		return types.DynamicFeeState{Multiplier: sdk.OneDec()}
+	}
+
+	var state types.DynamicFeeState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state
+}
+
+// SetState sets the state of the dynamic multiplier.
+func (k DynamicFeeKeeper) SetState(ctx sdk.Context, state types.DynamicFeeState) {
+	ctx.KVStore(k.storeKey).Set(types.DynamicFeeStateKey, k.cdc.MustMarshal(&state))
+}
+
+// RecordBlock records the fullness of the context block, i.e. its gas used
+// over the max gas of a block, and adjusts the multiplier with the average
+// fullness of the recent blocks and the dynamic fee params. The multiplier is
+// reset to one while the dynamic fee sensitivity is zero. Nothing is recorded
+// when the max gas of a block is unlimited, as the fullness is then unknown.
+func (k DynamicFeeKeeper) RecordBlock(ctx sdk.Context, paramSource ParamSource) {
+	cp := ctx.ConsensusParams()
+	if cp == nil || cp.Block == nil || cp.Block.MaxGas <= 0 || ctx.BlockGasMeter() == nil {
+		return
+	}
+	fullness := sdk.NewDecFromInt(sdk.NewIntFromUint64(ctx.BlockGasMeter().GasConsumedToLimit())).
+		QuoInt64(cp.Block.MaxGas)
+	if fullness.GT(sdk.OneDec()) {
+		fullness = sdk.OneDec()
+	}
+
+	params := types.DefaultParams()
+	if paramSource.Has(ctx, types.ParamStoreKeyDynamicFeeSensitivity) {
+		paramSource.Get(ctx, types.ParamStoreKeyDynamicFeeSensitivity, &params.DynamicFeeSensitivity)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyDynamicFeeFloor) {
+		paramSource.Get(ctx, types.ParamStoreKeyDynamicFeeFloor, &params.DynamicFeeFloor)
+	}
+	if paramSource.Has(ctx, types.ParamStoreKeyDynamicFeeCeiling) {
+		paramSource.Get(ctx, types.ParamStoreKeyDynamicFeeCeiling, &params.DynamicFeeCeiling)
+	}
+	floor, ceiling, hasCeiling := params.DynamicFeeBounds()
+
+	state := k.GetState(ctx)
+	state.RecentFullness = append(state.RecentFullness, fullness)
+	if len(state.RecentFullness) > DynamicFeeWindow {
+		state.RecentFullness = state.RecentFullness[len(state.RecentFullness)-DynamicFeeWindow:]
+	}
+	state.Height = ctx.BlockHeight()
+
+	if params.DynamicFeeSensitivity.IsNil() || params.DynamicFeeSensitivity.IsZero() {
+		state.Multiplier = sdk.OneDec()
+	} else {
+		state.Multiplier = AdjustDynamicFeeMultiplier(state.Multiplier, AverageFullness(state), params.DynamicFeeSensitivity, floor, ceiling, hasCeiling)
+	}
+	k.SetState(ctx, state)
+}
+
+// Multiplier returns the current dynamic multiplier of the minimum gas prices.
+func (k DynamicFeeKeeper) Multiplier(ctx sdk.Context) sdk.Dec {
+	return k.GetState(ctx).Multiplier
+}
+
+// AverageFullness returns the average fullness of the recent blocks of the
+// state, zero when none was recorded.
+func AverageFullness(state types.DynamicFeeState) sdk.Dec {
+	if len(state.RecentFullness) == 0 {
+		return sdk.ZeroDec()
+	}
+	sum := sdk.ZeroDec()
+	for _, f := range state.RecentFullness {
+		sum = sum.Add(f)
+	}
+	return sum.QuoInt64(int64(len(state.RecentFullness)))
+}
+
+// AdjustDynamicFeeMultiplier returns the multiplier of the next block given
+// the current multiplier and the average fullness of the recent blocks. The
+// multiplier changes by the sensitivity times the relative distance of the
+// fullness to the half full target, i.e. it is multiplied by 1 + sensitivity
+// for full blocks and by 1 - sensitivity for empty blocks, and is bounded by
+// the floor and, if any, the ceiling. The ceiling prevails over a higher
+// floor.
+func AdjustDynamicFeeMultiplier(multiplier, fullness, sensitivity, floor, ceiling sdk.Dec, hasCeiling bool) sdk.Dec {
+	delta := fullness.Sub(targetFullness).Quo(targetFullness).Mul(sensitivity)
+	next := multiplier.Mul(sdk.OneDec().Add(delta))
+	if next.LT(floor) {
+		next = floor
+	}
+	if hasCeiling && next.GT(ceiling) {
+		next = ceiling
+	}
+	return next
+}
+
+// ApplyDynamicFeeMultiplier returns the gas prices multiplied by the
+// multiplier. Unlike sdk.DecCoins.MulDec, the zero gas prices are kept as
+// they allow txs without fees.
+func ApplyDynamicFeeMultiplier(gasPrices sdk.DecCoins, multiplier sdk.Dec) sdk.DecCoins {
+	res := make(sdk.DecCoins, len(gasPrices))
+	for i, gp := range gasPrices {
+		res[i] = sdk.DecCoin{Denom: gp.Denom, Amount: gp.Amount.Mul(multiplier)}
+	}
+	return res
+}
@@ -0,0 +1,154 @@
+package globalfee
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+func TestAdjustDynamicFeeMultiplier(t *testing.T) {
+	sensitivity := sdk.NewDecWithPrec(125, 3)
+
+	specs := map[string]struct {
+		multiplier sdk.Dec
+		fullness   sdk.Dec
+		floor      sdk.Dec
+		ceiling    sdk.Dec
+		hasCeiling bool
+		exp        sdk.Dec
+	}{
+		"full blocks": {
+			multiplier: sdk.OneDec(),
+			fullness:   sdk.OneDec(),
+			floor:      sdk.OneDec(),
+			exp:        sdk.NewDecWithPrec(1125, 3),
+		},
+		"half full blocks": {
+			multiplier: sdk.NewDec(2),
+			fullness:   sdk.NewDecWithPrec(5, 1),
+			floor:      sdk.OneDec(),
+			exp:        sdk.NewDec(2),
+		},
+		"empty blocks": {
+			multiplier: sdk.NewDec(2),
+			fullness:   sdk.ZeroDec(),
+			floor:      sdk.OneDec(),
+			exp:        sdk.NewDecWithPrec(175, 2),
+		},
+		"bounded by the floor": {
+			multiplier: sdk.OneDec(),
+			fullness:   sdk.ZeroDec(),
+			floor:      sdk.OneDec(),
+			exp:        sdk.OneDec(),
+		},
+		"bounded by the ceiling": {
+			multiplier: sdk.NewDec(2),
+			fullness:   sdk.OneDec(),
+			floor:      sdk.OneDec(),
+			ceiling:    sdk.NewDec(2),
+			hasCeiling: true,
+			exp:        sdk.NewDec(2),
+		},
+		"ceiling prevails over the floor": {
+			multiplier: sdk.OneDec(),
+			fullness:   sdk.ZeroDec(),
+			floor:      sdk.OneDec(),
+			ceiling:    sdk.NewDecWithPrec(5, 1),
+			hasCeiling: true,
+			exp:        sdk.NewDecWithPrec(5, 1),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := AdjustDynamicFeeMultiplier(spec.multiplier, spec.fullness, sensitivity, spec.floor, spec.ceiling, spec.hasCeiling)
+			assert.Equal(t, spec.exp.String(), got.String())
+		})
+	}
+}
+
+func TestDynamicFeeKeeper(t *testing.T) {
+	ctx, _, subspace, k := setupTestStoreWithDynamicFees(t)
+	subspace.SetParamSet(ctx, &types.Params{
+		DynamicFeeSensitivity: sdk.NewDecWithPrec(125, 3),
+		DynamicFeeCeiling:     sdk.NewDec(2),
+	})
+	ctx = ctx.WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 1_000_000}})
+	block := func(height int64, gasUsed uint64) sdk.Context {
+		gasMeter := sdk.NewGasMeter(1_000_000)
+		gasMeter.ConsumeGas(gasUsed, "test")
+		return ctx.WithBlockHeight(height).WithBlockGasMeter(gasMeter)
+	}
+
+	// the multiplier is one until a block is recorded
+	assert.Equal(t, sdk.OneDec().String(), k.Multiplier(ctx).String())
+
+	// the multiplier rises under sustained full blocks up to the ceiling
+	prev := k.Multiplier(ctx)
+	for h := int64(1); h <= 5; h++ {
+		k.RecordBlock(block(h, 1_000_000), subspace)
+		require.True(t, k.Multiplier(ctx).GT(prev), "height %d", h)
+		prev = k.Multiplier(ctx)
+	}
+	for h := int64(6); h <= 20; h++ {
+		k.RecordBlock(block(h, 1_000_000), subspace)
+	}
+	state := k.GetState(ctx)
+	assert.Equal(t, sdk.NewDec(2).String(), state.Multiplier.String())
+	assert.Equal(t, sdk.OneDec().String(), AverageFullness(state).String())
+	assert.Len(t, state.RecentFullness, DynamicFeeWindow)
+	assert.Equal(t, int64(20), state.Height)
+
+	// and decreases under sustained empty blocks down to the default floor
+	for h := int64(21); h <= 60; h++ {
+		k.RecordBlock(block(h, 0), subspace)
+	}
+	state = k.GetState(ctx)
+	assert.Equal(t, sdk.OneDec().String(), state.Multiplier.String())
+	assert.Equal(t, sdk.ZeroDec().String(), AverageFullness(state).String())
+
+	// the blocks are not recorded without a max block gas
+	k.RecordBlock(block(61, 1_000_000).WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: -1}}), subspace)
+	assert.Equal(t, int64(60), k.GetState(ctx).Height)
+
+	// the state is kept in the store, so a keeper over the same store, e.g.
+	// after a restart of the node, reads the same multiplier
+	for h := int64(62); h <= 71; h++ {
+		k.RecordBlock(block(h, 1_000_000), subspace)
+	}
+	require.True(t, k.Multiplier(ctx).GT(sdk.OneDec()))
+	restarted := NewDynamicFeeKeeper(k.cdc, k.storeKey)
+	assert.Equal(t, k.Multiplier(ctx).String(), restarted.Multiplier(ctx).String())
+
+	// and the multiplier is reset when the dynamic fees are disabled
+	subspace.Set(ctx, types.ParamStoreKeyDynamicFeeSensitivity, sdk.ZeroDec())
+	k.RecordBlock(block(72, 1_000_000), subspace)
+	assert.Equal(t, sdk.OneDec().String(), k.Multiplier(ctx).String())
+}
+
+func TestQueryDynamicMinimumGasPrices(t *testing.T) {
+	ctx, _, subspace, k := setupTestStoreWithDynamicFees(t)
+	subspace.SetParamSet(ctx, &types.Params{
+		MinimumGasPrices:      sdk.DecCoins{sdk.NewDecCoin("photon", sdk.ZeroInt()), sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 2))},
+		DynamicFeeSensitivity: sdk.NewDecWithPrec(5, 1),
+	})
+	gasMeter := sdk.NewGasMeter(100)
+	gasMeter.ConsumeGas(100, "test")
+	k.RecordBlock(ctx.WithBlockGasMeter(gasMeter).WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 100}}), subspace)
+
+	q := NewGrpcQuerier(subspace, nil, nil, &k, nil, nil)
+	res, err := q.DynamicMinimumGasPrices(sdk.WrapSDKContext(ctx), &types.QueryDynamicMinimumGasPricesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.DecCoins{sdk.NewDecCoin("photon", sdk.ZeroInt()), sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(15, 3))}, res.MinimumGasPrices)
+	assert.Equal(t, sdk.NewDecWithPrec(15, 1).String(), res.Multiplier.String())
+	assert.Equal(t, sdk.OneDec().String(), res.Fullness.String())
+	assert.Equal(t, ctx.BlockHeight(), res.Height)
+
+	// the multiplier is not tracked without a keeper
+	_, err = NewGrpcQuerier(subspace, nil, nil, nil, nil, nil).DynamicMinimumGasPrices(sdk.WrapSDKContext(ctx), &types.QueryDynamicMinimumGasPricesRequest{})
+	require.Error(t, err)
+}
@@ -0,0 +1,267 @@
+package globalfee
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+const (
+	// DefaultGasPriceWindow is the number of blocks aggregated by the
+	// ObservedGasPrices and TimeWeightedAverageFee queries when no window is
+	// given.
+	DefaultGasPriceWindow = 100
+	// DefaultGasPriceRetention is the number of blocks the gas prices are kept
+	// for by the GasPriceIndex. It is lower than the fee rejection retention
+	// as a gas price is kept per tx instead of a count per bucket.
+	DefaultGasPriceRetention = 1_000
+)
+
+// GasPriceRecorder records the gas prices paid by the txs of the blocks.
+type GasPriceRecorder interface {
+	RecordGasPrices(ctx sdk.Context, fee sdk.Coins, gas uint64)
+}
+
+var _ GasPriceRecorder = &GasPriceIndex{}
+
+// GasPriceIndex keeps in memory the gas prices paid by the txs delivered by
+// this node, per block height and fee denom, along with the fees of each
+// block and its block time.
+type GasPriceIndex struct {
+	mtx       sync.RWMutex
+	retention int64
+	// heights maps a block height to the gas prices paid in each denom
+	heights map[int64]map[string][]sdk.Dec
+	// blockFees maps a block height to the fees paid in each denom
+	blockFees map[int64]map[string]*denomBlockFees
+	// blockTimes maps a block height to its block time
+	blockTimes map[int64]time.Time
+}
+
+// denomBlockFees are the fees paid in a denom by the txs of a block and the
+// sum of their gas limits.
+type denomBlockFees struct {
+	fees sdk.Int
+	gas  sdk.Int
+}
+
+// NewGasPriceIndex returns a GasPriceIndex keeping the gas prices of the
+// given number of most recent blocks.
+func NewGasPriceIndex(retention int64) *GasPriceIndex {
+	if retention <= 0 {
+		retention = DefaultGasPriceRetention
+	}
+
+	return &GasPriceIndex{
+		retention:  retention,
+		heights:    make(map[int64]map[string][]sdk.Dec),
+		blockFees:  make(map[int64]map[string]*denomBlockFees),
+		blockTimes: make(map[int64]time.Time),
+	}
+}
+
+// Retention returns the number of blocks the gas prices are kept for.
+func (idx *GasPriceIndex) Retention() int64 {
+	return idx.retention
+}
+
+// RecordGasPrices records the gas prices paid in each fee denom by a tx of the
+// context block height with the given gas limit. Nothing is recorded for a
+// zero gas limit or a zero fee.
+func (idx *GasPriceIndex) RecordGasPrices(ctx sdk.Context, fee sdk.Coins, gas uint64) {
+	if gas == 0 || fee.IsZero() {
+		return
+	}
+	height := ctx.BlockHeight()
+	gasInt := sdk.NewIntFromUint64(gas)
+	gasDec := sdk.NewDecFromInt(gasInt)
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	prices, ok := idx.heights[height]
+	if !ok {
+		prices = make(map[string][]sdk.Dec)
+		idx.heights[height] = prices
+		idx.prune(height)
+	}
+	blockFees, ok := idx.blockFees[height]
+	if !ok {
+		blockFees = make(map[string]*denomBlockFees)
+		idx.blockFees[height] = blockFees
+	}
+	for _, coin := range fee {
+		prices[coin.Denom] = append(prices[coin.Denom], coin.Amount.ToDec().Quo(gasDec))
+
+		denomFees, ok := blockFees[coin.Denom]
+		if !ok {
+			denomFees = &denomBlockFees{fees: sdk.ZeroInt(), gas: sdk.ZeroInt()}
+			blockFees[coin.Denom] = denomFees
+		}
+		denomFees.fees = denomFees.fees.Add(coin.Amount)
+		denomFees.gas = denomFees.gas.Add(gasInt)
+	}
+}
+
+// RecordBlockTime records the block time of the context block, which weights
+// its fees in the time weighted average fees.
+func (idx *GasPriceIndex) RecordBlockTime(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	idx.blockTimes[height] = ctx.BlockTime()
+	idx.prune(height)
+}
+
+// Stats returns the gas price percentiles of the window blocks ending at
+// toHeight.
+func (idx *GasPriceIndex) Stats(toHeight int64, window uint64) types.QueryObservedGasPricesResponse {
+	fromHeight := toHeight - int64(window) + 1
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+
+	idx.mtx.RLock()
+	byDenom := make(map[string][]sdk.Dec)
+	for height, prices := range idx.heights {
+		if height < fromHeight || height > toHeight {
+			continue
+		}
+		for denom, denomPrices := range prices {
+			byDenom[denom] = append(byDenom[denom], denomPrices...)
+		}
+	}
+	idx.mtx.RUnlock()
+
+	gasPrices := make([]types.DenomGasPrices, 0, len(byDenom))
+	for denom, prices := range byDenom {
+		sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+		gasPrices = append(gasPrices, types.DenomGasPrices{
+			Denom:   denom,
+			TxCount: uint64(len(prices)),
+			P10:     percentile(prices, 10),
+			P50:     percentile(prices, 50),
+			P90:     percentile(prices, 90),
+		})
+	}
+	sort.Slice(gasPrices, func(i, j int) bool { return gasPrices[i].Denom < gasPrices[j].Denom })
+
+	return types.QueryObservedGasPricesResponse{
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+		GasPrices:  gasPrices,
+	}
+}
+
+// TimeWeightedAverageFees returns the average fees per gas of the window
+// blocks ending at toHeight, each block weighted by the time elapsed since the
+// previous block. The blocks whose previous block time is not recorded, e.g.
+// the first block delivered after the node started, are left out.
+func (idx *GasPriceIndex) TimeWeightedAverageFees(toHeight int64, window uint64) types.QueryTimeWeightedAverageFeeResponse {
+	fromHeight := toHeight - int64(window) + 1
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+
+	type weightedFees struct {
+		blockCount uint64
+		duration   time.Duration
+		// sum is the sum of the fees per gas of the blocks times their block
+		// time in nanoseconds
+		sum sdk.Dec
+	}
+
+	idx.mtx.RLock()
+	byDenom := make(map[string]*weightedFees)
+	for height, blockFees := range idx.blockFees {
+		if height < fromHeight || height > toHeight {
+			continue
+		}
+		blockTime, ok := idx.blockTimes[height]
+		if !ok {
+			continue
+		}
+		prevBlockTime, ok := idx.blockTimes[height-1]
+		if !ok {
+			continue
+		}
+		duration := blockTime.Sub(prevBlockTime)
+		if duration <= 0 {
+			continue
+		}
+
+		for denom, denomFees := range blockFees {
+			weighted, ok := byDenom[denom]
+			if !ok {
+				weighted = &weightedFees{sum: sdk.ZeroDec()}
+				byDenom[denom] = weighted
+			}
+			feePerGas := denomFees.fees.ToDec().QuoInt(denomFees.gas)
+			weighted.blockCount++
+			weighted.duration += duration
+			weighted.sum = weighted.sum.Add(feePerGas.MulInt64(duration.Nanoseconds()))
+		}
+	}
+	idx.mtx.RUnlock()
+
+	averageFees := make([]types.DenomTimeWeightedFee, 0, len(byDenom))
+	for denom, weighted := range byDenom {
+		averageFees = append(averageFees, types.DenomTimeWeightedFee{
+			Denom:            denom,
+			BlockCount:       weighted.blockCount,
+			Duration:         weighted.duration,
+			AverageFeePerGas: weighted.sum.QuoInt64(weighted.duration.Nanoseconds()),
+		})
+	}
+	sort.Slice(averageFees, func(i, j int) bool { return averageFees[i].Denom < averageFees[j].Denom })
+
+	return types.QueryTimeWeightedAverageFeeResponse{
+		FromHeight:  fromHeight,
+		ToHeight:    toHeight,
+		AverageFees: averageFees,
+	}
+}
+
+// prune drops the heights that fell out of the retention window.
+// It must be called with the lock held.
+func (idx *GasPriceIndex) prune(latestHeight int64) {
+	for height := range idx.heights {
+		if height <= latestHeight-idx.retention {
+			delete(idx.heights, height)
+		}
+	}
+	for height := range idx.blockFees {
+		if height <= latestHeight-idx.retention {
+			delete(idx.blockFees, height)
+		}
+	}
+	// the block time preceding the window is kept to weight its first block
+	for height := range idx.blockTimes {
+		if height < latestHeight-idx.retention {
+			delete(idx.blockTimes, height)
+		}
+	}
+}
+
+// percentile returns the p-th percentile of the sorted values with the
+// nearest-rank method, i.e. the smallest value greater than or equal to p
+// percent of the values. Zero is returned for no values.
+func percentile(sorted []sdk.Dec, p int) sdk.Dec {
+	if len(sorted) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	// rank is ceil(p * n / 100), at least 1
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
@@ -0,0 +1,232 @@
+package globalfee
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+func TestPercentile(t *testing.T) {
+	values := make([]sdk.Dec, 20)
+	for i := range values {
+		values[i] = sdk.NewDec(int64(i + 1))
+	}
+
+	specs := map[string]struct {
+		values []sdk.Dec
+		p      int
+		exp    sdk.Dec
+	}{
+		"no values": {
+			p:   50,
+			exp: sdk.ZeroDec(),
+		},
+		"single value": {
+			values: values[:1],
+			p:      10,
+			exp:    sdk.NewDec(1),
+		},
+		"p10": {
+			values: values,
+			p:      10,
+			exp:    sdk.NewDec(2),
+		},
+		"p50": {
+			values: values,
+			p:      50,
+			exp:    sdk.NewDec(10),
+		},
+		"p90": {
+			values: values,
+			p:      90,
+			exp:    sdk.NewDec(18),
+		},
+		"rank rounded up": {
+			values: values[:5],
+			p:      50,
+			exp:    sdk.NewDec(3),
+		},
+		"p0": {
+			values: values,
+			p:      0,
+			exp:    sdk.NewDec(1),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp.String(), percentile(spec.values, spec.p).String())
+		})
+	}
+}
+
+func TestGasPriceIndex(t *testing.T) {
+	ctx, _, _ := setupTestStore(t)
+	const gas = 100_000
+
+	idx := NewGasPriceIndex(10)
+	// 10 txs paying 0.01 to 0.1uatom per gas over blocks 1 to 10, in
+	// descending order to check the sorting
+	for i := int64(10); i > 0; i-- {
+		idx.RecordGasPrices(ctx.WithBlockHeight(i), sdk.NewCoins(sdk.NewInt64Coin("uatom", i*1000)), gas)
+	}
+	// a tx paying in two denoms counts for both
+	idx.RecordGasPrices(ctx.WithBlockHeight(10), sdk.NewCoins(sdk.NewInt64Coin("photon", 50_000), sdk.NewInt64Coin("uatom", 20_000)), gas)
+	// txs without gas or fee are not recorded
+	idx.RecordGasPrices(ctx.WithBlockHeight(10), sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), 0)
+	idx.RecordGasPrices(ctx.WithBlockHeight(10), sdk.Coins{}, gas)
+
+	stats := idx.Stats(10, 10)
+	assert.Equal(t, int64(1), stats.FromHeight)
+	assert.Equal(t, int64(10), stats.ToHeight)
+	require.Equal(t, []types.DenomGasPrices{
+		{
+			Denom:   "photon",
+			TxCount: 1,
+			P10:     sdk.NewDecWithPrec(5, 1),
+			P50:     sdk.NewDecWithPrec(5, 1),
+			P90:     sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			Denom:   "uatom",
+			TxCount: 11,
+			P10:     sdk.NewDecWithPrec(2, 2),
+			P50:     sdk.NewDecWithPrec(6, 2),
+			P90:     sdk.NewDecWithPrec(10, 2),
+		},
+	}, stats.GasPrices)
+
+	// only the most recent blocks are aggregated
+	stats = idx.Stats(10, 2)
+	assert.Equal(t, int64(9), stats.FromHeight)
+	require.Len(t, stats.GasPrices, 2)
+	assert.Equal(t, uint64(3), stats.GasPrices[1].TxCount)
+	assert.Equal(t, sdk.NewDecWithPrec(9, 2).String(), stats.GasPrices[1].P10.String())
+	assert.Equal(t, sdk.NewDecWithPrec(2, 1).String(), stats.GasPrices[1].P90.String())
+
+	// heights out of the retention window are pruned
+	idx.RecordGasPrices(ctx.WithBlockHeight(15), sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), gas)
+	stats = idx.Stats(15, 15)
+	require.Len(t, stats.GasPrices, 2)
+	assert.Equal(t, uint64(7), stats.GasPrices[1].TxCount)
+}
+
+func TestQueryObservedGasPrices(t *testing.T) {
+	ctx, _, subspace := setupTestStore(t)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+
+	idx := NewGasPriceIndex(1000)
+	idx.RecordGasPrices(ctx, fee, 1000)
+	idx.RecordGasPrices(ctx.WithBlockHeight(ctx.BlockHeight()-DefaultGasPriceWindow), fee, 1000)
+
+	q := NewGrpcQuerier(subspace, nil, idx, nil, nil, nil)
+	gotResp, gotErr := q.ObservedGasPrices(sdk.WrapSDKContext(ctx), &types.QueryObservedGasPricesRequest{})
+	require.NoError(t, gotErr)
+	assert.Equal(t, ctx.BlockHeight(), gotResp.ToHeight)
+	assert.Equal(t, ctx.BlockHeight()-DefaultGasPriceWindow+1, gotResp.FromHeight)
+	require.Len(t, gotResp.GasPrices, 1)
+	assert.Equal(t, uint64(1), gotResp.GasPrices[0].TxCount)
+	assert.Equal(t, sdk.OneDec().String(), gotResp.GasPrices[0].P50.String())
+
+	gotResp, gotErr = q.ObservedGasPrices(sdk.WrapSDKContext(ctx), &types.QueryObservedGasPricesRequest{Window: DefaultGasPriceWindow + 1})
+	require.NoError(t, gotErr)
+	assert.Equal(t, uint64(2), gotResp.GasPrices[0].TxCount)
+
+	_, gotErr = q.ObservedGasPrices(sdk.WrapSDKContext(ctx), &types.QueryObservedGasPricesRequest{Window: 1001})
+	require.Error(t, gotErr)
+
+	_, gotErr = NewGrpcQuerier(subspace, nil, nil, nil, nil, nil).ObservedGasPrices(sdk.WrapSDKContext(ctx), &types.QueryObservedGasPricesRequest{})
+	require.Error(t, gotErr)
+}
+
+func TestTimeWeightedAverageFees(t *testing.T) {
+	ctx, _, _ := setupTestStore(t)
+	const gas = 100_000
+	uatom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uatom", amount)) }
+
+	idx := NewGasPriceIndex(10)
+	genesisTime := time.Unix(1_700_000_000, 0).UTC()
+	// block times of 5s, 1s, 10s and 4s after block 1
+	blockTimes := map[int64]time.Duration{1: 0, 2: 5 * time.Second, 3: 6 * time.Second, 4: 16 * time.Second, 5: 20 * time.Second}
+	blockCtx := func(height int64) sdk.Context {
+		return ctx.WithBlockHeight(height).WithBlockTime(genesisTime.Add(blockTimes[height]))
+	}
+
+	// block 1 is left out as the previous block time is unknown
+	idx.RecordGasPrices(blockCtx(1), uatom(1_000_000), gas)
+	// block 2 pays 4000uatom for 200000 gas, i.e. 0.02uatom per gas
+	idx.RecordGasPrices(blockCtx(2), uatom(1000), gas)
+	idx.RecordGasPrices(blockCtx(2), uatom(3000), gas)
+	// block 3 pays 0.1uatom per gas
+	idx.RecordGasPrices(blockCtx(3), uatom(10_000), gas)
+	// block 4 pays 0.005uatom and 0.02photon per gas
+	idx.RecordGasPrices(blockCtx(4), uatom(500), gas)
+	idx.RecordGasPrices(blockCtx(4), sdk.NewCoins(sdk.NewInt64Coin("photon", 2000)), gas)
+	// block 5 has no tx
+	for height := int64(1); height <= 5; height++ {
+		idx.RecordBlockTime(blockCtx(height))
+	}
+
+	res := idx.TimeWeightedAverageFees(5, 10)
+	assert.Equal(t, int64(1), res.FromHeight)
+	assert.Equal(t, int64(5), res.ToHeight)
+	require.Len(t, res.AverageFees, 2)
+	assert.Equal(t, "photon", res.AverageFees[0].Denom)
+	assert.Equal(t, uint64(1), res.AverageFees[0].BlockCount)
+	assert.Equal(t, 10*time.Second, res.AverageFees[0].Duration)
+	assert.Equal(t, sdk.NewDecWithPrec(2, 2).String(), res.AverageFees[0].AverageFeePerGas.String())
+	// (0.02 * 5 + 0.1 * 1 + 0.005 * 10) / 16 = 0.015625, whereas the simple
+	// average of the blocks is 0.041666...
+	assert.Equal(t, "uatom", res.AverageFees[1].Denom)
+	assert.Equal(t, uint64(3), res.AverageFees[1].BlockCount)
+	assert.Equal(t, 16*time.Second, res.AverageFees[1].Duration)
+	assert.Equal(t, sdk.NewDecWithPrec(15625, 6).String(), res.AverageFees[1].AverageFeePerGas.String())
+
+	// only the most recent blocks are averaged
+	res = idx.TimeWeightedAverageFees(5, 2)
+	assert.Equal(t, int64(4), res.FromHeight)
+	require.Len(t, res.AverageFees, 2)
+	assert.Equal(t, sdk.NewDecWithPrec(5, 3).String(), res.AverageFees[1].AverageFeePerGas.String())
+
+	// heights out of the retention window are pruned
+	idx.RecordBlockTime(ctx.WithBlockHeight(14).WithBlockTime(genesisTime.Add(time.Minute)))
+	res = idx.TimeWeightedAverageFees(14, 10)
+	assert.Equal(t, int64(5), res.FromHeight)
+	require.Empty(t, res.AverageFees)
+}
+
+func TestQueryTimeWeightedAverageFee(t *testing.T) {
+	ctx, _, subspace := setupTestStore(t)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+
+	idx := NewGasPriceIndex(1000)
+	for _, height := range []int64{ctx.BlockHeight() - DefaultGasPriceWindow, ctx.BlockHeight()} {
+		idx.RecordGasPrices(ctx.WithBlockHeight(height), fee, 1000)
+		idx.RecordBlockTime(ctx.WithBlockHeight(height - 1).WithBlockTime(blockTime))
+		idx.RecordBlockTime(ctx.WithBlockHeight(height).WithBlockTime(blockTime.Add(time.Second)))
+	}
+
+	q := NewGrpcQuerier(subspace, nil, idx, nil, nil, nil)
+	gotResp, gotErr := q.TimeWeightedAverageFee(sdk.WrapSDKContext(ctx), &types.QueryTimeWeightedAverageFeeRequest{})
+	require.NoError(t, gotErr)
+	assert.Equal(t, ctx.BlockHeight(), gotResp.ToHeight)
+	assert.Equal(t, ctx.BlockHeight()-DefaultGasPriceWindow+1, gotResp.FromHeight)
+	require.Len(t, gotResp.AverageFees, 1)
+	assert.Equal(t, uint64(1), gotResp.AverageFees[0].BlockCount)
+	assert.Equal(t, sdk.OneDec().String(), gotResp.AverageFees[0].AverageFeePerGas.String())
+
+	gotResp, gotErr = q.TimeWeightedAverageFee(sdk.WrapSDKContext(ctx), &types.QueryTimeWeightedAverageFeeRequest{Window: DefaultGasPriceWindow + 1})
+	require.NoError(t, gotErr)
+	assert.Equal(t, uint64(2), gotResp.AverageFees[0].BlockCount)
+
+	_, gotErr = q.TimeWeightedAverageFee(sdk.WrapSDKContext(ctx), &types.QueryTimeWeightedAverageFeeRequest{Window: 1001})
+	require.Error(t, gotErr)
+
+	_, gotErr = NewGrpcQuerier(subspace, nil, nil, nil, nil, nil).TimeWeightedAverageFee(sdk.WrapSDKContext(ctx), &types.QueryTimeWeightedAverageFeeRequest{})
+	require.Error(t, gotErr)
+}
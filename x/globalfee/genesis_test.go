@@ -23,7 +23,7 @@ import (
 func TestDefaultGenesis(t *testing.T) {
 	encCfg := simapp.MakeTestEncodingConfig()
 	gotJSON := AppModuleBasic{}.DefaultGenesis(encCfg.Marshaler)
-	assert.JSONEq(t, `{"params":{"minimum_gas_prices":[]}}`, string(gotJSON), string(gotJSON))
+	assert.JSONEq(t, `{"params":{"minimum_gas_prices":[],"min_flat_fee":[],"msg_gas_floors":[],"dynamic_fee_sensitivity":"0.000000000000000000","dynamic_fee_floor":"0.000000000000000000","dynamic_fee_ceiling":"0.000000000000000000","allowed_fee_sponsors":[]},"dynamic_fee_state":null}`, string(gotJSON), string(gotJSON))
 }
 
 func TestValidateGenesis(t *testing.T) {
@@ -61,6 +61,37 @@ func TestValidateGenesis(t *testing.T) {
 			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"},{"denom":"ZLX", "amount":"2"}]}}`,
 			expErr: false,
 		},
+		"min flat fee is allowed": {
+			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"min_flat_fee":[{"denom":"ALX", "amount":"1000"}]}}`,
+			expErr: false,
+		},
+		"zero min flat fee not allowed": {
+			src:    `{"params":{"min_flat_fee":[{"denom":"ALX", "amount":"0"}]}}`,
+			expErr: true,
+		},
+		"msg gas floors are allowed": {
+			src:    `{"params":{"msg_gas_floors":[{"msg_type_url":"/cosmos.bank.v1beta1.MsgMultiSend","min_gas":"100000"}]}}`,
+			expErr: false,
+		},
+		"duplicate msg gas floors not allowed": {
+			src:    `{"params":{"msg_gas_floors":[{"msg_type_url":"/cosmos.bank.v1beta1.MsgSend","min_gas":"1"},{"msg_type_url":"/cosmos.bank.v1beta1.MsgSend","min_gas":"2"}]}}`,
+			expErr: true,
+		},
+		"min flat fee denom must be sorted": {
+			src:    `{"params":{"min_flat_fee":[{"denom":"ZLX", "amount":"1"},{"denom":"ALX", "amount":"2"}]}}`,
+			expErr: true,
+		},
+		"dynamic fee state is allowed": {
+			src: `{"params":{},"dynamic_fee_state":{"multiplier":"1.5","recent_fullness":["0.5","1"],"height":"10"}}`,
+		},
+		"zero dynamic fee multiplier not allowed": {
+			src:    `{"params":{},"dynamic_fee_state":{"multiplier":"0","recent_fullness":[],"height":"10"}}`,
+			expErr: true,
+		},
+		"block fullness above one not allowed": {
+			src:    `{"params":{},"dynamic_fee_state":{"multiplier":"1","recent_fullness":["1.5"],"height":"10"}}`,
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -81,22 +112,46 @@ func TestInitExportGenesis(t *testing.T) {
 	}{
 		"single fee": {
 			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}]}}`,
-			exp: types.GenesisState{Params: types.Params{MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)))}},
+			exp: types.GenesisState{Params: types.Params{MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1))), MinFlatFee: sdk.Coins{}, MsgGasFloors: []types.MsgGasFloor{}, DynamicFeeSensitivity: sdk.ZeroDec(), DynamicFeeFloor: sdk.ZeroDec(), DynamicFeeCeiling: sdk.ZeroDec(), AllowedFeeSponsors: []string{}}},
 		},
 		"multiple fee options": {
 			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}, {"denom":"BLX", "amount":"0.001"}]}}`,
 			exp: types.GenesisState{Params: types.Params{MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)),
-				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)))}},
+				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3))), MinFlatFee: sdk.Coins{}, MsgGasFloors: []types.MsgGasFloor{}, DynamicFeeSensitivity: sdk.ZeroDec(), DynamicFeeFloor: sdk.ZeroDec(), DynamicFeeCeiling: sdk.ZeroDec(), AllowedFeeSponsors: []string{}}},
 		},
 		"no fee set": {
 			src: `{"params":{}}`,
-			exp: types.GenesisState{Params: types.Params{MinimumGasPrices: sdk.DecCoins{}}},
+			exp: types.GenesisState{Params: types.Params{MinimumGasPrices: sdk.DecCoins{}, MinFlatFee: sdk.Coins{}, MsgGasFloors: []types.MsgGasFloor{}, DynamicFeeSensitivity: sdk.ZeroDec(), DynamicFeeFloor: sdk.ZeroDec(), DynamicFeeCeiling: sdk.ZeroDec(), AllowedFeeSponsors: []string{}}},
+		},
+		"min flat fee": {
+			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}],"min_flat_fee":[{"denom":"ALX", "amount":"1000"}]}}`,
+			exp: types.GenesisState{Params: types.Params{
+				MinimumGasPrices:      sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1))),
+				MinFlatFee:            sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1000))),
+				MsgGasFloors:          []types.MsgGasFloor{},
+				DynamicFeeSensitivity: sdk.ZeroDec(),
+				DynamicFeeFloor:       sdk.ZeroDec(),
+				DynamicFeeCeiling:     sdk.ZeroDec(),
+				AllowedFeeSponsors:    []string{},
+			}},
+		},
+		"msg gas floors": {
+			src: `{"params":{"msg_gas_floors":[{"msg_type_url":"/cosmos.bank.v1beta1.MsgMultiSend","min_gas":"100000"}]}}`,
+			exp: types.GenesisState{Params: types.Params{
+				MinimumGasPrices:      sdk.DecCoins{},
+				MinFlatFee:            sdk.Coins{},
+				MsgGasFloors:          []types.MsgGasFloor{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend", MinGas: 100_000}},
+				DynamicFeeSensitivity: sdk.ZeroDec(),
+				DynamicFeeFloor:       sdk.ZeroDec(),
+				DynamicFeeCeiling:     sdk.ZeroDec(),
+				AllowedFeeSponsors:    []string{},
+			}},
 		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			ctx, encCfg, subspace := setupTestStore(t)
-			m := NewAppModule(subspace)
+			m := NewAppModule(subspace, nil, nil, nil, nil, nil)
 			m.InitGenesis(ctx, encCfg.Marshaler, []byte(spec.src))
 			gotJSON := m.ExportGenesis(ctx, encCfg.Marshaler)
 			var got types.GenesisState
@@ -106,15 +161,45 @@ func TestInitExportGenesis(t *testing.T) {
 	}
 }
 
+func TestInitExportDynamicFeeState(t *testing.T) {
+	ctx, encCfg, subspace, k := setupTestStoreWithDynamicFees(t)
+	m := NewAppModule(subspace, nil, nil, &k, nil, nil)
+
+	// the multiplier is one without a state
+	m.InitGenesis(ctx, encCfg.Marshaler, []byte(`{"params":{}}`))
+	var got types.GenesisState
+	require.NoError(t, encCfg.Marshaler.UnmarshalJSON(m.ExportGenesis(ctx, encCfg.Marshaler), &got))
+	require.NotNil(t, got.DynamicFeeState)
+	assert.Equal(t, sdk.OneDec().String(), got.DynamicFeeState.Multiplier.String())
+
+	// and the state is kept across an export and import
+	m.InitGenesis(ctx, encCfg.Marshaler, []byte(`{"params":{},"dynamic_fee_state":{"multiplier":"1.5","recent_fullness":["0.5","1"],"height":"10"}}`))
+	require.NoError(t, encCfg.Marshaler.UnmarshalJSON(m.ExportGenesis(ctx, encCfg.Marshaler), &got))
+	assert.Equal(t, types.DynamicFeeState{
+		Multiplier:     sdk.NewDecWithPrec(15, 1),
+		RecentFullness: []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.OneDec()},
+		Height:         10,
+	}, *got.DynamicFeeState)
+	assert.Equal(t, sdk.NewDecWithPrec(15, 1).String(), k.Multiplier(ctx).String())
+}
+
 func setupTestStore(t *testing.T) (sdk.Context, simappparams.EncodingConfig, paramstypes.Subspace) {
+	t.Helper()
+	ctx, encCfg, subspace, _ := setupTestStoreWithDynamicFees(t)
+	return ctx, encCfg, subspace
+}
+
+func setupTestStoreWithDynamicFees(t *testing.T) (sdk.Context, simappparams.EncodingConfig, paramstypes.Subspace, DynamicFeeKeeper) {
 	t.Helper()
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	encCfg := simapp.MakeTestEncodingConfig()
 	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	keyGlobalFee := sdk.NewKVStoreKey(types.StoreKey)
 	ms.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, storetypes.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyGlobalFee, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, ms.LoadLatestVersion())
 
 	paramsKeeper := paramskeeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, keyParams, tkeyParams)
@@ -125,5 +210,5 @@ func setupTestStore(t *testing.T) (sdk.Context, simappparams.EncodingConfig, par
 	}, false, log.NewNopLogger())
 
 	subspace := paramsKeeper.Subspace(ModuleName).WithKeyTable(types.ParamKeyTable())
-	return ctx, encCfg, subspace
+	return ctx, encCfg, subspace, NewDynamicFeeKeeper(encCfg.Marshaler, keyGlobalFee)
 }
@@ -0,0 +1,139 @@
+package globalfee
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+// mempoolSampleLimit is the maximum number of pending txs read from the
+// mempool, which is the maximum page size of the Tendermint RPC.
+const mempoolSampleLimit = 100
+
+// MempoolGasPriceBuckets are the upper bounds of the mempool gas price
+// histogram buckets, per order of magnitude.
+var MempoolGasPriceBuckets = []sdk.Dec{
+	sdk.NewDecWithPrec(1, 4),
+	sdk.NewDecWithPrec(1, 3),
+	sdk.NewDecWithPrec(1, 2),
+	sdk.NewDecWithPrec(1, 1),
+	sdk.OneDec(),
+	sdk.NewDec(10),
+}
+
+var _ types.MempoolServer = &MempoolServer{}
+
+// MempoolServer serves the fees of the txs pending in the mempool of the
+// node. It is node local: the mempool is read through the Tendermint RPC
+// client of the client context.
+type MempoolServer struct {
+	clientCtx client.Context
+}
+
+// NewMempoolServer returns a MempoolServer reading from the given client
+// context, which must have a Tendermint RPC client and a tx config set.
+func NewMempoolServer(clientCtx client.Context) MempoolServer {
+	return MempoolServer{clientCtx: clientCtx}
+}
+
+// Fees returns the number of pending txs and the gas price histograms of the
+// first pending txs, up to mempoolSampleLimit.
+func (m MempoolServer) Fees(ctx context.Context, _ *types.MempoolFeesRequest) (*types.MempoolFeesResponse, error) {
+	if m.clientCtx.Client == nil {
+		return nil, status.Error(codes.Unavailable, "no tendermint client")
+	}
+	if m.clientCtx.TxConfig == nil {
+		return nil, status.Error(codes.Unavailable, "no tx config")
+	}
+
+	limit := mempoolSampleLimit
+	res, err := m.clientCtx.Client.UnconfirmedTxs(ctx, &limit)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	decoder := m.clientCtx.TxConfig.TxDecoder()
+	feeTxs := make([]sdk.FeeTx, 0, len(res.Txs))
+	for _, txBytes := range res.Txs {
+		// the txs which can't be decoded are left out of the sample
+		tx, err := decoder(txBytes)
+		if err != nil {
+			continue
+		}
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			feeTxs = append(feeTxs, feeTx)
+		}
+	}
+
+	fees := mempoolFees(feeTxs)
+	fees.TxCount = uint64(res.Total)
+	fees.TotalBytes = res.TotalBytes
+	return &fees, nil
+}
+
+// mempoolFees returns the gas price histograms of the txs.
+func mempoolFees(txs []sdk.FeeTx) types.MempoolFeesResponse {
+	res := types.MempoolFeesResponse{SampledTxCount: uint64(len(txs))}
+
+	byDenom := make(map[string]*types.DenomGasPriceHistogram)
+	for _, tx := range txs {
+		fee := tx.GetFee()
+		if fee.IsZero() {
+			res.ZeroFeeTxCount++
+			continue
+		}
+		if tx.GetGas() == 0 {
+			continue
+		}
+		gasDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(tx.GetGas()))
+
+		for _, coin := range fee {
+			histogram, ok := byDenom[coin.Denom]
+			if !ok {
+				histogram = &types.DenomGasPriceHistogram{
+					Denom:   coin.Denom,
+					Buckets: make([]types.GasPriceBucket, len(MempoolGasPriceBuckets)),
+				}
+				for i, maxGasPrice := range MempoolGasPriceBuckets {
+					histogram.Buckets[i].MaxGasPrice = maxGasPrice
+				}
+				byDenom[coin.Denom] = histogram
+			}
+
+			bucket := gasPriceBucket(coin.Amount.ToDec().Quo(gasDec))
+			if bucket < 0 {
+				histogram.AboveMaxCount++
+			} else {
+				histogram.Buckets[bucket].Count++
+			}
+		}
+	}
+
+	res.GasPriceHistograms = make([]types.DenomGasPriceHistogram, 0, len(byDenom))
+	for _, histogram := range byDenom {
+		res.GasPriceHistograms = append(res.GasPriceHistograms, *histogram)
+	}
+	sort.Slice(res.GasPriceHistograms, func(i, j int) bool {
+		return res.GasPriceHistograms[i].Denom < res.GasPriceHistograms[j].Denom
+	})
+
+	return res
+}
+
+// gasPriceBucket returns the index of the bucket of the gas price, or -1 if
+// it is above the last bucket.
+func gasPriceBucket(gasPrice sdk.Dec) int {
+	for i, maxGasPrice := range MempoolGasPriceBuckets {
+		if gasPrice.LTE(maxGasPrice) {
+			return i
+		}
+	}
+
+	return -1
+}
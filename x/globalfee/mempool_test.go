@@ -0,0 +1,96 @@
+package globalfee_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+// mockMempool serves the pending txs of a mempool holding total txs.
+type mockMempool struct {
+	rpcclient.Client
+	txs   []tmtypes.Tx
+	total int
+}
+
+func (m mockMempool) UnconfirmedTxs(_ context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	txs := m.txs
+	if limit != nil && *limit < len(txs) {
+		txs = txs[:*limit]
+	}
+	return &coretypes.ResultUnconfirmedTxs{Count: len(txs), Total: m.total, TotalBytes: 1234, Txs: txs}, nil
+}
+
+func TestMempoolFees(t *testing.T) {
+	txConfig := gaiaapp.MakeTestEncodingConfig().TxConfig
+	encodeTx := func(fee sdk.Coins, gas uint64) tmtypes.Tx {
+		builder := txConfig.NewTxBuilder()
+		addr := sdk.AccAddress("addr________________")
+		require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))))
+		builder.SetFeeAmount(fee)
+		builder.SetGasLimit(gas)
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	mempool := mockMempool{
+		txs: []tmtypes.Tx{
+			encodeTx(sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)), 200_000),
+			encodeTx(sdk.NewCoins(sdk.NewInt64Coin("uatom", 50)), 100_000),
+			encodeTx(sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("photon", 3_000_000)), 100_000),
+			encodeTx(sdk.Coins{}, 100_000),
+			tmtypes.Tx("not a tx"),
+		},
+		// more txs are pending than sampled
+		total: 7,
+	}
+	server := globalfee.NewMempoolServer(client.Context{}.WithClient(mempool).WithTxConfig(txConfig))
+
+	res, err := server.Fees(context.Background(), &types.MempoolFeesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), res.TxCount)
+	assert.Equal(t, int64(1234), res.TotalBytes)
+	assert.Equal(t, uint64(4), res.SampledTxCount)
+	assert.Equal(t, uint64(1), res.ZeroFeeTxCount)
+
+	require.Len(t, res.GasPriceHistograms, 2)
+	counts := func(histogram types.DenomGasPriceHistogram) []uint64 {
+		require.Len(t, histogram.Buckets, len(globalfee.MempoolGasPriceBuckets))
+		counts := make([]uint64, len(histogram.Buckets))
+		for i, bucket := range histogram.Buckets {
+			assert.Equal(t, globalfee.MempoolGasPriceBuckets[i], bucket.MaxGasPrice)
+			counts[i] = bucket.Count
+		}
+		return counts
+	}
+
+	photon := res.GasPriceHistograms[0]
+	assert.Equal(t, "photon", photon.Denom)
+	assert.Equal(t, []uint64{0, 0, 0, 0, 0, 0}, counts(photon))
+	assert.Equal(t, uint64(1), photon.AboveMaxCount)
+
+	uatom := res.GasPriceHistograms[1]
+	assert.Equal(t, "uatom", uatom.Denom)
+	// the gas prices are 0.01, 0.0005 and 0.001
+	assert.Equal(t, []uint64{0, 2, 1, 0, 0, 0}, counts(uatom))
+	assert.Zero(t, uatom.AboveMaxCount)
+
+	// the mempool can't be read without a tendermint client
+	_, err = globalfee.NewMempoolServer(client.Context{}.WithTxConfig(txConfig)).Fees(context.Background(), &types.MempoolFeesRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,77 @@
+package globalfee
+
+import (
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+// DefaultMinGasPriceTimelineRetention is the number of steps of the effective
+// minimum gas prices kept by the MinGasPriceTimelineIndex. Steps are only
+// recorded when the minimum gas prices change, so they usually span far more
+// blocks.
+const DefaultMinGasPriceTimelineRetention = 10_000
+
+// MinGasPriceTimelineIndex keeps in memory the steps of the effective minimum
+// gas prices, i.e. the minimum gas prices scaled by the dynamic multiplier,
+// recorded at the end of the blocks delivered by this node. A step is only
+// recorded when the effective minimum gas prices change.
+type MinGasPriceTimelineIndex struct {
+	mtx       sync.RWMutex
+	retention int
+	// steps are the recorded steps, by increasing height
+	steps []types.MinGasPriceStep
+}
+
+// NewMinGasPriceTimelineIndex returns a MinGasPriceTimelineIndex keeping the
+// given number of most recent steps.
+func NewMinGasPriceTimelineIndex(retention int) *MinGasPriceTimelineIndex {
+	if retention <= 0 {
+		retention = DefaultMinGasPriceTimelineRetention
+	}
+
+	return &MinGasPriceTimelineIndex{retention: retention}
+}
+
+// RecordBlock records the effective minimum gas prices of the context block
+// unless they are the same as the ones of the last step.
+func (idx *MinGasPriceTimelineIndex) RecordBlock(ctx sdk.Context, minGasPrices sdk.DecCoins) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if n := len(idx.steps); n > 0 && idx.steps[n-1].MinimumGasPrices.String() == minGasPrices.String() {
+		return
+	}
+	idx.steps = append(idx.steps, types.MinGasPriceStep{
+		Height:           ctx.BlockHeight(),
+		MinimumGasPrices: minGasPrices,
+	})
+	if len(idx.steps) > idx.retention {
+		idx.steps = idx.steps[len(idx.steps)-idx.retention:]
+	}
+}
+
+// Steps returns the steps in effect between fromHeight and toHeight: the step
+// in effect at fromHeight, if recorded, followed by the steps recorded up to
+// toHeight.
+func (idx *MinGasPriceTimelineIndex) Steps(fromHeight, toHeight int64) []types.MinGasPriceStep {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	// first is the index of the first step recorded after fromHeight
+	first := sort.Search(len(idx.steps), func(i int) bool { return idx.steps[i].Height > fromHeight })
+	if first > 0 {
+		first--
+	}
+	steps := []types.MinGasPriceStep{}
+	for _, step := range idx.steps[first:] {
+		if step.Height > toHeight {
+			break
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
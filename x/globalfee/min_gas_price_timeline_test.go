@@ -0,0 +1,97 @@
+package globalfee
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+func TestMinGasPriceTimeline(t *testing.T) {
+	ctx, _, subspace := setupTestStore(t)
+	idx := NewMinGasPriceTimelineIndex(0)
+	m := NewAppModule(subspace, nil, nil, nil, idx, nil)
+
+	initial := sdk.DecCoins{sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 3))}
+	raised := sdk.DecCoins{sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 2))}
+	lowered := sdk.DecCoins{sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(5, 3))}
+	subspace.SetParamSet(ctx, &types.Params{MinimumGasPrices: initial})
+	for height := int64(1); height <= 10; height++ {
+		// the params change at heights 4 and 7, the other blocks record the
+		// same minimum gas prices and are compressed into the same step
+		switch height {
+		case 4:
+			subspace.Set(ctx, types.ParamStoreKeyMinGasPrices, raised)
+		case 7:
+			subspace.Set(ctx, types.ParamStoreKeyMinGasPrices, lowered)
+		}
+		m.EndBlock(ctx.WithBlockHeight(height), abci.RequestEndBlock{})
+	}
+
+	q := NewGrpcQuerier(subspace, nil, nil, nil, idx, nil)
+	specs := map[string]struct {
+		from, to int64
+		exp      []types.MinGasPriceStep
+	}{
+		"whole timeline": {
+			from: 1,
+			to:   10,
+			exp: []types.MinGasPriceStep{
+				{Height: 1, MinimumGasPrices: initial},
+				{Height: 4, MinimumGasPrices: raised},
+				{Height: 7, MinimumGasPrices: lowered},
+			},
+		},
+		"starts with the step in effect": {
+			from: 5,
+			to:   10,
+			exp: []types.MinGasPriceStep{
+				{Height: 4, MinimumGasPrices: raised},
+				{Height: 7, MinimumGasPrices: lowered},
+			},
+		},
+		"within a step": {
+			from: 2,
+			to:   3,
+			exp: []types.MinGasPriceStep{
+				{Height: 1, MinimumGasPrices: initial},
+			},
+		},
+		"latest height by default": {
+			from: 8,
+			exp: []types.MinGasPriceStep{
+				{Height: 7, MinimumGasPrices: lowered},
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			res, err := q.MinGasPriceTimeline(sdk.WrapSDKContext(ctx.WithBlockHeight(10)), &types.QueryMinGasPriceTimelineRequest{FromHeight: spec.from, ToHeight: spec.to})
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, res.Steps)
+		})
+	}
+
+	_, err := q.MinGasPriceTimeline(sdk.WrapSDKContext(ctx), &types.QueryMinGasPriceTimelineRequest{FromHeight: 5, ToHeight: 4})
+	require.Error(t, err)
+	// the timeline is not tracked without an index
+	_, err = NewGrpcQuerier(subspace, nil, nil, nil, nil, nil).MinGasPriceTimeline(sdk.WrapSDKContext(ctx), &types.QueryMinGasPriceTimelineRequest{})
+	require.Error(t, err)
+}
+
+func TestMinGasPriceTimelineRetention(t *testing.T) {
+	ctx, _, _ := setupTestStore(t)
+	idx := NewMinGasPriceTimelineIndex(2)
+	for height := int64(1); height <= 3; height++ {
+		idx.RecordBlock(ctx.WithBlockHeight(height), sdk.DecCoins{sdk.NewDecCoin("uatom", sdk.NewInt(height))})
+	}
+
+	steps := idx.Steps(1, 3)
+	require.Len(t, steps, 2)
+	assert.Equal(t, int64(2), steps[0].Height)
+	assert.Equal(t, int64(3), steps[1].Height)
+}
@@ -48,6 +48,11 @@ func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, _ client.TxEn
 	if err := data.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	if data.DynamicFeeState != nil {
+		if err := data.DynamicFeeState.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "dynamic fee state")
+		}
+	}
 	return nil
 }
 
@@ -63,6 +68,14 @@ func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux
 		// same behavior as in cosmos-sdk
 		panic(err)
 	}
+	err = types.RegisterMempoolHandlerClient(context.Background(), mux, types.NewMempoolClient(clientCtx))
+	if err != nil {
+		panic(err)
+	}
+	err = types.RegisterNodeConfigHandlerClient(context.Background(), mux, types.NewNodeConfigClient(clientCtx))
+	if err != nil {
+		panic(err)
+	}
 }
 
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
@@ -78,28 +91,45 @@ func (a AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {
 
 type AppModule struct {
 	AppModuleBasic
-	paramSpace paramstypes.Subspace
-}
-
-// NewAppModule constructor
-func NewAppModule(paramSpace paramstypes.Subspace) *AppModule {
+	paramSpace  paramstypes.Subspace
+	rejections  *FeeRejectionIndex
+	gasPrices   *GasPriceIndex
+	dynamicFees *DynamicFeeKeeper
+	timeline    *MinGasPriceTimelineIndex
+	bypasses    *BypassRateIndex
+}
+
+// NewAppModule constructor. The fee rejection, gas price, min gas price
+// timeline and bypass rate indexes are optional, the FeeRejectionStats,
+// ObservedGasPrices, TimeWeightedAverageFee, MinGasPriceTimeline and
+// BypassRate queries are unavailable without them. The dynamic fee keeper is
+// only optional in tests, the multiplier is neither adjusted nor exported
+// without it.
+func NewAppModule(paramSpace paramstypes.Subspace, rejections *FeeRejectionIndex, gasPrices *GasPriceIndex, dynamicFees *DynamicFeeKeeper, timeline *MinGasPriceTimelineIndex, bypasses *BypassRateIndex) *AppModule {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return &AppModule{paramSpace: paramSpace}
+	return &AppModule{paramSpace: paramSpace, rejections: rejections, gasPrices: gasPrices, dynamicFees: dynamicFees, timeline: timeline, bypasses: bypasses}
 }
 
 func (a AppModule) InitGenesis(ctx sdk.Context, marshaler codec.JSONCodec, message json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState types.GenesisState
 	marshaler.MustUnmarshalJSON(message, &genesisState)
 	a.paramSpace.SetParamSet(ctx, &genesisState.Params)
+	if a.dynamicFees != nil && genesisState.DynamicFeeState != nil {
+		a.dynamicFees.SetState(ctx, *genesisState.DynamicFeeState)
+	}
 	return nil
 }
 
 func (a AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) json.RawMessage {
-	var genState types.GenesisState
-	a.paramSpace.GetParamSet(ctx, &genState.Params)
+	genState := types.GenesisState{Params: types.DefaultParams()}
+	a.paramSpace.GetParamSetIfExists(ctx, &genState.Params)
+	if a.dynamicFees != nil {
+		state := a.dynamicFees.GetState(ctx)
+		genState.DynamicFeeState = &state
+	}
 	return marshaler.MustMarshalJSON(&genState)
 }
 
@@ -119,13 +149,43 @@ func (a AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 }
 
 func (a AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterQueryServer(cfg.QueryServer(), NewGrpcQuerier(a.paramSpace))
+	types.RegisterQueryServer(cfg.QueryServer(), NewGrpcQuerier(a.paramSpace, a.rejections, a.gasPrices, a.dynamicFees, a.timeline, a.bypasses))
 }
 
 func (a AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {
 }
 
-func (a AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock emits an EventTypeParamsChanged event when any of the params was
+// set in this block, so that clients can subscribe to the params changes,
+// adjusts the dynamic multiplier of the minimum gas prices with the fullness
+// of the block, records the resulting effective minimum gas prices and
+// records the block time weighting the fees of the block.
+func (a AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if a.gasPrices != nil {
+		a.gasPrices.RecordBlockTime(ctx)
+	}
+	if a.dynamicFees != nil {
+		a.dynamicFees.RecordBlock(ctx, a.paramSpace)
+	}
+	if a.timeline != nil {
+		var minGasPrices sdk.DecCoins
+		if a.paramSpace.Has(ctx, types.ParamStoreKeyMinGasPrices) {
+			a.paramSpace.Get(ctx, types.ParamStoreKeyMinGasPrices, &minGasPrices)
+		}
+		if a.dynamicFees != nil {
+			minGasPrices = ApplyDynamicFeeMultiplier(minGasPrices, a.dynamicFees.Multiplier(ctx))
+		}
+		a.timeline.RecordBlock(ctx, minGasPrices)
+	}
+	for _, pair := range (&types.Params{}).ParamSetPairs() {
+		if a.paramSpace.Modified(ctx, pair.Key) {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeParamsChanged,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			))
+			break
+		}
+	}
 	return nil
 }
 
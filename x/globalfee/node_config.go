@@ -0,0 +1,124 @@
+package globalfee
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// ClientConfigVersion is the version of the client config. It is bumped when
+// the fee policy gains an element the clients must take into account.
+const ClientConfigVersion uint32 = 1
+
+var _ types.NodeConfigServer = &NodeConfigServer{}
+
+// NodeConfigServer serves the fee config of the node. It is node local: the
+// bypass min fee msg types are read from the app.toml of the node.
+type NodeConfigServer struct {
+	bypassMsgTypes    []string
+	maxBypassGasUsage uint64
+	paramSource       ParamSource
+	policySource      ParamSource
+	stakingSource     ParamSource
+	dynamicFees       DynamicFeeSource
+}
+
+// NewNodeConfigServer returns a NodeConfigServer serving the given bypass min
+// fee msg types and bypass gas limit. The globalfee, policy and staking params
+// and the dynamic fees, if set, are read to serve the client config.
+func NewNodeConfigServer(bypassMsgTypes []string, maxBypassGasUsage uint64, paramSource, policySource, stakingSource ParamSource, dynamicFees DynamicFeeSource) NodeConfigServer {
+	return NodeConfigServer{
+		bypassMsgTypes:    bypassMsgTypes,
+		maxBypassGasUsage: maxBypassGasUsage,
+		paramSource:       paramSource,
+		policySource:      policySource,
+		stakingSource:     stakingSource,
+		dynamicFees:       dynamicFees,
+	}
+}
+
+// BypassMinFeeMsgTypes returns the bypass min fee msg types of the node, as
+// configured, and their hash.
+func (n NodeConfigServer) BypassMinFeeMsgTypes(_ context.Context, _ *types.QueryBypassMinFeeMsgTypesRequest) (*types.QueryBypassMinFeeMsgTypesResponse, error) {
+	return &types.QueryBypassMinFeeMsgTypesResponse{
+		MsgTypes: n.bypassMsgTypes,
+		Hash:     BypassMinFeeMsgTypesHash(n.bypassMsgTypes),
+	}, nil
+}
+
+// ClientConfig returns the fee policy of the globalfee params and of the node
+// config a client needs to construct a TX accepted by this node.
+func (n NodeConfigServer) ClientConfig(stdCtx context.Context, _ *types.QueryClientConfigRequest) (*types.QueryClientConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	var minGasPrices sdk.DecCoins
+	if n.paramSource.Has(ctx, types.ParamStoreKeyMinGasPrices) {
+		n.paramSource.Get(ctx, types.ParamStoreKeyMinGasPrices, &minGasPrices)
+	}
+	if n.dynamicFees != nil {
+		minGasPrices = ApplyDynamicFeeMultiplier(minGasPrices, n.dynamicFees.Multiplier(ctx))
+	}
+	// the fee ante handler requires the bond denom at zero when the global
+	// minimum gas prices are empty
+	if len(minGasPrices) == 0 && n.stakingSource.Has(ctx, stakingtypes.KeyBondDenom) {
+		var bondDenom string
+		n.stakingSource.Get(ctx, stakingtypes.KeyBondDenom, &bondDenom)
+		minGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec(bondDenom, sdk.ZeroDec())}
+	}
+	feeDenoms := make([]string, len(minGasPrices))
+	for i, gasPrice := range minGasPrices {
+		feeDenoms[i] = gasPrice.Denom
+	}
+
+	res := &types.QueryClientConfigResponse{
+		Version:                         ClientConfigVersion,
+		MinimumGasPrices:                minGasPrices,
+		LocalMinimumGasPrices:           ctx.MinGasPrices(),
+		FeeDenoms:                       feeDenoms,
+		BypassMinFeeMsgTypes:            n.bypassMsgTypes,
+		MaxTotalBypassMinFeeMsgGasUsage: n.maxBypassGasUsage,
+	}
+	if n.paramSource.Has(ctx, types.ParamStoreKeyMinFlatFee) {
+		n.paramSource.Get(ctx, types.ParamStoreKeyMinFlatFee, &res.MinFlatFee)
+	}
+	if n.paramSource.Has(ctx, types.ParamStoreKeyMsgGasFloors) {
+		n.paramSource.Get(ctx, types.ParamStoreKeyMsgGasFloors, &res.MsgGasFloors)
+	}
+	if n.policySource.Has(ctx, policytypes.ParamStoreKeyMaxTxBytes) {
+		n.policySource.Get(ctx, policytypes.ParamStoreKeyMaxTxBytes, &res.MaxTxBytes)
+	}
+	return res, nil
+}
+
+// SortedMsgTypes returns a sorted copy of the msg types without duplicates.
+func SortedMsgTypes(msgTypes []string) []string {
+	sorted := make([]string, 0, len(msgTypes))
+	seen := make(map[string]bool, len(msgTypes))
+	for _, msgType := range msgTypes {
+		if !seen[msgType] {
+			seen[msgType] = true
+			sorted = append(sorted, msgType)
+		}
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// BypassMinFeeMsgTypesHash returns the hex encoded SHA-256 hash of the sorted
+// msg types, each followed by a newline, so that the same msg types in any
+// order have the same hash.
+func BypassMinFeeMsgTypesHash(msgTypes []string) string {
+	h := sha256.New()
+	for _, msgType := range SortedMsgTypes(msgTypes) {
+		h.Write([]byte(msgType))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
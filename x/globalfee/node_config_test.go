@@ -0,0 +1,26 @@
+package globalfee_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+func TestBypassMinFeeMsgTypes(t *testing.T) {
+	msgTypes := []string{"/ibc.core.channel.v1.MsgTimeout", "/ibc.core.channel.v1.MsgRecvPacket", "/ibc.core.channel.v1.MsgTimeout"}
+
+	res, err := globalfee.NewNodeConfigServer(msgTypes, 0, nil, nil, nil, nil).BypassMinFeeMsgTypes(context.Background(), &types.QueryBypassMinFeeMsgTypesRequest{})
+	require.NoError(t, err)
+	// the msg types are served as configured
+	require.Equal(t, msgTypes, res.MsgTypes)
+
+	// the hash ignores the order and the duplicates
+	require.Equal(t, globalfee.BypassMinFeeMsgTypesHash([]string{"/ibc.core.channel.v1.MsgRecvPacket", "/ibc.core.channel.v1.MsgTimeout"}), res.Hash)
+	require.NotEqual(t, globalfee.BypassMinFeeMsgTypesHash([]string{"/ibc.core.channel.v1.MsgRecvPacket"}), res.Hash)
+	// sha256 of the empty string
+	require.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", globalfee.BypassMinFeeMsgTypesHash(nil))
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/gaia/v9/x/globalfee/types"
 )
@@ -18,10 +20,15 @@ type ParamSource interface {
 
 type GrpcQuerier struct {
 	paramSource ParamSource
+	rejections  *FeeRejectionIndex
+	gasPrices   *GasPriceIndex
+	dynamicFees *DynamicFeeKeeper
+	timeline    *MinGasPriceTimelineIndex
+	bypasses    *BypassRateIndex
 }
 
-func NewGrpcQuerier(paramSource ParamSource) GrpcQuerier {
-	return GrpcQuerier{paramSource: paramSource}
+func NewGrpcQuerier(paramSource ParamSource, rejections *FeeRejectionIndex, gasPrices *GasPriceIndex, dynamicFees *DynamicFeeKeeper, timeline *MinGasPriceTimelineIndex, bypasses *BypassRateIndex) GrpcQuerier {
+	return GrpcQuerier{paramSource: paramSource, rejections: rejections, gasPrices: gasPrices, dynamicFees: dynamicFees, timeline: timeline, bypasses: bypasses}
 }
 
 // MinimumGasPrices return minimum gas prices
@@ -35,3 +42,171 @@ func (g GrpcQuerier) MinimumGasPrices(stdCtx context.Context, _ *types.QueryMini
 		MinimumGasPrices: minGasPrices,
 	}, nil
 }
+
+// Params returns the module params
+func (g GrpcQuerier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params := types.DefaultParams()
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMinGasPrices) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMinGasPrices, &params.MinimumGasPrices)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMinFlatFee) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMinFlatFee, &params.MinFlatFee)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMsgGasFloors) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMsgGasFloors, &params.MsgGasFloors)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyDynamicFeeSensitivity) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyDynamicFeeSensitivity, &params.DynamicFeeSensitivity)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyDynamicFeeFloor) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyDynamicFeeFloor, &params.DynamicFeeFloor)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyDynamicFeeCeiling) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyDynamicFeeCeiling, &params.DynamicFeeCeiling)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyAllowedFeeSponsors) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyAllowedFeeSponsors, &params.AllowedFeeSponsors)
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// FeeRejectionStats returns the txs rejected for insufficient fees by this node
+// over the most recent blocks
+func (g GrpcQuerier) FeeRejectionStats(stdCtx context.Context, req *types.QueryFeeRejectionStatsRequest) (*types.QueryFeeRejectionStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if g.rejections == nil {
+		return nil, status.Error(codes.Unavailable, "fee rejections are not indexed by this node")
+	}
+
+	window := req.Window
+	if window == 0 {
+		window = DefaultFeeRejectionWindow
+	}
+	if window > uint64(g.rejections.Retention()) {
+		return nil, status.Errorf(codes.InvalidArgument, "window %d exceeds the %d blocks retained", window, g.rejections.Retention())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	stats := g.rejections.Stats(ctx.BlockHeight(), window)
+
+	return &stats, nil
+}
+
+// BypassRate returns the txs delivered by this node which bypassed the minimum
+// fees over the most recent blocks
+func (g GrpcQuerier) BypassRate(stdCtx context.Context, req *types.QueryBypassRateRequest) (*types.QueryBypassRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if g.bypasses == nil {
+		return nil, status.Error(codes.Unavailable, "bypassed txs are not indexed by this node")
+	}
+
+	window := req.Window
+	if window == 0 {
+		window = DefaultBypassRateWindow
+	}
+	if window > uint64(g.bypasses.Retention()) {
+		return nil, status.Errorf(codes.InvalidArgument, "window %d exceeds the %d blocks retained", window, g.bypasses.Retention())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	stats := g.bypasses.Stats(ctx.BlockHeight(), window)
+
+	return &stats, nil
+}
+
+// ObservedGasPrices returns the percentiles of the gas prices paid by the txs
+// delivered by this node over the most recent blocks
+func (g GrpcQuerier) ObservedGasPrices(stdCtx context.Context, req *types.QueryObservedGasPricesRequest) (*types.QueryObservedGasPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if g.gasPrices == nil {
+		return nil, status.Error(codes.Unavailable, "gas prices are not indexed by this node")
+	}
+
+	window := req.Window
+	if window == 0 {
+		window = DefaultGasPriceWindow
+	}
+	if window > uint64(g.gasPrices.Retention()) {
+		return nil, status.Errorf(codes.InvalidArgument, "window %d exceeds the %d blocks retained", window, g.gasPrices.Retention())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	stats := g.gasPrices.Stats(ctx.BlockHeight(), window)
+
+	return &stats, nil
+}
+
+// TimeWeightedAverageFee returns the average fees per gas of the blocks
+// delivered by this node over the most recent blocks, weighted by their block
+// time
+func (g GrpcQuerier) TimeWeightedAverageFee(stdCtx context.Context, req *types.QueryTimeWeightedAverageFeeRequest) (*types.QueryTimeWeightedAverageFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if g.gasPrices == nil {
+		return nil, status.Error(codes.Unavailable, "gas prices are not indexed by this node")
+	}
+
+	window := req.Window
+	if window == 0 {
+		window = DefaultGasPriceWindow
+	}
+	if window > uint64(g.gasPrices.Retention()) {
+		return nil, status.Errorf(codes.InvalidArgument, "window %d exceeds the %d blocks retained", window, g.gasPrices.Retention())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	averageFees := g.gasPrices.TimeWeightedAverageFees(ctx.BlockHeight(), window)
+
+	return &averageFees, nil
+}
+
+// DynamicMinimumGasPrices returns the minimum gas prices scaled by the dynamic
+// multiplier of the module state
+func (g GrpcQuerier) DynamicMinimumGasPrices(stdCtx context.Context, _ *types.QueryDynamicMinimumGasPricesRequest) (*types.QueryDynamicMinimumGasPricesResponse, error) {
+	if g.dynamicFees == nil {
+		return nil, status.Error(codes.Unavailable, "dynamic fees are not tracked")
+	}
+
+	var minGasPrices sdk.DecCoins
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMinGasPrices) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMinGasPrices, &minGasPrices)
+	}
+
+	state := g.dynamicFees.GetState(ctx)
+	return &types.QueryDynamicMinimumGasPricesResponse{
+		MinimumGasPrices: ApplyDynamicFeeMultiplier(minGasPrices, state.Multiplier),
+		Multiplier:       state.Multiplier,
+		Fullness:         AverageFullness(state),
+		Height:           state.Height,
+	}, nil
+}
+
+// MinGasPriceTimeline returns the steps of the effective minimum gas prices
+// recorded by this node over a range of heights
+func (g GrpcQuerier) MinGasPriceTimeline(stdCtx context.Context, req *types.QueryMinGasPriceTimelineRequest) (*types.QueryMinGasPriceTimelineResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if g.timeline == nil {
+		return nil, status.Error(codes.Unavailable, "min gas price timeline is not indexed by this node")
+	}
+
+	toHeight := req.ToHeight
+	if toHeight == 0 {
+		toHeight = sdk.UnwrapSDKContext(stdCtx).BlockHeight()
+	}
+	if req.FromHeight < 0 || req.FromHeight > toHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", req.FromHeight, toHeight)
+	}
+
+	return &types.QueryMinGasPriceTimelineResponse{Steps: g.timeline.Steps(req.FromHeight, toHeight)}, nil
+}
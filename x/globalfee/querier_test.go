@@ -5,10 +5,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/gaia/v9/x/globalfee/types"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
 )
 
 func TestQueryMinimumGasPrices(t *testing.T) {
@@ -46,7 +48,7 @@ func TestQueryMinimumGasPrices(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctx, _, subspace := setupTestStore(t)
 			spec.setupStore(ctx, subspace)
-			q := NewGrpcQuerier(subspace)
+			q := NewGrpcQuerier(subspace, nil, nil, nil, nil, nil)
 			gotResp, gotErr := q.MinimumGasPrices(sdk.WrapSDKContext(ctx), nil)
 			require.NoError(t, gotErr)
 			require.NotNil(t, gotResp)
@@ -54,3 +56,82 @@ func TestQueryMinimumGasPrices(t *testing.T) {
 		})
 	}
 }
+
+type mockBondDenomSource string
+
+func (s mockBondDenomSource) Get(_ sdk.Context, _ []byte, ptr interface{}) {
+	*ptr.(*string) = string(s)
+}
+
+func (s mockBondDenomSource) Has(_ sdk.Context, key []byte) bool {
+	return string(key) == string(stakingtypes.KeyBondDenom)
+}
+
+// mockUint64Param is a param source of a single uint64 param.
+type mockUint64Param struct {
+	key   []byte
+	value uint64
+}
+
+func (p mockUint64Param) Get(_ sdk.Context, _ []byte, ptr interface{}) {
+	*ptr.(*uint64) = p.value
+}
+
+func (p mockUint64Param) Has(_ sdk.Context, key []byte) bool {
+	return string(key) == string(p.key)
+}
+
+type mockMultiplier sdk.Dec
+
+func (m mockMultiplier) Multiplier(sdk.Context) sdk.Dec {
+	return sdk.Dec(m)
+}
+
+func TestQueryClientConfig(t *testing.T) {
+	ctx, _, subspace := setupTestStore(t)
+	bypassMsgTypes := []string{"/ibc.core.channel.v1.MsgRecvPacket"}
+	localMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(5, 3)))
+	ctx = ctx.WithMinGasPrices(localMinGasPrices)
+
+	// every fee policy element is part of the config
+	subspace.SetParamSet(ctx, &types.Params{
+		MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("photon", sdk.OneInt()), sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 2))),
+		MinFlatFee:       sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)),
+		MsgGasFloors:     []types.MsgGasFloor{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend", MinGas: 100_000}},
+	})
+	s := NewNodeConfigServer(bypassMsgTypes, 1_000_000, subspace, mockUint64Param{policytypes.ParamStoreKeyMaxTxBytes, 65536}, mockBondDenomSource("stake"), mockMultiplier(sdk.NewDec(2)))
+	res, err := s.ClientConfig(sdk.WrapSDKContext(ctx), &types.QueryClientConfigRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, &types.QueryClientConfigResponse{
+		Version:                         ClientConfigVersion,
+		MinimumGasPrices:                sdk.NewDecCoins(sdk.NewDecCoin("photon", sdk.NewInt(2)), sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(2, 2))),
+		LocalMinimumGasPrices:           localMinGasPrices,
+		FeeDenoms:                       []string{"photon", "uatom"},
+		MinFlatFee:                      sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)),
+		BypassMinFeeMsgTypes:            bypassMsgTypes,
+		MaxTotalBypassMinFeeMsgGasUsage: 1_000_000,
+		MsgGasFloors:                    []types.MsgGasFloor{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend", MinGas: 100_000}},
+		MaxTxBytes:                      65536,
+	}, res)
+
+	// the bond denom is required at zero without global minimum gas prices
+	subspace.Set(ctx, types.ParamStoreKeyMinGasPrices, sdk.DecCoins{})
+	res, err = s.ClientConfig(sdk.WrapSDKContext(ctx), &types.QueryClientConfigRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.DecCoins{sdk.NewDecCoin("stake", sdk.ZeroInt())}, res.MinimumGasPrices)
+	assert.Equal(t, []string{"stake"}, res.FeeDenoms)
+}
+
+func TestQueryParams(t *testing.T) {
+	ctx, _, subspace := setupTestStore(t)
+	q := NewGrpcQuerier(subspace, nil, nil, nil, nil, nil)
+
+	res, err := q.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultParams(), res.Params)
+
+	subspace.Set(ctx, types.ParamStoreKeyMinFlatFee, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)))
+	res, err = q.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), res.Params.MinFlatFee)
+}
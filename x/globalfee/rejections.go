@@ -0,0 +1,163 @@
+package globalfee
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+const (
+	// DefaultFeeRejectionWindow is the number of blocks aggregated by the
+	// FeeRejectionStats query when no window is given.
+	DefaultFeeRejectionWindow = 100
+	// DefaultFeeRejectionRetention is the number of blocks the fee rejections
+	// are kept for by the FeeRejectionIndex.
+	DefaultFeeRejectionRetention = 10_000
+)
+
+// FeeShortfallBuckets are the upper bounds of the shortfall histogram buckets.
+// The shortfall of a rejected tx is the fraction of the required fee that was
+// not paid, so that 1 means that nothing usable was paid.
+var FeeShortfallBuckets = []sdk.Dec{
+	sdk.NewDecWithPrec(1, 1),
+	sdk.NewDecWithPrec(25, 2),
+	sdk.NewDecWithPrec(5, 1),
+	sdk.NewDecWithPrec(75, 2),
+	sdk.OneDec(),
+}
+
+// FeeRejectionRecorder records the txs rejected for insufficient fees.
+type FeeRejectionRecorder interface {
+	RecordFeeRejection(ctx sdk.Context, required, paid sdk.Coins)
+}
+
+var _ FeeRejectionRecorder = &FeeRejectionIndex{}
+
+// FeeRejectionIndex keeps in memory the number of txs rejected for
+// insufficient fees by this node in CheckTx, per block height and shortfall
+// bucket.
+type FeeRejectionIndex struct {
+	mtx       sync.RWMutex
+	retention int64
+	// heights maps a block height to its rejection counts per shortfall bucket
+	heights map[int64][]uint64
+}
+
+// NewFeeRejectionIndex returns a FeeRejectionIndex keeping the rejections of
+// the given number of most recent blocks.
+func NewFeeRejectionIndex(retention int64) *FeeRejectionIndex {
+	if retention <= 0 {
+		retention = DefaultFeeRejectionRetention
+	}
+
+	return &FeeRejectionIndex{
+		retention: retention,
+		heights:   make(map[int64][]uint64),
+	}
+}
+
+// Retention returns the number of blocks the rejections are kept for.
+func (idx *FeeRejectionIndex) Retention() int64 {
+	return idx.retention
+}
+
+// RecordFeeRejection records a tx rejected at the context block height for
+// paying less than the required fees.
+func (idx *FeeRejectionIndex) RecordFeeRejection(ctx sdk.Context, required, paid sdk.Coins) {
+	height := ctx.BlockHeight()
+	bucket := shortfallBucket(FeeShortfall(required, paid))
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	counts, ok := idx.heights[height]
+	if !ok {
+		counts = make([]uint64, len(FeeShortfallBuckets))
+		idx.heights[height] = counts
+		idx.prune(height)
+	}
+	counts[bucket]++
+}
+
+// Stats aggregates the rejections of the window blocks ending at toHeight.
+func (idx *FeeRejectionIndex) Stats(toHeight int64, window uint64) types.QueryFeeRejectionStatsResponse {
+	fromHeight := toHeight - int64(window) + 1
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+
+	histogram := make([]types.FeeShortfallBucket, len(FeeShortfallBuckets))
+	for i, maxShortfall := range FeeShortfallBuckets {
+		histogram[i].MaxShortfall = maxShortfall
+	}
+
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	var total uint64
+	for height, counts := range idx.heights {
+		if height < fromHeight || height > toHeight {
+			continue
+		}
+		for i, count := range counts {
+			histogram[i].Count += count
+			total += count
+		}
+	}
+
+	return types.QueryFeeRejectionStatsResponse{
+		FromHeight:         fromHeight,
+		ToHeight:           toHeight,
+		TotalRejections:    total,
+		ShortfallHistogram: histogram,
+	}
+}
+
+// prune drops the heights that fell out of the retention window.
+// It must be called with the lock held.
+func (idx *FeeRejectionIndex) prune(latestHeight int64) {
+	for height := range idx.heights {
+		if height <= latestHeight-idx.retention {
+			delete(idx.heights, height)
+		}
+	}
+}
+
+// FeeShortfall returns the fraction of the required fees that the paid fees
+// fall short of. As paying any of the required fee denoms is enough, the
+// denom coming the closest to its requirement is used. Zero is returned when
+// nothing is required.
+func FeeShortfall(required, paid sdk.Coins) sdk.Dec {
+	if required.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	bestRatio := sdk.ZeroDec()
+	for _, req := range required {
+		if !req.IsPositive() {
+			continue
+		}
+		ratio := paid.AmountOf(req.Denom).ToDec().Quo(req.Amount.ToDec())
+		if ratio.GT(bestRatio) {
+			bestRatio = ratio
+		}
+	}
+
+	if bestRatio.GTE(sdk.OneDec()) {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.OneDec().Sub(bestRatio)
+}
+
+func shortfallBucket(shortfall sdk.Dec) int {
+	for i, maxShortfall := range FeeShortfallBuckets {
+		if shortfall.LTE(maxShortfall) {
+			return i
+		}
+	}
+
+	return len(FeeShortfallBuckets) - 1
+}
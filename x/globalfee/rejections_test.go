@@ -0,0 +1,112 @@
+package globalfee
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+func TestFeeShortfall(t *testing.T) {
+	specs := map[string]struct {
+		required sdk.Coins
+		paid     sdk.Coins
+		exp      sdk.Dec
+	}{
+		"nothing required": {
+			paid: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)),
+			exp:  sdk.ZeroDec(),
+		},
+		"nothing paid": {
+			required: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+			exp:      sdk.OneDec(),
+		},
+		"partially paid": {
+			required: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+			paid:     sdk.NewCoins(sdk.NewInt64Coin("uatom", 80)),
+			exp:      sdk.NewDecWithPrec(2, 1),
+		},
+		"fully paid": {
+			required: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+			paid:     sdk.NewCoins(sdk.NewInt64Coin("uatom", 150)),
+			exp:      sdk.ZeroDec(),
+		},
+		"unknown denom paid": {
+			required: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+			paid:     sdk.NewCoins(sdk.NewInt64Coin("photon", 100)),
+			exp:      sdk.OneDec(),
+		},
+		"closest denom used": {
+			required: sdk.NewCoins(sdk.NewInt64Coin("photon", 10), sdk.NewInt64Coin("uatom", 100)),
+			paid:     sdk.NewCoins(sdk.NewInt64Coin("photon", 5), sdk.NewInt64Coin("uatom", 90)),
+			exp:      sdk.NewDecWithPrec(1, 1),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp.String(), FeeShortfall(spec.required, spec.paid).String())
+		})
+	}
+}
+
+func TestFeeRejectionIndex(t *testing.T) {
+	ctx, _, _ := setupTestStore(t)
+	required := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+
+	idx := NewFeeRejectionIndex(10)
+	idx.RecordFeeRejection(ctx.WithBlockHeight(5), required, sdk.NewCoins(sdk.NewInt64Coin("uatom", 95)))
+	idx.RecordFeeRejection(ctx.WithBlockHeight(8), required, sdk.NewCoins(sdk.NewInt64Coin("uatom", 60)))
+	idx.RecordFeeRejection(ctx.WithBlockHeight(10), required, sdk.Coins{})
+	idx.RecordFeeRejection(ctx.WithBlockHeight(10), required, sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)))
+
+	stats := idx.Stats(10, 10)
+	assert.Equal(t, int64(1), stats.FromHeight)
+	assert.Equal(t, int64(10), stats.ToHeight)
+	assert.Equal(t, uint64(4), stats.TotalRejections)
+	require.Len(t, stats.ShortfallHistogram, len(FeeShortfallBuckets))
+	counts := make([]uint64, len(stats.ShortfallHistogram))
+	for i, bucket := range stats.ShortfallHistogram {
+		assert.Equal(t, FeeShortfallBuckets[i], bucket.MaxShortfall)
+		counts[i] = bucket.Count
+	}
+	assert.Equal(t, []uint64{1, 0, 1, 1, 1}, counts)
+
+	// only the most recent blocks are aggregated
+	stats = idx.Stats(10, 3)
+	assert.Equal(t, int64(8), stats.FromHeight)
+	assert.Equal(t, uint64(3), stats.TotalRejections)
+
+	// heights out of the retention window are pruned
+	idx.RecordFeeRejection(ctx.WithBlockHeight(16), required, sdk.Coins{})
+	stats = idx.Stats(16, 16)
+	assert.Equal(t, uint64(4), stats.TotalRejections)
+}
+
+func TestQueryFeeRejectionStats(t *testing.T) {
+	ctx, _, subspace := setupTestStore(t)
+	required := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+
+	idx := NewFeeRejectionIndex(1000)
+	idx.RecordFeeRejection(ctx, required, sdk.Coins{})
+	idx.RecordFeeRejection(ctx.WithBlockHeight(ctx.BlockHeight()-DefaultFeeRejectionWindow), required, sdk.Coins{})
+
+	q := NewGrpcQuerier(subspace, idx, nil, nil, nil, nil)
+	gotResp, gotErr := q.FeeRejectionStats(sdk.WrapSDKContext(ctx), &types.QueryFeeRejectionStatsRequest{})
+	require.NoError(t, gotErr)
+	assert.Equal(t, ctx.BlockHeight(), gotResp.ToHeight)
+	assert.Equal(t, ctx.BlockHeight()-DefaultFeeRejectionWindow+1, gotResp.FromHeight)
+	assert.Equal(t, uint64(1), gotResp.TotalRejections)
+
+	gotResp, gotErr = q.FeeRejectionStats(sdk.WrapSDKContext(ctx), &types.QueryFeeRejectionStatsRequest{Window: DefaultFeeRejectionWindow + 1})
+	require.NoError(t, gotErr)
+	assert.Equal(t, uint64(2), gotResp.TotalRejections)
+
+	_, gotErr = q.FeeRejectionStats(sdk.WrapSDKContext(ctx), &types.QueryFeeRejectionStatsRequest{Window: 1001})
+	require.Error(t, gotErr)
+
+	_, gotErr = NewGrpcQuerier(subspace, nil, nil, nil, nil, nil).FeeRejectionStats(sdk.WrapSDKContext(ctx), &types.QueryFeeRejectionStatsRequest{})
+	require.Error(t, gotErr)
+}
@@ -0,0 +1,8 @@
+package types
+
+// globalfee module event types
+const (
+	// EventTypeParamsChanged is emitted at the end of a block in which the
+	// globalfee params were changed.
+	EventTypeParamsChanged = "globalfee_params_changed"
+)
@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -35,6 +37,29 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "globalfee params")
 	}
+	if data.DynamicFeeState != nil {
+		if err := data.DynamicFeeState.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "globalfee dynamic fee state")
+		}
+	}
+
+	return nil
+}
+
+// Validate requires the multiplier to be positive and the fullness of the
+// recent blocks to be within [0, 1].
+func (s DynamicFeeState) Validate() error {
+	if s.Multiplier.IsNil() || !s.Multiplier.IsPositive() {
+		return fmt.Errorf("dynamic fee multiplier must be positive, got %s", s.Multiplier)
+	}
+	for _, fullness := range s.RecentFullness {
+		if fullness.IsNil() || fullness.IsNegative() || fullness.GT(sdk.OneDec()) {
+			return fmt.Errorf("block fullness must be within [0, 1], got %s", fullness)
+		}
+	}
+	if s.Height < 0 {
+		return fmt.Errorf("negative height %d", s.Height)
+	}
 
 	return nil
 }
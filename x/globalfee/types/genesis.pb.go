@@ -29,6 +29,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Params of this module
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// DynamicFeeState is the state of the dynamic multiplier of the minimum gas
+	// prices, the multiplier is one when unset.
+	DynamicFeeState *DynamicFeeState `protobuf:"bytes,2,opt,name=dynamic_fee_state,json=dynamicFeeState,proto3" json:"dynamic_fee_state,omitempty" yaml:"dynamic_fee_state"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +74,66 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetDynamicFeeState() *DynamicFeeState {
+	if m != nil {
+		return m.DynamicFeeState
+	}
+	return nil
+}
+
+// DynamicFeeState is the state of the dynamic multiplier of the minimum gas
+// prices, adjusted at the end of each block with the fullness of the recent
+// blocks.
+type DynamicFeeState struct {
+	// multiplier is the dynamic multiplier of the minimum gas prices.
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+	// recent_fullness is the fullness of the most recent blocks, oldest first,
+	// i.e. their gas used over the max gas of a block.
+	RecentFullness []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,rep,name=recent_fullness,json=recentFullness,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"recent_fullness" yaml:"recent_fullness"`
+	// height is the height of the block the multiplier was last adjusted at.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DynamicFeeState) Reset()         { *m = DynamicFeeState{} }
+func (m *DynamicFeeState) String() string { return proto.CompactTextString(m) }
+func (*DynamicFeeState) ProtoMessage()    {}
+func (*DynamicFeeState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_015b3e8b7a7c65c5, []int{1}
+}
+func (m *DynamicFeeState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicFeeState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicFeeState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicFeeState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicFeeState.Merge(m, src)
+}
+func (m *DynamicFeeState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicFeeState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicFeeState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicFeeState proto.InternalMessageInfo
+
+func (m *DynamicFeeState) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	// Minimum stores the minimum gas price(s) for all TX on the chain.
@@ -79,13 +142,40 @@ type Params struct {
 	// values allowed. For more information see
 	// https://docs.cosmos.network/main/modules/auth#concepts
 	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices,omitempty" yaml:"minimum_gas_prices"`
+	// MinFlatFee stores the minimum fee(s) that any TX on the chain must pay
+	// regardless of its gas limit. The stricter of this floor and the fee
+	// derived from the minimum gas prices is required for each denom. Denoms
+	// absent from the minimum gas prices are ignored.
+	MinFlatFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=min_flat_fee,json=minFlatFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_flat_fee,omitempty" yaml:"min_flat_fee"`
+	// MsgGasFloors sets the minimum gas limit a TX must declare for each of
+	// its messages of the given types. TXs declaring less gas than the sum of
+	// the floors of their messages are rejected. No duplicate message types
+	// are allowed.
+	MsgGasFloors []MsgGasFloor `protobuf:"bytes,4,rep,name=msg_gas_floors,json=msgGasFloors,proto3" json:"msg_gas_floors,omitempty" yaml:"msg_gas_floors"`
+	// DynamicFeeSensitivity is the maximum change rate per block of the dynamic
+	// multiplier of the minimum gas prices, reached when the recent blocks are
+	// full or empty. The multiplier rises when the recent blocks are more than
+	// half full and decreases otherwise. Zero disables the dynamic minimum.
+	DynamicFeeSensitivity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=dynamic_fee_sensitivity,json=dynamicFeeSensitivity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dynamic_fee_sensitivity,omitempty" yaml:"dynamic_fee_sensitivity"`
+	// DynamicFeeFloor is the lowest value of the dynamic multiplier of the
+	// minimum gas prices. Zero sets a floor of one, so that the dynamic minimum
+	// does not decrease below the minimum gas prices.
+	DynamicFeeFloor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=dynamic_fee_floor,json=dynamicFeeFloor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dynamic_fee_floor,omitempty" yaml:"dynamic_fee_floor"`
+	// DynamicFeeCeiling is the highest value of the dynamic multiplier of the
+	// minimum gas prices. Zero sets no ceiling.
+	DynamicFeeCeiling github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=dynamic_fee_ceiling,json=dynamicFeeCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dynamic_fee_ceiling,omitempty" yaml:"dynamic_fee_ceiling"`
+	// AllowedFeeSponsors are the addresses, e.g. paymaster contracts, allowed to
+	// pay the fees of the transactions they don't sign a message of, either as
+	// the fee payer or as the fee granter. The other sponsored transactions are
+	// rejected. No duplicate addresses are allowed. Empty disables the check.
+	AllowedFeeSponsors []string `protobuf:"bytes,16,rep,name=allowed_fee_sponsors,json=allowedFeeSponsors,proto3" json:"allowed_fee_sponsors,omitempty" yaml:"allowed_fee_sponsors"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_015b3e8b7a7c65c5, []int{1}
+	return fileDescriptor_015b3e8b7a7c65c5, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -121,9 +211,88 @@ func (m *Params) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoi
 	return nil
 }
 
+func (m *Params) GetMinFlatFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFlatFee
+	}
+	return nil
+}
+
+func (m *Params) GetMsgGasFloors() []MsgGasFloor {
+	if m != nil {
+		return m.MsgGasFloors
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedFeeSponsors() []string {
+	if m != nil {
+		return m.AllowedFeeSponsors
+	}
+	return nil
+}
+
+// MsgGasFloor is the minimum gas limit a TX must declare for a message of
+// the given type.
+type MsgGasFloor struct {
+	// msg_type_url is the type URL of the message, e.g.
+	// /cosmos.bank.v1beta1.MsgMultiSend.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	MinGas     uint64 `protobuf:"varint,2,opt,name=min_gas,json=minGas,proto3" json:"min_gas,omitempty" yaml:"min_gas"`
+}
+
+func (m *MsgGasFloor) Reset()         { *m = MsgGasFloor{} }
+func (m *MsgGasFloor) String() string { return proto.CompactTextString(m) }
+func (*MsgGasFloor) ProtoMessage()    {}
+func (*MsgGasFloor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_015b3e8b7a7c65c5, []int{3}
+}
+func (m *MsgGasFloor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasFloor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasFloor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasFloor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasFloor.Merge(m, src)
+}
+func (m *MsgGasFloor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasFloor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasFloor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasFloor proto.InternalMessageInfo
+
+func (m *MsgGasFloor) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgGasFloor) GetMinGas() uint64 {
+	if m != nil {
+		return m.MinGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "gaia.globalfee.v1beta1.GenesisState")
+	proto.RegisterType((*DynamicFeeState)(nil), "gaia.globalfee.v1beta1.DynamicFeeState")
 	proto.RegisterType((*Params)(nil), "gaia.globalfee.v1beta1.Params")
+	proto.RegisterType((*MsgGasFloor)(nil), "gaia.globalfee.v1beta1.MsgGasFloor")
 }
 
 func init() {
@@ -131,28 +300,62 @@ func init() {
 }
 
 var fileDescriptor_015b3e8b7a7c65c5 = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4b, 0x03, 0x31,
-	0x14, 0xc7, 0x2f, 0x08, 0x1d, 0xae, 0x0e, 0xe5, 0x10, 0xa9, 0xa5, 0xe4, 0xe4, 0x70, 0x28, 0xa8,
-	0x09, 0xad, 0x9b, 0xe3, 0x29, 0x74, 0x2d, 0x75, 0x73, 0xa9, 0xb9, 0x33, 0xc6, 0x60, 0x73, 0x09,
-	0x4d, 0x2a, 0xf6, 0x5b, 0xf8, 0x39, 0xfc, 0x0c, 0xee, 0x76, 0xec, 0xe8, 0x54, 0xe5, 0x6e, 0x73,
-	0xf4, 0x13, 0xc8, 0x25, 0x67, 0x2b, 0x9c, 0x53, 0x02, 0xef, 0xf7, 0x7e, 0xff, 0xc7, 0x7b, 0xfe,
-	0x11, 0x23, 0x9c, 0x60, 0x36, 0x95, 0x09, 0x99, 0xde, 0x51, 0x8a, 0x1f, 0xfb, 0x09, 0x35, 0xa4,
-	0x8f, 0x19, 0xcd, 0xa8, 0xe6, 0x1a, 0xa9, 0x99, 0x34, 0x32, 0xd8, 0x2f, 0x29, 0xb4, 0xa1, 0x50,
-	0x45, 0x75, 0xf6, 0x98, 0x64, 0xd2, 0x22, 0xb8, 0xfc, 0x39, 0xba, 0x03, 0x53, 0xa9, 0x85, 0xd4,
-	0x38, 0x21, 0x7a, 0x2b, 0x4c, 0x25, 0xcf, 0x5c, 0x3d, 0xba, 0xf1, 0x77, 0x87, 0x4e, 0x7f, 0x65,
-	0x88, 0xa1, 0xc1, 0xc8, 0x6f, 0x28, 0x32, 0x23, 0x42, 0xb7, 0xc1, 0x21, 0xe8, 0x35, 0x07, 0x10,
-	0xfd, 0x1f, 0x87, 0x46, 0x96, 0x8a, 0xdb, 0xcb, 0x75, 0xe8, 0x7d, 0xad, 0xc3, 0x96, 0xeb, 0x3a,
-	0x91, 0x82, 0x1b, 0x2a, 0x94, 0x59, 0x8c, 0x2b, 0x4f, 0xf4, 0x06, 0xfc, 0x86, 0x83, 0x83, 0x57,
-	0xe0, 0x07, 0x82, 0x67, 0x5c, 0xcc, 0xc5, 0x84, 0x11, 0x3d, 0x51, 0x33, 0x9e, 0xd2, 0x32, 0x69,
-	0xa7, 0xd7, 0x1c, 0x74, 0x91, 0x1b, 0x15, 0x95, 0xa3, 0x6e, 0x62, 0x2e, 0x69, 0x7a, 0x21, 0x79,
-	0x16, 0xab, 0x2a, 0xa7, 0x5b, 0xef, 0xdf, 0x66, 0x7e, 0xaf, 0xc3, 0x83, 0x05, 0x11, 0xd3, 0xf3,
-	0xa8, 0x4e, 0x45, 0x2f, 0x1f, 0xe1, 0x31, 0xe3, 0xe6, 0x7e, 0x9e, 0xa0, 0x54, 0x0a, 0x5c, 0xed,
-	0xc5, 0x3d, 0xa7, 0xfa, 0xf6, 0x01, 0x9b, 0x85, 0xa2, 0xfa, 0x37, 0x50, 0x8f, 0x5b, 0x95, 0x63,
-	0x48, 0xf4, 0xc8, 0x1a, 0xe2, 0x78, 0x99, 0x43, 0xb0, 0xca, 0x21, 0xf8, 0xcc, 0x21, 0x78, 0x2e,
-	0xa0, 0xb7, 0x2a, 0xa0, 0xf7, 0x5e, 0x40, 0xef, 0xba, 0x57, 0x17, 0xdb, 0x5b, 0x3e, 0xfd, 0xb9,
-	0xa6, 0xd5, 0x27, 0x0d, 0xbb, 0xf6, 0xb3, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x6d, 0x01,
-	0xcd, 0xec, 0x01, 0x00, 0x00,
+	// 867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x6e, 0xe3, 0x44,
+	0x1c, 0xc7, 0xeb, 0xcd, 0x90, 0x4e, 0x27, 0xd9, 0x64, 0x3a, 0x6d, 0x53, 0x6f, 0xb7, 0xd8, 0xc5,
+	0x20, 0x88, 0xb4, 0xe0, 0x68, 0xcb, 0x69, 0xb9, 0xe1, 0x5d, 0xa5, 0x68, 0x24, 0x50, 0x65, 0xe0,
+	0xc2, 0x25, 0x9a, 0xb8, 0x13, 0x77, 0x84, 0xc7, 0xf6, 0x66, 0x9c, 0x85, 0xdc, 0x79, 0x00, 0x2e,
+	0x1c, 0xe1, 0x01, 0x10, 0xbc, 0x01, 0x0f, 0xb0, 0xc7, 0x95, 0xb8, 0x20, 0x0e, 0x06, 0xb5, 0xb7,
+	0x8a, 0x53, 0x9f, 0x00, 0x79, 0x6c, 0x35, 0xf1, 0x26, 0x95, 0xb6, 0xa7, 0xc4, 0x33, 0xdf, 0xf9,
+	0xce, 0x67, 0xe6, 0xf7, 0x67, 0xd0, 0x7b, 0x21, 0x13, 0x6c, 0x10, 0x46, 0xc9, 0x98, 0x45, 0x13,
+	0xce, 0x07, 0x2f, 0x1e, 0x8f, 0x79, 0xc6, 0x1e, 0x0f, 0x42, 0x1e, 0x73, 0x25, 0x94, 0x9b, 0x4e,
+	0x93, 0x2c, 0x21, 0xbd, 0x42, 0xe5, 0xde, 0xa8, 0xdc, 0x4a, 0x75, 0xb0, 0x1b, 0x26, 0x61, 0xa2,
+	0x25, 0x83, 0xe2, 0x5f, 0xa9, 0x3e, 0xb0, 0x82, 0x44, 0xc9, 0x44, 0x0d, 0xc6, 0x4c, 0x2d, 0x0c,
+	0x83, 0x44, 0xc4, 0xe5, 0xbc, 0xf3, 0xa7, 0x81, 0xda, 0x27, 0xa5, 0xff, 0x97, 0x19, 0xcb, 0x38,
+	0x39, 0x45, 0xcd, 0x94, 0x4d, 0x99, 0x54, 0xa6, 0x71, 0x64, 0xf4, 0x5b, 0xc7, 0x96, 0xbb, 0x7e,
+	0x3f, 0xf7, 0x54, 0xab, 0x3c, 0xf3, 0x65, 0x6e, 0x6f, 0x5c, 0xe5, 0x36, 0x2e, 0x57, 0x7d, 0x98,
+	0x48, 0x91, 0x71, 0x99, 0x66, 0x73, 0xbf, 0xf2, 0x21, 0xcf, 0xd1, 0xf6, 0xd9, 0x3c, 0x66, 0x52,
+	0x04, 0xa3, 0x09, 0xe7, 0x23, 0x55, 0x6c, 0x63, 0xde, 0xd3, 0xe6, 0x1f, 0xdc, 0x66, 0xfe, 0xac,
+	0x5c, 0x30, 0xe4, 0x5c, 0x53, 0x79, 0x87, 0xd7, 0xb9, 0x6d, 0xce, 0x99, 0x8c, 0x3e, 0x71, 0x56,
+	0xbc, 0x1c, 0xbf, 0x7b, 0x56, 0x97, 0x3b, 0xff, 0x19, 0xa8, 0xfb, 0x9a, 0x05, 0xf9, 0x02, 0x21,
+	0x39, 0x8b, 0x32, 0x91, 0x46, 0x82, 0x4f, 0xf5, 0xe1, 0xb6, 0x3c, 0xb7, 0x80, 0xff, 0x3b, 0xb7,
+	0xdf, 0x0f, 0x45, 0x76, 0x3e, 0x1b, 0xbb, 0x41, 0x22, 0x07, 0xd5, 0x85, 0x95, 0x3f, 0x1f, 0xa9,
+	0xb3, 0x6f, 0x07, 0xd9, 0x3c, 0xe5, 0xca, 0x7d, 0xc6, 0x03, 0x7f, 0xc9, 0x81, 0x3c, 0x47, 0xdd,
+	0x29, 0x0f, 0x78, 0x9c, 0x8d, 0x26, 0xb3, 0x28, 0x8a, 0xb9, 0x52, 0xe6, 0xbd, 0xa3, 0x46, 0x7f,
+	0xcb, 0xfb, 0xec, 0x6e, 0xa6, 0xd7, 0xb9, 0xdd, 0x2b, 0x4f, 0xf6, 0x9a, 0x9d, 0xe3, 0x77, 0xca,
+	0x91, 0x61, 0x35, 0x40, 0x7a, 0xa8, 0x79, 0xce, 0x45, 0x78, 0x9e, 0x99, 0x8d, 0x23, 0xa3, 0xdf,
+	0xf0, 0xab, 0x2f, 0xe7, 0xb7, 0x2d, 0xd4, 0x2c, 0xc3, 0x41, 0xfe, 0x30, 0x10, 0x91, 0x22, 0x16,
+	0x72, 0x26, 0x47, 0x21, 0x53, 0xa3, 0x74, 0x2a, 0x02, 0x5e, 0xc4, 0xb2, 0xd1, 0x6f, 0x1d, 0x1f,
+	0xba, 0x25, 0x80, 0x5b, 0x64, 0xc3, 0xe2, 0xae, 0x79, 0xf0, 0x34, 0x11, 0xb1, 0x97, 0x56, 0x91,
+	0x3c, 0x5c, 0x5d, 0xbf, 0x88, 0xea, 0x75, 0x6e, 0x3f, 0x28, 0x69, 0x57, 0x55, 0xce, 0xaf, 0xff,
+	0xd8, 0x8f, 0xde, 0xec, 0xd0, 0xc5, 0x86, 0xca, 0xc7, 0x95, 0xc7, 0x09, 0x53, 0xa7, 0xda, 0x81,
+	0xfc, 0x62, 0xa0, 0xb6, 0x14, 0xf1, 0x68, 0x12, 0xb1, 0xac, 0x88, 0xb0, 0xbe, 0xd2, 0xd6, 0xf1,
+	0x83, 0xb5, 0xe0, 0x9a, 0x9a, 0x55, 0xd4, 0xbd, 0xe5, 0x65, 0x35, 0xde, 0x9d, 0x1b, 0xde, 0x9b,
+	0xf9, 0x82, 0xb4, 0xff, 0x06, 0xa4, 0x25, 0x26, 0x92, 0x22, 0x1e, 0x46, 0x2c, 0x1b, 0x72, 0x4e,
+	0x7e, 0x30, 0x50, 0x47, 0xaa, 0x50, 0x9f, 0x7a, 0x12, 0x25, 0xc9, 0x54, 0x99, 0x40, 0x23, 0xbe,
+	0x7b, 0x5b, 0x2a, 0x7f, 0xae, 0xc2, 0x13, 0xa6, 0x86, 0x85, 0xd6, 0x7b, 0x52, 0xc1, 0x9a, 0x75,
+	0x8b, 0x1a, 0xee, 0x5e, 0x85, 0x5b, 0x53, 0x38, 0x7e, 0x5b, 0x2e, 0x7c, 0x14, 0xf9, 0xdd, 0x40,
+	0xfb, 0xb5, 0x42, 0xe0, 0xb1, 0x12, 0x99, 0x78, 0x21, 0xb2, 0xb9, 0x09, 0x75, 0x6a, 0xcf, 0xee,
+	0x96, 0x85, 0x57, 0xb9, 0xfd, 0xce, 0x2d, 0x86, 0x35, 0x3a, 0x6b, 0x4d, 0x11, 0x2e, 0xa4, 0x8e,
+	0xbf, 0xb7, 0x54, 0x8a, 0x8b, 0x71, 0xf2, 0x93, 0x51, 0x6f, 0x02, 0xfa, 0x54, 0xe6, 0x96, 0x26,
+	0x15, 0x77, 0x26, 0x7d, 0xb8, 0x62, 0x55, 0x63, 0x5c, 0xd3, 0x28, 0xb4, 0xa8, 0xd6, 0x28, 0xf4,
+	0x45, 0x92, 0x9f, 0x0d, 0xb4, 0xb3, 0xac, 0x0b, 0xb8, 0x88, 0x44, 0x1c, 0x9a, 0x48, 0x93, 0xc9,
+	0x3b, 0x93, 0xbd, 0xbd, 0xc6, 0xac, 0xc6, 0x76, 0xb0, 0xca, 0x56, 0xc9, 0x1c, 0x7f, 0x7b, 0x41,
+	0xf7, 0xb4, 0x1c, 0x23, 0x0a, 0xed, 0xb2, 0x28, 0x4a, 0xbe, 0xe3, 0x67, 0xe5, 0x55, 0xa7, 0x49,
+	0xac, 0x8a, 0x9c, 0xc3, 0xba, 0xd3, 0x7c, 0x7a, 0x95, 0xdb, 0xd6, 0xba, 0xf9, 0xda, 0x96, 0x0f,
+	0xcb, 0x2d, 0xd7, 0xe9, 0x1c, 0x9f, 0x54, 0xc3, 0x45, 0xbc, 0xaa, 0x41, 0x0a, 0x60, 0x03, 0x03,
+	0x0a, 0xe0, 0x5b, 0xb8, 0x49, 0x01, 0x6c, 0xe2, 0x4d, 0x0a, 0xe0, 0x26, 0x86, 0x14, 0xc0, 0x16,
+	0x6e, 0x53, 0x00, 0xdb, 0xf8, 0x3e, 0x05, 0xf0, 0x3e, 0xee, 0x50, 0x00, 0x3b, 0xb8, 0x4b, 0x01,
+	0xec, 0x62, 0x4c, 0x01, 0xdc, 0xc6, 0x84, 0x02, 0x48, 0xf0, 0x0e, 0x05, 0x70, 0x07, 0xef, 0x52,
+	0x00, 0x77, 0xf1, 0x1e, 0x05, 0x70, 0x0f, 0xf7, 0x28, 0x80, 0x3d, 0xbc, 0x4f, 0x01, 0xdc, 0xc7,
+	0xa6, 0x33, 0x43, 0xad, 0xa5, 0xa2, 0x20, 0x4f, 0x50, 0x91, 0xdb, 0xa3, 0xe2, 0x1a, 0x47, 0xb3,
+	0x69, 0x54, 0xb5, 0xe6, 0xfd, 0xa5, 0xca, 0x5d, 0x9a, 0x75, 0x7c, 0x24, 0x55, 0xf8, 0xd5, 0x3c,
+	0xe5, 0x5f, 0x4f, 0x23, 0xf2, 0x08, 0x6d, 0x16, 0x65, 0x1d, 0x32, 0xa5, 0x1f, 0x14, 0xe0, 0x91,
+	0xeb, 0xdc, 0xee, 0x2c, 0xea, 0x3d, 0x64, 0xca, 0xf1, 0x9b, 0x52, 0xc4, 0x27, 0x4c, 0x79, 0xde,
+	0xcb, 0x0b, 0xcb, 0x78, 0x75, 0x61, 0x19, 0xff, 0x5e, 0x58, 0xc6, 0x8f, 0x97, 0xd6, 0xc6, 0xab,
+	0x4b, 0x6b, 0xe3, 0xaf, 0x4b, 0x6b, 0xe3, 0x9b, 0x35, 0xad, 0x40, 0x3f, 0xc5, 0xdf, 0x2f, 0x3d,
+	0xc6, 0x3a, 0xca, 0xe3, 0xa6, 0x7e, 0x35, 0x3f, 0xfe, 0x7f, 0x00, 0x87, 0x77, 0x43, 0xf7, 0xab,
+	0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -175,6 +378,18 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DynamicFeeState != nil {
+		{
+			size, err := m.DynamicFeeState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -188,6 +403,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DynamicFeeState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicFeeState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicFeeState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecentFullness) > 0 {
+		for iNdEx := len(m.RecentFullness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RecentFullness[iNdEx].Size()
+				i -= size
+				if _, err := m.RecentFullness[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -208,6 +475,75 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedFeeSponsors) > 0 {
+		for iNdEx := len(m.AllowedFeeSponsors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedFeeSponsors[iNdEx])
+			copy(dAtA[i:], m.AllowedFeeSponsors[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedFeeSponsors[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size := m.DynamicFeeCeiling.Size()
+		i -= size
+		if _, err := m.DynamicFeeCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.DynamicFeeFloor.Size()
+		i -= size
+		if _, err := m.DynamicFeeFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.DynamicFeeSensitivity.Size()
+		i -= size
+		if _, err := m.DynamicFeeSensitivity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.MsgGasFloors) > 0 {
+		for iNdEx := len(m.MsgGasFloors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGasFloors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MinFlatFee) > 0 {
+		for iNdEx := len(m.MinFlatFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFlatFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.MinimumGasPrices) > 0 {
 		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -225,6 +561,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgGasFloor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasFloor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasFloor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -244,6 +615,30 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.DynamicFeeState != nil {
+		l = m.DynamicFeeState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DynamicFeeState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Multiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RecentFullness) > 0 {
+		for _, e := range m.RecentFullness {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
 	return n
 }
 
@@ -259,6 +654,46 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MinFlatFee) > 0 {
+		for _, e := range m.MinFlatFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MsgGasFloors) > 0 {
+		for _, e := range m.MsgGasFloors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.DynamicFeeSensitivity.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DynamicFeeFloor.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DynamicFeeCeiling.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AllowedFeeSponsors) > 0 {
+		for _, s := range m.AllowedFeeSponsors {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGasFloor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MinGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MinGas))
+	}
 	return n
 }
 
@@ -330,6 +765,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicFeeState == nil {
+				m.DynamicFeeState = &DynamicFeeState{}
+			}
+			if err := m.DynamicFeeState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -351,7 +822,146 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DynamicFeeState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicFeeState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicFeeState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentFullness", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RecentFullness = append(m.RecentFullness, v)
+			if err := m.RecentFullness[len(m.RecentFullness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -414,6 +1024,309 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFlatFee = append(m.MinFlatFee, types.Coin{})
+			if err := m.MinFlatFee[len(m.MinFlatFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasFloors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGasFloors = append(m.MsgGasFloors, MsgGasFloor{})
+			if err := m.MsgGasFloors[len(m.MsgGasFloors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeSensitivity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicFeeSensitivity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicFeeFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicFeeCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedFeeSponsors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedFeeSponsors = append(m.AllowedFeeSponsors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGasFloor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGasFloor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGasFloor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGas", wireType)
+			}
+			m.MinGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,5 +4,12 @@ const (
 	// ModuleName is the name of the this module
 	ModuleName = "globalfee"
 
+	// StoreKey is the default store key for the module
+	StoreKey = ModuleName
+
 	QuerierRoute = ModuleName
 )
+
+// DynamicFeeStateKey is the key of the state of the dynamic multiplier of the
+// minimum gas prices
+var DynamicFeeStateKey = []byte{0x01}
@@ -2,18 +2,41 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-// ParamStoreKeyMinGasPrices store key
-var ParamStoreKeyMinGasPrices = []byte("MinimumGasPricesParam")
+var (
+	// ParamStoreKeyMinGasPrices store key
+	ParamStoreKeyMinGasPrices = []byte("MinimumGasPricesParam")
+	// ParamStoreKeyMinFlatFee store key
+	ParamStoreKeyMinFlatFee = []byte("MinFlatFee")
+	// ParamStoreKeyMsgGasFloors store key
+	ParamStoreKeyMsgGasFloors = []byte("MsgGasFloors")
+	// ParamStoreKeyDynamicFeeSensitivity store key
+	ParamStoreKeyDynamicFeeSensitivity = []byte("DynamicFeeSensitivity")
+	// ParamStoreKeyDynamicFeeFloor store key
+	ParamStoreKeyDynamicFeeFloor = []byte("DynamicFeeFloor")
+	// ParamStoreKeyDynamicFeeCeiling store key
+	ParamStoreKeyDynamicFeeCeiling = []byte("DynamicFeeCeiling")
+	// ParamStoreKeyAllowedFeeSponsors store key
+	ParamStoreKeyAllowedFeeSponsors = []byte("AllowedFeeSponsors")
+)
 
 // DefaultParams returns default parameters
 func DefaultParams() Params {
-	return Params{MinimumGasPrices: sdk.DecCoins{}}
+	return Params{
+		MinimumGasPrices:      sdk.DecCoins{},
+		MinFlatFee:            sdk.Coins{},
+		MsgGasFloors:          []MsgGasFloor{},
+		DynamicFeeSensitivity: sdk.ZeroDec(),
+		DynamicFeeFloor:       sdk.ZeroDec(),
+		DynamicFeeCeiling:     sdk.ZeroDec(),
+		AllowedFeeSponsors:    []string{},
+	}
 }
 
 func ParamKeyTable() paramtypes.KeyTable {
@@ -22,7 +45,39 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // ValidateBasic performs basic validation.
 func (p Params) ValidateBasic() error {
-	return validateMinimumGasPrices(p.MinimumGasPrices)
+	if err := validateMinimumGasPrices(p.MinimumGasPrices); err != nil {
+		return err
+	}
+
+	if err := validateMinFlatFee(p.MinFlatFee); err != nil {
+		return err
+	}
+
+	if err := validateMsgGasFloors(p.MsgGasFloors); err != nil {
+		return err
+	}
+
+	if err := validateDynamicFeeSensitivity(p.DynamicFeeSensitivity); err != nil {
+		return err
+	}
+
+	if err := validateDynamicFeeBound(p.DynamicFeeFloor); err != nil {
+		return err
+	}
+
+	if err := validateDynamicFeeBound(p.DynamicFeeCeiling); err != nil {
+		return err
+	}
+
+	if floor, ceiling, hasCeiling := p.DynamicFeeBounds(); hasCeiling && floor.GT(ceiling) {
+		return fmt.Errorf("dynamic fee floor %s is greater than the ceiling %s", floor, ceiling)
+	}
+
+	if err := validateAllowedFeeSponsors(p.AllowedFeeSponsors); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // ParamSetPairs returns the parameter set pairs.
@@ -31,6 +86,24 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(
 			ParamStoreKeyMinGasPrices, &p.MinimumGasPrices, validateMinimumGasPrices,
 		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMinFlatFee, &p.MinFlatFee, validateMinFlatFee,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMsgGasFloors, &p.MsgGasFloors, validateMsgGasFloors,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyDynamicFeeSensitivity, &p.DynamicFeeSensitivity, validateDynamicFeeSensitivity,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyDynamicFeeFloor, &p.DynamicFeeFloor, validateDynamicFeeBound,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyDynamicFeeCeiling, &p.DynamicFeeCeiling, validateDynamicFeeBound,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyAllowedFeeSponsors, &p.AllowedFeeSponsors, validateAllowedFeeSponsors,
+		),
 	}
 }
 
@@ -45,6 +118,108 @@ func validateMinimumGasPrices(i interface{}) error {
 	return dec.Validate()
 }
 
+// this requires the flat fee to be valid, sorted and non-zero
+// this requires the coins to be valid. The denoms missing from the minimum
+// gas prices are not rejected, as the two params can be changed separately,
+// but their flat fee is not enforced.
+func validateMinFlatFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Coins", i)
+	}
+
+	return v.Validate()
+}
+
+// this requires the msg type URLs to be unique and the floors to be positive
+func validateMsgGasFloors(i interface{}) error {
+	v, ok := i.([]MsgGasFloor)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected []MsgGasFloor", i)
+	}
+
+	seenTypes := make(map[string]bool)
+	for _, floor := range v {
+		if !strings.HasPrefix(floor.MsgTypeUrl, "/") {
+			return fmt.Errorf("invalid msg type URL %q", floor.MsgTypeUrl)
+		}
+		if seenTypes[floor.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg type URL %s", floor.MsgTypeUrl)
+		}
+		if floor.MinGas == 0 {
+			return fmt.Errorf("zero gas floor for %s", floor.MsgTypeUrl)
+		}
+		seenTypes[floor.MsgTypeUrl] = true
+	}
+
+	return nil
+}
+
+// DynamicFeeBounds returns the floor and the ceiling of the dynamic
+// multiplier of the minimum gas prices. The floor is one when unset, so that
+// the dynamic minimum does not decrease below the minimum gas prices, and
+// hasCeiling is false when the ceiling is unset.
+func (p Params) DynamicFeeBounds() (floor, ceiling sdk.Dec, hasCeiling bool) {
+	floor = sdk.OneDec()
+	if !p.DynamicFeeFloor.IsNil() && p.DynamicFeeFloor.IsPositive() {
+		floor = p.DynamicFeeFloor
+	}
+	if p.DynamicFeeCeiling.IsNil() || p.DynamicFeeCeiling.IsZero() {
+		return floor, sdk.Dec{}, false
+	}
+	return floor, p.DynamicFeeCeiling, true
+}
+
+// this requires the sensitivity to be within [0, 1), unset being zero
+func validateDynamicFeeSensitivity(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Dec", i)
+	}
+
+	if !v.IsNil() && (v.IsNegative() || v.GTE(sdk.OneDec())) {
+		return fmt.Errorf("dynamic fee sensitivity must be within [0, 1), got %s", v)
+	}
+
+	return nil
+}
+
+// this requires the bound of the multiplier to be non-negative, unset being
+// zero, the floor and ceiling order is checked by ValidateBasic
+func validateDynamicFeeBound(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Dec", i)
+	}
+
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("dynamic fee bound must not be negative, got %s", v)
+	}
+
+	return nil
+}
+
+// this requires the addresses to be valid and unique
+func validateAllowedFeeSponsors(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected []string", i)
+	}
+
+	seenAddrs := make(map[string]bool)
+	for _, addr := range v {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid fee sponsor address %q: %w", addr, err)
+		}
+		if seenAddrs[addr] {
+			return fmt.Errorf("duplicate fee sponsor address %s", addr)
+		}
+		seenAddrs[addr] = true
+	}
+
+	return nil
+}
+
 type DecCoins sdk.DecCoins
 
 // Validate checks that the DecCoins are sorted, have nonnegtive amount, with a valid and unique
@@ -71,3 +71,227 @@ func Test_validateParams(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateMinFlatFee(t *testing.T) {
+	tests := map[string]struct {
+		coins     interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().MinFlatFee,
+			false,
+		},
+		"Coins conversion fails, fail": {
+			sdk.DecCoins{sdk.NewDecCoin("photon", sdk.OneInt())},
+			true,
+		},
+		"sorted coins, pass": {
+			sdk.Coins{
+				sdk.NewCoin("atom", sdk.OneInt()),
+				sdk.NewCoin("photon", sdk.OneInt()),
+			},
+			false,
+		},
+		"zero amount, fail": {
+			sdk.Coins{sdk.NewCoin("photon", sdk.ZeroInt())},
+			true,
+		},
+		"coins are not sorted by denom alphabetically, fail": {
+			sdk.Coins{
+				sdk.NewCoin("photon", sdk.OneInt()),
+				sdk.NewCoin("atom", sdk.OneInt()),
+			},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateMinFlatFee(test.coins)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateMsgGasFloors(t *testing.T) {
+	tests := map[string]struct {
+		floors    interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().MsgGasFloors,
+			false,
+		},
+		"type conversion fails, fail": {
+			map[string]uint64{"/cosmos.bank.v1beta1.MsgSend": 1},
+			true,
+		},
+		"distinct msg types, pass": {
+			[]MsgGasFloor{
+				{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend", MinGas: 100_000},
+				{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", MinGas: 50_000},
+			},
+			false,
+		},
+		"duplicate msg types, fail": {
+			[]MsgGasFloor{
+				{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", MinGas: 100_000},
+				{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", MinGas: 50_000},
+			},
+			true,
+		},
+		"invalid msg type URL, fail": {
+			[]MsgGasFloor{{MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend", MinGas: 100_000}},
+			true,
+		},
+		"zero floor, fail": {
+			[]MsgGasFloor{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"}},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateMsgGasFloors(test.floors)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateDynamicFeeSensitivity(t *testing.T) {
+	tests := map[string]struct {
+		sensitivity interface{}
+		expectErr   bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().DynamicFeeSensitivity,
+			false,
+		},
+		"unset, pass": {
+			sdk.Dec{},
+			false,
+		},
+		"type conversion fails, fail": {
+			"0.125",
+			true,
+		},
+		"within range, pass": {
+			sdk.NewDecWithPrec(125, 3),
+			false,
+		},
+		"one, fail": {
+			sdk.OneDec(),
+			true,
+		},
+		"negative, fail": {
+			sdk.NewDecWithPrec(-1, 1),
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateDynamicFeeSensitivity(test.sensitivity)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateDynamicFeeBounds(t *testing.T) {
+	tests := map[string]struct {
+		floor     sdk.Dec
+		ceiling   sdk.Dec
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().DynamicFeeFloor,
+			DefaultParams().DynamicFeeCeiling,
+			false,
+		},
+		"floor below ceiling, pass": {
+			sdk.NewDecWithPrec(5, 1),
+			sdk.NewDec(4),
+			false,
+		},
+		"negative floor, fail": {
+			sdk.NewDec(-1),
+			sdk.ZeroDec(),
+			true,
+		},
+		"floor above ceiling, fail": {
+			sdk.NewDec(4),
+			sdk.NewDec(2),
+			true,
+		},
+		"unset floor above ceiling, fail": {
+			sdk.ZeroDec(),
+			sdk.NewDecWithPrec(5, 1),
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := DefaultParams()
+			p.DynamicFeeFloor = test.floor
+			p.DynamicFeeCeiling = test.ceiling
+			err := p.ValidateBasic()
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateAllowedFeeSponsors(t *testing.T) {
+	tests := map[string]struct {
+		addrs     interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().AllowedFeeSponsors,
+			false,
+		},
+		"type conversion fails, fail": {
+			"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+			true,
+		},
+		"distinct addresses, pass": {
+			[]string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"},
+			false,
+		},
+		"duplicate addresses, fail": {
+			[]string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"},
+			true,
+		},
+		"invalid address, fail": {
+			[]string{"cosmos1invalid"},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateAllowedFeeSponsors(test.addrs)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -11,19 +11,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -115,225 +119,7291 @@ func (m *QueryMinimumGasPricesResponse) GetMinimumGasPrices() github_com_cosmos_
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryMinimumGasPricesRequest)(nil), "gaia.globalfee.v1beta1.QueryMinimumGasPricesRequest")
-	proto.RegisterType((*QueryMinimumGasPricesResponse)(nil), "gaia.globalfee.v1beta1.QueryMinimumGasPricesResponse")
+// QueryFeeRejectionStatsRequest is the request type for the
+// Query/FeeRejectionStats RPC method.
+type QueryFeeRejectionStatsRequest struct {
+	// window is the number of most recent blocks to aggregate the rejections
+	// of. It defaults to 100 blocks when zero.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("gaia/globalfee/v1beta1/query.proto", fileDescriptor_12a736cede25d10a)
+func (m *QueryFeeRejectionStatsRequest) Reset()         { *m = QueryFeeRejectionStatsRequest{} }
+func (m *QueryFeeRejectionStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRejectionStatsRequest) ProtoMessage()    {}
+func (*QueryFeeRejectionStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{2}
+}
+func (m *QueryFeeRejectionStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRejectionStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRejectionStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeRejectionStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRejectionStatsRequest.Merge(m, src)
+}
+func (m *QueryFeeRejectionStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRejectionStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRejectionStatsRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_12a736cede25d10a = []byte{
-	// 377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x4f, 0xdb, 0x40,
-	0x14, 0xc6, 0x7d, 0xad, 0xda, 0xc1, 0x5d, 0x22, 0xab, 0xaa, 0xda, 0xc8, 0x3d, 0x57, 0x9e, 0xa2,
-	0x36, 0xbd, 0x53, 0xd2, 0x76, 0xe9, 0x98, 0x56, 0x62, 0x42, 0x82, 0x8c, 0x2c, 0xd1, 0xd9, 0x1c,
-	0xc7, 0x09, 0x9f, 0x9f, 0x93, 0x3b, 0x23, 0xbc, 0xf2, 0x17, 0x20, 0xf1, 0x5f, 0xb0, 0xb2, 0xc2,
-	0x9e, 0x31, 0x12, 0x0b, 0x93, 0x41, 0x09, 0x13, 0x23, 0x7f, 0x01, 0xb2, 0x9d, 0x00, 0x8a, 0x09,
-	0x12, 0x93, 0x2d, 0x7d, 0xbf, 0xf7, 0x3e, 0x7d, 0xdf, 0x3d, 0xdb, 0x17, 0x4c, 0x32, 0x2a, 0x22,
-	0x08, 0x58, 0xb4, 0xc3, 0x39, 0xdd, 0xef, 0x04, 0xdc, 0xb0, 0x0e, 0x1d, 0xa6, 0x7c, 0x94, 0x91,
-	0x64, 0x04, 0x06, 0x9c, 0x4f, 0x05, 0x43, 0x1e, 0x18, 0x32, 0x67, 0x9a, 0x1f, 0x05, 0x08, 0x28,
-	0x11, 0x5a, 0xfc, 0x55, 0x74, 0xd3, 0x15, 0x00, 0x22, 0xe2, 0x94, 0x25, 0x92, 0xb2, 0x38, 0x06,
-	0xc3, 0x8c, 0x84, 0x58, 0xcf, 0x55, 0x1c, 0x82, 0x56, 0xa0, 0x69, 0xc0, 0xf4, 0xa3, 0x59, 0x08,
-	0x32, 0xae, 0x74, 0x1f, 0xdb, 0xee, 0x66, 0x61, 0xbd, 0x2e, 0x63, 0xa9, 0x52, 0xb5, 0xc6, 0xf4,
-	0xc6, 0x48, 0x86, 0x5c, 0xf7, 0xf9, 0x30, 0xe5, 0xda, 0xf8, 0x39, 0xb2, 0xbf, 0xae, 0x00, 0x74,
-	0x02, 0xb1, 0xe6, 0xce, 0x19, 0xb2, 0x1d, 0x55, 0x89, 0x03, 0xc1, 0xf4, 0x20, 0x29, 0xe5, 0xcf,
-	0xe8, 0xdb, 0xdb, 0xd6, 0x87, 0xae, 0x4b, 0x2a, 0x7f, 0x52, 0xf8, 0x2f, 0x82, 0x90, 0xff, 0x3c,
-	0xfc, 0x07, 0x32, 0xee, 0x25, 0xe3, 0xdc, 0xb3, 0x6e, 0x73, 0xcf, 0xad, 0xcf, 0xb7, 0x41, 0x49,
-	0xc3, 0x55, 0x62, 0xb2, 0xbb, 0xdc, 0xfb, 0x92, 0x31, 0x15, 0xfd, 0xf5, 0xeb, 0x94, 0x7f, 0x72,
-	0xe5, 0xfd, 0x10, 0xd2, 0xec, 0xa6, 0x01, 0x09, 0x41, 0xd1, 0x79, 0xd8, 0xea, 0xf3, 0x53, 0x6f,
-	0xef, 0x51, 0x93, 0x25, 0x5c, 0x2f, 0x0c, 0x75, 0xbf, 0xa1, 0x96, 0x62, 0x74, 0xcf, 0x91, 0xfd,
-	0xae, 0x0c, 0xe8, 0x9c, 0x22, 0xbb, 0xb1, 0x9c, 0xd2, 0xf9, 0x4d, 0x9e, 0x7f, 0x0c, 0xf2, 0x52,
-	0x6b, 0xcd, 0x3f, 0xaf, 0x9c, 0xaa, 0xaa, 0xf4, 0xbb, 0x87, 0x17, 0x37, 0xc7, 0x6f, 0xda, 0xce,
-	0x77, 0xba, 0xe2, 0x4a, 0xea, 0x0d, 0xf4, 0x7a, 0xe3, 0x29, 0x46, 0x93, 0x29, 0x46, 0xd7, 0x53,
-	0x8c, 0x8e, 0x66, 0xd8, 0x9a, 0xcc, 0xb0, 0x75, 0x39, 0xc3, 0xd6, 0x56, 0xab, 0x5e, 0x4c, 0xb9,
-	0xf6, 0xe0, 0xc9, 0xe2, 0xb2, 0x9e, 0xe0, 0x7d, 0x79, 0x0b, 0xbf, 0xee, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x73, 0x0d, 0xf8, 0x43, 0x9d, 0x02, 0x00, 0x00,
+var xxx_messageInfo_QueryFeeRejectionStatsRequest proto.InternalMessageInfo
+
+func (m *QueryFeeRejectionStatsRequest) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// QueryFeeRejectionStatsResponse is the response type for the
+// Query/FeeRejectionStats RPC method.
+type QueryFeeRejectionStatsResponse struct {
+	// from_height is the first height of the aggregated window.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty" yaml:"from_height"`
+	// to_height is the last height of the aggregated window.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty" yaml:"to_height"`
+	// total_rejections is the number of txs rejected by this node for
+	// insufficient fees within the window.
+	TotalRejections uint64 `protobuf:"varint,3,opt,name=total_rejections,json=totalRejections,proto3" json:"total_rejections,omitempty" yaml:"total_rejections"`
+	// shortfall_histogram is the number of rejections by shortfall, the
+	// fraction of the required fee that was not paid.
+	ShortfallHistogram []FeeShortfallBucket `protobuf:"bytes,4,rep,name=shortfall_histogram,json=shortfallHistogram,proto3" json:"shortfall_histogram" yaml:"shortfall_histogram"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryFeeRejectionStatsResponse) Reset()         { *m = QueryFeeRejectionStatsResponse{} }
+func (m *QueryFeeRejectionStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRejectionStatsResponse) ProtoMessage()    {}
+func (*QueryFeeRejectionStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{3}
+}
+func (m *QueryFeeRejectionStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRejectionStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRejectionStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeRejectionStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRejectionStatsResponse.Merge(m, src)
+}
+func (m *QueryFeeRejectionStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRejectionStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRejectionStatsResponse.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error)
+var xxx_messageInfo_QueryFeeRejectionStatsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeRejectionStatsResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryFeeRejectionStatsResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFeeRejectionStatsResponse) GetTotalRejections() uint64 {
+	if m != nil {
+		return m.TotalRejections
+	}
+	return 0
 }
 
-func (c *queryClient) MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error) {
-	out := new(QueryMinimumGasPricesResponse)
-	err := c.cc.Invoke(ctx, "/gaia.globalfee.v1beta1.Query/MinimumGasPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeRejectionStatsResponse) GetShortfallHistogram() []FeeShortfallBucket {
+	if m != nil {
+		return m.ShortfallHistogram
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	MinimumGasPrices(context.Context, *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error)
+// FeeShortfallBucket counts the fee rejections whose shortfall is at most
+// max_shortfall and above the max_shortfall of the previous bucket.
+type FeeShortfallBucket struct {
+	MaxShortfall github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_shortfall,json=maxShortfall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_shortfall" yaml:"max_shortfall"`
+	Count        uint64                                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *FeeShortfallBucket) Reset()         { *m = FeeShortfallBucket{} }
+func (m *FeeShortfallBucket) String() string { return proto.CompactTextString(m) }
+func (*FeeShortfallBucket) ProtoMessage()    {}
+func (*FeeShortfallBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{4}
+}
+func (m *FeeShortfallBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeShortfallBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeShortfallBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeShortfallBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeShortfallBucket.Merge(m, src)
+}
+func (m *FeeShortfallBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeShortfallBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeShortfallBucket.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) MinimumGasPrices(ctx context.Context, req *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MinimumGasPrices not implemented")
+var xxx_messageInfo_FeeShortfallBucket proto.InternalMessageInfo
+
+func (m *FeeShortfallBucket) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryObservedGasPricesRequest is the request type for the
+// Query/ObservedGasPrices RPC method.
+type QueryObservedGasPricesRequest struct {
+	// window is the number of most recent blocks to aggregate the gas prices
+	// of. It defaults to 100 blocks when zero.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func _Query_MinimumGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMinimumGasPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryObservedGasPricesRequest) Reset()         { *m = QueryObservedGasPricesRequest{} }
+func (m *QueryObservedGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryObservedGasPricesRequest) ProtoMessage()    {}
+func (*QueryObservedGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{5}
+}
+func (m *QueryObservedGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObservedGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObservedGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).MinimumGasPrices(ctx, in)
+}
+func (m *QueryObservedGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObservedGasPricesRequest.Merge(m, src)
+}
+func (m *QueryObservedGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObservedGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObservedGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryObservedGasPricesRequest proto.InternalMessageInfo
+
+func (m *QueryObservedGasPricesRequest) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gaia.globalfee.v1beta1.Query/MinimumGasPrices",
+	return 0
+}
+
+// QueryObservedGasPricesResponse is the response type for the
+// Query/ObservedGasPrices RPC method.
+type QueryObservedGasPricesResponse struct {
+	// from_height is the first height of the aggregated window.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty" yaml:"from_height"`
+	// to_height is the last height of the aggregated window.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty" yaml:"to_height"`
+	// gas_prices are the gas prices observed within the window, per fee denom
+	// sorted by denom.
+	GasPrices []DenomGasPrices `protobuf:"bytes,3,rep,name=gas_prices,json=gasPrices,proto3" json:"gas_prices" yaml:"gas_prices"`
+}
+
+func (m *QueryObservedGasPricesResponse) Reset()         { *m = QueryObservedGasPricesResponse{} }
+func (m *QueryObservedGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryObservedGasPricesResponse) ProtoMessage()    {}
+func (*QueryObservedGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{6}
+}
+func (m *QueryObservedGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObservedGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObservedGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MinimumGasPrices(ctx, req.(*QueryMinimumGasPricesRequest))
+}
+func (m *QueryObservedGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObservedGasPricesResponse.Merge(m, src)
+}
+func (m *QueryObservedGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObservedGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObservedGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryObservedGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryObservedGasPricesResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gaia.globalfee.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MinimumGasPrices",
-			Handler:    _Query_MinimumGasPrices_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "gaia/globalfee/v1beta1/query.proto",
+func (m *QueryObservedGasPricesResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
 }
 
-func (m *QueryMinimumGasPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryObservedGasPricesResponse) GetGasPrices() []DenomGasPrices {
+	if m != nil {
+		return m.GasPrices
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMinimumGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DenomGasPrices are the percentiles of the gas prices paid in a denom, the
+// gas price of a tx being its fee in the denom divided by its gas limit.
+type DenomGasPrices struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// tx_count is the number of txs which paid fees in the denom.
+	TxCount uint64                                 `protobuf:"varint,2,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty" yaml:"tx_count"`
+	P10     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=p10,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p10"`
+	P50     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=p50,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p50"`
+	P90     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=p90,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p90"`
 }
 
-func (m *QueryMinimumGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *DenomGasPrices) Reset()         { *m = DenomGasPrices{} }
+func (m *DenomGasPrices) String() string { return proto.CompactTextString(m) }
+func (*DenomGasPrices) ProtoMessage()    {}
+func (*DenomGasPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{7}
+}
+func (m *DenomGasPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomGasPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomGasPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomGasPrices.Merge(m, src)
+}
+func (m *DenomGasPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomGasPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomGasPrices.DiscardUnknown(m)
 }
 
-func (m *QueryMinimumGasPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DenomGasPrices proto.InternalMessageInfo
+
+func (m *DenomGasPrices) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryMinimumGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DenomGasPrices) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
 }
 
-func (m *QueryMinimumGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MinimumGasPrices) > 0 {
-		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryTimeWeightedAverageFeeRequest is the request type for the
+// Query/TimeWeightedAverageFee RPC method.
+type QueryTimeWeightedAverageFeeRequest struct {
+	// window is the number of most recent blocks to average the fees of. It
+	// defaults to 100 blocks when zero.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *QueryTimeWeightedAverageFeeRequest) Reset()         { *m = QueryTimeWeightedAverageFeeRequest{} }
+func (m *QueryTimeWeightedAverageFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeWeightedAverageFeeRequest) ProtoMessage()    {}
+func (*QueryTimeWeightedAverageFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{8}
+}
+func (m *QueryTimeWeightedAverageFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeWeightedAverageFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeWeightedAverageFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimeWeightedAverageFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeWeightedAverageFeeRequest.Merge(m, src)
+}
+func (m *QueryTimeWeightedAverageFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeWeightedAverageFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeWeightedAverageFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimeWeightedAverageFeeRequest proto.InternalMessageInfo
+
+func (m *QueryTimeWeightedAverageFeeRequest) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// QueryTimeWeightedAverageFeeResponse is the response type for the
+// Query/TimeWeightedAverageFee RPC method.
+type QueryTimeWeightedAverageFeeResponse struct {
+	// from_height is the first height of the averaged window.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty" yaml:"from_height"`
+	// to_height is the last height of the averaged window.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty" yaml:"to_height"`
+	// average_fees are the time weighted average fees within the window, per
+	// fee denom sorted by denom.
+	AverageFees []DenomTimeWeightedFee `protobuf:"bytes,3,rep,name=average_fees,json=averageFees,proto3" json:"average_fees" yaml:"average_fees"`
+}
+
+func (m *QueryTimeWeightedAverageFeeResponse) Reset()         { *m = QueryTimeWeightedAverageFeeResponse{} }
+func (m *QueryTimeWeightedAverageFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeWeightedAverageFeeResponse) ProtoMessage()    {}
+func (*QueryTimeWeightedAverageFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{9}
+}
+func (m *QueryTimeWeightedAverageFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeWeightedAverageFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeWeightedAverageFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimeWeightedAverageFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeWeightedAverageFeeResponse.Merge(m, src)
+}
+func (m *QueryTimeWeightedAverageFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeWeightedAverageFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeWeightedAverageFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimeWeightedAverageFeeResponse proto.InternalMessageInfo
+
+func (m *QueryTimeWeightedAverageFeeResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryTimeWeightedAverageFeeResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryTimeWeightedAverageFeeResponse) GetAverageFees() []DenomTimeWeightedFee {
+	if m != nil {
+		return m.AverageFees
+	}
+	return nil
+}
+
+// DenomTimeWeightedFee is the average of the fees per gas paid in a denom by
+// the blocks of a window, each block weighted by its block time, i.e. the time
+// elapsed since the previous block. The fee per gas of a block is the sum of
+// the fees paid in the denom by its txs divided by the sum of their gas
+// limits.
+type DenomTimeWeightedFee struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// block_count is the number of blocks averaged, i.e. the blocks with fees
+	// paid in the denom whose block time is known.
+	BlockCount uint64 `protobuf:"varint,2,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty" yaml:"block_count"`
+	// duration is the sum of the block times of the averaged blocks.
+	Duration         time.Duration                          `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration"`
+	AverageFeePerGas github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=average_fee_per_gas,json=averageFeePerGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_fee_per_gas" yaml:"average_fee_per_gas"`
+}
+
+func (m *DenomTimeWeightedFee) Reset()         { *m = DenomTimeWeightedFee{} }
+func (m *DenomTimeWeightedFee) String() string { return proto.CompactTextString(m) }
+func (*DenomTimeWeightedFee) ProtoMessage()    {}
+func (*DenomTimeWeightedFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{10}
+}
+func (m *DenomTimeWeightedFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTimeWeightedFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTimeWeightedFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTimeWeightedFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTimeWeightedFee.Merge(m, src)
+}
+func (m *DenomTimeWeightedFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTimeWeightedFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTimeWeightedFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTimeWeightedFee proto.InternalMessageInfo
+
+func (m *DenomTimeWeightedFee) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomTimeWeightedFee) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *DenomTimeWeightedFee) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// QueryDynamicMinimumGasPricesRequest is the request type for the
+// Query/DynamicMinimumGasPrices RPC method.
+type QueryDynamicMinimumGasPricesRequest struct {
+}
+
+func (m *QueryDynamicMinimumGasPricesRequest) Reset()         { *m = QueryDynamicMinimumGasPricesRequest{} }
+func (m *QueryDynamicMinimumGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDynamicMinimumGasPricesRequest) ProtoMessage()    {}
+func (*QueryDynamicMinimumGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{11}
+}
+func (m *QueryDynamicMinimumGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDynamicMinimumGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDynamicMinimumGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDynamicMinimumGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDynamicMinimumGasPricesRequest.Merge(m, src)
+}
+func (m *QueryDynamicMinimumGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDynamicMinimumGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDynamicMinimumGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDynamicMinimumGasPricesRequest proto.InternalMessageInfo
+
+// QueryDynamicMinimumGasPricesResponse is the response type for the
+// Query/DynamicMinimumGasPrices RPC method.
+type QueryDynamicMinimumGasPricesResponse struct {
+	// minimum_gas_prices are the minimum gas prices scaled by the multiplier.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices,omitempty" yaml:"minimum_gas_prices"`
+	// multiplier is the dynamic multiplier of the minimum gas prices.
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+	// fullness is the average fullness of the recent blocks the multiplier
+	// was last adjusted with.
+	Fullness github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fullness,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fullness"`
+	// height is the height of the block the multiplier was last adjusted at.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryDynamicMinimumGasPricesResponse) Reset()         { *m = QueryDynamicMinimumGasPricesResponse{} }
+func (m *QueryDynamicMinimumGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDynamicMinimumGasPricesResponse) ProtoMessage()    {}
+func (*QueryDynamicMinimumGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{12}
+}
+func (m *QueryDynamicMinimumGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDynamicMinimumGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDynamicMinimumGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDynamicMinimumGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDynamicMinimumGasPricesResponse.Merge(m, src)
+}
+func (m *QueryDynamicMinimumGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDynamicMinimumGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDynamicMinimumGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDynamicMinimumGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryDynamicMinimumGasPricesResponse) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+func (m *QueryDynamicMinimumGasPricesResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryMinGasPriceTimelineRequest is the request type for the
+// Query/MinGasPriceTimeline RPC method.
+type QueryMinGasPriceTimelineRequest struct {
+	// from_height is the first height of the range.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the last height of the range, the latest height when zero.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *QueryMinGasPriceTimelineRequest) Reset()         { *m = QueryMinGasPriceTimelineRequest{} }
+func (m *QueryMinGasPriceTimelineRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinGasPriceTimelineRequest) ProtoMessage()    {}
+func (*QueryMinGasPriceTimelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{13}
+}
+func (m *QueryMinGasPriceTimelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinGasPriceTimelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinGasPriceTimelineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinGasPriceTimelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinGasPriceTimelineRequest.Merge(m, src)
+}
+func (m *QueryMinGasPriceTimelineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinGasPriceTimelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinGasPriceTimelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinGasPriceTimelineRequest proto.InternalMessageInfo
+
+func (m *QueryMinGasPriceTimelineRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryMinGasPriceTimelineRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// QueryMinGasPriceTimelineResponse is the response type for the
+// Query/MinGasPriceTimeline RPC method.
+type QueryMinGasPriceTimelineResponse struct {
+	// steps are the effective minimum gas prices in effect over the range,
+	// ordered by height. The first step is the one in effect at from_height.
+	Steps []MinGasPriceStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps"`
+}
+
+func (m *QueryMinGasPriceTimelineResponse) Reset()         { *m = QueryMinGasPriceTimelineResponse{} }
+func (m *QueryMinGasPriceTimelineResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinGasPriceTimelineResponse) ProtoMessage()    {}
+func (*QueryMinGasPriceTimelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{14}
+}
+func (m *QueryMinGasPriceTimelineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinGasPriceTimelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinGasPriceTimelineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinGasPriceTimelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinGasPriceTimelineResponse.Merge(m, src)
+}
+func (m *QueryMinGasPriceTimelineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinGasPriceTimelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinGasPriceTimelineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinGasPriceTimelineResponse proto.InternalMessageInfo
+
+func (m *QueryMinGasPriceTimelineResponse) GetSteps() []MinGasPriceStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+// MinGasPriceStep is the effective minimum gas prices from a height until the
+// height of the next step.
+type MinGasPriceStep struct {
+	// height is the height of the block the minimum gas prices took effect at.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// minimum_gas_prices are the effective minimum gas prices.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices,omitempty" yaml:"minimum_gas_prices"`
+}
+
+func (m *MinGasPriceStep) Reset()         { *m = MinGasPriceStep{} }
+func (m *MinGasPriceStep) String() string { return proto.CompactTextString(m) }
+func (*MinGasPriceStep) ProtoMessage()    {}
+func (*MinGasPriceStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{15}
+}
+func (m *MinGasPriceStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinGasPriceStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinGasPriceStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinGasPriceStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinGasPriceStep.Merge(m, src)
+}
+func (m *MinGasPriceStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinGasPriceStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinGasPriceStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinGasPriceStep proto.InternalMessageInfo
+
+func (m *MinGasPriceStep) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MinGasPriceStep) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+// QueryBypassRateRequest is the request type for the Query/BypassRate RPC
+// method.
+type QueryBypassRateRequest struct {
+	// window is the number of most recent blocks to aggregate the txs of. It
+	// defaults to 100 blocks when zero.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *QueryBypassRateRequest) Reset()         { *m = QueryBypassRateRequest{} }
+func (m *QueryBypassRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBypassRateRequest) ProtoMessage()    {}
+func (*QueryBypassRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{16}
+}
+func (m *QueryBypassRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBypassRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBypassRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBypassRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBypassRateRequest.Merge(m, src)
+}
+func (m *QueryBypassRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBypassRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBypassRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBypassRateRequest proto.InternalMessageInfo
+
+func (m *QueryBypassRateRequest) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// QueryBypassRateResponse is the response type for the Query/BypassRate RPC
+// method.
+type QueryBypassRateResponse struct {
+	// from_height is the first height of the aggregated window.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty" yaml:"from_height"`
+	// to_height is the last height of the aggregated window.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty" yaml:"to_height"`
+	// total_txs is the number of txs delivered within the window.
+	TotalTxs uint64 `protobuf:"varint,3,opt,name=total_txs,json=totalTxs,proto3" json:"total_txs,omitempty" yaml:"total_txs"`
+	// bypassed_txs is the number of txs delivered within the window which
+	// bypassed the minimum fees.
+	BypassedTxs uint64 `protobuf:"varint,4,opt,name=bypassed_txs,json=bypassedTxs,proto3" json:"bypassed_txs,omitempty" yaml:"bypassed_txs"`
+	// bypass_percentage is the percentage of the txs delivered within the
+	// window which bypassed the minimum fees, zero without txs.
+	BypassPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=bypass_percentage,json=bypassPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bypass_percentage" yaml:"bypass_percentage"`
+	// msg_types are the number of bypassed txs per message type sorted by type
+	// URL.
+	MsgTypes []BypassedMsgType `protobuf:"bytes,6,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types" yaml:"msg_types"`
+}
+
+func (m *QueryBypassRateResponse) Reset()         { *m = QueryBypassRateResponse{} }
+func (m *QueryBypassRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBypassRateResponse) ProtoMessage()    {}
+func (*QueryBypassRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{17}
+}
+func (m *QueryBypassRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBypassRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBypassRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBypassRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBypassRateResponse.Merge(m, src)
+}
+func (m *QueryBypassRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBypassRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBypassRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBypassRateResponse proto.InternalMessageInfo
+
+func (m *QueryBypassRateResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryBypassRateResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryBypassRateResponse) GetTotalTxs() uint64 {
+	if m != nil {
+		return m.TotalTxs
+	}
+	return 0
+}
+
+func (m *QueryBypassRateResponse) GetBypassedTxs() uint64 {
+	if m != nil {
+		return m.BypassedTxs
+	}
+	return 0
+}
+
+func (m *QueryBypassRateResponse) GetMsgTypes() []BypassedMsgType {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+// BypassedMsgType counts the bypassed txs containing a message type.
+type BypassedMsgType struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// txs is the number of bypassed txs containing at least one message of the
+	// type.
+	Txs uint64 `protobuf:"varint,2,opt,name=txs,proto3" json:"txs,omitempty"`
+	// msgs is the number of messages of the type in the bypassed txs.
+	Msgs uint64 `protobuf:"varint,3,opt,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *BypassedMsgType) Reset()         { *m = BypassedMsgType{} }
+func (m *BypassedMsgType) String() string { return proto.CompactTextString(m) }
+func (*BypassedMsgType) ProtoMessage()    {}
+func (*BypassedMsgType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{18}
+}
+func (m *BypassedMsgType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BypassedMsgType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BypassedMsgType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BypassedMsgType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BypassedMsgType.Merge(m, src)
+}
+func (m *BypassedMsgType) XXX_Size() int {
+	return m.Size()
+}
+func (m *BypassedMsgType) XXX_DiscardUnknown() {
+	xxx_messageInfo_BypassedMsgType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BypassedMsgType proto.InternalMessageInfo
+
+func (m *BypassedMsgType) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *BypassedMsgType) GetTxs() uint64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func (m *BypassedMsgType) GetMsgs() uint64 {
+	if m != nil {
+		return m.Msgs
+	}
+	return 0
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{19}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{20}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// WatchParamsRequest is the request type for the Watch/Params RPC method.
+type WatchParamsRequest struct {
+}
+
+func (m *WatchParamsRequest) Reset()         { *m = WatchParamsRequest{} }
+func (m *WatchParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchParamsRequest) ProtoMessage()    {}
+func (*WatchParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{21}
+}
+func (m *WatchParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchParamsRequest.Merge(m, src)
+}
+func (m *WatchParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchParamsRequest proto.InternalMessageInfo
+
+// WatchParamsResponse is the response type for the Watch/Params RPC method.
+type WatchParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// height is the block height at which the params were read.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *WatchParamsResponse) Reset()         { *m = WatchParamsResponse{} }
+func (m *WatchParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchParamsResponse) ProtoMessage()    {}
+func (*WatchParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{22}
+}
+func (m *WatchParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchParamsResponse.Merge(m, src)
+}
+func (m *WatchParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchParamsResponse proto.InternalMessageInfo
+
+func (m *WatchParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *WatchParamsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MempoolFeesRequest is the request type for the Mempool/Fees RPC method.
+type MempoolFeesRequest struct {
+}
+
+func (m *MempoolFeesRequest) Reset()         { *m = MempoolFeesRequest{} }
+func (m *MempoolFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*MempoolFeesRequest) ProtoMessage()    {}
+func (*MempoolFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{23}
+}
+func (m *MempoolFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolFeesRequest.Merge(m, src)
+}
+func (m *MempoolFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolFeesRequest proto.InternalMessageInfo
+
+// MempoolFeesResponse is the response type for the Mempool/Fees RPC method.
+type MempoolFeesResponse struct {
+	// tx_count is the number of txs pending in the mempool.
+	TxCount uint64 `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty" yaml:"tx_count"`
+	// total_bytes is the size of the txs pending in the mempool.
+	TotalBytes int64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty" yaml:"total_bytes"`
+	// sampled_tx_count is the number of pending txs the fees are computed
+	// from, as the mempool is only read up to the Tendermint RPC limit.
+	SampledTxCount uint64 `protobuf:"varint,3,opt,name=sampled_tx_count,json=sampledTxCount,proto3" json:"sampled_tx_count,omitempty" yaml:"sampled_tx_count"`
+	// zero_fee_tx_count is the number of sampled txs paying no fee.
+	ZeroFeeTxCount uint64 `protobuf:"varint,4,opt,name=zero_fee_tx_count,json=zeroFeeTxCount,proto3" json:"zero_fee_tx_count,omitempty" yaml:"zero_fee_tx_count"`
+	// gas_price_histograms are the histograms of the gas prices of the sampled
+	// txs, per fee denom sorted by denom.
+	GasPriceHistograms []DenomGasPriceHistogram `protobuf:"bytes,5,rep,name=gas_price_histograms,json=gasPriceHistograms,proto3" json:"gas_price_histograms" yaml:"gas_price_histograms"`
+}
+
+func (m *MempoolFeesResponse) Reset()         { *m = MempoolFeesResponse{} }
+func (m *MempoolFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MempoolFeesResponse) ProtoMessage()    {}
+func (*MempoolFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{24}
+}
+func (m *MempoolFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolFeesResponse.Merge(m, src)
+}
+func (m *MempoolFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolFeesResponse proto.InternalMessageInfo
+
+func (m *MempoolFeesResponse) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *MempoolFeesResponse) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *MempoolFeesResponse) GetSampledTxCount() uint64 {
+	if m != nil {
+		return m.SampledTxCount
+	}
+	return 0
+}
+
+func (m *MempoolFeesResponse) GetZeroFeeTxCount() uint64 {
+	if m != nil {
+		return m.ZeroFeeTxCount
+	}
+	return 0
+}
+
+func (m *MempoolFeesResponse) GetGasPriceHistograms() []DenomGasPriceHistogram {
+	if m != nil {
+		return m.GasPriceHistograms
+	}
+	return nil
+}
+
+// DenomGasPriceHistogram is the histogram of the gas prices paid in a denom,
+// the gas price of a tx being its fee in the denom divided by its gas limit.
+type DenomGasPriceHistogram struct {
+	Denom   string           `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Buckets []GasPriceBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets"`
+	// above_max_count is the number of txs whose gas price is above the
+	// max_gas_price of the last bucket.
+	AboveMaxCount uint64 `protobuf:"varint,3,opt,name=above_max_count,json=aboveMaxCount,proto3" json:"above_max_count,omitempty" yaml:"above_max_count"`
+}
+
+func (m *DenomGasPriceHistogram) Reset()         { *m = DenomGasPriceHistogram{} }
+func (m *DenomGasPriceHistogram) String() string { return proto.CompactTextString(m) }
+func (*DenomGasPriceHistogram) ProtoMessage()    {}
+func (*DenomGasPriceHistogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{25}
+}
+func (m *DenomGasPriceHistogram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomGasPriceHistogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomGasPriceHistogram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomGasPriceHistogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomGasPriceHistogram.Merge(m, src)
+}
+func (m *DenomGasPriceHistogram) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomGasPriceHistogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomGasPriceHistogram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomGasPriceHistogram proto.InternalMessageInfo
+
+func (m *DenomGasPriceHistogram) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomGasPriceHistogram) GetBuckets() []GasPriceBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *DenomGasPriceHistogram) GetAboveMaxCount() uint64 {
+	if m != nil {
+		return m.AboveMaxCount
+	}
+	return 0
+}
+
+// GasPriceBucket counts the txs whose gas price is at most max_gas_price and
+// above the max_gas_price of the previous bucket.
+type GasPriceBucket struct {
+	MaxGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_gas_price,json=maxGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_gas_price" yaml:"max_gas_price"`
+	Count       uint64                                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *GasPriceBucket) Reset()         { *m = GasPriceBucket{} }
+func (m *GasPriceBucket) String() string { return proto.CompactTextString(m) }
+func (*GasPriceBucket) ProtoMessage()    {}
+func (*GasPriceBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{26}
+}
+func (m *GasPriceBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceBucket.Merge(m, src)
+}
+func (m *GasPriceBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceBucket proto.InternalMessageInfo
+
+func (m *GasPriceBucket) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// QueryBypassMinFeeMsgTypesRequest is the request type for the
+// NodeConfig/BypassMinFeeMsgTypes RPC method.
+type QueryBypassMinFeeMsgTypesRequest struct {
+}
+
+func (m *QueryBypassMinFeeMsgTypesRequest) Reset()         { *m = QueryBypassMinFeeMsgTypesRequest{} }
+func (m *QueryBypassMinFeeMsgTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBypassMinFeeMsgTypesRequest) ProtoMessage()    {}
+func (*QueryBypassMinFeeMsgTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{27}
+}
+func (m *QueryBypassMinFeeMsgTypesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBypassMinFeeMsgTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBypassMinFeeMsgTypesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBypassMinFeeMsgTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBypassMinFeeMsgTypesRequest.Merge(m, src)
+}
+func (m *QueryBypassMinFeeMsgTypesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBypassMinFeeMsgTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBypassMinFeeMsgTypesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBypassMinFeeMsgTypesRequest proto.InternalMessageInfo
+
+// QueryBypassMinFeeMsgTypesResponse is the response type for the
+// NodeConfig/BypassMinFeeMsgTypes RPC method.
+type QueryBypassMinFeeMsgTypesResponse struct {
+	// msg_types are the bypass min fee msg types of the node, as configured.
+	MsgTypes []string `protobuf:"bytes,1,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty" yaml:"msg_types"`
+	// hash is the hex encoded SHA-256 hash of the sorted msg types, each
+	// followed by a newline.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryBypassMinFeeMsgTypesResponse) Reset()         { *m = QueryBypassMinFeeMsgTypesResponse{} }
+func (m *QueryBypassMinFeeMsgTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBypassMinFeeMsgTypesResponse) ProtoMessage()    {}
+func (*QueryBypassMinFeeMsgTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{28}
+}
+func (m *QueryBypassMinFeeMsgTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBypassMinFeeMsgTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBypassMinFeeMsgTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBypassMinFeeMsgTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBypassMinFeeMsgTypesResponse.Merge(m, src)
+}
+func (m *QueryBypassMinFeeMsgTypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBypassMinFeeMsgTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBypassMinFeeMsgTypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBypassMinFeeMsgTypesResponse proto.InternalMessageInfo
+
+func (m *QueryBypassMinFeeMsgTypesResponse) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+func (m *QueryBypassMinFeeMsgTypesResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// QueryClientConfigRequest is the request type for the
+// NodeConfig/ClientConfig RPC method.
+type QueryClientConfigRequest struct {
+}
+
+func (m *QueryClientConfigRequest) Reset()         { *m = QueryClientConfigRequest{} }
+func (m *QueryClientConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientConfigRequest) ProtoMessage()    {}
+func (*QueryClientConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{29}
+}
+func (m *QueryClientConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientConfigRequest.Merge(m, src)
+}
+func (m *QueryClientConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientConfigRequest proto.InternalMessageInfo
+
+// QueryClientConfigResponse is the response type for the
+// NodeConfig/ClientConfig RPC method.
+type QueryClientConfigResponse struct {
+	// version is the version of the config, bumped when the fee policy gains
+	// an element the clients must take into account.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// minimum_gas_prices are the global minimum gas prices, scaled by the
+	// dynamic multiplier. They are the bond denom at zero when the global
+	// minimum gas prices are empty.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices" yaml:"minimum_gas_prices"`
+	// local_minimum_gas_prices are the minimum-gas-prices of the app.toml of
+	// the node, required on top of the global minimum gas prices when the TXs
+	// enter its mempool.
+	LocalMinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=local_minimum_gas_prices,json=localMinimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"local_minimum_gas_prices" yaml:"local_minimum_gas_prices"`
+	// fee_denoms are the denoms the fees can be paid in, i.e. the denoms of
+	// the minimum gas prices. The fee can be paid in any one of them.
+	FeeDenoms []string `protobuf:"bytes,4,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms,omitempty" yaml:"fee_denoms"`
+	// min_flat_fee is the minimum fee of a denom whatever the gas limit.
+	MinFlatFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=min_flat_fee,json=minFlatFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_flat_fee" yaml:"min_flat_fee"`
+	// bypass_min_fee_msg_types are the msg types of the TXs accepted by the
+	// node without fee, within the bypass gas limit.
+	BypassMinFeeMsgTypes []string `protobuf:"bytes,6,rep,name=bypass_min_fee_msg_types,json=bypassMinFeeMsgTypes,proto3" json:"bypass_min_fee_msg_types,omitempty" yaml:"bypass_min_fee_msg_types"`
+	// max_total_bypass_min_fee_msg_gas_usage is the bypass gas limit, the
+	// maximum gas limit of a TX bypassing the fees.
+	MaxTotalBypassMinFeeMsgGasUsage uint64 `protobuf:"varint,7,opt,name=max_total_bypass_min_fee_msg_gas_usage,json=maxTotalBypassMinFeeMsgGasUsage,proto3" json:"max_total_bypass_min_fee_msg_gas_usage,omitempty" yaml:"max_total_bypass_min_fee_msg_gas_usage"`
+	// msg_gas_floors are the minimum gas limits of the TXs with a message of
+	// the given types.
+	MsgGasFloors []MsgGasFloor `protobuf:"bytes,8,rep,name=msg_gas_floors,json=msgGasFloors,proto3" json:"msg_gas_floors" yaml:"msg_gas_floors"`
+	// max_tx_bytes is the maximum size in bytes of a serialized TX. Zero
+	// disables the limit.
+	MaxTxBytes uint64 `protobuf:"varint,9,opt,name=max_tx_bytes,json=maxTxBytes,proto3" json:"max_tx_bytes,omitempty" yaml:"max_tx_bytes"`
+}
+
+func (m *QueryClientConfigResponse) Reset()         { *m = QueryClientConfigResponse{} }
+func (m *QueryClientConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientConfigResponse) ProtoMessage()    {}
+func (*QueryClientConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12a736cede25d10a, []int{30}
+}
+func (m *QueryClientConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientConfigResponse.Merge(m, src)
+}
+func (m *QueryClientConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientConfigResponse proto.InternalMessageInfo
+
+func (m *QueryClientConfigResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *QueryClientConfigResponse) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+func (m *QueryClientConfigResponse) GetLocalMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.LocalMinimumGasPrices
+	}
+	return nil
+}
+
+func (m *QueryClientConfigResponse) GetFeeDenoms() []string {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *QueryClientConfigResponse) GetMinFlatFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFlatFee
+	}
+	return nil
+}
+
+func (m *QueryClientConfigResponse) GetBypassMinFeeMsgTypes() []string {
+	if m != nil {
+		return m.BypassMinFeeMsgTypes
+	}
+	return nil
+}
+
+func (m *QueryClientConfigResponse) GetMaxTotalBypassMinFeeMsgGasUsage() uint64 {
+	if m != nil {
+		return m.MaxTotalBypassMinFeeMsgGasUsage
+	}
+	return 0
+}
+
+func (m *QueryClientConfigResponse) GetMsgGasFloors() []MsgGasFloor {
+	if m != nil {
+		return m.MsgGasFloors
+	}
+	return nil
+}
+
+func (m *QueryClientConfigResponse) GetMaxTxBytes() uint64 {
+	if m != nil {
+		return m.MaxTxBytes
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryMinimumGasPricesRequest)(nil), "gaia.globalfee.v1beta1.QueryMinimumGasPricesRequest")
+	proto.RegisterType((*QueryMinimumGasPricesResponse)(nil), "gaia.globalfee.v1beta1.QueryMinimumGasPricesResponse")
+	proto.RegisterType((*QueryFeeRejectionStatsRequest)(nil), "gaia.globalfee.v1beta1.QueryFeeRejectionStatsRequest")
+	proto.RegisterType((*QueryFeeRejectionStatsResponse)(nil), "gaia.globalfee.v1beta1.QueryFeeRejectionStatsResponse")
+	proto.RegisterType((*FeeShortfallBucket)(nil), "gaia.globalfee.v1beta1.FeeShortfallBucket")
+	proto.RegisterType((*QueryObservedGasPricesRequest)(nil), "gaia.globalfee.v1beta1.QueryObservedGasPricesRequest")
+	proto.RegisterType((*QueryObservedGasPricesResponse)(nil), "gaia.globalfee.v1beta1.QueryObservedGasPricesResponse")
+	proto.RegisterType((*DenomGasPrices)(nil), "gaia.globalfee.v1beta1.DenomGasPrices")
+	proto.RegisterType((*QueryTimeWeightedAverageFeeRequest)(nil), "gaia.globalfee.v1beta1.QueryTimeWeightedAverageFeeRequest")
+	proto.RegisterType((*QueryTimeWeightedAverageFeeResponse)(nil), "gaia.globalfee.v1beta1.QueryTimeWeightedAverageFeeResponse")
+	proto.RegisterType((*DenomTimeWeightedFee)(nil), "gaia.globalfee.v1beta1.DenomTimeWeightedFee")
+	proto.RegisterType((*QueryDynamicMinimumGasPricesRequest)(nil), "gaia.globalfee.v1beta1.QueryDynamicMinimumGasPricesRequest")
+	proto.RegisterType((*QueryDynamicMinimumGasPricesResponse)(nil), "gaia.globalfee.v1beta1.QueryDynamicMinimumGasPricesResponse")
+	proto.RegisterType((*QueryMinGasPriceTimelineRequest)(nil), "gaia.globalfee.v1beta1.QueryMinGasPriceTimelineRequest")
+	proto.RegisterType((*QueryMinGasPriceTimelineResponse)(nil), "gaia.globalfee.v1beta1.QueryMinGasPriceTimelineResponse")
+	proto.RegisterType((*MinGasPriceStep)(nil), "gaia.globalfee.v1beta1.MinGasPriceStep")
+	proto.RegisterType((*QueryBypassRateRequest)(nil), "gaia.globalfee.v1beta1.QueryBypassRateRequest")
+	proto.RegisterType((*QueryBypassRateResponse)(nil), "gaia.globalfee.v1beta1.QueryBypassRateResponse")
+	proto.RegisterType((*BypassedMsgType)(nil), "gaia.globalfee.v1beta1.BypassedMsgType")
+	proto.RegisterType((*QueryParamsRequest)(nil), "gaia.globalfee.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "gaia.globalfee.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*WatchParamsRequest)(nil), "gaia.globalfee.v1beta1.WatchParamsRequest")
+	proto.RegisterType((*WatchParamsResponse)(nil), "gaia.globalfee.v1beta1.WatchParamsResponse")
+	proto.RegisterType((*MempoolFeesRequest)(nil), "gaia.globalfee.v1beta1.MempoolFeesRequest")
+	proto.RegisterType((*MempoolFeesResponse)(nil), "gaia.globalfee.v1beta1.MempoolFeesResponse")
+	proto.RegisterType((*DenomGasPriceHistogram)(nil), "gaia.globalfee.v1beta1.DenomGasPriceHistogram")
+	proto.RegisterType((*GasPriceBucket)(nil), "gaia.globalfee.v1beta1.GasPriceBucket")
+	proto.RegisterType((*QueryBypassMinFeeMsgTypesRequest)(nil), "gaia.globalfee.v1beta1.QueryBypassMinFeeMsgTypesRequest")
+	proto.RegisterType((*QueryBypassMinFeeMsgTypesResponse)(nil), "gaia.globalfee.v1beta1.QueryBypassMinFeeMsgTypesResponse")
+	proto.RegisterType((*QueryClientConfigRequest)(nil), "gaia.globalfee.v1beta1.QueryClientConfigRequest")
+	proto.RegisterType((*QueryClientConfigResponse)(nil), "gaia.globalfee.v1beta1.QueryClientConfigResponse")
+}
+
+func init() {
+	proto.RegisterFile("gaia/globalfee/v1beta1/query.proto", fileDescriptor_12a736cede25d10a)
+}
+
+var fileDescriptor_12a736cede25d10a = []byte{
+	// 2225 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x8c, 0x1b, 0x49,
+	0x15, 0x4e, 0xdb, 0x33, 0x99, 0xf1, 0xf3, 0x24, 0x99, 0xd4, 0x38, 0x13, 0xc7, 0xc9, 0xda, 0xa1,
+	0xf2, 0xb3, 0x51, 0x26, 0xb1, 0x67, 0x9c, 0xcd, 0x2f, 0x91, 0x80, 0x4e, 0x70, 0x56, 0x2b, 0xb2,
+	0x84, 0xce, 0x2c, 0x2b, 0x81, 0x44, 0x53, 0xf6, 0x94, 0x7b, 0x3a, 0xd3, 0xed, 0xf6, 0x76, 0xb5,
+	0x27, 0x1e, 0x38, 0x20, 0xad, 0xe0, 0xc0, 0x01, 0x09, 0x81, 0x04, 0x2c, 0xda, 0x13, 0xdc, 0x90,
+	0x38, 0x71, 0x40, 0x42, 0x48, 0x70, 0x41, 0xca, 0x01, 0xa4, 0x48, 0x08, 0x09, 0x71, 0xf0, 0xa2,
+	0x84, 0x03, 0xe2, 0xc0, 0x61, 0x6e, 0x9c, 0x40, 0xf5, 0xd3, 0xed, 0xf6, 0x4f, 0x7b, 0x3c, 0xa3,
+	0x45, 0x11, 0x9c, 0xec, 0xae, 0x7a, 0xdf, 0xab, 0xef, 0xbd, 0x7a, 0xef, 0xd5, 0xab, 0x02, 0x6c,
+	0x11, 0x9b, 0x54, 0x2c, 0xc7, 0xab, 0x13, 0xa7, 0x49, 0x69, 0x65, 0x7b, 0xad, 0x4e, 0x03, 0xb2,
+	0x56, 0x79, 0xaf, 0x43, 0xfd, 0x9d, 0x72, 0xdb, 0xf7, 0x02, 0x0f, 0x2d, 0x73, 0x99, 0x72, 0x24,
+	0x53, 0x56, 0x32, 0x85, 0x9c, 0xe5, 0x59, 0x9e, 0x10, 0xa9, 0xf0, 0x7f, 0x52, 0xba, 0x70, 0xc6,
+	0xf2, 0x3c, 0xcb, 0xa1, 0x15, 0xd2, 0xb6, 0x2b, 0xa4, 0xd5, 0xf2, 0x02, 0x12, 0xd8, 0x5e, 0x8b,
+	0xa9, 0xd9, 0xa2, 0x9a, 0x15, 0x5f, 0xf5, 0x4e, 0xb3, 0xb2, 0xd1, 0xf1, 0x85, 0x40, 0x38, 0xdf,
+	0xf0, 0x98, 0xeb, 0xb1, 0x4a, 0x9d, 0xb0, 0x3e, 0x99, 0x86, 0x67, 0x87, 0xf3, 0xe7, 0x13, 0xf8,
+	0x5a, 0xb4, 0x45, 0x99, 0xad, 0x56, 0xc1, 0x45, 0x38, 0xf3, 0x05, 0x6e, 0xc0, 0x43, 0xbb, 0x65,
+	0xbb, 0x1d, 0xf7, 0x01, 0x61, 0x8f, 0x7c, 0xbb, 0x41, 0x99, 0x41, 0xdf, 0xeb, 0x50, 0x16, 0xe0,
+	0x9e, 0x06, 0xaf, 0x25, 0x08, 0xb0, 0xb6, 0xd7, 0x62, 0x14, 0xfd, 0x5a, 0x03, 0xe4, 0xca, 0x49,
+	0xd3, 0x22, 0xcc, 0x6c, 0x8b, 0xe9, 0xbc, 0x76, 0x36, 0x7d, 0x29, 0x5b, 0x3d, 0x53, 0x96, 0x2c,
+	0xcb, 0x9c, 0x65, 0xe8, 0x8e, 0xf2, 0x7d, 0xda, 0xb8, 0xe7, 0xd9, 0x2d, 0xbd, 0xfd, 0xac, 0x57,
+	0x3a, 0xf4, 0x8f, 0x5e, 0xe9, 0xcc, 0x28, 0xfe, 0x8a, 0xe7, 0xda, 0x01, 0x75, 0xdb, 0xc1, 0xce,
+	0x6e, 0xaf, 0x74, 0x6a, 0x87, 0xb8, 0xce, 0x1d, 0x3c, 0x2a, 0x85, 0x7f, 0xf6, 0x51, 0x69, 0xc5,
+	0xb2, 0x83, 0xcd, 0x4e, 0xbd, 0xdc, 0xf0, 0xdc, 0x8a, 0x72, 0x89, 0xfc, 0xb9, 0xca, 0x36, 0xb6,
+	0x2a, 0xc1, 0x4e, 0x9b, 0xb2, 0x70, 0x41, 0x66, 0x2c, 0xba, 0x43, 0x66, 0xe0, 0x9b, 0xca, 0xbe,
+	0x1a, 0xa5, 0x06, 0x7d, 0x42, 0x1b, 0xdc, 0xc3, 0x8f, 0x03, 0x12, 0x84, 0x1e, 0x40, 0xcb, 0x70,
+	0xf8, 0xa9, 0xdd, 0xda, 0xf0, 0x9e, 0xe6, 0xb5, 0xb3, 0xda, 0xa5, 0x19, 0x43, 0x7d, 0xe1, 0x3f,
+	0xa5, 0xa0, 0x98, 0x84, 0x54, 0xae, 0xb9, 0x09, 0xd9, 0xa6, 0xef, 0xb9, 0xe6, 0x26, 0xb5, 0xad,
+	0xcd, 0x40, 0xe0, 0xd3, 0xfa, 0xf2, 0x6e, 0xaf, 0x84, 0xa4, 0x41, 0xb1, 0x49, 0x6c, 0x00, 0xff,
+	0x7a, 0x53, 0x7c, 0xa0, 0x35, 0xc8, 0x04, 0x5e, 0x08, 0x4b, 0x09, 0x58, 0x6e, 0xb7, 0x57, 0x5a,
+	0x94, 0xb0, 0x68, 0x0a, 0x1b, 0xf3, 0x81, 0xa7, 0x20, 0x35, 0x58, 0x0c, 0xbc, 0x80, 0x38, 0xa6,
+	0x1f, 0x72, 0x61, 0xf9, 0x34, 0x27, 0xac, 0x9f, 0xde, 0xed, 0x95, 0x4e, 0x86, 0xc8, 0x41, 0x09,
+	0x6c, 0x1c, 0x13, 0x43, 0x11, 0x7f, 0x86, 0xbe, 0x01, 0x4b, 0x6c, 0xd3, 0xf3, 0x83, 0x26, 0x71,
+	0x1c, 0x73, 0xd3, 0x66, 0x81, 0x67, 0xf9, 0xc4, 0xcd, 0xcf, 0x88, 0xed, 0xbc, 0x5c, 0x1e, 0x1f,
+	0xe0, 0xe5, 0x1a, 0xa5, 0x8f, 0x43, 0x94, 0xde, 0x69, 0x6c, 0xd1, 0x40, 0xc7, 0x7c, 0x73, 0x77,
+	0x7b, 0xa5, 0x82, 0x5c, 0x7a, 0x8c, 0x52, 0x6c, 0xa0, 0x68, 0xf4, 0xcd, 0x68, 0xf0, 0x87, 0x1a,
+	0xa0, 0x51, 0x75, 0x68, 0x0b, 0x8e, 0xb8, 0xa4, 0x6b, 0x46, 0x00, 0xe1, 0xcd, 0x8c, 0x5e, 0xe3,
+	0xab, 0xfc, 0xa5, 0x57, 0xba, 0x38, 0x5d, 0x14, 0xec, 0xf6, 0x4a, 0x39, 0x15, 0x4c, 0x71, 0x65,
+	0xd8, 0x58, 0x70, 0x49, 0x37, 0x5a, 0x12, 0xe5, 0x60, 0xb6, 0xe1, 0x75, 0x5a, 0xd2, 0xf7, 0x33,
+	0x86, 0xfc, 0x88, 0x42, 0xe5, 0xf3, 0x75, 0x46, 0xfd, 0x6d, 0xba, 0x31, 0x9c, 0x2c, 0x89, 0xa1,
+	0xf2, 0x4f, 0x0d, 0x8a, 0x49, 0xc8, 0x57, 0x10, 0x2a, 0x5f, 0x05, 0x88, 0x25, 0x6a, 0x5a, 0xec,
+	0xec, 0xc5, 0xa4, 0x9d, 0xbd, 0x4f, 0x5b, 0x5e, 0x3f, 0x5d, 0xf4, 0x53, 0x6a, 0x57, 0x8f, 0x4b,
+	0xfd, 0xb1, 0x54, 0x34, 0x32, 0x56, 0x94, 0x54, 0x1f, 0xa6, 0xe0, 0xe8, 0x20, 0x90, 0xbb, 0x74,
+	0x83, 0x8f, 0xc8, 0x7d, 0x33, 0xe4, 0x07, 0x2a, 0xc3, 0x7c, 0xd0, 0x35, 0x63, 0xbe, 0xd6, 0x97,
+	0x76, 0x7b, 0xa5, 0x63, 0x8a, 0xbc, 0x9a, 0xc1, 0xc6, 0x5c, 0xd0, 0xbd, 0xc7, 0xff, 0xa1, 0x4f,
+	0x43, 0xba, 0xbd, 0xb6, 0x2a, 0x02, 0x3b, 0xa3, 0x97, 0xf7, 0xb7, 0xf7, 0x06, 0x87, 0x0a, 0x0d,
+	0xd7, 0x57, 0xf3, 0x33, 0x07, 0xd4, 0x70, 0x5d, 0x6a, 0xb8, 0xbd, 0x9a, 0x9f, 0x3d, 0xa0, 0x86,
+	0xdb, 0xab, 0xf8, 0x2e, 0x60, 0x11, 0x0e, 0xeb, 0xb6, 0x4b, 0xdf, 0x15, 0x7b, 0x42, 0x37, 0x3e,
+	0xb3, 0x4d, 0x7d, 0x62, 0x51, 0x51, 0x4c, 0x26, 0x47, 0xd3, 0xbf, 0x35, 0x38, 0x37, 0x11, 0xfe,
+	0x0a, 0x42, 0xca, 0x81, 0x05, 0x22, 0x19, 0x98, 0x4d, 0x1a, 0x05, 0xd5, 0x95, 0x89, 0x41, 0x15,
+	0xa7, 0x5f, 0xa3, 0x54, 0x3f, 0xad, 0x42, 0x6b, 0x49, 0xae, 0x13, 0xd7, 0x87, 0x8d, 0x2c, 0x89,
+	0x0c, 0x64, 0xf8, 0xa7, 0x29, 0xc8, 0x8d, 0x53, 0x91, 0x10, 0x64, 0x37, 0x21, 0x5b, 0x77, 0xbc,
+	0xc6, 0xd6, 0x40, 0x9c, 0xc5, 0x1c, 0x11, 0x9b, 0xc4, 0x06, 0x88, 0x2f, 0x19, 0x6d, 0x9f, 0x82,
+	0xf9, 0xf0, 0xd0, 0x15, 0x21, 0x97, 0xad, 0x9e, 0x2a, 0xcb, 0x53, 0xb9, 0x1c, 0x9e, 0xca, 0xe5,
+	0xfb, 0x4a, 0x40, 0x9f, 0xe7, 0xf4, 0x7f, 0xf4, 0x51, 0x49, 0x33, 0x22, 0x10, 0xfa, 0x3a, 0x2c,
+	0xc5, 0xcc, 0x30, 0xdb, 0xd4, 0xe7, 0x87, 0x97, 0x0a, 0xbe, 0xcf, 0xed, 0xbb, 0x74, 0x15, 0x46,
+	0x3c, 0x13, 0xaa, 0xc4, 0xc6, 0x62, 0xdf, 0x41, 0x8f, 0xa8, 0xff, 0x80, 0x30, 0x7c, 0x41, 0x85,
+	0xc9, 0xfd, 0x9d, 0x16, 0x71, 0xed, 0x46, 0xd2, 0x09, 0xff, 0x41, 0x1a, 0xce, 0x4f, 0x96, 0xfb,
+	0xbf, 0x38, 0xe8, 0xd1, 0xdb, 0x00, 0x6e, 0xc7, 0x09, 0xec, 0xb6, 0x63, 0x53, 0x3f, 0x9f, 0x3a,
+	0x50, 0xf6, 0xc6, 0x34, 0xa0, 0xb7, 0x60, 0xbe, 0xd9, 0x71, 0x9c, 0x16, 0x65, 0xec, 0x80, 0xf5,
+	0x28, 0xc2, 0xf3, 0x54, 0x57, 0xe9, 0xc6, 0x43, 0x23, 0x6d, 0xa8, 0x2f, 0x6c, 0x42, 0x29, 0x6c,
+	0xbe, 0x42, 0x43, 0x78, 0xc8, 0x3b, 0x76, 0x2b, 0xaa, 0x12, 0xa5, 0x31, 0x59, 0x3e, 0x90, 0xcd,
+	0xa7, 0x47, 0xb2, 0xb9, 0x9f, 0xb7, 0xd8, 0x82, 0xb3, 0xc9, 0x0b, 0xa8, 0x7d, 0xbf, 0x07, 0xb3,
+	0x2c, 0xa0, 0xed, 0x70, 0xa7, 0x5f, 0x4f, 0x4a, 0xea, 0x98, 0x8e, 0xc7, 0x01, 0x6d, 0xeb, 0x33,
+	0xdc, 0x1d, 0x86, 0xc4, 0xe2, 0xbf, 0x6b, 0x70, 0x6c, 0x48, 0x20, 0x66, 0xb5, 0x16, 0xb7, 0x3a,
+	0x29, 0xd0, 0x52, 0xff, 0x23, 0x1d, 0xe5, 0x2a, 0x2c, 0x0b, 0x9f, 0xea, 0x3b, 0x6d, 0xc2, 0x98,
+	0x41, 0x82, 0x3d, 0x2b, 0xfa, 0x1f, 0xd2, 0x70, 0x72, 0x04, 0xf2, 0x0a, 0xaa, 0xb8, 0x80, 0xf0,
+	0x0e, 0x31, 0xe8, 0x86, 0xcd, 0xe3, 0x00, 0x44, 0x4d, 0x09, 0x48, 0x40, 0x9c, 0xf5, 0x2e, 0x43,
+	0x77, 0x60, 0xa1, 0x2e, 0x48, 0xd3, 0x0d, 0x81, 0x9a, 0x11, 0xa8, 0x93, 0xfd, 0x32, 0x1e, 0x9f,
+	0xc5, 0x46, 0x36, 0xfc, 0xe4, 0xd8, 0xa7, 0x70, 0x5c, 0x7e, 0xf2, 0x2a, 0xd6, 0xa0, 0xad, 0x80,
+	0x58, 0x54, 0x1d, 0xab, 0x6f, 0xed, 0xbb, 0x36, 0xe6, 0xe3, 0xcb, 0xc5, 0x14, 0x62, 0x63, 0x51,
+	0x8e, 0x3d, 0x8a, 0x86, 0xd0, 0x57, 0x20, 0xe3, 0x32, 0xcb, 0x14, 0xf0, 0xfc, 0xe1, 0xc9, 0x51,
+	0xad, 0x2b, 0xc2, 0x0f, 0x99, 0xb5, 0xbe, 0xd3, 0xa6, 0x7a, 0x5e, 0x9d, 0x52, 0xca, 0x29, 0x91,
+	0x1e, 0x6c, 0xcc, 0xbb, 0x52, 0x84, 0x61, 0x1f, 0x8e, 0x0d, 0xc1, 0xd0, 0x6d, 0x58, 0x08, 0x45,
+	0xcd, 0x8e, 0x1f, 0x76, 0xaf, 0x31, 0x3f, 0xc5, 0x67, 0xb1, 0x01, 0x4a, 0xd7, 0x3b, 0xbe, 0x83,
+	0x16, 0x21, 0xcd, 0x3d, 0x2b, 0x5b, 0x51, 0xfe, 0x17, 0x21, 0x98, 0x71, 0x99, 0xa5, 0xb6, 0xc8,
+	0x10, 0xff, 0x71, 0x0e, 0x90, 0x08, 0xa1, 0x47, 0xc4, 0x27, 0x6e, 0x54, 0xdc, 0x1f, 0xc3, 0xd2,
+	0xc0, 0xa8, 0x0a, 0xaa, 0xbb, 0x70, 0xb8, 0x2d, 0x46, 0x04, 0x8f, 0x6c, 0xb5, 0x98, 0x64, 0xbd,
+	0xc4, 0xa9, 0x54, 0x56, 0x18, 0xbe, 0xd4, 0xbb, 0x24, 0x68, 0x6c, 0x0e, 0x2e, 0xb5, 0x05, 0x4b,
+	0x03, 0xa3, 0x1f, 0xc7, 0x52, 0xb1, 0x12, 0x91, 0x1a, 0x28, 0x8c, 0x39, 0x40, 0x0f, 0xa9, 0xdb,
+	0xf6, 0x3c, 0x87, 0x37, 0x04, 0x21, 0x85, 0x1f, 0xa4, 0x61, 0x69, 0x60, 0x58, 0x71, 0x88, 0x77,
+	0x99, 0xda, 0x14, 0x5d, 0xe6, 0x4d, 0xc8, 0xca, 0x60, 0xaf, 0xef, 0x04, 0x94, 0xe5, 0x53, 0xc3,
+	0x39, 0x17, 0x9b, 0xc4, 0x06, 0x88, 0x2f, 0x9d, 0x7f, 0xa0, 0xcf, 0xc2, 0x22, 0x23, 0x6e, 0xdb,
+	0x11, 0xe1, 0xae, 0x16, 0x1c, 0xb9, 0x84, 0x0d, 0x4b, 0x60, 0xe3, 0xa8, 0x1a, 0x5a, 0x57, 0xeb,
+	0x3f, 0x80, 0xe3, 0x5f, 0xa3, 0xbe, 0x27, 0x0e, 0xf8, 0x48, 0x8f, 0xcc, 0xac, 0x33, 0xfd, 0x50,
+	0x1f, 0x11, 0xc1, 0xc6, 0x51, 0x3e, 0x56, 0xa3, 0x34, 0x54, 0xf4, 0x2d, 0x0d, 0x72, 0x51, 0x6d,
+	0xeb, 0x5f, 0xbc, 0x58, 0x7e, 0x56, 0x04, 0x7d, 0x79, 0xaa, 0xa6, 0x3f, 0xba, 0x9a, 0xe9, 0xe7,
+	0x54, 0xec, 0x9f, 0x1e, 0x6a, 0xfe, 0x63, 0x9a, 0xb1, 0x81, 0xac, 0x61, 0x1c, 0xc3, 0xbf, 0xd2,
+	0x60, 0x79, 0xbc, 0xce, 0x84, 0x96, 0xad, 0x06, 0x73, 0x75, 0x71, 0xef, 0x0b, 0xcb, 0x7e, 0xe2,
+	0xfd, 0x24, 0xd4, 0xa8, 0x6e, 0x9d, 0x32, 0x7c, 0x42, 0x30, 0xd2, 0xe1, 0x18, 0xa9, 0x7b, 0xdb,
+	0xd4, 0x74, 0x89, 0x72, 0x92, 0xda, 0x8f, 0xc2, 0x6e, 0xaf, 0xb4, 0x2c, 0xcd, 0x18, 0x12, 0xc0,
+	0xc6, 0x11, 0x31, 0xf2, 0x90, 0x48, 0x27, 0xe2, 0xef, 0x69, 0x70, 0x74, 0x70, 0x15, 0xf4, 0x44,
+	0x5e, 0x46, 0x23, 0x07, 0x7c, 0x1c, 0x97, 0xd1, 0x48, 0x19, 0x36, 0xb2, 0x2e, 0xe9, 0x86, 0x2b,
+	0x26, 0xdc, 0x45, 0xb1, 0x3a, 0xb8, 0x65, 0x9d, 0x79, 0x68, 0xb7, 0x6a, 0x94, 0xaa, 0x5a, 0x13,
+	0xa5, 0xc3, 0x13, 0xf8, 0xc4, 0x04, 0x19, 0x95, 0x1b, 0x6b, 0xf1, 0x5a, 0xc8, 0x4f, 0xf8, 0x4c,
+	0xbc, 0xe6, 0x8f, 0x2b, 0x6f, 0xbc, 0xfc, 0x6c, 0x12, 0xb6, 0x29, 0x7b, 0x28, 0x43, 0xfc, 0xc7,
+	0x05, 0xc8, 0x8b, 0xb5, 0xee, 0x39, 0x36, 0x6d, 0x05, 0xf7, 0xbc, 0x56, 0xd3, 0xb6, 0x42, 0x1e,
+	0xcf, 0xe6, 0xe0, 0xd4, 0x98, 0x49, 0x45, 0x20, 0x0f, 0x73, 0xdb, 0xd4, 0x67, 0xbc, 0xc7, 0xe6,
+	0x5e, 0x3c, 0x62, 0x84, 0x9f, 0xe8, 0xc3, 0x83, 0xf7, 0x01, 0x8f, 0x54, 0xa4, 0xfe, 0x37, 0x1b,
+	0xca, 0x9f, 0x6b, 0x90, 0x77, 0xbc, 0x06, 0x71, 0xcc, 0x31, 0x24, 0xd3, 0x53, 0x90, 0xfc, 0xa2,
+	0x22, 0x59, 0x92, 0x24, 0x93, 0x74, 0xed, 0x9b, 0xea, 0x09, 0xa1, 0x69, 0xb8, 0x8f, 0x47, 0x6f,
+	0x00, 0xf0, 0x6a, 0x21, 0x12, 0x8c, 0x89, 0x07, 0x9d, 0x8c, 0x7e, 0xa2, 0x7f, 0x95, 0xef, 0xcf,
+	0x61, 0x23, 0xd3, 0xa4, 0x54, 0xe4, 0x2b, 0x43, 0xdf, 0xd4, 0x60, 0xc1, 0xb5, 0x5b, 0x66, 0xd3,
+	0x21, 0x01, 0xaf, 0x36, 0xaa, 0x74, 0x9c, 0x1a, 0x6b, 0x99, 0x30, 0xeb, 0xc1, 0xe0, 0x3d, 0x2e,
+	0x0e, 0xe6, 0xa6, 0x5c, 0x9a, 0xc2, 0x14, 0x69, 0x07, 0xb8, 0x76, 0xab, 0xe6, 0x90, 0x80, 0xdf,
+	0xec, 0xbe, 0x0c, 0x79, 0x75, 0xb4, 0x0b, 0x7d, 0x94, 0x9a, 0x83, 0x27, 0x78, 0x46, 0x3f, 0xd7,
+	0xf7, 0x64, 0x92, 0x24, 0x36, 0x72, 0xf5, 0x31, 0xb9, 0x80, 0xde, 0xd7, 0xe0, 0x22, 0x4f, 0xc1,
+	0xb0, 0xae, 0x8f, 0xa0, 0xf9, 0x66, 0x74, 0x18, 0x6f, 0x4f, 0xe6, 0x44, 0xf5, 0x58, 0xdb, 0xed,
+	0x95, 0xae, 0xf6, 0x53, 0x77, 0x6f, 0x1c, 0x36, 0x4a, 0x2e, 0xe9, 0xae, 0xcb, 0x93, 0x62, 0x80,
+	0xc1, 0x03, 0xc2, 0xde, 0xe1, 0x12, 0x68, 0x13, 0x8e, 0x86, 0x90, 0xa6, 0xe3, 0x79, 0x3e, 0xcb,
+	0xcf, 0x0b, 0x4f, 0x9f, 0x4b, 0xec, 0xb7, 0x05, 0xb8, 0xc6, 0x65, 0xf5, 0xd7, 0x94, 0xcf, 0x4f,
+	0xf4, 0xd3, 0xb6, 0xaf, 0x88, 0xbf, 0x6e, 0xf5, 0x65, 0x99, 0xe8, 0x45, 0x38, 0xeb, 0xae, 0x3a,
+	0xdf, 0x32, 0xc3, 0x3d, 0x5b, 0x7c, 0x96, 0xf7, 0x22, 0xa4, 0xbb, 0xde, 0x15, 0x07, 0x5c, 0xf5,
+	0x37, 0x59, 0x98, 0x15, 0xa9, 0x8c, 0x7e, 0xa1, 0xc1, 0xe2, 0x68, 0x88, 0x25, 0x71, 0x9d, 0xf4,
+	0xc6, 0x5c, 0xb8, 0xbe, 0x4f, 0x94, 0x2c, 0x1c, 0xb8, 0xfa, 0xfe, 0x1f, 0xff, 0xf6, 0xfd, 0xd4,
+	0x15, 0x74, 0xb9, 0x92, 0xf0, 0xd2, 0x3d, 0x9a, 0x4a, 0xe8, 0x97, 0x1a, 0x1c, 0x1f, 0x79, 0xaf,
+	0x45, 0x93, 0x09, 0x24, 0xbd, 0x0c, 0x17, 0x6e, 0xec, 0x17, 0xa6, 0x88, 0x5f, 0x13, 0xc4, 0xaf,
+	0xa2, 0x95, 0x24, 0xe2, 0x3c, 0x7c, 0xa2, 0x47, 0x5a, 0x93, 0x09, 0x8e, 0x9c, 0xf9, 0xc8, 0xf3,
+	0xe1, 0x1e, 0xcc, 0x93, 0x1e, 0x2a, 0x0b, 0x37, 0xf6, 0x0b, 0x9b, 0x96, 0xb9, 0xa7, 0xa0, 0x71,
+	0x9f, 0xff, 0x5e, 0x83, 0xe5, 0xf1, 0x4f, 0x55, 0xe8, 0xce, 0x44, 0x1e, 0x13, 0x9f, 0xc7, 0x0a,
+	0x9f, 0x3c, 0x10, 0x56, 0x19, 0x72, 0x5b, 0x18, 0x72, 0x0d, 0xad, 0x25, 0x19, 0x12, 0xd8, 0x2e,
+	0x35, 0x9f, 0x2a, 0x05, 0x66, 0xec, 0xc5, 0x05, 0x3d, 0xd7, 0xe0, 0x64, 0xc2, 0x53, 0x09, 0x9a,
+	0xcc, 0x69, 0xf2, 0x43, 0x4c, 0xe1, 0xee, 0xc1, 0xc0, 0xca, 0xa2, 0x3b, 0xc2, 0xa2, 0x37, 0x50,
+	0x35, 0xc9, 0xa2, 0x0d, 0xa9, 0x60, 0xcc, 0x01, 0x83, 0x7e, 0xab, 0xc1, 0xd2, 0x98, 0x17, 0x00,
+	0x74, 0x73, 0xaf, 0xc4, 0x4c, 0x78, 0x94, 0x28, 0xdc, 0xda, 0x3f, 0x50, 0x99, 0x71, 0x43, 0x98,
+	0xb1, 0x8a, 0xca, 0x13, 0x92, 0xba, 0x4f, 0xdd, 0x0c, 0x42, 0xaa, 0x3f, 0xd6, 0x00, 0xfa, 0xb7,
+	0x67, 0x54, 0x9e, 0x48, 0x60, 0xe4, 0x66, 0x5e, 0xa8, 0x4c, 0x2d, 0xaf, 0x78, 0xae, 0x08, 0x9e,
+	0x17, 0xd0, 0xb9, 0x24, 0x9e, 0xea, 0x34, 0xf0, 0x39, 0x9b, 0x6f, 0x6b, 0x70, 0x58, 0x5e, 0x6f,
+	0xd0, 0xe5, 0x89, 0x0b, 0x0d, 0xdc, 0xa8, 0x0a, 0x2b, 0x53, 0xc9, 0x2a, 0x42, 0x17, 0x05, 0xa1,
+	0xb3, 0xa8, 0x98, 0x44, 0x48, 0xde, 0xa8, 0xaa, 0x0e, 0xcc, 0x8a, 0x6b, 0x1a, 0x6a, 0xec, 0xcd,
+	0x69, 0xf4, 0x96, 0x57, 0x58, 0x99, 0x4a, 0x56, 0x72, 0x5a, 0xd5, 0xaa, 0x1f, 0x68, 0x30, 0xa7,
+	0x6e, 0x64, 0xe8, 0x3b, 0x1a, 0xcc, 0xf0, 0x6b, 0x59, 0xf2, 0x7a, 0xa3, 0x57, 0xba, 0xc2, 0xca,
+	0x54, 0xb2, 0xca, 0x07, 0x57, 0x84, 0x0f, 0x2e, 0xa2, 0xf3, 0x89, 0xc1, 0x23, 0x41, 0xe2, 0x4d,
+	0xb9, 0xfa, 0xaf, 0x14, 0xc0, 0xdb, 0xde, 0x06, 0x95, 0xfd, 0x28, 0xfa, 0x9d, 0x06, 0xb9, 0x71,
+	0x9d, 0x32, 0xba, 0x35, 0x45, 0x6c, 0x8c, 0x6d, 0xc0, 0x0b, 0xb7, 0x0f, 0x80, 0x54, 0xa6, 0xdc,
+	0x12, 0xa6, 0x54, 0xd1, 0xea, 0x1e, 0xf1, 0x35, 0xd2, 0xe3, 0xa0, 0x9f, 0x68, 0xb0, 0x10, 0x6f,
+	0xb4, 0xd1, 0xea, 0x44, 0x16, 0x63, 0x1a, 0xf6, 0xc2, 0xda, 0x3e, 0x10, 0x8a, 0xef, 0x55, 0xc1,
+	0xf7, 0x75, 0x74, 0x21, 0x89, 0x6f, 0x43, 0xa0, 0xcc, 0x86, 0x80, 0xe9, 0xfa, 0xb3, 0x17, 0x45,
+	0xed, 0xf9, 0x8b, 0xa2, 0xf6, 0xd7, 0x17, 0x45, 0xed, 0xbb, 0x2f, 0x8b, 0x87, 0x9e, 0xbf, 0x2c,
+	0x1e, 0xfa, 0xf3, 0xcb, 0xe2, 0xa1, 0x2f, 0x8d, 0x69, 0x0f, 0x85, 0xc6, 0x6e, 0x4c, 0xa7, 0x30,
+	0xb4, 0x7e, 0x58, 0xbc, 0xc1, 0x5f, 0xfb, 0xcf, 0x00, 0xb0, 0xb4, 0x81, 0x8f, 0x99, 0x1f, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error)
+	// FeeRejectionStats returns the txs rejected by this node for insufficient
+	// fees over the most recent blocks. The stats are node local and not part
+	// of the consensus state.
+	FeeRejectionStats(ctx context.Context, in *QueryFeeRejectionStatsRequest, opts ...grpc.CallOption) (*QueryFeeRejectionStatsResponse, error)
+	// ObservedGasPrices returns the percentiles of the gas prices paid by the
+	// txs of the most recent blocks, per fee denom. The gas prices are node
+	// local and not part of the consensus state.
+	ObservedGasPrices(ctx context.Context, in *QueryObservedGasPricesRequest, opts ...grpc.CallOption) (*QueryObservedGasPricesResponse, error)
+	// TimeWeightedAverageFee returns the average fee per gas of the most recent
+	// blocks weighted by their block time, per fee denom. The fees are node
+	// local and not part of the consensus state.
+	TimeWeightedAverageFee(ctx context.Context, in *QueryTimeWeightedAverageFeeRequest, opts ...grpc.CallOption) (*QueryTimeWeightedAverageFeeResponse, error)
+	// DynamicMinimumGasPrices returns the minimum gas prices scaled by the
+	// dynamic multiplier derived from the fullness of the recent blocks. The
+	// multiplier is part of the consensus state of the module.
+	DynamicMinimumGasPrices(ctx context.Context, in *QueryDynamicMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryDynamicMinimumGasPricesResponse, error)
+	// MinGasPriceTimeline returns the steps of the effective minimum gas prices,
+	// i.e. the minimum gas prices scaled by the dynamic multiplier, over a
+	// range of heights. The timeline is node local and not part of the
+	// consensus state.
+	MinGasPriceTimeline(ctx context.Context, in *QueryMinGasPriceTimelineRequest, opts ...grpc.CallOption) (*QueryMinGasPriceTimelineResponse, error)
+	// BypassRate returns the txs delivered by this node over the most recent
+	// blocks which bypassed the minimum fees, i.e. made only of bypass message
+	// types within the bypass gas limit, per message type. The rate is node
+	// local and not part of the consensus state.
+	BypassRate(ctx context.Context, in *QueryBypassRateRequest, opts ...grpc.CallOption) (*QueryBypassRateResponse, error)
+	// Params returns the globalfee module params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error) {
+	out := new(QueryMinimumGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/gaia.globalfee.v1beta1.Query/MinimumGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeRejectionStats(ctx context.Context, in *QueryFeeRejectionStatsRequest, opts ...grpc.CallOption) (*QueryFeeRejectionStatsResponse, error) {
+	out := new(QueryFeeRejectionStatsResponse)
+	err := c.cc.Invoke(ctx, "/gaia.globalfee.v1beta1.Query/FeeRejectionStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ObservedGasPrices(ctx context.Context, in *QueryObservedGasPricesRequest, opts ...grpc.CallOption) (*QueryObservedGasPricesResponse, error) {
+	out := new(QueryObservedGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/gaia.globalfee.v1beta1.Query/ObservedGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimeWeightedAverageFee(ctx context.Context, in *QueryTimeWeightedAverageFeeRequest, opts ...grpc.CallOption) (*QueryTimeWeightedAverageFeeResponse, error) {
+	out := new(QueryTimeWeightedAverageFeeResponse)
+	err := c.cc.Invoke(ctx, "/gaia.globalfee.v1beta1.Query/TimeWeightedAverageFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DynamicMinimumGasPrices(ctx context.Context, in *QueryDynamicMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryDynamicMinimumGasPricesResponse, error) {
+	out := new(QueryDynamicMinimumGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/gaia.globalfee.v1beta1.Query/DynamicMinimumGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinGasPriceTimeline(ctx context.Context, in *QueryMinGasPriceTimelineRequest, opts ...grpc.CallOption) (*QueryMinGasPriceTimelineResponse, error) {
+	out := new(QueryMinGasPriceTimelineResponse)
+	err := c.cc.Invoke(ctx, "/gaia.globalfee.v1beta1.Query/MinGasPriceTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BypassRate(ctx context.Context, in *QueryBypassRateRequest, opts ...grpc.CallOption) (*QueryBypassRateResponse, error) {
+	out := new(QueryBypassRateResponse)
+	err := c.cc.Invoke(ctx, "/gaia.globalfee.v1beta1.Query/BypassRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/gaia.globalfee.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	MinimumGasPrices(context.Context, *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error)
+	// FeeRejectionStats returns the txs rejected by this node for insufficient
+	// fees over the most recent blocks. The stats are node local and not part
+	// of the consensus state.
+	FeeRejectionStats(context.Context, *QueryFeeRejectionStatsRequest) (*QueryFeeRejectionStatsResponse, error)
+	// ObservedGasPrices returns the percentiles of the gas prices paid by the
+	// txs of the most recent blocks, per fee denom. The gas prices are node
+	// local and not part of the consensus state.
+	ObservedGasPrices(context.Context, *QueryObservedGasPricesRequest) (*QueryObservedGasPricesResponse, error)
+	// TimeWeightedAverageFee returns the average fee per gas of the most recent
+	// blocks weighted by their block time, per fee denom. The fees are node
+	// local and not part of the consensus state.
+	TimeWeightedAverageFee(context.Context, *QueryTimeWeightedAverageFeeRequest) (*QueryTimeWeightedAverageFeeResponse, error)
+	// DynamicMinimumGasPrices returns the minimum gas prices scaled by the
+	// dynamic multiplier derived from the fullness of the recent blocks. The
+	// multiplier is part of the consensus state of the module.
+	DynamicMinimumGasPrices(context.Context, *QueryDynamicMinimumGasPricesRequest) (*QueryDynamicMinimumGasPricesResponse, error)
+	// MinGasPriceTimeline returns the steps of the effective minimum gas prices,
+	// i.e. the minimum gas prices scaled by the dynamic multiplier, over a
+	// range of heights. The timeline is node local and not part of the
+	// consensus state.
+	MinGasPriceTimeline(context.Context, *QueryMinGasPriceTimelineRequest) (*QueryMinGasPriceTimelineResponse, error)
+	// BypassRate returns the txs delivered by this node over the most recent
+	// blocks which bypassed the minimum fees, i.e. made only of bypass message
+	// types within the bypass gas limit, per message type. The rate is node
+	// local and not part of the consensus state.
+	BypassRate(context.Context, *QueryBypassRateRequest) (*QueryBypassRateResponse, error)
+	// Params returns the globalfee module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) MinimumGasPrices(ctx context.Context, req *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinimumGasPrices not implemented")
+}
+func (*UnimplementedQueryServer) FeeRejectionStats(ctx context.Context, req *QueryFeeRejectionStatsRequest) (*QueryFeeRejectionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRejectionStats not implemented")
+}
+func (*UnimplementedQueryServer) ObservedGasPrices(ctx context.Context, req *QueryObservedGasPricesRequest) (*QueryObservedGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObservedGasPrices not implemented")
+}
+func (*UnimplementedQueryServer) TimeWeightedAverageFee(ctx context.Context, req *QueryTimeWeightedAverageFeeRequest) (*QueryTimeWeightedAverageFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeWeightedAverageFee not implemented")
+}
+func (*UnimplementedQueryServer) DynamicMinimumGasPrices(ctx context.Context, req *QueryDynamicMinimumGasPricesRequest) (*QueryDynamicMinimumGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamicMinimumGasPrices not implemented")
+}
+func (*UnimplementedQueryServer) MinGasPriceTimeline(ctx context.Context, req *QueryMinGasPriceTimelineRequest) (*QueryMinGasPriceTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinGasPriceTimeline not implemented")
+}
+func (*UnimplementedQueryServer) BypassRate(ctx context.Context, req *QueryBypassRateRequest) (*QueryBypassRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BypassRate not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MinimumGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinimumGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinimumGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.globalfee.v1beta1.Query/MinimumGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinimumGasPrices(ctx, req.(*QueryMinimumGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRejectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRejectionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRejectionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.globalfee.v1beta1.Query/FeeRejectionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRejectionStats(ctx, req.(*QueryFeeRejectionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ObservedGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObservedGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObservedGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.globalfee.v1beta1.Query/ObservedGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObservedGasPrices(ctx, req.(*QueryObservedGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimeWeightedAverageFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimeWeightedAverageFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimeWeightedAverageFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.globalfee.v1beta1.Query/TimeWeightedAverageFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimeWeightedAverageFee(ctx, req.(*QueryTimeWeightedAverageFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DynamicMinimumGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDynamicMinimumGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DynamicMinimumGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.globalfee.v1beta1.Query/DynamicMinimumGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DynamicMinimumGasPrices(ctx, req.(*QueryDynamicMinimumGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinGasPriceTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinGasPriceTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinGasPriceTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.globalfee.v1beta1.Query/MinGasPriceTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinGasPriceTimeline(ctx, req.(*QueryMinGasPriceTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BypassRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBypassRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BypassRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.globalfee.v1beta1.Query/BypassRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BypassRate(ctx, req.(*QueryBypassRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.globalfee.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.globalfee.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MinimumGasPrices",
+			Handler:    _Query_MinimumGasPrices_Handler,
+		},
+		{
+			MethodName: "FeeRejectionStats",
+			Handler:    _Query_FeeRejectionStats_Handler,
+		},
+		{
+			MethodName: "ObservedGasPrices",
+			Handler:    _Query_ObservedGasPrices_Handler,
+		},
+		{
+			MethodName: "TimeWeightedAverageFee",
+			Handler:    _Query_TimeWeightedAverageFee_Handler,
+		},
+		{
+			MethodName: "DynamicMinimumGasPrices",
+			Handler:    _Query_DynamicMinimumGasPrices_Handler,
+		},
+		{
+			MethodName: "MinGasPriceTimeline",
+			Handler:    _Query_MinGasPriceTimeline_Handler,
+		},
+		{
+			MethodName: "BypassRate",
+			Handler:    _Query_BypassRate_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/globalfee/v1beta1/query.proto",
+}
+
+// WatchClient is the client API for Watch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WatchClient interface {
+	// Params sends the current globalfee params on subscription and then the
+	// params each time they are changed.
+	Params(ctx context.Context, in *WatchParamsRequest, opts ...grpc.CallOption) (Watch_ParamsClient, error)
+}
+
+type watchClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewWatchClient(cc grpc1.ClientConn) WatchClient {
+	return &watchClient{cc}
+}
+
+func (c *watchClient) Params(ctx context.Context, in *WatchParamsRequest, opts ...grpc.CallOption) (Watch_ParamsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Watch_serviceDesc.Streams[0], "/gaia.globalfee.v1beta1.Watch/Params", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchParamsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Watch_ParamsClient interface {
+	Recv() (*WatchParamsResponse, error)
+	grpc.ClientStream
+}
+
+type watchParamsClient struct {
+	grpc.ClientStream
+}
+
+func (x *watchParamsClient) Recv() (*WatchParamsResponse, error) {
+	m := new(WatchParamsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WatchServer is the server API for Watch service.
+type WatchServer interface {
+	// Params sends the current globalfee params on subscription and then the
+	// params each time they are changed.
+	Params(*WatchParamsRequest, Watch_ParamsServer) error
+}
+
+// UnimplementedWatchServer can be embedded to have forward compatible implementations.
+type UnimplementedWatchServer struct {
+}
+
+func (*UnimplementedWatchServer) Params(req *WatchParamsRequest, srv Watch_ParamsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterWatchServer(s grpc1.Server, srv WatchServer) {
+	s.RegisterService(&_Watch_serviceDesc, srv)
+}
+
+func _Watch_Params_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchParamsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchServer).Params(m, &watchParamsServer{stream})
+}
+
+type Watch_ParamsServer interface {
+	Send(*WatchParamsResponse) error
+	grpc.ServerStream
+}
+
+type watchParamsServer struct {
+	grpc.ServerStream
+}
+
+func (x *watchParamsServer) Send(m *WatchParamsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Watch_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.globalfee.v1beta1.Watch",
+	HandlerType: (*WatchServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Params",
+			Handler:       _Watch_Params_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "gaia/globalfee/v1beta1/query.proto",
+}
+
+// MempoolClient is the client API for Mempool service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MempoolClient interface {
+	// Fees returns the number of txs pending in the mempool of this node and
+	// the histogram of their gas prices, per fee denom.
+	Fees(ctx context.Context, in *MempoolFeesRequest, opts ...grpc.CallOption) (*MempoolFeesResponse, error)
+}
+
+type mempoolClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMempoolClient(cc grpc1.ClientConn) MempoolClient {
+	return &mempoolClient{cc}
+}
+
+func (c *mempoolClient) Fees(ctx context.Context, in *MempoolFeesRequest, opts ...grpc.CallOption) (*MempoolFeesResponse, error) {
+	out := new(MempoolFeesResponse)
+	err := c.cc.Invoke(ctx, "/gaia.globalfee.v1beta1.Mempool/Fees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MempoolServer is the server API for Mempool service.
+type MempoolServer interface {
+	// Fees returns the number of txs pending in the mempool of this node and
+	// the histogram of their gas prices, per fee denom.
+	Fees(context.Context, *MempoolFeesRequest) (*MempoolFeesResponse, error)
+}
+
+// UnimplementedMempoolServer can be embedded to have forward compatible implementations.
+type UnimplementedMempoolServer struct {
+}
+
+func (*UnimplementedMempoolServer) Fees(ctx context.Context, req *MempoolFeesRequest) (*MempoolFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fees not implemented")
+}
+
+func RegisterMempoolServer(s grpc1.Server, srv MempoolServer) {
+	s.RegisterService(&_Mempool_serviceDesc, srv)
+}
+
+func _Mempool_Fees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MempoolFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServer).Fees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.globalfee.v1beta1.Mempool/Fees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServer).Fees(ctx, req.(*MempoolFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Mempool_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.globalfee.v1beta1.Mempool",
+	HandlerType: (*MempoolServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Fees",
+			Handler:    _Mempool_Fees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/globalfee/v1beta1/query.proto",
+}
+
+// NodeConfigClient is the client API for NodeConfig service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeConfigClient interface {
+	// BypassMinFeeMsgTypes returns the bypass-min-fee-msg-types of this node
+	// and their hash, so that the config of several nodes can be compared.
+	BypassMinFeeMsgTypes(ctx context.Context, in *QueryBypassMinFeeMsgTypesRequest, opts ...grpc.CallOption) (*QueryBypassMinFeeMsgTypesResponse, error)
+	// ClientConfig returns in a single call the fee policy a client needs to
+	// construct a TX accepted by this node, combining the globalfee params and
+	// the node config.
+	ClientConfig(ctx context.Context, in *QueryClientConfigRequest, opts ...grpc.CallOption) (*QueryClientConfigResponse, error)
+}
+
+type nodeConfigClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewNodeConfigClient(cc grpc1.ClientConn) NodeConfigClient {
+	return &nodeConfigClient{cc}
+}
+
+func (c *nodeConfigClient) BypassMinFeeMsgTypes(ctx context.Context, in *QueryBypassMinFeeMsgTypesRequest, opts ...grpc.CallOption) (*QueryBypassMinFeeMsgTypesResponse, error) {
+	out := new(QueryBypassMinFeeMsgTypesResponse)
+	err := c.cc.Invoke(ctx, "/gaia.globalfee.v1beta1.NodeConfig/BypassMinFeeMsgTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeConfigClient) ClientConfig(ctx context.Context, in *QueryClientConfigRequest, opts ...grpc.CallOption) (*QueryClientConfigResponse, error) {
+	out := new(QueryClientConfigResponse)
+	err := c.cc.Invoke(ctx, "/gaia.globalfee.v1beta1.NodeConfig/ClientConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeConfigServer is the server API for NodeConfig service.
+type NodeConfigServer interface {
+	// BypassMinFeeMsgTypes returns the bypass-min-fee-msg-types of this node
+	// and their hash, so that the config of several nodes can be compared.
+	BypassMinFeeMsgTypes(context.Context, *QueryBypassMinFeeMsgTypesRequest) (*QueryBypassMinFeeMsgTypesResponse, error)
+	// ClientConfig returns in a single call the fee policy a client needs to
+	// construct a TX accepted by this node, combining the globalfee params and
+	// the node config.
+	ClientConfig(context.Context, *QueryClientConfigRequest) (*QueryClientConfigResponse, error)
+}
+
+// UnimplementedNodeConfigServer can be embedded to have forward compatible implementations.
+type UnimplementedNodeConfigServer struct {
+}
+
+func (*UnimplementedNodeConfigServer) BypassMinFeeMsgTypes(ctx context.Context, req *QueryBypassMinFeeMsgTypesRequest) (*QueryBypassMinFeeMsgTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BypassMinFeeMsgTypes not implemented")
+}
+func (*UnimplementedNodeConfigServer) ClientConfig(ctx context.Context, req *QueryClientConfigRequest) (*QueryClientConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientConfig not implemented")
+}
+
+func RegisterNodeConfigServer(s grpc1.Server, srv NodeConfigServer) {
+	s.RegisterService(&_NodeConfig_serviceDesc, srv)
+}
+
+func _NodeConfig_BypassMinFeeMsgTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBypassMinFeeMsgTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeConfigServer).BypassMinFeeMsgTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.globalfee.v1beta1.NodeConfig/BypassMinFeeMsgTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeConfigServer).BypassMinFeeMsgTypes(ctx, req.(*QueryBypassMinFeeMsgTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeConfig_ClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeConfigServer).ClientConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.globalfee.v1beta1.NodeConfig/ClientConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeConfigServer).ClientConfig(ctx, req.(*QueryClientConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NodeConfig_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.globalfee.v1beta1.NodeConfig",
+	HandlerType: (*NodeConfigServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BypassMinFeeMsgTypes",
+			Handler:    _NodeConfig_BypassMinFeeMsgTypes_Handler,
+		},
+		{
+			MethodName: "ClientConfig",
+			Handler:    _NodeConfig_ClientConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/globalfee/v1beta1/query.proto",
+}
+
+func (m *QueryMinimumGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinimumGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinimumGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinimumGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinimumGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinimumGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRejectionStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRejectionStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRejectionStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRejectionStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRejectionStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRejectionStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShortfallHistogram) > 0 {
+		for iNdEx := len(m.ShortfallHistogram) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortfallHistogram[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalRejections != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalRejections))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeShortfallBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeShortfallBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeShortfallBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxShortfall.Size()
+		i -= size
+		if _, err := m.MaxShortfall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryObservedGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObservedGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObservedGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryObservedGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObservedGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObservedGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomGasPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomGasPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.P90.Size()
+		i -= size
+		if _, err := m.P90.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.P50.Size()
+		i -= size
+		if _, err := m.P50.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.P10.Size()
+		i -= size
+		if _, err := m.P10.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeWeightedAverageFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeWeightedAverageFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeWeightedAverageFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeWeightedAverageFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeWeightedAverageFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeWeightedAverageFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AverageFees) > 0 {
+		for iNdEx := len(m.AverageFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AverageFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTimeWeightedFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTimeWeightedFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTimeWeightedFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AverageFeePerGas.Size()
+		i -= size
+		if _, err := m.AverageFeePerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDynamicMinimumGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicMinimumGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicMinimumGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDynamicMinimumGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicMinimumGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicMinimumGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Fullness.Size()
+		i -= size
+		if _, err := m.Fullness.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinGasPriceTimelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinGasPriceTimelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinGasPriceTimelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinGasPriceTimelineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinGasPriceTimelineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinGasPriceTimelineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinGasPriceStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinGasPriceStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinGasPriceStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBypassRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBypassRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBypassRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBypassRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBypassRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBypassRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.BypassPercentage.Size()
+		i -= size
+		if _, err := m.BypassPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BypassedTxs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BypassedTxs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalTxs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalTxs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BypassedMsgType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BypassedMsgType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BypassedMsgType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msgs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Msgs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Txs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MempoolFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MempoolFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MempoolFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MempoolFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPriceHistograms) > 0 {
+		for iNdEx := len(m.GasPriceHistograms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPriceHistograms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ZeroFeeTxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ZeroFeeTxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SampledTxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SampledTxCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomGasPriceHistogram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomGasPriceHistogram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomGasPriceHistogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AboveMaxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AboveMaxCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxGasPrice.Size()
+		i -= size
+		if _, err := m.MaxGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBypassMinFeeMsgTypesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBypassMinFeeMsgTypesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBypassMinFeeMsgTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBypassMinFeeMsgTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBypassMinFeeMsgTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBypassMinFeeMsgTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTxBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxTxBytes))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MsgGasFloors) > 0 {
+		for iNdEx := len(m.MsgGasFloors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGasFloors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for iNdEx := len(m.BypassMinFeeMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BypassMinFeeMsgTypes[iNdEx])
+			copy(dAtA[i:], m.BypassMinFeeMsgTypes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.BypassMinFeeMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MinFlatFee) > 0 {
+		for iNdEx := len(m.MinFlatFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFlatFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeDenoms[iNdEx])
+			copy(dAtA[i:], m.FeeDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LocalMinimumGasPrices) > 0 {
+		for iNdEx := len(m.LocalMinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LocalMinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMinimumGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinimumGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeRejectionStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryFeeRejectionStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.TotalRejections != 0 {
+		n += 1 + sovQuery(uint64(m.TotalRejections))
+	}
+	if len(m.ShortfallHistogram) > 0 {
+		for _, e := range m.ShortfallHistogram {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeShortfallBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxShortfall.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryObservedGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryObservedGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovQuery(uint64(m.TxCount))
+	}
+	l = m.P10.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.P50.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.P90.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTimeWeightedAverageFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryTimeWeightedAverageFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if len(m.AverageFees) > 0 {
+		for _, e := range m.AverageFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomTimeWeightedFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AverageFeePerGas.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDynamicMinimumGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDynamicMinimumGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fullness.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryMinGasPriceTimelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *QueryMinGasPriceTimelineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinGasPriceStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBypassRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryBypassRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.TotalTxs != 0 {
+		n += 1 + sovQuery(uint64(m.TotalTxs))
+	}
+	if m.BypassedTxs != 0 {
+		n += 1 + sovQuery(uint64(m.BypassedTxs))
+	}
+	l = m.BypassPercentage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.MsgTypes) > 0 {
+		for _, e := range m.MsgTypes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BypassedMsgType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Txs != 0 {
+		n += 1 + sovQuery(uint64(m.Txs))
+	}
+	if m.Msgs != 0 {
+		n += 1 + sovQuery(uint64(m.Msgs))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *WatchParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WatchParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MempoolFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MempoolFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxCount != 0 {
+		n += 1 + sovQuery(uint64(m.TxCount))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovQuery(uint64(m.TotalBytes))
+	}
+	if m.SampledTxCount != 0 {
+		n += 1 + sovQuery(uint64(m.SampledTxCount))
+	}
+	if m.ZeroFeeTxCount != 0 {
+		n += 1 + sovQuery(uint64(m.ZeroFeeTxCount))
+	}
+	if len(m.GasPriceHistograms) > 0 {
+		for _, e := range m.GasPriceHistograms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomGasPriceHistogram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AboveMaxCount != 0 {
+		n += 1 + sovQuery(uint64(m.AboveMaxCount))
+	}
+	return n
+}
+
+func (m *GasPriceBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryBypassMinFeeMsgTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBypassMinFeeMsgTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryClientConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.LocalMinimumGasPrices) > 0 {
+		for _, e := range m.LocalMinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FeeDenoms) > 0 {
+		for _, s := range m.FeeDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MinFlatFee) > 0 {
+		for _, e := range m.MinFlatFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for _, s := range m.BypassMinFeeMsgTypes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		n += 1 + sovQuery(uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+	}
+	if len(m.MsgGasFloors) > 0 {
+		for _, e := range m.MsgGasFloors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxTxBytes != 0 {
+		n += 1 + sovQuery(uint64(m.MaxTxBytes))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRejectionStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRejectionStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRejectionStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRejectionStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRejectionStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRejectionStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRejections", wireType)
+			}
+			m.TotalRejections = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRejections |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortfallHistogram", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortfallHistogram = append(m.ShortfallHistogram, FeeShortfallBucket{})
+			if err := m.ShortfallHistogram[len(m.ShortfallHistogram)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeShortfallBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeShortfallBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeShortfallBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxShortfall", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxShortfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObservedGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObservedGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObservedGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObservedGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObservedGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObservedGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, DenomGasPrices{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomGasPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomGasPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P10", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P10.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P50.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P90.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimeWeightedAverageFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeWeightedAverageFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeWeightedAverageFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimeWeightedAverageFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeWeightedAverageFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeWeightedAverageFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AverageFees = append(m.AverageFees, DenomTimeWeightedFee{})
+			if err := m.AverageFees[len(m.AverageFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTimeWeightedFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTimeWeightedFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTimeWeightedFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageFeePerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageFeePerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDynamicMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDynamicMinimumGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDynamicMinimumGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDynamicMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDynamicMinimumGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDynamicMinimumGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fullness", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fullness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinGasPriceTimelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinGasPriceTimelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinGasPriceTimelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinGasPriceTimelineResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinGasPriceTimelineResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinGasPriceTimelineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, MinGasPriceStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinGasPriceStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinGasPriceStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinGasPriceStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBypassRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBypassRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBypassRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBypassRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBypassRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBypassRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTxs", wireType)
+			}
+			m.TotalTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassedTxs", wireType)
+			}
+			m.BypassedTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BypassedTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BypassPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, BypassedMsgType{})
+			if err := m.MsgTypes[len(m.MsgTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BypassedMsgType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BypassedMsgType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BypassedMsgType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			m.Msgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Msgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MempoolFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MempoolFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			}
+			m.TotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampledTxCount", wireType)
+			}
+			m.SampledTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampledTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroFeeTxCount", wireType)
+			}
+			m.ZeroFeeTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZeroFeeTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceHistograms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPriceHistograms = append(m.GasPriceHistograms, DenomGasPriceHistogram{})
+			if err := m.GasPriceHistograms[len(m.GasPriceHistograms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomGasPriceHistogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomGasPriceHistogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomGasPriceHistogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, GasPriceBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AboveMaxCount", wireType)
+			}
+			m.AboveMaxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AboveMaxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBypassMinFeeMsgTypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBypassMinFeeMsgTypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBypassMinFeeMsgTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBypassMinFeeMsgTypesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBypassMinFeeMsgTypesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBypassMinFeeMsgTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			i--
-			dAtA[i] = 0xa
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryMinimumGasPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryMinimumGasPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MinimumGasPrices) > 0 {
-		for _, e := range m.MinimumGasPrices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClientConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -356,10 +7426,10 @@ func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -383,7 +7453,7 @@ func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClientConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -406,13 +7476,32 @@ func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
 			}
@@ -446,6 +7535,210 @@ func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalMinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalMinimumGasPrices = append(m.LocalMinimumGasPrices, types.DecCoin{})
+			if err := m.LocalMinimumGasPrices[len(m.LocalMinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFlatFee = append(m.MinFlatFee, types.Coin{})
+			if err := m.MinFlatFee[len(m.MinFlatFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFeeMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassMinFeeMsgTypes = append(m.BypassMinFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalBypassMinFeeMsgGasUsage", wireType)
+			}
+			m.MaxTotalBypassMinFeeMsgGasUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalBypassMinFeeMsgGasUsage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasFloors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGasFloors = append(m.MsgGasFloors, MsgGasFloor{})
+			if err := m.MsgGasFloors[len(m.MsgGasFloors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxBytes", wireType)
+			}
+			m.MaxTxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
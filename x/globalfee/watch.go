@@ -0,0 +1,96 @@
+package globalfee
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+var _ types.WatchServer = &WatchServer{}
+
+// ParamsChangedQuery is the Tendermint event query matching the blocks in
+// which the globalfee params were changed.
+var ParamsChangedQuery = fmt.Sprintf("tm.event='NewBlock' AND %s.%s='%s'",
+	types.EventTypeParamsChanged, sdk.AttributeKeyModule, types.ModuleName)
+
+// watchSubscribers is used to give each stream a unique subscriber name, as
+// Tendermint rejects a second subscription of a subscriber to the same query.
+var watchSubscribers uint64
+
+// WatchServer serves the globalfee params stream. It is node local: the
+// params changes are detected through the Tendermint events of the node and
+// the params are read through the ABCI queries of the client context.
+type WatchServer struct {
+	clientCtx client.Context
+}
+
+// NewWatchServer returns a WatchServer reading from the given client context,
+// which must have a Tendermint RPC client set.
+func NewWatchServer(clientCtx client.Context) WatchServer {
+	return WatchServer{clientCtx: clientCtx}
+}
+
+// Params sends the current params and then the params of each block in which
+// they were changed, until the client disconnects.
+func (w WatchServer) Params(_ *types.WatchParamsRequest, stream types.Watch_ParamsServer) error {
+	if w.clientCtx.Client == nil {
+		return status.Error(codes.Unavailable, "no tendermint client")
+	}
+	ctx := stream.Context()
+
+	// subscribe before reading the current params so that no change is missed
+	subscriber := "globalfee-watch-" + strconv.FormatUint(atomic.AddUint64(&watchSubscribers, 1), 10)
+	events, err := w.clientCtx.Client.Subscribe(ctx, subscriber, ParamsChangedQuery)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	// the stream context is done when unsubscribing
+	defer w.clientCtx.Client.Unsubscribe(context.Background(), subscriber, ParamsChangedQuery) //nolint:errcheck
+
+	if err := w.sendParams(stream); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "subscription closed")
+			}
+			if err := w.sendParams(stream); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendParams queries the latest params and sends them with their height.
+func (w WatchServer) sendParams(stream types.Watch_ParamsServer) error {
+	var header metadata.MD
+	res, err := types.NewQueryClient(w.clientCtx).Params(stream.Context(), &types.QueryParamsRequest{}, grpc.Header(&header))
+	if err != nil {
+		return err
+	}
+
+	var height int64
+	if values := header.Get(grpctypes.GRPCBlockHeightHeader); len(values) == 1 {
+		height, err = strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return stream.Send(&types.WatchParamsResponse{Params: res.Params, Height: height})
+}
@@ -0,0 +1,106 @@
+package globalfee_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	"github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+// mockNode serves the ABCI queries from the app and the subscriptions from a
+// channel fed by the test.
+type mockNode struct {
+	rpcclient.Client
+	app    *gaiaapp.GaiaApp
+	events chan coretypes.ResultEvent
+}
+
+func (n mockNode) ABCIQueryWithOptions(_ context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	res := n.app.Query(abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	return &coretypes.ResultABCIQuery{Response: res}, nil
+}
+
+func (n mockNode) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	if query != globalfee.ParamsChangedQuery {
+		panic("unexpected query " + query)
+	}
+	return n.events, nil
+}
+
+func (n mockNode) Unsubscribe(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func TestWatchParams(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	node := mockNode{app: app, events: make(chan coretypes.ResultEvent, 1)}
+	app.RegisterTendermintService(client.Context{}.WithClient(node))
+
+	srv := grpc.NewServer()
+	app.RegisterGRPCServer(srv)
+	listener := bufconn.Listen(1 << 20)
+	go srv.Serve(listener) //nolint:errcheck
+	defer srv.Stop()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := types.NewWatchClient(conn).Params(ctx, &types.WatchParamsRequest{})
+	require.NoError(t, err)
+
+	// the current params are sent on subscription
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Height)
+	require.True(t, res.Params.MinimumGasPrices.Empty())
+
+	// a block without params change emits no event
+	endRes := app.EndBlock(abci.RequestEndBlock{Height: 2})
+	require.False(t, hasParamsChangedEvent(endRes.Events))
+	app.Commit()
+
+	newParams := res.Params
+	newParams.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 3)))
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 3}})
+	app.GetSubspace(globalfee.ModuleName).SetParamSet(app.NewContext(false, tmproto.Header{Height: 3}), &newParams)
+	endRes = app.EndBlock(abci.RequestEndBlock{Height: 3})
+	require.True(t, hasParamsChangedEvent(endRes.Events))
+	app.Commit()
+
+	// the node delivers the new block event to the subscription
+	node.events <- coretypes.ResultEvent{Query: globalfee.ParamsChangedQuery}
+	res, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.Height)
+	require.Equal(t, newParams.MinimumGasPrices, res.Params.MinimumGasPrices)
+}
+
+func hasParamsChangedEvent(events []abci.Event) bool {
+	for _, event := range events {
+		if event.Type == types.EventTypeParamsChanged {
+			return true
+		}
+	}
+	return false
+}
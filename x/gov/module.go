@@ -0,0 +1,54 @@
+package gov
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule wraps the gov module of the SDK to enforce the community pool
+// spend cap, and the maximum number of active proposals and the deposit
+// denoms set in the policy params, in its msg server. The other services of the module are
+// unchanged.
+type AppModule struct {
+	gov.AppModule
+	keeper         keeper.Keeper
+	spendCapKeeper SpendCapKeeper
+	policyParam    policy.ParamSource
+}
+
+// NewAppModule creates a new AppModule object.
+func NewAppModule(
+	cdc codec.Codec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	spendCapKeeper SpendCapKeeper,
+	policyParam policy.ParamSource,
+) AppModule {
+	return AppModule{
+		AppModule:      gov.NewAppModule(cdc, k, ak, bk),
+		keeper:         k,
+		spendCapKeeper: spendCapKeeper,
+		policyParam:    policyParam,
+	}
+}
+
+// RegisterServices registers the wrapped msg server in place of the msg
+// server of the SDK, along with the query server and the migrations of the
+// SDK.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), NewMsgServerImpl(am.keeper, am.spendCapKeeper, am.policyParam))
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,170 @@
+package gov
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	govscheduletypes "github.com/cosmos/gaia/v9/x/govschedule/types"
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+// SpendCapKeeper defines the expected recurring spend keeper
+type SpendCapKeeper interface {
+	ValidateSpendCap(ctx sdk.Context, amount sdk.Coins) error
+}
+
+// ProposalQueueKeeper defines the expected gov keeper
+type ProposalQueueKeeper interface {
+	IterateInactiveProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal types.Proposal) (stop bool))
+	IterateActiveProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal types.Proposal) (stop bool))
+}
+
+// maxProposalEndTime is past the end of the deposit and voting periods of all
+// the proposals, so that iterating the proposal queues up to it iterates all
+// the queued proposals. The queues are keyed by the formatted end times, so it
+// must keep a four digit year to sort after them.
+var maxProposalEndTime = time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer wraps the gov msg server of the SDK to reject the community pool
+// spend proposals requesting more than the max spend fraction of the
+// community pool, the proposals submitted once the maximum number of active
+// proposals is reached, and the deposits, initial or not, paid in a denom
+// outside of the deposit denoms. Checking them here covers the messages
+// executed through authz and by the interchain accounts as well.
+type msgServer struct {
+	types.MsgServer
+	keeper         keeper.Keeper
+	spendCapKeeper SpendCapKeeper
+	policyParam    policy.ParamSource
+}
+
+// NewMsgServerImpl returns an implementation of the gov MsgServer interface
+// enforcing the community pool spend cap, the maximum number of active
+// proposals and the deposit denoms.
+func NewMsgServerImpl(k keeper.Keeper, spendCapKeeper SpendCapKeeper, policyParam policy.ParamSource) types.MsgServer {
+	return msgServer{
+		MsgServer:      keeper.NewMsgServerImpl(k),
+		keeper:         k,
+		spendCapKeeper: spendCapKeeper,
+		policyParam:    policyParam,
+	}
+}
+
+// SubmitProposal rejects the community pool spend proposals requesting more
+// than the max spend fraction of the community pool, and the proposals
+// submitted once the number of proposals in their deposit or voting period
+// reaches the maximum, and the initial deposits paid in a denom outside of the
+// deposit denoms. For the recurring spends, the total amount of their
+// payments is capped.
+func (k msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitProposal) (*types.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateDepositDenoms(ctx, msg.InitialDeposit); err != nil {
+		return nil, err
+	}
+	if amount, ok := SpendAmount(msg.GetContent(), ctx.BlockHeight()); ok {
+		if err := k.spendCapKeeper.ValidateSpendCap(ctx, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if maxProposals := MaxActiveProposals(ctx, k.policyParam); maxProposals > 0 {
+		if active := CountActiveProposals(ctx, k.keeper, maxProposals); active >= maxProposals {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot submit proposals, %d proposals are already in their deposit or voting period, the limit is %d", active, maxProposals)
+		}
+	}
+
+	return k.MsgServer.SubmitProposal(goCtx, msg)
+}
+
+// Deposit rejects the deposits paid in a denom outside of the deposit denoms.
+func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types.MsgDepositResponse, error) {
+	if err := k.validateDepositDenoms(sdk.UnwrapSDKContext(goCtx), msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return k.MsgServer.Deposit(goCtx, msg)
+}
+
+// validateDepositDenoms returns an error if the deposit is paid in a denom
+// outside of the DepositDenoms policy param, e.g. in worthless IBC denoms to
+// spam the proposals. When the param is empty, only the denoms of the
+// MinDeposit gov param are allowed.
+func (k msgServer) validateDepositDenoms(ctx sdk.Context, deposit sdk.Coins) error {
+	if deposit.Empty() {
+		return nil
+	}
+
+	var denoms []string
+	if k.policyParam.Has(ctx, policytypes.ParamStoreKeyDepositDenoms) {
+		k.policyParam.Get(ctx, policytypes.ParamStoreKeyDepositDenoms, &denoms)
+	}
+	if len(denoms) == 0 {
+		for _, coin := range k.keeper.GetDepositParams(ctx).MinDeposit {
+			denoms = append(denoms, coin.Denom)
+		}
+	}
+
+	allowed := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		allowed[denom] = true
+	}
+	for _, coin := range deposit {
+		if !allowed[coin.Denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "deposit denom %s is not allowed", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// MaxActiveProposals returns the maximum number of proposals in their deposit
+// or voting period set in the MaxActiveProposals param, zero when unlimited.
+func MaxActiveProposals(ctx sdk.Context, paramSource policy.ParamSource) uint64 {
+	maxProposals := policytypes.DefaultMaxActiveProposals
+	if paramSource.Has(ctx, policytypes.ParamStoreKeyMaxActiveProposals) {
+		paramSource.Get(ctx, policytypes.ParamStoreKeyMaxActiveProposals, &maxProposals)
+	}
+	return maxProposals
+}
+
+// CountActiveProposals returns the number of proposals in their deposit or
+// voting period, counting no further than the limit so that the cost of the
+// count is bounded by the cap.
+func CountActiveProposals(ctx sdk.Context, k ProposalQueueKeeper, limit uint64) uint64 {
+	var count uint64
+	countProposal := func(types.Proposal) bool {
+		count++
+		return count >= limit
+	}
+
+	k.IterateInactiveProposalsQueue(ctx, maxProposalEndTime, countProposal)
+	if count < limit {
+		k.IterateActiveProposalsQueue(ctx, maxProposalEndTime, countProposal)
+	}
+	return count
+}
+
+// SpendAmount returns the amount spent from the community pool by the content
+// submitted at the given height, the scheduled proposals are unwrapped. For
+// the recurring spends, it is the total amount of their payments.
+func SpendAmount(content types.Content, height int64) (sdk.Coins, bool) {
+	switch c := content.(type) {
+	case *distrtypes.CommunityPoolSpendProposal:
+		return c.Amount, true
+	case *recurringspendtypes.RecurringCommunityPoolSpendProposal:
+		return c.TotalAmount(height), true
+	case *govscheduletypes.ScheduledProposal:
+		return SpendAmount(c.GetContent(), height)
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,194 @@
+package gov_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/gov"
+	govscheduletypes "github.com/cosmos/gaia/v9/x/govschedule/types"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+func TestMsgServerSpendCap(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := gov.NewMsgServerImpl(app.GovKeeper, app.RecurringSpendKeeper, app.GetSubspace(policytypes.ModuleName))
+
+	// fund the community pool with 1000stake and cap the spends to half of it
+	poolCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, poolCoins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, poolCoins))
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(poolCoins...)
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+	app.RecurringSpendKeeper.SetParams(ctx, recurringspendtypes.Params{MaxSpendFraction: sdk.NewDecWithPrec(5, 1)})
+
+	proposer := sdk.AccAddress("proposer____________")
+	recipient := sdk.AccAddress("recipient___________")
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, proposer, sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000))))
+	spendContent := func(amount int64) govtypes.Content {
+		return distrtypes.NewCommunityPoolSpendProposal("title", "description", recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+	scheduledContent := func(amount int64) govtypes.Content {
+		content, err := govscheduletypes.NewScheduledProposal("title", "description", 100, spendContent(amount))
+		require.NoError(t, err)
+		return content
+	}
+
+	specs := map[string]struct {
+		content govtypes.Content
+		expErr  bool
+	}{
+		"spend within the cap": {
+			content: spendContent(500),
+		},
+		"spend above the cap": {
+			content: spendContent(501),
+			expErr:  true,
+		},
+		"recurring spend payments within the cap": {
+			content: recurringspendtypes.NewRecurringCommunityPoolSpendProposal("title", "description", recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 10, 100),
+		},
+		"recurring spend payment above the cap": {
+			content: recurringspendtypes.NewRecurringCommunityPoolSpendProposal("title", "description", recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 501)), 10, 1000),
+			expErr:  true,
+		},
+		"recurring spend payments above the cap": {
+			// each payment is within the cap, but the 1000 payments of the
+			// spend would drain the pool
+			content: recurringspendtypes.NewRecurringCommunityPoolSpendProposal("title", "description", recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), 1, 1000),
+			expErr:  true,
+		},
+		"scheduled spend above the cap": {
+			content: scheduledContent(501),
+			expErr:  true,
+		},
+		"other proposal": {
+			content: govtypes.NewTextProposal("title", "description"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			msg, err := govtypes.NewMsgSubmitProposal(spec.content, deposit, proposer)
+			require.NoError(t, err)
+			_, err = msgServer.SubmitProposal(sdk.WrapSDKContext(cacheCtx), msg)
+			if spec.expErr {
+				require.ErrorIs(t, err, recurringspendtypes.ErrSpendCapExceeded)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgServerMaxActiveProposals(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.GetSubspace(policytypes.ModuleName).Set(ctx, policytypes.ParamStoreKeyMaxActiveProposals, uint64(2))
+	msgServer := gov.NewMsgServerImpl(app.GovKeeper, app.RecurringSpendKeeper, app.GetSubspace(policytypes.ModuleName))
+
+	proposer := sdk.AccAddress("proposer____________")
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, proposer, sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000))))
+	submit := func() error {
+		msg, err := govtypes.NewMsgSubmitProposal(govtypes.NewTextProposal("title", "description"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), proposer)
+		require.NoError(t, err)
+		_, err = msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	require.NoError(t, submit())
+	require.NoError(t, submit())
+	require.ErrorIs(t, submit(), sdkerrors.ErrInvalidRequest)
+
+	// the cap is lifted when the param is zero
+	app.GetSubspace(policytypes.ModuleName).Set(ctx, policytypes.ParamStoreKeyMaxActiveProposals, uint64(0))
+	require.NoError(t, submit())
+}
+
+func TestMsgServerDepositDenoms(t *testing.T) {
+	depositor := sdk.AccAddress("depositor___________")
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	stakeDeposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	ibcDeposit := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1000))
+
+	newProposalMsg := func(deposit sdk.Coins) sdk.Msg {
+		msg, err := govtypes.NewMsgSubmitProposal(govtypes.NewTextProposal("title", "description"), deposit, depositor)
+		require.NoError(t, err)
+		return msg
+	}
+	newDepositMsg := func(deposit sdk.Coins) sdk.Msg {
+		return govtypes.NewMsgDeposit(depositor, 1, deposit)
+	}
+
+	specs := map[string]struct {
+		depositDenoms []string
+		msg           sdk.Msg
+		expErr        bool
+	}{
+		"allowlisted denom, deposit": {
+			depositDenoms: []string{"stake", ibcDenom},
+			msg:           newDepositMsg(ibcDeposit),
+		},
+		"allowlisted denom, initial deposit": {
+			depositDenoms: []string{"stake", ibcDenom},
+			msg:           newProposalMsg(ibcDeposit),
+		},
+		"not allowlisted denom, deposit": {
+			depositDenoms: []string{"stake"},
+			msg:           newDepositMsg(ibcDeposit),
+			expErr:        true,
+		},
+		"not allowlisted denom, initial deposit": {
+			depositDenoms: []string{"stake"},
+			msg:           newProposalMsg(stakeDeposit.Add(ibcDeposit...)),
+			expErr:        true,
+		},
+		"empty allowlist, min deposit denom": {
+			msg: newDepositMsg(stakeDeposit),
+		},
+		"empty allowlist, other denom": {
+			msg:    newDepositMsg(ibcDeposit),
+			expErr: true,
+		},
+		"proposal without initial deposit": {
+			depositDenoms: []string{ibcDenom},
+			msg:           newProposalMsg(sdk.NewCoins()),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			app := gaiahelpers.Setup(t)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			subspace := app.GetSubspace(policytypes.ModuleName)
+			subspace.Set(ctx, policytypes.ParamStoreKeyDepositDenoms, spec.depositDenoms)
+			msgServer := gov.NewMsgServerImpl(app.GovKeeper, app.RecurringSpendKeeper, subspace)
+			require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, depositor, stakeDeposit.Add(ibcDeposit...)))
+			_, err := msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), newProposalMsg(sdk.NewCoins()).(*govtypes.MsgSubmitProposal))
+			require.NoError(t, err)
+
+			switch msg := spec.msg.(type) {
+			case *govtypes.MsgSubmitProposal:
+				_, err = msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+			case *govtypes.MsgDeposit:
+				_, err = msgServer.Deposit(sdk.WrapSDKContext(ctx), msg)
+			}
+			if spec.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package govschedule
+
+import (
+	"github.com/cosmos/gaia/v9/x/govschedule/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
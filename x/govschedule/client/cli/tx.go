@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v9/x/govschedule/types"
+)
+
+// ScheduledProposalJSON defines a scheduled proposal read from a JSON file.
+type ScheduledProposalJSON struct {
+	Title              string `json:"title"`
+	Description        string `json:"description"`
+	ExecuteAfterHeight int64  `json:"execute_after_height"`
+	// Content is the proto JSON of the scheduled proposal content, with its
+	// type URL in the @type field.
+	Content json.RawMessage `json:"content"`
+	Deposit string          `json:"deposit"`
+}
+
+// ParseScheduledProposalJSON reads and parses a ScheduledProposalJSON from a
+// file.
+func ParseScheduledProposalJSON(proposalFile string) (ScheduledProposalJSON, error) {
+	var proposal ScheduledProposalJSON
+
+	contents, err := os.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// GetCmdSubmitScheduledProposal implements the command to submit a scheduled
+// proposal.
+func GetCmdSubmitScheduledProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal whose content is executed at a given height",
+		Long: `Submit a proposal wrapping the content of another proposal, along with an initial
+deposit. The proposal details must be supplied via a JSON file. Once the proposal passes,
+the content is executed at the end of the block at the execute after height, or at the
+end of the block the proposal passed in if that height is already reached.
+
+Example:
+$ gaiad tx gov submit-proposal scheduled-proposal <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Scheduled Community Pool Spend",
+  "description": "Fund the team once the program starts",
+  "execute_after_height": 20000000,
+  "content": {
+    "@type": "/cosmos.distribution.v1beta1.CommunityPoolSpendProposal",
+    "title": "Community Pool Spend",
+    "description": "Fund the team",
+    "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+    "amount": [{"denom": "uatom", "amount": "1000"}]
+  },
+  "deposit": "1000uatom"
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseScheduledProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			var content govtypes.Content
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(proposal.Content, &content); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			scheduled, err := types.NewScheduledProposal(proposal.Title, proposal.Description, proposal.ExecuteAfterHeight, content)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(scheduled, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/govschedule/types"
+)
+
+// InitGenesis initializes the scheduled executions from the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetNextExecutionID(ctx, genState.NextExecutionId)
+	for _, execution := range genState.Executions {
+		k.SetExecution(ctx, execution)
+	}
+}
+
+// ExportGenesis returns the scheduled executions as a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		NextExecutionId: k.GetNextExecutionID(ctx),
+		Executions:      k.GetAllExecutions(ctx),
+	}
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v9/x/govschedule/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ScheduledExecutions returns the pending scheduled executions
+func (k Keeper) ScheduledExecutions(stdCtx context.Context, req *types.QueryScheduledExecutionsRequest) (*types.QueryScheduledExecutionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExecutionKeyPrefix)
+
+	var executions []types.ScheduledExecution
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var execution types.ScheduledExecution
+		if err := k.cdc.Unmarshal(value, &execution); err != nil {
+			return err
+		}
+		executions = append(executions, execution)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledExecutionsResponse{Executions: executions, Pagination: pageRes}, nil
+}
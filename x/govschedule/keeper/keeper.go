@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/gaia/v9/x/govschedule/types"
+)
+
+// Keeper of the gov schedule store
+type Keeper struct {
+	storeKey  storetypes.StoreKey
+	cdc       codec.BinaryCodec
+	govRouter types.GovRouter
+}
+
+// NewKeeper creates a new gov schedule Keeper instance. The gov router
+// executes the scheduled contents, it can still be filled after the keeper is
+// created.
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, govRouter types.GovRouter) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		govRouter: govRouter,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetNextExecutionID returns the id given to the next scheduled execution.
+func (k Keeper) GetNextExecutionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextExecutionIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextExecutionID sets the id given to the next scheduled execution.
+func (k Keeper) SetNextExecutionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextExecutionIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetExecution stores a scheduled execution.
+func (k Keeper) SetExecution(ctx sdk.Context, execution types.ScheduledExecution) {
+	ctx.KVStore(k.storeKey).Set(types.GetExecutionKey(execution.ExecuteAfterHeight, execution.Id), k.cdc.MustMarshal(&execution))
+}
+
+// DeleteExecution removes a scheduled execution.
+func (k Keeper) DeleteExecution(ctx sdk.Context, execution types.ScheduledExecution) {
+	ctx.KVStore(k.storeKey).Delete(types.GetExecutionKey(execution.ExecuteAfterHeight, execution.Id))
+}
+
+// IterateExecutions iterates over the scheduled executions by height and id.
+// The iteration stops when cb returns true.
+func (k Keeper) IterateExecutions(ctx sdk.Context, cb func(execution types.ScheduledExecution) (stop bool)) {
+	k.iterateExecutions(ctx, nil, cb)
+}
+
+// iterateExecutions iterates over the scheduled executions by height and id
+// up to the end key, which is excluded. All the executions are iterated over
+// for a nil end key.
+func (k Keeper) iterateExecutions(ctx sdk.Context, end []byte, cb func(execution types.ScheduledExecution) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExecutionKeyPrefix)
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var execution types.ScheduledExecution
+		k.cdc.MustUnmarshal(iterator.Value(), &execution)
+		if cb(execution) {
+			break
+		}
+	}
+}
+
+// GetAllExecutions returns all the scheduled executions by height and id.
+func (k Keeper) GetAllExecutions(ctx sdk.Context) []types.ScheduledExecution {
+	var executions []types.ScheduledExecution
+	k.IterateExecutions(ctx, func(execution types.ScheduledExecution) bool {
+		executions = append(executions, execution)
+		return false
+	})
+	return executions
+}
+
+// ScheduleExecution schedules the execution of the content at the end of the
+// block at the given height, or at the end of the current block if the height
+// is already reached. It returns the id of the execution.
+func (k Keeper) ScheduleExecution(ctx sdk.Context, title string, executeAfterHeight int64, content govtypes.Content) (uint64, error) {
+	if content == nil || !k.govRouter.HasRoute(content.ProposalRoute()) {
+		return 0, sdkerrors.Wrapf(govtypes.ErrNoProposalHandlerExists, "%T", content)
+	}
+
+	execution, err := types.NewScheduledExecution(k.GetNextExecutionID(ctx), title, executeAfterHeight, content)
+	if err != nil {
+		return 0, err
+	}
+	if err := execution.Validate(); err != nil {
+		return 0, err
+	}
+
+	k.SetExecution(ctx, execution)
+	k.SetNextExecutionID(ctx, execution.Id+1)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExecutionScheduled,
+		sdk.NewAttribute(types.AttributeKeyExecutionID, sdk.NewUint(execution.Id).String()),
+		sdk.NewAttribute(types.AttributeKeyExecuteAfterHeight, sdk.NewInt(executeAfterHeight).String()),
+		sdk.NewAttribute(types.AttributeKeyProposalType, content.ProposalType()),
+	))
+
+	return execution.Id, nil
+}
+
+// ExecuteDueExecutions executes the scheduled contents due at the current
+// height with the handlers of their proposal routes, and removes them. Like
+// gov does for the passed proposals, the state changes of a content whose
+// handler fails are discarded.
+func (k Keeper) ExecuteDueExecutions(ctx sdk.Context) {
+	var due []types.ScheduledExecution
+	k.iterateExecutions(ctx, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()+1)), func(execution types.ScheduledExecution) bool {
+		due = append(due, execution)
+		return false
+	})
+
+	for _, execution := range due {
+		k.DeleteExecution(ctx, execution)
+
+		executionID := sdk.NewUint(execution.Id).String()
+		if err := k.execute(ctx, execution); err != nil {
+			k.Logger(ctx).Error("failed to execute scheduled proposal content", "id", execution.Id, "err", err)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeExecutionFailed,
+				sdk.NewAttribute(types.AttributeKeyExecutionID, executionID),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			continue
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExecutionSucceeded,
+			sdk.NewAttribute(types.AttributeKeyExecutionID, executionID),
+		))
+	}
+}
+
+// execute runs the handler of the execution content, leaving the state
+// untouched on failure.
+func (k Keeper) execute(ctx sdk.Context, execution types.ScheduledExecution) error {
+	content := execution.GetContent()
+	if content == nil {
+		return sdkerrors.Wrap(types.ErrInvalidExecution, "missing content")
+	}
+	if !k.govRouter.HasRoute(content.ProposalRoute()) {
+		return sdkerrors.Wrap(govtypes.ErrNoProposalHandlerExists, content.ProposalRoute())
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.govRouter.GetRoute(content.ProposalRoute())(cacheCtx, content); err != nil {
+		return err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
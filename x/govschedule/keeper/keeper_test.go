@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/govschedule"
+	"github.com/cosmos/gaia/v9/x/govschedule/types"
+)
+
+const denom = "stake"
+
+func setupGovSchedule(t *testing.T, communityPool sdk.Int) (*gaiaapp.GaiaApp, sdk.Context) {
+	t.Helper()
+
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	// reset the community pool, then fund it with the given amount
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.DecCoins{}
+	if communityPool.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, communityPool))
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, coins))
+		feePool.CommunityPool = sdk.NewDecCoinsFromCoins(coins...)
+	}
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+
+	return app, ctx
+}
+
+func spendContent(recipient sdk.AccAddress, amount int64) govtypes.Content {
+	return distrtypes.NewCommunityPoolSpendProposal("spend", "description", recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, amount)))
+}
+
+func TestScheduledProposalExecutesAtHeight(t *testing.T) {
+	app, ctx := setupGovSchedule(t, sdk.NewInt(1_000))
+	k := app.GovScheduleKeeper
+	recipient := sdk.AccAddress("recipient___________")
+
+	// the proposal passes at height 10, its content is due at height 15
+	proposal, err := types.NewScheduledProposal("scheduled", "description", 15, spendContent(recipient, 100))
+	require.NoError(t, err)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, govschedule.NewScheduledProposalHandler(k)(ctx, proposal))
+	require.Len(t, k.GetAllExecutions(ctx), 1)
+	require.Equal(t, uint64(2), k.GetNextExecutionID(ctx))
+
+	for height := int64(10); height <= 20; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		k.ExecuteDueExecutions(ctx)
+
+		expBalance := sdk.ZeroInt()
+		if height >= 15 {
+			expBalance = sdk.NewInt(100)
+		}
+		require.Equal(t, expBalance, app.BankKeeper.GetBalance(ctx, recipient, denom).Amount, "height %d", height)
+		require.Equal(t, height < 15, len(k.GetAllExecutions(ctx)) == 1, "height %d", height)
+	}
+}
+
+func TestScheduledProposalPastHeight(t *testing.T) {
+	app, ctx := setupGovSchedule(t, sdk.NewInt(1_000))
+	k := app.GovScheduleKeeper
+	recipient := sdk.AccAddress("recipient___________")
+
+	// the height is already reached when the proposal passes, the content is
+	// executed at the end of the current block
+	_, err := k.ScheduleExecution(ctx, "scheduled", 5, spendContent(recipient, 100))
+	require.NoError(t, err)
+
+	k.ExecuteDueExecutions(ctx)
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+	require.Empty(t, k.GetAllExecutions(ctx))
+}
+
+func TestScheduledProposalExecutionFailure(t *testing.T) {
+	app, ctx := setupGovSchedule(t, sdk.NewInt(150))
+	k := app.GovScheduleKeeper
+	recipient := sdk.AccAddress("recipient___________")
+
+	_, err := k.ScheduleExecution(ctx, "too large", 12, spendContent(recipient, 200))
+	require.NoError(t, err)
+	_, err = k.ScheduleExecution(ctx, "affordable", 12, spendContent(recipient, 100))
+	require.NoError(t, err)
+
+	// the failed execution is discarded without stopping the next one
+	ctx = ctx.WithBlockHeight(12)
+	k.ExecuteDueExecutions(ctx)
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+	require.Equal(t, sdk.NewDec(50), app.DistrKeeper.GetFeePool(ctx).CommunityPool.AmountOf(denom))
+	require.Empty(t, k.GetAllExecutions(ctx))
+}
+
+func TestScheduleExecutionInvalid(t *testing.T) {
+	app, ctx := setupGovSchedule(t, sdk.ZeroInt())
+	k := app.GovScheduleKeeper
+	recipient := sdk.AccAddress("recipient___________")
+
+	_, err := k.ScheduleExecution(ctx, "no content", 15, nil)
+	require.ErrorIs(t, err, govtypes.ErrNoProposalHandlerExists)
+	_, err = k.ScheduleExecution(ctx, "no height", 0, spendContent(recipient, 100))
+	require.ErrorIs(t, err, types.ErrInvalidExecution)
+	require.Empty(t, k.GetAllExecutions(ctx))
+	require.Equal(t, uint64(1), k.GetNextExecutionID(ctx))
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	app, ctx := setupGovSchedule(t, sdk.ZeroInt())
+	k := app.GovScheduleKeeper
+	recipient := sdk.AccAddress("recipient___________")
+
+	_, err := k.ScheduleExecution(ctx, "first", 20, spendContent(recipient, 100))
+	require.NoError(t, err)
+	_, err = k.ScheduleExecution(ctx, "second", 15, govtypes.NewTextProposal("text", "description"))
+	require.NoError(t, err)
+
+	genState := k.ExportGenesis(ctx)
+	require.NoError(t, genState.Validate())
+	require.Equal(t, uint64(3), genState.NextExecutionId)
+	require.Len(t, genState.Executions, 2)
+	// the executions are exported by height
+	require.Equal(t, uint64(2), genState.Executions[0].Id)
+
+	app2, ctx2 := setupGovSchedule(t, sdk.ZeroInt())
+	app2.GovScheduleKeeper.InitGenesis(ctx2, *genState)
+	require.Equal(t, genState, app2.GovScheduleKeeper.ExportGenesis(ctx2))
+}
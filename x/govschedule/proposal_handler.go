@@ -0,0 +1,26 @@
+package govschedule
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v9/x/govschedule/keeper"
+	"github.com/cosmos/gaia/v9/x/govschedule/types"
+)
+
+// NewScheduledProposalHandler returns the gov handler of the scheduled
+// proposals, which schedules the execution of their content instead of
+// executing it.
+func NewScheduledProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ScheduledProposal:
+			_, err := k.ScheduleExecution(ctx, c.Title, c.ExecuteAfterHeight, c.GetContent())
+			return err
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gov schedule proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/govschedule module sentinel errors
+var (
+	ErrInvalidScheduledProposal = sdkerrors.Register(ModuleName, 2, "invalid scheduled proposal")
+	ErrInvalidExecution         = sdkerrors.Register(ModuleName, 3, "invalid scheduled execution")
+)
@@ -0,0 +1,13 @@
+package types
+
+// gov schedule module event types
+const (
+	EventTypeExecutionScheduled = "execution_scheduled"
+	EventTypeExecutionSucceeded = "execution_succeeded"
+	EventTypeExecutionFailed    = "execution_failed"
+
+	AttributeKeyExecutionID        = "execution_id"
+	AttributeKeyExecuteAfterHeight = "execute_after_height"
+	AttributeKeyProposalType       = "proposal_type"
+	AttributeKeyError              = "error"
+)
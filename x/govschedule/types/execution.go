@@ -0,0 +1,49 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &ScheduledExecution{}
+
+// NewScheduledExecution creates a new scheduled execution of the content at
+// the given height.
+func NewScheduledExecution(id uint64, title string, executeAfterHeight int64, content govtypes.Content) (ScheduledExecution, error) {
+	anyContent, err := contentToAny(content)
+	if err != nil {
+		return ScheduledExecution{}, err
+	}
+	return ScheduledExecution{
+		Id:                 id,
+		Title:              title,
+		ExecuteAfterHeight: executeAfterHeight,
+		Content:            anyContent,
+	}, nil
+}
+
+// GetContent returns the content of a scheduled execution.
+func (e ScheduledExecution) GetContent() govtypes.Content {
+	return contentFromAny(e.Content)
+}
+
+// Validate performs basic validation of a scheduled execution.
+func (e ScheduledExecution) Validate() error {
+	if e.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidExecution, "id must be positive")
+	}
+	if e.ExecuteAfterHeight <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidExecution, "execute after height must be positive: %d", e.ExecuteAfterHeight)
+	}
+	if err := validateContent(e.GetContent()); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidExecution, "execution %d: %s", e.Id, err)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (e *ScheduledExecution) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var content govtypes.Content
+	return unpacker.UnpackAny(e.Content, &content)
+}
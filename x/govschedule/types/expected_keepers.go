@@ -0,0 +1,12 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// GovRouter defines the expected gov proposal router, which routes the
+// scheduled contents to their handlers
+type GovRouter interface {
+	HasRoute(r string) bool
+	GetRoute(path string) govtypes.Handler
+}
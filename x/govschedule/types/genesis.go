@@ -0,0 +1,47 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// DefaultGenesisState returns the default genesis state, without scheduled
+// executions.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{NextExecutionId: 1}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if gs.NextExecutionId == 0 {
+		return sdkerrors.Wrap(ErrInvalidExecution, "next execution id must be positive")
+	}
+
+	seen := make(map[uint64]bool, len(gs.Executions))
+	for _, execution := range gs.Executions {
+		if err := execution.Validate(); err != nil {
+			return err
+		}
+		if seen[execution.Id] {
+			return sdkerrors.Wrapf(ErrInvalidExecution, "duplicate execution id %d", execution.Id)
+		}
+		if execution.Id >= gs.NextExecutionId {
+			return sdkerrors.Wrapf(ErrInvalidExecution, "execution id %d is not below the next execution id %d", execution.Id, gs.NextExecutionId)
+		}
+		seen[execution.Id] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for i := range gs.Executions {
+		if err := gs.Executions[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,730 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/govschedule/v1beta1/govschedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduledExecution is the content of a passed scheduled proposal, executed
+// at the end of the first block at or above execute_after_height.
+type ScheduledExecution struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// title is the title of the proposal that scheduled the execution.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// execute_after_height is the height the content is executed at.
+	ExecuteAfterHeight int64 `protobuf:"varint,3,opt,name=execute_after_height,json=executeAfterHeight,proto3" json:"execute_after_height,omitempty" yaml:"execute_after_height"`
+	// content is the proposal content executed.
+	Content *types.Any `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (m *ScheduledExecution) Reset()         { *m = ScheduledExecution{} }
+func (m *ScheduledExecution) String() string { return proto.CompactTextString(m) }
+func (*ScheduledExecution) ProtoMessage()    {}
+func (*ScheduledExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34310dd9ffc889ff, []int{0}
+}
+func (m *ScheduledExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledExecution.Merge(m, src)
+}
+func (m *ScheduledExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledExecution proto.InternalMessageInfo
+
+// ScheduledProposal wraps the content of another proposal, whose execution is
+// deferred until execute_after_height instead of happening as soon as the
+// proposal passed. The content is executed right away if the proposal passed
+// after execute_after_height.
+type ScheduledProposal struct {
+	Title              string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ExecuteAfterHeight int64      `protobuf:"varint,3,opt,name=execute_after_height,json=executeAfterHeight,proto3" json:"execute_after_height,omitempty" yaml:"execute_after_height"`
+	Content            *types.Any `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (m *ScheduledProposal) Reset()      { *m = ScheduledProposal{} }
+func (*ScheduledProposal) ProtoMessage() {}
+func (*ScheduledProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34310dd9ffc889ff, []int{1}
+}
+func (m *ScheduledProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledProposal.Merge(m, src)
+}
+func (m *ScheduledProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ScheduledExecution)(nil), "gaia.govschedule.v1beta1.ScheduledExecution")
+	proto.RegisterType((*ScheduledProposal)(nil), "gaia.govschedule.v1beta1.ScheduledProposal")
+}
+
+func init() {
+	proto.RegisterFile("gaia/govschedule/v1beta1/govschedule.proto", fileDescriptor_34310dd9ffc889ff)
+}
+
+var fileDescriptor_34310dd9ffc889ff = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0x87, 0x73, 0x6d, 0xb5, 0x78, 0x15, 0xc1, 0x90, 0x21, 0x56, 0x48, 0x42, 0xa6, 0x50, 0x30,
+	0xa1, 0xba, 0xd5, 0xa9, 0x55, 0xc1, 0x51, 0xe3, 0xe6, 0x52, 0xf2, 0xe7, 0x7a, 0x39, 0x48, 0x73,
+	0x21, 0xb9, 0x94, 0xe6, 0x1b, 0x38, 0x3a, 0x3a, 0xf6, 0x43, 0xf8, 0x21, 0xc4, 0xa9, 0xa3, 0x93,
+	0x48, 0xbb, 0xe8, 0xea, 0x27, 0x90, 0xe4, 0x52, 0x8d, 0xe0, 0x07, 0x70, 0xbb, 0xf7, 0xf7, 0x3e,
+	0xc7, 0xfb, 0x3e, 0xf0, 0xc2, 0x1e, 0x76, 0x88, 0x63, 0x61, 0x3a, 0x4b, 0xbd, 0x00, 0xf9, 0x59,
+	0x88, 0xac, 0x59, 0xdf, 0x45, 0xcc, 0xe9, 0xd7, 0x33, 0x33, 0x4e, 0x28, 0xa3, 0xa2, 0x5c, 0xb0,
+	0x66, 0x3d, 0xaf, 0xd8, 0xae, 0x84, 0x29, 0xa6, 0x25, 0x64, 0x15, 0x2f, 0xce, 0x77, 0x0f, 0x3c,
+	0x9a, 0x4e, 0x69, 0x3a, 0xe6, 0x0d, 0x5e, 0x6c, 0x5a, 0x98, 0x52, 0x1c, 0x22, 0xab, 0xac, 0xdc,
+	0x6c, 0x62, 0x39, 0x51, 0xce, 0x5b, 0xfa, 0x12, 0x40, 0xf1, 0xa6, 0x1a, 0xe0, 0x5f, 0xcc, 0x91,
+	0x97, 0x31, 0x42, 0x23, 0x71, 0x0f, 0x36, 0x88, 0x2f, 0x03, 0x0d, 0x18, 0x2d, 0xbb, 0x41, 0x7c,
+	0x51, 0x82, 0x5b, 0x8c, 0xb0, 0x10, 0xc9, 0x0d, 0x0d, 0x18, 0x3b, 0x36, 0x2f, 0xc4, 0x6b, 0x28,
+	0xa1, 0xf2, 0x0b, 0x1a, 0x3b, 0x13, 0x86, 0x92, 0x71, 0x80, 0x08, 0x0e, 0x98, 0xdc, 0xd4, 0x80,
+	0xd1, 0x1c, 0xa9, 0x9f, 0xaf, 0xea, 0x61, 0xee, 0x4c, 0xc3, 0x81, 0xfe, 0x17, 0xa5, 0xdb, 0x62,
+	0x15, 0x0f, 0x8b, 0xf4, 0xb2, 0x0c, 0xc5, 0x53, 0xd8, 0xf6, 0x68, 0xc4, 0x50, 0xc4, 0xe4, 0x96,
+	0x06, 0x8c, 0xce, 0xb1, 0x64, 0xf2, 0xe5, 0xcd, 0xcd, 0xf2, 0xe6, 0x30, 0xca, 0x47, 0x9d, 0xe7,
+	0xc7, 0xa3, 0xf6, 0x19, 0x07, 0xed, 0xcd, 0x8f, 0x41, 0xeb, 0x6e, 0xa1, 0x0a, 0xfa, 0x07, 0x80,
+	0xfb, 0xdf, 0x4a, 0x57, 0x09, 0x8d, 0x69, 0xea, 0x84, 0x3f, 0x06, 0xa0, 0x6e, 0xa0, 0xc1, 0x8e,
+	0x8f, 0x52, 0x2f, 0x21, 0x71, 0xa1, 0x5d, 0xd9, 0xd5, 0xa3, 0x7f, 0xe7, 0xb8, 0x5b, 0x38, 0x3e,
+	0x2c, 0x54, 0xe1, 0x7d, 0xa1, 0x0a, 0xa3, 0xf3, 0xa7, 0x95, 0x02, 0x96, 0x2b, 0x05, 0xbc, 0xad,
+	0x14, 0x70, 0xbf, 0x56, 0x84, 0xe5, 0x5a, 0x11, 0x5e, 0xd6, 0x8a, 0x70, 0xdb, 0xc3, 0x84, 0x05,
+	0x99, 0x6b, 0x7a, 0x74, 0x5a, 0x1d, 0x83, 0x55, 0x1e, 0xdf, 0xfc, 0xd7, 0xf9, 0xb1, 0x3c, 0x46,
+	0xa9, 0xbb, 0x5d, 0xce, 0x3d, 0xf9, 0x1a, 0x00, 0xc2, 0x76, 0x92, 0x0c, 0x9f, 0x02, 0x00, 0x00,
+}
+
+func (m *ScheduledExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Content != nil {
+		{
+			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGovschedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExecuteAfterHeight != 0 {
+		i = encodeVarintGovschedule(dAtA, i, uint64(m.ExecuteAfterHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGovschedule(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGovschedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Content != nil {
+		{
+			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGovschedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExecuteAfterHeight != 0 {
+		i = encodeVarintGovschedule(dAtA, i, uint64(m.ExecuteAfterHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGovschedule(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGovschedule(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGovschedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGovschedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGovschedule(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGovschedule(uint64(l))
+	}
+	if m.ExecuteAfterHeight != 0 {
+		n += 1 + sovGovschedule(uint64(m.ExecuteAfterHeight))
+	}
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovGovschedule(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGovschedule(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGovschedule(uint64(l))
+	}
+	if m.ExecuteAfterHeight != 0 {
+		n += 1 + sovGovschedule(uint64(m.ExecuteAfterHeight))
+	}
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovGovschedule(uint64(l))
+	}
+	return n
+}
+
+func sovGovschedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGovschedule(x uint64) (n int) {
+	return sovGovschedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduledExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfterHeight", wireType)
+			}
+			m.ExecuteAfterHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAfterHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &types.Any{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfterHeight", wireType)
+			}
+			m.ExecuteAfterHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAfterHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &types.Any{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGovschedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGovschedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGovschedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGovschedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGovschedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGovschedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGovschedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGovschedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGovschedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGovschedule = fmt.Errorf("proto: unexpected end of group")
+)
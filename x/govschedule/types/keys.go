@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the this module
+	ModuleName = "govschedule"
+
+	// StoreKey is the default store key for the module, the module name is
+	// not used as it is prefixed by the gov store key
+	StoreKey = "schedulegov"
+
+	// RouterKey is the message route for the module proposals
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ExecutionKeyPrefix is the prefix of the scheduled executions by height
+	// and id
+	ExecutionKeyPrefix = []byte{0x01}
+	// NextExecutionIDKey is the key of the id given to the next scheduled
+	// execution
+	NextExecutionIDKey = []byte{0x02}
+)
+
+// GetExecutionKey returns the store key of the scheduled execution with the
+// given height and id.
+func GetExecutionKey(height int64, id uint64) []byte {
+	return append(GetExecutionHeightPrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetExecutionHeightPrefix returns the prefix of the store keys of the
+// scheduled executions with the given height.
+func GetExecutionHeightPrefix(height int64) []byte {
+	return append(append([]byte{}, ExecutionKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
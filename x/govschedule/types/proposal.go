@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// ProposalTypeScheduled defines the type for a ScheduledProposal
+const ProposalTypeScheduled = "Scheduled"
+
+var (
+	_ govtypes.Content                   = &ScheduledProposal{}
+	_ codectypes.UnpackInterfacesMessage = &ScheduledProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeScheduled)
+	govtypes.RegisterProposalTypeCodec(&ScheduledProposal{}, "gaia/ScheduledProposal")
+}
+
+// NewScheduledProposal creates a new scheduled proposal executing the content
+// at the given height.
+func NewScheduledProposal(title, description string, executeAfterHeight int64, content govtypes.Content) (*ScheduledProposal, error) {
+	p := &ScheduledProposal{
+		Title:              title,
+		Description:        description,
+		ExecuteAfterHeight: executeAfterHeight,
+	}
+	if err := p.SetContent(content); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// GetContent returns the content executed by a scheduled proposal.
+func (p *ScheduledProposal) GetContent() govtypes.Content {
+	return contentFromAny(p.Content)
+}
+
+// SetContent sets the content executed by a scheduled proposal.
+func (p *ScheduledProposal) SetContent(content govtypes.Content) error {
+	anyContent, err := contentToAny(content)
+	if err != nil {
+		return err
+	}
+	p.Content = anyContent
+	return nil
+}
+
+// GetTitle returns the title of a scheduled proposal.
+func (p *ScheduledProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a scheduled proposal.
+func (p *ScheduledProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a scheduled proposal.
+func (p *ScheduledProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a scheduled proposal.
+func (p *ScheduledProposal) ProposalType() string { return ProposalTypeScheduled }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ScheduledProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.ExecuteAfterHeight <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidScheduledProposal, "execute after height must be positive: %d", p.ExecuteAfterHeight)
+	}
+	return validateContent(p.GetContent())
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p *ScheduledProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var content govtypes.Content
+	return unpacker.UnpackAny(p.Content, &content)
+}
+
+// String implements the Stringer interface.
+func (p ScheduledProposal) String() string {
+	content := "<nil>"
+	if c := p.GetContent(); c != nil {
+		content = c.String()
+	}
+	return fmt.Sprintf(`Scheduled Proposal:
+  Title:                %s
+  Description:          %s
+  Execute After Height: %d
+  Content:              %s
+`, p.Title, p.Description, p.ExecuteAfterHeight, content)
+}
+
+// validateContent checks that the scheduled content is set, is not itself a
+// scheduled proposal and is valid.
+func validateContent(content govtypes.Content) error {
+	if content == nil {
+		return sdkerrors.Wrap(ErrInvalidScheduledProposal, "missing content")
+	}
+	if _, ok := content.(*ScheduledProposal); ok {
+		return sdkerrors.Wrap(ErrInvalidScheduledProposal, "scheduled proposals cannot be nested")
+	}
+	return content.ValidateBasic()
+}
+
+func contentToAny(content govtypes.Content) (*codectypes.Any, error) {
+	msg, ok := content.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("can't proto marshal %T", content)
+	}
+	return codectypes.NewAnyWithValue(msg)
+}
+
+func contentFromAny(anyContent *codectypes.Any) govtypes.Content {
+	if anyContent == nil {
+		return nil
+	}
+	content, ok := anyContent.GetCachedValue().(govtypes.Content)
+	if !ok {
+		return nil
+	}
+	return content
+}
@@ -0,0 +1,675 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/govschedule/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryScheduledExecutionsRequest is the request type for the
+// Query/ScheduledExecutions RPC method.
+type QueryScheduledExecutionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledExecutionsRequest) Reset()         { *m = QueryScheduledExecutionsRequest{} }
+func (m *QueryScheduledExecutionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledExecutionsRequest) ProtoMessage()    {}
+func (*QueryScheduledExecutionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc60b6980c4647c0, []int{0}
+}
+func (m *QueryScheduledExecutionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledExecutionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledExecutionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledExecutionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledExecutionsRequest.Merge(m, src)
+}
+func (m *QueryScheduledExecutionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledExecutionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledExecutionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledExecutionsRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledExecutionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScheduledExecutionsResponse is the response type for the
+// Query/ScheduledExecutions RPC method.
+type QueryScheduledExecutionsResponse struct {
+	Executions []ScheduledExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledExecutionsResponse) Reset()         { *m = QueryScheduledExecutionsResponse{} }
+func (m *QueryScheduledExecutionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledExecutionsResponse) ProtoMessage()    {}
+func (*QueryScheduledExecutionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc60b6980c4647c0, []int{1}
+}
+func (m *QueryScheduledExecutionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledExecutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledExecutionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledExecutionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledExecutionsResponse.Merge(m, src)
+}
+func (m *QueryScheduledExecutionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledExecutionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledExecutionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledExecutionsResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledExecutionsResponse) GetExecutions() []ScheduledExecution {
+	if m != nil {
+		return m.Executions
+	}
+	return nil
+}
+
+func (m *QueryScheduledExecutionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryScheduledExecutionsRequest)(nil), "gaia.govschedule.v1beta1.QueryScheduledExecutionsRequest")
+	proto.RegisterType((*QueryScheduledExecutionsResponse)(nil), "gaia.govschedule.v1beta1.QueryScheduledExecutionsResponse")
+}
+
+func init() {
+	proto.RegisterFile("gaia/govschedule/v1beta1/query.proto", fileDescriptor_fc60b6980c4647c0)
+}
+
+var fileDescriptor_fc60b6980c4647c0 = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xbb, 0x6e, 0xea, 0x30,
+	0x18, 0xc7, 0x63, 0xce, 0x65, 0x30, 0x9b, 0xcf, 0x19, 0x10, 0xaa, 0x02, 0x42, 0x88, 0x22, 0x84,
+	0x6c, 0x41, 0xa7, 0x76, 0x44, 0xbd, 0xac, 0x6d, 0xba, 0x75, 0x73, 0x82, 0x65, 0x22, 0x41, 0xbe,
+	0x80, 0x1d, 0x04, 0x6b, 0x9f, 0xa0, 0x52, 0xdf, 0xa6, 0x4b, 0x97, 0x0e, 0x8c, 0x48, 0x5d, 0x3a,
+	0x55, 0x15, 0xf4, 0x41, 0xaa, 0x38, 0x29, 0x04, 0xb5, 0x11, 0x52, 0xb7, 0xc8, 0xf9, 0x7d, 0xff,
+	0xcb, 0x67, 0xe3, 0xba, 0xe4, 0x3e, 0x67, 0x12, 0xa6, 0xca, 0x1b, 0x88, 0x7e, 0x34, 0x14, 0x6c,
+	0xda, 0x71, 0x85, 0xe6, 0x1d, 0x36, 0x8e, 0xc4, 0x64, 0x4e, 0xc3, 0x09, 0x68, 0x20, 0xa5, 0x98,
+	0xa2, 0x19, 0x8a, 0xa6, 0x54, 0xf9, 0xbf, 0x04, 0x09, 0x06, 0x62, 0xf1, 0x57, 0xc2, 0x97, 0x0f,
+	0x24, 0x80, 0x1c, 0x0a, 0xc6, 0x43, 0x9f, 0xf1, 0x20, 0x00, 0xcd, 0xb5, 0x0f, 0x81, 0x4a, 0xff,
+	0xb6, 0x3c, 0x50, 0x23, 0x50, 0xcc, 0xe5, 0x4a, 0x24, 0x36, 0x1b, 0xd3, 0x90, 0x4b, 0x3f, 0x30,
+	0xf0, 0x27, 0x9b, 0x9b, 0x2f, 0x9b, 0xc6, 0xb0, 0x35, 0x1f, 0x57, 0xae, 0x62, 0xb5, 0xeb, 0xf4,
+	0xb8, 0x7f, 0x36, 0x13, 0x5e, 0x64, 0x9c, 0x1d, 0x31, 0x8e, 0x84, 0xd2, 0xe4, 0x1c, 0xe3, 0xad,
+	0x45, 0x09, 0x55, 0x51, 0xb3, 0xd8, 0x6d, 0xd0, 0x24, 0x0f, 0x8d, 0xf3, 0xd0, 0xa4, 0x76, 0x6a,
+	0x42, 0x2f, 0xb9, 0x14, 0xe9, 0xac, 0x93, 0x99, 0xac, 0x3d, 0x22, 0x5c, 0xcd, 0xf7, 0x52, 0x21,
+	0x04, 0x4a, 0x10, 0x07, 0x63, 0xb1, 0x39, 0x2d, 0xa1, 0xea, 0xaf, 0x66, 0xb1, 0xdb, 0xa6, 0x79,
+	0xab, 0xa4, 0x5f, 0xa5, 0x7a, 0xbf, 0x17, 0xaf, 0x15, 0xcb, 0xc9, 0xa8, 0x90, 0x8b, 0x9d, 0x02,
+	0x05, 0x53, 0xe0, 0x70, 0x6f, 0x81, 0x24, 0x50, 0xb6, 0x41, 0xf7, 0x09, 0xe1, 0x3f, 0xa6, 0x01,
+	0x79, 0x40, 0xf8, 0xdf, 0x37, 0x35, 0xc8, 0x71, 0x7e, 0xd4, 0x3d, 0x6b, 0x2e, 0x9f, 0xfc, 0x64,
+	0x34, 0x09, 0x59, 0x6b, 0xdf, 0x3e, 0xbf, 0xdf, 0x17, 0x1a, 0xa4, 0xce, 0x72, 0xaf, 0x7e, 0xbb,
+	0x8f, 0xde, 0xe9, 0x62, 0x65, 0xa3, 0xe5, 0xca, 0x46, 0x6f, 0x2b, 0x1b, 0xdd, 0xad, 0x6d, 0x6b,
+	0xb9, 0xb6, 0xad, 0x97, 0xb5, 0x6d, 0xdd, 0xb4, 0xa4, 0xaf, 0x07, 0x91, 0x4b, 0x3d, 0x18, 0xb1,
+	0xf4, 0xc1, 0x19, 0xc1, 0xd9, 0x8e, 0xa4, 0x9e, 0x87, 0x42, 0xb9, 0x7f, 0xcd, 0x03, 0x3a, 0xfa,
+	0x18, 0x00, 0x4e, 0xbf, 0xf9, 0x27, 0x0e, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ScheduledExecutions returns the pending scheduled executions, by
+	// execution height.
+	ScheduledExecutions(ctx context.Context, in *QueryScheduledExecutionsRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ScheduledExecutions(ctx context.Context, in *QueryScheduledExecutionsRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionsResponse, error) {
+	out := new(QueryScheduledExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/gaia.govschedule.v1beta1.Query/ScheduledExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ScheduledExecutions returns the pending scheduled executions, by
+	// execution height.
+	ScheduledExecutions(context.Context, *QueryScheduledExecutionsRequest) (*QueryScheduledExecutionsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ScheduledExecutions(ctx context.Context, req *QueryScheduledExecutionsRequest) (*QueryScheduledExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExecutions not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ScheduledExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.govschedule.v1beta1.Query/ScheduledExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExecutions(ctx, req.(*QueryScheduledExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.govschedule.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduledExecutions",
+			Handler:    _Query_ScheduledExecutions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/govschedule/v1beta1/query.proto",
+}
+
+func (m *QueryScheduledExecutionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledExecutionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledExecutionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledExecutionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledExecutionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledExecutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Executions) > 0 {
+		for iNdEx := len(m.Executions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Executions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryScheduledExecutionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledExecutionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Executions) > 0 {
+		for _, e := range m.Executions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryScheduledExecutionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledExecutionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledExecutionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledExecutionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledExecutionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledExecutionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executions = append(m.Executions, ScheduledExecution{})
+			if err := m.Executions[len(m.Executions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
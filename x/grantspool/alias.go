@@ -0,0 +1,9 @@
+package grantspool
+
+import (
+	"github.com/cosmos/gaia/v9/x/grantspool/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
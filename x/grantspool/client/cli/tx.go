@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v9/x/grantspool/types"
+)
+
+// GrantsPoolSpendProposalJSON defines a grants pool spend proposal read from
+// a JSON file.
+type GrantsPoolSpendProposalJSON struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Recipient   string `json:"recipient"`
+	Amount      string `json:"amount"`
+	Deposit     string `json:"deposit"`
+}
+
+// ParseGrantsPoolSpendProposalJSON reads and parses a
+// GrantsPoolSpendProposalJSON from a file.
+func ParseGrantsPoolSpendProposalJSON(proposalFile string) (GrantsPoolSpendProposalJSON, error) {
+	var proposal GrantsPoolSpendProposalJSON
+
+	contents, err := os.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// GetCmdSubmitGrantsPoolSpendProposal implements the command to submit a
+// grants pool spend proposal.
+func GetCmdSubmitGrantsPoolSpendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants-pool-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a grants pool spend proposal",
+		Long: `Submit a grants pool spend proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Once the proposal passes, the
+amount is sent from the grants pool to the recipient.
+
+Example:
+$ gaiad tx gov submit-proposal grants-pool-spend <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Grant",
+  "description": "Fund the program",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": "1000uatom",
+  "deposit": "1000uatom"
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseGrantsPoolSpendProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(proposal.Amount)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(proposal.Recipient)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewGrantsPoolSpendProposal(proposal.Title, proposal.Description, recipient, amount)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/grantspool/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Pool returns the balance of the grants pool
+func (k Keeper) Pool(stdCtx context.Context, _ *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	return &types.QueryPoolResponse{Pool: k.GetPool(ctx)}, nil
+}
+
+// Params returns the module params
+func (k Keeper) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/gaia/v9/x/grantspool/types"
+)
+
+// Keeper of the grants pool
+type Keeper struct {
+	paramSpace       paramstypes.Subspace
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates a new grants pool Keeper instance
+func NewKeeper(paramSpace paramstypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, feeCollectorName string) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace:       paramSpace,
+		accountKeeper:    accountKeeper,
+		bankKeeper:       bankKeeper,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the module params. The params that are not set yet take
+// their default value.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetPool returns the balance of the grants pool.
+func (k Keeper) GetPool(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+}
+
+// FundFromFees sends the fee share of the balance of the fee collector to the
+// grants pool, rounded down, and returns the amount sent. It must run before
+// the fees are distributed and, to leave the minted coins out, before they
+// are minted.
+func (k Keeper) FundFromFees(ctx sdk.Context) (sdk.Coins, error) {
+	feeShare := k.GetParams(ctx).FeeShare
+	if feeShare.IsZero() {
+		return sdk.Coins{}, nil
+	}
+
+	fees := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(k.feeCollectorName))
+	share := SplitFees(fees, feeShare)
+	if share.IsZero() {
+		return share, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, share); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGrantsPoolFunded,
+		sdk.NewAttribute(types.AttributeKeyAmount, share.String()),
+	))
+
+	return share, nil
+}
+
+// SplitFees returns the feeShare fraction of the fees, rounded down.
+func SplitFees(fees sdk.Coins, feeShare sdk.Dec) sdk.Coins {
+	share := sdk.NewCoins()
+	for _, fee := range fees {
+		share = share.Add(sdk.NewCoin(fee.Denom, fee.Amount.ToDec().Mul(feeShare).TruncateInt()))
+	}
+	return share
+}
+
+// Spend sends the amount from the grants pool to the recipient.
+func (k Keeper) Spend(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) error {
+	if pool := k.GetPool(ctx); !pool.IsAllGTE(amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "grants pool %s is smaller than the spend %s", pool, amount)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGrantsPoolSpend,
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	))
+
+	return nil
+}
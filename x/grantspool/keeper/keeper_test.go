@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/grantspool/keeper"
+	"github.com/cosmos/gaia/v9/x/grantspool/types"
+)
+
+const denom = "stake"
+
+// setupGrantsPool returns an app whose fee collector holds the given fees.
+func setupGrantsPool(t *testing.T, fees sdk.Coins) (*gaiaapp.GaiaApp, sdk.Context) {
+	t.Helper()
+
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+	if !fees.IsZero() {
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+	}
+
+	return app, ctx
+}
+
+func TestFundFromFees(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000), sdk.NewInt64Coin("uatom", 55))
+	specs := map[string]struct {
+		feeShare sdk.Dec
+		expShare sdk.Coins
+	}{
+		"disabled": {
+			feeShare: sdk.ZeroDec(),
+			expShare: sdk.Coins{},
+		},
+		"fraction rounded down": {
+			feeShare: sdk.NewDecWithPrec(1, 1),
+			expShare: sdk.NewCoins(sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin("uatom", 5)),
+		},
+		"all the fees": {
+			feeShare: sdk.OneDec(),
+			expShare: fees,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			app, ctx := setupGrantsPool(t, fees)
+			k := app.GrantsPoolKeeper
+			k.SetParams(ctx, types.Params{FeeShare: spec.feeShare})
+
+			share, err := k.FundFromFees(ctx)
+			require.NoError(t, err)
+			require.Equal(t, spec.expShare, share)
+			require.Equal(t, spec.expShare, k.GetPool(ctx))
+
+			feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			require.Equal(t, fees.Sub(spec.expShare).String(), app.BankKeeper.GetAllBalances(ctx, feeCollector).String())
+		})
+	}
+}
+
+func TestFundFromFeesBeforeDistribution(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000))
+	app, ctx := setupGrantsPool(t, fees)
+	k := app.GrantsPoolKeeper
+	k.SetParams(ctx, types.Params{FeeShare: sdk.NewDecWithPrec(25, 2)})
+
+	// the minted coins are added to the fee collector after the grants pool
+	// got its share, and the fee collector is emptied by the distribution
+	app.BeginBlocker(ctx, abci.RequestBeginBlock{Header: ctx.BlockHeader()})
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 250)), k.GetPool(ctx))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+}
+
+func TestSplitFees(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin(denom, 9), sdk.NewInt64Coin("uatom", 10))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), keeper.SplitFees(fees, sdk.NewDecWithPrec(1, 1)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 4), sdk.NewInt64Coin("uatom", 5)), keeper.SplitFees(fees, sdk.NewDecWithPrec(5, 1)))
+	require.True(t, keeper.SplitFees(fees, sdk.ZeroDec()).IsZero())
+}
+
+func TestSpend(t *testing.T) {
+	app, ctx := setupGrantsPool(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000)))
+	k := app.GrantsPoolKeeper
+	k.SetParams(ctx, types.Params{FeeShare: sdk.NewDecWithPrec(5, 1)})
+	_, err := k.FundFromFees(ctx)
+	require.NoError(t, err)
+
+	recipient := sdk.AccAddress("recipient___________")
+	require.NoError(t, k.Spend(ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+	require.Equal(t, sdk.NewInt(300), app.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 200)), k.GetPool(ctx))
+
+	err = k.Spend(ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 201)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 200)), k.GetPool(ctx))
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	app, ctx := setupGrantsPool(t, sdk.Coins{})
+	k := app.GrantsPoolKeeper
+	require.True(t, k.GetParams(ctx).FeeShare.IsZero())
+
+	k.SetParams(ctx, types.Params{FeeShare: sdk.NewDecWithPrec(2, 2)})
+	genState := k.ExportGenesis(ctx)
+	require.NoError(t, genState.Validate())
+
+	app2, ctx2 := setupGrantsPool(t, sdk.Coins{})
+	app2.GrantsPoolKeeper.InitGenesis(ctx2, *genState)
+	require.Equal(t, genState, app2.GrantsPoolKeeper.ExportGenesis(ctx2))
+}
@@ -0,0 +1,28 @@
+package grantspool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v9/x/grantspool/keeper"
+	"github.com/cosmos/gaia/v9/x/grantspool/types"
+)
+
+// NewGrantsPoolSpendProposalHandler returns the gov handler of the grants
+// pool spend proposals.
+func NewGrantsPoolSpendProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.GrantsPoolSpendProposal:
+			recipient, err := sdk.AccAddressFromBech32(c.Recipient)
+			if err != nil {
+				return err
+			}
+			return k.Spend(ctx, recipient, c.Amount)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized grants pool proposal content type: %T", c)
+		}
+	}
+}
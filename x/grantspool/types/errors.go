@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/grantspool module sentinel errors
+var (
+	ErrInvalidSpend = sdkerrors.Register(ModuleName, 2, "invalid grants pool spend")
+)
@@ -0,0 +1,10 @@
+package types
+
+// grants pool module event types
+const (
+	EventTypeGrantsPoolFunded = "grants_pool_funded"
+	EventTypeGrantsPoolSpend  = "grants_pool_spend"
+
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyAmount    = "amount"
+)
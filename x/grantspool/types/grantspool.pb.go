@@ -0,0 +1,466 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/grantspool/v1beta1/grantspool.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GrantsPoolSpendProposal sends the amount from the grants pool to the
+// recipient once the proposal passed.
+type GrantsPoolSpendProposal struct {
+	Title       string                                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Recipient   string                                   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *GrantsPoolSpendProposal) Reset()      { *m = GrantsPoolSpendProposal{} }
+func (*GrantsPoolSpendProposal) ProtoMessage() {}
+func (*GrantsPoolSpendProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccea05cc5d33be50, []int{0}
+}
+func (m *GrantsPoolSpendProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantsPoolSpendProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantsPoolSpendProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantsPoolSpendProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantsPoolSpendProposal.Merge(m, src)
+}
+func (m *GrantsPoolSpendProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantsPoolSpendProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantsPoolSpendProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantsPoolSpendProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GrantsPoolSpendProposal)(nil), "gaia.grantspool.v1beta1.GrantsPoolSpendProposal")
+}
+
+func init() {
+	proto.RegisterFile("gaia/grantspool/v1beta1/grantspool.proto", fileDescriptor_ccea05cc5d33be50)
+}
+
+var fileDescriptor_ccea05cc5d33be50 = []byte{
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0x9d, 0xbf, 0x3f, 0x95, 0xea, 0x32, 0x45, 0x95, 0x1a, 0x2a, 0xe4, 0x54, 0x4c, 0x61,
+	0xc0, 0xa6, 0xb0, 0x31, 0xb6, 0x03, 0x6b, 0x55, 0x36, 0x36, 0xc7, 0xb5, 0x82, 0x45, 0xea, 0x6b,
+	0xc5, 0x2e, 0x82, 0x37, 0x60, 0x64, 0x64, 0xec, 0xcc, 0x93, 0x74, 0xec, 0xc8, 0x04, 0x28, 0x5d,
+	0x78, 0x0c, 0x54, 0x27, 0x40, 0x24, 0x26, 0xdb, 0xe7, 0x1c, 0x7f, 0xbe, 0xd7, 0x17, 0x27, 0x19,
+	0x57, 0x9c, 0x65, 0x05, 0xd7, 0xce, 0x1a, 0x80, 0x9c, 0xdd, 0x8d, 0x52, 0xe9, 0xf8, 0xa8, 0x21,
+	0x51, 0x53, 0x80, 0x83, 0xb0, 0xbf, 0x4b, 0xd2, 0x86, 0x5c, 0x27, 0x07, 0xbd, 0x0c, 0x32, 0xf0,
+	0x19, 0xb6, 0xdb, 0x55, 0xf1, 0x01, 0x11, 0x60, 0x17, 0x60, 0x59, 0xca, 0xad, 0xfc, 0x81, 0x0a,
+	0x50, 0xba, 0xf2, 0x8f, 0xca, 0x00, 0xf7, 0x2f, 0x3d, 0x6c, 0x0a, 0x90, 0x5f, 0x19, 0xa9, 0xe7,
+	0xd3, 0x02, 0x0c, 0x58, 0x9e, 0x87, 0x3d, 0xbc, 0xe7, 0x94, 0xcb, 0x65, 0x14, 0x0c, 0x83, 0xa4,
+	0x33, 0xab, 0x0e, 0xe1, 0x10, 0x77, 0xe7, 0xd2, 0x8a, 0x42, 0x19, 0xa7, 0x40, 0x47, 0xff, 0xbc,
+	0xd7, 0x94, 0xc2, 0x43, 0xdc, 0x29, 0xa4, 0x50, 0x46, 0x49, 0xed, 0xa2, 0x96, 0xf7, 0x7f, 0x85,
+	0x50, 0xe0, 0x36, 0x5f, 0xc0, 0x52, 0xbb, 0xe8, 0xff, 0xb0, 0x95, 0x74, 0xcf, 0x0e, 0x68, 0x55,
+	0x22, 0xdd, 0x95, 0xf8, 0xdd, 0x0d, 0x9d, 0x80, 0xd2, 0xe3, 0xd3, 0xf5, 0x5b, 0x8c, 0x5e, 0xde,
+	0xe3, 0x24, 0x53, 0xee, 0x66, 0x99, 0x52, 0x01, 0x0b, 0x56, 0xf7, 0x53, 0x2d, 0x27, 0x76, 0x7e,
+	0xcb, 0xdc, 0x83, 0x91, 0xd6, 0x5f, 0xb0, 0xb3, 0x1a, 0x7d, 0xb1, 0xff, 0xb8, 0x8a, 0xd1, 0xf3,
+	0x2a, 0x46, 0x9f, 0xab, 0x18, 0x8d, 0x27, 0xeb, 0x92, 0x04, 0x9b, 0x92, 0x04, 0x1f, 0x25, 0x09,
+	0x9e, 0xb6, 0x04, 0x6d, 0xb6, 0x04, 0xbd, 0x6e, 0x09, 0xba, 0x3e, 0xfe, 0x4b, 0xf6, 0x93, 0xb8,
+	0x6f, 0xce, 0xc2, 0x3f, 0x90, 0xb6, 0xfd, 0x87, 0x9d, 0x7f, 0x0d, 0x00, 0x59, 0xdc, 0x19, 0x30,
+	0xab, 0x01, 0x00, 0x00,
+}
+
+func (m *GrantsPoolSpendProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantsPoolSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantsPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGrantspool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGrantspool(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGrantspool(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGrantspool(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGrantspool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGrantspool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GrantsPoolSpendProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGrantspool(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGrantspool(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGrantspool(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGrantspool(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGrantspool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGrantspool(x uint64) (n int) {
+	return sovGrantspool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GrantsPoolSpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrantspool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantsPoolSpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantsPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrantspool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGrantspool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrantspool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrantspool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGrantspool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrantspool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrantspool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGrantspool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrantspool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrantspool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrantspool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrantspool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrantspool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrantspool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGrantspool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGrantspool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrantspool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrantspool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGrantspool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGrantspool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGrantspool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGrantspool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGrantspool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGrantspool = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName is the name of the this module, also the name of the module
+	// account holding the grants pool
+	ModuleName = "grantspool"
+
+	// RouterKey is the message route for the module proposals
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// ParamStoreKeyFeeShare store key
+var ParamStoreKeyFeeShare = []byte("FeeShare")
+
+// DefaultParams returns default parameters, without fees sent to the grants
+// pool.
+func DefaultParams() Params {
+	return Params{
+		FeeShare: sdk.ZeroDec(),
+	}
+}
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Validate performs basic params validation.
+func (p Params) Validate() error {
+	return validateFeeShare(p.FeeShare)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyFeeShare, &p.FeeShare, validateFeeShare,
+		),
+	}
+}
+
+// this requires the share to be within [0, 1]
+func validateFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Dec", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "fee share must be within [0, 1]: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ProposalTypeGrantsPoolSpend defines the type for a GrantsPoolSpendProposal
+const ProposalTypeGrantsPoolSpend = "GrantsPoolSpend"
+
+var _ govtypes.Content = &GrantsPoolSpendProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeGrantsPoolSpend)
+	govtypes.RegisterProposalTypeCodec(&GrantsPoolSpendProposal{}, "gaia/GrantsPoolSpendProposal")
+}
+
+// NewGrantsPoolSpendProposal creates a new grants pool spend proposal.
+func NewGrantsPoolSpendProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins) *GrantsPoolSpendProposal {
+	return &GrantsPoolSpendProposal{
+		Title:       title,
+		Description: description,
+		Recipient:   recipient.String(),
+		Amount:      amount,
+	}
+}
+
+// GetTitle returns the title of a grants pool spend proposal.
+func (p *GrantsPoolSpendProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a grants pool spend proposal.
+func (p *GrantsPoolSpendProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a grants pool spend proposal.
+func (p *GrantsPoolSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a grants pool spend proposal.
+func (p *GrantsPoolSpendProposal) ProposalType() string { return ProposalTypeGrantsPoolSpend }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *GrantsPoolSpendProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+	if !p.Amount.IsValid() || p.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidSpend, "invalid amount %s", p.Amount)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p GrantsPoolSpendProposal) String() string {
+	return fmt.Sprintf(`Grants Pool Spend Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+`, p.Title, p.Description, p.Recipient, p.Amount)
+}
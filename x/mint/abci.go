@@ -0,0 +1,74 @@
+package mint
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// EventTypeMintSkipped is the type of the event emitted when the inflation of
+// a block is not minted as it would exceed the supply cap of the bond denom.
+const EventTypeMintSkipped = "mint_skipped"
+
+// BeginBlocker mints new tokens for the previous block, as the BeginBlocker
+// of the SDK does. The mints exceeding the supply cap of the bond denom are
+// skipped rather than panicking, the minter being updated nonetheless.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// fetch stored minter & params
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	// recalculate inflation rate
+	totalStakingSupply := k.StakingTokenSupply(ctx)
+	bondedRatio := k.BondedRatio(ctx)
+	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+	k.SetMinter(ctx, minter)
+
+	// mint coins, update supply
+	mintedCoin := minter.BlockProvision(params)
+	mintedCoins := sdk.NewCoins(mintedCoin)
+
+	err := k.MintCoins(ctx, mintedCoins)
+	if sdkerrors.IsOf(err, policytypes.ErrSupplyCapExceeded) {
+		ctx.Logger().Error("skipping the block inflation", "err", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeMintSkipped,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.String()),
+			),
+		)
+		return
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	// send the minted coins to the fee collector account
+	err = k.AddCollectedFees(ctx, mintedCoins)
+	if err != nil {
+		panic(err)
+	}
+
+	if mintedCoin.Amount.IsInt64() {
+		defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyBondedRatio, bondedRatio.String()),
+			sdk.NewAttribute(types.AttributeKeyInflation, minter.Inflation.String()),
+			sdk.NewAttribute(types.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+		),
+	)
+}
@@ -0,0 +1,35 @@
+package mint_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/mint"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+func TestBeginBlockerSupplyCap(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	supply := app.BankKeeper.GetSupply(ctx, bondDenom)
+
+	// the supply is already at the cap, the inflation is skipped
+	app.GetSubspace(policytypes.ModuleName).Set(ctx, policytypes.ParamStoreKeySupplyCaps, sdk.NewCoins(supply))
+	require.NotPanics(t, func() { mint.BeginBlocker(ctx, app.MintKeeper) })
+	require.Equal(t, supply, app.BankKeeper.GetSupply(ctx, bondDenom))
+	var skipped bool
+	for _, event := range ctx.EventManager().Events() {
+		skipped = skipped || event.Type == mint.EventTypeMintSkipped
+	}
+	require.True(t, skipped)
+
+	// the inflation is minted again once the cap is lifted
+	app.GetSubspace(policytypes.ModuleName).Set(ctx, policytypes.ParamStoreKeySupplyCaps, sdk.Coins{})
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.True(t, app.BankKeeper.GetSupply(ctx, bondDenom).Amount.GT(supply.Amount))
+}
@@ -0,0 +1,35 @@
+package mint
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule wraps the mint module of the SDK to skip the inflation of the
+// blocks whose mint would take the supply of the bond denom above its cap,
+// instead of halting the chain. The other services of the module are
+// unchanged.
+type AppModule struct {
+	mint.AppModule
+	keeper keeper.Keeper
+}
+
+// NewAppModule creates a new AppModule object.
+func NewAppModule(cdc codec.Codec, k keeper.Keeper, ak types.AccountKeeper) AppModule {
+	return AppModule{
+		AppModule: mint.NewAppModule(cdc, k, ak),
+		keeper:    k,
+	}
+}
+
+// BeginBlock returns the begin blocker for the mint module.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlocker(ctx, am.keeper)
+}
@@ -0,0 +1,9 @@
+package policy
+
+import (
+	"github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
@@ -0,0 +1,92 @@
+package policy
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// HaltedMsgTypes returns the set of the msg types halted by the
+// HaltedMsgTypes param.
+func HaltedMsgTypes(ctx sdk.Context, paramSource ParamSource) map[string]bool {
+	var msgTypes []string
+	if paramSource.Has(ctx, types.ParamStoreKeyHaltedMsgTypes) {
+		paramSource.Get(ctx, types.ParamStoreKeyHaltedMsgTypes, &msgTypes)
+	}
+
+	halted := make(map[string]bool, len(msgTypes))
+	for _, msgType := range msgTypes {
+		halted[msgType] = true
+	}
+	return halted
+}
+
+// ValidateMsgNotHalted returns an error if the msg is of a halted type.
+func ValidateMsgNotHalted(halted map[string]bool, msg sdk.Msg) error {
+	if msgType := sdk.MsgTypeURL(msg); halted[msgType] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "msg type %s is halted by governance", msgType)
+	}
+	return nil
+}
+
+var _ gogogrpc.Server = CircuitBreaker{}
+
+// CircuitBreaker wraps the msg service router to fail the msgs of a type of
+// the HaltedMsgTypes param. The msg services are registered through it, so
+// that it checks every executed msg, including the msgs executed through authz
+// and by the interchain accounts, which are dispatched one by one to the
+// router.
+type CircuitBreaker struct {
+	router      gogogrpc.Server
+	paramSource ParamSource
+}
+
+// NewCircuitBreaker returns a CircuitBreaker registering the msg services on
+// the given router.
+func NewCircuitBreaker(router gogogrpc.Server, paramSource ParamSource) CircuitBreaker {
+	return CircuitBreaker{
+		router:      router,
+		paramSource: paramSource,
+	}
+}
+
+// RegisterService registers the service on the router, with the method
+// handlers checking the msg type before handling the msg.
+func (cb CircuitBreaker) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	wrapped := *sd
+	wrapped.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    cb.wrapMethodHandler(method.Handler),
+		}
+	}
+	cb.router.RegisterService(&wrapped, handler)
+}
+
+// wrapMethodHandler returns a method handler intercepting the decoded msg to
+// check its type before calling the handler of the msg server.
+func (cb CircuitBreaker) wrapMethodHandler(methodHandler func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error)) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(srv interface{}, goCtx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		return methodHandler(srv, goCtx, dec, func(goCtx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			checked := func(goCtx context.Context, req interface{}) (interface{}, error) {
+				if msg, ok := req.(sdk.Msg); ok {
+					halted := HaltedMsgTypes(sdk.UnwrapSDKContext(goCtx), cb.paramSource)
+					if err := ValidateMsgNotHalted(halted, msg); err != nil {
+						return nil, err
+					}
+				}
+				return handler(goCtx, req)
+			}
+			if interceptor == nil {
+				return checked(goCtx, req)
+			}
+			return interceptor(goCtx, req, info, checked)
+		})
+	}
+}
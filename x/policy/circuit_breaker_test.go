@@ -0,0 +1,67 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	sender := sdk.AccAddress("sender______________")
+	recipient := sdk.AccAddress("recipient___________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	send := banktypes.NewMsgSend(sender, recipient, coins)
+	exec := authz.NewMsgExec(sender, []sdk.Msg{send})
+
+	specs := map[string]struct {
+		haltedMsgTypes []string
+		msg            sdk.Msg
+		expErr         bool
+	}{
+		"no halted msg type": {
+			msg: send,
+		},
+		"halted msg type": {
+			haltedMsgTypes: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+			msg:            send,
+			expErr:         true,
+		},
+		"halted msg type executed through authz": {
+			haltedMsgTypes: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+			msg:            &exec,
+			expErr:         true,
+		},
+		"other msg type halted": {
+			haltedMsgTypes: []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})},
+			msg:            &exec,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			app := gaiahelpers.Setup(t)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			app.GetSubspace(types.ModuleName).Set(ctx, types.ParamStoreKeyHaltedMsgTypes, spec.haltedMsgTypes)
+			require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, sender, coins))
+
+			// the msgs are executed by the handlers of the msg service
+			// router, as by the interchain accounts host
+			handler := app.MsgServiceRouter().Handler(spec.msg)
+			require.NotNil(t, handler)
+			_, err := handler(ctx, spec.msg)
+			if spec.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+func TestDefaultGenesis(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	gotJSON := AppModuleBasic{}.DefaultGenesis(encCfg.Marshaler)
+	assert.JSONEq(t, `{"params":{"transfer_caps":[],"supply_caps":[],"halted_msg_types":[],"min_commission_rate":"0.000000000000000000","max_validator_creations_per_block":"10","max_delegations_per_delegator":"0","max_active_proposals":"100","deposit_denoms":[],"upgrade_freeze_blocks":"0","memo_required_addresses":[],"max_tx_bytes":"0","max_tx_bytes_bypass_exempt":false,"max_signatures_per_tx":"100","high_value_transfer_thresholds":[],"min_high_value_signers":"0","max_packet_data_bytes":"0"}}`, string(gotJSON), string(gotJSON))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	specs := map[string]struct {
+		src    string
+		expErr bool
+	}{
+		"all good": {
+			src: `{"params":{"transfer_caps":[{"denom":"ubridged","amount":"1000"}],"max_delegations_per_delegator":"10"}}`,
+		},
+		"empty params": {
+			src: `{"params":{}}`,
+		},
+		"zero transfer cap not allowed": {
+			src:    `{"params":{"transfer_caps":[{"denom":"ubridged","amount":"0"}]}}`,
+			expErr: true,
+		},
+		"halted msg types are allowed": {
+			src: `{"params":{"halted_msg_types":["/cosmos.bank.v1beta1.MsgSend"]}}`,
+		},
+		"halted gov msg types not allowed": {
+			src:    `{"params":{"halted_msg_types":["/cosmos.gov.v1beta1.MsgVote"]}}`,
+			expErr: true,
+		},
+		"min commission rate above one not allowed": {
+			src:    `{"params":{"min_commission_rate":"1.5"}}`,
+			expErr: true,
+		},
+		"duplicate deposit denoms not allowed": {
+			src:    `{"params":{"deposit_denoms":["uatom","uatom"]}}`,
+			expErr: true,
+		},
+		"supply caps must be sorted": {
+			src:    `{"params":{"supply_caps":[{"denom":"zlx","amount":"1"},{"denom":"alx","amount":"2"}]}}`,
+			expErr: true,
+		},
+		"upgrade freeze blocks is allowed": {
+			src: `{"params":{"upgrade_freeze_blocks":"10"}}`,
+		},
+		"memo required addresses are allowed": {
+			src: `{"params":{"memo_required_addresses":["cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"]}}`,
+		},
+		"duplicate memo required addresses not allowed": {
+			src:    `{"params":{"memo_required_addresses":["cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu","cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"]}}`,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := AppModuleBasic{}.ValidateGenesis(encCfg.Marshaler, nil, []byte(spec.src))
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestInitExportGenesis(t *testing.T) {
+	specs := map[string]struct {
+		src string
+		exp types.GenesisState
+	}{
+		"no params set": {
+			src: `{"params":{}}`,
+			exp: types.GenesisState{Params: types.Params{
+				TransferCaps:                sdk.Coins{},
+				SupplyCaps:                  sdk.Coins{},
+				HaltedMsgTypes:              []string{},
+				MinCommissionRate:           sdk.ZeroDec(),
+				DepositDenoms:               []string{},
+				MemoRequiredAddresses:       []string{},
+				HighValueTransferThresholds: sdk.Coins{},
+			}},
+		},
+		"transfer caps": {
+			src: `{"params":{"transfer_caps":[{"denom":"ubridged","amount":"1000"}]}}`,
+			exp: types.GenesisState{Params: types.Params{
+				TransferCaps:                sdk.NewCoins(sdk.NewInt64Coin("ubridged", 1000)),
+				SupplyCaps:                  sdk.Coins{},
+				HaltedMsgTypes:              []string{},
+				MinCommissionRate:           sdk.ZeroDec(),
+				DepositDenoms:               []string{},
+				MemoRequiredAddresses:       []string{},
+				HighValueTransferThresholds: sdk.Coins{},
+			}},
+		},
+		"max delegations per delegator": {
+			src: `{"params":{"max_delegations_per_delegator":"10"}}`,
+			exp: types.GenesisState{Params: types.Params{
+				TransferCaps:                sdk.Coins{},
+				SupplyCaps:                  sdk.Coins{},
+				HaltedMsgTypes:              []string{},
+				MinCommissionRate:           sdk.ZeroDec(),
+				MaxDelegationsPerDelegator:  10,
+				DepositDenoms:               []string{},
+				MemoRequiredAddresses:       []string{},
+				HighValueTransferThresholds: sdk.Coins{},
+			}},
+		},
+		"halted msg types": {
+			src: `{"params":{"halted_msg_types":["/cosmos.bank.v1beta1.MsgSend"]}}`,
+			exp: types.GenesisState{Params: types.Params{
+				TransferCaps:                sdk.Coins{},
+				SupplyCaps:                  sdk.Coins{},
+				HaltedMsgTypes:              []string{"/cosmos.bank.v1beta1.MsgSend"},
+				MinCommissionRate:           sdk.ZeroDec(),
+				DepositDenoms:               []string{},
+				MemoRequiredAddresses:       []string{},
+				HighValueTransferThresholds: sdk.Coins{},
+			}},
+		},
+		"memo required addresses": {
+			src: `{"params":{"memo_required_addresses":["cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"]}}`,
+			exp: types.GenesisState{Params: types.Params{
+				TransferCaps:                sdk.Coins{},
+				SupplyCaps:                  sdk.Coins{},
+				HaltedMsgTypes:              []string{},
+				MinCommissionRate:           sdk.ZeroDec(),
+				DepositDenoms:               []string{},
+				MemoRequiredAddresses:       []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"},
+				HighValueTransferThresholds: sdk.Coins{},
+			}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, encCfg, subspace := setupTestStore(t)
+			m := NewAppModule(subspace)
+			m.InitGenesis(ctx, encCfg.Marshaler, []byte(spec.src))
+			gotJSON := m.ExportGenesis(ctx, encCfg.Marshaler)
+			var got types.GenesisState
+			require.NoError(t, encCfg.Marshaler.UnmarshalJSON(gotJSON, &got))
+			assert.Equal(t, spec.exp, got, string(gotJSON))
+		})
+	}
+}
+
+func setupTestStore(t *testing.T) (sdk.Context, simappparams.EncodingConfig, paramstypes.Subspace) {
+	t.Helper()
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	encCfg := simapp.MakeTestEncodingConfig()
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	ms.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, storetypes.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	paramsKeeper := paramskeeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, keyParams, tkeyParams)
+
+	ctx := sdk.NewContext(ms, tmproto.Header{
+		Height: 1234567,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	}, false, log.NewNopLogger())
+
+	subspace := paramsKeeper.Subspace(ModuleName).WithKeyTable(types.ParamKeyTable())
+	return ctx, encCfg, subspace
+}
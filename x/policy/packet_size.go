@@ -0,0 +1,91 @@
+package policy
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// MaxPacketDataBytes returns the maximum size of the data of the transfer
+// packets set in the MaxPacketDataBytes param, zero when unlimited.
+func MaxPacketDataBytes(ctx sdk.Context, paramSource ParamSource) uint64 {
+	var maxBytes uint64
+	if paramSource.Has(ctx, types.ParamStoreKeyMaxPacketDataBytes) {
+		paramSource.Get(ctx, types.ParamStoreKeyMaxPacketDataBytes, &maxBytes)
+	}
+	return maxBytes
+}
+
+// ValidatePacketDataSize returns an error if the packet data is larger than
+// maxBytes. Zero disables the limit.
+func ValidatePacketDataSize(maxBytes uint64, data []byte) error {
+	if maxBytes > 0 && uint64(len(data)) > maxBytes {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "packet data of %d bytes exceeds the limit of %d bytes", len(data), maxBytes)
+	}
+	return nil
+}
+
+var _ ibctransfertypes.ICS4Wrapper = PacketSizeICS4Wrapper{}
+
+// PacketSizeICS4Wrapper rejects the transfer packets sent with data larger
+// than the MaxPacketDataBytes param, failing the TX sending them. The packets
+// received are limited by the PacketSizeMiddleware.
+type PacketSizeICS4Wrapper struct {
+	ibctransfertypes.ICS4Wrapper
+	paramSource ParamSource
+}
+
+// NewPacketSizeICS4Wrapper creates a new PacketSizeICS4Wrapper wrapping the
+// ICS4Wrapper of the transfer keeper.
+func NewPacketSizeICS4Wrapper(ics4Wrapper ibctransfertypes.ICS4Wrapper, paramSource ParamSource) PacketSizeICS4Wrapper {
+	return PacketSizeICS4Wrapper{
+		ICS4Wrapper: ics4Wrapper,
+		paramSource: paramSource,
+	}
+}
+
+// SendPacket implements the ICS4Wrapper interface.
+func (w PacketSizeICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	if err := ValidatePacketDataSize(MaxPacketDataBytes(ctx, w.paramSource), packet.GetData()); err != nil {
+		return err
+	}
+	return w.ICS4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+var _ porttypes.IBCModule = PacketSizeMiddleware{}
+
+// PacketSizeMiddleware rejects the transfer packets received with data larger
+// than the MaxPacketDataBytes param with an error acknowledgement, so that the
+// funds are refunded to the sender on the counterparty chain. The other
+// callbacks are passed through to the wrapped IBC module.
+type PacketSizeMiddleware struct {
+	porttypes.IBCModule
+	paramSource ParamSource
+}
+
+// NewPacketSizeMiddleware creates a new PacketSizeMiddleware wrapping the
+// given transfer stack.
+func NewPacketSizeMiddleware(app porttypes.IBCModule, paramSource ParamSource) PacketSizeMiddleware {
+	return PacketSizeMiddleware{
+		IBCModule:   app,
+		paramSource: paramSource,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im PacketSizeMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if err := ValidatePacketDataSize(MaxPacketDataBytes(ctx, im.paramSource), packet.GetData()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
@@ -0,0 +1,84 @@
+package policy
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// mockICS4Wrapper counts the packets sent.
+type mockICS4Wrapper struct {
+	sent int
+}
+
+func (m *mockICS4Wrapper) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, _ ibcexported.PacketI) error {
+	m.sent++
+	return nil
+}
+
+func TestMaxPacketDataBytes(t *testing.T) {
+	ctx, _, subspace := setupTestStore(t)
+
+	newPacket := func(memo string) channeltypes.Packet {
+		data := ibctransfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", "cosmos1receiver")
+		data.Memo = memo
+		return channeltypes.Packet{
+			Data:               data.GetBytes(),
+			SourcePort:         "transfer",
+			SourceChannel:      "channel-7",
+			DestinationPort:    "transfer",
+			DestinationChannel: "channel-1",
+		}
+	}
+
+	specs := map[string]struct {
+		maxBytes   uint64
+		packet     channeltypes.Packet
+		expSuccess bool
+	}{
+		"normal sized packet": {
+			maxBytes:   1024,
+			packet:     newPacket("note"),
+			expSuccess: true,
+		},
+		"oversized packet": {
+			maxBytes: 1024,
+			packet:   newPacket(strings.Repeat("x", 1024)),
+		},
+		"no limit": {
+			packet:     newPacket(strings.Repeat("x", 1024)),
+			expSuccess: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			subspace.Set(ctx, types.ParamStoreKeyMaxPacketDataBytes, spec.maxBytes)
+
+			// the packets received are acknowledged with an error
+			transferModule := &mockTransferModule{}
+			ack := NewPacketSizeMiddleware(transferModule, subspace).OnRecvPacket(ctx, spec.packet, nil)
+			require.Equal(t, spec.expSuccess, ack.Success())
+
+			// the packets sent are rejected
+			ics4Wrapper := &mockICS4Wrapper{}
+			err := NewPacketSizeICS4Wrapper(ics4Wrapper, subspace).SendPacket(ctx, nil, spec.packet)
+			if spec.expSuccess {
+				require.NoError(t, err)
+				require.Equal(t, 1, transferModule.received)
+				require.Equal(t, 1, ics4Wrapper.sent)
+				return
+			}
+			require.Error(t, err)
+			require.Zero(t, transferModule.received)
+			require.Zero(t, ics4Wrapper.sent)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package policy
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+var _ types.QueryServer = &GrpcQuerier{}
+
+// ParamSource is a read only subset of paramtypes.Subspace
+type ParamSource interface {
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+	Has(ctx sdk.Context, key []byte) bool
+}
+
+type GrpcQuerier struct {
+	paramSource ParamSource
+}
+
+func NewGrpcQuerier(paramSource ParamSource) GrpcQuerier {
+	return GrpcQuerier{paramSource: paramSource}
+}
+
+// Params returns the module params
+func (g GrpcQuerier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params := types.UnsetParams()
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	if g.paramSource.Has(ctx, types.ParamStoreKeyTransferCaps) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyTransferCaps, &params.TransferCaps)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeySupplyCaps) {
+		g.paramSource.Get(ctx, types.ParamStoreKeySupplyCaps, &params.SupplyCaps)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyHaltedMsgTypes) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyHaltedMsgTypes, &params.HaltedMsgTypes)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMinCommissionRate) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMinCommissionRate, &params.MinCommissionRate)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMaxValidatorCreationsPerBlock) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMaxValidatorCreationsPerBlock, &params.MaxValidatorCreationsPerBlock)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMaxDelegationsPerDelegator) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMaxDelegationsPerDelegator, &params.MaxDelegationsPerDelegator)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMaxActiveProposals) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMaxActiveProposals, &params.MaxActiveProposals)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyDepositDenoms) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyDepositDenoms, &params.DepositDenoms)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyUpgradeFreezeBlocks) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyUpgradeFreezeBlocks, &params.UpgradeFreezeBlocks)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMemoRequiredAddresses) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMemoRequiredAddresses, &params.MemoRequiredAddresses)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMaxTxBytes) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMaxTxBytes, &params.MaxTxBytes)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMaxTxBytesBypassExempt) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMaxTxBytesBypassExempt, &params.MaxTxBytesBypassExempt)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMaxSignaturesPerTx) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMaxSignaturesPerTx, &params.MaxSignaturesPerTx)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyHighValueTransferThresholds) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyHighValueTransferThresholds, &params.HighValueTransferThresholds)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMinHighValueSigners) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMinHighValueSigners, &params.MinHighValueSigners)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMaxPacketDataBytes) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMaxPacketDataBytes, &params.MaxPacketDataBytes)
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
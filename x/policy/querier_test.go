@@ -0,0 +1,32 @@
+package policy
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+func TestQueryParams(t *testing.T) {
+	ctx, _, subspace := setupTestStore(t)
+	q := NewGrpcQuerier(subspace)
+
+	// the unset params are reported as the checks apply them: the validator
+	// creations are unlimited
+	res, err := q.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, types.UnsetParams(), res.Params)
+	assert.Equal(t, uint64(0), res.Params.MaxValidatorCreationsPerBlock)
+	assert.Equal(t, types.DefaultMaxActiveProposals, res.Params.MaxActiveProposals)
+	assert.Equal(t, types.DefaultMaxSignaturesPerTx, res.Params.MaxSignaturesPerTx)
+
+	subspace.Set(ctx, types.ParamStoreKeyMaxValidatorCreationsPerBlock, uint64(3))
+	subspace.Set(ctx, types.ParamStoreKeyMaxSignaturesPerTx, uint64(3))
+	res, err = q.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), res.Params.MaxValidatorCreationsPerBlock)
+	assert.Equal(t, uint64(3), res.Params.MaxSignaturesPerTx)
+}
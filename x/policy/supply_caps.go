@@ -0,0 +1,36 @@
+package policy
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// SupplyKeeper defines the expected bank keeper
+type SupplyKeeper interface {
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+}
+
+// SupplyCaps returns the maximum total supplies set in the SupplyCaps param.
+func SupplyCaps(ctx sdk.Context, paramSource ParamSource) sdk.Coins {
+	var caps sdk.Coins
+	if paramSource.Has(ctx, types.ParamStoreKeySupplyCaps) {
+		paramSource.Get(ctx, types.ParamStoreKeySupplyCaps, &caps)
+	}
+	return caps
+}
+
+// ValidateSupplyCap returns an error if minting the coin takes the total
+// supply of its denom above the cap of the denom. The denoms without a cap
+// are uncapped.
+func ValidateSupplyCap(caps sdk.Coins, supply, minted sdk.Coin) error {
+	limit := caps.AmountOf(minted.Denom)
+	if !limit.IsPositive() {
+		return nil
+	}
+	if supply.Amount.Add(minted.Amount).GT(limit) {
+		return sdkerrors.Wrapf(types.ErrSupplyCapExceeded, "minting %s takes the supply of %s above the cap of %s%s", minted, supply, limit, minted.Denom)
+	}
+	return nil
+}
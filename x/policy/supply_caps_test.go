@@ -0,0 +1,91 @@
+package policy
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// mockSupplyKeeper returns the same supply for every denom.
+type mockSupplyKeeper struct {
+	supply sdk.Int
+}
+
+func (m mockSupplyKeeper) GetSupply(_ sdk.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, m.supply)
+}
+
+func TestSupplyCapMiddlewareOnRecvPacket(t *testing.T) {
+	ctx, _, subspace := setupTestStore(t)
+
+	// the vouchers of the ucounter tokens received over channel-1
+	voucherDenom := ibctransfertypes.ParseDenomTrace("transfer/channel-1/ucounter").IBCDenom()
+	params := types.DefaultParams()
+	params.SupplyCaps = sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 1000), sdk.NewInt64Coin("ubridged", 1000))
+	subspace.SetParamSet(ctx, &params)
+
+	newPacket := func(denom, amount string) channeltypes.Packet {
+		data := ibctransfertypes.NewFungibleTokenPacketData(denom, amount, "cosmos1sender", "cosmos1receiver")
+		return channeltypes.Packet{
+			Data:               data.GetBytes(),
+			SourcePort:         "transfer",
+			SourceChannel:      "channel-7",
+			DestinationPort:    "transfer",
+			DestinationChannel: "channel-1",
+		}
+	}
+
+	specs := map[string]struct {
+		packet     channeltypes.Packet
+		expSuccess bool
+	}{
+		"capped voucher under the cap": {
+			packet:     newPacket("ucounter", "99"),
+			expSuccess: true,
+		},
+		"capped voucher at the cap": {
+			packet:     newPacket("ucounter", "100"),
+			expSuccess: true,
+		},
+		"capped voucher over the cap": {
+			packet: newPacket("ucounter", "101"),
+		},
+		"capped native denom coming back over the cap": {
+			packet:     newPacket("transfer/channel-7/ubridged", "101"),
+			expSuccess: true,
+		},
+		"uncapped denom": {
+			packet:     newPacket("uatom", "1000000000"),
+			expSuccess: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			transferModule := &mockTransferModule{}
+			middleware := NewTransferCapMiddleware(transferModule, subspace, mockSupplyKeeper{supply: sdk.NewInt(900)})
+
+			ack := middleware.OnRecvPacket(ctx, spec.packet, nil)
+			require.Equal(t, spec.expSuccess, ack.Success())
+			if spec.expSuccess {
+				require.Equal(t, 1, transferModule.received)
+			} else {
+				require.Zero(t, transferModule.received)
+			}
+		})
+	}
+}
+
+func TestValidateSupplyCap(t *testing.T) {
+	caps := sdk.NewCoins(sdk.NewInt64Coin("ubridged", 1000))
+	supply := sdk.NewInt64Coin("ubridged", 900)
+
+	require.NoError(t, ValidateSupplyCap(caps, supply, sdk.NewInt64Coin("ubridged", 100)))
+	require.ErrorContains(t, ValidateSupplyCap(caps, supply, sdk.NewInt64Coin("ubridged", 101)), "above the cap of 1000ubridged")
+	require.NoError(t, ValidateSupplyCap(caps, sdk.NewInt64Coin("uatom", 900), sdk.NewInt64Coin("uatom", 101)))
+	require.NoError(t, ValidateSupplyCap(nil, supply, sdk.NewInt64Coin("ubridged", 101)))
+}
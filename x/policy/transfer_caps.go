@@ -0,0 +1,111 @@
+package policy
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// TransferCaps returns the maximum amounts movable in a single transfer set
+// in the TransferCaps param.
+func TransferCaps(ctx sdk.Context, paramSource ParamSource) sdk.Coins {
+	var caps sdk.Coins
+	if paramSource.Has(ctx, types.ParamStoreKeyTransferCaps) {
+		paramSource.Get(ctx, types.ParamStoreKeyTransferCaps, &caps)
+	}
+	return caps
+}
+
+// ValidateTransferCaps returns an error if the amount of a denom transferred
+// exceeds the cap of the denom. The denoms without a cap are uncapped.
+func ValidateTransferCaps(caps, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if limit := caps.AmountOf(coin.Denom); limit.IsPositive() && coin.Amount.GT(limit) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "transfer of %s exceeds the cap of %s%s", coin, limit, coin.Denom)
+		}
+	}
+	return nil
+}
+
+var _ porttypes.IBCModule = TransferCapMiddleware{}
+
+// TransferCapMiddleware rejects the transfer packets received for an amount
+// above the transfer cap of their denom on this chain, or minting vouchers
+// above the supply cap of their denom, with an error acknowledgement, so that
+// the funds are refunded to the sender on the counterparty chain. The other
+// callbacks are passed through to the wrapped IBC module.
+//
+// Only the receipts are checked against the supply caps: the refunds of the
+// packets sent mint back vouchers burnt by this chain, and rejecting them
+// would leave the packets unacknowledged.
+type TransferCapMiddleware struct {
+	porttypes.IBCModule
+	paramSource  ParamSource
+	supplyKeeper SupplyKeeper
+}
+
+// NewTransferCapMiddleware creates a new TransferCapMiddleware wrapping the
+// given transfer stack.
+func NewTransferCapMiddleware(app porttypes.IBCModule, paramSource ParamSource, supplyKeeper SupplyKeeper) TransferCapMiddleware {
+	return TransferCapMiddleware{
+		IBCModule:    app,
+		paramSource:  paramSource,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im TransferCapMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	transferCaps := TransferCaps(ctx, im.paramSource)
+	supplyCaps := SupplyCaps(ctx, im.paramSource)
+	if transferCaps.Empty() && supplyCaps.Empty() {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not a transfer packet, left to the wrapped module to reject
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	// an invalid amount is rejected by the wrapped module
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok || !amount.IsPositive() {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	coin := sdk.Coin{Denom: receivedDenom(packet, data.Denom), Amount: amount}
+	if err := ValidateTransferCaps(transferCaps, sdk.Coins{coin}); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// the tokens coming back to this chain are unescrowed, the others are
+	// minted as vouchers
+	if !supplyCaps.Empty() && !ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		if err := ValidateSupplyCap(supplyCaps, im.supplyKeeper.GetSupply(ctx, coin.Denom), coin); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// receivedDenom returns the denom on this chain of the tokens of a transfer
+// packet, as derived by the transfer module when receiving them.
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	if ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// the tokens come back to this chain, the denom is unprefixed
+		prefix := ibctransfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return ibctransfertypes.ParseDenomTrace(denom[len(prefix):]).IBCDenom()
+	}
+	prefix := ibctransfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	return ibctransfertypes.ParseDenomTrace(prefix + denom).IBCDenom()
+}
@@ -0,0 +1,97 @@
+package policy
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// mockTransferModule acknowledges every packet successfully.
+type mockTransferModule struct {
+	porttypes.IBCModule
+	received int
+}
+
+func (m *mockTransferModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	m.received++
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func TestTransferCapMiddlewareOnRecvPacket(t *testing.T) {
+	ctx, _, subspace := setupTestStore(t)
+
+	// the vouchers of the ucounter tokens received over channel-1
+	voucherDenom := ibctransfertypes.ParseDenomTrace("transfer/channel-1/ucounter").IBCDenom()
+	params := types.DefaultParams()
+	params.TransferCaps = sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 1000), sdk.NewInt64Coin("ubridged", 500))
+	subspace.SetParamSet(ctx, &params)
+
+	newPacket := func(denom, amount string) channeltypes.Packet {
+		data := ibctransfertypes.NewFungibleTokenPacketData(denom, amount, "cosmos1sender", "cosmos1receiver")
+		return channeltypes.Packet{
+			Data:               data.GetBytes(),
+			SourcePort:         "transfer",
+			SourceChannel:      "channel-7",
+			DestinationPort:    "transfer",
+			DestinationChannel: "channel-1",
+		}
+	}
+
+	specs := map[string]struct {
+		packet     channeltypes.Packet
+		expSuccess bool
+	}{
+		"capped voucher at the cap": {
+			packet:     newPacket("ucounter", "1000"),
+			expSuccess: true,
+		},
+		"capped voucher over the cap": {
+			packet: newPacket("ucounter", "1001"),
+		},
+		"capped native denom coming back over the cap": {
+			packet: newPacket("transfer/channel-7/ubridged", "501"),
+		},
+		"capped native denom coming back at the cap": {
+			packet:     newPacket("transfer/channel-7/ubridged", "500"),
+			expSuccess: true,
+		},
+		"uncapped denom": {
+			packet:     newPacket("uatom", "1000000000"),
+			expSuccess: true,
+		},
+		"not a transfer packet": {
+			packet:     channeltypes.Packet{Data: []byte("not json")},
+			expSuccess: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			transferModule := &mockTransferModule{}
+			middleware := NewTransferCapMiddleware(transferModule, subspace, nil)
+
+			ack := middleware.OnRecvPacket(ctx, spec.packet, nil)
+			require.Equal(t, spec.expSuccess, ack.Success())
+			if spec.expSuccess {
+				require.Equal(t, 1, transferModule.received)
+			} else {
+				require.Zero(t, transferModule.received)
+			}
+		})
+	}
+}
+
+func TestValidateTransferCaps(t *testing.T) {
+	caps := sdk.NewCoins(sdk.NewInt64Coin("ubridged", 1000))
+
+	require.NoError(t, ValidateTransferCaps(caps, sdk.NewCoins(sdk.NewInt64Coin("ubridged", 1000))))
+	require.Error(t, ValidateTransferCaps(caps, sdk.NewCoins(sdk.NewInt64Coin("ubridged", 1001))))
+	require.NoError(t, ValidateTransferCaps(caps, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1001))))
+	require.NoError(t, ValidateTransferCaps(nil, sdk.NewCoins(sdk.NewInt64Coin("ubridged", 1001))))
+}
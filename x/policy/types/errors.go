@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/policy module sentinel errors
+var (
+	ErrSupplyCapExceeded = sdkerrors.Register(ModuleName, 2, "supply cap exceeded")
+)
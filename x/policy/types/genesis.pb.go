@@ -0,0 +1,1266 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/policy/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GenesisState struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56a451411cd5761b, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type Params struct {
+	// TransferCaps sets the maximum amount of a denom movable in a single
+	// transfer, i.e. a bank send or an IBC transfer in either direction. The
+	// denoms without a cap are uncapped.
+	TransferCaps github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=transfer_caps,json=transferCaps,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"transfer_caps,omitempty" yaml:"transfer_caps"`
+	// SupplyCaps sets the maximum total supply of a denom. The mints of a denom
+	// above its cap are rejected, the IBC transfers received minting vouchers
+	// with an error acknowledgement. The denoms without a cap are uncapped.
+	SupplyCaps github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=supply_caps,json=supplyCaps,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply_caps,omitempty" yaml:"supply_caps"`
+	// HaltedMsgTypes are the type URLs of the messages, e.g.
+	// /cosmos.bank.v1beta1.MsgMultiSend, whose TXs are rejected, including
+	// through an authz MsgExec, to pause a module in an emergency. The gov
+	// messages cannot be halted. No duplicate message types are allowed.
+	HaltedMsgTypes []string `protobuf:"bytes,3,rep,name=halted_msg_types,json=haltedMsgTypes,proto3" json:"halted_msg_types,omitempty" yaml:"halted_msg_types"`
+	// MinCommissionRate is the minimum commission rate of the validators. The
+	// validators cannot be created nor edited with a commission rate below the
+	// minimum, the validators below the minimum are bumped up to the minimum on
+	// their next edit. Zero disables the minimum.
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate,omitempty" yaml:"min_commission_rate"`
+	// MaxValidatorCreationsPerBlock is the maximum number of validators created
+	// in a block, including through an authz MsgExec. The validator creations
+	// beyond it fail until the next block. Zero disables the limit.
+	MaxValidatorCreationsPerBlock uint64 `protobuf:"varint,5,opt,name=max_validator_creations_per_block,json=maxValidatorCreationsPerBlock,proto3" json:"max_validator_creations_per_block,omitempty" yaml:"max_validator_creations_per_block"`
+	// MaxDelegationsPerDelegator is the maximum number of distinct validators a
+	// delegator can delegate to. The delegations to a new validator above the
+	// cap are rejected, the delegators already above the cap can only top up
+	// or reduce their existing delegations. Zero disables the cap.
+	MaxDelegationsPerDelegator uint64 `protobuf:"varint,6,opt,name=max_delegations_per_delegator,json=maxDelegationsPerDelegator,proto3" json:"max_delegations_per_delegator,omitempty" yaml:"max_delegations_per_delegator"`
+	// MaxActiveProposals is the maximum number of proposals in their deposit or
+	// voting period. The proposals submitted once the cap is reached are
+	// rejected. Zero disables the cap.
+	MaxActiveProposals uint64 `protobuf:"varint,7,opt,name=max_active_proposals,json=maxActiveProposals,proto3" json:"max_active_proposals,omitempty" yaml:"max_active_proposals"`
+	// DepositDenoms are the denoms the deposits of the gov proposals, initial
+	// or not, can be paid in. The deposits in other denoms are rejected,
+	// including through an authz MsgExec. Empty allows the denoms of the
+	// MinDeposit gov param only. No duplicate denoms are allowed.
+	DepositDenoms []string `protobuf:"bytes,8,rep,name=deposit_denoms,json=depositDenoms,proto3" json:"deposit_denoms,omitempty" yaml:"deposit_denoms"`
+	// UpgradeFreezeBlocks is the number of blocks before the height of a
+	// scheduled upgrade during which the txs not made only of bypass message
+	// types are rejected from the mempool. Zero disables the freeze window.
+	UpgradeFreezeBlocks uint64 `protobuf:"varint,9,opt,name=upgrade_freeze_blocks,json=upgradeFreezeBlocks,proto3" json:"upgrade_freeze_blocks,omitempty" yaml:"upgrade_freeze_blocks"`
+	// MemoRequiredAddresses are the recipient addresses, e.g. exchange deposit
+	// addresses, the bank sends to which are rejected when the TX has an empty
+	// memo. No duplicate addresses are allowed.
+	MemoRequiredAddresses []string `protobuf:"bytes,10,rep,name=memo_required_addresses,json=memoRequiredAddresses,proto3" json:"memo_required_addresses,omitempty" yaml:"memo_required_addresses"`
+	// MaxTxBytes is the maximum size in bytes of a serialized transaction. The
+	// larger transactions are rejected. Zero disables the limit.
+	MaxTxBytes uint64 `protobuf:"varint,11,opt,name=max_tx_bytes,json=maxTxBytes,proto3" json:"max_tx_bytes,omitempty" yaml:"max_tx_bytes"`
+	// MaxTxBytesBypassExempt exempts the transactions bypassing the fees, i.e.
+	// made only of bypass message types within the bypass gas limit, from the
+	// MaxTxBytes limit. As the bypass message types are node config, the limit
+	// is then only enforced when the transactions enter the mempool.
+	MaxTxBytesBypassExempt bool `protobuf:"varint,12,opt,name=max_tx_bytes_bypass_exempt,json=maxTxBytesBypassExempt,proto3" json:"max_tx_bytes_bypass_exempt,omitempty" yaml:"max_tx_bytes_bypass_exempt"`
+	// MaxSignaturesPerTx is the maximum number of signatures of a transaction,
+	// the signatures of a multisig counting individually. The transactions with
+	// more signatures are rejected. Zero disables the limit.
+	MaxSignaturesPerTx uint64 `protobuf:"varint,13,opt,name=max_signatures_per_tx,json=maxSignaturesPerTx,proto3" json:"max_signatures_per_tx,omitempty" yaml:"max_signatures_per_tx"`
+	// HighValueTransferThresholds sets the amount of a denom above which the
+	// bank sends of a TX, summed across its messages, are high value. The
+	// denoms without a threshold are never high value.
+	HighValueTransferThresholds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=high_value_transfer_thresholds,json=highValueTransferThresholds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"high_value_transfer_thresholds,omitempty" yaml:"high_value_transfer_thresholds"`
+	// MinHighValueSigners is the minimum number of distinct signatures of a TX
+	// making high value bank sends, the signatures of a multisig counting
+	// individually. The TXs with fewer signatures are rejected. Zero disables
+	// the check.
+	MinHighValueSigners uint64 `protobuf:"varint,15,opt,name=min_high_value_signers,json=minHighValueSigners,proto3" json:"min_high_value_signers,omitempty" yaml:"min_high_value_signers"`
+	// MaxPacketDataBytes is the maximum size in bytes of the data of the IBC
+	// transfer packets, sent or received. The packets received with larger data
+	// are rejected with an error acknowledgement, the larger packets sent are
+	// rejected with the TX sending them. Zero disables the limit.
+	MaxPacketDataBytes uint64 `protobuf:"varint,16,opt,name=max_packet_data_bytes,json=maxPacketDataBytes,proto3" json:"max_packet_data_bytes,omitempty" yaml:"max_packet_data_bytes"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56a451411cd5761b, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetTransferCaps() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TransferCaps
+	}
+	return nil
+}
+
+func (m *Params) GetSupplyCaps() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SupplyCaps
+	}
+	return nil
+}
+
+func (m *Params) GetHaltedMsgTypes() []string {
+	if m != nil {
+		return m.HaltedMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxValidatorCreationsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxValidatorCreationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDelegationsPerDelegator() uint64 {
+	if m != nil {
+		return m.MaxDelegationsPerDelegator
+	}
+	return 0
+}
+
+func (m *Params) GetMaxActiveProposals() uint64 {
+	if m != nil {
+		return m.MaxActiveProposals
+	}
+	return 0
+}
+
+func (m *Params) GetDepositDenoms() []string {
+	if m != nil {
+		return m.DepositDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetUpgradeFreezeBlocks() uint64 {
+	if m != nil {
+		return m.UpgradeFreezeBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMemoRequiredAddresses() []string {
+	if m != nil {
+		return m.MemoRequiredAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTxBytes() uint64 {
+	if m != nil {
+		return m.MaxTxBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTxBytesBypassExempt() bool {
+	if m != nil {
+		return m.MaxTxBytesBypassExempt
+	}
+	return false
+}
+
+func (m *Params) GetMaxSignaturesPerTx() uint64 {
+	if m != nil {
+		return m.MaxSignaturesPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetHighValueTransferThresholds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.HighValueTransferThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetMinHighValueSigners() uint64 {
+	if m != nil {
+		return m.MinHighValueSigners
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPacketDataBytes() uint64 {
+	if m != nil {
+		return m.MaxPacketDataBytes
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "gaia.policy.v1beta1.GenesisState")
+	proto.RegisterType((*Params)(nil), "gaia.policy.v1beta1.Params")
+}
+
+func init() { proto.RegisterFile("gaia/policy/v1beta1/genesis.proto", fileDescriptor_56a451411cd5761b) }
+
+var fileDescriptor_56a451411cd5761b = []byte{
+	// 997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x6b, 0xba, 0x94, 0xed, 0xf4, 0x07, 0x5d, 0xf7, 0x97, 0x49, 0x69, 0x9c, 0x5a, 0xbb,
+	0x10, 0x09, 0x48, 0xd4, 0x45, 0x1c, 0xe0, 0x82, 0x9a, 0x76, 0x59, 0x2e, 0x95, 0x22, 0xb7, 0xda,
+	0x03, 0x3f, 0x34, 0x9a, 0xd8, 0xb3, 0x8e, 0xd5, 0x8c, 0xc7, 0xcc, 0x4c, 0x8a, 0xb3, 0x17, 0x4e,
+	0x1c, 0xb8, 0x71, 0x81, 0x0b, 0xe2, 0x84, 0xb8, 0xf0, 0x97, 0xec, 0x71, 0x8f, 0x88, 0x83, 0x41,
+	0xad, 0xc4, 0xc1, 0x47, 0xfe, 0x02, 0x34, 0x33, 0x76, 0x12, 0x37, 0x6e, 0xbb, 0x7b, 0x4a, 0xfc,
+	0xde, 0xe7, 0xbd, 0xef, 0x77, 0xe6, 0x8d, 0x3c, 0x06, 0x7b, 0x01, 0x0a, 0x51, 0x3b, 0xa6, 0x83,
+	0xd0, 0x1b, 0xb5, 0xcf, 0xf7, 0x7b, 0x58, 0xa0, 0xfd, 0x76, 0x80, 0x23, 0xcc, 0x43, 0xde, 0x8a,
+	0x19, 0x15, 0xd4, 0x5c, 0x97, 0x48, 0x4b, 0x23, 0xad, 0x1c, 0xa9, 0x6d, 0x04, 0x34, 0xa0, 0x2a,
+	0xdf, 0x96, 0xff, 0x34, 0x5a, 0xab, 0x7b, 0x94, 0x13, 0xca, 0xdb, 0x3d, 0xc4, 0xf1, 0xb8, 0x9b,
+	0x47, 0xc3, 0x48, 0xe7, 0x9d, 0xaf, 0xc1, 0xf2, 0x63, 0xdd, 0xfb, 0x44, 0x20, 0x81, 0xcd, 0x63,
+	0xb0, 0x10, 0x23, 0x86, 0x08, 0xb7, 0x8c, 0x86, 0xd1, 0x5c, 0x7a, 0xb8, 0xd3, 0xaa, 0xd0, 0x6a,
+	0x75, 0x15, 0xd2, 0xb1, 0x9e, 0xa7, 0xf6, 0x5c, 0x96, 0xda, 0x6b, 0xba, 0xe4, 0x7d, 0x4a, 0x42,
+	0x81, 0x49, 0x2c, 0x46, 0x6e, 0xde, 0xc4, 0xf9, 0xe9, 0x1e, 0x58, 0xd0, 0xb0, 0xf9, 0x9b, 0x01,
+	0x56, 0x04, 0x43, 0x11, 0x7f, 0x8a, 0x19, 0xf4, 0x50, 0x2c, 0x15, 0xe6, 0x9b, 0x4b, 0x0f, 0xdf,
+	0x6a, 0x69, 0x8b, 0x2d, 0x69, 0x71, 0xac, 0x70, 0x48, 0xc3, 0xa8, 0xe3, 0xe5, 0xfd, 0xb7, 0x4b,
+	0x75, 0x13, 0x99, 0xff, 0x52, 0x7b, 0x63, 0x84, 0xc8, 0xe0, 0x13, 0xa7, 0x04, 0x38, 0x7f, 0xfc,
+	0x6d, 0x37, 0x83, 0x50, 0xf4, 0x87, 0xbd, 0x96, 0x47, 0x49, 0x3b, 0xdf, 0x02, 0xfd, 0xf3, 0x01,
+	0xf7, 0xcf, 0xda, 0x62, 0x14, 0x63, 0xae, 0x34, 0xb8, 0xbb, 0x5c, 0xd4, 0x1e, 0xa2, 0x98, 0x9b,
+	0xbf, 0x18, 0x60, 0x89, 0x0f, 0xe3, 0x78, 0x30, 0xd2, 0x1e, 0x5f, 0xbb, 0xcd, 0x23, 0xcc, 0x3d,
+	0x6e, 0x4e, 0x55, 0x95, 0x1c, 0x9a, 0xda, 0xe1, 0x54, 0xfa, 0xd5, 0xfc, 0x01, 0x5d, 0xa9, 0xdc,
+	0x79, 0x60, 0xad, 0x8f, 0x06, 0x02, 0xfb, 0x90, 0xf0, 0x00, 0x2a, 0xca, 0x9a, 0x6f, 0xcc, 0x37,
+	0x17, 0x3b, 0x1f, 0x67, 0xa9, 0x5d, 0xbb, 0x9a, 0x2b, 0xf9, 0xd8, 0xd6, 0x3e, 0xae, 0x32, 0x8e,
+	0xbb, 0xaa, 0x43, 0xc7, 0x3c, 0x38, 0x95, 0x01, 0xf3, 0x57, 0x03, 0xac, 0x93, 0x30, 0x82, 0x1e,
+	0x25, 0x24, 0xe4, 0x3c, 0xa4, 0x11, 0x64, 0x48, 0x60, 0xeb, 0x4e, 0xc3, 0x68, 0x2e, 0x76, 0x88,
+	0x5c, 0xef, 0x5f, 0xa9, 0xfd, 0xce, 0x4b, 0x2c, 0xe0, 0x08, 0x7b, 0x59, 0x6a, 0xef, 0x56, 0x34,
+	0x2b, 0x39, 0xab, 0x69, 0x67, 0x15, 0x98, 0xe3, 0xde, 0x23, 0x61, 0x74, 0x38, 0x0e, 0xba, 0xf2,
+	0x88, 0xfe, 0x6e, 0x80, 0x3d, 0x82, 0x12, 0x78, 0x8e, 0x06, 0xa1, 0x8f, 0x04, 0x65, 0xd0, 0x63,
+	0x18, 0x89, 0x90, 0x46, 0x1c, 0xc6, 0x98, 0xc1, 0xde, 0x80, 0x7a, 0x67, 0xd6, 0xeb, 0x0d, 0xa3,
+	0x79, 0xa7, 0xf3, 0x65, 0x96, 0xda, 0xef, 0xdd, 0x0a, 0x97, 0xdc, 0x34, 0x73, 0x37, 0xb7, 0x15,
+	0x39, 0xee, 0x2e, 0x41, 0xc9, 0x93, 0x02, 0x39, 0x2c, 0x88, 0x2e, 0x66, 0x1d, 0x99, 0x37, 0x7f,
+	0x36, 0x80, 0x24, 0xa0, 0x8f, 0x07, 0x38, 0x98, 0xaa, 0xce, 0x9f, 0x29, 0xb3, 0x16, 0x94, 0xc7,
+	0x93, 0x2c, 0xb5, 0xdf, 0xbd, 0x11, 0x2c, 0xf9, 0xbb, 0x3f, 0xf1, 0x77, 0x6d, 0x81, 0xe3, 0xd6,
+	0x08, 0x4a, 0x8e, 0x26, 0xe9, 0x2e, 0x66, 0x47, 0x45, 0xd2, 0xe4, 0x60, 0x43, 0x56, 0x23, 0x4f,
+	0x84, 0xe7, 0x18, 0xc6, 0x8c, 0xc6, 0x94, 0xa3, 0x01, 0xb7, 0xde, 0x50, 0x76, 0x0e, 0xb2, 0xd4,
+	0xae, 0x57, 0xe5, 0x4b, 0x2e, 0x76, 0x26, 0x2e, 0xae, 0x72, 0x8e, 0x6b, 0x12, 0x94, 0x1c, 0xa8,
+	0x68, 0xb7, 0x08, 0x9a, 0x5f, 0x81, 0x55, 0x1f, 0xc7, 0x94, 0x87, 0x02, 0xfa, 0x38, 0xa2, 0x84,
+	0x5b, 0x77, 0xd5, 0xc1, 0xfd, 0x28, 0x4b, 0x6d, 0xab, 0x9c, 0x29, 0x09, 0x6d, 0x6a, 0xa1, 0x32,
+	0xe1, 0xb8, 0x2b, 0x79, 0xe0, 0x48, 0x3d, 0x9b, 0xdf, 0x82, 0xcd, 0x61, 0x1c, 0x30, 0xe4, 0x63,
+	0xf8, 0x94, 0x61, 0xfc, 0x0c, 0xeb, 0x19, 0x71, 0x6b, 0x51, 0xad, 0xe9, 0x30, 0x4b, 0x6d, 0xbb,
+	0x12, 0x28, 0x69, 0xbd, 0xad, 0xb5, 0x2a, 0x41, 0xc7, 0x5d, 0xcf, 0xe3, 0x9f, 0xa9, 0xb0, 0x9a,
+	0x31, 0x37, 0xbf, 0x03, 0xdb, 0x04, 0x13, 0x0a, 0x19, 0xfe, 0x66, 0x18, 0x32, 0xec, 0x43, 0xe4,
+	0xfb, 0x0c, 0x73, 0x8e, 0xb9, 0x05, 0xd4, 0xfa, 0x1e, 0x67, 0xa9, 0xbd, 0x77, 0x0d, 0x52, 0x12,
+	0xaf, 0xe7, 0x3b, 0x5a, 0x8d, 0x3a, 0xee, 0xa6, 0xcc, 0xb8, 0x79, 0xe2, 0xa0, 0x88, 0x9b, 0x27,
+	0x60, 0x59, 0x0e, 0x41, 0x24, 0xb0, 0x37, 0x12, 0x98, 0x5b, 0x4b, 0x6a, 0xc1, 0xfb, 0x59, 0x6a,
+	0x6f, 0x4d, 0xc7, 0x4b, 0x52, 0xeb, 0x93, 0xe1, 0x15, 0x79, 0xc7, 0x05, 0x04, 0x25, 0xa7, 0x49,
+	0x47, 0x3e, 0x98, 0x3f, 0x18, 0xa0, 0x36, 0x9d, 0x85, 0xbd, 0x51, 0x8c, 0x38, 0x87, 0x38, 0x91,
+	0x2d, 0xac, 0xe5, 0x86, 0xd1, 0xbc, 0xdb, 0x39, 0xce, 0x52, 0xfb, 0xfe, 0xf5, 0x54, 0x49, 0x71,
+	0x6f, 0x56, 0xb1, 0x4c, 0x3b, 0xee, 0xd6, 0x44, 0xbf, 0xa3, 0x32, 0x8f, 0x54, 0xc2, 0x3c, 0x07,
+	0x9b, 0xb2, 0x8c, 0x87, 0x41, 0x84, 0xc4, 0x90, 0x61, 0x7d, 0xd4, 0x45, 0x62, 0xad, 0x4c, 0x46,
+	0x5b, 0x09, 0x54, 0x8d, 0xb6, 0x12, 0xd4, 0x07, 0xf6, 0x64, 0x1c, 0xee, 0x62, 0x76, 0x9a, 0x98,
+	0xff, 0x1a, 0xa0, 0xde, 0x0f, 0x83, 0xbe, 0x7c, 0x0b, 0x0c, 0x31, 0x1c, 0xdf, 0x30, 0xa2, 0xcf,
+	0x30, 0xef, 0xd3, 0x81, 0xcf, 0xad, 0xd5, 0xdb, 0x2e, 0x87, 0xef, 0x8d, 0xfc, 0x76, 0x68, 0xde,
+	0xdc, 0xa9, 0x64, 0xf5, 0x41, 0xfe, 0xa2, 0xbe, 0xb1, 0xe2, 0xd5, 0xee, 0x90, 0x1d, 0xd9, 0xec,
+	0x89, 0xec, 0x75, 0x9a, 0xb7, 0x3a, 0x1d, 0x77, 0x32, 0x9f, 0x81, 0x2d, 0xf9, 0xea, 0x9d, 0xd2,
+	0x93, 0x3b, 0x84, 0x19, 0xb7, 0xde, 0x54, 0x3b, 0xfc, 0x28, 0x4b, 0xed, 0x46, 0x35, 0x51, 0xf2,
+	0xbd, 0x3b, 0x79, 0x8d, 0xcf, 0x92, 0x8e, 0x2b, 0xef, 0x94, 0xcf, 0x0b, 0x1b, 0x27, 0x3a, 0x5a,
+	0x0c, 0x37, 0x46, 0xde, 0x19, 0x16, 0xd0, 0x47, 0x02, 0xe5, 0xc7, 0x78, 0xad, 0x3c, 0xdc, 0x19,
+	0xe0, 0xba, 0xe1, 0xce, 0x80, 0x7a, 0xb8, 0x5d, 0x15, 0x3e, 0x42, 0x02, 0xe9, 0x03, 0xf6, 0xe9,
+	0xf3, 0x8b, 0xba, 0xf1, 0xe2, 0xa2, 0x6e, 0xfc, 0x73, 0x51, 0x37, 0x7e, 0xbc, 0xac, 0xcf, 0xbd,
+	0xb8, 0xac, 0xcf, 0xfd, 0x79, 0x59, 0x9f, 0xfb, 0xe2, 0xc1, 0xec, 0xa6, 0xaa, 0x0f, 0xb2, 0xa4,
+	0xf8, 0x24, 0x53, 0xfb, 0xda, 0x5b, 0x50, 0x9f, 0x4f, 0x1f, 0xfe, 0x3f, 0x00, 0x39, 0x98, 0xca,
+	0x6d, 0xae, 0x09, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPacketDataBytes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPacketDataBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MinHighValueSigners != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinHighValueSigners))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.HighValueTransferThresholds) > 0 {
+		for iNdEx := len(m.HighValueTransferThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HighValueTransferThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.MaxSignaturesPerTx != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxSignaturesPerTx))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxTxBytesBypassExempt {
+		i--
+		if m.MaxTxBytesBypassExempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxTxBytes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTxBytes))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.MemoRequiredAddresses) > 0 {
+		for iNdEx := len(m.MemoRequiredAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MemoRequiredAddresses[iNdEx])
+			copy(dAtA[i:], m.MemoRequiredAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MemoRequiredAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.UpgradeFreezeBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UpgradeFreezeBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DepositDenoms) > 0 {
+		for iNdEx := len(m.DepositDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DepositDenoms[iNdEx])
+			copy(dAtA[i:], m.DepositDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DepositDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxActiveProposals != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxActiveProposals))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxDelegationsPerDelegator != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxDelegationsPerDelegator))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxValidatorCreationsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxValidatorCreationsPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.HaltedMsgTypes) > 0 {
+		for iNdEx := len(m.HaltedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HaltedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.HaltedMsgTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.HaltedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SupplyCaps) > 0 {
+		for iNdEx := len(m.SupplyCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TransferCaps) > 0 {
+		for iNdEx := len(m.TransferCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferCaps) > 0 {
+		for _, e := range m.TransferCaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyCaps) > 0 {
+		for _, e := range m.SupplyCaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HaltedMsgTypes) > 0 {
+		for _, s := range m.HaltedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxValidatorCreationsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxValidatorCreationsPerBlock))
+	}
+	if m.MaxDelegationsPerDelegator != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxDelegationsPerDelegator))
+	}
+	if m.MaxActiveProposals != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxActiveProposals))
+	}
+	if len(m.DepositDenoms) > 0 {
+		for _, s := range m.DepositDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.UpgradeFreezeBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.UpgradeFreezeBlocks))
+	}
+	if len(m.MemoRequiredAddresses) > 0 {
+		for _, s := range m.MemoRequiredAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxTxBytes != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTxBytes))
+	}
+	if m.MaxTxBytesBypassExempt {
+		n += 2
+	}
+	if m.MaxSignaturesPerTx != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxSignaturesPerTx))
+	}
+	if len(m.HighValueTransferThresholds) > 0 {
+		for _, e := range m.HighValueTransferThresholds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MinHighValueSigners != 0 {
+		n += 1 + sovGenesis(uint64(m.MinHighValueSigners))
+	}
+	if m.MaxPacketDataBytes != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxPacketDataBytes))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferCaps = append(m.TransferCaps, types.Coin{})
+			if err := m.TransferCaps[len(m.TransferCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyCaps = append(m.SupplyCaps, types.Coin{})
+			if err := m.SupplyCaps[len(m.SupplyCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HaltedMsgTypes = append(m.HaltedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorCreationsPerBlock", wireType)
+			}
+			m.MaxValidatorCreationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidatorCreationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegationsPerDelegator", wireType)
+			}
+			m.MaxDelegationsPerDelegator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegationsPerDelegator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveProposals", wireType)
+			}
+			m.MaxActiveProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositDenoms = append(m.DepositDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeFreezeBlocks", wireType)
+			}
+			m.UpgradeFreezeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeFreezeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoRequiredAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemoRequiredAddresses = append(m.MemoRequiredAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxBytes", wireType)
+			}
+			m.MaxTxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxBytesBypassExempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxTxBytesBypassExempt = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSignaturesPerTx", wireType)
+			}
+			m.MaxSignaturesPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSignaturesPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighValueTransferThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighValueTransferThresholds = append(m.HighValueTransferThresholds, types.Coin{})
+			if err := m.HighValueTransferThresholds[len(m.HighValueTransferThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHighValueSigners", wireType)
+			}
+			m.MinHighValueSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHighValueSigners |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketDataBytes", wireType)
+			}
+			m.MaxPacketDataBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketDataBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
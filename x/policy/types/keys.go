@@ -0,0 +1,8 @@
+package types
+
+const (
+	// ModuleName is the name of the this module
+	ModuleName = "policy"
+
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,404 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	// ParamStoreKeyTransferCaps store key
+	ParamStoreKeyTransferCaps = []byte("TransferCaps")
+	// ParamStoreKeySupplyCaps store key
+	ParamStoreKeySupplyCaps = []byte("SupplyCaps")
+	// ParamStoreKeyHaltedMsgTypes store key
+	ParamStoreKeyHaltedMsgTypes = []byte("HaltedMsgTypes")
+	// ParamStoreKeyMinCommissionRate store key
+	ParamStoreKeyMinCommissionRate = []byte("MinCommissionRate")
+	// ParamStoreKeyMaxValidatorCreationsPerBlock store key
+	ParamStoreKeyMaxValidatorCreationsPerBlock = []byte("MaxValidatorCreationsPerBlock")
+	// ParamStoreKeyMaxDelegationsPerDelegator store key
+	ParamStoreKeyMaxDelegationsPerDelegator = []byte("MaxDelegationsPerDelegator")
+	// ParamStoreKeyMaxActiveProposals store key
+	ParamStoreKeyMaxActiveProposals = []byte("MaxActiveProposals")
+	// ParamStoreKeyDepositDenoms store key
+	ParamStoreKeyDepositDenoms = []byte("DepositDenoms")
+	// ParamStoreKeyUpgradeFreezeBlocks store key
+	ParamStoreKeyUpgradeFreezeBlocks = []byte("UpgradeFreezeBlocks")
+	// ParamStoreKeyMemoRequiredAddresses store key
+	ParamStoreKeyMemoRequiredAddresses = []byte("MemoRequiredAddresses")
+	// ParamStoreKeyMaxTxBytes store key
+	ParamStoreKeyMaxTxBytes = []byte("MaxTxBytes")
+	// ParamStoreKeyMaxTxBytesBypassExempt store key
+	ParamStoreKeyMaxTxBytesBypassExempt = []byte("MaxTxBytesBypassExempt")
+	// ParamStoreKeyMaxSignaturesPerTx store key
+	ParamStoreKeyMaxSignaturesPerTx = []byte("MaxSignaturesPerTx")
+	// ParamStoreKeyHighValueTransferThresholds store key
+	ParamStoreKeyHighValueTransferThresholds = []byte("HighValueTransferThresholds")
+	// ParamStoreKeyMinHighValueSigners store key
+	ParamStoreKeyMinHighValueSigners = []byte("MinHighValueSigners")
+	// ParamStoreKeyMaxPacketDataBytes store key
+	ParamStoreKeyMaxPacketDataBytes = []byte("MaxPacketDataBytes")
+)
+
+// DefaultMaxActiveProposals is the default maximum number of proposals in their
+// deposit or voting period. It is well above the number of proposals active at
+// once in normal operation.
+const DefaultMaxActiveProposals uint64 = 100
+
+// DefaultMaxValidatorCreationsPerBlock is the default maximum number of
+// validators created in a block. It is well above the number of validators
+// created at once in normal operation.
+const DefaultMaxValidatorCreationsPerBlock uint64 = 10
+
+// DefaultMaxSignaturesPerTx is the default maximum number of signatures of a
+// transaction. It is well above the number of signatures of the legitimate
+// multisig transactions.
+const DefaultMaxSignaturesPerTx uint64 = 100
+
+// govMsgTypeURLPrefix is the prefix of the type URLs of the gov messages,
+// which cannot be halted so that governance can lift the halts.
+const govMsgTypeURLPrefix = "/cosmos.gov."
+
+// DefaultParams returns default parameters
+func DefaultParams() Params {
+	return Params{
+		TransferCaps:                  sdk.Coins{},
+		SupplyCaps:                    sdk.Coins{},
+		HaltedMsgTypes:                []string{},
+		MinCommissionRate:             sdk.ZeroDec(),
+		MaxValidatorCreationsPerBlock: DefaultMaxValidatorCreationsPerBlock,
+		MaxActiveProposals:            DefaultMaxActiveProposals,
+		DepositDenoms:                 []string{},
+		MemoRequiredAddresses:         []string{},
+		MaxSignaturesPerTx:            DefaultMaxSignaturesPerTx,
+		HighValueTransferThresholds:   sdk.Coins{},
+	}
+}
+
+// UnsetParams returns the params the checks apply while their keys are unset.
+// They are the default params, except for the validator creations which are
+// unlimited.
+func UnsetParams() Params {
+	params := DefaultParams()
+	params.MaxValidatorCreationsPerBlock = 0
+	return params
+}
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ValidateBasic performs basic validation.
+func (p Params) ValidateBasic() error {
+	if err := validateTransferCaps(p.TransferCaps); err != nil {
+		return err
+	}
+
+	if err := validateSupplyCaps(p.SupplyCaps); err != nil {
+		return err
+	}
+
+	if err := validateHaltedMsgTypes(p.HaltedMsgTypes); err != nil {
+		return err
+	}
+
+	if err := validateMinCommissionRate(p.MinCommissionRate); err != nil {
+		return err
+	}
+
+	if err := validateMaxValidatorCreationsPerBlock(p.MaxValidatorCreationsPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateMaxDelegationsPerDelegator(p.MaxDelegationsPerDelegator); err != nil {
+		return err
+	}
+
+	if err := validateMaxActiveProposals(p.MaxActiveProposals); err != nil {
+		return err
+	}
+
+	if err := validateDepositDenoms(p.DepositDenoms); err != nil {
+		return err
+	}
+
+	if err := validateUpgradeFreezeBlocks(p.UpgradeFreezeBlocks); err != nil {
+		return err
+	}
+
+	if err := validateMemoRequiredAddresses(p.MemoRequiredAddresses); err != nil {
+		return err
+	}
+
+	if err := validateMaxTxBytes(p.MaxTxBytes); err != nil {
+		return err
+	}
+
+	if err := validateMaxTxBytesBypassExempt(p.MaxTxBytesBypassExempt); err != nil {
+		return err
+	}
+
+	if err := validateMaxSignaturesPerTx(p.MaxSignaturesPerTx); err != nil {
+		return err
+	}
+
+	if err := validateHighValueTransferThresholds(p.HighValueTransferThresholds); err != nil {
+		return err
+	}
+
+	if err := validateMinHighValueSigners(p.MinHighValueSigners); err != nil {
+		return err
+	}
+
+	if err := validateMaxPacketDataBytes(p.MaxPacketDataBytes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyTransferCaps, &p.TransferCaps, validateTransferCaps,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeySupplyCaps, &p.SupplyCaps, validateSupplyCaps,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyHaltedMsgTypes, &p.HaltedMsgTypes, validateHaltedMsgTypes,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxValidatorCreationsPerBlock, &p.MaxValidatorCreationsPerBlock, validateMaxValidatorCreationsPerBlock,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxDelegationsPerDelegator, &p.MaxDelegationsPerDelegator, validateMaxDelegationsPerDelegator,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxActiveProposals, &p.MaxActiveProposals, validateMaxActiveProposals,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyDepositDenoms, &p.DepositDenoms, validateDepositDenoms,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyUpgradeFreezeBlocks, &p.UpgradeFreezeBlocks, validateUpgradeFreezeBlocks,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMemoRequiredAddresses, &p.MemoRequiredAddresses, validateMemoRequiredAddresses,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxTxBytes, &p.MaxTxBytes, validateMaxTxBytes,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxTxBytesBypassExempt, &p.MaxTxBytesBypassExempt, validateMaxTxBytesBypassExempt,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxSignaturesPerTx, &p.MaxSignaturesPerTx, validateMaxSignaturesPerTx,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyHighValueTransferThresholds, &p.HighValueTransferThresholds, validateHighValueTransferThresholds,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMinHighValueSigners, &p.MinHighValueSigners, validateMinHighValueSigners,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxPacketDataBytes, &p.MaxPacketDataBytes, validateMaxPacketDataBytes,
+		),
+	}
+}
+
+// this requires the caps to be valid, sorted and non-zero
+func validateTransferCaps(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Coins", i)
+	}
+
+	return v.Validate()
+}
+
+func validateSupplyCaps(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Coins", i)
+	}
+
+	return v.Validate()
+}
+
+// this requires the msg type URLs to be unique and not of gov messages, so
+// that the halts can be lifted by governance
+func validateHaltedMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected []string", i)
+	}
+
+	seenTypes := make(map[string]bool)
+	for _, msgType := range v {
+		if !strings.HasPrefix(msgType, "/") {
+			return fmt.Errorf("invalid msg type URL %q", msgType)
+		}
+		if strings.HasPrefix(msgType, govMsgTypeURLPrefix) {
+			return fmt.Errorf("gov msg type %s cannot be halted", msgType)
+		}
+		if seenTypes[msgType] {
+			return fmt.Errorf("duplicate msg type URL %s", msgType)
+		}
+		seenTypes[msgType] = true
+	}
+
+	return nil
+}
+
+// this requires the rate to be within [0, 1], unset being zero
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Dec", i)
+	}
+
+	if !v.IsNil() && (v.IsNegative() || v.GT(sdk.OneDec())) {
+		return fmt.Errorf("min commission rate must be within [0, 1], got %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxValidatorCreationsPerBlock(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+
+	return nil
+}
+
+func validateMaxDelegationsPerDelegator(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+
+	return nil
+}
+
+func validateMaxActiveProposals(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+
+	return nil
+}
+
+// this requires the denoms to be valid and unique
+func validateDepositDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected []string", i)
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid deposit denom %q: %w", denom, err)
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate deposit denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
+
+// this requires the number of blocks to fit in a block height
+func validateUpgradeFreezeBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+	if v > math.MaxInt64 {
+		return fmt.Errorf("upgrade freeze blocks %d exceed the maximum of %d", v, int64(math.MaxInt64))
+	}
+
+	return nil
+}
+
+// this requires the addresses to be valid and unique
+func validateMemoRequiredAddresses(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected []string", i)
+	}
+
+	seenAddrs := make(map[string]bool)
+	for _, addr := range v {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid memo required address %q: %w", addr, err)
+		}
+		if seenAddrs[addr] {
+			return fmt.Errorf("duplicate memo required address %s", addr)
+		}
+		seenAddrs[addr] = true
+	}
+
+	return nil
+}
+
+func validateMaxTxBytes(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+
+	return nil
+}
+
+func validateMaxTxBytesBypassExempt(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected bool", i)
+	}
+
+	return nil
+}
+
+func validateMaxSignaturesPerTx(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+
+	return nil
+}
+
+// this requires the thresholds to be valid, sorted and non-zero
+func validateHighValueTransferThresholds(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Coins", i)
+	}
+
+	return v.Validate()
+}
+
+func validateMinHighValueSigners(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+
+	return nil
+}
+
+func validateMaxPacketDataBytes(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+
+	return nil
+}
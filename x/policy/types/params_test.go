@@ -0,0 +1,336 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultParams(t *testing.T) {
+	p := DefaultParams()
+	require.Equal(t, DefaultMaxValidatorCreationsPerBlock, p.MaxValidatorCreationsPerBlock)
+	require.Zero(t, UnsetParams().MaxValidatorCreationsPerBlock)
+}
+
+func Test_validateTransferCaps(t *testing.T) {
+	tests := map[string]struct {
+		caps      interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().TransferCaps,
+			false,
+		},
+		"type conversion fails, fail": {
+			sdk.NewDecCoins(sdk.NewDecCoin("ubridged", sdk.NewInt(1000))),
+			true,
+		},
+		"distinct denoms, pass": {
+			sdk.NewCoins(sdk.NewInt64Coin("ubridged", 1000), sdk.NewInt64Coin("uexp", 10)),
+			false,
+		},
+		"zero cap, fail": {
+			sdk.Coins{sdk.NewInt64Coin("ubridged", 0)},
+			true,
+		},
+		"unsorted denoms, fail": {
+			sdk.Coins{sdk.NewInt64Coin("uexp", 10), sdk.NewInt64Coin("ubridged", 1000)},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateTransferCaps(test.caps)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateHaltedMsgTypes(t *testing.T) {
+	tests := map[string]struct {
+		msgTypes  interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().HaltedMsgTypes,
+			false,
+		},
+		"type conversion fails, fail": {
+			"/cosmos.bank.v1beta1.MsgSend",
+			true,
+		},
+		"distinct msg types, pass": {
+			[]string{"/cosmos.bank.v1beta1.MsgSend", "/ibc.applications.transfer.v1.MsgTransfer"},
+			false,
+		},
+		"duplicate msg types, fail": {
+			[]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"},
+			true,
+		},
+		"invalid msg type URL, fail": {
+			[]string{"cosmos.bank.v1beta1.MsgSend"},
+			true,
+		},
+		"gov msg type, fail": {
+			[]string{"/cosmos.gov.v1beta1.MsgVote"},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateHaltedMsgTypes(test.msgTypes)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateMinCommissionRate(t *testing.T) {
+	tests := map[string]struct {
+		rate      interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().MinCommissionRate,
+			false,
+		},
+		"unset, pass": {
+			sdk.Dec{},
+			false,
+		},
+		"type conversion fails, fail": {
+			"0.05",
+			true,
+		},
+		"within range, pass": {
+			sdk.NewDecWithPrec(5, 2),
+			false,
+		},
+		"one, pass": {
+			sdk.OneDec(),
+			false,
+		},
+		"above one, fail": {
+			sdk.NewDecWithPrec(11, 1),
+			true,
+		},
+		"negative, fail": {
+			sdk.NewDecWithPrec(-1, 2),
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateMinCommissionRate(test.rate)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateDepositDenoms(t *testing.T) {
+	tests := map[string]struct {
+		denoms    interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().DepositDenoms,
+			false,
+		},
+		"type conversion fails, fail": {
+			"uatom",
+			true,
+		},
+		"distinct denoms, pass": {
+			[]string{"uatom", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},
+			false,
+		},
+		"duplicate denoms, fail": {
+			[]string{"uatom", "uatom"},
+			true,
+		},
+		"invalid denom, fail": {
+			[]string{"1atom"},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateDepositDenoms(test.denoms)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateMemoRequiredAddresses(t *testing.T) {
+	tests := map[string]struct {
+		addrs     interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().MemoRequiredAddresses,
+			false,
+		},
+		"type conversion fails, fail": {
+			"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+			true,
+		},
+		"distinct addresses, pass": {
+			[]string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"},
+			false,
+		},
+		"duplicate addresses, fail": {
+			[]string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"},
+			true,
+		},
+		"invalid address, fail": {
+			[]string{"cosmos1invalid"},
+			true,
+		},
+		"empty address, fail": {
+			[]string{""},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateMemoRequiredAddresses(test.addrs)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateMaxValidatorCreationsPerBlock(t *testing.T) {
+	tests := map[string]struct {
+		max       interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().MaxValidatorCreationsPerBlock,
+			false,
+		},
+		"zero, pass": {
+			uint64(0),
+			false,
+		},
+		"type conversion fails, fail": {
+			10,
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateMaxValidatorCreationsPerBlock(test.max)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateUpgradeFreezeBlocks(t *testing.T) {
+	tests := map[string]struct {
+		blocks    interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().UpgradeFreezeBlocks,
+			false,
+		},
+		"max height, pass": {
+			uint64(math.MaxInt64),
+			false,
+		},
+		"above max height, fail": {
+			uint64(math.MaxInt64) + 1,
+			true,
+		},
+		"type conversion fails, fail": {
+			10,
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateUpgradeFreezeBlocks(test.blocks)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// the params are checked as a whole as well
+	params := DefaultParams()
+	params.UpgradeFreezeBlocks = uint64(math.MaxInt64) + 1
+	require.Error(t, params.ValidateBasic())
+}
+
+func Test_validateSupplyCaps(t *testing.T) {
+	tests := map[string]struct {
+		caps      interface{}
+		expectErr bool
+	}{
+		"DefaultParams, pass": {
+			DefaultParams().SupplyCaps,
+			false,
+		},
+		"type conversion fails, fail": {
+			sdk.NewDecCoins(sdk.NewDecCoin("ubridged", sdk.NewInt(1000))),
+			true,
+		},
+		"distinct denoms, pass": {
+			sdk.NewCoins(sdk.NewInt64Coin("ubridged", 1000), sdk.NewInt64Coin("uexp", 10)),
+			false,
+		},
+		"zero cap, fail": {
+			sdk.Coins{sdk.NewInt64Coin("ubridged", 0)},
+			true,
+		},
+		"duplicate denoms, fail": {
+			sdk.Coins{sdk.NewInt64Coin("ubridged", 1000), sdk.NewInt64Coin("ubridged", 10)},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateSupplyCaps(test.caps)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package query
+
+import (
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gaiaante "github.com/cosmos/gaia/v9/ante"
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+var _ types.AnteProfileServer = AnteProfileServer{}
+
+// AnteProfileServer reports the overhead of the ante decorators recorded by
+// this node. It is node local and only available when the ante-profiling
+// option of the node is set.
+type AnteProfileServer struct {
+	profiles *gaiaante.AnteProfileIndex
+}
+
+// NewAnteProfileServer returns an AnteProfileServer reporting the profiles of
+// the given index, which is nil when the ante profiling is disabled.
+func NewAnteProfileServer(profiles *gaiaante.AnteProfileIndex) AnteProfileServer {
+	return AnteProfileServer{profiles: profiles}
+}
+
+// Decorators returns the average time spent and gas consumed by each ante
+// decorator over the most recent txs handled by this node.
+func (s AnteProfileServer) Decorators(_ context.Context, _ *types.QueryAnteProfileRequest) (*types.QueryAnteProfileResponse, error) {
+	if s.profiles == nil {
+		return nil, status.Error(codes.Unavailable, "ante profiling is disabled on this node")
+	}
+
+	profiles := s.profiles.Profiles()
+	res := &types.QueryAnteProfileResponse{
+		Decorators: make([]types.DecoratorProfile, len(profiles)),
+		Retention:  uint64(s.profiles.Retention()),
+	}
+	for i, profile := range profiles {
+		res.Decorators[i] = types.DecoratorProfile{
+			Decorator:   profile.Decorator,
+			Txs:         profile.Txs,
+			AvgDuration: profile.AvgDuration,
+			AvgGas:      profile.AvgGas,
+		}
+	}
+	return res, nil
+}
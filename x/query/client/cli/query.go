@@ -0,0 +1,742 @@
+package cli
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "gaia",
+		Short:                      "Querying commands aggregating the state of the Gaia modules",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdAccountStakingSchedule(),
+		GetCmdAccountTotalPosition(),
+		GetCmdProjectedCommunityPool(),
+		GetCmdParams(),
+		GetCmdParamsDiffFromDefaults(),
+		GetCmdAllGovernableParams(),
+		GetCmdPreviewParamsAfterProposal(),
+		GetCmdNextUnbondingCompletion(),
+		GetCmdSafePruneHeight(),
+		GetCmdExpiringClients(),
+		GetCmdNonVoters(),
+		GetCmdRewardHistory(),
+		GetCmdChannelIncentiveFees(),
+		GetCmdIncentivizedChannels(),
+		GetCmdBreakEvenRelayFee(),
+		GetCmdValidatorRelayActivity(),
+		GetCmdDecentralizationMetrics(),
+		GetCmdProjectedDelegationReward(),
+		GetCmdDenomChannelHistory(),
+		GetCmdHoldersAbove(),
+		GetCmdAddressFeesPaid(),
+		GetCmdBlockProposer(),
+		GetCmdAnteProfile(),
+	)
+	return queryCmd
+}
+
+func GetCmdAccountStakingSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-schedule [address]",
+		Short: "Show the delegations and pending unbondings of an account",
+		Long:  "Show the delegations of an account by validator followed by its pending unbondings, earliest completion first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountStakingSchedule(cmd.Context(), &types.QueryAccountStakingScheduleRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdAccountTotalPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-position [address]",
+		Short: "Show the total tokens of an account by denom",
+		Long:  "Show the liquid, staked, unbonding and pending reward tokens of an account by denom, and their total",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountTotalPosition(cmd.Context(), &types.QueryAccountTotalPositionRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdProjectedCommunityPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-community-pool [blocks]",
+		Short: "Show the community pool balance projected forward by a number of blocks",
+		Long: `Show the community pool balance projected forward by a number of blocks from the current
+inflation, community tax and bonded ratio, assuming no spends. The assumptions of the
+projection are listed in the response.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ProjectedCommunityPool(cmd.Context(), &types.QueryProjectedCommunityPoolRequest{
+				Blocks: blocks,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Show the params of all the Gaia custom modules",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdParamsDiffFromDefaults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params-diff-from-defaults",
+		Short: "Show the params of the modules which differ from their defaults",
+		Long:  "Show the params of the modules which differ from the default params of the modules, with their current and default values",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ParamsDiffFromDefaults(cmd.Context(), &types.QueryParamsDiffFromDefaultsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdAllGovernableParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governable-params",
+		Short: "Show the current params of all the modules governed by param change proposals",
+		Long:  "Show the current params of every module whose params can be changed by a param change proposal, the Gaia custom modules included, per module and as the values to set in a proposal. The params not set in the state are flagged as defaults.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllGovernableParams(cmd.Context(), &types.QueryAllGovernableParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdPreviewParamsAfterProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview-params-after-proposal [proposal-id]",
+		Short: "Show the params of the Gaia custom modules once a pending param change proposal applies",
+		Long:  "Show the params of the Gaia custom modules as they would be once a param change proposal in deposit or voting period passes, along with the value of each param it changes before and after. Nothing is written to the state.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PreviewParamsAfterProposal(cmd.Context(), &types.QueryPreviewParamsAfterProposalRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdNextUnbondingCompletion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-unbonding-completion [validator-address]",
+		Short: "Show the next completion of the pending unbondings from a validator",
+		Long:  "Show the earliest completion time of the pending unbondings from a validator, across all its delegators, and the amount completing then",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NextUnbondingCompletion(cmd.Context(), &types.QueryNextUnbondingCompletionRequest{
+				ValidatorAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSafePruneHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "safe-prune-height",
+		Short: "Show the lowest height still needed by an active IBC client",
+		Long:  "Show the lowest block height at which a consensus state of an active IBC client was stored. Pruning the heights below it does not break the relaying of the clients.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SafePruneHeight(cmd.Context(), &types.QuerySafePruneHeightRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdExpiringClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring-clients [within]",
+		Short: "Show the IBC clients expired or expiring within a duration",
+		Long:  "Show the IBC clients whose latest consensus state is older than their trusting period minus the given duration, e.g. 72h, earliest expiry first. Frozen clients are left out.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			within, err := time.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ExpiringClients(cmd.Context(), &types.QueryExpiringClientsRequest{
+				Within: within,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdNonVoters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "non-voters [proposal-id]",
+		Short: "Show the bonded validators which have not voted yet on a proposal",
+		Long:  "Show the bonded validators which have not voted yet on a proposal in voting period, sorted by descending voting power.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NonVoters(cmd.Context(), &types.QueryNonVotersRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+			sort.SliceStable(res.NonVoters, func(i, j int) bool {
+				return res.NonVoters[i].VotingPower > res.NonVoters[j].VotingPower
+			})
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdRewardHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-history [delegator-address] [from-height] [to-height]",
+		Short: "Show the delegation rewards withdrawn by a delegator in each block of a height range",
+		Long: `Show the delegation rewards withdrawn by a delegator in each block of a height range, both explicitly
+and when a delegation changes. The range starts at the first height and ends at the latest height when
+they are not given. The withdrawals are indexed in memory by the queried node as it delivers the blocks,
+so only the heights since indexed_from_height are known. The index is only kept when "rewards" is set in
+the query-indexes option of the app.toml of the node.`,
+		Args: cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRewardHistoryRequest{DelegatorAddress: args[0]}
+			if len(args) > 1 {
+				if req.FromHeight, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+					return err
+				}
+			}
+			if len(args) > 2 {
+				if req.ToHeight, err = strconv.ParseInt(args[2], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RewardHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// FlagPort is the port of the channel to query the incentive fees of.
+const FlagPort = "port"
+
+// FlagRecvGas and FlagAckGas are the gas of the txs relaying a packet and its
+// acknowledgement, overriding the estimates.
+const (
+	FlagRecvGas = "recv-gas"
+	FlagAckGas  = "ack-gas"
+)
+
+func GetCmdChannelIncentiveFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incentive-fees [channel-id]",
+		Short: "Show the relayer incentives escrowed for the packets of a fee enabled channel",
+		Long: `Show the ICS-29 relayer incentives escrowed for each packet of a fee enabled channel awaiting relayers,
+by ascending sequence, with the fees paid for relaying the packet, its acknowledgement and its timeout.
+The channel is on the transfer port unless --port is given.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, err := cmd.Flags().GetString(FlagPort)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ChannelIncentiveFees(cmd.Context(), &types.QueryChannelIncentiveFeesRequest{
+				ChannelId: args[0],
+				PortId:    portID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagPort, ibctransfertypes.PortID, "The port of the channel")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdIncentivizedChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incentivized-channels",
+		Short: "Show the fee enabled channels with the relayer incentives escrowed for their packets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IncentivizedChannels(cmd.Context(), &types.QueryIncentivizedChannelsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdBreakEvenRelayFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "break-even-relay-fee [channel-id]",
+		Short: "Show the relayer incentives paying for the fees of relaying a packet on a fee enabled channel",
+		Long: `Show the fees of the txs relaying a packet and its acknowledgement on a fee enabled channel under the
+global fees, i.e. the lowest ICS-29 relayer incentives making the relaying profitable. The gas of the txs is
+estimated from a typical relaying tx updating the client, unless --recv-gas and --ack-gas are given.
+The channel is on the transfer port unless --port is given.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, err := cmd.Flags().GetString(FlagPort)
+			if err != nil {
+				return err
+			}
+			recvGas, err := cmd.Flags().GetUint64(FlagRecvGas)
+			if err != nil {
+				return err
+			}
+			ackGas, err := cmd.Flags().GetUint64(FlagAckGas)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BreakEvenRelayFee(cmd.Context(), &types.QueryBreakEvenRelayFeeRequest{
+				ChannelId: args[0],
+				PortId:    portID,
+				RecvGas:   recvGas,
+				AckGas:    ackGas,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagPort, ibctransfertypes.PortID, "The port of the channel")
+	cmd.Flags().Uint64(FlagRecvGas, 0, "The gas of the tx relaying a packet, estimated when zero")
+	cmd.Flags().Uint64(FlagAckGas, 0, "The gas of the tx relaying an acknowledgement, estimated when zero")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdValidatorRelayActivity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-relay-activity [window]",
+		Short: "Show the IBC packets relayed by the validator operators in the latest blocks",
+		Long: `Show the IBC packets relayed by the validator operators, i.e. by the accounts of their operator
+addresses, in the given number of latest blocks, by descending number of relayed packets. All the blocks
+kept by the index are queried when the window is not given. The relays are indexed in memory by the
+queried node as it delivers the blocks, so only the heights since indexed_from_height are known. The index
+is only kept when "relays" is set in the query-indexes option of the app.toml of the node.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValidatorRelayActivityRequest{}
+			if len(args) > 0 {
+				if req.Window, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidatorRelayActivity(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdDecentralizationMetrics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decentralization-metrics",
+		Short: "Show the Nakamoto and Gini coefficients of the bonded validators",
+		Long:  "Show the Nakamoto coefficient, i.e. the minimum number of bonded validators holding more than 1/3 of the voting power, and the Gini coefficient of the voting power of the bonded validators.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DecentralizationMetrics(cmd.Context(), &types.QueryDecentralizationMetricsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdProjectedDelegationReward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-delegation-reward [validator-address] [amount]",
+		Short: "Show the annual reward projected for delegating an amount to a validator",
+		Long:  "Show the annual reward projected for delegating an amount of bond denom tokens to a validator, from the current inflation, the commission of the validator and its share of the bonded tokens after the delegation, along with the assumptions of the projection.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ProjectedDelegationReward(cmd.Context(), &types.QueryProjectedDelegationRewardRequest{
+				ValidatorAddress: args[0],
+				Amount:           args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdDenomChannelHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-channel-history [denom]",
+		Short: "Show the channels a denom was transferred over",
+		Long: `Show the channels an ibc/{hash} voucher denom traversed to reach this chain, from its denom trace, and
+the channels of this chain the denom was sent or received over by transfer packets. The transfers are indexed in
+memory by the queried node as it delivers the blocks, so only the heights since indexed_from_height are known.
+The index is only kept when "transfers" is set in the query-indexes option of the app.toml of the node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomChannelHistory(cmd.Context(), &types.QueryDenomChannelHistoryRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdHoldersAbove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holders-above [denom] [threshold]",
+		Short: "Show the accounts holding at least a threshold amount of a denom",
+		Long: `Show the accounts holding at least a threshold amount of a denom, by address. The balances of all the
+accounts are scanned, so the query can be slow on a node with many accounts.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.HoldersAbove(cmd.Context(), &types.QueryHoldersAboveRequest{
+				Denom:      args[0],
+				Threshold:  args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holders")
+	return cmd
+}
+
+func GetCmdAddressFeesPaid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fees-paid [address]",
+		Short: "Show the sum of the fees an address paid over its txs",
+		Long: `Show the sum of the fees an address paid over its txs, as the fee payer or as the fee granter of the txs.
+The fees are indexed in memory by the queried node as it delivers the blocks, so only the heights since
+indexed_from_height are known. The index is only kept when "fees-paid" is set in the query-indexes option
+of the app.toml of the node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AddressFeesPaid(cmd.Context(), &types.QueryAddressFeesPaidRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdBlockProposer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-proposer [height]",
+		Short: "Show the validator which proposed the block at a height",
+		Long: `Show the operator and consensus addresses of the validator which proposed the block at a height, the
+current height when omitted. Only the headers of the most recent historical entries of the staking module are
+kept.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var height int64
+			if len(args) > 0 {
+				height, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BlockProposer(cmd.Context(), &types.QueryBlockProposerRequest{Height: height})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdAnteProfile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ante-profile",
+		Short: "Show the average overhead of each ante decorator over the recent txs",
+		Long: `Show the average time spent and gas consumed by each ante decorator over the most recent txs handled by
+the queried node. The profile is node local and only available when the ante-profiling option of its app.toml is set.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewAnteProfileClient(clientCtx)
+			res, err := queryClient.Decorators(cmd.Context(), &types.QueryAnteProfileRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,64 @@
+package query
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NakamotoCoefficient returns the minimum number of validators of the given
+// voting powers holding together more than 1/3 of the total voting power,
+// i.e. able to halt the chain. It is zero for no voting power.
+func NakamotoCoefficient(powers []int64) uint32 {
+	sorted := sortedPowers(powers)
+
+	total := sdk.ZeroInt()
+	for _, power := range sorted {
+		total = total.AddRaw(power)
+	}
+	if !total.IsPositive() {
+		return 0
+	}
+
+	// the largest validators are counted first
+	held := sdk.ZeroInt()
+	for i := len(sorted) - 1; i >= 0; i-- {
+		held = held.AddRaw(sorted[i])
+		if held.MulRaw(3).GT(total) {
+			return uint32(len(sorted) - i)
+		}
+	}
+	return uint32(len(sorted))
+}
+
+// GiniCoefficient returns the Gini coefficient of the given voting powers,
+// i.e. the mean absolute difference of the powers over twice their mean, from
+// 0 when all the powers are equal to (n-1)/n when a single validator holds
+// all the power. It is zero for no voting power.
+func GiniCoefficient(powers []int64) sdk.Dec {
+	sorted := sortedPowers(powers)
+
+	// with the powers in ascending order x_1..x_n, the coefficient is
+	// 2 * sum(i * x_i) / (n * sum(x_i)) - (n + 1) / n
+	total := sdk.ZeroInt()
+	weighted := sdk.ZeroInt()
+	for i, power := range sorted {
+		total = total.AddRaw(power)
+		weighted = weighted.Add(sdk.NewInt(power).MulRaw(int64(i + 1)))
+	}
+	if !total.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	n := int64(len(sorted))
+	return weighted.MulRaw(2).ToDec().Quo(total.MulRaw(n).ToDec()).
+		Sub(sdk.NewDec(n + 1).QuoInt64(n))
+}
+
+// sortedPowers returns a copy of the voting powers in ascending order.
+func sortedPowers(powers []int64) []int64 {
+	sorted := make([]int64, len(powers))
+	copy(sorted, powers)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
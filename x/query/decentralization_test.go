@@ -0,0 +1,87 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/query"
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+func TestNakamotoCoefficient(t *testing.T) {
+	tests := map[string]struct {
+		powers   []int64
+		expected uint32
+	}{
+		// 40 * 3 = 120 > 100
+		"largest validator above a third": {[]int64{10, 40, 20, 30}, 1},
+		// 30 * 3 = 90 <= 100, (30 + 20) * 3 = 150 > 100
+		"largest validator below a third": {[]int64{20, 15, 30, 20, 15}, 2},
+		// exactly a third doesn't halt the chain: 3 * 3 = 9 <= 9, 6 * 3 = 18 > 9
+		"equal powers":     {[]int64{1, 1, 1, 1, 1, 1, 1, 1, 1}, 4},
+		"single validator": {[]int64{5}, 1},
+		"no power":         {[]int64{0, 0}, 0},
+		"no validator":     {nil, 0},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, test.expected, query.NakamotoCoefficient(test.powers))
+		})
+	}
+}
+
+func TestGiniCoefficient(t *testing.T) {
+	tests := map[string]struct {
+		powers   []int64
+		expected sdk.Dec
+	}{
+		"equal powers": {[]int64{7, 7, 7, 7}, sdk.ZeroDec()},
+		// (n - 1) / n
+		"single validator holding all the power": {[]int64{0, 100, 0, 0}, sdk.NewDecWithPrec(75, 2)},
+		// sum of |x_i - x_j| over all pairs = 8, 8 / (2 * 3^2 * 2) = 2/9
+		"unequal powers": {[]int64{3, 1, 2}, sdk.NewDec(2).QuoInt64(9)},
+		"no power":       {[]int64{0, 0}, sdk.ZeroDec()},
+		"no validator":   {nil, sdk.ZeroDec()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.True(t, test.expected.Sub(query.GiniCoefficient(test.powers)).Abs().LTE(sdk.NewDecWithPrec(1, 17)),
+				"expected %s, got %s", test.expected, query.GiniCoefficient(test.powers))
+		})
+	}
+}
+
+func TestDecentralizationMetrics(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	querier := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+	})
+	res, err := querier.DecentralizationMetrics(sdk.WrapSDKContext(ctx), &types.QueryDecentralizationMetricsRequest{})
+	require.NoError(t, err)
+
+	var powers []int64
+	var total int64
+	for _, validator := range app.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		power := validator.GetConsensusPower(app.StakingKeeper.PowerReduction(ctx))
+		powers = append(powers, power)
+		total += power
+	}
+	require.NotEmpty(t, powers)
+	require.Equal(t, uint32(len(powers)), res.ValidatorCount)
+	require.Equal(t, total, res.TotalVotingPower)
+	require.Equal(t, query.NakamotoCoefficient(powers), res.NakamotoCoefficient)
+	require.Equal(t, query.GiniCoefficient(powers), res.GiniCoefficient)
+}
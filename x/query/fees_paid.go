@@ -0,0 +1,154 @@
+package query
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+var _ baseapp.StreamingService = &FeesPaidIndex{}
+
+// AddressFeesPaid is the sum of the fees paid by an address.
+type AddressFeesPaid struct {
+	Fees sdk.Coins
+	// Txs is the number of txs the fees were paid for.
+	Txs uint64
+}
+
+// FeesPaidIndex keeps in memory the sum of the fees paid by each address,
+// from the fee_payer attribute of the tx event emitted when the fees of a tx
+// are deducted. The fee payer is the fee granter of a tx when its fee is
+// granted.
+//
+// A tx whose msgs fail still pays its fees, so the fees of the failed txs are
+// indexed as well.
+type FeesPaidIndex struct {
+	mtx sync.RWMutex
+	// firstHeight is the first height indexed, zero before any block
+	firstHeight int64
+	fees        map[string]AddressFeesPaid
+}
+
+// NewFeesPaidIndex returns an empty FeesPaidIndex.
+func NewFeesPaidIndex() *FeesPaidIndex {
+	return &FeesPaidIndex{
+		fees: make(map[string]AddressFeesPaid),
+	}
+}
+
+// IndexedFromHeight returns the first height whose fees are known, zero if no
+// block was indexed yet.
+func (idx *FeesPaidIndex) IndexedFromHeight() int64 {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	return idx.firstHeight
+}
+
+// RecordFee records the fee of a tx paid by an address.
+func (idx *FeesPaidIndex) RecordFee(payer string, fee sdk.Coins) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	paid := idx.fees[payer]
+	paid.Fees = paid.Fees.Add(fee...)
+	paid.Txs++
+	idx.fees[payer] = paid
+}
+
+// FeesPaid returns the sum of the fees paid by an address.
+func (idx *FeesPaidIndex) FeesPaid(payer string) AddressFeesPaid {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	paid := idx.fees[payer]
+	if paid.Fees == nil {
+		paid.Fees = sdk.NewCoins()
+	}
+	return paid
+}
+
+// ListenBeginBlock marks the start of the indexed heights.
+func (idx *FeesPaidIndex) ListenBeginBlock(goCtx context.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	height := sdk.UnwrapSDKContext(goCtx).BlockHeight()
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if idx.firstHeight == 0 {
+		idx.firstHeight = height
+	}
+	return nil
+}
+
+// ListenDeliverTx indexes the fee paid by a tx, whatever the result of its
+// msgs.
+func (idx *FeesPaidIndex) ListenDeliverTx(_ context.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	for _, event := range res.Events {
+		if event.Type != sdk.EventTypeTx {
+			continue
+		}
+		payer, fee, ok := paidFee(event)
+		if !ok {
+			continue
+		}
+		idx.RecordFee(payer, fee)
+	}
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener, end block events are not
+// indexed.
+func (idx *FeesPaidIndex) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener.
+func (idx *FeesPaidIndex) ListenCommit(context.Context, abci.ResponseCommit) error {
+	return nil
+}
+
+// Stream implements baseapp.StreamingService, the index does not stream the
+// store writes.
+func (idx *FeesPaidIndex) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService, the index does not listen
+// to the store writes.
+func (idx *FeesPaidIndex) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Close implements baseapp.StreamingService.
+func (idx *FeesPaidIndex) Close() error {
+	return nil
+}
+
+// paidFee returns the fee payer and the fee of the tx event emitted by the
+// fee deduction. The other tx events, e.g. of the signatures, have no fee
+// payer and are skipped.
+func paidFee(event abci.Event) (string, sdk.Coins, bool) {
+	var payer, fee string
+	for _, attr := range event.Attributes {
+		switch string(attr.Key) {
+		case sdk.AttributeKeyFeePayer:
+			payer = string(attr.Value)
+		case sdk.AttributeKeyFee:
+			fee = string(attr.Value)
+		}
+	}
+	if payer == "" {
+		return "", nil, false
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(fee)
+	if err != nil {
+		return "", nil, false
+	}
+	return payer, coins, true
+}
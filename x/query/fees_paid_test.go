@@ -0,0 +1,71 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v9/x/query"
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+// feeEvent returns the tx event emitted by the deduction of the fee of a tx.
+func feeEvent(payer sdk.AccAddress, fee string) sdk.Event {
+	return sdk.NewEvent(sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, payer.String()),
+	)
+}
+
+func TestAddressFeesPaid(t *testing.T) {
+	payer := sdk.AccAddress("payer_______________")
+	granter := sdk.AccAddress("granter_____________")
+	ctx := sdk.Context{}.WithContext(context.Background()).WithBlockHeight(5)
+
+	idx := query.NewFeesPaidIndex()
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		FeesPaid: idx,
+	})
+
+	deliver := func(code uint32, events ...sdk.Event) {
+		res := abci.ResponseDeliverTx{Code: code, Events: sdk.Events(events).ToABCIEvents()}
+		require.NoError(t, idx.ListenDeliverTx(sdk.WrapSDKContext(ctx), abci.RequestDeliverTx{}, res))
+	}
+	require.NoError(t, idx.ListenBeginBlock(sdk.WrapSDKContext(ctx), abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+
+	// the fees of a failed tx are charged as well, the other tx events and a
+	// zero fee don't add up to the fees
+	deliver(0, feeEvent(payer, "1000uatom"), sdk.NewEvent(sdk.EventTypeTx, sdk.NewAttribute(sdk.AttributeKeyAccountSequence, payer.String()+"/1")))
+	deliver(1, feeEvent(payer, "500uatom,20uphoton"))
+	deliver(0, feeEvent(payer, ""))
+	deliver(0, feeEvent(granter, "300uatom"))
+
+	res, err := q.AddressFeesPaid(sdk.WrapSDKContext(ctx), &types.QueryAddressFeesPaidRequest{Address: payer.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500), sdk.NewInt64Coin("uphoton", 20)), res.FeesPaid)
+	require.Equal(t, uint64(3), res.Txs)
+	require.Equal(t, int64(5), res.IndexedFromHeight)
+
+	res, err = q.AddressFeesPaid(sdk.WrapSDKContext(ctx), &types.QueryAddressFeesPaidRequest{Address: granter.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 300)), res.FeesPaid)
+	require.Equal(t, uint64(1), res.Txs)
+
+	// an address without fees paid
+	res, err = q.AddressFeesPaid(sdk.WrapSDKContext(ctx), &types.QueryAddressFeesPaidRequest{Address: sdk.AccAddress("other_______________").String()})
+	require.NoError(t, err)
+	require.True(t, res.FeesPaid.IsZero())
+	require.Zero(t, res.Txs)
+
+	_, err = q.AddressFeesPaid(sdk.WrapSDKContext(ctx), &types.QueryAddressFeesPaidRequest{Address: "cosmos1invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the index is disabled
+	_, err = query.NewGrpcQuerier(query.QuerierOptions{}).AddressFeesPaid(sdk.WrapSDKContext(ctx), &types.QueryAddressFeesPaidRequest{Address: payer.String()})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
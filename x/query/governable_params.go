@@ -0,0 +1,44 @@
+package query
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+// GovernableParams returns the current params of the subspaces, sorted by
+// subspace and key, as the amino JSON they are stored with. The params not
+// set in a subspace are returned with their default value.
+func GovernableParams(ctx sdk.Context, sets []DefaultParamSet) ([]types.ModuleParams, error) {
+	amino := codec.NewLegacyAmino()
+
+	var modules []types.ModuleParams
+	for _, set := range sets {
+		module := types.ModuleParams{Subspace: set.Subspace.Name()}
+		for _, pair := range set.Defaults.ParamSetPairs() {
+			param := types.ParamValue{Key: string(pair.Key)}
+			if value := set.Subspace.GetRaw(ctx, pair.Key); value != nil {
+				param.Value = string(value)
+			} else {
+				defaultValue, err := amino.MarshalJSON(pair.Value)
+				if err != nil {
+					return nil, err
+				}
+				param.Value = string(defaultValue)
+				param.IsDefault = true
+			}
+			module.Params = append(module.Params, param)
+		}
+		sort.SliceStable(module.Params, func(i, j int) bool {
+			return module.Params[i].Key < module.Params[j].Key
+		})
+		modules = append(modules, module)
+	}
+	sort.SliceStable(modules, func(i, j int) bool {
+		return modules[i].Subspace < modules[j].Subspace
+	})
+	return modules, nil
+}
@@ -0,0 +1,101 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+// DefaultParamSet pairs the params subspace of a module with the default
+// params of the module.
+type DefaultParamSet struct {
+	Subspace types.ParamSubspace
+	Defaults paramtypes.ParamSet
+}
+
+// ParamsDiffFromDefaults returns the params stored in the subspaces which
+// differ from the defaults, sorted by subspace and key. The params are
+// compared as the amino JSON they are stored with, the params not set in a
+// subspace being skipped.
+func ParamsDiffFromDefaults(ctx sdk.Context, sets []DefaultParamSet) ([]types.ParamDiff, error) {
+	amino := codec.NewLegacyAmino()
+
+	var diffs []types.ParamDiff
+	for _, set := range sets {
+		for _, pair := range set.Defaults.ParamSetPairs() {
+			value := set.Subspace.GetRaw(ctx, pair.Key)
+			if value == nil {
+				continue
+			}
+			defaultValue, err := amino.MarshalJSON(pair.Value)
+			if err != nil {
+				return nil, err
+			}
+			equal, err := equalJSON(value, defaultValue)
+			if err != nil {
+				return nil, err
+			}
+			if equal {
+				continue
+			}
+			diffs = append(diffs, types.ParamDiff{
+				Subspace:     set.Subspace.Name(),
+				Key:          string(pair.Key),
+				Value:        string(value),
+				DefaultValue: string(defaultValue),
+			})
+		}
+	}
+	sort.SliceStable(diffs, func(i, j int) bool {
+		if diffs[i].Subspace != diffs[j].Subspace {
+			return diffs[i].Subspace < diffs[j].Subspace
+		}
+		return diffs[i].Key < diffs[j].Key
+	})
+	return diffs, nil
+}
+
+// equalJSON reports whether two JSON values are equal regardless of their
+// formatting, an empty list being equal to null as amino encodes nil slices
+// as either.
+func equalJSON(a, b []byte) (bool, error) {
+	if bytes.Equal(a, b) {
+		return true, nil
+	}
+	var va, vb interface{}
+	if err := json.Unmarshal(a, &va); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(b, &vb); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(normalizeJSON(va), normalizeJSON(vb)), nil
+}
+
+func normalizeJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		if len(v) == 0 {
+			return nil
+		}
+		for i := range v {
+			v[i] = normalizeJSON(v[i])
+		}
+	case map[string]interface{}:
+		for k, e := range v {
+			if e = normalizeJSON(e); e == nil {
+				delete(v, k)
+				continue
+			}
+			v[k] = e
+		}
+	}
+	return v
+}
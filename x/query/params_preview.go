@@ -0,0 +1,36 @@
+package query
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+// ApplyParamChanges applies the changes of a param change proposal to the
+// subspaces the way the params proposal handler does, and returns each
+// change with the value of the param before and after it. The changes are
+// applied in order, so that a param changed twice ends with its last value.
+// The caller is expected to pass a cached context it then discards.
+func ApplyParamChanges(ctx sdk.Context, keeper types.ParamsKeeper, changes []paramproposal.ParamChange) ([]types.ParamChangePreview, error) {
+	previews := make([]types.ParamChangePreview, 0, len(changes))
+	for _, change := range changes {
+		ss, ok := keeper.GetSubspace(change.Subspace)
+		if !ok {
+			return nil, sdkerrors.Wrap(paramproposal.ErrUnknownSubspace, change.Subspace)
+		}
+
+		value := ss.GetRaw(ctx, []byte(change.Key))
+		if err := ss.Update(ctx, []byte(change.Key), []byte(change.Value)); err != nil {
+			return nil, err
+		}
+		previews = append(previews, types.ParamChangePreview{
+			Subspace: change.Subspace,
+			Key:      change.Key,
+			Value:    string(value),
+			NewValue: string(ss.GetRaw(ctx, []byte(change.Key))),
+		})
+	}
+	return previews, nil
+}
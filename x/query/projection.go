@@ -0,0 +1,120 @@
+package query
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// CommunityPoolProjectionMaxSteps is the maximum number of steps the mint is
+// replayed in by ProjectCommunityPool, so that the cost of a projection stays
+// bounded whatever the number of blocks.
+const CommunityPoolProjectionMaxSteps = 1000
+
+// CommunityPoolProjectionAssumptions are the assumptions ProjectCommunityPool
+// relies on, reported along with the projection.
+var CommunityPoolProjectionAssumptions = []string{
+	"no community pool spends are executed",
+	"the mint, distribution and staking params stay unchanged",
+	"the bonded tokens stay unchanged while the supply grows by the minted tokens",
+	"all validators sign every block, so the community pool receives exactly the community tax of the block rewards",
+	"transaction fees are not included",
+	"over more than 1000 blocks, the inflation is updated in 1000 equal steps rather than at every block",
+}
+
+// ProjectCommunityPool replays the mint and distribution of the block rewards
+// over the given number of blocks and returns the tokens minted and the
+// amount of them allocated to the community pool. The blocks are replayed in
+// at most CommunityPoolProjectionMaxSteps steps of equal length: the
+// inflation changes once per step, by the change of all its blocks, and each
+// block of the step mints the same provision.
+func ProjectCommunityPool(
+	minter minttypes.Minter,
+	mintParams minttypes.Params,
+	communityTax sdk.Dec,
+	totalSupply, bondedTokens sdk.Int,
+	blocks uint64,
+) (minted sdk.Int, toCommunityPool sdk.Dec) {
+	minted = sdk.ZeroInt()
+	toCommunityPool = sdk.ZeroDec()
+
+	stepBlocks := (blocks + CommunityPoolProjectionMaxSteps - 1) / CommunityPoolProjectionMaxSteps
+	for done := uint64(0); done < blocks; done += stepBlocks {
+		if blocks-done < stepBlocks {
+			stepBlocks = blocks - done
+		}
+
+		supply := totalSupply.Add(minted)
+		bondedRatio := sdk.ZeroDec()
+		if supply.IsPositive() {
+			bondedRatio = bondedTokens.ToDec().QuoInt(supply)
+		}
+
+		// same as the mint BeginBlocker, for each block of the step
+		minter.Inflation = nextInflationRate(minter, mintParams, bondedRatio, stepBlocks)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(mintParams, supply)
+		provision := minter.BlockProvision(mintParams).Amount.Mul(sdk.NewIntFromUint64(stepBlocks))
+
+		minted = minted.Add(provision)
+		toCommunityPool = toCommunityPool.Add(provision.ToDec().Mul(communityTax))
+	}
+
+	return minted, toCommunityPool
+}
+
+// nextInflationRate returns the inflation rate after the given number of
+// blocks of the mint BeginBlocker at a constant bonded ratio, which is
+// minttypes.Minter.NextInflationRate for a single block.
+func nextInflationRate(minter minttypes.Minter, params minttypes.Params, bondedRatio sdk.Dec, blocks uint64) sdk.Dec {
+	inflationRateChangePerYear := sdk.OneDec().
+		Sub(bondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange)
+	inflationRateChange := inflationRateChangePerYear.
+		Quo(sdk.NewDec(int64(params.BlocksPerYear))).
+		Mul(sdk.NewDec(int64(blocks)))
+
+	inflation := minter.Inflation.Add(inflationRateChange)
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+	return inflation
+}
+
+// DelegationRewardProjectionAssumptions are the assumptions
+// ProjectDelegationReward relies on, reported along with the projection.
+var DelegationRewardProjectionAssumptions = []string{
+	"the annual provisions, the community tax and the commission rate stay unchanged",
+	"the bonded tokens stay unchanged apart from the projected delegation",
+	"the validator stays in the active set and signs every block",
+	"the proposer rewards average out to the voting power share of the validator",
+	"transaction fees are not included",
+	"the rewards are not compounded",
+}
+
+// ProjectDelegationReward returns the reward projected over a year for
+// delegating amount to a validator of validatorTokens, out of bondedTokens
+// including them, along with the share of the bonded tokens of the validator
+// after the delegation. The validator receives its power share of the
+// annual provisions net of the community tax, and the delegation its share
+// of the validator rewards net of the commission.
+func ProjectDelegationReward(
+	annualProvisions, communityTax, commissionRate sdk.Dec,
+	validatorTokens, bondedTokens, amount sdk.Int,
+) (annualReward, powerShare sdk.Dec) {
+	bondedAfter := bondedTokens.Add(amount)
+	if !bondedAfter.IsPositive() {
+		return sdk.ZeroDec(), sdk.ZeroDec()
+	}
+	powerShare = validatorTokens.Add(amount).ToDec().QuoInt(bondedAfter)
+
+	// the power share times the delegation share of the validator tokens
+	// reduces to amount / bondedAfter
+	annualReward = annualProvisions.
+		Mul(sdk.OneDec().Sub(communityTax)).
+		Mul(sdk.OneDec().Sub(commissionRate)).
+		MulInt(amount).
+		QuoInt(bondedAfter)
+	return annualReward, powerShare
+}
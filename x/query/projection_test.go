@@ -0,0 +1,173 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/query"
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+func TestProjectCommunityPool(t *testing.T) {
+	// a fixed inflation rate makes the supply grow geometrically, so that
+	// after n blocks supply = s0 * (1 + inflation/blocksPerYear)^n
+	inflation := sdk.NewDecWithPrec(1, 1)
+	mintParams := minttypes.NewParams("stake", sdk.NewDecWithPrec(13, 2), inflation, inflation, sdk.NewDecWithPrec(67, 2), 1000)
+	minter := minttypes.NewMinter(inflation, sdk.ZeroDec())
+	communityTax := sdk.NewDecWithPrec(2, 2)
+	totalSupply := sdk.NewInt(1_000_000_000)
+	blocks := uint64(10)
+
+	minted, toCommunityPool := query.ProjectCommunityPool(minter, mintParams, communityTax, totalSupply, totalSupply.QuoRaw(2), blocks)
+
+	growth := sdk.OneDec().Add(inflation.QuoInt64(int64(mintParams.BlocksPerYear))).Power(blocks)
+	expMinted := totalSupply.ToDec().Mul(growth).Sub(totalSupply.ToDec())
+
+	// block provisions are truncated, so at most one token is lost per block
+	diff := expMinted.Sub(minted.ToDec())
+	require.True(t, diff.GTE(sdk.ZeroDec()), "minted %s more than %s", minted, expMinted)
+	require.True(t, diff.LTE(sdk.NewDec(int64(blocks))), "minted %s, expected %s", minted, expMinted)
+	require.Equal(t, minted.ToDec().Mul(communityTax), toCommunityPool)
+}
+
+func TestProjectCommunityPoolSteps(t *testing.T) {
+	// the inflation moves towards its max as the bonded ratio is below goal
+	mintParams := minttypes.DefaultParams()
+	mintParams.BlocksPerYear = 100_000
+	minter := minttypes.NewMinter(sdk.NewDecWithPrec(7, 2), sdk.ZeroDec())
+	communityTax := sdk.NewDecWithPrec(2, 2)
+	totalSupply := sdk.NewInt(1_000_000_000_000)
+	bondedTokens := totalSupply.QuoRaw(2)
+	blocks := mintParams.BlocksPerYear
+
+	// replayMint replays the mint BeginBlocker at every block
+	replayMint := func(blocks uint64) sdk.Int {
+		replayMinter, replayMinted := minter, sdk.ZeroInt()
+		for i := uint64(0); i < blocks; i++ {
+			supply := totalSupply.Add(replayMinted)
+			replayMinter.Inflation = replayMinter.NextInflationRate(mintParams, bondedTokens.ToDec().QuoInt(supply))
+			replayMinter.AnnualProvisions = replayMinter.NextAnnualProvisions(mintParams, supply)
+			replayMinted = replayMinted.Add(replayMinter.BlockProvision(mintParams).Amount)
+		}
+		return replayMinted
+	}
+
+	// the steps stay within 0.1% of the replay of every block
+	expMinted := replayMint(blocks)
+	minted, toCommunityPool := query.ProjectCommunityPool(minter, mintParams, communityTax, totalSupply, bondedTokens, blocks)
+	diff := expMinted.Sub(minted).Abs().ToDec().QuoInt(expMinted)
+	require.True(t, diff.LT(sdk.NewDecWithPrec(1, 3)), "minted %s, expected %s", minted, expMinted)
+	require.Equal(t, minted.ToDec().Mul(communityTax), toCommunityPool)
+
+	// up to the max steps, every block is replayed
+	minted, _ = query.ProjectCommunityPool(minter, mintParams, communityTax, totalSupply, bondedTokens, query.CommunityPoolProjectionMaxSteps)
+	require.Equal(t, replayMint(query.CommunityPoolProjectionMaxSteps), minted)
+}
+
+func TestQueryProjectedCommunityPool(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Height: 2,
+		Time:   time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+	})
+	mintParams := app.MintKeeper.GetParams(ctx)
+
+	res, err := q.ProjectedCommunityPool(sdk.WrapSDKContext(ctx), &types.QueryProjectedCommunityPoolRequest{Blocks: 100})
+	require.NoError(t, err)
+
+	require.Equal(t, app.DistrKeeper.GetFeePool(ctx).CommunityPool, res.CommunityPool)
+	require.True(t, res.ProjectedMinted.IsPositive())
+	projectedIncrease := res.ProjectedCommunityPool.AmountOf(mintParams.MintDenom).Sub(res.CommunityPool.AmountOf(mintParams.MintDenom))
+	require.Equal(t, res.ProjectedMinted.Amount.ToDec().Mul(app.DistrKeeper.GetCommunityTax(ctx)), projectedIncrease)
+	require.Equal(t, query.CommunityPoolProjectionAssumptions, res.Assumptions)
+
+	_, err = q.ProjectedCommunityPool(sdk.WrapSDKContext(ctx), &types.QueryProjectedCommunityPoolRequest{})
+	require.Error(t, err)
+	_, err = q.ProjectedCommunityPool(sdk.WrapSDKContext(ctx), &types.QueryProjectedCommunityPoolRequest{Blocks: mintParams.BlocksPerYear + 1})
+	require.Error(t, err)
+}
+
+func TestProjectDelegationReward(t *testing.T) {
+	annualProvisions := sdk.NewDec(1_000_000)
+	communityTax := sdk.NewDecWithPrec(2, 2)
+	commissionRate := sdk.NewDecWithPrec(1, 1)
+
+	// the validator holds 100_000 of the 900_000 bonded tokens and 200_000 of
+	// the 1_000_000 after the delegation, so it receives
+	// 1_000_000 * 0.98 * 0.2 = 196_000, 176_400 net of the commission, half of
+	// which goes to the delegation
+	annualReward, powerShare := query.ProjectDelegationReward(annualProvisions, communityTax, commissionRate,
+		sdk.NewInt(100_000), sdk.NewInt(900_000), sdk.NewInt(100_000))
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), powerShare)
+	require.Equal(t, sdk.NewDec(88_200), annualReward)
+
+	// no bonded tokens
+	annualReward, powerShare = query.ProjectDelegationReward(annualProvisions, communityTax, commissionRate,
+		sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())
+	require.True(t, annualReward.IsZero())
+	require.True(t, powerShare.IsZero())
+}
+
+func TestQueryProjectedDelegationReward(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Height: 2,
+		Time:   time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+	})
+
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	amount := sdk.NewInt(1_000_000)
+	res, err := q.ProjectedDelegationReward(sdk.WrapSDKContext(ctx), &types.QueryProjectedDelegationRewardRequest{
+		ValidatorAddress: validator.OperatorAddress,
+		Amount:           amount.String(),
+	})
+	require.NoError(t, err)
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	expReward, expShare := query.ProjectDelegationReward(minter.AnnualProvisions, app.DistrKeeper.GetCommunityTax(ctx), validator.Commission.Rate,
+		validator.Tokens, app.StakingKeeper.TotalBondedTokens(ctx), amount)
+	require.Equal(t, sdk.NewDecCoinFromDec(app.StakingKeeper.BondDenom(ctx), expReward), res.AnnualReward)
+	require.Equal(t, expReward.QuoInt(amount), res.Apr)
+	require.Equal(t, expShare, res.PowerShare)
+	require.Equal(t, validator.Commission.Rate, res.CommissionRate)
+	require.Equal(t, minter.Inflation, res.Inflation)
+	require.Equal(t, query.DelegationRewardProjectionAssumptions, res.Assumptions)
+
+	_, err = q.ProjectedDelegationReward(sdk.WrapSDKContext(ctx), &types.QueryProjectedDelegationRewardRequest{
+		ValidatorAddress: validator.OperatorAddress,
+		Amount:           "0",
+	})
+	require.Error(t, err)
+	_, err = q.ProjectedDelegationReward(sdk.WrapSDKContext(ctx), &types.QueryProjectedDelegationRewardRequest{
+		ValidatorAddress: validator.OperatorAddress,
+		Amount:           "1.5",
+	})
+	require.Error(t, err)
+	_, err = q.ProjectedDelegationReward(sdk.WrapSDKContext(ctx), &types.QueryProjectedDelegationRewardRequest{
+		ValidatorAddress: sdk.ValAddress("unknown_validator___").String(),
+		Amount:           amount.String(),
+	})
+	require.Error(t, err)
+}
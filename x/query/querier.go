@@ -0,0 +1,1029 @@
+package query
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	autocompoundtypes "github.com/cosmos/gaia/v9/x/autocompound/types"
+	downtimegracetypes "github.com/cosmos/gaia/v9/x/downtimegrace/types"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	grantspooltypes "github.com/cosmos/gaia/v9/x/grantspool/types"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+	"github.com/cosmos/gaia/v9/x/query/types"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+var _ types.QueryServer = &GrpcQuerier{}
+
+// DefaultHoldersAboveMaxScan is the default maximum number of balances
+// scanned by a HoldersAbove query, bounding the cost of a request whatever
+// the number of accounts.
+const DefaultHoldersAboveMaxScan = 100_000
+
+type GrpcQuerier struct {
+	stakingKeeper  types.StakingKeeper
+	bankKeeper     types.BankKeeper
+	mintKeeper     types.MintKeeper
+	distrKeeper    types.DistributionKeeper
+	clientKeeper   types.ClientKeeper
+	govKeeper      types.GovKeeper
+	feeKeeper      types.IBCFeeKeeper
+	globalFee      types.GlobalFeeQuerier
+	policy         types.PolicyQuerier
+	recurringSpend types.RecurringSpendQuerier
+	downtimeGrace  types.DowntimeGraceQuerier
+	grantsPool     types.GrantsPoolQuerier
+	autoCompound   types.AutoCompoundQuerier
+	rewards        *RewardIndex
+	defaultParams  []DefaultParamSet
+	relays         *RelayIndex
+	transferKeeper types.TransferKeeper
+	transfers      *TransferIndex
+	paramsKeeper   types.ParamsKeeper
+	feesPaid       *FeesPaidIndex
+	holdersMaxScan uint64
+}
+
+// QuerierOptions are the keepers and the indexes read by the queries. The
+// queries reading a nil index, not enabled in the node config, are
+// unavailable.
+type QuerierOptions struct {
+	StakingKeeper  types.StakingKeeper
+	BankKeeper     types.BankKeeper
+	MintKeeper     types.MintKeeper
+	DistrKeeper    types.DistributionKeeper
+	ClientKeeper   types.ClientKeeper
+	GovKeeper      types.GovKeeper
+	FeeKeeper      types.IBCFeeKeeper
+	GlobalFee      types.GlobalFeeQuerier
+	Policy         types.PolicyQuerier
+	RecurringSpend types.RecurringSpendQuerier
+	DowntimeGrace  types.DowntimeGraceQuerier
+	GrantsPool     types.GrantsPoolQuerier
+	AutoCompound   types.AutoCompoundQuerier
+	Rewards        *RewardIndex
+	DefaultParams  []DefaultParamSet
+	Relays         *RelayIndex
+	TransferKeeper types.TransferKeeper
+	Transfers      *TransferIndex
+	ParamsKeeper   types.ParamsKeeper
+	FeesPaid       *FeesPaidIndex
+	// HoldersAboveMaxScan is the maximum number of balances scanned by a
+	// HoldersAbove query, DefaultHoldersAboveMaxScan when zero.
+	HoldersAboveMaxScan uint64
+}
+
+func NewGrpcQuerier(opts QuerierOptions) GrpcQuerier {
+	holdersMaxScan := opts.HoldersAboveMaxScan
+	if holdersMaxScan == 0 {
+		holdersMaxScan = DefaultHoldersAboveMaxScan
+	}
+
+	return GrpcQuerier{
+		stakingKeeper:  opts.StakingKeeper,
+		bankKeeper:     opts.BankKeeper,
+		mintKeeper:     opts.MintKeeper,
+		distrKeeper:    opts.DistrKeeper,
+		clientKeeper:   opts.ClientKeeper,
+		govKeeper:      opts.GovKeeper,
+		feeKeeper:      opts.FeeKeeper,
+		globalFee:      opts.GlobalFee,
+		policy:         opts.Policy,
+		recurringSpend: opts.RecurringSpend,
+		downtimeGrace:  opts.DowntimeGrace,
+		grantsPool:     opts.GrantsPool,
+		autoCompound:   opts.AutoCompound,
+		rewards:        opts.Rewards,
+		defaultParams:  opts.DefaultParams,
+		relays:         opts.Relays,
+		transferKeeper: opts.TransferKeeper,
+		transfers:      opts.Transfers,
+		paramsKeeper:   opts.ParamsKeeper,
+		feesPaid:       opts.FeesPaid,
+		holdersMaxScan: holdersMaxScan,
+	}
+}
+
+// AccountStakingSchedule returns the delegations and the pending unbondings of an account
+func (g GrpcQuerier) AccountStakingSchedule(stdCtx context.Context, req *types.QueryAccountStakingScheduleRequest) (*types.QueryAccountStakingScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	bondDenom := g.stakingKeeper.BondDenom(ctx)
+
+	delegations := g.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)
+	delegationResps := make([]stakingtypes.DelegationResponse, 0, len(delegations))
+	for _, del := range delegations {
+		val, found := g.stakingKeeper.GetValidator(ctx, del.GetValidatorAddr())
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "validator %s not found", del.ValidatorAddress)
+		}
+
+		delegationResps = append(delegationResps, stakingtypes.NewDelegationResp(
+			delAddr,
+			del.GetValidatorAddr(),
+			del.Shares,
+			sdk.NewCoin(bondDenom, val.TokensFromShares(del.Shares).TruncateInt()),
+		))
+	}
+
+	var unbondings []types.UnbondingScheduleEntry
+	for _, ubd := range g.stakingKeeper.GetAllUnbondingDelegations(ctx, delAddr) {
+		for _, entry := range ubd.Entries {
+			unbondings = append(unbondings, types.UnbondingScheduleEntry{
+				ValidatorAddress: ubd.ValidatorAddress,
+				CreationHeight:   entry.CreationHeight,
+				CompletionTime:   entry.CompletionTime,
+				InitialBalance:   entry.InitialBalance,
+				Balance:          entry.Balance,
+			})
+		}
+	}
+	sort.SliceStable(unbondings, func(i, j int) bool {
+		return unbondings[i].CompletionTime.Before(unbondings[j].CompletionTime)
+	})
+
+	return &types.QueryAccountStakingScheduleResponse{
+		Delegations: delegationResps,
+		Unbondings:  unbondings,
+	}, nil
+}
+
+// AccountTotalPosition returns the liquid, staked, unbonding and reward tokens of an account by denom
+func (g GrpcQuerier) AccountTotalPosition(stdCtx context.Context, req *types.QueryAccountTotalPositionRequest) (*types.QueryAccountTotalPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	bondDenom := g.stakingKeeper.BondDenom(ctx)
+
+	positions := make(map[string]*types.DenomPosition)
+	position := func(denom string) *types.DenomPosition {
+		if p, ok := positions[denom]; ok {
+			return p
+		}
+		p := &types.DenomPosition{
+			Denom:     denom,
+			Liquid:    sdk.ZeroInt(),
+			Staked:    sdk.ZeroInt(),
+			Unbonding: sdk.ZeroInt(),
+			Rewards:   sdk.ZeroDec(),
+		}
+		positions[denom] = p
+		return p
+	}
+
+	for _, coin := range g.bankKeeper.GetAllBalances(ctx, addr) {
+		p := position(coin.Denom)
+		p.Liquid = p.Liquid.Add(coin.Amount)
+	}
+
+	for _, del := range g.stakingKeeper.GetAllDelegatorDelegations(ctx, addr) {
+		val, found := g.stakingKeeper.GetValidator(ctx, del.GetValidatorAddr())
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "validator %s not found", del.ValidatorAddress)
+		}
+		p := position(bondDenom)
+		p.Staked = p.Staked.Add(val.TokensFromShares(del.Shares).TruncateInt())
+	}
+
+	for _, ubd := range g.stakingKeeper.GetAllUnbondingDelegations(ctx, addr) {
+		for _, entry := range ubd.Entries {
+			p := position(bondDenom)
+			p.Unbonding = p.Unbonding.Add(entry.Balance)
+		}
+	}
+
+	rewardsRes, err := g.distrKeeper.DelegationTotalRewards(stdCtx, &distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: req.Address})
+	if err != nil {
+		return nil, err
+	}
+	for _, coin := range rewardsRes.Total {
+		p := position(coin.Denom)
+		p.Rewards = p.Rewards.Add(coin.Amount)
+	}
+
+	res := &types.QueryAccountTotalPositionResponse{Positions: make([]types.DenomPosition, 0, len(positions))}
+	for _, p := range positions {
+		p.Total = sdk.NewDecFromInt(p.Liquid.Add(p.Staked).Add(p.Unbonding)).Add(p.Rewards)
+		res.Positions = append(res.Positions, *p)
+	}
+	sort.Slice(res.Positions, func(i, j int) bool {
+		return res.Positions[i].Denom < res.Positions[j].Denom
+	})
+	return res, nil
+}
+
+// ProjectedCommunityPool returns the community pool balance projected forward by a number of blocks
+func (g GrpcQuerier) ProjectedCommunityPool(stdCtx context.Context, req *types.QueryProjectedCommunityPoolRequest) (*types.QueryProjectedCommunityPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	mintParams := g.mintKeeper.GetParams(ctx)
+	if req.Blocks == 0 || req.Blocks > mintParams.BlocksPerYear {
+		return nil, status.Errorf(codes.InvalidArgument, "blocks must be between 1 and %d", mintParams.BlocksPerYear)
+	}
+
+	minted, toCommunityPool := ProjectCommunityPool(
+		g.mintKeeper.GetMinter(ctx),
+		mintParams,
+		g.distrKeeper.GetCommunityTax(ctx),
+		g.mintKeeper.StakingTokenSupply(ctx),
+		g.stakingKeeper.TotalBondedTokens(ctx),
+		req.Blocks,
+	)
+
+	communityPool := g.distrKeeper.GetFeePool(ctx).CommunityPool
+	projected := communityPool.Add(sdk.NewDecCoinFromDec(mintParams.MintDenom, toCommunityPool))
+
+	return &types.QueryProjectedCommunityPoolResponse{
+		CommunityPool:          communityPool,
+		ProjectedCommunityPool: projected,
+		ProjectedMinted:        sdk.NewCoin(mintParams.MintDenom, minted),
+		Assumptions:            CommunityPoolProjectionAssumptions,
+	}, nil
+}
+
+// ProjectedDelegationReward returns the annual reward projected for delegating an amount to a validator
+func (g GrpcQuerier) ProjectedDelegationReward(stdCtx context.Context, req *types.QueryProjectedDelegationRewardRequest) (*types.QueryProjectedDelegationRewardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	amount, ok := sdk.NewIntFromString(req.Amount)
+	if !ok || !amount.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "amount must be a positive integer: %s", req.Amount)
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	validator, found := g.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddress)
+	}
+
+	bondedTokens := g.stakingKeeper.TotalBondedTokens(ctx)
+	// the validator is assumed to be in the active set, its tokens are
+	// bonded along with the delegation
+	if !validator.IsBonded() {
+		bondedTokens = bondedTokens.Add(validator.Tokens)
+	}
+
+	minter := g.mintKeeper.GetMinter(ctx)
+	commissionRate := validator.Commission.Rate
+	annualReward, powerShare := ProjectDelegationReward(
+		minter.AnnualProvisions,
+		g.distrKeeper.GetCommunityTax(ctx),
+		commissionRate,
+		validator.Tokens,
+		bondedTokens,
+		amount,
+	)
+
+	return &types.QueryProjectedDelegationRewardResponse{
+		AnnualReward:   sdk.NewDecCoinFromDec(g.stakingKeeper.BondDenom(ctx), annualReward),
+		Apr:            annualReward.QuoInt(amount),
+		PowerShare:     powerShare,
+		CommissionRate: commissionRate,
+		Inflation:      minter.Inflation,
+		Assumptions:    DelegationRewardProjectionAssumptions,
+	}, nil
+}
+
+// Params returns the params of the Gaia custom modules
+func (g GrpcQuerier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	globalFeeRes, err := g.globalFee.Params(stdCtx, &globalfeetypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	recurringSpendRes, err := g.recurringSpend.Params(stdCtx, &recurringspendtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	downtimeGraceRes, err := g.downtimeGrace.Params(stdCtx, &downtimegracetypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	grantsPoolRes, err := g.grantsPool.Params(stdCtx, &grantspooltypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	autoCompoundRes, err := g.autoCompound.Params(stdCtx, &autocompoundtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	policyRes, err := g.policy.Params(stdCtx, &policytypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{
+		Globalfee:      globalFeeRes.Params,
+		Recurringspend: recurringSpendRes.Params,
+		Downtimegrace:  downtimeGraceRes.Params,
+		Grantspool:     grantsPoolRes.Params,
+		Autocompound:   autoCompoundRes.Params,
+		Policy:         policyRes.Params,
+	}, nil
+}
+
+// ParamsDiffFromDefaults returns the params of the modules which differ from their defaults
+func (g GrpcQuerier) ParamsDiffFromDefaults(stdCtx context.Context, _ *types.QueryParamsDiffFromDefaultsRequest) (*types.QueryParamsDiffFromDefaultsResponse, error) {
+	diffs, err := ParamsDiffFromDefaults(sdk.UnwrapSDKContext(stdCtx), g.defaultParams)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryParamsDiffFromDefaultsResponse{Diffs: diffs}, nil
+}
+
+// AllGovernableParams returns the current params of the modules governed by the param change proposals
+func (g GrpcQuerier) AllGovernableParams(stdCtx context.Context, _ *types.QueryAllGovernableParamsRequest) (*types.QueryAllGovernableParamsResponse, error) {
+	modules, err := GovernableParams(sdk.UnwrapSDKContext(stdCtx), g.defaultParams)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllGovernableParamsResponse{Modules: modules}, nil
+}
+
+// PreviewParamsAfterProposal returns the params of the Gaia custom modules once a pending param change proposal
+// applies, with each change of the proposal. The changes are applied to a cached context which is discarded.
+func (g GrpcQuerier) PreviewParamsAfterProposal(stdCtx context.Context, req *types.QueryPreviewParamsAfterProposalRequest) (*types.QueryPreviewParamsAfterProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	proposal, found := g.govKeeper.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d not found", req.ProposalId)
+	}
+	if proposal.Status != govtypes.StatusDepositPeriod && proposal.Status != govtypes.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not pending", req.ProposalId)
+	}
+	content, ok := proposal.GetContent().(*paramproposal.ParameterChangeProposal)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "proposal %d is not a param change proposal", req.ProposalId)
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	changes, err := ApplyParamChanges(cacheCtx, g.paramsKeeper, content.Changes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	params, err := g.Params(sdk.WrapSDKContext(cacheCtx), &types.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPreviewParamsAfterProposalResponse{
+		Params:  *params,
+		Changes: changes,
+	}, nil
+}
+
+// NextUnbondingCompletion returns the earliest completion time of the pending unbondings from a validator and the amount completing then
+func (g GrpcQuerier) NextUnbondingCompletion(stdCtx context.Context, req *types.QueryNextUnbondingCompletionRequest) (*types.QueryNextUnbondingCompletionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	var (
+		found          bool
+		completionTime time.Time
+		amount         = sdk.ZeroInt()
+	)
+	for _, ubd := range g.stakingKeeper.GetUnbondingDelegationsFromValidator(ctx, valAddr) {
+		for _, entry := range ubd.Entries {
+			switch {
+			case !found || entry.CompletionTime.Before(completionTime):
+				found = true
+				completionTime = entry.CompletionTime
+				amount = entry.Balance
+			case entry.CompletionTime.Equal(completionTime):
+				amount = amount.Add(entry.Balance)
+			}
+		}
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no pending unbonding from validator %s", req.ValidatorAddress)
+	}
+
+	return &types.QueryNextUnbondingCompletionResponse{
+		CompletionTime: completionTime,
+		Amount:         sdk.NewCoin(g.stakingKeeper.BondDenom(ctx), amount),
+	}, nil
+}
+
+// SafePruneHeight returns the lowest block height at which a consensus state of an active IBC client was stored.
+// Only the clients recording the height their consensus states were processed at, i.e. the Tendermint clients, are
+// considered.
+func (g GrpcQuerier) SafePruneHeight(stdCtx context.Context, _ *types.QuerySafePruneHeightRequest) (*types.QuerySafePruneHeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	var clientIDs []string
+	g.clientKeeper.IterateClients(ctx, func(clientID string, _ ibcexported.ClientState) bool {
+		clientIDs = append(clientIDs, clientID)
+		return false
+	})
+
+	res := &types.QuerySafePruneHeightResponse{Height: ctx.BlockHeight()}
+	for _, clientID := range clientIDs {
+		statusRes, err := g.clientKeeper.ClientStatus(stdCtx, &clienttypes.QueryClientStatusRequest{ClientId: clientID})
+		if err != nil {
+			return nil, err
+		}
+		if statusRes.Status != ibcexported.Active.String() {
+			continue
+		}
+
+		clientStore := g.clientKeeper.ClientStore(ctx, clientID)
+		err = ibctm.IterateConsensusStateAscending(clientStore, func(height ibcexported.Height) bool {
+			processedHeight, found := ibctm.GetProcessedHeight(clientStore, height)
+			if found && int64(processedHeight.GetRevisionHeight()) < res.Height {
+				res.Height = int64(processedHeight.GetRevisionHeight())
+				res.ClientId = clientID
+			}
+			return false
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return res, nil
+}
+
+// ExpiringClients returns the Tendermint IBC clients whose latest consensus state is older than their trusting period
+// minus the requested buffer, earliest expiry first. The frozen clients are left out as updating them is of no use.
+func (g GrpcQuerier) ExpiringClients(stdCtx context.Context, req *types.QueryExpiringClientsRequest) (*types.QueryExpiringClientsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Within < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative buffer")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	now := ctx.BlockTime()
+
+	clients := []types.ExpiringClient{}
+	var err error
+	g.clientKeeper.IterateClients(ctx, func(clientID string, cs ibcexported.ClientState) bool {
+		clientState, ok := cs.(*ibctm.ClientState)
+		if !ok || clientState.FrozenHeight != (clienttypes.Height{}) {
+			return false
+		}
+		consState, found := g.clientKeeper.GetClientConsensusState(ctx, clientID, clientState.GetLatestHeight())
+		if !found {
+			err = status.Errorf(codes.Internal, "consensus state of client %s not found at height %s", clientID, clientState.GetLatestHeight())
+			return true
+		}
+		tmConsState, ok := consState.(*ibctm.ConsensusState)
+		if !ok {
+			return false
+		}
+
+		expiryTime := tmConsState.Timestamp.Add(clientState.TrustingPeriod)
+		if expiryTime.After(now.Add(req.Within)) {
+			return false
+		}
+		clients = append(clients, types.ExpiringClient{
+			ClientId:        clientID,
+			ChainId:         clientState.ChainId,
+			LatestHeight:    clientState.GetLatestHeight().String(),
+			LatestTimestamp: tmConsState.Timestamp,
+			TrustingPeriod:  clientState.TrustingPeriod,
+			ExpiryTime:      expiryTime,
+			Expired:         !expiryTime.After(now),
+		})
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(clients, func(i, j int) bool {
+		return clients[i].ExpiryTime.Before(clients[j].ExpiryTime)
+	})
+	return &types.QueryExpiringClientsResponse{Clients: clients}, nil
+}
+
+// NonVoters returns the bonded validators which have not voted yet on a proposal in voting period, by descending
+// voting power.
+func (g GrpcQuerier) NonVoters(stdCtx context.Context, req *types.QueryNonVotersRequest) (*types.QueryNonVotersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	proposal, found := g.govKeeper.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d not found", req.ProposalId)
+	}
+	if proposal.Status != govtypes.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	powerReduction := g.stakingKeeper.PowerReduction(ctx)
+	nonVoters := []types.NonVoter{}
+	g.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		// validators vote with the account of their operator address
+		if _, voted := g.govKeeper.GetVote(ctx, req.ProposalId, sdk.AccAddress(validator.GetOperator())); voted {
+			return false
+		}
+		nonVoters = append(nonVoters, types.NonVoter{
+			ValidatorAddress: validator.GetOperator().String(),
+			Moniker:          validator.GetMoniker(),
+			VotingPower:      validator.GetConsensusPower(powerReduction),
+		})
+		return false
+	})
+
+	return &types.QueryNonVotersResponse{NonVoters: nonVoters}, nil
+}
+
+// RewardHistory returns the delegation rewards withdrawn by a delegator in each block of a height range, as indexed
+// by this node
+func (g GrpcQuerier) RewardHistory(stdCtx context.Context, req *types.QueryRewardHistoryRequest) (*types.QueryRewardHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if g.rewards == nil {
+		return nil, status.Error(codes.Unavailable, "reward history is not indexed by this node")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	toHeight := req.ToHeight
+	if toHeight == 0 || toHeight > ctx.BlockHeight() {
+		toHeight = ctx.BlockHeight()
+	}
+	if req.FromHeight < 0 || req.FromHeight > toHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "from height %d must be between 0 and to height %d", req.FromHeight, toHeight)
+	}
+
+	deltas := g.rewards.History(delAddr.String(), req.FromHeight, toHeight)
+	total := sdk.NewCoins()
+	for _, delta := range deltas {
+		total = total.Add(delta.Amount...)
+	}
+
+	return &types.QueryRewardHistoryResponse{
+		Rewards:           deltas,
+		Total:             total,
+		FromHeight:        req.FromHeight,
+		ToHeight:          toHeight,
+		IndexedFromHeight: g.rewards.IndexedFromHeight(),
+	}, nil
+}
+
+// ChannelIncentiveFees returns the relayer incentives escrowed for each packet of a fee enabled channel awaiting
+// relayers
+func (g GrpcQuerier) ChannelIncentiveFees(stdCtx context.Context, req *types.QueryChannelIncentiveFeesRequest) (*types.QueryChannelIncentiveFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	portID := req.PortId
+	if portID == "" {
+		portID = ibctransfertypes.PortID
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	if !g.feeKeeper.IsFeeEnabled(ctx, portID, req.ChannelId) {
+		return nil, status.Errorf(codes.FailedPrecondition, "channel %s on port %s is not fee enabled", req.ChannelId, portID)
+	}
+
+	packets := []types.PacketIncentive{}
+	escrowed := sdk.NewCoins()
+	for _, packetFees := range g.feeKeeper.GetIdentifiedPacketFeesForChannel(ctx, portID, req.ChannelId) {
+		packet := packetIncentive(packetFees)
+		packets = append(packets, packet)
+		escrowed = escrowed.Add(packet.Escrowed...)
+	}
+	sort.Slice(packets, func(i, j int) bool { return packets[i].Sequence < packets[j].Sequence })
+
+	return &types.QueryChannelIncentiveFeesResponse{
+		Packets:  packets,
+		Escrowed: escrowed,
+	}, nil
+}
+
+// IncentivizedChannels returns the fee enabled channels with the total of the relayer incentives escrowed for their
+// packets
+func (g GrpcQuerier) IncentivizedChannels(stdCtx context.Context, _ *types.QueryIncentivizedChannelsRequest) (*types.QueryIncentivizedChannelsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	channels := []types.ChannelIncentives{}
+	for _, channel := range g.feeKeeper.GetAllFeeEnabledChannels(ctx) {
+		packetFees := g.feeKeeper.GetIdentifiedPacketFeesForChannel(ctx, channel.PortId, channel.ChannelId)
+		escrowed := sdk.NewCoins()
+		for _, fees := range packetFees {
+			escrowed = escrowed.Add(packetIncentive(fees).Escrowed...)
+		}
+		channels = append(channels, types.ChannelIncentives{
+			PortId:      channel.PortId,
+			ChannelId:   channel.ChannelId,
+			PacketCount: uint64(len(packetFees)),
+			Escrowed:    escrowed,
+		})
+	}
+
+	return &types.QueryIncentivizedChannelsResponse{Channels: channels}, nil
+}
+
+// BreakEvenRelayFee returns the fees of the txs relaying a packet and its acknowledgement on a fee enabled channel
+// under the global fees
+func (g GrpcQuerier) BreakEvenRelayFee(stdCtx context.Context, req *types.QueryBreakEvenRelayFeeRequest) (*types.QueryBreakEvenRelayFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	portID := req.PortId
+	if portID == "" {
+		portID = ibctransfertypes.PortID
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	if !g.feeKeeper.IsFeeEnabled(ctx, portID, req.ChannelId) {
+		return nil, status.Errorf(codes.FailedPrecondition, "channel %s on port %s is not fee enabled", req.ChannelId, portID)
+	}
+
+	globalFeeRes, err := g.globalFee.Params(stdCtx, &globalfeetypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	params := globalFeeRes.Params
+
+	recvGas := req.RecvGas
+	if recvGas == 0 {
+		recvGas = EstimateRelayGas(recvPacketMsgType, DefaultRecvPacketGas, params.MsgGasFloors)
+	}
+	ackGas := req.AckGas
+	if ackGas == 0 {
+		ackGas = EstimateRelayGas(ackPacketMsgType, DefaultAckPacketGas, params.MsgGasFloors)
+	}
+
+	recvFee := BreakEvenRelayFee(recvGas, params.MinimumGasPrices)
+	ackFee := BreakEvenRelayFee(ackGas, params.MinimumGasPrices)
+	return &types.QueryBreakEvenRelayFeeResponse{
+		RecvGas: recvGas,
+		AckGas:  ackGas,
+		RecvFee: recvFee,
+		AckFee:  ackFee,
+		Total:   recvFee.Add(ackFee...),
+	}, nil
+}
+
+// ValidatorRelayActivity returns the IBC packets relayed by the validator operators in the latest blocks, as indexed
+// by this node
+func (g GrpcQuerier) ValidatorRelayActivity(stdCtx context.Context, req *types.QueryValidatorRelayActivityRequest) (*types.QueryValidatorRelayActivityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if g.relays == nil {
+		return nil, status.Error(codes.Unavailable, "relay activity is not indexed by this node")
+	}
+
+	window := req.Window
+	if window == 0 {
+		window = g.relays.Retention()
+	}
+	if window < 0 || window > g.relays.Retention() {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be between 0 and %d blocks", g.relays.Retention())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	toHeight := ctx.BlockHeight()
+	fromHeight := toHeight - window + 1
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+
+	activities := []types.ValidatorRelayActivity{}
+	totals := make(map[string]uint64)
+	for relayer, counts := range g.relays.Activity(fromHeight, toHeight) {
+		relayerAddr, err := sdk.AccAddressFromBech32(relayer)
+		if err != nil {
+			continue
+		}
+		// the operator account of a validator has the bytes of its operator address
+		valAddr := sdk.ValAddress(relayerAddr)
+		if _, found := g.stakingKeeper.GetValidator(ctx, valAddr); !found {
+			continue
+		}
+		activities = append(activities, types.ValidatorRelayActivity{
+			ValidatorAddress: valAddr.String(),
+			RelayerAddress:   relayer,
+			RecvPackets:      counts.RecvPackets,
+			Acknowledgements: counts.Acknowledgements,
+			Timeouts:         counts.Timeouts,
+		})
+		totals[valAddr.String()] = counts.Total()
+	}
+	sort.Slice(activities, func(i, j int) bool {
+		ti, tj := totals[activities[i].ValidatorAddress], totals[activities[j].ValidatorAddress]
+		if ti != tj {
+			return ti > tj
+		}
+		return activities[i].ValidatorAddress < activities[j].ValidatorAddress
+	})
+
+	return &types.QueryValidatorRelayActivityResponse{
+		Activities:        activities,
+		FromHeight:        fromHeight,
+		ToHeight:          toHeight,
+		IndexedFromHeight: g.relays.IndexedFromHeight(),
+	}, nil
+}
+
+// DecentralizationMetrics returns the Nakamoto and Gini coefficients of the voting power of the bonded validators
+func (g GrpcQuerier) DecentralizationMetrics(stdCtx context.Context, _ *types.QueryDecentralizationMetricsRequest) (*types.QueryDecentralizationMetricsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	powerReduction := g.stakingKeeper.PowerReduction(ctx)
+	var powers []int64
+	var totalPower int64
+	g.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		power := validator.GetConsensusPower(powerReduction)
+		powers = append(powers, power)
+		totalPower += power
+		return false
+	})
+
+	return &types.QueryDecentralizationMetricsResponse{
+		NakamotoCoefficient: NakamotoCoefficient(powers),
+		GiniCoefficient:     GiniCoefficient(powers),
+		ValidatorCount:      uint32(len(powers)),
+		TotalVotingPower:    totalPower,
+	}, nil
+}
+
+// packetIncentive sums the fees paid for a packet
+func packetIncentive(packetFees ibcfeetypes.IdentifiedPacketFees) types.PacketIncentive {
+	packet := types.PacketIncentive{
+		Sequence:   packetFees.PacketId.Sequence,
+		RecvFee:    sdk.NewCoins(),
+		AckFee:     sdk.NewCoins(),
+		TimeoutFee: sdk.NewCoins(),
+		Escrowed:   sdk.NewCoins(),
+		Payers:     uint32(len(packetFees.PacketFees)),
+	}
+	for _, packetFee := range packetFees.PacketFees {
+		packet.RecvFee = packet.RecvFee.Add(packetFee.Fee.RecvFee...)
+		packet.AckFee = packet.AckFee.Add(packetFee.Fee.AckFee...)
+		packet.TimeoutFee = packet.TimeoutFee.Add(packetFee.Fee.TimeoutFee...)
+		packet.Escrowed = packet.Escrowed.Add(packetFee.Fee.Total()...)
+	}
+
+	return packet
+}
+
+// DenomChannelHistory returns the channels a denom traversed to reach this chain, from its denom trace, and the
+// channels of this chain it was transferred over, as indexed by this node
+func (g GrpcQuerier) DenomChannelHistory(stdCtx context.Context, req *types.QueryDenomChannelHistoryRequest) (*types.QueryDenomChannelHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if g.transfers == nil {
+		return nil, status.Error(codes.Unavailable, "transfers are not indexed by this node")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	res := &types.QueryDenomChannelHistoryResponse{
+		BaseDenom:         req.Denom,
+		TraceChannels:     []types.DenomTraceChannel{},
+		TransferChannels:  []types.DenomChannelTransfers{},
+		IndexedFromHeight: g.transfers.IndexedFromHeight(),
+	}
+
+	if strings.HasPrefix(req.Denom, ibctransfertypes.DenomPrefix+"/") {
+		hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(req.Denom, ibctransfertypes.DenomPrefix+"/"))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		trace, found := g.transferKeeper.GetDenomTrace(ctx, hash)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "denom trace of %s not found", req.Denom)
+		}
+		res.BaseDenom = trace.BaseDenom
+		// the path is made of port and channel pairs
+		hops := strings.Split(trace.Path, "/")
+		for i := 0; i+1 < len(hops); i += 2 {
+			res.TraceChannels = append(res.TraceChannels, types.DenomTraceChannel{PortId: hops[i], ChannelId: hops[i+1]})
+		}
+	}
+
+	transfers := g.transfers.DenomTransfers(req.Denom)
+	for _, channel := range sortedDenomChannels(transfers) {
+		t := transfers[channel]
+		res.TransferChannels = append(res.TransferChannels, types.DenomChannelTransfers{
+			PortId:          channel.PortID,
+			ChannelId:       channel.ChannelID,
+			SentPackets:     t.SentPackets,
+			ReceivedPackets: t.ReceivedPackets,
+			LastHeight:      t.LastHeight,
+		})
+	}
+
+	return res, nil
+}
+
+// HoldersAbove returns the accounts holding at least a threshold amount of a denom, in the order of the bank store
+func (g GrpcQuerier) HoldersAbove(stdCtx context.Context, req *types.QueryHoldersAboveRequest) (*types.QueryHoldersAboveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	threshold, ok := sdk.NewIntFromString(req.Threshold)
+	if !ok || threshold.IsNegative() {
+		return nil, status.Errorf(codes.InvalidArgument, "threshold must be a non-negative integer: %s", req.Threshold)
+	}
+	page := req.Pagination
+	if page == nil {
+		page = &query.PageRequest{}
+	}
+	if len(page.Key) > 0 && page.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	if len(page.Key) > 0 {
+		if err := sdk.VerifyAddressFormat(page.Key); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page key: %s", err)
+		}
+	}
+	limit := page.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	// the balances are iterated from the account of the page key, the
+	// holders before the offset are skipped, and the iteration stops at the
+	// first holder after the page unless the total is counted. At most
+	// holdersMaxScan balances are scanned, the iteration then stops before
+	// the next account, which is returned as the next key so that the next
+	// page resumes the scan.
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	holders := []types.DenomHolder{}
+	var (
+		nextKey   []byte
+		total     uint64
+		scanned   uint64
+		lastAddr  sdk.AccAddress
+		truncated bool
+	)
+	g.bankKeeper.IterateBalancesFrom(ctx, page.Key, func(address sdk.AccAddress, coin sdk.Coin) bool {
+		if scanned >= g.holdersMaxScan && !address.Equals(lastAddr) {
+			if nextKey == nil {
+				nextKey = address
+			}
+			truncated = true
+			return true
+		}
+		scanned++
+		lastAddr = address
+
+		if coin.Denom != req.Denom || coin.Amount.LT(threshold) {
+			return false
+		}
+		total++
+		switch {
+		case total <= page.Offset:
+		case uint64(len(holders)) < limit:
+			holders = append(holders, types.DenomHolder{Address: address.String(), Balance: coin.Amount})
+		case nextKey == nil:
+			nextKey = address
+			return !page.CountTotal
+		}
+		return false
+	})
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	// like the SDK pagination, the total is only counted without a page key,
+	// and only when all the balances were scanned
+	if page.CountTotal && len(page.Key) == 0 && !truncated {
+		pageRes.Total = total
+	}
+
+	return &types.QueryHoldersAboveResponse{Holders: holders, Pagination: pageRes}, nil
+}
+
+// AddressFeesPaid returns the sum of the fees an address paid over its txs, as indexed by this node
+func (g GrpcQuerier) AddressFeesPaid(_ context.Context, req *types.QueryAddressFeesPaidRequest) (*types.QueryAddressFeesPaidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if g.feesPaid == nil {
+		return nil, status.Error(codes.Unavailable, "fees are not indexed by this node")
+	}
+
+	paid := g.feesPaid.FeesPaid(addr.String())
+	return &types.QueryAddressFeesPaidResponse{
+		FeesPaid:          paid.Fees,
+		Txs:               paid.Txs,
+		IndexedFromHeight: g.feesPaid.IndexedFromHeight(),
+	}, nil
+}
+
+// BlockProposer returns the validator which proposed the block at a height. The proposer consensus address of the
+// block header is looked up in the validator set of the height, then in the current validators for a proposer which
+// left the set at that height. Only the headers of the most recent historical entries of the staking module are kept.
+func (g GrpcQuerier) BlockProposer(stdCtx context.Context, req *types.QueryBlockProposerRequest) (*types.QueryBlockProposerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	height := req.Height
+	if height == 0 {
+		height = ctx.BlockHeight()
+	}
+	if height < 0 || height > ctx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is not in [1, %d]", height, ctx.BlockHeight())
+	}
+
+	histInfo, found := g.stakingKeeper.GetHistoricalInfo(ctx, height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "header of height %d not found, the %d most recent headers are kept", height, g.stakingKeeper.HistoricalEntries(ctx))
+	}
+	consAddr := sdk.ConsAddress(histInfo.Header.ProposerAddress)
+
+	res := &types.QueryBlockProposerResponse{
+		Height:           height,
+		ConsensusAddress: consAddr.String(),
+	}
+	for _, val := range histInfo.Valset {
+		valConsAddr, err := val.GetConsAddr()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if valConsAddr.Equals(consAddr) {
+			res.OperatorAddress = val.OperatorAddress
+			return res, nil
+		}
+	}
+
+	val, found := g.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposer %s of height %d not found", consAddr, height)
+	}
+	res.OperatorAddress = val.OperatorAddress
+	return res, nil
+}
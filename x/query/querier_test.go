@@ -0,0 +1,776 @@
+package query_test
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
+	ibctm "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	autocompoundtypes "github.com/cosmos/gaia/v9/x/autocompound/types"
+	downtimegracetypes "github.com/cosmos/gaia/v9/x/downtimegrace/types"
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	grantspooltypes "github.com/cosmos/gaia/v9/x/grantspool/types"
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+	"github.com/cosmos/gaia/v9/x/query"
+	"github.com/cosmos/gaia/v9/x/query/types"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+func TestQueryAccountStakingSchedule(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Height: 2,
+		Time:   time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	valAddr := validator.GetOperator()
+
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, delAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))))
+
+	_, err := app.StakingKeeper.Delegate(ctx, delAddr, sdk.NewInt(1000), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// undelegate twice an hour apart so the schedule has two entries
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	shares, err := validator.SharesFromTokens(sdk.NewInt(400))
+	require.NoError(t, err)
+	firstCompletion, err := app.StakingKeeper.Undelegate(ctx, delAddr, valAddr, shares)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	shares, err = validator.SharesFromTokens(sdk.NewInt(100))
+	require.NoError(t, err)
+	secondCompletion, err := app.StakingKeeper.Undelegate(ctx, delAddr, valAddr, shares)
+	require.NoError(t, err)
+
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+	})
+	res, err := q.AccountStakingSchedule(sdk.WrapSDKContext(ctx), &types.QueryAccountStakingScheduleRequest{Address: delAddr.String()})
+	require.NoError(t, err)
+
+	require.Len(t, res.Delegations, 1)
+	require.Equal(t, valAddr.String(), res.Delegations[0].Delegation.ValidatorAddress)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 500), res.Delegations[0].Balance)
+
+	require.Len(t, res.Unbondings, 2)
+	require.Equal(t, valAddr.String(), res.Unbondings[0].ValidatorAddress)
+	require.Equal(t, firstCompletion, res.Unbondings[0].CompletionTime)
+	require.Equal(t, sdk.NewInt(400), res.Unbondings[0].Balance)
+	require.Equal(t, secondCompletion, res.Unbondings[1].CompletionTime)
+	require.Equal(t, sdk.NewInt(100), res.Unbondings[1].Balance)
+	require.True(t, firstCompletion.Before(secondCompletion))
+
+	_, err = q.AccountStakingSchedule(sdk.WrapSDKContext(ctx), &types.QueryAccountStakingScheduleRequest{Address: "invalid"})
+	require.Error(t, err)
+}
+
+func TestQueryAccountTotalPosition(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	valAddr := validator.GetOperator()
+
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, delAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000), sdk.NewInt64Coin("uother", 50))))
+
+	_, err := app.StakingKeeper.Delegate(ctx, delAddr, sdk.NewInt(1000), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	shares, err := validator.SharesFromTokens(sdk.NewInt(300))
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.Undelegate(ctx, delAddr, valAddr, shares)
+	require.NoError(t, err)
+
+	// allocate about one token of reward per token staked, the rewards of a
+	// delegation accrue from the block after it started
+	ctx = ctx.WithBlockHeight(3)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, validator.GetTokens()), sdk.NewDecCoin("uother", validator.GetTokens())))
+	rewardsRes, err := app.DistrKeeper.DelegationTotalRewards(sdk.WrapSDKContext(ctx), &distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: delAddr.String()})
+	require.NoError(t, err)
+	bondRewards, otherRewards := rewardsRes.Total.AmountOf(bondDenom), rewardsRes.Total.AmountOf("uother")
+	require.True(t, bondRewards.IsPositive())
+	require.True(t, otherRewards.IsPositive())
+
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+	})
+	res, err := q.AccountTotalPosition(sdk.WrapSDKContext(ctx), &types.QueryAccountTotalPositionRequest{Address: delAddr.String()})
+	require.NoError(t, err)
+
+	require.Equal(t, []types.DenomPosition{
+		{
+			Denom:     bondDenom,
+			Liquid:    sdk.NewInt(1000),
+			Staked:    sdk.NewInt(700),
+			Unbonding: sdk.NewInt(300),
+			Rewards:   bondRewards,
+			Total:     sdk.NewDec(2000).Add(bondRewards),
+		},
+		{
+			Denom:     "uother",
+			Liquid:    sdk.NewInt(50),
+			Staked:    sdk.ZeroInt(),
+			Unbonding: sdk.ZeroInt(),
+			Rewards:   otherRewards,
+			Total:     sdk.NewDec(50).Add(otherRewards),
+		},
+	}, res.Positions)
+
+	_, err = q.AccountTotalPosition(sdk.WrapSDKContext(ctx), &types.QueryAccountTotalPositionRequest{Address: "invalid"})
+	require.Error(t, err)
+}
+
+func TestQueryNextUnbondingCompletion(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Height: 2,
+		Time:   time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	valAddr := app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+	})
+	req := &types.QueryNextUnbondingCompletionRequest{ValidatorAddress: valAddr.String()}
+
+	_, err := q.NextUnbondingCompletion(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err)
+
+	undelegate := func(ctx sdk.Context, delAddr sdk.AccAddress, amount int64) time.Time {
+		validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+		shares, err := validator.SharesFromTokens(sdk.NewInt(amount))
+		require.NoError(t, err)
+		completion, err := app.StakingKeeper.Undelegate(ctx, delAddr, valAddr, shares)
+		require.NoError(t, err)
+		return completion
+	}
+
+	var delAddrs []sdk.AccAddress
+	for i := 0; i < 2; i++ {
+		delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, delAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))))
+		validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+		_, err := app.StakingKeeper.Delegate(ctx, delAddr, sdk.NewInt(1000), stakingtypes.Unbonded, validator, true)
+		require.NoError(t, err)
+		delAddrs = append(delAddrs, delAddr)
+	}
+
+	// the two delegators unbond an hour apart
+	firstCompletion := undelegate(ctx, delAddrs[0], 400)
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	secondCompletion := undelegate(ctx, delAddrs[1], 100)
+	require.True(t, firstCompletion.Before(secondCompletion))
+
+	res, err := q.NextUnbondingCompletion(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, firstCompletion, res.CompletionTime)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 400), res.Amount)
+
+	_, err = q.NextUnbondingCompletion(sdk.WrapSDKContext(ctx), &types.QueryNextUnbondingCompletionRequest{ValidatorAddress: "invalid"})
+	require.Error(t, err)
+}
+
+func TestQueryParams(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+
+	globalFeeParams := globalfeetypes.Params{
+		MinimumGasPrices:      sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(25, 4))),
+		MinFlatFee:            sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)),
+		DynamicFeeSensitivity: sdk.NewDecWithPrec(125, 3),
+		DynamicFeeFloor:       sdk.OneDec(),
+		DynamicFeeCeiling:     sdk.NewDec(10),
+	}
+	subspace := app.GetSubspace(globalfee.ModuleName)
+	subspace.SetParamSet(ctx, &globalFeeParams)
+	policyParams := policytypes.Params{
+		TransferCaps:       sdk.NewCoins(sdk.NewInt64Coin("ubridged", 1000)),
+		MinCommissionRate:  sdk.NewDecWithPrec(5, 2),
+		MaxActiveProposals: 20,
+	}
+	policySubspace := app.GetSubspace(policy.ModuleName)
+	policySubspace.SetParamSet(ctx, &policyParams)
+	recurringSpendParams := recurringspendtypes.Params{MaxSpendFraction: sdk.NewDecWithPrec(1, 1)}
+	app.RecurringSpendKeeper.SetParams(ctx, recurringSpendParams)
+	downtimeGraceParams := downtimegracetypes.Params{GracePeriod: 1000}
+	app.DowntimeGraceKeeper.SetParams(ctx, downtimeGraceParams)
+	grantsPoolParams := grantspooltypes.Params{FeeShare: sdk.NewDecWithPrec(5, 2)}
+	app.GrantsPoolKeeper.SetParams(ctx, grantsPoolParams)
+	autoCompoundParams := autocompoundtypes.Params{CompoundInterval: 100, MinReward: sdk.NewInt(1000), MaxDelegatorsPerBlock: 50}
+	app.AutoCompoundKeeper.SetParams(ctx, autoCompoundParams)
+
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper:  app.StakingKeeper,
+		BankKeeper:     app.BankKeeper,
+		MintKeeper:     app.MintKeeper,
+		DistrKeeper:    app.DistrKeeper,
+		ClientKeeper:   app.IBCKeeper.ClientKeeper,
+		GovKeeper:      app.GovKeeper,
+		GlobalFee:      globalfee.NewGrpcQuerier(subspace, nil, nil, nil, nil, nil),
+		Policy:         policy.NewGrpcQuerier(policySubspace),
+		RecurringSpend: app.RecurringSpendKeeper,
+		DowntimeGrace:  app.DowntimeGraceKeeper,
+		GrantsPool:     app.GrantsPoolKeeper,
+		AutoCompound:   app.AutoCompoundKeeper,
+	})
+
+	res, err := q.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, globalFeeParams, res.Globalfee)
+	require.Equal(t, recurringSpendParams, res.Recurringspend)
+	require.Equal(t, downtimeGraceParams, res.Downtimegrace)
+	require.Equal(t, grantsPoolParams, res.Grantspool)
+	require.Equal(t, autoCompoundParams, res.Autocompound)
+	require.Equal(t, policyParams, res.Policy)
+}
+
+func TestQueryParamsDiffFromDefaults(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+		DefaultParams: app.DefaultParamSets(),
+	})
+
+	res, err := q.ParamsDiffFromDefaults(sdk.WrapSDKContext(ctx), &types.QueryParamsDiffFromDefaultsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Diffs)
+
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(25, 4)))
+	app.GetSubspace(globalfee.ModuleName).Set(ctx, globalfeetypes.ParamStoreKeyMinGasPrices, minGasPrices)
+
+	res, err = q.ParamsDiffFromDefaults(sdk.WrapSDKContext(ctx), &types.QueryParamsDiffFromDefaultsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ParamDiff{{
+		Subspace:     globalfee.ModuleName,
+		Key:          string(globalfeetypes.ParamStoreKeyMinGasPrices),
+		Value:        `[{"denom":"uatom","amount":"0.002500000000000000"}]`,
+		DefaultValue: "[]",
+	}}, res.Diffs)
+}
+
+func TestQueryAllGovernableParams(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+		DefaultParams: app.DefaultParamSets(),
+	})
+
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(25, 4)))
+	app.GetSubspace(globalfee.ModuleName).Set(ctx, globalfeetypes.ParamStoreKeyMinGasPrices, minGasPrices)
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	stakingParams.MaxValidators = 42
+	app.StakingKeeper.SetParams(ctx, stakingParams)
+
+	res, err := q.AllGovernableParams(sdk.WrapSDKContext(ctx), &types.QueryAllGovernableParamsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Modules, len(app.DefaultParamSets()))
+	require.True(t, sort.SliceIsSorted(res.Modules, func(i, j int) bool { return res.Modules[i].Subspace < res.Modules[j].Subspace }))
+
+	// paramValue returns the param of a subspace in the response
+	paramValue := func(subspace, key string) types.ParamValue {
+		for _, module := range res.Modules {
+			if module.Subspace != subspace {
+				continue
+			}
+			for _, param := range module.Params {
+				if param.Key == key {
+					return param
+				}
+			}
+		}
+		require.Failf(t, "param not found", "%s/%s", subspace, key)
+		return types.ParamValue{}
+	}
+	require.Equal(t, types.ParamValue{
+		Key:   string(globalfeetypes.ParamStoreKeyMinGasPrices),
+		Value: `[{"denom":"uatom","amount":"0.002500000000000000"}]`,
+	}, paramValue(globalfee.ModuleName, string(globalfeetypes.ParamStoreKeyMinGasPrices)))
+	require.Equal(t, types.ParamValue{
+		Key:   string(stakingtypes.KeyMaxValidators),
+		Value: "42",
+	}, paramValue(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators)))
+	require.Equal(t, `"`+sdk.DefaultBondDenom+`"`, paramValue(stakingtypes.ModuleName, string(stakingtypes.KeyBondDenom)).Value)
+}
+
+func TestQueryPreviewParamsAfterProposal(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+	subspace := app.GetSubspace(globalfee.ModuleName)
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper:  app.StakingKeeper,
+		BankKeeper:     app.BankKeeper,
+		MintKeeper:     app.MintKeeper,
+		DistrKeeper:    app.DistrKeeper,
+		ClientKeeper:   app.IBCKeeper.ClientKeeper,
+		GovKeeper:      app.GovKeeper,
+		GlobalFee:      globalfee.NewGrpcQuerier(subspace, nil, nil, nil, nil, nil),
+		Policy:         policy.NewGrpcQuerier(app.GetSubspace(policy.ModuleName)),
+		RecurringSpend: app.RecurringSpendKeeper,
+		DowntimeGrace:  app.DowntimeGraceKeeper,
+		GrantsPool:     app.GrantsPoolKeeper,
+		AutoCompound:   app.AutoCompoundKeeper,
+		ParamsKeeper:   app.ParamsKeeper,
+	})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(globalfee.ModuleName, string(globalfeetypes.ParamStoreKeyMinGasPrices), `[{"denom":"uatom","amount":"0.002500000000000000"}]`),
+		paramproposal.NewParamChange(policy.ModuleName, string(policytypes.ParamStoreKeyMaxActiveProposals), `"5"`),
+	}))
+	require.NoError(t, err)
+	req := &types.QueryPreviewParamsAfterProposalRequest{ProposalId: proposal.ProposalId}
+
+	expMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(25, 4)))
+	expChanges := []types.ParamChangePreview{
+		{
+			Subspace: globalfee.ModuleName,
+			Key:      string(globalfeetypes.ParamStoreKeyMinGasPrices),
+			Value:    "[]",
+			NewValue: `[{"denom":"uatom","amount":"0.002500000000000000"}]`,
+		},
+		{
+			Subspace: policy.ModuleName,
+			Key:      string(policytypes.ParamStoreKeyMaxActiveProposals),
+			Value:    `"100"`,
+			NewValue: `"5"`,
+		},
+	}
+
+	// the preview applies in both deposit and voting period
+	res, err := q.PreviewParamsAfterProposal(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, expMinGasPrices, res.Params.Globalfee.MinimumGasPrices)
+	require.Equal(t, uint64(5), res.Params.Policy.MaxActiveProposals)
+	require.Equal(t, expChanges, res.Changes)
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	res, err = q.PreviewParamsAfterProposal(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, expMinGasPrices, res.Params.Globalfee.MinimumGasPrices)
+	require.Equal(t, uint64(5), res.Params.Policy.MaxActiveProposals)
+	require.Equal(t, expChanges, res.Changes)
+
+	// the actual params are left untouched
+	var params globalfeetypes.Params
+	subspace.GetParamSet(ctx, &params)
+	require.Empty(t, params.MinimumGasPrices)
+	var policyParams policytypes.Params
+	app.GetSubspace(policy.ModuleName).GetParamSet(ctx, &policyParams)
+	require.Equal(t, uint64(100), policyParams.MaxActiveProposals)
+
+	// a proposal which is not a param change
+	textProposal, err := app.GovKeeper.SubmitProposal(ctx, govtypes.NewTextProposal("title", "description"))
+	require.NoError(t, err)
+	_, err = q.PreviewParamsAfterProposal(sdk.WrapSDKContext(ctx), &types.QueryPreviewParamsAfterProposalRequest{ProposalId: textProposal.ProposalId})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// a proposal which is no more pending
+	proposal.Status = govtypes.StatusPassed
+	app.GovKeeper.SetProposal(ctx, proposal)
+	_, err = q.PreviewParamsAfterProposal(sdk.WrapSDKContext(ctx), req)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = q.PreviewParamsAfterProposal(sdk.WrapSDKContext(ctx), &types.QueryPreviewParamsAfterProposalRequest{ProposalId: 100})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestQueryNonVoters(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+	})
+
+	// add a second bonded validator with twice the power of the genesis one
+	genesisVal := app.StakingKeeper.GetAllValidators(ctx)[0]
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	otherVal, err := stakingtypes.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, stakingtypes.Description{Moniker: "other"})
+	require.NoError(t, err)
+	otherVal.Status = stakingtypes.Bonded
+	otherVal.Tokens = app.StakingKeeper.PowerReduction(ctx).MulRaw(2)
+	otherVal.DelegatorShares = otherVal.Tokens.ToDec()
+	app.StakingKeeper.SetValidator(ctx, otherVal)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, otherVal)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, govtypes.NewTextProposal("title", "description"))
+	require.NoError(t, err)
+	req := &types.QueryNonVotersRequest{ProposalId: proposal.ProposalId}
+
+	// the proposal is still in deposit period
+	_, err = q.NonVoters(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err)
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	res, err := q.NonVoters(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, []types.NonVoter{
+		{ValidatorAddress: otherVal.OperatorAddress, Moniker: "other", VotingPower: 2},
+		{ValidatorAddress: genesisVal.OperatorAddress, VotingPower: 1},
+	}, res.NonVoters)
+
+	// the validator voting is no more returned
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, sdk.AccAddress(otherVal.GetOperator()), govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+	res, err = q.NonVoters(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, []types.NonVoter{
+		{ValidatorAddress: genesisVal.OperatorAddress, VotingPower: 1},
+	}, res.NonVoters)
+
+	_, err = q.NonVoters(sdk.WrapSDKContext(ctx), &types.QueryNonVotersRequest{ProposalId: 100})
+	require.Error(t, err)
+}
+
+func TestQuerySafePruneHeight(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	now := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 100, Time: now})
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+	})
+
+	// without clients, all the past heights can be pruned
+	res, err := q.SafePruneHeight(sdk.WrapSDKContext(ctx), &types.QuerySafePruneHeightRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QuerySafePruneHeightResponse{Height: 100}, res)
+
+	// the consensus states of a client are not necessarily processed in the
+	// order of their heights, the oldest is the one stored at height 35
+	setTendermintClient(t, app, ctx, "07-tendermint-0", now.Add(-time.Hour), map[uint64]int64{10: 40, 20: 35})
+	// the consensus states of an expired client are not needed anymore
+	setTendermintClient(t, app, ctx, "07-tendermint-1", now.Add(-30*24*time.Hour), map[uint64]int64{5: 12})
+
+	res, err = q.SafePruneHeight(sdk.WrapSDKContext(ctx), &types.QuerySafePruneHeightRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QuerySafePruneHeightResponse{Height: 35, ClientId: "07-tendermint-0"}, res)
+}
+
+func TestQueryExpiringClients(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	now := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 100, Time: now})
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+	})
+
+	// the clients have a trusting period of 14 days
+	setTendermintClient(t, app, ctx, "07-tendermint-0", now.Add(-13*24*time.Hour), map[uint64]int64{10: 40})
+	setTendermintClient(t, app, ctx, "07-tendermint-1", now.Add(-time.Hour), map[uint64]int64{20: 90})
+	setTendermintClient(t, app, ctx, "07-tendermint-2", now.Add(-30*24*time.Hour), map[uint64]int64{5: 12})
+	nearExpiry := types.ExpiringClient{
+		ClientId:        "07-tendermint-0",
+		ChainId:         "counterparty-1",
+		LatestHeight:    "1-10",
+		LatestTimestamp: now.Add(-13 * 24 * time.Hour),
+		TrustingPeriod:  14 * 24 * time.Hour,
+		ExpiryTime:      now.Add(24 * time.Hour),
+	}
+	expired := types.ExpiringClient{
+		ClientId:        "07-tendermint-2",
+		ChainId:         "counterparty-1",
+		LatestHeight:    "1-5",
+		LatestTimestamp: now.Add(-30 * 24 * time.Hour),
+		TrustingPeriod:  14 * 24 * time.Hour,
+		ExpiryTime:      now.Add(-16 * 24 * time.Hour),
+		Expired:         true,
+	}
+
+	// the fresh client is left out, the expired one comes first
+	res, err := q.ExpiringClients(sdk.WrapSDKContext(ctx), &types.QueryExpiringClientsRequest{Within: 2 * 24 * time.Hour})
+	require.NoError(t, err)
+	require.Equal(t, []types.ExpiringClient{expired, nearExpiry}, res.Clients)
+
+	// without buffer, only the expired client is returned
+	res, err = q.ExpiringClients(sdk.WrapSDKContext(ctx), &types.QueryExpiringClientsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ExpiringClient{expired}, res.Clients)
+
+	_, err = q.ExpiringClients(sdk.WrapSDKContext(ctx), &types.QueryExpiringClientsRequest{Within: -time.Hour})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryChannelIncentiveFees(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+		FeeKeeper:     app.IBCFeeKeeper,
+	})
+
+	uatom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uatom", amount)) }
+	payer := sdk.AccAddress("payer_______________").String()
+	app.IBCFeeKeeper.SetFeeEnabled(ctx, ibctransfertypes.PortID, "channel-0")
+	app.IBCFeeKeeper.SetFeeEnabled(ctx, ibctransfertypes.PortID, "channel-1")
+	// the second packet is incentivized twice
+	app.IBCFeeKeeper.SetFeesInEscrow(ctx, channeltypes.NewPacketId(ibctransfertypes.PortID, "channel-0", 2), ibcfeetypes.NewPacketFees([]ibcfeetypes.PacketFee{
+		ibcfeetypes.NewPacketFee(ibcfeetypes.NewFee(uatom(10), uatom(5), uatom(1)), payer, nil),
+		ibcfeetypes.NewPacketFee(ibcfeetypes.NewFee(uatom(20), nil, nil), payer, nil),
+	}))
+	app.IBCFeeKeeper.SetFeesInEscrow(ctx, channeltypes.NewPacketId(ibctransfertypes.PortID, "channel-0", 1), ibcfeetypes.NewPacketFees([]ibcfeetypes.PacketFee{
+		ibcfeetypes.NewPacketFee(ibcfeetypes.NewFee(uatom(3), uatom(2), uatom(1)), payer, nil),
+	}))
+
+	res, err := q.ChannelIncentiveFees(sdk.WrapSDKContext(ctx), &types.QueryChannelIncentiveFeesRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketIncentive{
+		{Sequence: 1, RecvFee: uatom(3), AckFee: uatom(2), TimeoutFee: uatom(1), Escrowed: uatom(6), Payers: 1},
+		{Sequence: 2, RecvFee: uatom(30), AckFee: uatom(5), TimeoutFee: uatom(1), Escrowed: uatom(36), Payers: 2},
+	}, res.Packets)
+	require.Equal(t, uatom(42), res.Escrowed)
+
+	res, err = q.ChannelIncentiveFees(sdk.WrapSDKContext(ctx), &types.QueryChannelIncentiveFeesRequest{ChannelId: "channel-1"})
+	require.NoError(t, err)
+	require.Empty(t, res.Packets)
+	require.True(t, res.Escrowed.IsZero())
+
+	_, err = q.ChannelIncentiveFees(sdk.WrapSDKContext(ctx), &types.QueryChannelIncentiveFeesRequest{ChannelId: "channel-2"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	channels, err := q.IncentivizedChannels(sdk.WrapSDKContext(ctx), &types.QueryIncentivizedChannelsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ChannelIncentives{
+		{PortId: ibctransfertypes.PortID, ChannelId: "channel-0", PacketCount: 2, Escrowed: uatom(42)},
+		{PortId: ibctransfertypes.PortID, ChannelId: "channel-1", PacketCount: 0, Escrowed: sdk.NewCoins()},
+	}, channels.Channels)
+}
+
+// setTendermintClient stores a Tendermint client with a two weeks trusting
+// period and a consensus state per given counterparty height, processed at
+// the mapped local height. The latest consensus state is timestamped with
+// latestTime.
+func setTendermintClient(t *testing.T, app *gaiaapp.GaiaApp, ctx sdk.Context, clientID string, latestTime time.Time, processedHeights map[uint64]int64) {
+	t.Helper()
+
+	var latestHeight clienttypes.Height
+	for height := range processedHeights {
+		if height > latestHeight.RevisionHeight {
+			latestHeight = clienttypes.NewHeight(1, height)
+		}
+	}
+	clientState := ibctm.NewClientState("counterparty-1", ibctm.DefaultTrustLevel, 14*24*time.Hour, 21*24*time.Hour, 10*time.Second,
+		latestHeight, commitmenttypes.GetSDKSpecs(), nil, false, false)
+	app.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, clientState)
+
+	clientStore := app.IBCKeeper.ClientKeeper.ClientStore(ctx, clientID)
+	for height, processedHeight := range processedHeights {
+		consHeight := clienttypes.NewHeight(1, height)
+		consState := ibctm.NewConsensusState(latestTime, commitmenttypes.NewMerkleRoot([]byte("root")), make([]byte, 32))
+		app.IBCKeeper.ClientKeeper.SetClientConsensusState(ctx, clientID, consHeight, consState)
+		ibctm.SetIterationKey(clientStore, consHeight)
+		ibctm.SetProcessedHeight(clientStore, consHeight, clienttypes.NewHeight(0, uint64(processedHeight)))
+	}
+}
+
+func TestQueryHoldersAbove(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+
+	// accounts of varying balances, and one holding another denom only
+	var above []sdk.AccAddress
+	for _, amount := range []int64{50, 100, 150, 99, 1000} {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("uholder", amount))))
+		if amount >= 100 {
+			above = append(above, addr)
+		}
+	}
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, other, sdk.NewCoins(sdk.NewInt64Coin("uother", 500))))
+	sort.Slice(above, func(i, j int) bool { return bytes.Compare(above[i], above[j]) < 0 })
+
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		BankKeeper: app.BankKeeper,
+	})
+	holdersAbove := func(threshold string, page *sdkquery.PageRequest) *types.QueryHoldersAboveResponse {
+		res, err := q.HoldersAbove(sdk.WrapSDKContext(ctx), &types.QueryHoldersAboveRequest{Denom: "uholder", Threshold: threshold, Pagination: page})
+		require.NoError(t, err)
+		return res
+	}
+	addresses := func(holders []types.DenomHolder) []string {
+		var res []string
+		for _, holder := range holders {
+			res = append(res, holder.Address)
+		}
+		return res
+	}
+
+	// only the accounts at or above the threshold are returned, by address
+	res := holdersAbove("100", nil)
+	require.Equal(t, []string{above[0].String(), above[1].String(), above[2].String()}, addresses(res.Holders))
+	require.Nil(t, res.Pagination.NextKey)
+	for _, holder := range res.Holders {
+		require.True(t, holder.Balance.GTE(sdk.NewInt(100)))
+	}
+
+	// the pages follow each other by key or offset
+	res = holdersAbove("100", &sdkquery.PageRequest{Limit: 2, CountTotal: true})
+	require.Equal(t, []string{above[0].String(), above[1].String()}, addresses(res.Holders))
+	require.Equal(t, []byte(above[2]), res.Pagination.NextKey)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	res = holdersAbove("100", &sdkquery.PageRequest{Key: res.Pagination.NextKey, Limit: 2})
+	require.Equal(t, []string{above[2].String()}, addresses(res.Holders))
+	require.Nil(t, res.Pagination.NextKey)
+	res = holdersAbove("100", &sdkquery.PageRequest{Offset: 1, Limit: 1})
+	require.Equal(t, []string{above[1].String()}, addresses(res.Holders))
+
+	require.Empty(t, holdersAbove("1001", nil).Holders)
+
+	// the scan of a request is bounded, the next key resuming it at the next
+	// account, and the total is not counted over a partial scan
+	bounded := query.NewGrpcQuerier(query.QuerierOptions{
+		BankKeeper:          app.BankKeeper,
+		HoldersAboveMaxScan: 1,
+	})
+	var (
+		holders  []string
+		requests int
+	)
+	page := &sdkquery.PageRequest{CountTotal: true}
+	for {
+		res, err := bounded.HoldersAbove(sdk.WrapSDKContext(ctx), &types.QueryHoldersAboveRequest{Denom: "uholder", Threshold: "100", Pagination: page})
+		require.NoError(t, err)
+		require.Zero(t, res.Pagination.Total)
+		holders = append(holders, addresses(res.Holders)...)
+		requests++
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		page = &sdkquery.PageRequest{Key: res.Pagination.NextKey}
+	}
+	require.Equal(t, []string{above[0].String(), above[1].String(), above[2].String()}, holders)
+	require.Greater(t, requests, len(above))
+
+	_, err := q.HoldersAbove(sdk.WrapSDKContext(ctx), &types.QueryHoldersAboveRequest{Denom: "uholder", Threshold: "-1"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = q.HoldersAbove(sdk.WrapSDKContext(ctx), &types.QueryHoldersAboveRequest{Denom: "uholder", Threshold: "100", Pagination: &sdkquery.PageRequest{Key: above[0], Offset: 1}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = q.HoldersAbove(sdk.WrapSDKContext(ctx), &types.QueryHoldersAboveRequest{Denom: "uholder", Threshold: "100", Pagination: &sdkquery.PageRequest{Key: make([]byte, 256)}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryBlockProposer(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+	})
+
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	// a validator which is not part of the validator set of the height
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	leftValidator, err := stakingtypes.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, stakingtypes.Description{Moniker: "left"})
+	require.NoError(t, err)
+	app.StakingKeeper.SetValidator(ctx, leftValidator)
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, leftValidator))
+	leftConsAddr, err := leftValidator.GetConsAddr()
+	require.NoError(t, err)
+
+	app.StakingKeeper.TrackHistoricalInfo(ctx.WithBlockHeader(tmproto.Header{Height: 2, ProposerAddress: consAddr}))
+	app.StakingKeeper.TrackHistoricalInfo(ctx.WithBlockHeader(tmproto.Header{Height: 3, ProposerAddress: leftConsAddr}))
+	ctx = ctx.WithBlockHeight(4)
+
+	res, err := q.BlockProposer(sdk.WrapSDKContext(ctx), &types.QueryBlockProposerRequest{Height: 2})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryBlockProposerResponse{
+		Height:           2,
+		OperatorAddress:  validator.OperatorAddress,
+		ConsensusAddress: consAddr.String(),
+	}, res)
+
+	// the proposer which left the validator set is found in the validators
+	res, err = q.BlockProposer(sdk.WrapSDKContext(ctx), &types.QueryBlockProposerRequest{Height: 3})
+	require.NoError(t, err)
+	require.Equal(t, leftValidator.OperatorAddress, res.OperatorAddress)
+
+	// the header of the current height is not tracked yet
+	_, err = q.BlockProposer(sdk.WrapSDKContext(ctx), &types.QueryBlockProposerRequest{})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = q.BlockProposer(sdk.WrapSDKContext(ctx), &types.QueryBlockProposerRequest{Height: 5})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
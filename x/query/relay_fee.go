@@ -0,0 +1,52 @@
+package query
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+)
+
+// The gas of the messages of a typical relaying tx, which updates the client
+// of the counterparty chain before delivering a packet or an acknowledgement.
+const (
+	DefaultUpdateClientGas uint64 = 120_000
+	DefaultRecvPacketGas   uint64 = 150_000
+	DefaultAckPacketGas    uint64 = 100_000
+)
+
+var (
+	updateClientMsgType = sdk.MsgTypeURL(&clienttypes.MsgUpdateClient{})
+	recvPacketMsgType   = sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{})
+	ackPacketMsgType    = sdk.MsgTypeURL(&channeltypes.MsgAcknowledgement{})
+)
+
+// EstimateRelayGas returns the gas of a typical tx updating the client then
+// delivering a packet or an acknowledgement, i.e. a message of the given type
+// and gas, raised to the sum of the gas floors of its messages.
+func EstimateRelayGas(msgTypeURL string, msgGas uint64, floors []globalfeetypes.MsgGasFloor) uint64 {
+	var floor uint64
+	for _, f := range floors {
+		if f.MsgTypeUrl == updateClientMsgType || f.MsgTypeUrl == msgTypeURL {
+			floor += f.MinGas
+		}
+	}
+
+	gas := DefaultUpdateClientGas + msgGas
+	if floor > gas {
+		return floor
+	}
+	return gas
+}
+
+// BreakEvenRelayFee returns the fee of a tx of the given gas under the given
+// gas prices, rounded up, in each denom of the gas prices.
+func BreakEvenRelayFee(gas uint64, gasPrices sdk.DecCoins) sdk.Coins {
+	fee := sdk.NewCoins()
+	gasLimit := sdk.NewIntFromUint64(gas).ToDec()
+	for _, price := range gasPrices {
+		fee = fee.Add(sdk.NewCoin(price.Denom, price.Amount.Mul(gasLimit).Ceil().RoundInt()))
+	}
+	return fee
+}
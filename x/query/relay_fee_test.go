@@ -0,0 +1,93 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	"github.com/cosmos/gaia/v9/x/query"
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+func TestBreakEvenRelayFee(t *testing.T) {
+	gasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(25, 4)),
+		sdk.NewDecCoinFromDec("uosmo", sdk.NewDecWithPrec(1, 1)),
+	)
+
+	// 0.0025 * 250001 = 625.0025 is rounded up
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 626), sdk.NewInt64Coin("uosmo", 25001)), query.BreakEvenRelayFee(250_001, gasPrices))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 500)), query.BreakEvenRelayFee(200_000, gasPrices[:1]))
+	// zero gas prices are free
+	require.True(t, query.BreakEvenRelayFee(200_000, sdk.DecCoins{sdk.NewDecCoin("uatom", sdk.ZeroInt())}).IsZero())
+	require.True(t, query.BreakEvenRelayFee(200_000, nil).IsZero())
+}
+
+func TestEstimateRelayGas(t *testing.T) {
+	recvMsgType := "/ibc.core.channel.v1.MsgRecvPacket"
+	require.Equal(t, query.DefaultUpdateClientGas+100_000, query.EstimateRelayGas(recvMsgType, 100_000, nil))
+
+	// the floors of the other msg types don't apply
+	floors := []globalfeetypes.MsgGasFloor{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", MinGas: 1_000_000}}
+	require.Equal(t, query.DefaultUpdateClientGas+100_000, query.EstimateRelayGas(recvMsgType, 100_000, floors))
+
+	// the floors of the update client and the recv messages add up
+	floors = []globalfeetypes.MsgGasFloor{
+		{MsgTypeUrl: "/ibc.core.client.v1.MsgUpdateClient", MinGas: 300_000},
+		{MsgTypeUrl: recvMsgType, MinGas: 200_000},
+	}
+	require.Equal(t, uint64(500_000), query.EstimateRelayGas(recvMsgType, 100_000, floors))
+}
+
+func TestQueryBreakEvenRelayFee(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+
+	subspace := app.GetSubspace(globalfee.ModuleName)
+	subspace.Set(ctx, globalfeetypes.ParamStoreKeyMinGasPrices, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(25, 4))))
+	subspace.Set(ctx, globalfeetypes.ParamStoreKeyMsgGasFloors, []globalfeetypes.MsgGasFloor{
+		{MsgTypeUrl: "/ibc.core.channel.v1.MsgAcknowledgement", MinGas: 400_000},
+	})
+	app.IBCFeeKeeper.SetFeeEnabled(ctx, ibctransfertypes.PortID, "channel-0")
+
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+		FeeKeeper:     app.IBCFeeKeeper,
+		GlobalFee:     globalfee.NewGrpcQuerier(subspace, nil, nil, nil, nil, nil),
+	})
+	uatom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uatom", amount)) }
+
+	// the recv gas is estimated, the ack gas is raised to its floor
+	res, err := q.BreakEvenRelayFee(sdk.WrapSDKContext(ctx), &types.QueryBreakEvenRelayFeeRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryBreakEvenRelayFeeResponse{
+		RecvGas: 270_000,
+		AckGas:  400_000,
+		RecvFee: uatom(675),
+		AckFee:  uatom(1000),
+		Total:   uatom(1675),
+	}, res)
+
+	// the gas given in the request is used as is
+	res, err = q.BreakEvenRelayFee(sdk.WrapSDKContext(ctx), &types.QueryBreakEvenRelayFeeRequest{ChannelId: "channel-0", RecvGas: 100_000, AckGas: 50_000})
+	require.NoError(t, err)
+	require.Equal(t, uatom(250), res.RecvFee)
+	require.Equal(t, uatom(125), res.AckFee)
+	require.Equal(t, uatom(375), res.Total)
+
+	_, err = q.BreakEvenRelayFee(sdk.WrapSDKContext(ctx), &types.QueryBreakEvenRelayFeeRequest{ChannelId: "channel-1"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,253 @@
+package query
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// DefaultRelayActivityRetention is the number of blocks the relayed packets
+// are kept for by the RelayIndex, about a week of blocks.
+const DefaultRelayActivityRetention = 100_000
+
+var _ baseapp.StreamingService = &RelayIndex{}
+
+// RelayCounts are the IBC packets relayed by a relayer, by relaying step.
+type RelayCounts struct {
+	RecvPackets      uint64
+	Acknowledgements uint64
+	Timeouts         uint64
+}
+
+// Total returns the number of relayed packets of all the relaying steps.
+func (c RelayCounts) Total() uint64 {
+	return c.RecvPackets + c.Acknowledgements + c.Timeouts
+}
+
+func (c RelayCounts) add(other RelayCounts) RelayCounts {
+	return RelayCounts{
+		RecvPackets:      c.RecvPackets + other.RecvPackets,
+		Acknowledgements: c.Acknowledgements + other.Acknowledgements,
+		Timeouts:         c.Timeouts + other.Timeouts,
+	}
+}
+
+// RelayIndex keeps in memory the IBC packets relayed by each relayer, i.e.
+// the signer of the relaying messages, per block height, in the txs delivered
+// by this node.
+//
+// A relaying message is counted only when it emitted its packet event, so
+// that the redundant relays of a packet already relayed by another relayer,
+// which succeed without effect, are not.
+type RelayIndex struct {
+	mtx       sync.RWMutex
+	txDecoder sdk.TxDecoder
+	retention int64
+	// firstHeight is the first height indexed, zero before any block
+	firstHeight int64
+	// heights are the heights with relays, in ascending order
+	heights []int64
+	// relays maps a block height to the packets relayed by each relayer
+	relays map[int64]map[string]RelayCounts
+}
+
+// NewRelayIndex returns a RelayIndex keeping the relays of the given number
+// of most recent blocks, decoding the delivered txs with the given decoder.
+func NewRelayIndex(txDecoder sdk.TxDecoder, retention int64) *RelayIndex {
+	if retention <= 0 {
+		retention = DefaultRelayActivityRetention
+	}
+
+	return &RelayIndex{
+		txDecoder: txDecoder,
+		retention: retention,
+		relays:    make(map[int64]map[string]RelayCounts),
+	}
+}
+
+// Retention returns the number of blocks the relays are kept for.
+func (idx *RelayIndex) Retention() int64 {
+	return idx.retention
+}
+
+// IndexedFromHeight returns the first height whose relays are known, zero if
+// no block was indexed yet.
+func (idx *RelayIndex) IndexedFromHeight() int64 {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	return idx.firstHeight
+}
+
+// RecordRelays records the packets relayed by a relayer at a height.
+func (idx *RelayIndex) RecordRelays(height int64, relayer string, counts RelayCounts) {
+	if counts.Total() == 0 {
+		return
+	}
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	relayers, ok := idx.relays[height]
+	if !ok {
+		relayers = make(map[string]RelayCounts)
+		idx.relays[height] = relayers
+		idx.heights = append(idx.heights, height)
+		// the heights only go backwards when blocks are replayed
+		if n := len(idx.heights); n > 1 && idx.heights[n-2] > height {
+			sort.Slice(idx.heights, func(i, j int) bool { return idx.heights[i] < idx.heights[j] })
+		}
+	}
+	relayers[relayer] = relayers[relayer].add(counts)
+}
+
+// Activity returns the packets relayed by each relayer from fromHeight to
+// toHeight, inclusive.
+func (idx *RelayIndex) Activity(fromHeight, toHeight int64) map[string]RelayCounts {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	activity := make(map[string]RelayCounts)
+	start := sort.Search(len(idx.heights), func(i int) bool { return idx.heights[i] >= fromHeight })
+	for _, height := range idx.heights[start:] {
+		if height > toHeight {
+			break
+		}
+		for relayer, counts := range idx.relays[height] {
+			activity[relayer] = activity[relayer].add(counts)
+		}
+	}
+
+	return activity
+}
+
+// ListenBeginBlock marks the start of the indexed heights and prunes the
+// heights that fell out of the retention window.
+func (idx *RelayIndex) ListenBeginBlock(goCtx context.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	height := sdk.UnwrapSDKContext(goCtx).BlockHeight()
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if idx.firstHeight == 0 {
+		idx.firstHeight = height
+	}
+	idx.prune(height)
+	return nil
+}
+
+// ListenDeliverTx indexes the packets relayed by a successful tx.
+func (idx *RelayIndex) ListenDeliverTx(goCtx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if !res.IsOK() {
+		return nil
+	}
+
+	tx, err := idx.txDecoder(req.Tx)
+	if err != nil {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(goCtx).BlockHeight()
+	for relayer, counts := range relayedPackets(tx.GetMsgs(), res.Events) {
+		idx.RecordRelays(height, relayer, counts)
+	}
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener, end block events are not
+// indexed.
+func (idx *RelayIndex) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener.
+func (idx *RelayIndex) ListenCommit(context.Context, abci.ResponseCommit) error {
+	return nil
+}
+
+// Stream implements baseapp.StreamingService, the index does not stream the
+// store writes.
+func (idx *RelayIndex) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService, the index does not listen
+// to the store writes.
+func (idx *RelayIndex) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Close implements baseapp.StreamingService.
+func (idx *RelayIndex) Close() error {
+	return nil
+}
+
+// prune drops the heights that fell out of the retention window.
+// It must be called with the lock held.
+func (idx *RelayIndex) prune(latestHeight int64) {
+	keep := sort.Search(len(idx.heights), func(i int) bool { return idx.heights[i] > latestHeight-idx.retention })
+	for _, height := range idx.heights[:keep] {
+		delete(idx.relays, height)
+	}
+	idx.heights = idx.heights[keep:]
+	if idx.firstHeight > 0 && idx.firstHeight <= latestHeight-idx.retention {
+		idx.firstHeight = latestHeight - idx.retention + 1
+	}
+}
+
+// relayedPackets returns the packets relayed per signer by the msgs of a tx.
+// The events of each msg start with the message event of its action emitted
+// by the baseapp, which attributes the packet events following it to the
+// msg.
+func relayedPackets(msgs []sdk.Msg, events []abci.Event) map[string]RelayCounts {
+	relayed := make(map[string]RelayCounts)
+	msgIdx := -1
+	for _, event := range events {
+		switch event.Type {
+		case sdk.EventTypeMessage:
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == sdk.AttributeKeyAction {
+					msgIdx++
+					break
+				}
+			}
+
+		case channeltypes.EventTypeRecvPacket, channeltypes.EventTypeAcknowledgePacket, channeltypes.EventTypeTimeoutPacket:
+			if msgIdx < 0 || msgIdx >= len(msgs) {
+				continue
+			}
+			var relayer string
+			switch msg := msgs[msgIdx].(type) {
+			case *channeltypes.MsgRecvPacket:
+				relayer = msg.Signer
+			case *channeltypes.MsgAcknowledgement:
+				relayer = msg.Signer
+			case *channeltypes.MsgTimeout:
+				relayer = msg.Signer
+			case *channeltypes.MsgTimeoutOnClose:
+				relayer = msg.Signer
+			default:
+				continue
+			}
+
+			counts := relayed[relayer]
+			switch event.Type {
+			case channeltypes.EventTypeRecvPacket:
+				counts.RecvPackets++
+			case channeltypes.EventTypeAcknowledgePacket:
+				counts.Acknowledgements++
+			default:
+				counts.Timeouts++
+			}
+			relayed[relayer] = counts
+		}
+	}
+
+	return relayed
+}
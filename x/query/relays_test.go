@@ -0,0 +1,118 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/query"
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+// relayEvents returns the events of a message, with the packet event of the
+// given type unless it is empty, i.e. for a redundant relay.
+func relayEvents(packetEventType string) sdk.Events {
+	events := sdk.Events{sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, "relay"))}
+	if packetEventType != "" {
+		events = append(events,
+			sdk.NewEvent(packetEventType, sdk.NewAttribute(channeltypes.AttributeKeySequence, "1")),
+			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, "ibc_channel")),
+		)
+	}
+	return events
+}
+
+func TestValidatorRelayActivity(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+	txConfig := gaiaapp.MakeTestEncodingConfig().TxConfig
+
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	valRelayer := sdk.AccAddress(validator.GetOperator()).String()
+	otherRelayer := sdk.AccAddress("relayer_____________").String()
+
+	idx := query.NewRelayIndex(txConfig.TxDecoder(), 10)
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		StakingKeeper: app.StakingKeeper,
+		BankKeeper:    app.BankKeeper,
+		MintKeeper:    app.MintKeeper,
+		DistrKeeper:   app.DistrKeeper,
+		ClientKeeper:  app.IBCKeeper.ClientKeeper,
+		GovKeeper:     app.GovKeeper,
+		Relays:        idx,
+	})
+
+	deliver := func(height int64, code uint32, msgs []sdk.Msg, events ...sdk.Events) {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+
+		res := abci.ResponseDeliverTx{Code: code}
+		for _, msgEvents := range events {
+			res.Events = append(res.Events, msgEvents.ToABCIEvents()...)
+		}
+		require.NoError(t, idx.ListenDeliverTx(sdk.WrapSDKContext(ctx.WithBlockHeight(height)), abci.RequestDeliverTx{Tx: txBytes}, res))
+	}
+	for height := int64(1); height <= 12; height++ {
+		require.NoError(t, idx.ListenBeginBlock(sdk.WrapSDKContext(ctx.WithBlockHeight(height)), abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+	}
+
+	// relayed before the window
+	deliver(5, 0, []sdk.Msg{&channeltypes.MsgRecvPacket{Signer: valRelayer}}, relayEvents(channeltypes.EventTypeRecvPacket))
+	// the redundant relay of the second packet is not counted
+	deliver(10, 0,
+		[]sdk.Msg{
+			&channeltypes.MsgRecvPacket{Signer: valRelayer},
+			&channeltypes.MsgRecvPacket{Signer: valRelayer},
+			&channeltypes.MsgAcknowledgement{Signer: valRelayer},
+		},
+		relayEvents(channeltypes.EventTypeRecvPacket),
+		relayEvents(""),
+		relayEvents(channeltypes.EventTypeAcknowledgePacket),
+	)
+	deliver(11, 0, []sdk.Msg{&channeltypes.MsgTimeout{Signer: valRelayer}}, relayEvents(channeltypes.EventTypeTimeoutPacket))
+	// failed txs are not counted
+	deliver(11, 1, []sdk.Msg{&channeltypes.MsgRecvPacket{Signer: valRelayer}}, relayEvents(channeltypes.EventTypeRecvPacket))
+	// the relayers which are not validator operators are not reported
+	deliver(12, 0, []sdk.Msg{&channeltypes.MsgRecvPacket{Signer: otherRelayer}}, relayEvents(channeltypes.EventTypeRecvPacket))
+
+	res, err := q.ValidatorRelayActivity(sdk.WrapSDKContext(ctx.WithBlockHeight(12)), &types.QueryValidatorRelayActivityRequest{Window: 5})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryValidatorRelayActivityResponse{
+		Activities: []types.ValidatorRelayActivity{
+			{
+				ValidatorAddress: validator.GetOperator().String(),
+				RelayerAddress:   valRelayer,
+				RecvPackets:      1,
+				Acknowledgements: 1,
+				Timeouts:         1,
+			},
+		},
+		FromHeight:        8,
+		ToHeight:          12,
+		IndexedFromHeight: 3,
+	}, res)
+	require.Equal(t, query.RelayCounts{RecvPackets: 1}, idx.Activity(12, 12)[otherRelayer])
+
+	// the whole retention window
+	res, err = q.ValidatorRelayActivity(sdk.WrapSDKContext(ctx.WithBlockHeight(12)), &types.QueryValidatorRelayActivityRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Activities, 1)
+	require.Equal(t, uint64(2), res.Activities[0].RecvPackets)
+	require.Equal(t, int64(3), res.FromHeight)
+
+	_, err = q.ValidatorRelayActivity(sdk.WrapSDKContext(ctx.WithBlockHeight(12)), &types.QueryValidatorRelayActivityRequest{Window: 11})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = query.NewGrpcQuerier(query.QuerierOptions{}).ValidatorRelayActivity(sdk.WrapSDKContext(ctx), &types.QueryValidatorRelayActivityRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,223 @@
+package query
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+// DefaultRewardHistoryRetention is the number of blocks the withdrawn rewards
+// are kept for by the RewardIndex, about a week of blocks.
+const DefaultRewardHistoryRetention = 100_000
+
+var _ baseapp.StreamingService = &RewardIndex{}
+
+// RewardIndex keeps in memory the delegation rewards withdrawn by each
+// delegator, per block height, from the events of the txs delivered by this
+// node.
+//
+// Both the explicit withdrawals and the withdrawals done by the staking
+// module when a delegation changes are indexed, as the distribution module
+// emits a withdraw_rewards event for each of them.
+type RewardIndex struct {
+	mtx       sync.RWMutex
+	retention int64
+	// firstHeight is the first height indexed, zero before any block
+	firstHeight int64
+	// heights are the heights with withdrawals, in ascending order
+	heights []int64
+	// rewards maps a block height to the rewards withdrawn by each delegator
+	rewards map[int64]map[string]sdk.Coins
+}
+
+// NewRewardIndex returns a RewardIndex keeping the withdrawals of the given
+// number of most recent blocks.
+func NewRewardIndex(retention int64) *RewardIndex {
+	if retention <= 0 {
+		retention = DefaultRewardHistoryRetention
+	}
+
+	return &RewardIndex{
+		retention: retention,
+		rewards:   make(map[int64]map[string]sdk.Coins),
+	}
+}
+
+// Retention returns the number of blocks the withdrawals are kept for.
+func (idx *RewardIndex) Retention() int64 {
+	return idx.retention
+}
+
+// IndexedFromHeight returns the first height whose withdrawals are known,
+// zero if no block was indexed yet.
+func (idx *RewardIndex) IndexedFromHeight() int64 {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	return idx.firstHeight
+}
+
+// RecordRewards records the rewards withdrawn by a delegator at a height.
+// Nothing is recorded for zero rewards.
+func (idx *RewardIndex) RecordRewards(height int64, delegator string, amount sdk.Coins) {
+	if amount.IsZero() {
+		return
+	}
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	delegators, ok := idx.rewards[height]
+	if !ok {
+		delegators = make(map[string]sdk.Coins)
+		idx.rewards[height] = delegators
+		idx.heights = append(idx.heights, height)
+		// the heights only go backwards when blocks are replayed
+		if n := len(idx.heights); n > 1 && idx.heights[n-2] > height {
+			sort.Slice(idx.heights, func(i, j int) bool { return idx.heights[i] < idx.heights[j] })
+		}
+	}
+	delegators[delegator] = delegators[delegator].Add(amount...)
+}
+
+// History returns the rewards withdrawn by a delegator in each block from
+// fromHeight to toHeight, inclusive, by ascending height.
+func (idx *RewardIndex) History(delegator string, fromHeight, toHeight int64) []types.RewardDelta {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	deltas := []types.RewardDelta{}
+	start := sort.Search(len(idx.heights), func(i int) bool { return idx.heights[i] >= fromHeight })
+	for _, height := range idx.heights[start:] {
+		if height > toHeight {
+			break
+		}
+		if amount, ok := idx.rewards[height][delegator]; ok {
+			deltas = append(deltas, types.RewardDelta{Height: height, Amount: amount})
+		}
+	}
+
+	return deltas
+}
+
+// ListenBeginBlock marks the start of the indexed heights and prunes the
+// heights that fell out of the retention window.
+func (idx *RewardIndex) ListenBeginBlock(goCtx context.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	height := sdk.UnwrapSDKContext(goCtx).BlockHeight()
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if idx.firstHeight == 0 {
+		idx.firstHeight = height
+	}
+	idx.prune(height)
+	return nil
+}
+
+// ListenDeliverTx indexes the rewards withdrawn by a successful tx.
+func (idx *RewardIndex) ListenDeliverTx(goCtx context.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if !res.IsOK() {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(goCtx).BlockHeight()
+	for delegator, amount := range withdrawnRewards(res.Events) {
+		idx.RecordRewards(height, delegator, amount)
+	}
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener, end block events are not
+// indexed.
+func (idx *RewardIndex) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener.
+func (idx *RewardIndex) ListenCommit(context.Context, abci.ResponseCommit) error {
+	return nil
+}
+
+// Stream implements baseapp.StreamingService, the index does not stream the
+// store writes.
+func (idx *RewardIndex) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService, the index does not listen
+// to the store writes.
+func (idx *RewardIndex) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Close implements baseapp.StreamingService.
+func (idx *RewardIndex) Close() error {
+	return nil
+}
+
+// prune drops the heights that fell out of the retention window.
+// It must be called with the lock held.
+func (idx *RewardIndex) prune(latestHeight int64) {
+	keep := sort.Search(len(idx.heights), func(i int) bool { return idx.heights[i] > latestHeight-idx.retention })
+	for _, height := range idx.heights[:keep] {
+		delete(idx.rewards, height)
+	}
+	idx.heights = idx.heights[keep:]
+	if idx.firstHeight > 0 && idx.firstHeight <= latestHeight-idx.retention {
+		idx.firstHeight = latestHeight - idx.retention + 1
+	}
+}
+
+// withdrawnRewards returns the rewards withdrawn per delegator by the events
+// of a tx. The withdraw_rewards event has no delegator attribute: the rewards
+// are attributed to the sender of the distribution or staking message event
+// emitted once the message withdrawing them is handled.
+func withdrawnRewards(events []abci.Event) map[string]sdk.Coins {
+	withdrawn := make(map[string]sdk.Coins)
+	var pending sdk.Coins
+	for _, event := range events {
+		switch event.Type {
+		case distrtypes.EventTypeWithdrawRewards:
+			for _, attr := range event.Attributes {
+				if string(attr.Key) != sdk.AttributeKeyAmount {
+					continue
+				}
+				// zero rewards are emitted as a zero coin
+				amount, err := sdk.ParseCoinsNormalized(string(attr.Value))
+				if err == nil {
+					pending = pending.Add(amount...)
+				}
+			}
+
+		case sdk.EventTypeMessage:
+			var module, sender string
+			for _, attr := range event.Attributes {
+				switch string(attr.Key) {
+				case sdk.AttributeKeyModule:
+					module = string(attr.Value)
+				case sdk.AttributeKeySender:
+					sender = string(attr.Value)
+				}
+			}
+			if sender == "" || (module != distrtypes.ModuleName && module != stakingtypes.ModuleName) {
+				continue
+			}
+			if !pending.IsZero() {
+				withdrawn[sender] = withdrawn[sender].Add(pending...)
+			}
+			pending = nil
+		}
+	}
+
+	return withdrawn
+}
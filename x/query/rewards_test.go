@@ -0,0 +1,115 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v9/x/query"
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+// withdrawal returns the events of a message withdrawing the rewards of the
+// given amounts, handled by the given module for the delegator.
+func withdrawal(module string, delegator sdk.AccAddress, amounts ...string) sdk.Events {
+	events := sdk.Events{sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, "withdraw"))}
+	for _, amount := range amounts {
+		events = append(events,
+			sdk.NewEvent(distrtypes.EventTypeWithdrawRewards,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount),
+				sdk.NewAttribute(distrtypes.AttributeKeyValidator, "cosmosvaloper1"),
+			),
+			// the rewards are sent by the distribution module
+			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeySender, "cosmos1distribution")),
+		)
+	}
+	return append(events, sdk.NewEvent(sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, module),
+		sdk.NewAttribute(sdk.AttributeKeySender, delegator.String()),
+	))
+}
+
+func TestRewardHistory(t *testing.T) {
+	idx := query.NewRewardIndex(5)
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		Rewards: idx,
+	})
+	ctxAt := func(height int64) sdk.Context {
+		return sdk.NewContext(nil, tmproto.Header{Height: height}, false, log.NewNopLogger())
+	}
+	deliver := func(height int64, code uint32, events ...sdk.Events) {
+		var res abci.ResponseDeliverTx
+		res.Code = code
+		for _, txEvents := range events {
+			res.Events = append(res.Events, txEvents.ToABCIEvents()...)
+		}
+		require.NoError(t, idx.ListenDeliverTx(sdk.WrapSDKContext(ctxAt(height)), abci.RequestDeliverTx{}, res))
+	}
+	beginBlock := func(height int64) {
+		require.NoError(t, idx.ListenBeginBlock(sdk.WrapSDKContext(ctxAt(height)), abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+	}
+
+	del1 := sdk.AccAddress("delegator1__________")
+	del2 := sdk.AccAddress("delegator2__________")
+
+	beginBlock(10)
+	deliver(10, 0,
+		withdrawal(distrtypes.ModuleName, del1, "5uatom", "2uatom,1stake"),
+		// zero rewards are not recorded
+		withdrawal(distrtypes.ModuleName, del2, "0uatom"),
+		// a delegation withdraws the rewards of the delegator
+		withdrawal(stakingtypes.ModuleName, del1, "3uatom"),
+	)
+	// a failed tx withdraws nothing
+	deliver(10, 5, withdrawal(distrtypes.ModuleName, del2, "100uatom"))
+	beginBlock(11)
+	beginBlock(12)
+	deliver(12, 0, withdrawal(distrtypes.ModuleName, del1, "4uatom"))
+
+	res, err := q.RewardHistory(sdk.WrapSDKContext(ctxAt(12)), &types.QueryRewardHistoryRequest{DelegatorAddress: del1.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.RewardDelta{
+		{Height: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("stake", 1))},
+		{Height: 12, Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 4))},
+	}, res.Rewards)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 14), sdk.NewInt64Coin("stake", 1)), res.Total)
+	require.Equal(t, int64(0), res.FromHeight)
+	require.Equal(t, int64(12), res.ToHeight)
+	require.Equal(t, int64(10), res.IndexedFromHeight)
+
+	res, err = q.RewardHistory(sdk.WrapSDKContext(ctxAt(12)), &types.QueryRewardHistoryRequest{DelegatorAddress: del1.String(), FromHeight: 11, ToHeight: 12})
+	require.NoError(t, err)
+	require.Equal(t, []types.RewardDelta{{Height: 12, Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 4))}}, res.Rewards)
+
+	res, err = q.RewardHistory(sdk.WrapSDKContext(ctxAt(12)), &types.QueryRewardHistoryRequest{DelegatorAddress: del2.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Rewards)
+	require.True(t, res.Total.IsZero())
+
+	// the heights out of the retention window are dropped
+	beginBlock(16)
+	res, err = q.RewardHistory(sdk.WrapSDKContext(ctxAt(16)), &types.QueryRewardHistoryRequest{DelegatorAddress: del1.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.RewardDelta{{Height: 12, Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 4))}}, res.Rewards)
+	require.Equal(t, int64(12), res.IndexedFromHeight)
+
+	for _, req := range []*types.QueryRewardHistoryRequest{
+		nil,
+		{DelegatorAddress: "invalid"},
+		{DelegatorAddress: del1.String(), FromHeight: 14, ToHeight: 13},
+	} {
+		_, err = q.RewardHistory(sdk.WrapSDKContext(ctxAt(16)), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	_, err = query.NewGrpcQuerier(query.QuerierOptions{}).RewardHistory(sdk.WrapSDKContext(ctxAt(16)), &types.QueryRewardHistoryRequest{DelegatorAddress: del1.String()})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,81 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gaiaante "github.com/cosmos/gaia/v9/ante"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+// SimulateFunc simulates the execution of a raw tx, e.g. BaseApp.Simulate.
+type SimulateFunc func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
+
+var _ types.TxServer = TxServer{}
+
+// TxServer wraps the tx simulation of the SDK tx service to return the
+// minimum fee of the simulated tx along with its gas. It is node local: the
+// minimum fee includes the minimum gas prices and the bypass msg types of
+// the node.
+type TxServer struct {
+	simulate  SimulateFunc
+	txDecoder sdk.TxDecoder
+	minFee    types.MinimumFeeSource
+	globalFee types.GlobalFeeQuerier
+}
+
+// NewTxServer returns a TxServer simulating the txs with simulate and
+// computing their minimum fee with minFee, usually the fee decorator of the
+// ante handler.
+func NewTxServer(simulate SimulateFunc, txDecoder sdk.TxDecoder, minFee types.MinimumFeeSource, globalFee types.GlobalFeeQuerier) TxServer {
+	return TxServer{
+		simulate:  simulate,
+		txDecoder: txDecoder,
+		minFee:    minFee,
+		globalFee: globalFee,
+	}
+}
+
+// Simulate simulates the tx and returns the minimum fee for the gas it used,
+// raised to the gas floors of its msgs as the tx must declare at least that
+// much gas.
+func (s TxServer) Simulate(stdCtx context.Context, req *types.SimulateRequest) (*types.SimulateResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty tx bytes")
+	}
+
+	tx, err := s.txDecoder(req.TxBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx: %s", err)
+	}
+
+	// same error as the SDK tx service
+	gasInfo, result, err := s.simulate(req.TxBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "%v With gas wanted: '%d' and gas used: '%d' ", err, gasInfo.GasWanted, gasInfo.GasUsed)
+	}
+
+	gfRes, err := s.globalFee.Params(stdCtx, &globalfeetypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	gas := gasInfo.GasUsed
+	if floor := gaiaante.MsgsGasFloor(gfRes.Params.MsgGasFloors, tx.GetMsgs()); floor > gas {
+		gas = floor
+	}
+
+	minFee, err := s.minFee.MinimumFee(sdk.UnwrapSDKContext(stdCtx), tx.GetMsgs(), gas)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.SimulateResponse{
+		GasInfo: &gasInfo,
+		Result:  result,
+		MinFee:  minFee,
+	}, nil
+}
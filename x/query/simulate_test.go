@@ -0,0 +1,68 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiaante "github.com/cosmos/gaia/v9/ante"
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/globalfee"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	"github.com/cosmos/gaia/v9/x/query"
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+func TestSimulateMinimumFee(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{Height: 2})
+	txConfig := gaiaapp.MakeTestEncodingConfig().TxConfig
+
+	globalFeeSubspace := app.GetSubspace(globalfee.ModuleName)
+	params := globalfeetypes.DefaultParams()
+	params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 1)))
+	globalFeeSubspace.SetParamSet(ctx, &params)
+
+	feeDecorator := gaiaante.NewFeeDecorator(gaiaante.HandlerOptions{
+		BypassMinFeeMsgTypes: gaiaapp.GetDefaultBypassFeeMessages(),
+		GlobalFeeSubspace:    globalFeeSubspace,
+		StakingSubspace:      app.GetSubspace(stakingtypes.ModuleName),
+	})
+	simulate := func([]byte) (sdk.GasInfo, *sdk.Result, error) {
+		return sdk.GasInfo{GasWanted: 200_000, GasUsed: 100_000}, &sdk.Result{}, nil
+	}
+	server := query.NewTxServer(simulate, txConfig.TxDecoder(), feeDecorator, globalfee.NewGrpcQuerier(globalFeeSubspace, nil, nil, nil, nil, nil))
+
+	txBytes := func(msg sdk.Msg) []byte {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		bz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+	addr := sdk.AccAddress("addr________________")
+
+	// a normal tx pays the global fee of the gas it used
+	res, err := server.Simulate(sdk.WrapSDKContext(ctx), &types.SimulateRequest{
+		TxBytes: txBytes(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100_000), res.GasInfo.GasUsed)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10_000)).String(), res.MinFee.String())
+
+	// a bypassed tx is free
+	res, err = server.Simulate(sdk.WrapSDKContext(ctx), &types.SimulateRequest{
+		TxBytes: txBytes(&channeltypes.MsgRecvPacket{Signer: addr.String()}),
+	})
+	require.NoError(t, err)
+	require.True(t, res.MinFee.IsZero())
+
+	_, err = server.Simulate(sdk.WrapSDKContext(ctx), &types.SimulateRequest{})
+	require.Error(t, err)
+}
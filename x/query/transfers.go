@@ -0,0 +1,210 @@
+package query
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+var _ baseapp.StreamingService = &TransferIndex{}
+
+// DenomChannel is a channel of this chain a denom was transferred over.
+type DenomChannel struct {
+	PortID    string
+	ChannelID string
+}
+
+// ChannelTransfers are the transfer packets of a denom over a channel.
+type ChannelTransfers struct {
+	SentPackets     uint64
+	ReceivedPackets uint64
+	// LastHeight is the height of the last packet.
+	LastHeight int64
+}
+
+// TransferIndex keeps in memory the channels of this chain each denom was
+// sent or received over by an ICS-20 transfer packet. The denoms are the
+// denoms on this chain, i.e. the ibc/{hash} denom of a voucher.
+//
+// The channels are few, so the index keeps the transfers of all the blocks
+// delivered since the node started.
+type TransferIndex struct {
+	mtx sync.RWMutex
+	// firstHeight is the first height indexed, zero before any block
+	firstHeight int64
+	transfers   map[string]map[DenomChannel]ChannelTransfers
+}
+
+// NewTransferIndex returns an empty TransferIndex.
+func NewTransferIndex() *TransferIndex {
+	return &TransferIndex{
+		transfers: make(map[string]map[DenomChannel]ChannelTransfers),
+	}
+}
+
+// IndexedFromHeight returns the first height whose transfers are known, zero
+// if no block was indexed yet.
+func (idx *TransferIndex) IndexedFromHeight() int64 {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	return idx.firstHeight
+}
+
+// RecordTransfer records a transfer packet of a denom sent, or received when
+// sent is false, over a channel at a height.
+func (idx *TransferIndex) RecordTransfer(height int64, denom string, channel DenomChannel, sent bool) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	channels, ok := idx.transfers[denom]
+	if !ok {
+		channels = make(map[DenomChannel]ChannelTransfers)
+		idx.transfers[denom] = channels
+	}
+	transfers := channels[channel]
+	if sent {
+		transfers.SentPackets++
+	} else {
+		transfers.ReceivedPackets++
+	}
+	if height > transfers.LastHeight {
+		transfers.LastHeight = height
+	}
+	channels[channel] = transfers
+}
+
+// DenomTransfers returns the transfers of a denom by channel.
+func (idx *TransferIndex) DenomTransfers(denom string) map[DenomChannel]ChannelTransfers {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	res := make(map[DenomChannel]ChannelTransfers, len(idx.transfers[denom]))
+	for channel, transfers := range idx.transfers[denom] {
+		res[channel] = transfers
+	}
+	return res
+}
+
+// ListenBeginBlock marks the start of the indexed heights.
+func (idx *TransferIndex) ListenBeginBlock(goCtx context.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	height := sdk.UnwrapSDKContext(goCtx).BlockHeight()
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if idx.firstHeight == 0 {
+		idx.firstHeight = height
+	}
+	return nil
+}
+
+// ListenDeliverTx indexes the transfer packets sent or received by a
+// successful tx.
+func (idx *TransferIndex) ListenDeliverTx(goCtx context.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if !res.IsOK() {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(goCtx).BlockHeight()
+	for _, event := range res.Events {
+		if event.Type != channeltypes.EventTypeSendPacket && event.Type != channeltypes.EventTypeRecvPacket {
+			continue
+		}
+		sent := event.Type == channeltypes.EventTypeSendPacket
+		denom, channel, ok := transferredDenom(event, sent)
+		if !ok {
+			continue
+		}
+		idx.RecordTransfer(height, denom, channel, sent)
+	}
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener, end block events are not
+// indexed.
+func (idx *TransferIndex) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener.
+func (idx *TransferIndex) ListenCommit(context.Context, abci.ResponseCommit) error {
+	return nil
+}
+
+// Stream implements baseapp.StreamingService, the index does not stream the
+// store writes.
+func (idx *TransferIndex) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService, the index does not listen
+// to the store writes.
+func (idx *TransferIndex) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Close implements baseapp.StreamingService.
+func (idx *TransferIndex) Close() error {
+	return nil
+}
+
+// transferredDenom returns the denom on this chain of the ICS-20 packet of a
+// send or recv packet event, along with the channel of this chain. The
+// packets of the other applications are skipped.
+func transferredDenom(event abci.Event, sent bool) (string, DenomChannel, bool) {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+
+	bz, err := hex.DecodeString(attrs[channeltypes.AttributeKeyDataHex])
+	if err != nil || len(bz) == 0 {
+		bz = []byte(attrs[channeltypes.AttributeKeyData])
+	}
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(bz, &data); err != nil || data.Denom == "" {
+		return "", DenomChannel{}, false
+	}
+
+	srcPort, srcChannel := attrs[channeltypes.AttributeKeySrcPort], attrs[channeltypes.AttributeKeySrcChannel]
+	dstPort, dstChannel := attrs[channeltypes.AttributeKeyDstPort], attrs[channeltypes.AttributeKeyDstChannel]
+	if sent {
+		// the packet denom is the full denom path on this chain
+		return transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), DenomChannel{PortID: srcPort, ChannelID: srcChannel}, true
+	}
+
+	// the denom is received as done by the transfer module: a denom returning
+	// to this chain is unprefixed, any other one is prefixed with the channel
+	// of this chain
+	channel := DenomChannel{PortID: dstPort, ChannelID: dstChannel}
+	if transfertypes.ReceiverChainIsSource(srcPort, srcChannel, data.Denom) {
+		unprefixed := data.Denom[len(transfertypes.GetDenomPrefix(srcPort, srcChannel)):]
+		return transfertypes.ParseDenomTrace(unprefixed).IBCDenom(), channel, true
+	}
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(dstPort, dstChannel, data.Denom)).IBCDenom(), channel, true
+}
+
+// sortedDenomChannels returns the channels of the transfers sorted by port
+// and channel.
+func sortedDenomChannels(transfers map[DenomChannel]ChannelTransfers) []DenomChannel {
+	channels := make([]DenomChannel, 0, len(transfers))
+	for channel := range transfers {
+		channels = append(channels, channel)
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		if channels[i].PortID != channels[j].PortID {
+			return channels[i].PortID < channels[j].PortID
+		}
+		return channels[i].ChannelID < channels[j].ChannelID
+	})
+	return channels
+}
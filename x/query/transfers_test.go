@@ -0,0 +1,97 @@
+package query_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/query"
+	"github.com/cosmos/gaia/v9/x/query/types"
+)
+
+// packetEvent returns a send or recv packet event of a transfer packet of the
+// given denom from the source channel to the destination channel.
+func packetEvent(eventType, denom, srcChannel, dstChannel string) sdk.Event {
+	data := transfertypes.NewFungibleTokenPacketData(denom, "100", "sender", "receiver")
+	return sdk.NewEvent(eventType,
+		sdk.NewAttribute(channeltypes.AttributeKeyDataHex, hex.EncodeToString(data.GetBytes())),
+		sdk.NewAttribute(channeltypes.AttributeKeySrcPort, transfertypes.PortID),
+		sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, srcChannel),
+		sdk.NewAttribute(channeltypes.AttributeKeyDstPort, transfertypes.PortID),
+		sdk.NewAttribute(channeltypes.AttributeKeyDstChannel, dstChannel),
+	)
+}
+
+func TestDenomChannelHistory(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+
+	idx := query.NewTransferIndex()
+	q := query.NewGrpcQuerier(query.QuerierOptions{
+		TransferKeeper: app.TransferKeeper,
+		Transfers:      idx,
+	})
+
+	deliver := func(height int64, code uint32, events ...sdk.Event) {
+		res := abci.ResponseDeliverTx{Code: code, Events: sdk.Events(events).ToABCIEvents()}
+		require.NoError(t, idx.ListenDeliverTx(sdk.WrapSDKContext(ctx.WithBlockHeight(height)), abci.RequestDeliverTx{}, res))
+	}
+	require.NoError(t, idx.ListenBeginBlock(sdk.WrapSDKContext(ctx.WithBlockHeight(5)), abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+
+	// uatom is sent over two channels of this chain, once returned over the
+	// second one, and a failed tx is not indexed
+	deliver(5, 0, packetEvent(channeltypes.EventTypeSendPacket, "uatom", "channel-0", "channel-10"))
+	deliver(6, 0, packetEvent(channeltypes.EventTypeSendPacket, "uatom", "channel-1", "channel-20"))
+	deliver(7, 0, packetEvent(channeltypes.EventTypeRecvPacket, "transfer/channel-20/uatom", "channel-20", "channel-1"))
+	deliver(8, 1, packetEvent(channeltypes.EventTypeSendPacket, "uatom", "channel-2", "channel-30"))
+
+	// a voucher of a denom of another chain, received over channel-1 and
+	// forwarded over channel-0
+	trace := transfertypes.ParseDenomTrace("transfer/channel-1/transfer/channel-7/uosmo")
+	app.TransferKeeper.SetDenomTrace(ctx, trace)
+	deliver(9, 0,
+		packetEvent(channeltypes.EventTypeRecvPacket, "transfer/channel-7/uosmo", "channel-20", "channel-1"),
+		packetEvent(channeltypes.EventTypeSendPacket, "transfer/channel-1/transfer/channel-7/uosmo", "channel-0", "channel-10"),
+	)
+
+	res, err := q.DenomChannelHistory(sdk.WrapSDKContext(ctx), &types.QueryDenomChannelHistoryRequest{Denom: "uatom"})
+	require.NoError(t, err)
+	require.Equal(t, "uatom", res.BaseDenom)
+	require.Empty(t, res.TraceChannels)
+	require.Equal(t, []types.DenomChannelTransfers{
+		{PortId: "transfer", ChannelId: "channel-0", SentPackets: 1, LastHeight: 5},
+		{PortId: "transfer", ChannelId: "channel-1", SentPackets: 1, ReceivedPackets: 1, LastHeight: 7},
+	}, res.TransferChannels)
+	require.Equal(t, int64(5), res.IndexedFromHeight)
+
+	res, err = q.DenomChannelHistory(sdk.WrapSDKContext(ctx), &types.QueryDenomChannelHistoryRequest{Denom: trace.IBCDenom()})
+	require.NoError(t, err)
+	require.Equal(t, "uosmo", res.BaseDenom)
+	require.Equal(t, []types.DenomTraceChannel{
+		{PortId: "transfer", ChannelId: "channel-1"},
+		{PortId: "transfer", ChannelId: "channel-7"},
+	}, res.TraceChannels)
+	require.Equal(t, []types.DenomChannelTransfers{
+		{PortId: "transfer", ChannelId: "channel-0", SentPackets: 1, LastHeight: 9},
+		{PortId: "transfer", ChannelId: "channel-1", ReceivedPackets: 1, LastHeight: 9},
+	}, res.TransferChannels)
+
+	// unknown voucher
+	_, err = q.DenomChannelHistory(sdk.WrapSDKContext(ctx), &types.QueryDenomChannelHistoryRequest{Denom: transfertypes.ParseDenomTrace("transfer/channel-3/ujuno").IBCDenom()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = q.DenomChannelHistory(sdk.WrapSDKContext(ctx), &types.QueryDenomChannelHistoryRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the index is disabled
+	_, err = query.NewGrpcQuerier(query.QuerierOptions{}).DenomChannelHistory(sdk.WrapSDKContext(ctx), &types.QueryDenomChannelHistoryRequest{Denom: "uatom"})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	autocompoundtypes "github.com/cosmos/gaia/v9/x/autocompound/types"
+	downtimegracetypes "github.com/cosmos/gaia/v9/x/downtimegrace/types"
+	globalfeetypes "github.com/cosmos/gaia/v9/x/globalfee/types"
+	grantspooltypes "github.com/cosmos/gaia/v9/x/grantspool/types"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+	recurringspendtypes "github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+// StakingKeeper defines the expected staking keeper
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation
+	GetAllUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.UnbondingDelegation
+	GetUnbondingDelegationsFromValidator(ctx sdk.Context, valAddr sdk.ValAddress) []stakingtypes.UnbondingDelegation
+	TotalBondedTokens(ctx sdk.Context) sdk.Int
+	IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool))
+	PowerReduction(ctx sdk.Context) sdk.Int
+	GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
+	HistoricalEntries(ctx sdk.Context) uint32
+	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator stakingtypes.Validator, found bool)
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	IterateBalancesFrom(ctx sdk.Context, start sdk.AccAddress, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+}
+
+// MintKeeper defines the expected mint keeper
+type MintKeeper interface {
+	GetMinter(ctx sdk.Context) minttypes.Minter
+	GetParams(ctx sdk.Context) minttypes.Params
+	StakingTokenSupply(ctx sdk.Context) sdk.Int
+}
+
+// DistributionKeeper defines the expected distribution keeper
+type DistributionKeeper interface {
+	GetFeePool(ctx sdk.Context) distrtypes.FeePool
+	GetCommunityTax(ctx sdk.Context) sdk.Dec
+	DelegationTotalRewards(ctx context.Context, req *distrtypes.QueryDelegationTotalRewardsRequest) (*distrtypes.QueryDelegationTotalRewardsResponse, error)
+}
+
+// ClientKeeper defines the expected IBC client keeper
+type ClientKeeper interface {
+	IterateClients(ctx sdk.Context, cb func(clientID string, cs ibcexported.ClientState) bool)
+	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	GetClientConsensusState(ctx sdk.Context, clientID string, height ibcexported.Height) (ibcexported.ConsensusState, bool)
+	ClientStatus(ctx context.Context, req *clienttypes.QueryClientStatusRequest) (*clienttypes.QueryClientStatusResponse, error)
+}
+
+// GovKeeper defines the expected gov keeper
+type GovKeeper interface {
+	GetProposal(ctx sdk.Context, proposalID uint64) (govtypes.Proposal, bool)
+	GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (govtypes.Vote, bool)
+}
+
+// IBCFeeKeeper defines the expected ICS-29 fee keeper
+type IBCFeeKeeper interface {
+	IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool
+	GetAllFeeEnabledChannels(ctx sdk.Context) []ibcfeetypes.FeeEnabledChannel
+	GetIdentifiedPacketFeesForChannel(ctx sdk.Context, portID, channelID string) []ibcfeetypes.IdentifiedPacketFees
+}
+
+// GlobalFeeQuerier defines the expected globalfee params query
+type GlobalFeeQuerier interface {
+	Params(ctx context.Context, req *globalfeetypes.QueryParamsRequest) (*globalfeetypes.QueryParamsResponse, error)
+}
+
+// MinimumFeeSource defines the expected source of the minimum fee of a tx
+type MinimumFeeSource interface {
+	MinimumFee(ctx sdk.Context, msgs []sdk.Msg, gas uint64) (sdk.Coins, error)
+}
+
+// RecurringSpendQuerier defines the expected recurringspend params query
+type RecurringSpendQuerier interface {
+	Params(ctx context.Context, req *recurringspendtypes.QueryParamsRequest) (*recurringspendtypes.QueryParamsResponse, error)
+}
+
+// DowntimeGraceQuerier defines the expected downtimegrace params query
+type DowntimeGraceQuerier interface {
+	Params(ctx context.Context, req *downtimegracetypes.QueryParamsRequest) (*downtimegracetypes.QueryParamsResponse, error)
+}
+
+// GrantsPoolQuerier defines the expected grantspool params query
+type GrantsPoolQuerier interface {
+	Params(ctx context.Context, req *grantspooltypes.QueryParamsRequest) (*grantspooltypes.QueryParamsResponse, error)
+}
+
+// PolicyQuerier defines the expected policy params query
+type PolicyQuerier interface {
+	Params(ctx context.Context, req *policytypes.QueryParamsRequest) (*policytypes.QueryParamsResponse, error)
+}
+
+// AutoCompoundQuerier defines the expected autocompound params query
+type AutoCompoundQuerier interface {
+	Params(ctx context.Context, req *autocompoundtypes.QueryParamsRequest) (*autocompoundtypes.QueryParamsResponse, error)
+}
+
+// ParamSubspace defines the expected params subspace of a module
+type ParamSubspace interface {
+	Name() string
+	GetRaw(ctx sdk.Context, key []byte) []byte
+}
+
+// ParamsKeeper defines the expected params keeper
+type ParamsKeeper interface {
+	GetSubspace(subspace string) (paramtypes.Subspace, bool)
+}
+
+// TransferKeeper defines the expected IBC transfer keeper
+type TransferKeeper interface {
+	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (transfertypes.DenomTrace, bool)
+}
@@ -0,0 +1,17174 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/query/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types6 "github.com/cosmos/gaia/v9/x/autocompound/types"
+	types4 "github.com/cosmos/gaia/v9/x/downtimegrace/types"
+	types2 "github.com/cosmos/gaia/v9/x/globalfee/types"
+	types5 "github.com/cosmos/gaia/v9/x/grantspool/types"
+	types7 "github.com/cosmos/gaia/v9/x/policy/types"
+	types3 "github.com/cosmos/gaia/v9/x/recurringspend/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryAccountStakingScheduleRequest is the request type for the
+// Query/AccountStakingSchedule RPC method.
+type QueryAccountStakingScheduleRequest struct {
+	// address is the delegator address to query for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountStakingScheduleRequest) Reset()         { *m = QueryAccountStakingScheduleRequest{} }
+func (m *QueryAccountStakingScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountStakingScheduleRequest) ProtoMessage()    {}
+func (*QueryAccountStakingScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{0}
+}
+func (m *QueryAccountStakingScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountStakingScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountStakingScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountStakingScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountStakingScheduleRequest.Merge(m, src)
+}
+func (m *QueryAccountStakingScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountStakingScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountStakingScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountStakingScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryAccountStakingScheduleRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAccountStakingScheduleResponse is the response type for the
+// Query/AccountStakingSchedule RPC method.
+type QueryAccountStakingScheduleResponse struct {
+	// delegations are the active delegations of the account by validator.
+	Delegations []types.DelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// unbondings are the pending unbonding entries of the account sorted by
+	// completion time, earliest first.
+	Unbondings []UnbondingScheduleEntry `protobuf:"bytes,2,rep,name=unbondings,proto3" json:"unbondings"`
+}
+
+func (m *QueryAccountStakingScheduleResponse) Reset()         { *m = QueryAccountStakingScheduleResponse{} }
+func (m *QueryAccountStakingScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountStakingScheduleResponse) ProtoMessage()    {}
+func (*QueryAccountStakingScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{1}
+}
+func (m *QueryAccountStakingScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountStakingScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountStakingScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountStakingScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountStakingScheduleResponse.Merge(m, src)
+}
+func (m *QueryAccountStakingScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountStakingScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountStakingScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountStakingScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryAccountStakingScheduleResponse) GetDelegations() []types.DelegationResponse {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryAccountStakingScheduleResponse) GetUnbondings() []UnbondingScheduleEntry {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
+// UnbondingScheduleEntry is a pending unbonding entry of a delegator from a
+// validator.
+type UnbondingScheduleEntry struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// creation_height is the height at which the unbonding took place.
+	CreationHeight int64 `protobuf:"varint,2,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+	// completion_time is the unix time for unbonding completion.
+	CompletionTime time.Time `protobuf:"bytes,3,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+	// initial_balance defines the tokens initially scheduled to receive at
+	// completion.
+	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance" yaml:"initial_balance"`
+	// balance defines the tokens to receive at completion.
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+}
+
+func (m *UnbondingScheduleEntry) Reset()         { *m = UnbondingScheduleEntry{} }
+func (m *UnbondingScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingScheduleEntry) ProtoMessage()    {}
+func (*UnbondingScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{2}
+}
+func (m *UnbondingScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingScheduleEntry.Merge(m, src)
+}
+func (m *UnbondingScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingScheduleEntry proto.InternalMessageInfo
+
+func (m *UnbondingScheduleEntry) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *UnbondingScheduleEntry) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *UnbondingScheduleEntry) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+// QueryAccountTotalPositionRequest is the request type for the
+// Query/AccountTotalPosition RPC method.
+type QueryAccountTotalPositionRequest struct {
+	// address is the account address to query for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountTotalPositionRequest) Reset()         { *m = QueryAccountTotalPositionRequest{} }
+func (m *QueryAccountTotalPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountTotalPositionRequest) ProtoMessage()    {}
+func (*QueryAccountTotalPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{3}
+}
+func (m *QueryAccountTotalPositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountTotalPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountTotalPositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountTotalPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountTotalPositionRequest.Merge(m, src)
+}
+func (m *QueryAccountTotalPositionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountTotalPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountTotalPositionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountTotalPositionRequest proto.InternalMessageInfo
+
+func (m *QueryAccountTotalPositionRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAccountTotalPositionResponse is the response type for the
+// Query/AccountTotalPosition RPC method.
+type QueryAccountTotalPositionResponse struct {
+	// positions are the tokens of the account by denom, sorted by denom.
+	Positions []DenomPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+}
+
+func (m *QueryAccountTotalPositionResponse) Reset()         { *m = QueryAccountTotalPositionResponse{} }
+func (m *QueryAccountTotalPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountTotalPositionResponse) ProtoMessage()    {}
+func (*QueryAccountTotalPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{4}
+}
+func (m *QueryAccountTotalPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountTotalPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountTotalPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountTotalPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountTotalPositionResponse.Merge(m, src)
+}
+func (m *QueryAccountTotalPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountTotalPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountTotalPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountTotalPositionResponse proto.InternalMessageInfo
+
+func (m *QueryAccountTotalPositionResponse) GetPositions() []DenomPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+// DenomPosition is the tokens of a denom held by an account.
+type DenomPosition struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// liquid is the bank balance of the account, including its vesting tokens.
+	Liquid github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=liquid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquid"`
+	// staked is the tokens delegated by the account, in the bond denom only.
+	Staked github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=staked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staked"`
+	// unbonding is the tokens of the pending unbondings of the account, in the
+	// bond denom only.
+	Unbonding github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=unbonding,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unbonding"`
+	// rewards is the pending staking rewards of the account.
+	Rewards github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=rewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rewards"`
+	// total is the sum of the liquid, staked, unbonding and rewards tokens.
+	Total github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total"`
+}
+
+func (m *DenomPosition) Reset()         { *m = DenomPosition{} }
+func (m *DenomPosition) String() string { return proto.CompactTextString(m) }
+func (*DenomPosition) ProtoMessage()    {}
+func (*DenomPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{5}
+}
+func (m *DenomPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPosition.Merge(m, src)
+}
+func (m *DenomPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPosition proto.InternalMessageInfo
+
+func (m *DenomPosition) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryProjectedCommunityPoolRequest is the request type for the
+// Query/ProjectedCommunityPool RPC method.
+type QueryProjectedCommunityPoolRequest struct {
+	// blocks is the number of blocks to project the community pool forward by.
+	// It must be positive and at most the blocks per year of the mint params.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *QueryProjectedCommunityPoolRequest) Reset()         { *m = QueryProjectedCommunityPoolRequest{} }
+func (m *QueryProjectedCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedCommunityPoolRequest) ProtoMessage()    {}
+func (*QueryProjectedCommunityPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{6}
+}
+func (m *QueryProjectedCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedCommunityPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedCommunityPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedCommunityPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedCommunityPoolRequest.Merge(m, src)
+}
+func (m *QueryProjectedCommunityPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedCommunityPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedCommunityPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedCommunityPoolRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedCommunityPoolRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// QueryProjectedCommunityPoolResponse is the response type for the
+// Query/ProjectedCommunityPool RPC method.
+type QueryProjectedCommunityPoolResponse struct {
+	// community_pool is the current community pool balance.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"community_pool" yaml:"community_pool"`
+	// projected_community_pool is the community pool balance projected after
+	// the requested number of blocks.
+	ProjectedCommunityPool github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=projected_community_pool,json=projectedCommunityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"projected_community_pool" yaml:"projected_community_pool"`
+	// projected_minted is the amount of tokens projected to be minted over the
+	// requested number of blocks.
+	ProjectedMinted types1.Coin `protobuf:"bytes,3,opt,name=projected_minted,json=projectedMinted,proto3" json:"projected_minted" yaml:"projected_minted"`
+	// assumptions lists the assumptions the projection relies on.
+	Assumptions []string `protobuf:"bytes,4,rep,name=assumptions,proto3" json:"assumptions,omitempty"`
+}
+
+func (m *QueryProjectedCommunityPoolResponse) Reset()         { *m = QueryProjectedCommunityPoolResponse{} }
+func (m *QueryProjectedCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedCommunityPoolResponse) ProtoMessage()    {}
+func (*QueryProjectedCommunityPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{7}
+}
+func (m *QueryProjectedCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedCommunityPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedCommunityPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedCommunityPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedCommunityPoolResponse.Merge(m, src)
+}
+func (m *QueryProjectedCommunityPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedCommunityPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedCommunityPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedCommunityPoolResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedCommunityPoolResponse) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *QueryProjectedCommunityPoolResponse) GetProjectedCommunityPool() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.ProjectedCommunityPool
+	}
+	return nil
+}
+
+func (m *QueryProjectedCommunityPoolResponse) GetProjectedMinted() types1.Coin {
+	if m != nil {
+		return m.ProjectedMinted
+	}
+	return types1.Coin{}
+}
+
+func (m *QueryProjectedCommunityPoolResponse) GetAssumptions() []string {
+	if m != nil {
+		return m.Assumptions
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{8}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+// It holds one field per Gaia custom module with params. Fields are only ever
+// added, so that clients of this version keep decoding the response as
+// modules are added.
+type QueryParamsResponse struct {
+	// globalfee is the params of the globalfee module.
+	Globalfee types2.Params `protobuf:"bytes,1,opt,name=globalfee,proto3" json:"globalfee"`
+	// recurringspend is the params of the recurringspend module.
+	Recurringspend types3.Params `protobuf:"bytes,2,opt,name=recurringspend,proto3" json:"recurringspend"`
+	// downtimegrace is the params of the downtimegrace module.
+	Downtimegrace types4.Params `protobuf:"bytes,3,opt,name=downtimegrace,proto3" json:"downtimegrace"`
+	// grantspool is the params of the grantspool module.
+	Grantspool types5.Params `protobuf:"bytes,4,opt,name=grantspool,proto3" json:"grantspool"`
+	// autocompound is the params of the autocompound module.
+	Autocompound types6.Params `protobuf:"bytes,5,opt,name=autocompound,proto3" json:"autocompound"`
+	// policy is the params of the policy module.
+	Policy types7.Params `protobuf:"bytes,6,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{9}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetGlobalfee() types2.Params {
+	if m != nil {
+		return m.Globalfee
+	}
+	return types2.Params{}
+}
+
+func (m *QueryParamsResponse) GetRecurringspend() types3.Params {
+	if m != nil {
+		return m.Recurringspend
+	}
+	return types3.Params{}
+}
+
+func (m *QueryParamsResponse) GetDowntimegrace() types4.Params {
+	if m != nil {
+		return m.Downtimegrace
+	}
+	return types4.Params{}
+}
+
+func (m *QueryParamsResponse) GetGrantspool() types5.Params {
+	if m != nil {
+		return m.Grantspool
+	}
+	return types5.Params{}
+}
+
+func (m *QueryParamsResponse) GetAutocompound() types6.Params {
+	if m != nil {
+		return m.Autocompound
+	}
+	return types6.Params{}
+}
+
+func (m *QueryParamsResponse) GetPolicy() types7.Params {
+	if m != nil {
+		return m.Policy
+	}
+	return types7.Params{}
+}
+
+// QueryParamsDiffFromDefaultsRequest is the request type for the
+// Query/ParamsDiffFromDefaults RPC method.
+type QueryParamsDiffFromDefaultsRequest struct {
+}
+
+func (m *QueryParamsDiffFromDefaultsRequest) Reset()         { *m = QueryParamsDiffFromDefaultsRequest{} }
+func (m *QueryParamsDiffFromDefaultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsDiffFromDefaultsRequest) ProtoMessage()    {}
+func (*QueryParamsDiffFromDefaultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{10}
+}
+func (m *QueryParamsDiffFromDefaultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsDiffFromDefaultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsDiffFromDefaultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsDiffFromDefaultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsDiffFromDefaultsRequest.Merge(m, src)
+}
+func (m *QueryParamsDiffFromDefaultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsDiffFromDefaultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsDiffFromDefaultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsDiffFromDefaultsRequest proto.InternalMessageInfo
+
+// QueryParamsDiffFromDefaultsResponse is the response type for the
+// Query/ParamsDiffFromDefaults RPC method.
+type QueryParamsDiffFromDefaultsResponse struct {
+	// diffs are the params differing from their default, sorted by subspace
+	// and key.
+	Diffs []ParamDiff `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs"`
+}
+
+func (m *QueryParamsDiffFromDefaultsResponse) Reset()         { *m = QueryParamsDiffFromDefaultsResponse{} }
+func (m *QueryParamsDiffFromDefaultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsDiffFromDefaultsResponse) ProtoMessage()    {}
+func (*QueryParamsDiffFromDefaultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{11}
+}
+func (m *QueryParamsDiffFromDefaultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsDiffFromDefaultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsDiffFromDefaultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsDiffFromDefaultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsDiffFromDefaultsResponse.Merge(m, src)
+}
+func (m *QueryParamsDiffFromDefaultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsDiffFromDefaultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsDiffFromDefaultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsDiffFromDefaultsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsDiffFromDefaultsResponse) GetDiffs() []ParamDiff {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+// ParamDiff is a param of a module differing from its default, the values
+// being the amino JSON the params are stored with.
+type ParamDiff struct {
+	Subspace     string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	Key          string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value        string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	DefaultValue string `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty" yaml:"default_value"`
+}
+
+func (m *ParamDiff) Reset()         { *m = ParamDiff{} }
+func (m *ParamDiff) String() string { return proto.CompactTextString(m) }
+func (*ParamDiff) ProtoMessage()    {}
+func (*ParamDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{12}
+}
+func (m *ParamDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamDiff.Merge(m, src)
+}
+func (m *ParamDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamDiff proto.InternalMessageInfo
+
+func (m *ParamDiff) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *ParamDiff) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ParamDiff) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ParamDiff) GetDefaultValue() string {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return ""
+}
+
+// QueryAllGovernableParamsRequest is the request type for the
+// Query/AllGovernableParams RPC method.
+type QueryAllGovernableParamsRequest struct {
+}
+
+func (m *QueryAllGovernableParamsRequest) Reset()         { *m = QueryAllGovernableParamsRequest{} }
+func (m *QueryAllGovernableParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGovernableParamsRequest) ProtoMessage()    {}
+func (*QueryAllGovernableParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{13}
+}
+func (m *QueryAllGovernableParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllGovernableParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllGovernableParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllGovernableParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGovernableParamsRequest.Merge(m, src)
+}
+func (m *QueryAllGovernableParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllGovernableParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGovernableParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllGovernableParamsRequest proto.InternalMessageInfo
+
+// QueryAllGovernableParamsResponse is the response type for the
+// Query/AllGovernableParams RPC method.
+type QueryAllGovernableParamsResponse struct {
+	// modules are the params of each module, sorted by subspace.
+	Modules []ModuleParams `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules"`
+}
+
+func (m *QueryAllGovernableParamsResponse) Reset()         { *m = QueryAllGovernableParamsResponse{} }
+func (m *QueryAllGovernableParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGovernableParamsResponse) ProtoMessage()    {}
+func (*QueryAllGovernableParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{14}
+}
+func (m *QueryAllGovernableParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllGovernableParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllGovernableParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllGovernableParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGovernableParamsResponse.Merge(m, src)
+}
+func (m *QueryAllGovernableParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllGovernableParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGovernableParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllGovernableParamsResponse proto.InternalMessageInfo
+
+func (m *QueryAllGovernableParamsResponse) GetModules() []ModuleParams {
+	if m != nil {
+		return m.Modules
+	}
+	return nil
+}
+
+// ModuleParams are the params of the subspace of a module.
+type ModuleParams struct {
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	// params are the params of the subspace sorted by key.
+	Params []ParamValue `protobuf:"bytes,2,rep,name=params,proto3" json:"params"`
+}
+
+func (m *ModuleParams) Reset()         { *m = ModuleParams{} }
+func (m *ModuleParams) String() string { return proto.CompactTextString(m) }
+func (*ModuleParams) ProtoMessage()    {}
+func (*ModuleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{15}
+}
+func (m *ModuleParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleParams.Merge(m, src)
+}
+func (m *ModuleParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleParams proto.InternalMessageInfo
+
+func (m *ModuleParams) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *ModuleParams) GetParams() []ParamValue {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// ParamValue is the current value of a param, as the amino JSON it is stored
+// with, i.e. the value to set in a param change proposal.
+type ParamValue struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// is_default is true when the param is not set in the state, the value
+	// being the default of the module.
+	IsDefault bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" yaml:"is_default"`
+}
+
+func (m *ParamValue) Reset()         { *m = ParamValue{} }
+func (m *ParamValue) String() string { return proto.CompactTextString(m) }
+func (*ParamValue) ProtoMessage()    {}
+func (*ParamValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{16}
+}
+func (m *ParamValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamValue.Merge(m, src)
+}
+func (m *ParamValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamValue proto.InternalMessageInfo
+
+func (m *ParamValue) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ParamValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ParamValue) GetIsDefault() bool {
+	if m != nil {
+		return m.IsDefault
+	}
+	return false
+}
+
+// QueryPreviewParamsAfterProposalRequest is the request type for the
+// Query/PreviewParamsAfterProposal RPC method.
+type QueryPreviewParamsAfterProposalRequest struct {
+	// proposal_id is the id of the param change proposal, in deposit or voting
+	// period.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+}
+
+func (m *QueryPreviewParamsAfterProposalRequest) Reset() {
+	*m = QueryPreviewParamsAfterProposalRequest{}
+}
+func (m *QueryPreviewParamsAfterProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPreviewParamsAfterProposalRequest) ProtoMessage()    {}
+func (*QueryPreviewParamsAfterProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{17}
+}
+func (m *QueryPreviewParamsAfterProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreviewParamsAfterProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreviewParamsAfterProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPreviewParamsAfterProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreviewParamsAfterProposalRequest.Merge(m, src)
+}
+func (m *QueryPreviewParamsAfterProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreviewParamsAfterProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreviewParamsAfterProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPreviewParamsAfterProposalRequest proto.InternalMessageInfo
+
+func (m *QueryPreviewParamsAfterProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryPreviewParamsAfterProposalResponse is the response type for the
+// Query/PreviewParamsAfterProposal RPC method.
+type QueryPreviewParamsAfterProposalResponse struct {
+	// params are the params of the Gaia custom modules once the proposal
+	// applies.
+	Params QueryParamsResponse `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// changes are the changes of the proposal, in the order they apply.
+	Changes []ParamChangePreview `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *QueryPreviewParamsAfterProposalResponse) Reset() {
+	*m = QueryPreviewParamsAfterProposalResponse{}
+}
+func (m *QueryPreviewParamsAfterProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPreviewParamsAfterProposalResponse) ProtoMessage()    {}
+func (*QueryPreviewParamsAfterProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{18}
+}
+func (m *QueryPreviewParamsAfterProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreviewParamsAfterProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreviewParamsAfterProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPreviewParamsAfterProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreviewParamsAfterProposalResponse.Merge(m, src)
+}
+func (m *QueryPreviewParamsAfterProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreviewParamsAfterProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreviewParamsAfterProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPreviewParamsAfterProposalResponse proto.InternalMessageInfo
+
+func (m *QueryPreviewParamsAfterProposalResponse) GetParams() QueryParamsResponse {
+	if m != nil {
+		return m.Params
+	}
+	return QueryParamsResponse{}
+}
+
+func (m *QueryPreviewParamsAfterProposalResponse) GetChanges() []ParamChangePreview {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// ParamChangePreview is a change of a param change proposal, the values being
+// the amino JSON the params are stored with. The change applies to any
+// subspace, not only to the ones of the Gaia custom modules.
+type ParamChangePreview struct {
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	Key      string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the current value of the param, empty if the param is not set.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// new_value is the value of the param once the proposal applies.
+	NewValue string `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty" yaml:"new_value"`
+}
+
+func (m *ParamChangePreview) Reset()         { *m = ParamChangePreview{} }
+func (m *ParamChangePreview) String() string { return proto.CompactTextString(m) }
+func (*ParamChangePreview) ProtoMessage()    {}
+func (*ParamChangePreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{19}
+}
+func (m *ParamChangePreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChangePreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChangePreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamChangePreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangePreview.Merge(m, src)
+}
+func (m *ParamChangePreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChangePreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangePreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangePreview proto.InternalMessageInfo
+
+func (m *ParamChangePreview) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *ParamChangePreview) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ParamChangePreview) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ParamChangePreview) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+// QueryNextUnbondingCompletionRequest is the request type for the
+// Query/NextUnbondingCompletion RPC method.
+type QueryNextUnbondingCompletionRequest struct {
+	// validator_address is the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryNextUnbondingCompletionRequest) Reset()         { *m = QueryNextUnbondingCompletionRequest{} }
+func (m *QueryNextUnbondingCompletionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextUnbondingCompletionRequest) ProtoMessage()    {}
+func (*QueryNextUnbondingCompletionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{20}
+}
+func (m *QueryNextUnbondingCompletionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextUnbondingCompletionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextUnbondingCompletionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextUnbondingCompletionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextUnbondingCompletionRequest.Merge(m, src)
+}
+func (m *QueryNextUnbondingCompletionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextUnbondingCompletionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextUnbondingCompletionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextUnbondingCompletionRequest proto.InternalMessageInfo
+
+func (m *QueryNextUnbondingCompletionRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryNextUnbondingCompletionResponse is the response type for the
+// Query/NextUnbondingCompletion RPC method.
+type QueryNextUnbondingCompletionResponse struct {
+	// completion_time is the earliest completion time of the pending unbondings.
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+	// amount is the sum of the balances of the unbonding entries completing at
+	// completion_time.
+	Amount types1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *QueryNextUnbondingCompletionResponse) Reset()         { *m = QueryNextUnbondingCompletionResponse{} }
+func (m *QueryNextUnbondingCompletionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextUnbondingCompletionResponse) ProtoMessage()    {}
+func (*QueryNextUnbondingCompletionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{21}
+}
+func (m *QueryNextUnbondingCompletionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextUnbondingCompletionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextUnbondingCompletionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextUnbondingCompletionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextUnbondingCompletionResponse.Merge(m, src)
+}
+func (m *QueryNextUnbondingCompletionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextUnbondingCompletionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextUnbondingCompletionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextUnbondingCompletionResponse proto.InternalMessageInfo
+
+func (m *QueryNextUnbondingCompletionResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryNextUnbondingCompletionResponse) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+// QuerySafePruneHeightRequest is the request type for the
+// Query/SafePruneHeight RPC method.
+type QuerySafePruneHeightRequest struct {
+}
+
+func (m *QuerySafePruneHeightRequest) Reset()         { *m = QuerySafePruneHeightRequest{} }
+func (m *QuerySafePruneHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySafePruneHeightRequest) ProtoMessage()    {}
+func (*QuerySafePruneHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{22}
+}
+func (m *QuerySafePruneHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySafePruneHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySafePruneHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySafePruneHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySafePruneHeightRequest.Merge(m, src)
+}
+func (m *QuerySafePruneHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySafePruneHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySafePruneHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySafePruneHeightRequest proto.InternalMessageInfo
+
+// QuerySafePruneHeightResponse is the response type for the
+// Query/SafePruneHeight RPC method.
+type QuerySafePruneHeightResponse struct {
+	// height is the lowest block height still needed by an active IBC client.
+	// It is the current block height when no active client has a consensus
+	// state.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// client_id is the client needing height, empty if there is none.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QuerySafePruneHeightResponse) Reset()         { *m = QuerySafePruneHeightResponse{} }
+func (m *QuerySafePruneHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySafePruneHeightResponse) ProtoMessage()    {}
+func (*QuerySafePruneHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{23}
+}
+func (m *QuerySafePruneHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySafePruneHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySafePruneHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySafePruneHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySafePruneHeightResponse.Merge(m, src)
+}
+func (m *QuerySafePruneHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySafePruneHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySafePruneHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySafePruneHeightResponse proto.InternalMessageInfo
+
+func (m *QuerySafePruneHeightResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QuerySafePruneHeightResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryExpiringClientsRequest is the request type for the
+// Query/ExpiringClients RPC method.
+type QueryExpiringClientsRequest struct {
+	// within is the buffer before the expiry of the clients to return.
+	Within time.Duration `protobuf:"bytes,1,opt,name=within,proto3,stdduration" json:"within"`
+}
+
+func (m *QueryExpiringClientsRequest) Reset()         { *m = QueryExpiringClientsRequest{} }
+func (m *QueryExpiringClientsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringClientsRequest) ProtoMessage()    {}
+func (*QueryExpiringClientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{24}
+}
+func (m *QueryExpiringClientsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringClientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringClientsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringClientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringClientsRequest.Merge(m, src)
+}
+func (m *QueryExpiringClientsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringClientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringClientsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringClientsRequest proto.InternalMessageInfo
+
+func (m *QueryExpiringClientsRequest) GetWithin() time.Duration {
+	if m != nil {
+		return m.Within
+	}
+	return 0
+}
+
+// QueryExpiringClientsResponse is the response type for the
+// Query/ExpiringClients RPC method.
+type QueryExpiringClientsResponse struct {
+	Clients []ExpiringClient `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients"`
+}
+
+func (m *QueryExpiringClientsResponse) Reset()         { *m = QueryExpiringClientsResponse{} }
+func (m *QueryExpiringClientsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringClientsResponse) ProtoMessage()    {}
+func (*QueryExpiringClientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{25}
+}
+func (m *QueryExpiringClientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringClientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringClientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringClientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringClientsResponse.Merge(m, src)
+}
+func (m *QueryExpiringClientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringClientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringClientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringClientsResponse proto.InternalMessageInfo
+
+func (m *QueryExpiringClientsResponse) GetClients() []ExpiringClient {
+	if m != nil {
+		return m.Clients
+	}
+	return nil
+}
+
+// ExpiringClient is an IBC client expired or expiring within the requested
+// buffer.
+type ExpiringClient struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// chain_id is the id of the chain tracked by the client.
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// latest_height is the latest height of the client, formatted as
+	// {revision number}-{revision height}.
+	LatestHeight string `protobuf:"bytes,3,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height,omitempty" yaml:"latest_height"`
+	// latest_timestamp is the timestamp of the consensus state at the latest
+	// height.
+	LatestTimestamp time.Time     `protobuf:"bytes,4,opt,name=latest_timestamp,json=latestTimestamp,proto3,stdtime" json:"latest_timestamp" yaml:"latest_timestamp"`
+	TrustingPeriod  time.Duration `protobuf:"bytes,5,opt,name=trusting_period,json=trustingPeriod,proto3,stdduration" json:"trusting_period" yaml:"trusting_period"`
+	// expiry_time is the time the client expires at unless updated.
+	ExpiryTime time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time" yaml:"expiry_time"`
+	// expired is true if the client is already expired.
+	Expired bool `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (m *ExpiringClient) Reset()         { *m = ExpiringClient{} }
+func (m *ExpiringClient) String() string { return proto.CompactTextString(m) }
+func (*ExpiringClient) ProtoMessage()    {}
+func (*ExpiringClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{26}
+}
+func (m *ExpiringClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiringClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiringClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiringClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiringClient.Merge(m, src)
+}
+func (m *ExpiringClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiringClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiringClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiringClient proto.InternalMessageInfo
+
+func (m *ExpiringClient) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ExpiringClient) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ExpiringClient) GetLatestHeight() string {
+	if m != nil {
+		return m.LatestHeight
+	}
+	return ""
+}
+
+func (m *ExpiringClient) GetLatestTimestamp() time.Time {
+	if m != nil {
+		return m.LatestTimestamp
+	}
+	return time.Time{}
+}
+
+func (m *ExpiringClient) GetTrustingPeriod() time.Duration {
+	if m != nil {
+		return m.TrustingPeriod
+	}
+	return 0
+}
+
+func (m *ExpiringClient) GetExpiryTime() time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return time.Time{}
+}
+
+func (m *ExpiringClient) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+// QueryNonVotersRequest is the request type for the Query/NonVoters RPC
+// method.
+type QueryNonVotersRequest struct {
+	// proposal_id is the id of the proposal to query for.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+}
+
+func (m *QueryNonVotersRequest) Reset()         { *m = QueryNonVotersRequest{} }
+func (m *QueryNonVotersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNonVotersRequest) ProtoMessage()    {}
+func (*QueryNonVotersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{27}
+}
+func (m *QueryNonVotersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNonVotersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNonVotersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNonVotersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNonVotersRequest.Merge(m, src)
+}
+func (m *QueryNonVotersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNonVotersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNonVotersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNonVotersRequest proto.InternalMessageInfo
+
+func (m *QueryNonVotersRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryNonVotersResponse is the response type for the Query/NonVoters RPC
+// method.
+type QueryNonVotersResponse struct {
+	// non_voters are the bonded validators which have not voted on the
+	// proposal, sorted by descending voting power.
+	NonVoters []NonVoter `protobuf:"bytes,1,rep,name=non_voters,json=nonVoters,proto3" json:"non_voters" yaml:"non_voters"`
+}
+
+func (m *QueryNonVotersResponse) Reset()         { *m = QueryNonVotersResponse{} }
+func (m *QueryNonVotersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNonVotersResponse) ProtoMessage()    {}
+func (*QueryNonVotersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{28}
+}
+func (m *QueryNonVotersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNonVotersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNonVotersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNonVotersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNonVotersResponse.Merge(m, src)
+}
+func (m *QueryNonVotersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNonVotersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNonVotersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNonVotersResponse proto.InternalMessageInfo
+
+func (m *QueryNonVotersResponse) GetNonVoters() []NonVoter {
+	if m != nil {
+		return m.NonVoters
+	}
+	return nil
+}
+
+// NonVoter is a bonded validator which has not voted on a proposal.
+type NonVoter struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	Moniker          string `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// voting_power is the consensus power of the validator.
+	VotingPower int64 `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty" yaml:"voting_power"`
+}
+
+func (m *NonVoter) Reset()         { *m = NonVoter{} }
+func (m *NonVoter) String() string { return proto.CompactTextString(m) }
+func (*NonVoter) ProtoMessage()    {}
+func (*NonVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{29}
+}
+func (m *NonVoter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NonVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NonVoter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NonVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonVoter.Merge(m, src)
+}
+func (m *NonVoter) XXX_Size() int {
+	return m.Size()
+}
+func (m *NonVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonVoter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonVoter proto.InternalMessageInfo
+
+func (m *NonVoter) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *NonVoter) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *NonVoter) GetVotingPower() int64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+// QueryRewardHistoryRequest is the request type for the Query/RewardHistory
+// RPC method.
+type QueryRewardHistoryRequest struct {
+	// delegator_address is the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// from_height is the first height of the range, inclusive.
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty" yaml:"from_height"`
+	// to_height is the last height of the range, inclusive. The latest height
+	// is used when it is zero.
+	ToHeight int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty" yaml:"to_height"`
+}
+
+func (m *QueryRewardHistoryRequest) Reset()         { *m = QueryRewardHistoryRequest{} }
+func (m *QueryRewardHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardHistoryRequest) ProtoMessage()    {}
+func (*QueryRewardHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{30}
+}
+func (m *QueryRewardHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardHistoryRequest.Merge(m, src)
+}
+func (m *QueryRewardHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRewardHistoryRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryRewardHistoryRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryRewardHistoryRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// QueryRewardHistoryResponse is the response type for the Query/RewardHistory
+// RPC method.
+type QueryRewardHistoryResponse struct {
+	// rewards are the rewards withdrawn in each block of the range where the
+	// delegator withdrew any, by ascending height.
+	Rewards []RewardDelta `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total is the sum of the rewards withdrawn over the range.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// from_height is the first height of the range.
+	FromHeight int64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty" yaml:"from_height"`
+	// to_height is the last height of the range.
+	ToHeight int64 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty" yaml:"to_height"`
+	// indexed_from_height is the first height indexed by this node. The
+	// withdrawals of the lower heights of the range are not known.
+	IndexedFromHeight int64 `protobuf:"varint,5,opt,name=indexed_from_height,json=indexedFromHeight,proto3" json:"indexed_from_height,omitempty" yaml:"indexed_from_height"`
+}
+
+func (m *QueryRewardHistoryResponse) Reset()         { *m = QueryRewardHistoryResponse{} }
+func (m *QueryRewardHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardHistoryResponse) ProtoMessage()    {}
+func (*QueryRewardHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{31}
+}
+func (m *QueryRewardHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardHistoryResponse.Merge(m, src)
+}
+func (m *QueryRewardHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRewardHistoryResponse) GetRewards() []RewardDelta {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QueryRewardHistoryResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *QueryRewardHistoryResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryRewardHistoryResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryRewardHistoryResponse) GetIndexedFromHeight() int64 {
+	if m != nil {
+		return m.IndexedFromHeight
+	}
+	return 0
+}
+
+// RewardDelta is the rewards withdrawn by a delegator in a block.
+type RewardDelta struct {
+	Height int64                                    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *RewardDelta) Reset()         { *m = RewardDelta{} }
+func (m *RewardDelta) String() string { return proto.CompactTextString(m) }
+func (*RewardDelta) ProtoMessage()    {}
+func (*RewardDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{32}
+}
+func (m *RewardDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardDelta.Merge(m, src)
+}
+func (m *RewardDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardDelta proto.InternalMessageInfo
+
+func (m *RewardDelta) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RewardDelta) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// QueryChannelIncentiveFeesRequest is the request type for the
+// Query/ChannelIncentiveFees RPC method.
+type QueryChannelIncentiveFeesRequest struct {
+	// channel_id is the channel to query for.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// port_id is the port of the channel, the transfer port when empty.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+}
+
+func (m *QueryChannelIncentiveFeesRequest) Reset()         { *m = QueryChannelIncentiveFeesRequest{} }
+func (m *QueryChannelIncentiveFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelIncentiveFeesRequest) ProtoMessage()    {}
+func (*QueryChannelIncentiveFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{33}
+}
+func (m *QueryChannelIncentiveFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelIncentiveFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelIncentiveFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelIncentiveFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelIncentiveFeesRequest.Merge(m, src)
+}
+func (m *QueryChannelIncentiveFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelIncentiveFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelIncentiveFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelIncentiveFeesRequest proto.InternalMessageInfo
+
+func (m *QueryChannelIncentiveFeesRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryChannelIncentiveFeesRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+// QueryChannelIncentiveFeesResponse is the response type for the
+// Query/ChannelIncentiveFees RPC method.
+type QueryChannelIncentiveFeesResponse struct {
+	// packets are the incentives of each packet awaiting relayers, by
+	// ascending sequence.
+	Packets []PacketIncentive `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	// escrowed is the total of the incentives escrowed for the packets.
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+}
+
+func (m *QueryChannelIncentiveFeesResponse) Reset()         { *m = QueryChannelIncentiveFeesResponse{} }
+func (m *QueryChannelIncentiveFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelIncentiveFeesResponse) ProtoMessage()    {}
+func (*QueryChannelIncentiveFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{34}
+}
+func (m *QueryChannelIncentiveFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelIncentiveFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelIncentiveFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelIncentiveFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelIncentiveFeesResponse.Merge(m, src)
+}
+func (m *QueryChannelIncentiveFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelIncentiveFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelIncentiveFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelIncentiveFeesResponse proto.InternalMessageInfo
+
+func (m *QueryChannelIncentiveFeesResponse) GetPackets() []PacketIncentive {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *QueryChannelIncentiveFeesResponse) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
+	}
+	return nil
+}
+
+// PacketIncentive is the sum of the incentives paid for a packet, by the
+// relaying step they reward.
+type PacketIncentive struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// recv_fee is paid to the relayer of the packet to the counterparty chain.
+	RecvFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=recv_fee,json=recvFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recv_fee" yaml:"recv_fee"`
+	// ack_fee is paid to the relayer of the acknowledgement back to this chain.
+	AckFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=ack_fee,json=ackFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ack_fee" yaml:"ack_fee"`
+	// timeout_fee is paid to the relayer of the timeout back to this chain.
+	TimeoutFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=timeout_fee,json=timeoutFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"timeout_fee" yaml:"timeout_fee"`
+	// escrowed is the sum of the three fees, escrowed until the packet is
+	// acknowledged or timed out.
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+	// payers is the number of fees paid for the packet.
+	Payers uint32 `protobuf:"varint,6,opt,name=payers,proto3" json:"payers,omitempty"`
+}
+
+func (m *PacketIncentive) Reset()         { *m = PacketIncentive{} }
+func (m *PacketIncentive) String() string { return proto.CompactTextString(m) }
+func (*PacketIncentive) ProtoMessage()    {}
+func (*PacketIncentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{35}
+}
+func (m *PacketIncentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketIncentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketIncentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketIncentive.Merge(m, src)
+}
+func (m *PacketIncentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketIncentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketIncentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketIncentive proto.InternalMessageInfo
+
+func (m *PacketIncentive) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketIncentive) GetRecvFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecvFee
+	}
+	return nil
+}
+
+func (m *PacketIncentive) GetAckFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AckFee
+	}
+	return nil
+}
+
+func (m *PacketIncentive) GetTimeoutFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TimeoutFee
+	}
+	return nil
+}
+
+func (m *PacketIncentive) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
+	}
+	return nil
+}
+
+func (m *PacketIncentive) GetPayers() uint32 {
+	if m != nil {
+		return m.Payers
+	}
+	return 0
+}
+
+// QueryIncentivizedChannelsRequest is the request type for the
+// Query/IncentivizedChannels RPC method.
+type QueryIncentivizedChannelsRequest struct {
+}
+
+func (m *QueryIncentivizedChannelsRequest) Reset()         { *m = QueryIncentivizedChannelsRequest{} }
+func (m *QueryIncentivizedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivizedChannelsRequest) ProtoMessage()    {}
+func (*QueryIncentivizedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{36}
+}
+func (m *QueryIncentivizedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivizedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivizedChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentivizedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivizedChannelsRequest.Merge(m, src)
+}
+func (m *QueryIncentivizedChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivizedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivizedChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentivizedChannelsRequest proto.InternalMessageInfo
+
+// QueryIncentivizedChannelsResponse is the response type for the
+// Query/IncentivizedChannels RPC method.
+type QueryIncentivizedChannelsResponse struct {
+	// channels are all the fee enabled channels, with or without incentivized
+	// packets.
+	Channels []ChannelIncentives `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
+}
+
+func (m *QueryIncentivizedChannelsResponse) Reset()         { *m = QueryIncentivizedChannelsResponse{} }
+func (m *QueryIncentivizedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivizedChannelsResponse) ProtoMessage()    {}
+func (*QueryIncentivizedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{37}
+}
+func (m *QueryIncentivizedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivizedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivizedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentivizedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivizedChannelsResponse.Merge(m, src)
+}
+func (m *QueryIncentivizedChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivizedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivizedChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentivizedChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryIncentivizedChannelsResponse) GetChannels() []ChannelIncentives {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// ChannelIncentives is the total of the incentives escrowed for the packets
+// of a fee enabled channel.
+type ChannelIncentives struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// packet_count is the number of packets awaiting relayers.
+	PacketCount uint64                                   `protobuf:"varint,3,opt,name=packet_count,json=packetCount,proto3" json:"packet_count,omitempty" yaml:"packet_count"`
+	Escrowed    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+}
+
+func (m *ChannelIncentives) Reset()         { *m = ChannelIncentives{} }
+func (m *ChannelIncentives) String() string { return proto.CompactTextString(m) }
+func (*ChannelIncentives) ProtoMessage()    {}
+func (*ChannelIncentives) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{38}
+}
+func (m *ChannelIncentives) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelIncentives) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelIncentives.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelIncentives) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelIncentives.Merge(m, src)
+}
+func (m *ChannelIncentives) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelIncentives) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelIncentives.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelIncentives proto.InternalMessageInfo
+
+func (m *ChannelIncentives) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelIncentives) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelIncentives) GetPacketCount() uint64 {
+	if m != nil {
+		return m.PacketCount
+	}
+	return 0
+}
+
+func (m *ChannelIncentives) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
+	}
+	return nil
+}
+
+// QueryBreakEvenRelayFeeRequest is the request type for the
+// Query/BreakEvenRelayFee RPC method.
+type QueryBreakEvenRelayFeeRequest struct {
+	// channel_id is the channel to query for.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// port_id is the port of the channel, the transfer port when empty.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// recv_gas is the gas of the tx relaying a packet, estimated when zero.
+	RecvGas uint64 `protobuf:"varint,3,opt,name=recv_gas,json=recvGas,proto3" json:"recv_gas,omitempty" yaml:"recv_gas"`
+	// ack_gas is the gas of the tx relaying an acknowledgement, estimated when
+	// zero.
+	AckGas uint64 `protobuf:"varint,4,opt,name=ack_gas,json=ackGas,proto3" json:"ack_gas,omitempty" yaml:"ack_gas"`
+}
+
+func (m *QueryBreakEvenRelayFeeRequest) Reset()         { *m = QueryBreakEvenRelayFeeRequest{} }
+func (m *QueryBreakEvenRelayFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBreakEvenRelayFeeRequest) ProtoMessage()    {}
+func (*QueryBreakEvenRelayFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{39}
+}
+func (m *QueryBreakEvenRelayFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBreakEvenRelayFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBreakEvenRelayFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBreakEvenRelayFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBreakEvenRelayFeeRequest.Merge(m, src)
+}
+func (m *QueryBreakEvenRelayFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBreakEvenRelayFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBreakEvenRelayFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBreakEvenRelayFeeRequest proto.InternalMessageInfo
+
+func (m *QueryBreakEvenRelayFeeRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryBreakEvenRelayFeeRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryBreakEvenRelayFeeRequest) GetRecvGas() uint64 {
+	if m != nil {
+		return m.RecvGas
+	}
+	return 0
+}
+
+func (m *QueryBreakEvenRelayFeeRequest) GetAckGas() uint64 {
+	if m != nil {
+		return m.AckGas
+	}
+	return 0
+}
+
+// QueryBreakEvenRelayFeeResponse is the response type for the
+// Query/BreakEvenRelayFee RPC method. The fees are listed in each denom of the
+// global fees, paying any one of them is enough.
+type QueryBreakEvenRelayFeeResponse struct {
+	// recv_gas is the gas of the tx relaying a packet.
+	RecvGas uint64 `protobuf:"varint,1,opt,name=recv_gas,json=recvGas,proto3" json:"recv_gas,omitempty" yaml:"recv_gas"`
+	// ack_gas is the gas of the tx relaying an acknowledgement.
+	AckGas uint64 `protobuf:"varint,2,opt,name=ack_gas,json=ackGas,proto3" json:"ack_gas,omitempty" yaml:"ack_gas"`
+	// recv_fee is the fee of the tx relaying a packet.
+	RecvFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=recv_fee,json=recvFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recv_fee" yaml:"recv_fee"`
+	// ack_fee is the fee of the tx relaying an acknowledgement.
+	AckFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=ack_fee,json=ackFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ack_fee" yaml:"ack_fee"`
+	// total is the fee of relaying both the packet and its acknowledgement, the
+	// lowest profitable incentive.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *QueryBreakEvenRelayFeeResponse) Reset()         { *m = QueryBreakEvenRelayFeeResponse{} }
+func (m *QueryBreakEvenRelayFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBreakEvenRelayFeeResponse) ProtoMessage()    {}
+func (*QueryBreakEvenRelayFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{40}
+}
+func (m *QueryBreakEvenRelayFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBreakEvenRelayFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBreakEvenRelayFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBreakEvenRelayFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBreakEvenRelayFeeResponse.Merge(m, src)
+}
+func (m *QueryBreakEvenRelayFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBreakEvenRelayFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBreakEvenRelayFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBreakEvenRelayFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBreakEvenRelayFeeResponse) GetRecvGas() uint64 {
+	if m != nil {
+		return m.RecvGas
+	}
+	return 0
+}
+
+func (m *QueryBreakEvenRelayFeeResponse) GetAckGas() uint64 {
+	if m != nil {
+		return m.AckGas
+	}
+	return 0
+}
+
+func (m *QueryBreakEvenRelayFeeResponse) GetRecvFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecvFee
+	}
+	return nil
+}
+
+func (m *QueryBreakEvenRelayFeeResponse) GetAckFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AckFee
+	}
+	return nil
+}
+
+func (m *QueryBreakEvenRelayFeeResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+// QueryValidatorRelayActivityRequest is the request type for the
+// Query/ValidatorRelayActivity RPC method.
+type QueryValidatorRelayActivityRequest struct {
+	// window is the number of latest blocks to query for, all the blocks kept
+	// by the index when zero.
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *QueryValidatorRelayActivityRequest) Reset()         { *m = QueryValidatorRelayActivityRequest{} }
+func (m *QueryValidatorRelayActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRelayActivityRequest) ProtoMessage()    {}
+func (*QueryValidatorRelayActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{41}
+}
+func (m *QueryValidatorRelayActivityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRelayActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRelayActivityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRelayActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRelayActivityRequest.Merge(m, src)
+}
+func (m *QueryValidatorRelayActivityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRelayActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRelayActivityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRelayActivityRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorRelayActivityRequest) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// QueryValidatorRelayActivityResponse is the response type for the
+// Query/ValidatorRelayActivity RPC method.
+type QueryValidatorRelayActivityResponse struct {
+	// activities are the packets relayed by each validator operator over the
+	// window, by descending number of relayed packets.
+	Activities []ValidatorRelayActivity `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities"`
+	// from_height is the first height of the window.
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty" yaml:"from_height"`
+	// to_height is the last height of the window.
+	ToHeight int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty" yaml:"to_height"`
+	// indexed_from_height is the first height indexed by this node. The relays
+	// of the lower heights of the window are not known.
+	IndexedFromHeight int64 `protobuf:"varint,4,opt,name=indexed_from_height,json=indexedFromHeight,proto3" json:"indexed_from_height,omitempty" yaml:"indexed_from_height"`
+}
+
+func (m *QueryValidatorRelayActivityResponse) Reset()         { *m = QueryValidatorRelayActivityResponse{} }
+func (m *QueryValidatorRelayActivityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRelayActivityResponse) ProtoMessage()    {}
+func (*QueryValidatorRelayActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{42}
+}
+func (m *QueryValidatorRelayActivityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRelayActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRelayActivityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRelayActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRelayActivityResponse.Merge(m, src)
+}
+func (m *QueryValidatorRelayActivityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRelayActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRelayActivityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRelayActivityResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorRelayActivityResponse) GetActivities() []ValidatorRelayActivity {
+	if m != nil {
+		return m.Activities
+	}
+	return nil
+}
+
+func (m *QueryValidatorRelayActivityResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryValidatorRelayActivityResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryValidatorRelayActivityResponse) GetIndexedFromHeight() int64 {
+	if m != nil {
+		return m.IndexedFromHeight
+	}
+	return 0
+}
+
+// ValidatorRelayActivity is the IBC packets relayed by a validator operator,
+// i.e. by the account of the operator address, counted by relaying step. The
+// relays of a packet already relayed by another relayer are not counted.
+type ValidatorRelayActivity struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// relayer_address is the account signing the relaying messages.
+	RelayerAddress   string `protobuf:"bytes,2,opt,name=relayer_address,json=relayerAddress,proto3" json:"relayer_address,omitempty" yaml:"relayer_address"`
+	RecvPackets      uint64 `protobuf:"varint,3,opt,name=recv_packets,json=recvPackets,proto3" json:"recv_packets,omitempty" yaml:"recv_packets"`
+	Acknowledgements uint64 `protobuf:"varint,4,opt,name=acknowledgements,proto3" json:"acknowledgements,omitempty"`
+	Timeouts         uint64 `protobuf:"varint,5,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+}
+
+func (m *ValidatorRelayActivity) Reset()         { *m = ValidatorRelayActivity{} }
+func (m *ValidatorRelayActivity) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRelayActivity) ProtoMessage()    {}
+func (*ValidatorRelayActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{43}
+}
+func (m *ValidatorRelayActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRelayActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRelayActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRelayActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRelayActivity.Merge(m, src)
+}
+func (m *ValidatorRelayActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRelayActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRelayActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRelayActivity proto.InternalMessageInfo
+
+func (m *ValidatorRelayActivity) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorRelayActivity) GetRelayerAddress() string {
+	if m != nil {
+		return m.RelayerAddress
+	}
+	return ""
+}
+
+func (m *ValidatorRelayActivity) GetRecvPackets() uint64 {
+	if m != nil {
+		return m.RecvPackets
+	}
+	return 0
+}
+
+func (m *ValidatorRelayActivity) GetAcknowledgements() uint64 {
+	if m != nil {
+		return m.Acknowledgements
+	}
+	return 0
+}
+
+func (m *ValidatorRelayActivity) GetTimeouts() uint64 {
+	if m != nil {
+		return m.Timeouts
+	}
+	return 0
+}
+
+// QueryDecentralizationMetricsRequest is the request type for the
+// Query/DecentralizationMetrics RPC method.
+type QueryDecentralizationMetricsRequest struct {
+}
+
+func (m *QueryDecentralizationMetricsRequest) Reset()         { *m = QueryDecentralizationMetricsRequest{} }
+func (m *QueryDecentralizationMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDecentralizationMetricsRequest) ProtoMessage()    {}
+func (*QueryDecentralizationMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{44}
+}
+func (m *QueryDecentralizationMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecentralizationMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecentralizationMetricsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDecentralizationMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecentralizationMetricsRequest.Merge(m, src)
+}
+func (m *QueryDecentralizationMetricsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecentralizationMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecentralizationMetricsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDecentralizationMetricsRequest proto.InternalMessageInfo
+
+// QueryDecentralizationMetricsResponse is the response type for the
+// Query/DecentralizationMetrics RPC method.
+type QueryDecentralizationMetricsResponse struct {
+	// nakamoto_coefficient is the minimum number of bonded validators holding
+	// more than 1/3 of the voting power, i.e. able to halt the chain.
+	NakamotoCoefficient uint32 `protobuf:"varint,1,opt,name=nakamoto_coefficient,json=nakamotoCoefficient,proto3" json:"nakamoto_coefficient,omitempty" yaml:"nakamoto_coefficient"`
+	// gini_coefficient is the Gini coefficient of the voting power of the
+	// bonded validators, from 0 for an equal distribution to 1 for a single
+	// validator holding all of it.
+	GiniCoefficient github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=gini_coefficient,json=giniCoefficient,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gini_coefficient" yaml:"gini_coefficient"`
+	// validator_count is the number of bonded validators.
+	ValidatorCount uint32 `protobuf:"varint,3,opt,name=validator_count,json=validatorCount,proto3" json:"validator_count,omitempty" yaml:"validator_count"`
+	// total_voting_power is the consensus power of the bonded validators.
+	TotalVotingPower int64 `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty" yaml:"total_voting_power"`
+}
+
+func (m *QueryDecentralizationMetricsResponse) Reset()         { *m = QueryDecentralizationMetricsResponse{} }
+func (m *QueryDecentralizationMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDecentralizationMetricsResponse) ProtoMessage()    {}
+func (*QueryDecentralizationMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{45}
+}
+func (m *QueryDecentralizationMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecentralizationMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecentralizationMetricsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDecentralizationMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecentralizationMetricsResponse.Merge(m, src)
+}
+func (m *QueryDecentralizationMetricsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecentralizationMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecentralizationMetricsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDecentralizationMetricsResponse proto.InternalMessageInfo
+
+func (m *QueryDecentralizationMetricsResponse) GetNakamotoCoefficient() uint32 {
+	if m != nil {
+		return m.NakamotoCoefficient
+	}
+	return 0
+}
+
+func (m *QueryDecentralizationMetricsResponse) GetValidatorCount() uint32 {
+	if m != nil {
+		return m.ValidatorCount
+	}
+	return 0
+}
+
+func (m *QueryDecentralizationMetricsResponse) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+// QueryProjectedDelegationRewardRequest is the request type for the
+// Query/ProjectedDelegationReward RPC method.
+type QueryProjectedDelegationRewardRequest struct {
+	// validator_address is the validator address to delegate to.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the amount of bond denom tokens to delegate, as an integer.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QueryProjectedDelegationRewardRequest) Reset()         { *m = QueryProjectedDelegationRewardRequest{} }
+func (m *QueryProjectedDelegationRewardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedDelegationRewardRequest) ProtoMessage()    {}
+func (*QueryProjectedDelegationRewardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{46}
+}
+func (m *QueryProjectedDelegationRewardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedDelegationRewardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedDelegationRewardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedDelegationRewardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedDelegationRewardRequest.Merge(m, src)
+}
+func (m *QueryProjectedDelegationRewardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedDelegationRewardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedDelegationRewardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedDelegationRewardRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedDelegationRewardRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueryProjectedDelegationRewardRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// QueryProjectedDelegationRewardResponse is the response type for the
+// Query/ProjectedDelegationReward RPC method.
+type QueryProjectedDelegationRewardResponse struct {
+	// annual_reward is the reward projected for the delegation over a year.
+	AnnualReward types1.DecCoin `protobuf:"bytes,1,opt,name=annual_reward,json=annualReward,proto3" json:"annual_reward" yaml:"annual_reward"`
+	// apr is the annual reward over the delegated amount.
+	Apr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=apr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"apr"`
+	// power_share is the share of the bonded tokens held by the validator
+	// after the delegation.
+	PowerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=power_share,json=powerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"power_share" yaml:"power_share"`
+	// commission_rate is the current commission rate of the validator.
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate" yaml:"commission_rate"`
+	// inflation is the current inflation rate of the mint module.
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// assumptions lists the assumptions the projection relies on.
+	Assumptions []string `protobuf:"bytes,6,rep,name=assumptions,proto3" json:"assumptions,omitempty"`
+}
+
+func (m *QueryProjectedDelegationRewardResponse) Reset() {
+	*m = QueryProjectedDelegationRewardResponse{}
+}
+func (m *QueryProjectedDelegationRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedDelegationRewardResponse) ProtoMessage()    {}
+func (*QueryProjectedDelegationRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{47}
+}
+func (m *QueryProjectedDelegationRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedDelegationRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedDelegationRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedDelegationRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedDelegationRewardResponse.Merge(m, src)
+}
+func (m *QueryProjectedDelegationRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedDelegationRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedDelegationRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedDelegationRewardResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedDelegationRewardResponse) GetAnnualReward() types1.DecCoin {
+	if m != nil {
+		return m.AnnualReward
+	}
+	return types1.DecCoin{}
+}
+
+func (m *QueryProjectedDelegationRewardResponse) GetAssumptions() []string {
+	if m != nil {
+		return m.Assumptions
+	}
+	return nil
+}
+
+// QueryDenomChannelHistoryRequest is the request type for the
+// Query/DenomChannelHistory RPC method.
+type QueryDenomChannelHistoryRequest struct {
+	// denom is the denom on this chain, either a native denom or an ibc/{hash}
+	// voucher denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomChannelHistoryRequest) Reset()         { *m = QueryDenomChannelHistoryRequest{} }
+func (m *QueryDenomChannelHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomChannelHistoryRequest) ProtoMessage()    {}
+func (*QueryDenomChannelHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{48}
+}
+func (m *QueryDenomChannelHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomChannelHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomChannelHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomChannelHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomChannelHistoryRequest.Merge(m, src)
+}
+func (m *QueryDenomChannelHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomChannelHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomChannelHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomChannelHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryDenomChannelHistoryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomChannelHistoryResponse is the response type for the
+// Query/DenomChannelHistory RPC method.
+type QueryDenomChannelHistoryResponse struct {
+	// base_denom is the denom on its source chain.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	// trace_channels are the channels the denom traversed to reach this chain,
+	// from the channel of this chain to the channel of the chain next to the
+	// source chain. It is empty for a native denom.
+	TraceChannels []DenomTraceChannel `protobuf:"bytes,2,rep,name=trace_channels,json=traceChannels,proto3" json:"trace_channels" yaml:"trace_channels"`
+	// transfer_channels are the channels of this chain the denom was sent or
+	// received over, sorted by port and channel.
+	TransferChannels []DenomChannelTransfers `protobuf:"bytes,3,rep,name=transfer_channels,json=transferChannels,proto3" json:"transfer_channels" yaml:"transfer_channels"`
+	// indexed_from_height is the first height indexed by this node. The
+	// transfers of the lower heights are not known.
+	IndexedFromHeight int64 `protobuf:"varint,4,opt,name=indexed_from_height,json=indexedFromHeight,proto3" json:"indexed_from_height,omitempty" yaml:"indexed_from_height"`
+}
+
+func (m *QueryDenomChannelHistoryResponse) Reset()         { *m = QueryDenomChannelHistoryResponse{} }
+func (m *QueryDenomChannelHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomChannelHistoryResponse) ProtoMessage()    {}
+func (*QueryDenomChannelHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{49}
+}
+func (m *QueryDenomChannelHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomChannelHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomChannelHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomChannelHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomChannelHistoryResponse.Merge(m, src)
+}
+func (m *QueryDenomChannelHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomChannelHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomChannelHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomChannelHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryDenomChannelHistoryResponse) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *QueryDenomChannelHistoryResponse) GetTraceChannels() []DenomTraceChannel {
+	if m != nil {
+		return m.TraceChannels
+	}
+	return nil
+}
+
+func (m *QueryDenomChannelHistoryResponse) GetTransferChannels() []DenomChannelTransfers {
+	if m != nil {
+		return m.TransferChannels
+	}
+	return nil
+}
+
+func (m *QueryDenomChannelHistoryResponse) GetIndexedFromHeight() int64 {
+	if m != nil {
+		return m.IndexedFromHeight
+	}
+	return 0
+}
+
+// DenomTraceChannel is a hop of the denom trace of a denom.
+type DenomTraceChannel struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *DenomTraceChannel) Reset()         { *m = DenomTraceChannel{} }
+func (m *DenomTraceChannel) String() string { return proto.CompactTextString(m) }
+func (*DenomTraceChannel) ProtoMessage()    {}
+func (*DenomTraceChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{50}
+}
+func (m *DenomTraceChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTraceChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTraceChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTraceChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTraceChannel.Merge(m, src)
+}
+func (m *DenomTraceChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTraceChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTraceChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTraceChannel proto.InternalMessageInfo
+
+func (m *DenomTraceChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *DenomTraceChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// DenomChannelTransfers is the transfer packets of a denom sent or received
+// over a channel of this chain, as indexed by this node. The packets are
+// counted when sent or received, whatever their acknowledgement.
+type DenomChannelTransfers struct {
+	PortId          string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId       string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	SentPackets     uint64 `protobuf:"varint,3,opt,name=sent_packets,json=sentPackets,proto3" json:"sent_packets,omitempty" yaml:"sent_packets"`
+	ReceivedPackets uint64 `protobuf:"varint,4,opt,name=received_packets,json=receivedPackets,proto3" json:"received_packets,omitempty" yaml:"received_packets"`
+	// last_height is the height of the last packet of the denom over the
+	// channel.
+	LastHeight int64 `protobuf:"varint,5,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty" yaml:"last_height"`
+}
+
+func (m *DenomChannelTransfers) Reset()         { *m = DenomChannelTransfers{} }
+func (m *DenomChannelTransfers) String() string { return proto.CompactTextString(m) }
+func (*DenomChannelTransfers) ProtoMessage()    {}
+func (*DenomChannelTransfers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{51}
+}
+func (m *DenomChannelTransfers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomChannelTransfers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomChannelTransfers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomChannelTransfers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomChannelTransfers.Merge(m, src)
+}
+func (m *DenomChannelTransfers) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomChannelTransfers) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomChannelTransfers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomChannelTransfers proto.InternalMessageInfo
+
+func (m *DenomChannelTransfers) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *DenomChannelTransfers) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *DenomChannelTransfers) GetSentPackets() uint64 {
+	if m != nil {
+		return m.SentPackets
+	}
+	return 0
+}
+
+func (m *DenomChannelTransfers) GetReceivedPackets() uint64 {
+	if m != nil {
+		return m.ReceivedPackets
+	}
+	return 0
+}
+
+func (m *DenomChannelTransfers) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+// QueryHoldersAboveRequest is the request type for the Query/HoldersAbove RPC
+// method.
+type QueryHoldersAboveRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// threshold is the minimum balance of the denom, as an integer.
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// pagination defines an optional pagination for the request, the key is the
+	// address to start from.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHoldersAboveRequest) Reset()         { *m = QueryHoldersAboveRequest{} }
+func (m *QueryHoldersAboveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHoldersAboveRequest) ProtoMessage()    {}
+func (*QueryHoldersAboveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{52}
+}
+func (m *QueryHoldersAboveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHoldersAboveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHoldersAboveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHoldersAboveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHoldersAboveRequest.Merge(m, src)
+}
+func (m *QueryHoldersAboveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHoldersAboveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHoldersAboveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHoldersAboveRequest proto.InternalMessageInfo
+
+func (m *QueryHoldersAboveRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryHoldersAboveRequest) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *QueryHoldersAboveRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryHoldersAboveResponse is the response type for the Query/HoldersAbove
+// RPC method.
+type QueryHoldersAboveResponse struct {
+	Holders    []DenomHolder       `protobuf:"bytes,1,rep,name=holders,proto3" json:"holders"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHoldersAboveResponse) Reset()         { *m = QueryHoldersAboveResponse{} }
+func (m *QueryHoldersAboveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHoldersAboveResponse) ProtoMessage()    {}
+func (*QueryHoldersAboveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{53}
+}
+func (m *QueryHoldersAboveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHoldersAboveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHoldersAboveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHoldersAboveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHoldersAboveResponse.Merge(m, src)
+}
+func (m *QueryHoldersAboveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHoldersAboveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHoldersAboveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHoldersAboveResponse proto.InternalMessageInfo
+
+func (m *QueryHoldersAboveResponse) GetHolders() []DenomHolder {
+	if m != nil {
+		return m.Holders
+	}
+	return nil
+}
+
+func (m *QueryHoldersAboveResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// DenomHolder is an account with its balance of a denom.
+type DenomHolder struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+}
+
+func (m *DenomHolder) Reset()         { *m = DenomHolder{} }
+func (m *DenomHolder) String() string { return proto.CompactTextString(m) }
+func (*DenomHolder) ProtoMessage()    {}
+func (*DenomHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{54}
+}
+func (m *DenomHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomHolder.Merge(m, src)
+}
+func (m *DenomHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomHolder proto.InternalMessageInfo
+
+func (m *DenomHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAddressFeesPaidRequest is the request type for the
+// Query/AddressFeesPaid RPC method.
+type QueryAddressFeesPaidRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAddressFeesPaidRequest) Reset()         { *m = QueryAddressFeesPaidRequest{} }
+func (m *QueryAddressFeesPaidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressFeesPaidRequest) ProtoMessage()    {}
+func (*QueryAddressFeesPaidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{55}
+}
+func (m *QueryAddressFeesPaidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressFeesPaidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressFeesPaidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressFeesPaidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressFeesPaidRequest.Merge(m, src)
+}
+func (m *QueryAddressFeesPaidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressFeesPaidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressFeesPaidRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressFeesPaidRequest proto.InternalMessageInfo
+
+func (m *QueryAddressFeesPaidRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAddressFeesPaidResponse is the response type for the
+// Query/AddressFeesPaid RPC method.
+type QueryAddressFeesPaidResponse struct {
+	// fees_paid is the sum of the fees paid by the address, including the fees
+	// of its txs whose msgs failed, as the fees are charged nonetheless.
+	FeesPaid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees_paid,json=feesPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_paid" yaml:"fees_paid"`
+	// txs is the number of txs the address paid the fees of, including the txs
+	// without fee.
+	Txs uint64 `protobuf:"varint,2,opt,name=txs,proto3" json:"txs,omitempty"`
+	// indexed_from_height is the first height indexed by this node. The fees
+	// paid at the lower heights are not known.
+	IndexedFromHeight int64 `protobuf:"varint,3,opt,name=indexed_from_height,json=indexedFromHeight,proto3" json:"indexed_from_height,omitempty" yaml:"indexed_from_height"`
+}
+
+func (m *QueryAddressFeesPaidResponse) Reset()         { *m = QueryAddressFeesPaidResponse{} }
+func (m *QueryAddressFeesPaidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressFeesPaidResponse) ProtoMessage()    {}
+func (*QueryAddressFeesPaidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{56}
+}
+func (m *QueryAddressFeesPaidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressFeesPaidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressFeesPaidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressFeesPaidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressFeesPaidResponse.Merge(m, src)
+}
+func (m *QueryAddressFeesPaidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressFeesPaidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressFeesPaidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressFeesPaidResponse proto.InternalMessageInfo
+
+func (m *QueryAddressFeesPaidResponse) GetFeesPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesPaid
+	}
+	return nil
+}
+
+func (m *QueryAddressFeesPaidResponse) GetTxs() uint64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func (m *QueryAddressFeesPaidResponse) GetIndexedFromHeight() int64 {
+	if m != nil {
+		return m.IndexedFromHeight
+	}
+	return 0
+}
+
+// QueryBlockProposerRequest is the request type for the Query/BlockProposer
+// RPC method.
+type QueryBlockProposerRequest struct {
+	// height is the height of the block, the current height when zero.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBlockProposerRequest) Reset()         { *m = QueryBlockProposerRequest{} }
+func (m *QueryBlockProposerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockProposerRequest) ProtoMessage()    {}
+func (*QueryBlockProposerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{57}
+}
+func (m *QueryBlockProposerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockProposerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockProposerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockProposerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockProposerRequest.Merge(m, src)
+}
+func (m *QueryBlockProposerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockProposerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockProposerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockProposerRequest proto.InternalMessageInfo
+
+func (m *QueryBlockProposerRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBlockProposerResponse is the response type for the Query/BlockProposer
+// RPC method.
+type QueryBlockProposerResponse struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// operator_address is the operator address of the proposer.
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	// consensus_address is the consensus address of the proposer, as found in
+	// the block header.
+	ConsensusAddress string `protobuf:"bytes,3,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty" yaml:"consensus_address"`
+}
+
+func (m *QueryBlockProposerResponse) Reset()         { *m = QueryBlockProposerResponse{} }
+func (m *QueryBlockProposerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockProposerResponse) ProtoMessage()    {}
+func (*QueryBlockProposerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{58}
+}
+func (m *QueryBlockProposerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockProposerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockProposerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockProposerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockProposerResponse.Merge(m, src)
+}
+func (m *QueryBlockProposerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockProposerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockProposerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockProposerResponse proto.InternalMessageInfo
+
+func (m *QueryBlockProposerResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryBlockProposerResponse) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *QueryBlockProposerResponse) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+// SimulateRequest is the request type for the Tx/Simulate RPC method.
+type SimulateRequest struct {
+	// tx_bytes is the raw tx to simulate, in the same encoding as for the SDK
+	// tx service.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty" yaml:"tx_bytes"`
+}
+
+func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
+func (m *SimulateRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateRequest) ProtoMessage()    {}
+func (*SimulateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{59}
+}
+func (m *SimulateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateRequest.Merge(m, src)
+}
+func (m *SimulateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateRequest proto.InternalMessageInfo
+
+func (m *SimulateRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+// SimulateResponse is the response type for the Tx/Simulate RPC method.
+type SimulateResponse struct {
+	// gas_info is the gas used by the simulated tx.
+	GasInfo *types1.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty" yaml:"gas_info"`
+	// result is the result of the simulated tx.
+	Result *types1.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// min_fee is the minimum fee of the tx for the gas it used, raised to the
+	// gas floors of its msgs, under the current globalfee params and the
+	// minimum gas prices of this node. The fee can be paid in any one of its
+	// denoms. It is empty when the tx can be sent without fee, e.g. when it
+	// only contains bypass msgs.
+	MinFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_fee,json=minFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fee" yaml:"min_fee"`
+}
+
+func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
+func (m *SimulateResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateResponse) ProtoMessage()    {}
+func (*SimulateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{60}
+}
+func (m *SimulateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateResponse.Merge(m, src)
+}
+func (m *SimulateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateResponse proto.InternalMessageInfo
+
+func (m *SimulateResponse) GetGasInfo() *types1.GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
+func (m *SimulateResponse) GetResult() *types1.Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *SimulateResponse) GetMinFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFee
+	}
+	return nil
+}
+
+// QueryAnteProfileRequest is the request type for the AnteProfile/Decorators
+// RPC method.
+type QueryAnteProfileRequest struct {
+}
+
+func (m *QueryAnteProfileRequest) Reset()         { *m = QueryAnteProfileRequest{} }
+func (m *QueryAnteProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAnteProfileRequest) ProtoMessage()    {}
+func (*QueryAnteProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{61}
+}
+func (m *QueryAnteProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnteProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnteProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnteProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnteProfileRequest.Merge(m, src)
+}
+func (m *QueryAnteProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnteProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnteProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnteProfileRequest proto.InternalMessageInfo
+
+// QueryAnteProfileResponse is the response type for the
+// AnteProfile/Decorators RPC method.
+type QueryAnteProfileResponse struct {
+	// decorators are the profiles of the ante decorators, in the order of the
+	// ante handler.
+	Decorators []DecoratorProfile `protobuf:"bytes,1,rep,name=decorators,proto3" json:"decorators"`
+	// retention is the maximum number of most recent txs averaged per
+	// decorator.
+	Retention uint64 `protobuf:"varint,2,opt,name=retention,proto3" json:"retention,omitempty"`
+}
+
+func (m *QueryAnteProfileResponse) Reset()         { *m = QueryAnteProfileResponse{} }
+func (m *QueryAnteProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAnteProfileResponse) ProtoMessage()    {}
+func (*QueryAnteProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{62}
+}
+func (m *QueryAnteProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnteProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnteProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnteProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnteProfileResponse.Merge(m, src)
+}
+func (m *QueryAnteProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnteProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnteProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnteProfileResponse proto.InternalMessageInfo
+
+func (m *QueryAnteProfileResponse) GetDecorators() []DecoratorProfile {
+	if m != nil {
+		return m.Decorators
+	}
+	return nil
+}
+
+func (m *QueryAnteProfileResponse) GetRetention() uint64 {
+	if m != nil {
+		return m.Retention
+	}
+	return 0
+}
+
+// DecoratorProfile is the average overhead of an ante decorator over the most
+// recent txs it handled. The overhead is measured from the call of the
+// decorator until it calls the next one or returns.
+type DecoratorProfile struct {
+	// decorator is the type name of the decorator, with its package path.
+	Decorator string `protobuf:"bytes,1,opt,name=decorator,proto3" json:"decorator,omitempty"`
+	// txs is the number of txs averaged.
+	Txs uint64 `protobuf:"varint,2,opt,name=txs,proto3" json:"txs,omitempty"`
+	// avg_duration is the average time spent in the decorator per tx.
+	AvgDuration time.Duration `protobuf:"bytes,3,opt,name=avg_duration,json=avgDuration,proto3,stdduration" json:"avg_duration" yaml:"avg_duration"`
+	// avg_gas is the average gas consumed by the decorator per tx.
+	AvgGas uint64 `protobuf:"varint,4,opt,name=avg_gas,json=avgGas,proto3" json:"avg_gas,omitempty" yaml:"avg_gas"`
+}
+
+func (m *DecoratorProfile) Reset()         { *m = DecoratorProfile{} }
+func (m *DecoratorProfile) String() string { return proto.CompactTextString(m) }
+func (*DecoratorProfile) ProtoMessage()    {}
+func (*DecoratorProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db2e5b4895cbe98d, []int{63}
+}
+func (m *DecoratorProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecoratorProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecoratorProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecoratorProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecoratorProfile.Merge(m, src)
+}
+func (m *DecoratorProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecoratorProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecoratorProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecoratorProfile proto.InternalMessageInfo
+
+func (m *DecoratorProfile) GetDecorator() string {
+	if m != nil {
+		return m.Decorator
+	}
+	return ""
+}
+
+func (m *DecoratorProfile) GetTxs() uint64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func (m *DecoratorProfile) GetAvgDuration() time.Duration {
+	if m != nil {
+		return m.AvgDuration
+	}
+	return 0
+}
+
+func (m *DecoratorProfile) GetAvgGas() uint64 {
+	if m != nil {
+		return m.AvgGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryAccountStakingScheduleRequest)(nil), "gaia.query.v1beta1.QueryAccountStakingScheduleRequest")
+	proto.RegisterType((*QueryAccountStakingScheduleResponse)(nil), "gaia.query.v1beta1.QueryAccountStakingScheduleResponse")
+	proto.RegisterType((*UnbondingScheduleEntry)(nil), "gaia.query.v1beta1.UnbondingScheduleEntry")
+	proto.RegisterType((*QueryAccountTotalPositionRequest)(nil), "gaia.query.v1beta1.QueryAccountTotalPositionRequest")
+	proto.RegisterType((*QueryAccountTotalPositionResponse)(nil), "gaia.query.v1beta1.QueryAccountTotalPositionResponse")
+	proto.RegisterType((*DenomPosition)(nil), "gaia.query.v1beta1.DenomPosition")
+	proto.RegisterType((*QueryProjectedCommunityPoolRequest)(nil), "gaia.query.v1beta1.QueryProjectedCommunityPoolRequest")
+	proto.RegisterType((*QueryProjectedCommunityPoolResponse)(nil), "gaia.query.v1beta1.QueryProjectedCommunityPoolResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "gaia.query.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "gaia.query.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsDiffFromDefaultsRequest)(nil), "gaia.query.v1beta1.QueryParamsDiffFromDefaultsRequest")
+	proto.RegisterType((*QueryParamsDiffFromDefaultsResponse)(nil), "gaia.query.v1beta1.QueryParamsDiffFromDefaultsResponse")
+	proto.RegisterType((*ParamDiff)(nil), "gaia.query.v1beta1.ParamDiff")
+	proto.RegisterType((*QueryAllGovernableParamsRequest)(nil), "gaia.query.v1beta1.QueryAllGovernableParamsRequest")
+	proto.RegisterType((*QueryAllGovernableParamsResponse)(nil), "gaia.query.v1beta1.QueryAllGovernableParamsResponse")
+	proto.RegisterType((*ModuleParams)(nil), "gaia.query.v1beta1.ModuleParams")
+	proto.RegisterType((*ParamValue)(nil), "gaia.query.v1beta1.ParamValue")
+	proto.RegisterType((*QueryPreviewParamsAfterProposalRequest)(nil), "gaia.query.v1beta1.QueryPreviewParamsAfterProposalRequest")
+	proto.RegisterType((*QueryPreviewParamsAfterProposalResponse)(nil), "gaia.query.v1beta1.QueryPreviewParamsAfterProposalResponse")
+	proto.RegisterType((*ParamChangePreview)(nil), "gaia.query.v1beta1.ParamChangePreview")
+	proto.RegisterType((*QueryNextUnbondingCompletionRequest)(nil), "gaia.query.v1beta1.QueryNextUnbondingCompletionRequest")
+	proto.RegisterType((*QueryNextUnbondingCompletionResponse)(nil), "gaia.query.v1beta1.QueryNextUnbondingCompletionResponse")
+	proto.RegisterType((*QuerySafePruneHeightRequest)(nil), "gaia.query.v1beta1.QuerySafePruneHeightRequest")
+	proto.RegisterType((*QuerySafePruneHeightResponse)(nil), "gaia.query.v1beta1.QuerySafePruneHeightResponse")
+	proto.RegisterType((*QueryExpiringClientsRequest)(nil), "gaia.query.v1beta1.QueryExpiringClientsRequest")
+	proto.RegisterType((*QueryExpiringClientsResponse)(nil), "gaia.query.v1beta1.QueryExpiringClientsResponse")
+	proto.RegisterType((*ExpiringClient)(nil), "gaia.query.v1beta1.ExpiringClient")
+	proto.RegisterType((*QueryNonVotersRequest)(nil), "gaia.query.v1beta1.QueryNonVotersRequest")
+	proto.RegisterType((*QueryNonVotersResponse)(nil), "gaia.query.v1beta1.QueryNonVotersResponse")
+	proto.RegisterType((*NonVoter)(nil), "gaia.query.v1beta1.NonVoter")
+	proto.RegisterType((*QueryRewardHistoryRequest)(nil), "gaia.query.v1beta1.QueryRewardHistoryRequest")
+	proto.RegisterType((*QueryRewardHistoryResponse)(nil), "gaia.query.v1beta1.QueryRewardHistoryResponse")
+	proto.RegisterType((*RewardDelta)(nil), "gaia.query.v1beta1.RewardDelta")
+	proto.RegisterType((*QueryChannelIncentiveFeesRequest)(nil), "gaia.query.v1beta1.QueryChannelIncentiveFeesRequest")
+	proto.RegisterType((*QueryChannelIncentiveFeesResponse)(nil), "gaia.query.v1beta1.QueryChannelIncentiveFeesResponse")
+	proto.RegisterType((*PacketIncentive)(nil), "gaia.query.v1beta1.PacketIncentive")
+	proto.RegisterType((*QueryIncentivizedChannelsRequest)(nil), "gaia.query.v1beta1.QueryIncentivizedChannelsRequest")
+	proto.RegisterType((*QueryIncentivizedChannelsResponse)(nil), "gaia.query.v1beta1.QueryIncentivizedChannelsResponse")
+	proto.RegisterType((*ChannelIncentives)(nil), "gaia.query.v1beta1.ChannelIncentives")
+	proto.RegisterType((*QueryBreakEvenRelayFeeRequest)(nil), "gaia.query.v1beta1.QueryBreakEvenRelayFeeRequest")
+	proto.RegisterType((*QueryBreakEvenRelayFeeResponse)(nil), "gaia.query.v1beta1.QueryBreakEvenRelayFeeResponse")
+	proto.RegisterType((*QueryValidatorRelayActivityRequest)(nil), "gaia.query.v1beta1.QueryValidatorRelayActivityRequest")
+	proto.RegisterType((*QueryValidatorRelayActivityResponse)(nil), "gaia.query.v1beta1.QueryValidatorRelayActivityResponse")
+	proto.RegisterType((*ValidatorRelayActivity)(nil), "gaia.query.v1beta1.ValidatorRelayActivity")
+	proto.RegisterType((*QueryDecentralizationMetricsRequest)(nil), "gaia.query.v1beta1.QueryDecentralizationMetricsRequest")
+	proto.RegisterType((*QueryDecentralizationMetricsResponse)(nil), "gaia.query.v1beta1.QueryDecentralizationMetricsResponse")
+	proto.RegisterType((*QueryProjectedDelegationRewardRequest)(nil), "gaia.query.v1beta1.QueryProjectedDelegationRewardRequest")
+	proto.RegisterType((*QueryProjectedDelegationRewardResponse)(nil), "gaia.query.v1beta1.QueryProjectedDelegationRewardResponse")
+	proto.RegisterType((*QueryDenomChannelHistoryRequest)(nil), "gaia.query.v1beta1.QueryDenomChannelHistoryRequest")
+	proto.RegisterType((*QueryDenomChannelHistoryResponse)(nil), "gaia.query.v1beta1.QueryDenomChannelHistoryResponse")
+	proto.RegisterType((*DenomTraceChannel)(nil), "gaia.query.v1beta1.DenomTraceChannel")
+	proto.RegisterType((*DenomChannelTransfers)(nil), "gaia.query.v1beta1.DenomChannelTransfers")
+	proto.RegisterType((*QueryHoldersAboveRequest)(nil), "gaia.query.v1beta1.QueryHoldersAboveRequest")
+	proto.RegisterType((*QueryHoldersAboveResponse)(nil), "gaia.query.v1beta1.QueryHoldersAboveResponse")
+	proto.RegisterType((*DenomHolder)(nil), "gaia.query.v1beta1.DenomHolder")
+	proto.RegisterType((*QueryAddressFeesPaidRequest)(nil), "gaia.query.v1beta1.QueryAddressFeesPaidRequest")
+	proto.RegisterType((*QueryAddressFeesPaidResponse)(nil), "gaia.query.v1beta1.QueryAddressFeesPaidResponse")
+	proto.RegisterType((*QueryBlockProposerRequest)(nil), "gaia.query.v1beta1.QueryBlockProposerRequest")
+	proto.RegisterType((*QueryBlockProposerResponse)(nil), "gaia.query.v1beta1.QueryBlockProposerResponse")
+	proto.RegisterType((*SimulateRequest)(nil), "gaia.query.v1beta1.SimulateRequest")
+	proto.RegisterType((*SimulateResponse)(nil), "gaia.query.v1beta1.SimulateResponse")
+	proto.RegisterType((*QueryAnteProfileRequest)(nil), "gaia.query.v1beta1.QueryAnteProfileRequest")
+	proto.RegisterType((*QueryAnteProfileResponse)(nil), "gaia.query.v1beta1.QueryAnteProfileResponse")
+	proto.RegisterType((*DecoratorProfile)(nil), "gaia.query.v1beta1.DecoratorProfile")
+}
+
+func init() { proto.RegisterFile("gaia/query/v1beta1/query.proto", fileDescriptor_db2e5b4895cbe98d) }
+
+var fileDescriptor_db2e5b4895cbe98d = []byte{
+	// 4440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3b, 0x4b, 0x6c, 0x1b, 0x49,
+	0x76, 0xd3, 0xa4, 0xac, 0xcf, 0xa3, 0x7e, 0x2e, 0x7b, 0x34, 0x34, 0x2d, 0x8b, 0x72, 0xf9, 0x3b,
+	0xf6, 0x58, 0xb4, 0x35, 0xf6, 0xca, 0xe3, 0x9d, 0x9d, 0x1d, 0x53, 0xb2, 0x6c, 0x65, 0x67, 0x0c,
+	0x4d, 0xdb, 0xf1, 0x61, 0x83, 0x80, 0x69, 0x35, 0x8b, 0x54, 0x8f, 0xc8, 0x6e, 0xba, 0xbb, 0x49,
+	0x49, 0xeb, 0x38, 0x87, 0xc1, 0xe6, 0x92, 0x00, 0xc9, 0x06, 0x8b, 0x7c, 0x80, 0x20, 0x87, 0x7c,
+	0x0f, 0x9b, 0x60, 0x03, 0x64, 0x0f, 0xd9, 0x9c, 0x12, 0x2c, 0x10, 0x64, 0x90, 0x45, 0x16, 0x9b,
+	0xec, 0x25, 0xc9, 0x41, 0x13, 0xcc, 0xe4, 0x94, 0xa3, 0x72, 0x4d, 0x82, 0xa0, 0xaa, 0x5e, 0xf5,
+	0x87, 0xea, 0xa6, 0x48, 0xad, 0xed, 0x3d, 0x49, 0x5d, 0xf5, 0xde, 0xab, 0x57, 0xaf, 0xde, 0xaf,
+	0x5e, 0x3d, 0xc2, 0x5c, 0xdd, 0xb0, 0x8c, 0xd2, 0xd3, 0x36, 0x73, 0x77, 0x4b, 0x9d, 0x1b, 0x1b,
+	0xcc, 0x37, 0x6e, 0xc8, 0xaf, 0x85, 0x96, 0xeb, 0xf8, 0x0e, 0x21, 0x7c, 0x7e, 0x41, 0x8e, 0xe0,
+	0x7c, 0xe1, 0x64, 0xdd, 0xa9, 0x3b, 0x62, 0xba, 0xc4, 0xff, 0x93, 0x90, 0x85, 0xd9, 0xba, 0xe3,
+	0xd4, 0x1b, 0xac, 0x64, 0xb4, 0xac, 0x92, 0x61, 0xdb, 0x8e, 0x6f, 0xf8, 0x96, 0x63, 0x7b, 0x38,
+	0x3b, 0x87, 0xb3, 0xe2, 0x6b, 0xa3, 0x5d, 0x2b, 0x55, 0xdb, 0xae, 0x00, 0xc0, 0xf9, 0x62, 0xf7,
+	0xbc, 0x6f, 0x35, 0x99, 0xe7, 0x1b, 0xcd, 0x16, 0x02, 0x9c, 0x33, 0x1d, 0xaf, 0xe9, 0x78, 0xa5,
+	0x0d, 0xc3, 0x63, 0x25, 0x63, 0xc3, 0xb4, 0x02, 0x76, 0xf9, 0x07, 0x02, 0x5d, 0x89, 0x02, 0xc5,
+	0x37, 0xd5, 0x32, 0xea, 0x96, 0x1d, 0x5d, 0x71, 0x2e, 0x0a, 0xab, 0xa0, 0x4c, 0xc7, 0x52, 0xf3,
+	0xe7, 0x71, 0xde, 0xf3, 0x8d, 0x2d, 0xcb, 0xae, 0x07, 0x20, 0xf8, 0x8d, 0x50, 0x97, 0x84, 0xfc,
+	0x8c, 0xb6, 0xef, 0x98, 0x4e, 0xb3, 0xe5, 0xb4, 0xed, 0x6a, 0x00, 0x58, 0x67, 0x36, 0xf3, 0x2c,
+	0x25, 0x80, 0xcb, 0x02, 0xb0, 0xea, 0x6c, 0xdb, 0x7c, 0x67, 0x75, 0xd7, 0x30, 0x59, 0x0a, 0xe4,
+	0x79, 0x01, 0x59, 0x6f, 0x38, 0x1b, 0x46, 0xa3, 0xc6, 0xd2, 0xa0, 0x2e, 0x48, 0x28, 0xd7, 0xb0,
+	0x7d, 0xaf, 0xe5, 0x38, 0x8d, 0x14, 0xb0, 0xb3, 0x02, 0xac, 0xe5, 0x34, 0x2c, 0x73, 0x37, 0x05,
+	0xe4, 0x4d, 0x01, 0xe2, 0x32, 0xb3, 0xed, 0xba, 0x96, 0x5d, 0xf7, 0x5a, 0x2c, 0x6d, 0x13, 0xf4,
+	0x3d, 0xa0, 0x1f, 0x71, 0xa9, 0xde, 0x35, 0x4d, 0xa7, 0x6d, 0xfb, 0x8f, 0xa4, 0x28, 0x1e, 0x99,
+	0x9b, 0xac, 0xda, 0x6e, 0x30, 0x9d, 0x3d, 0x6d, 0x33, 0xcf, 0x27, 0x79, 0x18, 0x31, 0xaa, 0x55,
+	0x97, 0x79, 0x5e, 0x5e, 0x9b, 0xd7, 0x2e, 0x8f, 0xe9, 0xea, 0x93, 0xfe, 0x50, 0x83, 0x73, 0x3d,
+	0x09, 0x78, 0x2d, 0xc7, 0xf6, 0x18, 0xd1, 0x21, 0x57, 0x65, 0x0d, 0x56, 0x97, 0x2a, 0x94, 0xd7,
+	0xe6, 0xb3, 0x97, 0x73, 0x8b, 0x57, 0x16, 0xe4, 0x89, 0x2c, 0xa8, 0x13, 0x40, 0x1e, 0x17, 0x56,
+	0x02, 0x50, 0x45, 0xa0, 0x3c, 0xf4, 0xe9, 0x5e, 0xf1, 0x35, 0x3d, 0x4a, 0x84, 0xac, 0x03, 0xb4,
+	0xed, 0x0d, 0xc7, 0xae, 0xf2, 0x3d, 0xe6, 0x33, 0x48, 0xf2, 0xa0, 0x7a, 0x2f, 0xfc, 0xbc, 0x82,
+	0x52, 0x6c, 0xdd, 0xb3, 0x7d, 0x77, 0x17, 0x49, 0x46, 0x68, 0xd0, 0x1f, 0x65, 0x61, 0x26, 0x19,
+	0x98, 0xac, 0xc1, 0xf1, 0x8e, 0xd1, 0xb0, 0xaa, 0x86, 0xef, 0xb8, 0x95, 0x98, 0x30, 0xca, 0xb3,
+	0xfb, 0x7b, 0xc5, 0xfc, 0xae, 0xd1, 0x6c, 0xdc, 0xa1, 0x07, 0x40, 0xa8, 0x3e, 0x1d, 0x8c, 0xdd,
+	0x95, 0x43, 0x64, 0x19, 0xa6, 0x4c, 0x97, 0x89, 0x4d, 0x54, 0x36, 0x99, 0x55, 0xdf, 0xf4, 0xf3,
+	0x99, 0x79, 0xed, 0x72, 0xb6, 0x5c, 0xd8, 0xdf, 0x2b, 0xce, 0x48, 0x42, 0x5d, 0x00, 0x54, 0x9f,
+	0x54, 0x23, 0x0f, 0xc4, 0x00, 0xa9, 0xc3, 0x14, 0xd7, 0xcf, 0x06, 0x13, 0x50, 0x5c, 0x03, 0xf3,
+	0xd9, 0x79, 0xed, 0x72, 0x6e, 0xb1, 0xb0, 0x20, 0x0d, 0x6f, 0x41, 0x19, 0xde, 0xc2, 0x63, 0x65,
+	0x78, 0x65, 0xca, 0x77, 0x1c, 0x59, 0x24, 0x4e, 0x80, 0x7e, 0xeb, 0xb3, 0xa2, 0xa6, 0x4f, 0x86,
+	0xa3, 0x1c, 0x91, 0x3c, 0x85, 0x29, 0xcb, 0xb6, 0x7c, 0xcb, 0x68, 0x54, 0x36, 0x8c, 0x86, 0x61,
+	0x9b, 0x2c, 0x3f, 0x24, 0xb6, 0xfd, 0x80, 0x13, 0xfb, 0xf7, 0xbd, 0xe2, 0xc5, 0xba, 0xe5, 0x6f,
+	0xb6, 0x37, 0x16, 0x4c, 0xa7, 0x59, 0x42, 0x0b, 0x93, 0x7f, 0xae, 0x79, 0xd5, 0xad, 0x92, 0xbf,
+	0xdb, 0x62, 0xde, 0xc2, 0x9a, 0xed, 0x87, 0xcb, 0x76, 0x91, 0xa3, 0xfa, 0x24, 0x8e, 0x94, 0xe5,
+	0x00, 0x79, 0x00, 0x23, 0x6a, 0xa9, 0x63, 0x62, 0xa9, 0x85, 0xc1, 0x96, 0xd2, 0x15, 0x3a, 0x7d,
+	0x17, 0xe6, 0xa3, 0xda, 0xf9, 0xd8, 0xf1, 0x8d, 0xc6, 0xba, 0xe3, 0x59, 0x52, 0xb5, 0x0e, 0x53,
+	0xee, 0x8f, 0xe1, 0x6c, 0x0f, 0x6c, 0xd4, 0xec, 0x7b, 0x30, 0xd6, 0xc2, 0x31, 0xa5, 0xd7, 0x67,
+	0x93, 0x94, 0x70, 0x85, 0xd9, 0x4e, 0x53, 0x61, 0xa3, 0xee, 0x85, 0x98, 0xf4, 0xdb, 0x59, 0x98,
+	0x88, 0x81, 0x90, 0x93, 0x70, 0xac, 0xca, 0x07, 0x90, 0x2b, 0xf9, 0x41, 0x56, 0x61, 0xb8, 0x61,
+	0x3d, 0x6d, 0x5b, 0xd5, 0x7c, 0xe6, 0x48, 0xa2, 0x41, 0x6c, 0x4e, 0x87, 0x5b, 0x1d, 0xab, 0xe6,
+	0xb3, 0x47, 0xa3, 0x23, 0xb1, 0xc9, 0x07, 0x30, 0x16, 0x18, 0x50, 0x7e, 0xe8, 0x48, 0xa4, 0x42,
+	0x02, 0xfc, 0xe4, 0x5d, 0xb6, 0x6d, 0xb8, 0x55, 0xef, 0x08, 0x27, 0xbf, 0xc2, 0x4c, 0x5d, 0xa1,
+	0x93, 0x15, 0x38, 0xe6, 0xf3, 0xf3, 0xca, 0x0f, 0x1f, 0x89, 0x8e, 0x44, 0xa6, 0xef, 0xa2, 0x7b,
+	0x5c, 0x77, 0x9d, 0x8f, 0x99, 0xe9, 0xb3, 0xea, 0xb2, 0xd3, 0x6c, 0xb6, 0x6d, 0xcb, 0xdf, 0x5d,
+	0x77, 0x9c, 0x86, 0xd2, 0xa0, 0x19, 0x18, 0xde, 0x68, 0x38, 0xe6, 0x96, 0x54, 0xa0, 0x21, 0x1d,
+	0xbf, 0xe8, 0x7f, 0x67, 0xe1, 0x5c, 0x4f, 0x74, 0x54, 0xa1, 0xdf, 0xd2, 0x60, 0xd2, 0x54, 0x33,
+	0x15, 0xee, 0xfb, 0x51, 0x91, 0x66, 0x95, 0x83, 0xe4, 0x21, 0x2d, 0xa2, 0x49, 0xe6, 0xb2, 0x63,
+	0xd9, 0xe5, 0x0f, 0xd0, 0x9a, 0x5f, 0x0f, 0xac, 0x39, 0x42, 0x81, 0x7e, 0xe7, 0xb3, 0xe2, 0xd5,
+	0xfe, 0x36, 0xcb, 0x89, 0x79, 0xfa, 0x84, 0x19, 0xe5, 0x8d, 0x7c, 0x57, 0x83, 0x7c, 0x4b, 0xb1,
+	0x5d, 0xe9, 0xe2, 0x2e, 0xd3, 0x07, 0x77, 0x4f, 0x90, 0xbb, 0xa2, 0xe4, 0x2e, 0x8d, 0xd6, 0xc0,
+	0x7c, 0xce, 0xb4, 0x12, 0x85, 0x49, 0x18, 0x4c, 0x87, 0x6b, 0x34, 0x2d, 0xdb, 0x47, 0xd5, 0xce,
+	0x2d, 0x9e, 0x4a, 0xe4, 0x53, 0x30, 0x59, 0x44, 0x26, 0xdf, 0xe8, 0x66, 0x52, 0x12, 0xa0, 0xfa,
+	0x54, 0x30, 0xf4, 0xa1, 0x18, 0x21, 0xf3, 0x90, 0x33, 0x3c, 0xaf, 0xdd, 0x6c, 0x49, 0x83, 0x1f,
+	0x9a, 0xcf, 0x5e, 0x1e, 0xd3, 0xa3, 0x43, 0xf4, 0x24, 0x10, 0x79, 0xe8, 0x86, 0x6b, 0x34, 0x3d,
+	0xd4, 0x11, 0xfa, 0xc3, 0x2c, 0x9c, 0x88, 0x0d, 0xe3, 0xd9, 0x97, 0x61, 0x2c, 0x48, 0x0c, 0x84,
+	0xfa, 0xe4, 0x16, 0xe7, 0xa4, 0xfb, 0x08, 0x86, 0x03, 0x96, 0x25, 0xaa, 0xf2, 0x1d, 0xc1, 0x3c,
+	0xf9, 0x08, 0x26, 0xe3, 0xc1, 0x5e, 0xf8, 0x86, 0xdc, 0xe2, 0x39, 0x49, 0x28, 0x3e, 0x97, 0x4c,
+	0xad, 0x8b, 0x00, 0x79, 0x08, 0x13, 0xb1, 0xcc, 0x06, 0x45, 0x49, 0x25, 0xc5, 0xd8, 0x54, 0x32,
+	0xc1, 0x38, 0x3a, 0xb9, 0x07, 0x10, 0x66, 0x36, 0xc2, 0x4f, 0xe4, 0x16, 0x8b, 0xb8, 0xcf, 0x60,
+	0x3c, 0x99, 0x52, 0x04, 0x91, 0x7c, 0x0d, 0xc6, 0xa3, 0x99, 0x99, 0x70, 0x12, 0x81, 0xbf, 0x8d,
+	0xce, 0x24, 0x93, 0x8a, 0x21, 0x93, 0x77, 0x60, 0x58, 0xa6, 0x51, 0xc2, 0x47, 0xe4, 0x16, 0x4f,
+	0x4b, 0x32, 0x72, 0x2c, 0x99, 0x00, 0x22, 0xd0, 0xf3, 0xca, 0x2f, 0x88, 0xc9, 0x15, 0xab, 0x56,
+	0x5b, 0x75, 0x9d, 0xe6, 0x0a, 0xab, 0x19, 0xed, 0x86, 0x1f, 0x9c, 0xf9, 0x2f, 0xc1, 0xb9, 0x9e,
+	0x50, 0xa8, 0x02, 0xef, 0xc0, 0xb1, 0xaa, 0x55, 0xab, 0xa9, 0xe8, 0x71, 0x26, 0x29, 0x7a, 0x08,
+	0x12, 0x9c, 0x02, 0x32, 0x22, 0x31, 0xe8, 0x6f, 0x68, 0x30, 0x16, 0x4c, 0x91, 0x02, 0x8c, 0x7a,
+	0xed, 0x0d, 0xaf, 0x65, 0x98, 0x52, 0x95, 0xc6, 0xf4, 0xe0, 0x9b, 0x4c, 0x43, 0x76, 0x8b, 0xed,
+	0xca, 0xa0, 0xa1, 0xf3, 0x7f, 0x79, 0x7c, 0xe9, 0x18, 0x8d, 0xb6, 0x3c, 0xda, 0x31, 0x5d, 0x7e,
+	0x90, 0xaf, 0xc0, 0x44, 0x55, 0x32, 0x58, 0x91, 0xb3, 0xd2, 0xa7, 0xe7, 0xf7, 0xf7, 0x8a, 0x27,
+	0xa5, 0x91, 0xc4, 0xa6, 0xa9, 0x3e, 0x8e, 0xdf, 0x4f, 0xc4, 0xe7, 0x59, 0x28, 0xca, 0x90, 0xd9,
+	0x68, 0xdc, 0x77, 0x3a, 0xcc, 0xb5, 0x8d, 0x8d, 0x06, 0x8b, 0x5b, 0x42, 0x15, 0xe6, 0xd3, 0x41,
+	0x50, 0x24, 0xef, 0xc3, 0x48, 0xd3, 0xe1, 0xc9, 0x97, 0x12, 0xca, 0x7c, 0x92, 0x50, 0x3e, 0x14,
+	0x20, 0xb1, 0x03, 0x52, 0x68, 0x74, 0x13, 0xc6, 0xa3, 0xd3, 0x3d, 0x65, 0xf3, 0x2e, 0x0c, 0xb7,
+	0x04, 0x14, 0x3a, 0xb6, 0xb9, 0xd4, 0x13, 0x10, 0x9b, 0x0c, 0x74, 0x41, 0xe0, 0xd0, 0x8f, 0x01,
+	0xc2, 0x39, 0x25, 0x67, 0x2d, 0x41, 0xce, 0x99, 0xa8, 0x9c, 0x6f, 0x02, 0x58, 0x5e, 0x05, 0x65,
+	0x27, 0x8e, 0x60, 0xb4, 0xfc, 0xfa, 0xfe, 0x5e, 0xf1, 0x38, 0xe6, 0x48, 0xc1, 0x1c, 0xd5, 0xc7,
+	0x2c, 0x0f, 0x75, 0x86, 0x1a, 0x70, 0x11, 0x03, 0x0a, 0xeb, 0x58, 0x6c, 0x5b, 0xee, 0xed, 0x6e,
+	0xcd, 0x67, 0xee, 0xba, 0xeb, 0xb4, 0x1c, 0xcf, 0x08, 0x62, 0xd2, 0x12, 0xe4, 0x5a, 0x38, 0x54,
+	0xb1, 0xaa, 0x32, 0x30, 0x95, 0x67, 0xf6, 0xf7, 0x8a, 0x24, 0x70, 0x75, 0x6a, 0x92, 0xea, 0xa0,
+	0xbe, 0xd6, 0xaa, 0xf4, 0xfb, 0x1a, 0x5c, 0x3a, 0x74, 0x8d, 0x20, 0xf7, 0x51, 0x82, 0x93, 0x9e,
+	0xeb, 0x52, 0x92, 0xe0, 0x12, 0xbc, 0x5e, 0x5c, 0x82, 0x64, 0x15, 0x46, 0xcc, 0x4d, 0xc3, 0xae,
+	0x33, 0x75, 0x00, 0x17, 0x53, 0x0f, 0x60, 0x59, 0xc0, 0x21, 0x6b, 0xea, 0xcc, 0x11, 0x99, 0xfe,
+	0xba, 0x06, 0xe4, 0x20, 0xd4, 0x0b, 0x31, 0x8b, 0x1b, 0x30, 0x66, 0xb3, 0xed, 0x98, 0x49, 0x9c,
+	0xdc, 0xdf, 0x2b, 0x4e, 0x4b, 0x61, 0x06, 0x53, 0x54, 0x1f, 0xb5, 0xd9, 0xb6, 0x34, 0x05, 0x1d,
+	0xad, 0xff, 0x21, 0xdb, 0xf1, 0x83, 0x4b, 0xc5, 0x72, 0x90, 0x5c, 0xab, 0x83, 0xba, 0x9a, 0x7a,
+	0xb1, 0x38, 0x78, 0x75, 0xa0, 0x9f, 0x6a, 0x70, 0xbe, 0x37, 0x51, 0x3c, 0x99, 0x84, 0xeb, 0x81,
+	0xf6, 0x52, 0xae, 0x07, 0x4b, 0x30, 0x6c, 0x34, 0x79, 0x76, 0x9c, 0xcf, 0x1c, 0x16, 0x6c, 0xf1,
+	0xd0, 0x25, 0x38, 0x3d, 0x03, 0xa7, 0xc5, 0x4e, 0x1e, 0x19, 0x35, 0xb6, 0xee, 0xb6, 0x6d, 0x26,
+	0x2f, 0x36, 0xca, 0x4b, 0x3c, 0x82, 0xd9, 0xe4, 0x69, 0xdc, 0xe0, 0x0c, 0x0c, 0xe3, 0xdd, 0x89,
+	0xef, 0x2b, 0xab, 0xe3, 0x17, 0x39, 0x0d, 0x63, 0x66, 0xc3, 0x62, 0xb6, 0x5f, 0x51, 0x29, 0xb2,
+	0x3e, 0x2a, 0x07, 0xd6, 0xaa, 0xf4, 0xeb, 0xb8, 0xe6, 0xbd, 0x9d, 0x96, 0xc5, 0x63, 0xdd, 0xb2,
+	0x98, 0x50, 0x9e, 0x89, 0x7c, 0x19, 0x86, 0xb7, 0x2d, 0x7f, 0xd3, 0xb2, 0x51, 0x56, 0xa7, 0x0e,
+	0xc8, 0x6a, 0x05, 0x6b, 0x1c, 0xe5, 0x51, 0xbe, 0x97, 0xdf, 0xe3, 0x02, 0x41, 0x14, 0xba, 0x01,
+	0xb3, 0xc9, 0xb4, 0x83, 0x40, 0x3f, 0x22, 0xf9, 0x50, 0x2e, 0x8d, 0x26, 0x29, 0x79, 0x1c, 0x3b,
+	0x50, 0x70, 0x89, 0x48, 0xff, 0x37, 0x0b, 0x93, 0x71, 0x08, 0xae, 0x98, 0xe1, 0x7e, 0xb5, 0x6e,
+	0xc5, 0x0c, 0xa6, 0x68, 0x28, 0x05, 0xb2, 0x00, 0xa3, 0xe6, 0xa6, 0x61, 0xd9, 0x81, 0x84, 0xca,
+	0x27, 0xf6, 0xf7, 0x8a, 0x53, 0x88, 0x81, 0x33, 0x54, 0x98, 0x95, 0x65, 0xaf, 0x55, 0x79, 0x48,
+	0x68, 0x18, 0x3e, 0xf3, 0x7c, 0x75, 0x5b, 0xcd, 0x76, 0x87, 0x84, 0xd8, 0x34, 0xd5, 0xc7, 0xe5,
+	0x37, 0xde, 0x54, 0x3f, 0x86, 0x69, 0x9c, 0x0f, 0x2a, 0x40, 0xf9, 0xa1, 0x43, 0x75, 0xf1, 0x5c,
+	0x3c, 0x33, 0xeb, 0xa6, 0x20, 0x95, 0x71, 0x4a, 0x0e, 0x07, 0x58, 0xa4, 0x06, 0x53, 0xbe, 0xdb,
+	0xf6, 0x7c, 0xcb, 0xae, 0x57, 0x5a, 0xcc, 0xb5, 0x1c, 0x95, 0x22, 0xf4, 0x38, 0xca, 0x2e, 0xad,
+	0xef, 0xc2, 0xa7, 0xe2, 0x90, 0x27, 0xd5, 0xe8, 0xba, 0x18, 0x24, 0xbf, 0x00, 0x39, 0xc6, 0xcf,
+	0x61, 0x57, 0x9a, 0xd6, 0xf0, 0xa1, 0xdb, 0x99, 0xc3, 0x45, 0xd0, 0xfb, 0x46, 0x90, 0xe5, 0x4e,
+	0x40, 0x8e, 0x08, 0x93, 0xca, 0xc3, 0x88, 0xf8, 0x62, 0xd5, 0xfc, 0x08, 0x8f, 0x0b, 0xba, 0xfa,
+	0xa4, 0xeb, 0xf0, 0xba, 0xb4, 0x7e, 0xc7, 0x7e, 0xe2, 0xf8, 0xcc, 0xf5, 0x7e, 0x6a, 0x6f, 0xdf,
+	0x82, 0x99, 0x6e, 0x8a, 0xa8, 0xaf, 0x4f, 0x00, 0x6c, 0xc7, 0xae, 0x74, 0xc4, 0x68, 0x70, 0x1f,
+	0x49, 0x50, 0x59, 0x85, 0x5a, 0x3e, 0x85, 0x7b, 0xc4, 0x10, 0x16, 0x62, 0x53, 0x7d, 0xcc, 0x56,
+	0xf4, 0xe9, 0x9f, 0x6b, 0x30, 0xaa, 0x50, 0x5e, 0x64, 0x55, 0x25, 0xcf, 0x53, 0x06, 0xdb, 0xda,
+	0x62, 0x2e, 0x9a, 0xbd, 0xfa, 0x24, 0x77, 0x60, 0xbc, 0xe3, 0xc8, 0x23, 0x75, 0xb6, 0x99, 0x2b,
+	0xd4, 0x37, 0x5b, 0x7e, 0x63, 0x7f, 0xaf, 0x78, 0x02, 0xe9, 0x47, 0x66, 0xa9, 0x9e, 0x93, 0x9f,
+	0xeb, 0xe2, 0xeb, 0x5f, 0x34, 0x38, 0x25, 0x04, 0xa4, 0x8b, 0x7b, 0xe5, 0x03, 0xcb, 0xf3, 0x1d,
+	0x77, 0x57, 0x89, 0x7d, 0x0d, 0x8e, 0x63, 0x41, 0xaa, 0x17, 0xfb, 0x07, 0x40, 0xa8, 0x3e, 0x1d,
+	0x8c, 0x29, 0xf6, 0x97, 0x20, 0x57, 0x73, 0x9d, 0x66, 0xbc, 0x20, 0x14, 0x39, 0xc1, 0xc8, 0x24,
+	0xd5, 0x81, 0x7f, 0xa1, 0x79, 0xdd, 0x80, 0x31, 0xdf, 0x89, 0x5a, 0x66, 0x36, 0xea, 0x00, 0x82,
+	0x29, 0xaa, 0x8f, 0xfa, 0x8e, 0x44, 0xa1, 0xff, 0x93, 0x81, 0x42, 0xd2, 0xa6, 0xf0, 0xe4, 0xbf,
+	0x1a, 0x5e, 0xc2, 0xe5, 0xb1, 0x17, 0x93, 0x8e, 0x5d, 0xe2, 0xae, 0xb0, 0x86, 0x6f, 0x28, 0x37,
+	0x85, 0x58, 0xc4, 0x50, 0x77, 0x6f, 0x19, 0xcd, 0x7b, 0x84, 0x84, 0xeb, 0x1c, 0xf1, 0x3b, 0x9f,
+	0x15, 0x2f, 0xf7, 0x71, 0x03, 0x94, 0xd7, 0x3f, 0x49, 0xb9, 0x5b, 0x5c, 0xd9, 0xa3, 0x89, 0x6b,
+	0xa8, 0x1f, 0x71, 0x91, 0x87, 0x70, 0xc2, 0xb2, 0xab, 0x6c, 0x87, 0x55, 0x2b, 0xd1, 0x35, 0x8f,
+	0x09, 0xe4, 0xb9, 0xfd, 0xbd, 0x62, 0x41, 0xd5, 0xb5, 0x0e, 0x00, 0x51, 0xfd, 0x38, 0x8e, 0xae,
+	0x06, 0x2c, 0xd0, 0x5f, 0xd3, 0x20, 0x17, 0x91, 0x5e, 0x6a, 0x28, 0x33, 0x23, 0xa1, 0xf5, 0x85,
+	0xcb, 0x51, 0x85, 0xe1, 0x5f, 0xd5, 0x30, 0x1d, 0xe7, 0x39, 0x93, 0xcd, 0x1a, 0x6b, 0xb6, 0xc9,
+	0x6c, 0xdf, 0xea, 0xb0, 0x55, 0xc6, 0x02, 0xf7, 0x72, 0x13, 0xc0, 0x94, 0xd3, 0x61, 0x94, 0x89,
+	0x24, 0xab, 0xe1, 0x1c, 0xd5, 0xc7, 0xf0, 0x63, 0xad, 0x4a, 0xae, 0xc2, 0x48, 0xcb, 0x71, 0xc3,
+	0x40, 0x5c, 0x26, 0xfb, 0x7b, 0xc5, 0x49, 0x74, 0x48, 0x72, 0x82, 0xf2, 0x1b, 0x95, 0xcb, 0x43,
+	0xf3, 0x3f, 0x6b, 0x70, 0xb6, 0x07, 0x1f, 0xa8, 0x9a, 0xcb, 0x30, 0xd2, 0x32, 0xcc, 0x2d, 0x16,
+	0x04, 0xd1, 0x73, 0xc9, 0x99, 0x22, 0x07, 0x09, 0x28, 0x28, 0xf5, 0x44, 0x4c, 0x52, 0x87, 0x51,
+	0xe6, 0x99, 0xae, 0xb3, 0xcd, 0xaa, 0x2f, 0x43, 0xb2, 0x01, 0x71, 0xfa, 0x67, 0x43, 0x30, 0xd5,
+	0xc5, 0x8b, 0x48, 0x46, 0xb9, 0x54, 0x6d, 0x4c, 0x46, 0x87, 0xf4, 0xe0, 0x9b, 0xec, 0xc2, 0xa8,
+	0xcb, 0xcc, 0x4e, 0x85, 0x97, 0x02, 0x0e, 0x65, 0x6c, 0x19, 0xbd, 0x2d, 0xc6, 0x6d, 0x85, 0x48,
+	0x07, 0xe2, 0x75, 0x84, 0xa3, 0xad, 0x32, 0x46, 0x3a, 0x30, 0x62, 0x98, 0x5b, 0x62, 0xe5, 0xec,
+	0x61, 0x2b, 0x97, 0x71, 0x65, 0x3c, 0x4a, 0xc4, 0xa3, 0x03, 0xaa, 0x9f, 0xb9, 0xc5, 0xd7, 0xfd,
+	0x44, 0x83, 0x1c, 0x8f, 0x82, 0x4e, 0xdb, 0x17, 0x8b, 0x0f, 0x1d, 0xb6, 0xf8, 0x6a, 0x3c, 0x90,
+	0x46, 0x70, 0x07, 0x63, 0x00, 0x10, 0x93, 0x33, 0x11, 0x55, 0x88, 0x63, 0x2f, 0x51, 0x21, 0xb8,
+	0xa5, 0xb7, 0x8c, 0x5d, 0x1e, 0x4f, 0x79, 0xc6, 0x30, 0xa1, 0xe3, 0x17, 0xa5, 0x68, 0x83, 0x4a,
+	0x4d, 0xac, 0x6f, 0xb0, 0x2a, 0xda, 0x41, 0x70, 0x6d, 0x6e, 0xc0, 0xd9, 0x1e, 0x30, 0x68, 0x1f,
+	0xf7, 0x45, 0x6a, 0x27, 0xc6, 0xd0, 0x40, 0x2e, 0x24, 0x19, 0x48, 0xb7, 0x8d, 0xa9, 0xdb, 0x73,
+	0x80, 0x4c, 0x7f, 0x3f, 0x03, 0xc7, 0x0f, 0x40, 0x45, 0x2d, 0x5a, 0x3b, 0xcc, 0xa2, 0xbb, 0x9c,
+	0x46, 0xa6, 0x4f, 0xa7, 0x71, 0x07, 0xc6, 0xa5, 0x9d, 0x56, 0x44, 0xcd, 0x5d, 0x78, 0xf6, 0xa1,
+	0x68, 0xb0, 0x8e, 0xce, 0x52, 0x3d, 0x27, 0x3f, 0x97, 0xf9, 0x57, 0xec, 0x1c, 0x87, 0x5e, 0xa6,
+	0x61, 0x7f, 0xa6, 0xc1, 0x19, 0x71, 0x18, 0x65, 0x97, 0x19, 0x5b, 0xf7, 0x3a, 0xcc, 0xd6, 0x59,
+	0xc3, 0xd8, 0x5d, 0x65, 0xec, 0xd5, 0x79, 0x4c, 0x9e, 0xc6, 0x0b, 0xa3, 0xaf, 0x1b, 0x1e, 0x4a,
+	0xe9, 0x44, 0x97, 0x3b, 0xa8, 0x1b, 0x1e, 0x95, 0x26, 0x7e, 0xdf, 0x10, 0x87, 0xc7, 0x4d, 0x95,
+	0x83, 0x0f, 0x09, 0x70, 0x12, 0xb7, 0x61, 0x01, 0xcd, 0xed, 0xf2, 0xbe, 0xe1, 0xd1, 0x9f, 0x64,
+	0x61, 0x2e, 0x6d, 0x87, 0xa8, 0x6b, 0xd1, 0xf5, 0xb5, 0xc1, 0xd6, 0xcf, 0x1c, 0xb6, 0x7e, 0xcc,
+	0x15, 0x66, 0x7f, 0x66, 0xae, 0x70, 0xe8, 0x55, 0xba, 0xc2, 0x20, 0x6b, 0x3a, 0xf6, 0xb2, 0xb2,
+	0xa6, 0xe0, 0x39, 0xe3, 0x89, 0x4a, 0x9e, 0xc5, 0xa1, 0xde, 0x35, 0xb9, 0x3b, 0xf1, 0x77, 0x23,
+	0xcf, 0x19, 0xdb, 0x96, 0x5d, 0x75, 0xb6, 0x55, 0x3e, 0x22, 0xbf, 0xe8, 0xf7, 0x32, 0x70, 0xae,
+	0x27, 0x3a, 0x2a, 0xc6, 0x3a, 0x80, 0x21, 0xc7, 0x2c, 0x16, 0x3e, 0xf5, 0x26, 0xb8, 0xa1, 0x64,
+	0x3a, 0xaa, 0xec, 0x1b, 0xd2, 0x78, 0x95, 0xc9, 0x71, 0x5a, 0xb6, 0x37, 0x74, 0xd4, 0x6c, 0xef,
+	0x2f, 0x32, 0x30, 0x93, 0xbc, 0xd1, 0x17, 0xfc, 0xa6, 0xec, 0x72, 0xda, 0x2c, 0x24, 0x24, 0x3d,
+	0x48, 0xe4, 0x4d, 0xb9, 0x0b, 0x80, 0xea, 0x93, 0x38, 0xa2, 0x88, 0xdc, 0x81, 0x71, 0x61, 0x3b,
+	0x2a, 0xc5, 0x3a, 0xe0, 0x7b, 0xa3, 0xb3, 0x54, 0xcf, 0xf1, 0x4f, 0x99, 0xdf, 0x78, 0xe4, 0x0a,
+	0x4c, 0x1b, 0xe6, 0x96, 0xed, 0x6c, 0x37, 0x58, 0xb5, 0xce, 0x9a, 0xa2, 0xce, 0x21, 0xdc, 0x8c,
+	0x7e, 0x60, 0x9c, 0xe7, 0x40, 0x18, 0x7d, 0xe5, 0x33, 0xdf, 0x90, 0x1e, 0x7c, 0xd3, 0x0b, 0xa8,
+	0x63, 0x2b, 0x8c, 0x47, 0x1d, 0xd7, 0x68, 0x58, 0xdf, 0x10, 0xd7, 0xf4, 0x0f, 0x99, 0xef, 0x5a,
+	0x66, 0x10, 0x0d, 0x3f, 0xc9, 0xc2, 0xf9, 0xde, 0x70, 0x41, 0xe3, 0xc1, 0x49, 0xdb, 0xd8, 0x32,
+	0x9a, 0x8e, 0xef, 0x54, 0x4c, 0x87, 0xd5, 0x6a, 0x96, 0x69, 0x31, 0x5b, 0xa6, 0xda, 0x13, 0xe5,
+	0xe2, 0xfe, 0x5e, 0xf1, 0x34, 0x5e, 0x57, 0x13, 0xa0, 0xa8, 0x7e, 0x42, 0x0d, 0x2f, 0x87, 0xa3,
+	0xc4, 0x87, 0xe9, 0xba, 0x65, 0x5b, 0x31, 0x7a, 0x52, 0xda, 0x6b, 0x83, 0x3d, 0x33, 0x86, 0xf5,
+	0x8d, 0x6e, 0x7a, 0x54, 0x9f, 0xe2, 0x43, 0xd1, 0x55, 0x97, 0x61, 0x2a, 0x54, 0x85, 0x30, 0x38,
+	0x4e, 0x44, 0x8f, 0xb8, 0x0b, 0x80, 0xea, 0x93, 0xc1, 0x88, 0x0c, 0x91, 0x5f, 0x03, 0x22, 0x5c,
+	0x41, 0x25, 0x76, 0x23, 0x96, 0xca, 0x7d, 0x66, 0x7f, 0xaf, 0x78, 0x4a, 0x59, 0x46, 0x37, 0x0c,
+	0xd5, 0xa7, 0xc5, 0xe0, 0x93, 0xc8, 0xe5, 0xb8, 0x01, 0x17, 0xe2, 0xcf, 0x9b, 0xd1, 0xbe, 0x0d,
+	0x7e, 0xbf, 0x39, 0x4a, 0x8d, 0x93, 0xbb, 0x9f, 0x48, 0x45, 0x71, 0x2c, 0xb8, 0xa9, 0xfc, 0xf6,
+	0x10, 0x5c, 0x3c, 0x6c, 0x39, 0x3c, 0xf4, 0x0a, 0x4c, 0x18, 0xb6, 0xdd, 0x36, 0x1a, 0x15, 0x79,
+	0x25, 0xc5, 0x7a, 0x5e, 0xef, 0x07, 0xcb, 0x59, 0xf4, 0xe5, 0x58, 0xd3, 0x8a, 0x11, 0xa0, 0xfa,
+	0xb8, 0xfc, 0x96, 0x0b, 0x91, 0xf7, 0x21, 0x6b, 0xb4, 0xdc, 0x7c, 0xe6, 0x48, 0x6f, 0xcb, 0x1c,
+	0x95, 0x30, 0xc8, 0x09, 0xb9, 0x56, 0xbc, 0x4d, 0xc3, 0xc5, 0x62, 0x73, 0x79, 0x65, 0x60, 0xf5,
+	0x51, 0xf5, 0x9d, 0x90, 0x14, 0xaf, 0xef, 0xf0, 0xaf, 0x47, 0xfc, 0x83, 0x77, 0x6f, 0xf0, 0xf7,
+	0x56, 0xcb, 0xf3, 0x78, 0x19, 0xd7, 0x35, 0xfc, 0xa3, 0x74, 0x6f, 0xc8, 0xa5, 0xc2, 0xaa, 0x70,
+	0x94, 0x1c, 0xd5, 0x27, 0xc3, 0x11, 0xdd, 0xf0, 0x19, 0xef, 0x08, 0xb0, 0xec, 0x5a, 0x43, 0x9c,
+	0xcb, 0x11, 0x5f, 0xf1, 0x43, 0x02, 0xdd, 0xef, 0xad, 0xc3, 0x07, 0xdf, 0x5b, 0x97, 0xf0, 0xc9,
+	0x49, 0x74, 0x4f, 0x60, 0xce, 0xda, 0x55, 0xa7, 0x49, 0x6c, 0xa5, 0xa0, 0xbf, 0x99, 0x85, 0xf9,
+	0x74, 0x4c, 0x54, 0xa5, 0x9b, 0x00, 0x5c, 0x5b, 0x2a, 0x11, 0xfc, 0x68, 0x22, 0x17, 0xce, 0x51,
+	0x7d, 0x8c, 0x7f, 0x08, 0x5a, 0x64, 0x0b, 0x26, 0x7d, 0xd7, 0x30, 0x59, 0x25, 0xc8, 0xc6, 0x33,
+	0xe9, 0xd9, 0xb8, 0x40, 0x79, 0xcc, 0xc1, 0x91, 0x87, 0xf2, 0x99, 0xf8, 0xcb, 0x7e, 0x9c, 0x14,
+	0xd5, 0x27, 0xfc, 0x08, 0xb0, 0x47, 0x76, 0xe0, 0xb8, 0xef, 0x1a, 0xb6, 0x57, 0x63, 0x6e, 0xb8,
+	0x9e, 0x4c, 0x9a, 0xde, 0x4c, 0x5d, 0x0f, 0xb1, 0x1f, 0x23, 0xa2, 0x57, 0x9e, 0xc7, 0x35, 0xf3,
+	0xc1, 0x9a, 0x71, 0x8a, 0xdc, 0x01, 0xe0, 0x58, 0xb0, 0xf2, 0x8b, 0x8e, 0x95, 0x1d, 0x38, 0x7e,
+	0x40, 0x18, 0xaf, 0xe0, 0xd2, 0x41, 0xff, 0x3a, 0x03, 0xaf, 0x27, 0x4a, 0xe5, 0x15, 0xdd, 0x78,
+	0x3c, 0x5e, 0xa4, 0x4f, 0x8d, 0xba, 0xd1, 0x59, 0xaa, 0xe7, 0xf8, 0xa7, 0x8a, 0xba, 0xab, 0x30,
+	0xed, 0x32, 0x93, 0x59, 0x1d, 0x56, 0x0d, 0xf0, 0x65, 0x72, 0x7f, 0x3a, 0x8c, 0x2d, 0xdd, 0x10,
+	0x54, 0x9f, 0x52, 0x43, 0x8a, 0xce, 0x12, 0xe4, 0x1a, 0x46, 0x58, 0xe0, 0x3f, 0xd6, 0x9d, 0x60,
+	0x45, 0x26, 0xa9, 0x0e, 0xfc, 0x0b, 0x4f, 0xec, 0x77, 0x34, 0xc8, 0x0b, 0x1b, 0x7a, 0xe0, 0x34,
+	0xaa, 0xcc, 0xf5, 0xee, 0x6e, 0x38, 0x1d, 0xd6, 0xd3, 0xec, 0xc8, 0x2c, 0x8c, 0xf9, 0x9b, 0x2e,
+	0xf3, 0x36, 0x9d, 0x86, 0x7a, 0xa1, 0x09, 0x07, 0xc8, 0x2a, 0x40, 0xd8, 0xd8, 0x89, 0x5d, 0x07,
+	0x17, 0x63, 0x7e, 0xbb, 0xbb, 0xd6, 0x53, 0x57, 0xeb, 0xe9, 0x11, 0x4c, 0xfa, 0xa7, 0xaa, 0x70,
+	0x1b, 0x67, 0x2c, 0x2c, 0x71, 0x6e, 0xca, 0xf1, 0x5e, 0x25, 0x4e, 0xa1, 0x12, 0x12, 0x5f, 0xd5,
+	0x90, 0x10, 0x8b, 0xdc, 0x8f, 0xb1, 0x99, 0xc1, 0xd7, 0xcf, 0xc3, 0xd8, 0x94, 0xab, 0xc7, 0xf8,
+	0x7c, 0x0a, 0xb9, 0xc8, 0x32, 0xe9, 0xcd, 0x68, 0xd1, 0xa6, 0xb8, 0xcc, 0x4f, 0xd7, 0x14, 0xb7,
+	0x84, 0xaf, 0x60, 0x18, 0x70, 0x79, 0x81, 0x6d, 0xdd, 0xb0, 0xaa, 0x87, 0xf7, 0xc3, 0xfd, 0x9f,
+	0x06, 0xb3, 0xc9, 0x98, 0x28, 0xd6, 0x5f, 0x86, 0xb1, 0x1a, 0x63, 0x5e, 0xa5, 0x65, 0x58, 0x55,
+	0x14, 0x6c, 0x8f, 0x6b, 0xcc, 0x0a, 0x7a, 0x1c, 0xcc, 0xc6, 0x03, 0xcc, 0xc1, 0xae, 0x4f, 0xa3,
+	0x35, 0xe4, 0x82, 0xbf, 0xe5, 0xfa, 0x3b, 0x78, 0xb9, 0xd4, 0xf9, 0xbf, 0x69, 0xfe, 0x29, 0x7b,
+	0x54, 0xff, 0xf4, 0x36, 0xea, 0x54, 0x99, 0xf7, 0x77, 0xc9, 0xd7, 0x70, 0xe6, 0x46, 0xae, 0x4d,
+	0x49, 0x65, 0x5c, 0xfa, 0x0f, 0x1a, 0x14, 0x92, 0xb0, 0x0e, 0x79, 0xc8, 0x5c, 0x85, 0x69, 0xa7,
+	0xc5, 0xdc, 0x58, 0xca, 0x24, 0x0f, 0x3e, 0x62, 0xda, 0xdd, 0x10, 0x54, 0x9f, 0x52, 0x43, 0x2a,
+	0x9d, 0x5a, 0x83, 0xe3, 0x26, 0x5f, 0xc8, 0xf6, 0xda, 0x5e, 0x40, 0x28, 0xdb, 0x7d, 0xc9, 0x38,
+	0x00, 0x42, 0xf5, 0xe9, 0x60, 0x0c, 0x49, 0xd1, 0xbb, 0x30, 0xf5, 0xc8, 0x6a, 0xb6, 0x1b, 0x86,
+	0x1f, 0x98, 0xf8, 0x02, 0x8c, 0xfa, 0x3b, 0x95, 0x8d, 0x5d, 0x9f, 0x49, 0x6d, 0x19, 0x8f, 0x16,
+	0x01, 0xd4, 0x0c, 0xd5, 0x47, 0xfc, 0x9d, 0xb2, 0xf8, 0xef, 0x77, 0x33, 0x30, 0x1d, 0xd2, 0x40,
+	0x11, 0x7c, 0x04, 0xa3, 0x75, 0xc3, 0xab, 0x58, 0x76, 0xcd, 0xc1, 0x4c, 0xed, 0x6c, 0x4c, 0x6b,
+	0x44, 0x3f, 0xb8, 0x52, 0x9d, 0xfb, 0x86, 0xb7, 0x66, 0xd7, 0x9c, 0xe8, 0x3a, 0x0a, 0x99, 0xea,
+	0x23, 0x75, 0x39, 0x4b, 0x6e, 0xc3, 0xb0, 0xcb, 0x3c, 0xde, 0x5a, 0x21, 0x6d, 0x73, 0x3e, 0x9d,
+	0xa0, 0x2e, 0xe0, 0x74, 0x84, 0xe7, 0xd7, 0xff, 0xa6, 0x65, 0x1f, 0xa9, 0x12, 0x8a, 0x78, 0x03,
+	0x5e, 0xff, 0x9b, 0x96, 0xbd, 0xca, 0x18, 0x3d, 0x05, 0x6f, 0x48, 0xdb, 0xb2, 0x7d, 0xb6, 0xee,
+	0x3a, 0x35, 0x2b, 0x68, 0xbf, 0xa6, 0xdf, 0x54, 0x4e, 0x36, 0x36, 0x87, 0xc2, 0xfb, 0x39, 0x80,
+	0x2a, 0x33, 0x1d, 0x71, 0xe6, 0xca, 0x9b, 0x9d, 0x4f, 0xf6, 0x66, 0x08, 0x85, 0x14, 0xd4, 0x3d,
+	0x3b, 0xc4, 0xe6, 0xae, 0xd9, 0x65, 0x3e, 0xb3, 0x03, 0xa7, 0x36, 0xa4, 0x87, 0x03, 0xf4, 0x47,
+	0x1a, 0x4c, 0x77, 0x13, 0xe1, 0x28, 0x01, 0x01, 0xf4, 0x17, 0xe1, 0x40, 0x82, 0x49, 0xfe, 0x22,
+	0x8c, 0x1b, 0x9d, 0x7a, 0x45, 0xfd, 0x58, 0x20, 0x68, 0xd1, 0x4b, 0x7d, 0x9e, 0x55, 0x2d, 0x7a,
+	0x18, 0x0c, 0xa3, 0xc8, 0xf2, 0x6d, 0x36, 0x67, 0x74, 0xea, 0x0a, 0x5a, 0x14, 0x99, 0x3a, 0xf5,
+	0x94, 0x22, 0x57, 0xa7, 0xae, 0x8a, 0x4c, 0x9d, 0xfa, 0x7d, 0xc3, 0x5b, 0xfc, 0xab, 0x79, 0x38,
+	0x26, 0xe4, 0x4a, 0xfe, 0x51, 0x83, 0x99, 0xe4, 0x0e, 0x76, 0xf2, 0xa5, 0xd4, 0x9e, 0x96, 0x9e,
+	0x3d, 0xf3, 0x85, 0xa5, 0x81, 0xf1, 0xe4, 0x81, 0xd2, 0xaf, 0x7e, 0xf2, 0x93, 0xff, 0xfc, 0x76,
+	0xe6, 0x1d, 0xb2, 0x54, 0x4a, 0xf8, 0x25, 0x87, 0x21, 0x71, 0xbd, 0xd2, 0x33, 0xb4, 0xd3, 0xe7,
+	0xea, 0xe7, 0x0b, 0x15, 0x4f, 0x71, 0xfc, 0x03, 0x0d, 0x4e, 0x26, 0xb5, 0x2c, 0x93, 0x9b, 0x87,
+	0xb1, 0x94, 0xd4, 0x1f, 0x5d, 0xb8, 0x35, 0x20, 0x16, 0x6e, 0xe3, 0x2b, 0x62, 0x1b, 0x4b, 0xe4,
+	0x56, 0x9f, 0xdb, 0x90, 0x57, 0x4e, 0xd5, 0x10, 0x4d, 0xfe, 0x56, 0x83, 0x99, 0xe4, 0xb6, 0xd9,
+	0x1e, 0x27, 0xd2, 0xb3, 0x4d, 0xb7, 0xb0, 0x34, 0x30, 0x1e, 0x6e, 0xe5, 0xa6, 0xd8, 0xca, 0x02,
+	0x79, 0x2b, 0x69, 0x2b, 0xf1, 0x76, 0xd6, 0x52, 0xd0, 0x2f, 0x4a, 0x9e, 0xc3, 0x30, 0xf6, 0x9e,
+	0x5d, 0x3c, 0xb4, 0x2d, 0x4a, 0x32, 0xd8, 0x6f, 0xfb, 0x14, 0xa5, 0x82, 0xa1, 0x59, 0x52, 0x48,
+	0x62, 0x08, 0x9b, 0xaa, 0xfe, 0x8e, 0x0b, 0x30, 0xb1, 0xf1, 0xb0, 0x97, 0x00, 0x7b, 0xf5, 0x33,
+	0x16, 0x96, 0x06, 0xc6, 0x43, 0x7e, 0x6f, 0x09, 0x7e, 0x4b, 0xe4, 0x5a, 0x3a, 0xbf, 0x25, 0xde,
+	0xd0, 0x28, 0x03, 0x70, 0x55, 0xf1, 0xf9, 0x97, 0x1a, 0x9c, 0x48, 0xe8, 0x12, 0x24, 0x6f, 0xa7,
+	0x6b, 0x64, 0x6a, 0xdb, 0x61, 0xe1, 0xe6, 0x60, 0x48, 0xc8, 0xf9, 0x35, 0xc1, 0xf9, 0x25, 0x72,
+	0xa1, 0x07, 0xe7, 0xf5, 0x00, 0x99, 0xfc, 0x9b, 0x06, 0x85, 0xf4, 0xbe, 0x39, 0x72, 0xa7, 0x87,
+	0x06, 0x1e, 0xd2, 0xd0, 0x57, 0xf8, 0xf2, 0x91, 0x70, 0x71, 0x1b, 0x65, 0xb1, 0x8d, 0x77, 0xc9,
+	0x9d, 0xc4, 0x6d, 0x20, 0xb4, 0x57, 0x7a, 0x16, 0xe9, 0x13, 0x79, 0x8e, 0xdb, 0xab, 0xb4, 0x24,
+	0x79, 0xf2, 0xb9, 0x06, 0x6f, 0xa4, 0xb4, 0x9d, 0x91, 0x74, 0xcd, 0xe8, 0xdd, 0xfd, 0x56, 0xb8,
+	0x3d, 0x38, 0x22, 0x6e, 0xe9, 0xb1, 0xd8, 0xd2, 0x43, 0xf2, 0x41, 0xd2, 0x96, 0x82, 0xa2, 0x92,
+	0x57, 0x7a, 0x76, 0xa0, 0xf2, 0xf4, 0xbc, 0x64, 0xb3, 0x1d, 0xbf, 0x12, 0xfc, 0xea, 0xa0, 0x12,
+	0xb6, 0xb4, 0x91, 0x3f, 0xd6, 0x60, 0xaa, 0xab, 0xe5, 0x8c, 0x94, 0x52, 0x79, 0x4c, 0xee, 0x5d,
+	0x2b, 0x5c, 0xef, 0x1f, 0xa1, 0x1f, 0x35, 0xf3, 0x8c, 0x1a, 0xab, 0xb4, 0x38, 0x16, 0xe6, 0xa6,
+	0xe4, 0x8f, 0x34, 0x98, 0xea, 0xea, 0x33, 0xeb, 0xc1, 0x65, 0x72, 0xb7, 0x5b, 0xe1, 0x7a, 0xff,
+	0x08, 0xc8, 0xe5, 0x5b, 0x82, 0xcb, 0x8b, 0xe4, 0x7c, 0x12, 0x97, 0x0c, 0x91, 0x2a, 0xd8, 0xac,
+	0xc6, 0x99, 0x1c, 0x0b, 0xda, 0x8a, 0xc8, 0x9b, 0xe9, 0x07, 0xdd, 0xd5, 0xcc, 0x54, 0xb8, 0xd2,
+	0x0f, 0x28, 0xb2, 0xf4, 0x9e, 0x60, 0xe9, 0x36, 0xf9, 0xd2, 0x20, 0x8a, 0x1d, 0x76, 0x26, 0x91,
+	0xbf, 0xd1, 0x60, 0x22, 0xd6, 0x05, 0x43, 0xae, 0xa5, 0xae, 0x9e, 0xd4, 0x02, 0x54, 0x58, 0xe8,
+	0x17, 0x1c, 0x19, 0x5e, 0x13, 0x0c, 0x2f, 0x93, 0xbb, 0x49, 0x0c, 0x07, 0x5d, 0x41, 0x5e, 0xe9,
+	0xd9, 0x81, 0xae, 0xa1, 0xe7, 0x25, 0x59, 0x8b, 0xac, 0x6c, 0x22, 0xa7, 0x7f, 0xaf, 0xc1, 0xc9,
+	0xa4, 0x6e, 0x89, 0x1e, 0x71, 0xbe, 0x47, 0x93, 0x47, 0xe1, 0xd6, 0x80, 0x58, 0xb8, 0xa1, 0xf7,
+	0xc5, 0x86, 0xee, 0x90, 0xdb, 0x89, 0xc1, 0x51, 0x62, 0x7a, 0xa5, 0x67, 0x61, 0xf1, 0xe3, 0x79,
+	0xc9, 0x52, 0x84, 0x78, 0xb6, 0xec, 0x91, 0xef, 0x6b, 0x70, 0x32, 0xe9, 0x55, 0xbb, 0xc7, 0x3e,
+	0x7a, 0x3c, 0x94, 0x17, 0x6e, 0x0d, 0x88, 0x85, 0xfb, 0x78, 0x5b, 0xec, 0xe3, 0x1a, 0xb9, 0xda,
+	0x73, 0x1f, 0x5d, 0xac, 0xff, 0x40, 0x83, 0xe3, 0x07, 0x5e, 0x48, 0xc9, 0x8d, 0x54, 0x0e, 0xd2,
+	0xde, 0x8b, 0x0b, 0x8b, 0x83, 0xa0, 0x20, 0xc7, 0xab, 0x82, 0xe3, 0xf7, 0xc9, 0x7b, 0xfd, 0x4b,
+	0x7e, 0x83, 0x13, 0xab, 0xb0, 0x0e, 0xb3, 0x2b, 0xe2, 0xed, 0x87, 0xef, 0x42, 0x64, 0x0a, 0x29,
+	0x2f, 0x54, 0xe9, 0x99, 0x42, 0xcf, 0x27, 0xc4, 0xc2, 0xd2, 0xc0, 0x78, 0xfd, 0x64, 0x0a, 0x11,
+	0xaf, 0x2e, 0xb9, 0x37, 0x14, 0x9f, 0xff, 0xa4, 0xc1, 0x1b, 0x29, 0x2f, 0x41, 0x3d, 0x62, 0x53,
+	0xef, 0x37, 0xa6, 0xc2, 0xed, 0xc1, 0x11, 0xfb, 0x49, 0xe1, 0x23, 0xbb, 0xa8, 0x76, 0xd1, 0xa9,
+	0x34, 0x91, 0xe7, 0xff, 0xd2, 0xe0, 0x54, 0xea, 0x33, 0x07, 0x79, 0xe7, 0xf0, 0x44, 0x36, 0xe5,
+	0x25, 0xa6, 0x70, 0xe7, 0x28, 0xa8, 0xb8, 0xab, 0x27, 0x62, 0x57, 0xeb, 0xe4, 0xe1, 0x11, 0x22,
+	0x6e, 0xf8, 0xcb, 0xaa, 0xf0, 0x17, 0xbc, 0xf8, 0xb6, 0x42, 0xbe, 0xa7, 0xc1, 0x89, 0x84, 0x12,
+	0x7c, 0x8f, 0x34, 0x2f, 0xbd, 0xd4, 0x5f, 0xb8, 0x39, 0x18, 0x12, 0x6e, 0xed, 0x86, 0xd8, 0xda,
+	0x55, 0xf2, 0x66, 0xb2, 0x57, 0xb6, 0x9d, 0xa6, 0xaa, 0x83, 0x07, 0xde, 0xf7, 0x0f, 0x34, 0x18,
+	0x8f, 0xd6, 0x16, 0xc9, 0x5b, 0xa9, 0x2b, 0x27, 0xd4, 0x46, 0x0b, 0xd7, 0xfa, 0x84, 0x46, 0x06,
+	0xaf, 0x0b, 0x06, 0xaf, 0x90, 0xcb, 0xa9, 0x0c, 0x7a, 0x25, 0xac, 0x4d, 0x56, 0x0c, 0xc1, 0xce,
+	0x77, 0x35, 0x98, 0xea, 0xaa, 0xd3, 0xf5, 0xc8, 0x11, 0x92, 0x6b, 0x81, 0x85, 0xeb, 0xfd, 0x23,
+	0x20, 0xa3, 0xb7, 0x05, 0xa3, 0x8b, 0xe4, 0x7a, 0x9f, 0xd7, 0xbe, 0xa0, 0xea, 0x47, 0xfe, 0x44,
+	0x83, 0x89, 0x58, 0x89, 0xac, 0x47, 0x28, 0x4e, 0x2a, 0xc0, 0x15, 0x16, 0xfa, 0x05, 0xef, 0xe7,
+	0x5a, 0x27, 0x7f, 0xc2, 0x59, 0x7a, 0x26, 0x33, 0xae, 0xe7, 0x98, 0x4b, 0x30, 0x77, 0xf1, 0x9b,
+	0x1a, 0x64, 0x1e, 0xef, 0x90, 0x5f, 0x81, 0x51, 0x55, 0xc7, 0x22, 0x89, 0x4d, 0x88, 0x5d, 0x95,
+	0xb2, 0xc2, 0xf9, 0xde, 0x40, 0xc8, 0xd3, 0x25, 0xc1, 0xd3, 0xd9, 0x3b, 0xda, 0x15, 0x3a, 0x9b,
+	0xc4, 0x96, 0x87, 0x08, 0x8b, 0x7f, 0xa8, 0x41, 0x2e, 0x52, 0x0e, 0xe2, 0xbf, 0x21, 0x85, 0x95,
+	0xb0, 0x92, 0x73, 0x35, 0xfd, 0xe0, 0x0e, 0xd4, 0x97, 0x0a, 0x6f, 0xf5, 0x07, 0x8c, 0x2c, 0x5e,
+	0x16, 0x2c, 0x52, 0x32, 0x9f, 0x78, 0xc2, 0xb6, 0xcf, 0x73, 0x55, 0x81, 0x51, 0x7e, 0xef, 0xd3,
+	0xcf, 0xe7, 0xb4, 0x1f, 0x7f, 0x3e, 0xa7, 0xfd, 0xc7, 0xe7, 0x73, 0xda, 0xb7, 0xbe, 0x98, 0x7b,
+	0xed, 0xc7, 0x5f, 0xcc, 0xbd, 0xf6, 0xaf, 0x5f, 0xcc, 0xbd, 0xf6, 0xf5, 0xf3, 0x07, 0xcb, 0x63,
+	0x82, 0xd8, 0x0e, 0x92, 0x13, 0x05, 0xb2, 0x8d, 0x61, 0x51, 0x0d, 0x7a, 0xfb, 0xff, 0x07, 0x00,
+	0x02, 0xbd, 0x5d, 0x1b, 0xd3, 0x42, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// AccountStakingSchedule returns the delegations of an account together
+	// with all its pending unbonding entries.
+	AccountStakingSchedule(ctx context.Context, in *QueryAccountStakingScheduleRequest, opts ...grpc.CallOption) (*QueryAccountStakingScheduleResponse, error)
+	// AccountTotalPosition returns the total tokens of an account by denom,
+	// summing its liquid balance, its delegations, its pending unbondings and
+	// its pending staking rewards.
+	AccountTotalPosition(ctx context.Context, in *QueryAccountTotalPositionRequest, opts ...grpc.CallOption) (*QueryAccountTotalPositionResponse, error)
+	// ProjectedCommunityPool returns the community pool balance projected
+	// forward by a number of blocks from the current mint and distribution
+	// params.
+	ProjectedCommunityPool(ctx context.Context, in *QueryProjectedCommunityPoolRequest, opts ...grpc.CallOption) (*QueryProjectedCommunityPoolResponse, error)
+	// Params returns the params of all the Gaia custom modules at once.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsDiffFromDefaults returns the params of the modules which differ
+	// from the default params of the modules.
+	ParamsDiffFromDefaults(ctx context.Context, in *QueryParamsDiffFromDefaultsRequest, opts ...grpc.CallOption) (*QueryParamsDiffFromDefaultsResponse, error)
+	// AllGovernableParams returns the current params of every module whose
+	// params can be changed by a param change proposal, the Gaia custom modules
+	// included, in a uniform structure.
+	AllGovernableParams(ctx context.Context, in *QueryAllGovernableParamsRequest, opts ...grpc.CallOption) (*QueryAllGovernableParamsResponse, error)
+	// PreviewParamsAfterProposal returns the params of the Gaia custom modules
+	// as they would be once a pending param change proposal passes, along with
+	// each change of the proposal. Nothing is written to the state.
+	PreviewParamsAfterProposal(ctx context.Context, in *QueryPreviewParamsAfterProposalRequest, opts ...grpc.CallOption) (*QueryPreviewParamsAfterProposalResponse, error)
+	// NextUnbondingCompletion returns the earliest completion time of the
+	// pending unbondings from a validator, across all its delegators, and the
+	// amount completing then.
+	NextUnbondingCompletion(ctx context.Context, in *QueryNextUnbondingCompletionRequest, opts ...grpc.CallOption) (*QueryNextUnbondingCompletionResponse, error)
+	// SafePruneHeight returns the lowest block height at which a consensus state
+	// of an active IBC client was stored, so that pruning the heights below it
+	// does not break the relaying of the client.
+	SafePruneHeight(ctx context.Context, in *QuerySafePruneHeightRequest, opts ...grpc.CallOption) (*QuerySafePruneHeightResponse, error)
+	// ExpiringClients returns the IBC clients whose latest consensus state is
+	// older than their trusting period minus a buffer, i.e. the clients expired
+	// or expiring within the buffer, earliest expiry first.
+	ExpiringClients(ctx context.Context, in *QueryExpiringClientsRequest, opts ...grpc.CallOption) (*QueryExpiringClientsResponse, error)
+	// NonVoters returns the bonded validators which have not voted yet on a
+	// proposal in voting period, with their voting power.
+	NonVoters(ctx context.Context, in *QueryNonVotersRequest, opts ...grpc.CallOption) (*QueryNonVotersResponse, error)
+	// RewardHistory returns the delegation rewards withdrawn by a delegator in
+	// each block of a height range. It is node local: the withdrawals are
+	// indexed by this node as it delivers the blocks.
+	RewardHistory(ctx context.Context, in *QueryRewardHistoryRequest, opts ...grpc.CallOption) (*QueryRewardHistoryResponse, error)
+	// ChannelIncentiveFees returns the ICS-29 relayer incentives escrowed for
+	// the packets of a fee enabled channel which are not relayed yet.
+	ChannelIncentiveFees(ctx context.Context, in *QueryChannelIncentiveFeesRequest, opts ...grpc.CallOption) (*QueryChannelIncentiveFeesResponse, error)
+	// IncentivizedChannels returns the fee enabled channels with the total of
+	// the relayer incentives escrowed for their packets.
+	IncentivizedChannels(ctx context.Context, in *QueryIncentivizedChannelsRequest, opts ...grpc.CallOption) (*QueryIncentivizedChannelsResponse, error)
+	// BreakEvenRelayFee returns the ICS-29 relayer incentives paying for the
+	// fees of the txs relaying a packet and its acknowledgement on a fee enabled
+	// channel under the global fees.
+	BreakEvenRelayFee(ctx context.Context, in *QueryBreakEvenRelayFeeRequest, opts ...grpc.CallOption) (*QueryBreakEvenRelayFeeResponse, error)
+	// ValidatorRelayActivity returns the IBC packets relayed by the validator
+	// operators in the latest blocks, as indexed by this node.
+	ValidatorRelayActivity(ctx context.Context, in *QueryValidatorRelayActivityRequest, opts ...grpc.CallOption) (*QueryValidatorRelayActivityResponse, error)
+	// DecentralizationMetrics returns the Nakamoto and Gini coefficients of the
+	// voting power of the bonded validators.
+	DecentralizationMetrics(ctx context.Context, in *QueryDecentralizationMetricsRequest, opts ...grpc.CallOption) (*QueryDecentralizationMetricsResponse, error)
+	// ProjectedDelegationReward returns the annual reward projected for
+	// delegating an amount to a validator, from the current inflation, the
+	// commission of the validator and its share of the bonded tokens after the
+	// delegation.
+	ProjectedDelegationReward(ctx context.Context, in *QueryProjectedDelegationRewardRequest, opts ...grpc.CallOption) (*QueryProjectedDelegationRewardResponse, error)
+	// DenomChannelHistory returns the channels a denom traversed to reach this
+	// chain, from its denom trace, and the channels of this chain it was
+	// transferred over, as indexed by this node.
+	DenomChannelHistory(ctx context.Context, in *QueryDenomChannelHistoryRequest, opts ...grpc.CallOption) (*QueryDenomChannelHistoryResponse, error)
+	// HoldersAbove returns the accounts holding at least a threshold amount of
+	// a denom, in the order of the bank store. The bank store has no index of
+	// the balances by denom, so the balances of the accounts are scanned from
+	// the page key: the query is meant for the occasional analytics, e.g. an
+	// airdrop snapshot. The balances scanned by a request are bounded, the
+	// next key then resuming the scan at the next account, possibly with fewer
+	// holders than the page limit, and the total is not counted.
+	HoldersAbove(ctx context.Context, in *QueryHoldersAboveRequest, opts ...grpc.CallOption) (*QueryHoldersAboveResponse, error)
+	// AddressFeesPaid returns the sum of the fees an address paid over its txs,
+	// as indexed by this node. The fees are paid by the fee payer of a tx, or
+	// by its fee granter when the fee is granted.
+	AddressFeesPaid(ctx context.Context, in *QueryAddressFeesPaidRequest, opts ...grpc.CallOption) (*QueryAddressFeesPaidResponse, error)
+	// BlockProposer returns the validator which proposed the block at a height,
+	// from the block headers kept by the staking module for its historical
+	// entries.
+	BlockProposer(ctx context.Context, in *QueryBlockProposerRequest, opts ...grpc.CallOption) (*QueryBlockProposerResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AccountStakingSchedule(ctx context.Context, in *QueryAccountStakingScheduleRequest, opts ...grpc.CallOption) (*QueryAccountStakingScheduleResponse, error) {
+	out := new(QueryAccountStakingScheduleResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/AccountStakingSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountTotalPosition(ctx context.Context, in *QueryAccountTotalPositionRequest, opts ...grpc.CallOption) (*QueryAccountTotalPositionResponse, error) {
+	out := new(QueryAccountTotalPositionResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/AccountTotalPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedCommunityPool(ctx context.Context, in *QueryProjectedCommunityPoolRequest, opts ...grpc.CallOption) (*QueryProjectedCommunityPoolResponse, error) {
+	out := new(QueryProjectedCommunityPoolResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/ProjectedCommunityPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsDiffFromDefaults(ctx context.Context, in *QueryParamsDiffFromDefaultsRequest, opts ...grpc.CallOption) (*QueryParamsDiffFromDefaultsResponse, error) {
+	out := new(QueryParamsDiffFromDefaultsResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/ParamsDiffFromDefaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllGovernableParams(ctx context.Context, in *QueryAllGovernableParamsRequest, opts ...grpc.CallOption) (*QueryAllGovernableParamsResponse, error) {
+	out := new(QueryAllGovernableParamsResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/AllGovernableParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreviewParamsAfterProposal(ctx context.Context, in *QueryPreviewParamsAfterProposalRequest, opts ...grpc.CallOption) (*QueryPreviewParamsAfterProposalResponse, error) {
+	out := new(QueryPreviewParamsAfterProposalResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/PreviewParamsAfterProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextUnbondingCompletion(ctx context.Context, in *QueryNextUnbondingCompletionRequest, opts ...grpc.CallOption) (*QueryNextUnbondingCompletionResponse, error) {
+	out := new(QueryNextUnbondingCompletionResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/NextUnbondingCompletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SafePruneHeight(ctx context.Context, in *QuerySafePruneHeightRequest, opts ...grpc.CallOption) (*QuerySafePruneHeightResponse, error) {
+	out := new(QuerySafePruneHeightResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/SafePruneHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpiringClients(ctx context.Context, in *QueryExpiringClientsRequest, opts ...grpc.CallOption) (*QueryExpiringClientsResponse, error) {
+	out := new(QueryExpiringClientsResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/ExpiringClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NonVoters(ctx context.Context, in *QueryNonVotersRequest, opts ...grpc.CallOption) (*QueryNonVotersResponse, error) {
+	out := new(QueryNonVotersResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/NonVoters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardHistory(ctx context.Context, in *QueryRewardHistoryRequest, opts ...grpc.CallOption) (*QueryRewardHistoryResponse, error) {
+	out := new(QueryRewardHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/RewardHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelIncentiveFees(ctx context.Context, in *QueryChannelIncentiveFeesRequest, opts ...grpc.CallOption) (*QueryChannelIncentiveFeesResponse, error) {
+	out := new(QueryChannelIncentiveFeesResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/ChannelIncentiveFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IncentivizedChannels(ctx context.Context, in *QueryIncentivizedChannelsRequest, opts ...grpc.CallOption) (*QueryIncentivizedChannelsResponse, error) {
+	out := new(QueryIncentivizedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/IncentivizedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BreakEvenRelayFee(ctx context.Context, in *QueryBreakEvenRelayFeeRequest, opts ...grpc.CallOption) (*QueryBreakEvenRelayFeeResponse, error) {
+	out := new(QueryBreakEvenRelayFeeResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/BreakEvenRelayFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRelayActivity(ctx context.Context, in *QueryValidatorRelayActivityRequest, opts ...grpc.CallOption) (*QueryValidatorRelayActivityResponse, error) {
+	out := new(QueryValidatorRelayActivityResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/ValidatorRelayActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DecentralizationMetrics(ctx context.Context, in *QueryDecentralizationMetricsRequest, opts ...grpc.CallOption) (*QueryDecentralizationMetricsResponse, error) {
+	out := new(QueryDecentralizationMetricsResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/DecentralizationMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedDelegationReward(ctx context.Context, in *QueryProjectedDelegationRewardRequest, opts ...grpc.CallOption) (*QueryProjectedDelegationRewardResponse, error) {
+	out := new(QueryProjectedDelegationRewardResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/ProjectedDelegationReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomChannelHistory(ctx context.Context, in *QueryDenomChannelHistoryRequest, opts ...grpc.CallOption) (*QueryDenomChannelHistoryResponse, error) {
+	out := new(QueryDenomChannelHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/DenomChannelHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HoldersAbove(ctx context.Context, in *QueryHoldersAboveRequest, opts ...grpc.CallOption) (*QueryHoldersAboveResponse, error) {
+	out := new(QueryHoldersAboveResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/HoldersAbove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressFeesPaid(ctx context.Context, in *QueryAddressFeesPaidRequest, opts ...grpc.CallOption) (*QueryAddressFeesPaidResponse, error) {
+	out := new(QueryAddressFeesPaidResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/AddressFeesPaid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockProposer(ctx context.Context, in *QueryBlockProposerRequest, opts ...grpc.CallOption) (*QueryBlockProposerResponse, error) {
+	out := new(QueryBlockProposerResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Query/BlockProposer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// AccountStakingSchedule returns the delegations of an account together
+	// with all its pending unbonding entries.
+	AccountStakingSchedule(context.Context, *QueryAccountStakingScheduleRequest) (*QueryAccountStakingScheduleResponse, error)
+	// AccountTotalPosition returns the total tokens of an account by denom,
+	// summing its liquid balance, its delegations, its pending unbondings and
+	// its pending staking rewards.
+	AccountTotalPosition(context.Context, *QueryAccountTotalPositionRequest) (*QueryAccountTotalPositionResponse, error)
+	// ProjectedCommunityPool returns the community pool balance projected
+	// forward by a number of blocks from the current mint and distribution
+	// params.
+	ProjectedCommunityPool(context.Context, *QueryProjectedCommunityPoolRequest) (*QueryProjectedCommunityPoolResponse, error)
+	// Params returns the params of all the Gaia custom modules at once.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsDiffFromDefaults returns the params of the modules which differ
+	// from the default params of the modules.
+	ParamsDiffFromDefaults(context.Context, *QueryParamsDiffFromDefaultsRequest) (*QueryParamsDiffFromDefaultsResponse, error)
+	// AllGovernableParams returns the current params of every module whose
+	// params can be changed by a param change proposal, the Gaia custom modules
+	// included, in a uniform structure.
+	AllGovernableParams(context.Context, *QueryAllGovernableParamsRequest) (*QueryAllGovernableParamsResponse, error)
+	// PreviewParamsAfterProposal returns the params of the Gaia custom modules
+	// as they would be once a pending param change proposal passes, along with
+	// each change of the proposal. Nothing is written to the state.
+	PreviewParamsAfterProposal(context.Context, *QueryPreviewParamsAfterProposalRequest) (*QueryPreviewParamsAfterProposalResponse, error)
+	// NextUnbondingCompletion returns the earliest completion time of the
+	// pending unbondings from a validator, across all its delegators, and the
+	// amount completing then.
+	NextUnbondingCompletion(context.Context, *QueryNextUnbondingCompletionRequest) (*QueryNextUnbondingCompletionResponse, error)
+	// SafePruneHeight returns the lowest block height at which a consensus state
+	// of an active IBC client was stored, so that pruning the heights below it
+	// does not break the relaying of the client.
+	SafePruneHeight(context.Context, *QuerySafePruneHeightRequest) (*QuerySafePruneHeightResponse, error)
+	// ExpiringClients returns the IBC clients whose latest consensus state is
+	// older than their trusting period minus a buffer, i.e. the clients expired
+	// or expiring within the buffer, earliest expiry first.
+	ExpiringClients(context.Context, *QueryExpiringClientsRequest) (*QueryExpiringClientsResponse, error)
+	// NonVoters returns the bonded validators which have not voted yet on a
+	// proposal in voting period, with their voting power.
+	NonVoters(context.Context, *QueryNonVotersRequest) (*QueryNonVotersResponse, error)
+	// RewardHistory returns the delegation rewards withdrawn by a delegator in
+	// each block of a height range. It is node local: the withdrawals are
+	// indexed by this node as it delivers the blocks.
+	RewardHistory(context.Context, *QueryRewardHistoryRequest) (*QueryRewardHistoryResponse, error)
+	// ChannelIncentiveFees returns the ICS-29 relayer incentives escrowed for
+	// the packets of a fee enabled channel which are not relayed yet.
+	ChannelIncentiveFees(context.Context, *QueryChannelIncentiveFeesRequest) (*QueryChannelIncentiveFeesResponse, error)
+	// IncentivizedChannels returns the fee enabled channels with the total of
+	// the relayer incentives escrowed for their packets.
+	IncentivizedChannels(context.Context, *QueryIncentivizedChannelsRequest) (*QueryIncentivizedChannelsResponse, error)
+	// BreakEvenRelayFee returns the ICS-29 relayer incentives paying for the
+	// fees of the txs relaying a packet and its acknowledgement on a fee enabled
+	// channel under the global fees.
+	BreakEvenRelayFee(context.Context, *QueryBreakEvenRelayFeeRequest) (*QueryBreakEvenRelayFeeResponse, error)
+	// ValidatorRelayActivity returns the IBC packets relayed by the validator
+	// operators in the latest blocks, as indexed by this node.
+	ValidatorRelayActivity(context.Context, *QueryValidatorRelayActivityRequest) (*QueryValidatorRelayActivityResponse, error)
+	// DecentralizationMetrics returns the Nakamoto and Gini coefficients of the
+	// voting power of the bonded validators.
+	DecentralizationMetrics(context.Context, *QueryDecentralizationMetricsRequest) (*QueryDecentralizationMetricsResponse, error)
+	// ProjectedDelegationReward returns the annual reward projected for
+	// delegating an amount to a validator, from the current inflation, the
+	// commission of the validator and its share of the bonded tokens after the
+	// delegation.
+	ProjectedDelegationReward(context.Context, *QueryProjectedDelegationRewardRequest) (*QueryProjectedDelegationRewardResponse, error)
+	// DenomChannelHistory returns the channels a denom traversed to reach this
+	// chain, from its denom trace, and the channels of this chain it was
+	// transferred over, as indexed by this node.
+	DenomChannelHistory(context.Context, *QueryDenomChannelHistoryRequest) (*QueryDenomChannelHistoryResponse, error)
+	// HoldersAbove returns the accounts holding at least a threshold amount of
+	// a denom, in the order of the bank store. The bank store has no index of
+	// the balances by denom, so the balances of the accounts are scanned from
+	// the page key: the query is meant for the occasional analytics, e.g. an
+	// airdrop snapshot. The balances scanned by a request are bounded, the
+	// next key then resuming the scan at the next account, possibly with fewer
+	// holders than the page limit, and the total is not counted.
+	HoldersAbove(context.Context, *QueryHoldersAboveRequest) (*QueryHoldersAboveResponse, error)
+	// AddressFeesPaid returns the sum of the fees an address paid over its txs,
+	// as indexed by this node. The fees are paid by the fee payer of a tx, or
+	// by its fee granter when the fee is granted.
+	AddressFeesPaid(context.Context, *QueryAddressFeesPaidRequest) (*QueryAddressFeesPaidResponse, error)
+	// BlockProposer returns the validator which proposed the block at a height,
+	// from the block headers kept by the staking module for its historical
+	// entries.
+	BlockProposer(context.Context, *QueryBlockProposerRequest) (*QueryBlockProposerResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AccountStakingSchedule(ctx context.Context, req *QueryAccountStakingScheduleRequest) (*QueryAccountStakingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountStakingSchedule not implemented")
+}
+func (*UnimplementedQueryServer) AccountTotalPosition(ctx context.Context, req *QueryAccountTotalPositionRequest) (*QueryAccountTotalPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountTotalPosition not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedCommunityPool(ctx context.Context, req *QueryProjectedCommunityPoolRequest) (*QueryProjectedCommunityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedCommunityPool not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ParamsDiffFromDefaults(ctx context.Context, req *QueryParamsDiffFromDefaultsRequest) (*QueryParamsDiffFromDefaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsDiffFromDefaults not implemented")
+}
+func (*UnimplementedQueryServer) AllGovernableParams(ctx context.Context, req *QueryAllGovernableParamsRequest) (*QueryAllGovernableParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllGovernableParams not implemented")
+}
+func (*UnimplementedQueryServer) PreviewParamsAfterProposal(ctx context.Context, req *QueryPreviewParamsAfterProposalRequest) (*QueryPreviewParamsAfterProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewParamsAfterProposal not implemented")
+}
+func (*UnimplementedQueryServer) NextUnbondingCompletion(ctx context.Context, req *QueryNextUnbondingCompletionRequest) (*QueryNextUnbondingCompletionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextUnbondingCompletion not implemented")
+}
+func (*UnimplementedQueryServer) SafePruneHeight(ctx context.Context, req *QuerySafePruneHeightRequest) (*QuerySafePruneHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SafePruneHeight not implemented")
+}
+func (*UnimplementedQueryServer) ExpiringClients(ctx context.Context, req *QueryExpiringClientsRequest) (*QueryExpiringClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringClients not implemented")
+}
+func (*UnimplementedQueryServer) NonVoters(ctx context.Context, req *QueryNonVotersRequest) (*QueryNonVotersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NonVoters not implemented")
+}
+func (*UnimplementedQueryServer) RewardHistory(ctx context.Context, req *QueryRewardHistoryRequest) (*QueryRewardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardHistory not implemented")
+}
+func (*UnimplementedQueryServer) ChannelIncentiveFees(ctx context.Context, req *QueryChannelIncentiveFeesRequest) (*QueryChannelIncentiveFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelIncentiveFees not implemented")
+}
+func (*UnimplementedQueryServer) IncentivizedChannels(ctx context.Context, req *QueryIncentivizedChannelsRequest) (*QueryIncentivizedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivizedChannels not implemented")
+}
+func (*UnimplementedQueryServer) BreakEvenRelayFee(ctx context.Context, req *QueryBreakEvenRelayFeeRequest) (*QueryBreakEvenRelayFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BreakEvenRelayFee not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorRelayActivity(ctx context.Context, req *QueryValidatorRelayActivityRequest) (*QueryValidatorRelayActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRelayActivity not implemented")
+}
+func (*UnimplementedQueryServer) DecentralizationMetrics(ctx context.Context, req *QueryDecentralizationMetricsRequest) (*QueryDecentralizationMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecentralizationMetrics not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedDelegationReward(ctx context.Context, req *QueryProjectedDelegationRewardRequest) (*QueryProjectedDelegationRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedDelegationReward not implemented")
+}
+func (*UnimplementedQueryServer) DenomChannelHistory(ctx context.Context, req *QueryDenomChannelHistoryRequest) (*QueryDenomChannelHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomChannelHistory not implemented")
+}
+func (*UnimplementedQueryServer) HoldersAbove(ctx context.Context, req *QueryHoldersAboveRequest) (*QueryHoldersAboveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldersAbove not implemented")
+}
+func (*UnimplementedQueryServer) AddressFeesPaid(ctx context.Context, req *QueryAddressFeesPaidRequest) (*QueryAddressFeesPaidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressFeesPaid not implemented")
+}
+func (*UnimplementedQueryServer) BlockProposer(ctx context.Context, req *QueryBlockProposerRequest) (*QueryBlockProposerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockProposer not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AccountStakingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountStakingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountStakingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/AccountStakingSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountStakingSchedule(ctx, req.(*QueryAccountStakingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountTotalPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountTotalPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountTotalPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/AccountTotalPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountTotalPosition(ctx, req.(*QueryAccountTotalPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedCommunityPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedCommunityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/ProjectedCommunityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedCommunityPool(ctx, req.(*QueryProjectedCommunityPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsDiffFromDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsDiffFromDefaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsDiffFromDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/ParamsDiffFromDefaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsDiffFromDefaults(ctx, req.(*QueryParamsDiffFromDefaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllGovernableParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGovernableParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllGovernableParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/AllGovernableParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllGovernableParams(ctx, req.(*QueryAllGovernableParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreviewParamsAfterProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreviewParamsAfterProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreviewParamsAfterProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/PreviewParamsAfterProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreviewParamsAfterProposal(ctx, req.(*QueryPreviewParamsAfterProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextUnbondingCompletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextUnbondingCompletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextUnbondingCompletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/NextUnbondingCompletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextUnbondingCompletion(ctx, req.(*QueryNextUnbondingCompletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SafePruneHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySafePruneHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SafePruneHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/SafePruneHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SafePruneHeight(ctx, req.(*QuerySafePruneHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpiringClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/ExpiringClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringClients(ctx, req.(*QueryExpiringClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NonVoters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNonVotersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NonVoters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/NonVoters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NonVoters(ctx, req.(*QueryNonVotersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/RewardHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardHistory(ctx, req.(*QueryRewardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelIncentiveFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelIncentiveFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelIncentiveFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/ChannelIncentiveFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelIncentiveFees(ctx, req.(*QueryChannelIncentiveFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncentivizedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentivizedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivizedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/IncentivizedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivizedChannels(ctx, req.(*QueryIncentivizedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BreakEvenRelayFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBreakEvenRelayFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BreakEvenRelayFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/BreakEvenRelayFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BreakEvenRelayFee(ctx, req.(*QueryBreakEvenRelayFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRelayActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRelayActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRelayActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/ValidatorRelayActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRelayActivity(ctx, req.(*QueryValidatorRelayActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DecentralizationMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDecentralizationMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecentralizationMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/DecentralizationMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecentralizationMetrics(ctx, req.(*QueryDecentralizationMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedDelegationReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedDelegationRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedDelegationReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/ProjectedDelegationReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedDelegationReward(ctx, req.(*QueryProjectedDelegationRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomChannelHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomChannelHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomChannelHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/DenomChannelHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomChannelHistory(ctx, req.(*QueryDenomChannelHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HoldersAbove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldersAboveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HoldersAbove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/HoldersAbove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HoldersAbove(ctx, req.(*QueryHoldersAboveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressFeesPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressFeesPaidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressFeesPaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/AddressFeesPaid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressFeesPaid(ctx, req.(*QueryAddressFeesPaidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockProposerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockProposer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Query/BlockProposer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockProposer(ctx, req.(*QueryBlockProposerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.query.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AccountStakingSchedule",
+			Handler:    _Query_AccountStakingSchedule_Handler,
+		},
+		{
+			MethodName: "AccountTotalPosition",
+			Handler:    _Query_AccountTotalPosition_Handler,
+		},
+		{
+			MethodName: "ProjectedCommunityPool",
+			Handler:    _Query_ProjectedCommunityPool_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsDiffFromDefaults",
+			Handler:    _Query_ParamsDiffFromDefaults_Handler,
+		},
+		{
+			MethodName: "AllGovernableParams",
+			Handler:    _Query_AllGovernableParams_Handler,
+		},
+		{
+			MethodName: "PreviewParamsAfterProposal",
+			Handler:    _Query_PreviewParamsAfterProposal_Handler,
+		},
+		{
+			MethodName: "NextUnbondingCompletion",
+			Handler:    _Query_NextUnbondingCompletion_Handler,
+		},
+		{
+			MethodName: "SafePruneHeight",
+			Handler:    _Query_SafePruneHeight_Handler,
+		},
+		{
+			MethodName: "ExpiringClients",
+			Handler:    _Query_ExpiringClients_Handler,
+		},
+		{
+			MethodName: "NonVoters",
+			Handler:    _Query_NonVoters_Handler,
+		},
+		{
+			MethodName: "RewardHistory",
+			Handler:    _Query_RewardHistory_Handler,
+		},
+		{
+			MethodName: "ChannelIncentiveFees",
+			Handler:    _Query_ChannelIncentiveFees_Handler,
+		},
+		{
+			MethodName: "IncentivizedChannels",
+			Handler:    _Query_IncentivizedChannels_Handler,
+		},
+		{
+			MethodName: "BreakEvenRelayFee",
+			Handler:    _Query_BreakEvenRelayFee_Handler,
+		},
+		{
+			MethodName: "ValidatorRelayActivity",
+			Handler:    _Query_ValidatorRelayActivity_Handler,
+		},
+		{
+			MethodName: "DecentralizationMetrics",
+			Handler:    _Query_DecentralizationMetrics_Handler,
+		},
+		{
+			MethodName: "ProjectedDelegationReward",
+			Handler:    _Query_ProjectedDelegationReward_Handler,
+		},
+		{
+			MethodName: "DenomChannelHistory",
+			Handler:    _Query_DenomChannelHistory_Handler,
+		},
+		{
+			MethodName: "HoldersAbove",
+			Handler:    _Query_HoldersAbove_Handler,
+		},
+		{
+			MethodName: "AddressFeesPaid",
+			Handler:    _Query_AddressFeesPaid_Handler,
+		},
+		{
+			MethodName: "BlockProposer",
+			Handler:    _Query_BlockProposer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/query/v1beta1/query.proto",
+}
+
+// TxClient is the client API for Tx service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TxClient interface {
+	// Simulate simulates the execution of a tx and returns the minimum fee
+	// required for the gas it used along with the simulation result.
+	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+}
+
+type txClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewTxClient(cc grpc1.ClientConn) TxClient {
+	return &txClient{cc}
+}
+
+func (c *txClient) Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error) {
+	out := new(SimulateResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.Tx/Simulate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TxServer is the server API for Tx service.
+type TxServer interface {
+	// Simulate simulates the execution of a tx and returns the minimum fee
+	// required for the gas it used along with the simulation result.
+	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+}
+
+// UnimplementedTxServer can be embedded to have forward compatible implementations.
+type UnimplementedTxServer struct {
+}
+
+func (*UnimplementedTxServer) Simulate(ctx context.Context, req *SimulateRequest) (*SimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
+
+func RegisterTxServer(s grpc1.Server, srv TxServer) {
+	s.RegisterService(&_Tx_serviceDesc, srv)
+}
+
+func _Tx_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.Tx/Simulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServer).Simulate(ctx, req.(*SimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Tx_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.query.v1beta1.Tx",
+	HandlerType: (*TxServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Simulate",
+			Handler:    _Tx_Simulate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/query/v1beta1/query.proto",
+}
+
+// AnteProfileClient is the client API for AnteProfile service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AnteProfileClient interface {
+	// Decorators returns the average time spent and gas consumed by each ante
+	// decorator over the most recent txs.
+	Decorators(ctx context.Context, in *QueryAnteProfileRequest, opts ...grpc.CallOption) (*QueryAnteProfileResponse, error)
+}
+
+type anteProfileClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewAnteProfileClient(cc grpc1.ClientConn) AnteProfileClient {
+	return &anteProfileClient{cc}
+}
+
+func (c *anteProfileClient) Decorators(ctx context.Context, in *QueryAnteProfileRequest, opts ...grpc.CallOption) (*QueryAnteProfileResponse, error) {
+	out := new(QueryAnteProfileResponse)
+	err := c.cc.Invoke(ctx, "/gaia.query.v1beta1.AnteProfile/Decorators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnteProfileServer is the server API for AnteProfile service.
+type AnteProfileServer interface {
+	// Decorators returns the average time spent and gas consumed by each ante
+	// decorator over the most recent txs.
+	Decorators(context.Context, *QueryAnteProfileRequest) (*QueryAnteProfileResponse, error)
+}
+
+// UnimplementedAnteProfileServer can be embedded to have forward compatible implementations.
+type UnimplementedAnteProfileServer struct {
+}
+
+func (*UnimplementedAnteProfileServer) Decorators(ctx context.Context, req *QueryAnteProfileRequest) (*QueryAnteProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decorators not implemented")
+}
+
+func RegisterAnteProfileServer(s grpc1.Server, srv AnteProfileServer) {
+	s.RegisterService(&_AnteProfile_serviceDesc, srv)
+}
+
+func _AnteProfile_Decorators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnteProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnteProfileServer).Decorators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.query.v1beta1.AnteProfile/Decorators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnteProfileServer).Decorators(ctx, req.(*QueryAnteProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AnteProfile_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.query.v1beta1.AnteProfile",
+	HandlerType: (*AnteProfileServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Decorators",
+			Handler:    _AnteProfile_Decorators_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/query/v1beta1/query.proto",
+}
+
+func (m *QueryAccountStakingScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountStakingScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountStakingScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountStakingScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountStakingScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountStakingScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InitialBalance.Size()
+		i -= size
+		if _, err := m.InitialBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.CreationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountTotalPositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountTotalPositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountTotalPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountTotalPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountTotalPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountTotalPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Rewards.Size()
+		i -= size
+		if _, err := m.Rewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Unbonding.Size()
+		i -= size
+		if _, err := m.Unbonding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Staked.Size()
+		i -= size
+		if _, err := m.Staked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Liquid.Size()
+		i -= size
+		if _, err := m.Liquid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedCommunityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedCommunityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Assumptions) > 0 {
+		for iNdEx := len(m.Assumptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Assumptions[iNdEx])
+			copy(dAtA[i:], m.Assumptions[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Assumptions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.ProjectedMinted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProjectedCommunityPool) > 0 {
+		for iNdEx := len(m.ProjectedCommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProjectedCommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Autocompound.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Grantspool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Downtimegrace.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Recurringspend.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Globalfee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsDiffFromDefaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsDiffFromDefaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsDiffFromDefaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsDiffFromDefaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsDiffFromDefaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsDiffFromDefaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Diffs) > 0 {
+		for iNdEx := len(m.Diffs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Diffs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DefaultValue) > 0 {
+		i -= len(m.DefaultValue)
+		copy(dAtA[i:], m.DefaultValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DefaultValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGovernableParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGovernableParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGovernableParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGovernableParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGovernableParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGovernableParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Modules) > 0 {
+		for iNdEx := len(m.Modules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Modules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsDefault {
+		i--
+		if m.IsDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreviewParamsAfterProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreviewParamsAfterProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreviewParamsAfterProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreviewParamsAfterProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreviewParamsAfterProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreviewParamsAfterProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChangePreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChangePreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChangePreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextUnbondingCompletionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextUnbondingCompletionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextUnbondingCompletionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextUnbondingCompletionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextUnbondingCompletionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextUnbondingCompletionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySafePruneHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySafePruneHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySafePruneHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySafePruneHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySafePruneHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySafePruneHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringClientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringClientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringClientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Within, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Within):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringClientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringClientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringClientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpiringClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpiringClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiringClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiryTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x32
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TrustingPeriod):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LatestTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LatestTimestamp):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintQuery(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if len(m.LatestHeight) > 0 {
+		i -= len(m.LatestHeight)
+		copy(dAtA[i:], m.LatestHeight)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LatestHeight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNonVotersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNonVotersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNonVotersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNonVotersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNonVotersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNonVotersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NonVoters) > 0 {
+		for iNdEx := len(m.NonVoters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonVoters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NonVoter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NonVoter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NonVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IndexedFromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IndexedFromHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelIncentiveFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelIncentiveFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelIncentiveFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelIncentiveFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelIncentiveFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelIncentiveFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketIncentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketIncentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Payers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Payers))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TimeoutFee) > 0 {
+		for iNdEx := len(m.TimeoutFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeoutFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for iNdEx := len(m.AckFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RecvFee) > 0 {
+		for iNdEx := len(m.RecvFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentivizedChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivizedChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivizedChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentivizedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivizedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivizedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelIncentives) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelIncentives) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelIncentives) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PacketCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PacketCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBreakEvenRelayFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBreakEvenRelayFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBreakEvenRelayFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AckGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AckGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RecvGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecvGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBreakEvenRelayFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBreakEvenRelayFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBreakEvenRelayFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for iNdEx := len(m.AckFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RecvFee) > 0 {
+		for iNdEx := len(m.RecvFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AckGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AckGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RecvGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecvGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRelayActivityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRelayActivityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRelayActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRelayActivityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRelayActivityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRelayActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IndexedFromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IndexedFromHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Activities) > 0 {
+		for iNdEx := len(m.Activities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Activities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRelayActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRelayActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRelayActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeouts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timeouts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Acknowledgements != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Acknowledgements))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RecvPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecvPackets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RelayerAddress) > 0 {
+		i -= len(m.RelayerAddress)
+		copy(dAtA[i:], m.RelayerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RelayerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecentralizationMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecentralizationMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecentralizationMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecentralizationMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecentralizationMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecentralizationMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ValidatorCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.GiniCoefficient.Size()
+		i -= size
+		if _, err := m.GiniCoefficient.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.NakamotoCoefficient != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NakamotoCoefficient))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedDelegationRewardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedDelegationRewardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedDelegationRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedDelegationRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedDelegationRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedDelegationRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Assumptions) > 0 {
+		for iNdEx := len(m.Assumptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Assumptions[iNdEx])
+			copy(dAtA[i:], m.Assumptions[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Assumptions[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PowerShare.Size()
+		i -= size
+		if _, err := m.PowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AnnualReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomChannelHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomChannelHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomChannelHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomChannelHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomChannelHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomChannelHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IndexedFromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IndexedFromHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TransferChannels) > 0 {
+		for iNdEx := len(m.TransferChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TraceChannels) > 0 {
+		for iNdEx := len(m.TraceChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraceChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTraceChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTraceChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTraceChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomChannelTransfers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomChannelTransfers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomChannelTransfers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReceivedPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReceivedPackets))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SentPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SentPackets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldersAboveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldersAboveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldersAboveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldersAboveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldersAboveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldersAboveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressFeesPaidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressFeesPaidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressFeesPaidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressFeesPaidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressFeesPaidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressFeesPaidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IndexedFromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IndexedFromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Txs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeesPaid) > 0 {
+		for iNdEx := len(m.FeesPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockProposerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockProposerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockProposerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockProposerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockProposerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockProposerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinFee) > 0 {
+		for iNdEx := len(m.MinFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasInfo != nil {
+		{
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnteProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnteProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnteProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnteProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnteProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnteProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retention != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Retention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Decorators) > 0 {
+		for iNdEx := len(m.Decorators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Decorators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecoratorProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecoratorProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecoratorProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AvgGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AvgGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AvgDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AvgDuration):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintQuery(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x1a
+	if m.Txs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Decorator) > 0 {
+		i -= len(m.Decorator)
+		copy(dAtA[i:], m.Decorator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Decorator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountStakingScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountStakingScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountTotalPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountTotalPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Liquid.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Staked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unbonding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectedCommunityPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *QueryProjectedCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ProjectedCommunityPool) > 0 {
+		for _, e := range m.ProjectedCommunityPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.ProjectedMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Assumptions) > 0 {
+		for _, s := range m.Assumptions {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Globalfee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Recurringspend.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Downtimegrace.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Grantspool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Autocompound.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsDiffFromDefaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsDiffFromDefaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Diffs) > 0 {
+		for _, e := range m.Diffs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DefaultValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGovernableParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllGovernableParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Modules) > 0 {
+		for _, e := range m.Modules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsDefault {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPreviewParamsAfterProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryPreviewParamsAfterProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamChangePreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextUnbondingCompletionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextUnbondingCompletionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySafePruneHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySafePruneHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiringClientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Within)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExpiringClientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for _, e := range m.Clients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExpiringClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LatestHeight)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LatestTimestamp)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TrustingPeriod)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiryTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryNonVotersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryNonVotersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NonVoters) > 0 {
+		for _, e := range m.NonVoters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NonVoter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *QueryRewardHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *QueryRewardHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.IndexedFromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.IndexedFromHeight))
+	}
+	return n
+}
+
+func (m *RewardDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryChannelIncentiveFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelIncentiveFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketIncentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if len(m.RecvFee) > 0 {
+		for _, e := range m.RecvFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for _, e := range m.AckFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TimeoutFee) > 0 {
+		for _, e := range m.TimeoutFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Payers != 0 {
+		n += 1 + sovQuery(uint64(m.Payers))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIncentivizedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelIncentives) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PacketCount != 0 {
+		n += 1 + sovQuery(uint64(m.PacketCount))
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBreakEvenRelayFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecvGas != 0 {
+		n += 1 + sovQuery(uint64(m.RecvGas))
+	}
+	if m.AckGas != 0 {
+		n += 1 + sovQuery(uint64(m.AckGas))
+	}
+	return n
+}
+
+func (m *QueryBreakEvenRelayFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecvGas != 0 {
+		n += 1 + sovQuery(uint64(m.RecvGas))
+	}
+	if m.AckGas != 0 {
+		n += 1 + sovQuery(uint64(m.AckGas))
+	}
+	if len(m.RecvFee) > 0 {
+		for _, e := range m.RecvFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for _, e := range m.AckFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorRelayActivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryValidatorRelayActivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Activities) > 0 {
+		for _, e := range m.Activities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.IndexedFromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.IndexedFromHeight))
+	}
+	return n
+}
+
+func (m *ValidatorRelayActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecvPackets != 0 {
+		n += 1 + sovQuery(uint64(m.RecvPackets))
+	}
+	if m.Acknowledgements != 0 {
+		n += 1 + sovQuery(uint64(m.Acknowledgements))
+	}
+	if m.Timeouts != 0 {
+		n += 1 + sovQuery(uint64(m.Timeouts))
+	}
+	return n
+}
+
+func (m *QueryDecentralizationMetricsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDecentralizationMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NakamotoCoefficient != 0 {
+		n += 1 + sovQuery(uint64(m.NakamotoCoefficient))
+	}
+	l = m.GiniCoefficient.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ValidatorCount != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorCount))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	}
+	return n
+}
+
+func (m *QueryProjectedDelegationRewardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectedDelegationRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PowerShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Assumptions) > 0 {
+		for _, s := range m.Assumptions {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomChannelHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomChannelHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TraceChannels) > 0 {
+		for _, e := range m.TraceChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TransferChannels) > 0 {
+		for _, e := range m.TransferChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.IndexedFromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.IndexedFromHeight))
+	}
+	return n
+}
+
+func (m *DenomTraceChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomChannelTransfers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SentPackets != 0 {
+		n += 1 + sovQuery(uint64(m.SentPackets))
+	}
+	if m.ReceivedPackets != 0 {
+		n += 1 + sovQuery(uint64(m.ReceivedPackets))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func (m *QueryHoldersAboveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHoldersAboveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Holders) > 0 {
+		for _, e := range m.Holders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAddressFeesPaidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressFeesPaidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeesPaid) > 0 {
+		for _, e := range m.FeesPaid {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Txs != 0 {
+		n += 1 + sovQuery(uint64(m.Txs))
+	}
+	if m.IndexedFromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.IndexedFromHeight))
+	}
+	return n
+}
+
+func (m *QueryBlockProposerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockProposerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.MinFee) > 0 {
+		for _, e := range m.MinFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAnteProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnteProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Decorators) > 0 {
+		for _, e := range m.Decorators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Retention != 0 {
+		n += 1 + sovQuery(uint64(m.Retention))
+	}
+	return n
+}
+
+func (m *DecoratorProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Decorator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Txs != 0 {
+		n += 1 + sovQuery(uint64(m.Txs))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AvgDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AvgGas != 0 {
+		n += 1 + sovQuery(uint64(m.AvgGas))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountStakingScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountStakingScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountStakingScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountStakingScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountStakingScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountStakingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, types.DelegationResponse{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, UnbondingScheduleEntry{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingScheduleEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingScheduleEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountTotalPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountTotalPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountTotalPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountTotalPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountTotalPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountTotalPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, DenomPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedCommunityPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedCommunityPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedCommunityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types1.DecCoin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedCommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectedCommunityPool = append(m.ProjectedCommunityPool, types1.DecCoin{})
+			if err := m.ProjectedCommunityPool[len(m.ProjectedCommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assumptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assumptions = append(m.Assumptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Globalfee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Globalfee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurringspend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Recurringspend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downtimegrace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Downtimegrace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantspool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Grantspool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Autocompound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Autocompound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsDiffFromDefaultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsDiffFromDefaultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsDiffFromDefaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsDiffFromDefaultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsDiffFromDefaultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsDiffFromDefaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diffs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diffs = append(m.Diffs, ParamDiff{})
+			if err := m.Diffs[len(m.Diffs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGovernableParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGovernableParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGovernableParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGovernableParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGovernableParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGovernableParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modules = append(m.Modules, ModuleParams{})
+			if err := m.Modules[len(m.Modules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, ParamValue{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDefault = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPreviewParamsAfterProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreviewParamsAfterProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreviewParamsAfterProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPreviewParamsAfterProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreviewParamsAfterProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreviewParamsAfterProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, ParamChangePreview{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamChangePreview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChangePreview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChangePreview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextUnbondingCompletionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextUnbondingCompletionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextUnbondingCompletionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextUnbondingCompletionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextUnbondingCompletionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextUnbondingCompletionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySafePruneHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySafePruneHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySafePruneHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySafePruneHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySafePruneHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySafePruneHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiringClientsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringClientsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringClientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Within", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Within, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiringClientsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringClientsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clients = append(m.Clients, ExpiringClient{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiringClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiringClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiringClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LatestTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TrustingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNonVotersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNonVotersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNonVotersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNonVotersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNonVotersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNonVotersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonVoters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonVoters = append(m.NonVoters, NonVoter{})
+			if err := m.NonVoters[len(m.NonVoters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NonVoter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NonVoter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NonVoter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, RewardDelta{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types1.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedFromHeight", wireType)
+			}
+			m.IndexedFromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedFromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelIncentiveFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelIncentiveFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelIncentiveFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelIncentiveFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelIncentiveFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelIncentiveFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, PacketIncentive{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, types1.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketIncentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketIncentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvFee = append(m.RecvFee, types1.Coin{})
+			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckFee = append(m.AckFee, types1.Coin{})
+			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutFee = append(m.TimeoutFee, types1.Coin{})
+			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, types1.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payers", wireType)
+			}
+			m.Payers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Payers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, ChannelIncentives{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelIncentives) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelIncentives: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelIncentives: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketCount", wireType)
+			}
+			m.PacketCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, types1.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBreakEvenRelayFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBreakEvenRelayFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBreakEvenRelayFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvGas", wireType)
+			}
+			m.RecvGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckGas", wireType)
+			}
+			m.AckGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBreakEvenRelayFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBreakEvenRelayFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBreakEvenRelayFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvGas", wireType)
+			}
+			m.RecvGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckGas", wireType)
+			}
+			m.AckGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvFee = append(m.RecvFee, types1.Coin{})
+			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckFee = append(m.AckFee, types1.Coin{})
+			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types1.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRelayActivityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRelayActivityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRelayActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRelayActivityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRelayActivityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRelayActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Activities = append(m.Activities, ValidatorRelayActivity{})
+			if err := m.Activities[len(m.Activities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedFromHeight", wireType)
+			}
+			m.IndexedFromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedFromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRelayActivity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRelayActivity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRelayActivity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvPackets", wireType)
+			}
+			m.RecvPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
+			}
+			m.Acknowledgements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Acknowledgements |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeouts", wireType)
+			}
+			m.Timeouts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeouts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDecentralizationMetricsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDecentralizationMetricsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDecentralizationMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDecentralizationMetricsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDecentralizationMetricsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDecentralizationMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NakamotoCoefficient", wireType)
+			}
+			m.NakamotoCoefficient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NakamotoCoefficient |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GiniCoefficient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GiniCoefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+			}
+			m.ValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedDelegationRewardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedDelegationRewardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedDelegationRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedDelegationRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedDelegationRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedDelegationRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assumptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assumptions = append(m.Assumptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomChannelHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomChannelHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomChannelHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomChannelHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomChannelHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomChannelHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceChannels = append(m.TraceChannels, DenomTraceChannel{})
+			if err := m.TraceChannels[len(m.TraceChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannels = append(m.TransferChannels, DenomChannelTransfers{})
+			if err := m.TransferChannels[len(m.TransferChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedFromHeight", wireType)
+			}
+			m.IndexedFromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedFromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTraceChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTraceChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTraceChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomChannelTransfers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomChannelTransfers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomChannelTransfers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentPackets", wireType)
+			}
+			m.SentPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedPackets", wireType)
+			}
+			m.ReceivedPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldersAboveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldersAboveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldersAboveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldersAboveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldersAboveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldersAboveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holders = append(m.Holders, DenomHolder{})
+			if err := m.Holders[len(m.Holders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressFeesPaidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressFeesPaidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressFeesPaidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressFeesPaidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressFeesPaidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressFeesPaidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesPaid = append(m.FeesPaid, types1.Coin{})
+			if err := m.FeesPaid[len(m.FeesPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedFromHeight", wireType)
+			}
+			m.IndexedFromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedFromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockProposerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockProposerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockProposerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockProposerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockProposerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockProposerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types1.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &types1.Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFee = append(m.MinFee, types1.Coin{})
+			if err := m.MinFee[len(m.MinFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnteProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnteProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnteProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnteProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnteProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnteProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decorators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decorators = append(m.Decorators, DecoratorProfile{})
+			if err := m.Decorators[len(m.Decorators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			m.Retention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecoratorProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecoratorProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecoratorProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decorator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decorator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AvgDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgGas", wireType)
+			}
+			m.AvgGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvgGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
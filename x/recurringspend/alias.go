@@ -0,0 +1,9 @@
+package recurringspend
+
+import (
+	"github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+// RecurringCommunityPoolSpendProposalJSON defines a recurring community pool
+// spend proposal read from a JSON file.
+type RecurringCommunityPoolSpendProposalJSON struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Recipient   string `json:"recipient"`
+	Amount      string `json:"amount"`
+	Interval    uint64 `json:"interval"`
+	EndHeight   int64  `json:"end_height"`
+	Deposit     string `json:"deposit"`
+}
+
+// ParseRecurringCommunityPoolSpendProposalJSON reads and parses a
+// RecurringCommunityPoolSpendProposalJSON from a file.
+func ParseRecurringCommunityPoolSpendProposalJSON(proposalFile string) (RecurringCommunityPoolSpendProposalJSON, error) {
+	var proposal RecurringCommunityPoolSpendProposalJSON
+
+	contents, err := os.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// GetCmdSubmitRecurringSpendProposal implements the command to submit a
+// recurring community pool spend proposal.
+func GetCmdSubmitRecurringSpendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recurring-community-pool-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a recurring community pool spend proposal",
+		Long: `Submit a recurring community pool spend proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Once the proposal passes, the
+amount is sent from the community pool to the recipient every interval blocks until
+the end height.
+
+Example:
+$ gaiad tx gov submit-proposal recurring-community-pool-spend <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Monthly Grant",
+  "description": "Fund the program every month",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": "1000uatom",
+  "interval": 432000,
+  "end_height": 20000000,
+  "deposit": "1000uatom"
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseRecurringCommunityPoolSpendProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(proposal.Amount)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(proposal.Recipient)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRecurringCommunityPoolSpendProposal(
+				proposal.Title, proposal.Description, recipient, amount, proposal.Interval, proposal.EndHeight,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelRecurringSpendProposal implements the command to submit a
+// cancel recurring community pool spend proposal.
+func GetCmdSubmitCancelRecurringSpendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-recurring-community-pool-spend [spend-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a recurring community pool spend",
+		Long: `Submit a proposal to cancel a recurring community pool spend along with an initial deposit.
+
+Example:
+$ gaiad tx gov submit-proposal cancel-recurring-community-pool-spend 1 --title="Stop the grant" --description="The program ended" --deposit=1000uatom --from=<key_or_address>
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spendID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCancelRecurringCommunityPoolSpendProposal(title, description, spendID)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+// InitGenesis initializes the params and the recurring spends from the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	k.SetNextSpendID(ctx, genState.NextSpendId)
+	for _, spend := range genState.Spends {
+		k.SetSpend(ctx, spend)
+	}
+}
+
+// ExportGenesis returns the params and the recurring spends as a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		NextSpendId: k.GetNextSpendID(ctx),
+		Spends:      k.GetAllSpends(ctx),
+		Params:      k.GetParams(ctx),
+	}
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// RecurringSpends returns the active recurring spends
+func (k Keeper) RecurringSpends(stdCtx context.Context, req *types.QueryRecurringSpendsRequest) (*types.QueryRecurringSpendsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpendKeyPrefix)
+
+	var spends []types.RecurringSpend
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var spend types.RecurringSpend
+		if err := k.cdc.Unmarshal(value, &spend); err != nil {
+			return err
+		}
+		spends = append(spends, spend)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecurringSpendsResponse{Spends: spends, Pagination: pageRes}, nil
+}
+
+// RecurringSpend returns an active recurring spend by id
+func (k Keeper) RecurringSpend(stdCtx context.Context, req *types.QueryRecurringSpendRequest) (*types.QueryRecurringSpendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	spend, found := k.GetSpend(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "recurring spend %d not found", req.Id)
+	}
+
+	return &types.QueryRecurringSpendResponse{Spend: spend}, nil
+}
+
+// Params returns the module params
+func (k Keeper) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+// Keeper of the recurring spend store
+type Keeper struct {
+	storeKey    storetypes.StoreKey
+	cdc         codec.BinaryCodec
+	paramSpace  paramstypes.Subspace
+	distrKeeper types.DistributionKeeper
+}
+
+// NewKeeper creates a new recurring spend Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramstypes.Subspace, distrKeeper types.DistributionKeeper) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:    key,
+		cdc:         cdc,
+		paramSpace:  paramSpace,
+		distrKeeper: distrKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the module params. The params that are not set yet take
+// their default value.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// ValidateSpendCap returns an error if the given amount exceeds, for any
+// denom, the max spend fraction of the community pool balance.
+func (k Keeper) ValidateSpendCap(ctx sdk.Context, amount sdk.Coins) error {
+	maxFraction := k.GetParams(ctx).MaxSpendFraction
+	if maxFraction.IsZero() {
+		return nil
+	}
+
+	communityPool := k.distrKeeper.GetFeePool(ctx).CommunityPool
+	for _, coin := range amount {
+		maxAmount := communityPool.AmountOf(coin.Denom).Mul(maxFraction).TruncateInt()
+		if coin.Amount.GT(maxAmount) {
+			return sdkerrors.Wrapf(types.ErrSpendCapExceeded, "requested %s, at most %s%s can be requested", coin, maxAmount, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// GetNextSpendID returns the id given to the next recurring spend.
+func (k Keeper) GetNextSpendID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextSpendIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextSpendID sets the id given to the next recurring spend.
+func (k Keeper) SetNextSpendID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextSpendIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetSpend returns the recurring spend with the given id.
+func (k Keeper) GetSpend(ctx sdk.Context, id uint64) (spend types.RecurringSpend, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSpendKey(id))
+	if bz == nil {
+		return spend, false
+	}
+	k.cdc.MustUnmarshal(bz, &spend)
+	return spend, true
+}
+
+// SetSpend stores a recurring spend.
+func (k Keeper) SetSpend(ctx sdk.Context, spend types.RecurringSpend) {
+	ctx.KVStore(k.storeKey).Set(types.GetSpendKey(spend.Id), k.cdc.MustMarshal(&spend))
+}
+
+// DeleteSpend removes a recurring spend.
+func (k Keeper) DeleteSpend(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetSpendKey(id))
+}
+
+// IterateSpends iterates over the recurring spends by id. The iteration stops
+// when cb returns true.
+func (k Keeper) IterateSpends(ctx sdk.Context, cb func(spend types.RecurringSpend) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpendKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var spend types.RecurringSpend
+		k.cdc.MustUnmarshal(iterator.Value(), &spend)
+		if cb(spend) {
+			break
+		}
+	}
+}
+
+// GetAllSpends returns all the recurring spends by id.
+func (k Keeper) GetAllSpends(ctx sdk.Context) []types.RecurringSpend {
+	var spends []types.RecurringSpend
+	k.IterateSpends(ctx, func(spend types.RecurringSpend) bool {
+		spends = append(spends, spend)
+		return false
+	})
+	return spends
+}
+
+// AddSpend sets up a recurring spend whose first disbursement happens
+// interval blocks after the current height. It returns the id of the spend.
+func (k Keeper) AddSpend(ctx sdk.Context, title string, recipient sdk.AccAddress, amount sdk.Coins, interval uint64, endHeight int64) (uint64, error) {
+	spend := types.RecurringSpend{
+		Id:         k.GetNextSpendID(ctx),
+		Title:      title,
+		Recipient:  recipient.String(),
+		Amount:     amount,
+		Interval:   interval,
+		NextHeight: ctx.BlockHeight() + int64(interval),
+		EndHeight:  endHeight,
+	}
+	if err := spend.Validate(); err != nil {
+		return 0, err
+	}
+
+	k.SetSpend(ctx, spend)
+	k.SetNextSpendID(ctx, spend.Id+1)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRecurringSpendCreated,
+		sdk.NewAttribute(types.AttributeKeySpendID, sdk.NewUint(spend.Id).String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, spend.Recipient),
+		sdk.NewAttribute(types.AttributeKeyAmount, spend.Amount.String()),
+	))
+
+	return spend.Id, nil
+}
+
+// CancelSpend removes a recurring spend before its end height.
+func (k Keeper) CancelSpend(ctx sdk.Context, id uint64) error {
+	if _, found := k.GetSpend(ctx, id); !found {
+		return sdkerrors.Wrapf(types.ErrSpendNotFound, "id %d", id)
+	}
+
+	k.DeleteSpend(ctx, id)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRecurringSpendCancelled,
+		sdk.NewAttribute(types.AttributeKeySpendID, sdk.NewUint(id).String()),
+	))
+
+	return nil
+}
+
+// ExecuteDueSpends disburses the recurring spends due at the current height
+// and schedules their next disbursement. A disbursement that fails, e.g.
+// because the community pool is short of funds, is skipped. The spends are
+// removed once their next disbursement would be after their end height.
+func (k Keeper) ExecuteDueSpends(ctx sdk.Context) {
+	var due []types.RecurringSpend
+	k.IterateSpends(ctx, func(spend types.RecurringSpend) bool {
+		if spend.NextHeight <= ctx.BlockHeight() {
+			due = append(due, spend)
+		}
+		return false
+	})
+
+	for _, spend := range due {
+		spendID := sdk.NewUint(spend.Id).String()
+		if err := k.disburse(ctx, spend); err != nil {
+			k.Logger(ctx).Error("failed to disburse recurring spend", "id", spend.Id, "err", err)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeRecurringSpendFailed,
+				sdk.NewAttribute(types.AttributeKeySpendID, spendID),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+		} else {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeRecurringSpendPaid,
+				sdk.NewAttribute(types.AttributeKeySpendID, spendID),
+				sdk.NewAttribute(types.AttributeKeyRecipient, spend.Recipient),
+				sdk.NewAttribute(types.AttributeKeyAmount, spend.Amount.String()),
+			))
+		}
+
+		spend.NextHeight = ctx.BlockHeight() + int64(spend.Interval)
+		if spend.NextHeight > spend.EndHeight {
+			k.DeleteSpend(ctx, spend.Id)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeRecurringSpendEnded,
+				sdk.NewAttribute(types.AttributeKeySpendID, spendID),
+			))
+			continue
+		}
+		k.SetSpend(ctx, spend)
+	}
+}
+
+// disburse transfers the spend amount from the community pool to the
+// recipient, leaving the state untouched on failure.
+func (k Keeper) disburse(ctx sdk.Context, spend types.RecurringSpend) error {
+	recipient, err := sdk.AccAddressFromBech32(spend.Recipient)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.distrKeeper.DistributeFromFeePool(cacheCtx, spend.Amount, recipient); err != nil {
+		return err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
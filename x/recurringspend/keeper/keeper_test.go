@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+const denom = "stake"
+
+func setupRecurringSpends(t *testing.T, communityPool sdk.Int) (*gaiaapp.GaiaApp, sdk.Context) {
+	t.Helper()
+
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	// reset the community pool, then fund it with the given amount
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.DecCoins{}
+	if communityPool.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, communityPool))
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, coins))
+		feePool.CommunityPool = sdk.NewDecCoinsFromCoins(coins...)
+	}
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+
+	return app, ctx
+}
+
+func TestExecuteDueSpends(t *testing.T) {
+	app, ctx := setupRecurringSpends(t, sdk.NewInt(1_000))
+	k := app.RecurringSpendKeeper
+	recipient := sdk.AccAddress("recipient___________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+
+	// disbursements are due at heights 15, 20 and 25
+	id, err := k.AddSpend(ctx, "grant", recipient, amount, 5, 27)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+	require.Equal(t, uint64(2), k.GetNextSpendID(ctx))
+
+	for height := int64(11); height <= 30; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		k.ExecuteDueSpends(ctx)
+
+		paid := (height - 10) / 5
+		if paid > 3 {
+			paid = 3
+		}
+		expBalance := sdk.NewInt(100 * paid)
+		require.Equal(t, expBalance, app.BankKeeper.GetBalance(ctx, recipient, denom).Amount, "height %d", height)
+		require.Equal(t, sdk.NewInt(1_000).Sub(expBalance).ToDec(), app.DistrKeeper.GetFeePool(ctx).CommunityPool.AmountOf(denom), "height %d", height)
+	}
+
+	// the spend is removed after its last disbursement
+	_, found := k.GetSpend(ctx, id)
+	require.False(t, found)
+}
+
+func TestExecuteDueSpendsInsufficientCommunityPool(t *testing.T) {
+	app, ctx := setupRecurringSpends(t, sdk.NewInt(150))
+	k := app.RecurringSpendKeeper
+	recipient := sdk.AccAddress("recipient___________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+
+	id, err := k.AddSpend(ctx, "grant", recipient, amount, 5, 100)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(15)
+	k.ExecuteDueSpends(ctx)
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+
+	// the pool is short of funds, the disbursement is skipped but the spend
+	// stays scheduled
+	ctx = ctx.WithBlockHeight(20)
+	k.ExecuteDueSpends(ctx)
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+	require.Equal(t, sdk.NewDec(50), app.DistrKeeper.GetFeePool(ctx).CommunityPool.AmountOf(denom))
+
+	spend, found := k.GetSpend(ctx, id)
+	require.True(t, found)
+	require.Equal(t, int64(25), spend.NextHeight)
+}
+
+func TestCancelSpend(t *testing.T) {
+	app, ctx := setupRecurringSpends(t, sdk.NewInt(1_000))
+	k := app.RecurringSpendKeeper
+	recipient := sdk.AccAddress("recipient___________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+
+	id, err := k.AddSpend(ctx, "grant", recipient, amount, 5, 100)
+	require.NoError(t, err)
+
+	require.NoError(t, k.CancelSpend(ctx, id))
+	require.ErrorIs(t, k.CancelSpend(ctx, id), types.ErrSpendNotFound)
+
+	ctx = ctx.WithBlockHeight(15)
+	k.ExecuteDueSpends(ctx)
+	require.True(t, app.BankKeeper.GetBalance(ctx, recipient, denom).Amount.IsZero())
+}
+
+func TestAddSpendInvalid(t *testing.T) {
+	app, ctx := setupRecurringSpends(t, sdk.ZeroInt())
+	k := app.RecurringSpendKeeper
+	recipient := sdk.AccAddress("recipient___________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+
+	// the first disbursement would be after the end height
+	_, err := k.AddSpend(ctx, "grant", recipient, amount, 5, 14)
+	require.ErrorIs(t, err, types.ErrInvalidRecurringSpend)
+	_, err = k.AddSpend(ctx, "grant", recipient, amount, 0, 100)
+	require.ErrorIs(t, err, types.ErrInvalidRecurringSpend)
+	// an interval overflowing the height of the next payment
+	_, err = k.AddSpend(ctx, "grant", recipient, amount, math.MaxInt64+1, 100)
+	require.ErrorIs(t, err, types.ErrInvalidRecurringSpend)
+	require.Empty(t, k.GetAllSpends(ctx))
+	require.Equal(t, uint64(1), k.GetNextSpendID(ctx))
+}
+
+func TestValidateSpendCap(t *testing.T) {
+	app, ctx := setupRecurringSpends(t, sdk.NewInt(1_000))
+	k := app.RecurringSpendKeeper
+
+	specs := map[string]struct {
+		maxSpendFraction sdk.Dec
+		amount           sdk.Coins
+		expErr           bool
+	}{
+		"no cap": {
+			maxSpendFraction: sdk.ZeroDec(),
+			amount:           sdk.NewCoins(sdk.NewInt64Coin(denom, 5_000)),
+		},
+		"at the cap": {
+			maxSpendFraction: sdk.NewDecWithPrec(25, 2),
+			amount:           sdk.NewCoins(sdk.NewInt64Coin(denom, 250)),
+		},
+		"above the cap": {
+			maxSpendFraction: sdk.NewDecWithPrec(25, 2),
+			amount:           sdk.NewCoins(sdk.NewInt64Coin(denom, 251)),
+			expErr:           true,
+		},
+		"denom not in the pool": {
+			maxSpendFraction: sdk.NewDecWithPrec(25, 2),
+			amount:           sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)),
+			expErr:           true,
+		},
+		"whole pool": {
+			maxSpendFraction: sdk.OneDec(),
+			amount:           sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000)),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			k.SetParams(ctx, types.Params{MaxSpendFraction: spec.maxSpendFraction})
+			err := k.ValidateSpendCap(ctx, spec.amount)
+			if spec.expErr {
+				require.ErrorIs(t, err, types.ErrSpendCapExceeded)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	app, ctx := setupRecurringSpends(t, sdk.ZeroInt())
+	k := app.RecurringSpendKeeper
+	recipient := sdk.AccAddress("recipient___________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+
+	k.SetParams(ctx, types.Params{MaxSpendFraction: sdk.NewDecWithPrec(1, 1)})
+	_, err := k.AddSpend(ctx, "first", recipient, amount, 5, 100)
+	require.NoError(t, err)
+	_, err = k.AddSpend(ctx, "second", recipient, amount, 10, 100)
+	require.NoError(t, err)
+
+	genState := k.ExportGenesis(ctx)
+	require.NoError(t, genState.Validate())
+	require.Equal(t, uint64(3), genState.NextSpendId)
+	require.Len(t, genState.Spends, 2)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), genState.Params.MaxSpendFraction)
+
+	app2, ctx2 := setupRecurringSpends(t, sdk.ZeroInt())
+	app2.RecurringSpendKeeper.InitGenesis(ctx2, *genState)
+	require.Equal(t, genState, app2.RecurringSpendKeeper.ExportGenesis(ctx2))
+}
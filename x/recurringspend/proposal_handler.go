@@ -0,0 +1,32 @@
+package recurringspend
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v9/x/recurringspend/keeper"
+	"github.com/cosmos/gaia/v9/x/recurringspend/types"
+)
+
+// NewRecurringSpendProposalHandler returns the gov handler of the recurring
+// community pool spend proposals.
+func NewRecurringSpendProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.RecurringCommunityPoolSpendProposal:
+			recipient, err := sdk.AccAddressFromBech32(c.Recipient)
+			if err != nil {
+				return err
+			}
+			_, err = k.AddSpend(ctx, c.Title, recipient, c.Amount, c.Interval, c.EndHeight)
+			return err
+
+		case *types.CancelRecurringCommunityPoolSpendProposal:
+			return k.CancelSpend(ctx, c.SpendId)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized recurring spend proposal content type: %T", c)
+		}
+	}
+}
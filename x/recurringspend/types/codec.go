@@ -0,0 +1,15 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterInterfaces registers the recurring spend proposals as gov contents.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&RecurringCommunityPoolSpendProposal{},
+		&CancelRecurringCommunityPoolSpendProposal{},
+	)
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/recurringspend module sentinel errors
+var (
+	ErrInvalidRecurringSpend = sdkerrors.Register(ModuleName, 2, "invalid recurring spend")
+	ErrSpendNotFound         = sdkerrors.Register(ModuleName, 3, "recurring spend not found")
+	ErrSpendCapExceeded      = sdkerrors.Register(ModuleName, 4, "community pool spend cap exceeded")
+)
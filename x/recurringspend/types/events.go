@@ -0,0 +1,15 @@
+package types
+
+// recurring spend module event types
+const (
+	EventTypeRecurringSpendCreated   = "recurring_spend_created"
+	EventTypeRecurringSpendCancelled = "recurring_spend_cancelled"
+	EventTypeRecurringSpendPaid      = "recurring_spend_paid"
+	EventTypeRecurringSpendFailed    = "recurring_spend_failed"
+	EventTypeRecurringSpendEnded     = "recurring_spend_ended"
+
+	AttributeKeySpendID   = "spend_id"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyError     = "error"
+)
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultGenesisState returns the default genesis state, without recurring spends.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		NextSpendId: 1,
+		Params:      DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenIDs := make(map[uint64]bool, len(gs.Spends))
+	for _, spend := range gs.Spends {
+		if seenIDs[spend.Id] {
+			return fmt.Errorf("duplicate recurring spend id %d", spend.Id)
+		}
+		seenIDs[spend.Id] = true
+
+		if spend.Id >= gs.NextSpendId {
+			return fmt.Errorf("recurring spend id %d is not lower than the next spend id %d", spend.Id, gs.NextSpendId)
+		}
+		if err := spend.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate performs basic recurring spend validation.
+func (s RecurringSpend) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "recurring spend %d: invalid recipient address: %s", s.Id, err)
+	}
+	if !s.Amount.IsValid() || s.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidRecurringSpend, "recurring spend %d: invalid amount %s", s.Id, s.Amount)
+	}
+	if s.Interval == 0 {
+		return sdkerrors.Wrapf(ErrInvalidRecurringSpend, "recurring spend %d: interval must be positive", s.Id)
+	}
+	if s.EndHeight <= 0 || s.Interval > uint64(s.EndHeight) {
+		return sdkerrors.Wrapf(ErrInvalidRecurringSpend, "recurring spend %d: interval %d exceeds the end height %d", s.Id, s.Interval, s.EndHeight)
+	}
+	if s.NextHeight > s.EndHeight {
+		return sdkerrors.Wrapf(ErrInvalidRecurringSpend, "recurring spend %d: next height %d is after the end height %d", s.Id, s.NextHeight, s.EndHeight)
+	}
+
+	return nil
+}
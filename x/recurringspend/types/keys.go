@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the this module
+	ModuleName = "recurringspend"
+
+	// StoreKey is the default store key for the module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the module proposals
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// SpendKeyPrefix is the prefix of the recurring spends by id
+	SpendKeyPrefix = []byte{0x01}
+	// NextSpendIDKey is the key of the id given to the next recurring spend
+	NextSpendIDKey = []byte{0x02}
+)
+
+// GetSpendKey returns the store key of the recurring spend with the given id.
+func GetSpendKey(id uint64) []byte {
+	return append(append([]byte{}, SpendKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// ParamStoreKeyMaxSpendFraction store key
+var ParamStoreKeyMaxSpendFraction = []byte("MaxSpendFraction")
+
+// DefaultParams returns default parameters, without spend cap.
+func DefaultParams() Params {
+	return Params{
+		MaxSpendFraction: sdk.ZeroDec(),
+	}
+}
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Validate performs basic params validation.
+func (p Params) Validate() error {
+	return validateMaxSpendFraction(p.MaxSpendFraction)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxSpendFraction, &p.MaxSpendFraction, validateMaxSpendFraction,
+		),
+	}
+}
+
+// this requires the fraction to be within [0, 1]
+func validateMaxSpendFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Dec", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max spend fraction must be within [0, 1]: %s", v)
+	}
+
+	return nil
+}
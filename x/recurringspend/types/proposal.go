@@ -0,0 +1,150 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeRecurringCommunityPoolSpend defines the type for a RecurringCommunityPoolSpendProposal
+	ProposalTypeRecurringCommunityPoolSpend = "RecurringCommunityPoolSpend"
+	// ProposalTypeCancelRecurringCommunityPoolSpend defines the type for a CancelRecurringCommunityPoolSpendProposal
+	ProposalTypeCancelRecurringCommunityPoolSpend = "CancelRecurringCommunityPoolSpend"
+)
+
+var (
+	_ govtypes.Content = &RecurringCommunityPoolSpendProposal{}
+	_ govtypes.Content = &CancelRecurringCommunityPoolSpendProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRecurringCommunityPoolSpend)
+	govtypes.RegisterProposalTypeCodec(&RecurringCommunityPoolSpendProposal{}, "gaia/RecurringCommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelRecurringCommunityPoolSpend)
+	govtypes.RegisterProposalTypeCodec(&CancelRecurringCommunityPoolSpendProposal{}, "gaia/CancelRecurringCommunityPoolSpendProposal")
+}
+
+// NewRecurringCommunityPoolSpendProposal creates a new recurring community pool spend proposal.
+func NewRecurringCommunityPoolSpendProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins, interval uint64, endHeight int64) *RecurringCommunityPoolSpendProposal {
+	return &RecurringCommunityPoolSpendProposal{
+		Title:       title,
+		Description: description,
+		Recipient:   recipient.String(),
+		Amount:      amount,
+		Interval:    interval,
+		EndHeight:   endHeight,
+	}
+}
+
+// GetTitle returns the title of a recurring community pool spend proposal.
+func (p *RecurringCommunityPoolSpendProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a recurring community pool spend proposal.
+func (p *RecurringCommunityPoolSpendProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a recurring community pool spend proposal.
+func (p *RecurringCommunityPoolSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a recurring community pool spend proposal.
+func (p *RecurringCommunityPoolSpendProposal) ProposalType() string {
+	return ProposalTypeRecurringCommunityPoolSpend
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RecurringCommunityPoolSpendProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+	if !p.Amount.IsValid() || p.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidRecurringSpend, "invalid amount %s", p.Amount)
+	}
+	if p.Interval == 0 {
+		return sdkerrors.Wrap(ErrInvalidRecurringSpend, "interval must be positive")
+	}
+	if p.EndHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalidRecurringSpend, "end height must be positive")
+	}
+	// a spend cannot pay out after its end height, and a larger interval
+	// would overflow the height of the next payment
+	if p.Interval > uint64(p.EndHeight) {
+		return sdkerrors.Wrapf(ErrInvalidRecurringSpend, "interval %d exceeds the end height %d", p.Interval, p.EndHeight)
+	}
+
+	return nil
+}
+
+// TotalAmount returns the total amount paid by the spend when it is set up at
+// the given height, i.e. the amount of each payment times the number of
+// payments until the end height. The later the spend is set up, the fewer
+// payments it makes, so the amount computed at the submission of the proposal
+// bounds the amount actually paid.
+func (p *RecurringCommunityPoolSpendProposal) TotalAmount(height int64) sdk.Coins {
+	if p.Interval == 0 || p.EndHeight <= height {
+		return sdk.NewCoins()
+	}
+
+	payments := sdk.NewIntFromUint64(uint64(p.EndHeight-height) / p.Interval)
+	total := sdk.NewCoins()
+	for _, coin := range p.Amount {
+		total = total.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(payments)))
+	}
+	return total
+}
+
+// String implements the Stringer interface.
+func (p RecurringCommunityPoolSpendProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Recurring Community Pool Spend Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+  Interval:    %d
+  End Height:  %d
+`, p.Title, p.Description, p.Recipient, p.Amount, p.Interval, p.EndHeight))
+	return b.String()
+}
+
+// NewCancelRecurringCommunityPoolSpendProposal creates a new cancel recurring community pool spend proposal.
+func NewCancelRecurringCommunityPoolSpendProposal(title, description string, spendID uint64) *CancelRecurringCommunityPoolSpendProposal {
+	return &CancelRecurringCommunityPoolSpendProposal{
+		Title:       title,
+		Description: description,
+		SpendId:     spendID,
+	}
+}
+
+// GetTitle returns the title of a cancel recurring community pool spend proposal.
+func (p *CancelRecurringCommunityPoolSpendProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a cancel recurring community pool spend proposal.
+func (p *CancelRecurringCommunityPoolSpendProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a cancel recurring community pool spend proposal.
+func (p *CancelRecurringCommunityPoolSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel recurring community pool spend proposal.
+func (p *CancelRecurringCommunityPoolSpendProposal) ProposalType() string {
+	return ProposalTypeCancelRecurringCommunityPoolSpend
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CancelRecurringCommunityPoolSpendProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+// String implements the Stringer interface.
+func (p CancelRecurringCommunityPoolSpendProposal) String() string {
+	return fmt.Sprintf(`Cancel Recurring Community Pool Spend Proposal:
+  Title:       %s
+  Description: %s
+  Spend ID:    %d
+`, p.Title, p.Description, p.SpendId)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurringCommunityPoolSpendProposalValidateBasic(t *testing.T) {
+	recipient := sdk.AccAddress("recipient___________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	tests := map[string]struct {
+		interval  uint64
+		endHeight int64
+		expectErr bool
+	}{
+		"valid spend, pass": {
+			10,
+			100,
+			false,
+		},
+		"interval of the end height, pass": {
+			100,
+			100,
+			false,
+		},
+		"zero interval, fail": {
+			0,
+			100,
+			true,
+		},
+		"interval after the end height, fail": {
+			101,
+			100,
+			true,
+		},
+		"interval overflowing a height, fail": {
+			math.MaxInt64 + 1,
+			100,
+			true,
+		},
+		"zero end height, fail": {
+			10,
+			0,
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewRecurringCommunityPoolSpendProposal("title", "description", recipient, amount, test.interval, test.endHeight)
+			err := p.ValidateBasic()
+			if test.expectErr {
+				require.ErrorIs(t, err, ErrInvalidRecurringSpend)
+				return
+			}
+			require.NoError(t, err)
+			// the payments fit within the end height
+			require.False(t, p.TotalAmount(0).IsZero())
+		})
+	}
+}
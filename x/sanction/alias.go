@@ -0,0 +1,9 @@
+package sanction
+
+import (
+	"github.com/cosmos/gaia/v9/x/sanction/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v9/x/sanction/types"
+)
+
+// GetCmdSubmitAddSanctionedAddressesProposal implements the command to submit
+// an add sanctioned addresses proposal.
+func GetCmdSubmitAddSanctionedAddressesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-sanctioned-addresses [address]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to sanction addresses",
+		Long: `Submit a proposal to sanction addresses along with an initial deposit.
+Sanctioned addresses can neither send nor receive funds.
+
+Example:
+$ gaiad tx gov submit-proposal add-sanctioned-addresses cosmos1... cosmos1... --title="Sanction" --description="Sanction the addresses" --deposit=1000uatom --from=<key_or_address>
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitSanctionProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewAddSanctionedAddressesProposal(title, description, args)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitRemoveSanctionedAddressesProposal implements the command to
+// submit a remove sanctioned addresses proposal.
+func GetCmdSubmitRemoveSanctionedAddressesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-sanctioned-addresses [address]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to lift the sanction of addresses",
+		Long: `Submit a proposal to lift the sanction of addresses along with an initial deposit.
+
+Example:
+$ gaiad tx gov submit-proposal remove-sanctioned-addresses cosmos1... --title="Lift sanction" --description="Lift the sanction of the address" --deposit=1000uatom --from=<key_or_address>
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitSanctionProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewRemoveSanctionedAddressesProposal(title, description, args)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+}
+
+func submitSanctionProposal(cmd *cobra.Command, newContent func(title, description string) govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/cosmos/gaia/v9/x/sanction/client/cli"
+)
+
+var (
+	AddSanctionedAddressesProposalHandler    = govclient.NewProposalHandler(cli.GetCmdSubmitAddSanctionedAddressesProposal, emptyRestHandler("add_sanctioned_addresses"))
+	RemoveSanctionedAddressesProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitRemoveSanctionedAddressesProposal, emptyRestHandler("remove_sanctioned_addresses"))
+)
+
+// emptyRestHandler returns a handler rejecting the submission of the proposal
+// through the legacy REST routes, which are not supported.
+func emptyRestHandler(subRoute string) govclient.RESTHandlerFn {
+	return func(client.Context) govrest.ProposalRESTHandler {
+		return govrest.ProposalRESTHandler{
+			SubRoute: subRoute,
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for sanction proposals")
+			},
+		}
+	}
+}
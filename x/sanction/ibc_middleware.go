@@ -0,0 +1,53 @@
+package sanction
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/cosmos/gaia/v9/x/sanction/keeper"
+	"github.com/cosmos/gaia/v9/x/sanction/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware rejects the transfer packets received for a sanctioned
+// recipient with an error acknowledgement, so that the funds are refunded to
+// the sender on the counterparty chain. The other callbacks are passed
+// through to the wrapped IBC module.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new sanction IBCMiddleware wrapping the given
+// transfer stack.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not a transfer packet, left to the wrapped module to reject
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	// an invalid receiver is rejected by the wrapped module
+	if receiver, err := sdk.AccAddressFromBech32(data.Receiver); err == nil && im.keeper.IsSanctioned(ctx, receiver) {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(types.ErrSanctionedAddress, "recipient %s", receiver))
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
@@ -0,0 +1,72 @@
+package sanction_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/sanction"
+)
+
+// mockTransferModule acknowledges every packet successfully.
+type mockTransferModule struct {
+	porttypes.IBCModule
+	received int
+}
+
+func (m *mockTransferModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	m.received++
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func TestIBCMiddlewareOnRecvPacket(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	sanctioned := sdk.AccAddress("sanctioned__________")
+	alice := sdk.AccAddress("alice_______________")
+	app.SanctionKeeper.SetSanctioned(ctx, sanctioned)
+
+	newPacket := func(receiver string) channeltypes.Packet {
+		data := ibctransfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", receiver)
+		return channeltypes.Packet{Data: data.GetBytes()}
+	}
+
+	specs := map[string]struct {
+		packet     channeltypes.Packet
+		expSuccess bool
+	}{
+		"normal recipient": {
+			packet:     newPacket(alice.String()),
+			expSuccess: true,
+		},
+		"sanctioned recipient": {
+			packet: newPacket(sanctioned.String()),
+		},
+		"not a transfer packet": {
+			packet:     channeltypes.Packet{Data: []byte("not json")},
+			expSuccess: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			transferModule := &mockTransferModule{}
+			middleware := sanction.NewIBCMiddleware(transferModule, app.SanctionKeeper)
+
+			ack := middleware.OnRecvPacket(ctx, spec.packet, nil)
+			require.Equal(t, spec.expSuccess, ack.Success())
+			if spec.expSuccess {
+				require.Equal(t, 1, transferModule.received)
+			} else {
+				require.Zero(t, transferModule.received)
+			}
+		})
+	}
+}
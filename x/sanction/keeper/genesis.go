@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/sanction/types"
+)
+
+// InitGenesis initializes the sanctioned addresses from the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	for _, address := range genState.SanctionedAddresses {
+		k.SetSanctioned(ctx, sdk.MustAccAddressFromBech32(address))
+	}
+}
+
+// ExportGenesis returns the sanctioned addresses as a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		SanctionedAddresses: k.GetAllSanctionedAddresses(ctx),
+	}
+}
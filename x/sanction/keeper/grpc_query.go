@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v9/x/sanction/types"
+)
+
+// Querier implements the sanction gRPC query service.
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// NewQuerier returns the sanction gRPC querier.
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// SanctionedAddresses returns the sanctioned addresses
+func (q Querier) SanctionedAddresses(stdCtx context.Context, req *types.QuerySanctionedAddressesRequest) (*types.QuerySanctionedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.SanctionedAddressKeyPrefix)
+
+	var addresses []string
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		addresses = append(addresses, addressFromKey(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySanctionedAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+// IsSanctioned returns whether an address is sanctioned
+func (q Querier) IsSanctioned(stdCtx context.Context, req *types.QueryIsSanctionedRequest) (*types.QueryIsSanctionedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	return &types.QueryIsSanctionedResponse{Sanctioned: q.Keeper.IsSanctioned(ctx, addr)}, nil
+}
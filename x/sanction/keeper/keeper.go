@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/gaia/v9/x/sanction/types"
+)
+
+// Keeper of the sanction store
+type Keeper struct {
+	storeKey storetypes.StoreKey
+}
+
+// NewKeeper creates a new sanction Keeper instance
+func NewKeeper(key storetypes.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// IsSanctioned returns whether the address is sanctioned.
+func (k Keeper) IsSanctioned(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetSanctionedAddressKey(addr))
+}
+
+// SendRestriction returns an error when funds cannot be transferred from the
+// sender to the recipient because either of them is sanctioned. An empty
+// sender or recipient is not checked.
+func (k Keeper) SendRestriction(ctx sdk.Context, from, to sdk.AccAddress) error {
+	if !from.Empty() && k.IsSanctioned(ctx, from) {
+		return sdkerrors.Wrapf(types.ErrSanctionedAddress, "sender %s", from)
+	}
+	if !to.Empty() && k.IsSanctioned(ctx, to) {
+		return sdkerrors.Wrapf(types.ErrSanctionedAddress, "recipient %s", to)
+	}
+	return nil
+}
+
+// SetSanctioned adds the address to the sanctioned addresses.
+func (k Keeper) SetSanctioned(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.GetSanctionedAddressKey(addr), []byte{0x01})
+}
+
+// DeleteSanctioned removes the address from the sanctioned addresses.
+func (k Keeper) DeleteSanctioned(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetSanctionedAddressKey(addr))
+}
+
+// IterateSanctionedAddresses iterates over the sanctioned addresses. The
+// iteration stops when cb returns true.
+func (k Keeper) IterateSanctionedAddresses(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SanctionedAddressKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(addressFromKey(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// GetAllSanctionedAddresses returns all the sanctioned addresses.
+func (k Keeper) GetAllSanctionedAddresses(ctx sdk.Context) []string {
+	var addresses []string
+	k.IterateSanctionedAddresses(ctx, func(addr sdk.AccAddress) bool {
+		addresses = append(addresses, addr.String())
+		return false
+	})
+	return addresses
+}
+
+// Sanction adds the addresses to the sanctioned addresses.
+func (k Keeper) Sanction(ctx sdk.Context, addresses []string) error {
+	addrs, err := parseAddresses(addresses)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		k.SetSanctioned(ctx, addr)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeAddressSanctioned,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		))
+	}
+
+	return nil
+}
+
+// Unsanction removes the addresses from the sanctioned addresses.
+func (k Keeper) Unsanction(ctx sdk.Context, addresses []string) error {
+	addrs, err := parseAddresses(addresses)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		if !k.IsSanctioned(ctx, addr) {
+			return sdkerrors.Wrapf(types.ErrInvalidAddresses, "address %s is not sanctioned", addr)
+		}
+	}
+	for _, addr := range addrs {
+		k.DeleteSanctioned(ctx, addr)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeAddressUnsanctioned,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		))
+	}
+
+	return nil
+}
+
+func parseAddresses(addresses []string) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, len(addresses))
+	for i, address := range addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s: %s", address, err)
+		}
+		addrs[i] = addr
+	}
+	return addrs, nil
+}
+
+// addressFromKey returns the address of a sanctioned address key stripped of
+// its prefix.
+func addressFromKey(key []byte) sdk.AccAddress {
+	// the first byte is the length of the address
+	return sdk.AccAddress(key[1:])
+}
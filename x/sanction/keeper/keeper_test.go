@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/sanction/keeper"
+	"github.com/cosmos/gaia/v9/x/sanction/types"
+)
+
+func TestSanction(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.SanctionKeeper
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+
+	// the list is empty by default
+	require.Empty(t, k.GetAllSanctionedAddresses(ctx))
+	require.NoError(t, k.SendRestriction(ctx, alice, bob))
+
+	require.NoError(t, k.Sanction(ctx, []string{alice.String()}))
+	require.True(t, k.IsSanctioned(ctx, alice))
+	require.False(t, k.IsSanctioned(ctx, bob))
+	require.Equal(t, []string{alice.String()}, k.GetAllSanctionedAddresses(ctx))
+
+	require.ErrorIs(t, k.SendRestriction(ctx, alice, bob), types.ErrSanctionedAddress)
+	require.ErrorIs(t, k.SendRestriction(ctx, bob, alice), types.ErrSanctionedAddress)
+	require.NoError(t, k.SendRestriction(ctx, bob, nil))
+
+	q := keeper.NewQuerier(k)
+	res, err := q.IsSanctioned(sdk.WrapSDKContext(ctx), &types.QueryIsSanctionedRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.True(t, res.Sanctioned)
+	addrsRes, err := q.SanctionedAddresses(sdk.WrapSDKContext(ctx), &types.QuerySanctionedAddressesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{alice.String()}, addrsRes.Addresses)
+
+	// all the addresses must be sanctioned to lift the sanction
+	require.ErrorIs(t, k.Unsanction(ctx, []string{alice.String(), bob.String()}), types.ErrInvalidAddresses)
+	require.True(t, k.IsSanctioned(ctx, alice))
+
+	require.NoError(t, k.Unsanction(ctx, []string{alice.String()}))
+	require.False(t, k.IsSanctioned(ctx, alice))
+	require.NoError(t, k.SendRestriction(ctx, alice, bob))
+}
+
+func TestGenesis(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.SanctionKeeper
+
+	alice := sdk.AccAddress("alice_______________")
+	genState := types.GenesisState{SanctionedAddresses: []string{alice.String()}}
+	require.NoError(t, genState.Validate())
+
+	k.InitGenesis(ctx, genState)
+	require.True(t, k.IsSanctioned(ctx, alice))
+	require.Equal(t, &genState, k.ExportGenesis(ctx))
+
+	genState.SanctionedAddresses = append(genState.SanctionedAddresses, alice.String())
+	require.Error(t, genState.Validate())
+	require.Error(t, types.GenesisState{SanctionedAddresses: []string{"invalid"}}.Validate())
+}
@@ -0,0 +1,27 @@
+package sanction
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v9/x/sanction/keeper"
+	"github.com/cosmos/gaia/v9/x/sanction/types"
+)
+
+// NewSanctionProposalHandler returns the gov handler of the sanction
+// proposals.
+func NewSanctionProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddSanctionedAddressesProposal:
+			return k.Sanction(ctx, c.Addresses)
+
+		case *types.RemoveSanctionedAddressesProposal:
+			return k.Unsanction(ctx, c.Addresses)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized sanction proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/sanction module sentinel errors
+var (
+	ErrSanctionedAddress = sdkerrors.Register(ModuleName, 2, "sanctioned address")
+	ErrInvalidAddresses  = sdkerrors.Register(ModuleName, 3, "invalid addresses")
+)
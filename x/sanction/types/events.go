@@ -0,0 +1,9 @@
+package types
+
+// sanction module event types
+const (
+	EventTypeAddressSanctioned   = "address_sanctioned"
+	EventTypeAddressUnsanctioned = "address_unsanctioned"
+
+	AttributeKeyAddress = "address"
+)
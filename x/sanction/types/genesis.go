@@ -0,0 +1,12 @@
+package types
+
+// DefaultGenesisState returns the default genesis state, without sanctioned
+// addresses.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	return ValidateAddresses(gs.SanctionedAddresses)
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the this module
+	ModuleName = "sanction"
+
+	// StoreKey is the default store key for the module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the module proposals
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+// SanctionedAddressKeyPrefix is the prefix of the sanctioned addresses
+var SanctionedAddressKeyPrefix = []byte{0x01}
+
+// GetSanctionedAddressKey returns the store key of a sanctioned address.
+func GetSanctionedAddressKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, SanctionedAddressKeyPrefix...), address.MustLengthPrefix(addr)...)
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeAddSanctionedAddresses defines the type for a AddSanctionedAddressesProposal
+	ProposalTypeAddSanctionedAddresses = "AddSanctionedAddresses"
+	// ProposalTypeRemoveSanctionedAddresses defines the type for a RemoveSanctionedAddressesProposal
+	ProposalTypeRemoveSanctionedAddresses = "RemoveSanctionedAddresses"
+)
+
+var (
+	_ govtypes.Content = &AddSanctionedAddressesProposal{}
+	_ govtypes.Content = &RemoveSanctionedAddressesProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddSanctionedAddresses)
+	govtypes.RegisterProposalTypeCodec(&AddSanctionedAddressesProposal{}, "gaia/AddSanctionedAddressesProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveSanctionedAddresses)
+	govtypes.RegisterProposalTypeCodec(&RemoveSanctionedAddressesProposal{}, "gaia/RemoveSanctionedAddressesProposal")
+}
+
+// ValidateAddresses checks that the addresses are valid and not duplicated.
+func ValidateAddresses(addresses []string) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, addr := range addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s: %s", addr, err)
+		}
+		if seen[addr] {
+			return sdkerrors.Wrapf(ErrInvalidAddresses, "duplicate address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+func validateProposalAddresses(addresses []string) error {
+	if len(addresses) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAddresses, "no address given")
+	}
+	return ValidateAddresses(addresses)
+}
+
+// NewAddSanctionedAddressesProposal creates a new add sanctioned addresses proposal.
+func NewAddSanctionedAddressesProposal(title, description string, addresses []string) *AddSanctionedAddressesProposal {
+	return &AddSanctionedAddressesProposal{
+		Title:       title,
+		Description: description,
+		Addresses:   addresses,
+	}
+}
+
+// GetTitle returns the title of an add sanctioned addresses proposal.
+func (p *AddSanctionedAddressesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add sanctioned addresses proposal.
+func (p *AddSanctionedAddressesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add sanctioned addresses proposal.
+func (p *AddSanctionedAddressesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add sanctioned addresses proposal.
+func (p *AddSanctionedAddressesProposal) ProposalType() string {
+	return ProposalTypeAddSanctionedAddresses
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddSanctionedAddressesProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return validateProposalAddresses(p.Addresses)
+}
+
+// String implements the Stringer interface.
+func (p AddSanctionedAddressesProposal) String() string {
+	return fmt.Sprintf(`Add Sanctioned Addresses Proposal:
+  Title:       %s
+  Description: %s
+  Addresses:   %s
+`, p.Title, p.Description, strings.Join(p.Addresses, ", "))
+}
+
+// NewRemoveSanctionedAddressesProposal creates a new remove sanctioned addresses proposal.
+func NewRemoveSanctionedAddressesProposal(title, description string, addresses []string) *RemoveSanctionedAddressesProposal {
+	return &RemoveSanctionedAddressesProposal{
+		Title:       title,
+		Description: description,
+		Addresses:   addresses,
+	}
+}
+
+// GetTitle returns the title of a remove sanctioned addresses proposal.
+func (p *RemoveSanctionedAddressesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove sanctioned addresses proposal.
+func (p *RemoveSanctionedAddressesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove sanctioned addresses proposal.
+func (p *RemoveSanctionedAddressesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove sanctioned addresses proposal.
+func (p *RemoveSanctionedAddressesProposal) ProposalType() string {
+	return ProposalTypeRemoveSanctionedAddresses
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveSanctionedAddressesProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return validateProposalAddresses(p.Addresses)
+}
+
+// String implements the Stringer interface.
+func (p RemoveSanctionedAddressesProposal) String() string {
+	return fmt.Sprintf(`Remove Sanctioned Addresses Proposal:
+  Title:       %s
+  Description: %s
+  Addresses:   %s
+`, p.Title, p.Description, strings.Join(p.Addresses, ", "))
+}
@@ -0,0 +1,1037 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/sanction/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QuerySanctionedAddressesRequest is the request type for the
+// Query/SanctionedAddresses RPC method.
+type QuerySanctionedAddressesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySanctionedAddressesRequest) Reset()         { *m = QuerySanctionedAddressesRequest{} }
+func (m *QuerySanctionedAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySanctionedAddressesRequest) ProtoMessage()    {}
+func (*QuerySanctionedAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b97e617d6dd7d069, []int{0}
+}
+func (m *QuerySanctionedAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySanctionedAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySanctionedAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySanctionedAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySanctionedAddressesRequest.Merge(m, src)
+}
+func (m *QuerySanctionedAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySanctionedAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySanctionedAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySanctionedAddressesRequest proto.InternalMessageInfo
+
+func (m *QuerySanctionedAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySanctionedAddressesResponse is the response type for the
+// Query/SanctionedAddresses RPC method.
+type QuerySanctionedAddressesResponse struct {
+	Addresses  []string            `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySanctionedAddressesResponse) Reset()         { *m = QuerySanctionedAddressesResponse{} }
+func (m *QuerySanctionedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySanctionedAddressesResponse) ProtoMessage()    {}
+func (*QuerySanctionedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b97e617d6dd7d069, []int{1}
+}
+func (m *QuerySanctionedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySanctionedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySanctionedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySanctionedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySanctionedAddressesResponse.Merge(m, src)
+}
+func (m *QuerySanctionedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySanctionedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySanctionedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySanctionedAddressesResponse proto.InternalMessageInfo
+
+func (m *QuerySanctionedAddressesResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QuerySanctionedAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIsSanctionedRequest is the request type for the Query/IsSanctioned RPC
+// method.
+type QueryIsSanctionedRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryIsSanctionedRequest) Reset()         { *m = QueryIsSanctionedRequest{} }
+func (m *QueryIsSanctionedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsSanctionedRequest) ProtoMessage()    {}
+func (*QueryIsSanctionedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b97e617d6dd7d069, []int{2}
+}
+func (m *QueryIsSanctionedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsSanctionedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsSanctionedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsSanctionedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsSanctionedRequest.Merge(m, src)
+}
+func (m *QueryIsSanctionedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsSanctionedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsSanctionedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsSanctionedRequest proto.InternalMessageInfo
+
+func (m *QueryIsSanctionedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryIsSanctionedResponse is the response type for the Query/IsSanctioned
+// RPC method.
+type QueryIsSanctionedResponse struct {
+	Sanctioned bool `protobuf:"varint,1,opt,name=sanctioned,proto3" json:"sanctioned,omitempty"`
+}
+
+func (m *QueryIsSanctionedResponse) Reset()         { *m = QueryIsSanctionedResponse{} }
+func (m *QueryIsSanctionedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsSanctionedResponse) ProtoMessage()    {}
+func (*QueryIsSanctionedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b97e617d6dd7d069, []int{3}
+}
+func (m *QueryIsSanctionedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsSanctionedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsSanctionedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsSanctionedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsSanctionedResponse.Merge(m, src)
+}
+func (m *QueryIsSanctionedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsSanctionedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsSanctionedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsSanctionedResponse proto.InternalMessageInfo
+
+func (m *QueryIsSanctionedResponse) GetSanctioned() bool {
+	if m != nil {
+		return m.Sanctioned
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QuerySanctionedAddressesRequest)(nil), "gaia.sanction.v1beta1.QuerySanctionedAddressesRequest")
+	proto.RegisterType((*QuerySanctionedAddressesResponse)(nil), "gaia.sanction.v1beta1.QuerySanctionedAddressesResponse")
+	proto.RegisterType((*QueryIsSanctionedRequest)(nil), "gaia.sanction.v1beta1.QueryIsSanctionedRequest")
+	proto.RegisterType((*QueryIsSanctionedResponse)(nil), "gaia.sanction.v1beta1.QueryIsSanctionedResponse")
+}
+
+func init() { proto.RegisterFile("gaia/sanction/v1beta1/query.proto", fileDescriptor_b97e617d6dd7d069) }
+
+var fileDescriptor_b97e617d6dd7d069 = []byte{
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc6, 0x7b, 0x15, 0xff, 0xf4, 0x74, 0x3a, 0x11, 0x62, 0x28, 0x31, 0x66, 0xb0, 0xa5, 0xc8,
+	0x9d, 0xad, 0xa2, 0x83, 0x53, 0x1d, 0x14, 0x37, 0x8d, 0x9b, 0xdb, 0xa5, 0x3d, 0x62, 0xc0, 0xe6,
+	0xd2, 0x5e, 0x2a, 0x16, 0x71, 0x71, 0x73, 0x13, 0xfc, 0x0c, 0xee, 0x7e, 0x0c, 0xc7, 0x82, 0x8b,
+	0xa3, 0xa4, 0x7e, 0x10, 0xc9, 0xe5, 0xd2, 0xb4, 0x10, 0xad, 0x6e, 0xc9, 0x9b, 0xe7, 0x79, 0x9f,
+	0xdf, 0xfb, 0x5e, 0x0e, 0x6e, 0xba, 0xd4, 0xa3, 0x44, 0x50, 0xbf, 0x15, 0x7a, 0xdc, 0x27, 0x37,
+	0x75, 0x87, 0x85, 0xb4, 0x4e, 0xba, 0x7d, 0xd6, 0x1b, 0xe0, 0xa0, 0xc7, 0x43, 0x8e, 0xd6, 0x62,
+	0x09, 0x4e, 0x25, 0x58, 0x49, 0xf4, 0xb2, 0xcb, 0xb9, 0x7b, 0xcd, 0x08, 0x0d, 0x3c, 0x42, 0x7d,
+	0x9f, 0x87, 0x34, 0xfe, 0x2c, 0x12, 0x93, 0x5e, 0x6b, 0x71, 0xd1, 0xe1, 0x82, 0x38, 0x54, 0xb0,
+	0xa4, 0xdb, 0xb8, 0x77, 0x40, 0x5d, 0xcf, 0x97, 0xe2, 0x44, 0x6b, 0x79, 0x70, 0xe3, 0x3c, 0x56,
+	0x5c, 0xa8, 0x08, 0xd6, 0x6e, 0xb6, 0xdb, 0x3d, 0x26, 0x04, 0x13, 0x36, 0xeb, 0xf6, 0x99, 0x08,
+	0xd1, 0x31, 0x84, 0x99, 0x4d, 0x03, 0x26, 0xa8, 0x2e, 0x37, 0xb6, 0x70, 0x92, 0x81, 0xe3, 0x0c,
+	0x9c, 0x10, 0xab, 0x0c, 0x7c, 0x46, 0x5d, 0xa6, 0xbc, 0xf6, 0x84, 0xd3, 0x7a, 0x04, 0xd0, 0xfc,
+	0x39, 0x4b, 0x04, 0xdc, 0x17, 0x0c, 0x95, 0x61, 0x89, 0xa6, 0x45, 0x0d, 0x98, 0x73, 0xd5, 0x92,
+	0x9d, 0x15, 0xd0, 0xc9, 0x14, 0x4a, 0x51, 0xa2, 0x54, 0x66, 0xa2, 0x24, 0xad, 0xa7, 0x58, 0xf6,
+	0xa0, 0x26, 0x51, 0x4e, 0x45, 0x06, 0x93, 0xce, 0xab, 0xc1, 0x45, 0x95, 0x28, 0x87, 0x2d, 0xd9,
+	0xe9, 0xab, 0x75, 0x08, 0xd7, 0x73, 0x5c, 0x8a, 0xdc, 0x80, 0x50, 0x8c, 0xab, 0xd2, 0xb9, 0x64,
+	0x4f, 0x54, 0x1a, 0x51, 0x11, 0xce, 0x4b, 0x37, 0x7a, 0x05, 0x70, 0x35, 0x67, 0x07, 0x68, 0x1f,
+	0xe7, 0x9e, 0x36, 0x9e, 0x71, 0x40, 0xfa, 0xc1, 0xbf, 0x7d, 0x09, 0xb2, 0x55, 0x7d, 0x78, 0xff,
+	0x7a, 0x2e, 0x5a, 0xc8, 0x24, 0xf9, 0x7f, 0x62, 0xb6, 0xf8, 0x17, 0x00, 0x57, 0x26, 0xa7, 0x46,
+	0xe4, 0xb7, 0xcc, 0x9c, 0xad, 0xea, 0x3b, 0x7f, 0x37, 0x28, 0xba, 0x86, 0xa4, 0xdb, 0x46, 0xb5,
+	0x59, 0x74, 0xe4, 0x4e, 0x3d, 0xde, 0x1f, 0x35, 0xdf, 0x22, 0x03, 0x0c, 0x23, 0x03, 0x7c, 0x46,
+	0x06, 0x78, 0x1a, 0x19, 0x85, 0xe1, 0xc8, 0x28, 0x7c, 0x8c, 0x8c, 0xc2, 0x65, 0xc5, 0xf5, 0xc2,
+	0xab, 0xbe, 0x83, 0x5b, 0xbc, 0x43, 0xd4, 0xfd, 0x90, 0x6d, 0x6f, 0xb3, 0xc6, 0xe1, 0x20, 0x60,
+	0xc2, 0x59, 0x90, 0x17, 0x63, 0xf7, 0x7b, 0x00, 0x48, 0x1c, 0xb9, 0xa1, 0x9e, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// SanctionedAddresses returns the sanctioned addresses.
+	SanctionedAddresses(ctx context.Context, in *QuerySanctionedAddressesRequest, opts ...grpc.CallOption) (*QuerySanctionedAddressesResponse, error)
+	// IsSanctioned returns whether an address is sanctioned.
+	IsSanctioned(ctx context.Context, in *QueryIsSanctionedRequest, opts ...grpc.CallOption) (*QueryIsSanctionedResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SanctionedAddresses(ctx context.Context, in *QuerySanctionedAddressesRequest, opts ...grpc.CallOption) (*QuerySanctionedAddressesResponse, error) {
+	out := new(QuerySanctionedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/gaia.sanction.v1beta1.Query/SanctionedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsSanctioned(ctx context.Context, in *QueryIsSanctionedRequest, opts ...grpc.CallOption) (*QueryIsSanctionedResponse, error) {
+	out := new(QueryIsSanctionedResponse)
+	err := c.cc.Invoke(ctx, "/gaia.sanction.v1beta1.Query/IsSanctioned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// SanctionedAddresses returns the sanctioned addresses.
+	SanctionedAddresses(context.Context, *QuerySanctionedAddressesRequest) (*QuerySanctionedAddressesResponse, error)
+	// IsSanctioned returns whether an address is sanctioned.
+	IsSanctioned(context.Context, *QueryIsSanctionedRequest) (*QueryIsSanctionedResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) SanctionedAddresses(ctx context.Context, req *QuerySanctionedAddressesRequest) (*QuerySanctionedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SanctionedAddresses not implemented")
+}
+func (*UnimplementedQueryServer) IsSanctioned(ctx context.Context, req *QueryIsSanctionedRequest) (*QueryIsSanctionedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsSanctioned not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_SanctionedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySanctionedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SanctionedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.sanction.v1beta1.Query/SanctionedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SanctionedAddresses(ctx, req.(*QuerySanctionedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsSanctioned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsSanctionedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsSanctioned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.sanction.v1beta1.Query/IsSanctioned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsSanctioned(ctx, req.(*QueryIsSanctionedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.sanction.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SanctionedAddresses",
+			Handler:    _Query_SanctionedAddresses_Handler,
+		},
+		{
+			MethodName: "IsSanctioned",
+			Handler:    _Query_IsSanctioned_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/sanction/v1beta1/query.proto",
+}
+
+func (m *QuerySanctionedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySanctionedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySanctionedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySanctionedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySanctionedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySanctionedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsSanctionedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsSanctionedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsSanctionedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsSanctionedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsSanctionedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsSanctionedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sanctioned {
+		i--
+		if m.Sanctioned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySanctionedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySanctionedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsSanctionedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsSanctionedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sanctioned {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySanctionedAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySanctionedAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySanctionedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySanctionedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySanctionedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySanctionedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsSanctionedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsSanctionedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsSanctionedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsSanctionedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsSanctionedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsSanctionedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sanctioned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sanctioned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,9 @@
+package spendlimit
+
+import (
+	"github.com/cosmos/gaia/v9/x/spendlimit/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
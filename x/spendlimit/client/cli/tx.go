@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gaia/v9/x/spendlimit/types"
+)
+
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Spending limit transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		NewSetSpendLimitCmd(),
+		NewRemoveSpendLimitCmd(),
+	)
+	return txCmd
+}
+
+func NewSetSpendLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set [limit] [window]",
+		Short: "Limit the amount the sender can send over a rolling window of blocks",
+		Long: `Limit the amount the sender can send over a rolling window of blocks.
+
+The bank sends, the multi-send inputs and the IBC transfers of the sender,
+including those executed through authz, are rejected when the amount sent
+within the most recent window blocks would exceed the limit. Only the denoms of
+the limit are limited. An existing limit of the sender is replaced, once its
+window elapses when the new limit is looser.
+
+Example:
+	gaiad tx spendlimit set 1000000000uatom 1000 --from mykey
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			window, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSpendLimit(clientCtx.GetFromAddress(), limit, window)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRemoveSpendLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove the spending limit of the sender",
+		Long: `Remove the spending limit of the sender once its window elapses. The limit
+stays in force until then.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveSpendLimit(clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/spendlimit/types"
+)
+
+// InitGenesis initializes the spending limits and the outflows of the limited
+// accounts from the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	for _, limit := range genState.SpendLimits {
+		k.setSpendLimit(ctx, limit)
+	}
+	for _, outflow := range genState.Outflows {
+		k.setOutflow(ctx, outflow)
+	}
+}
+
+// ExportGenesis returns the spending limits and the outflows of the limited
+// accounts as a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var genState types.GenesisState
+	k.IterateSpendLimits(ctx, func(limit types.SpendLimit) bool {
+		genState.SpendLimits = append(genState.SpendLimits, limit)
+		return false
+	})
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutflowKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var outflow types.Outflow
+		k.cdc.MustUnmarshal(iterator.Value(), &outflow)
+		genState.Outflows = append(genState.Outflows, outflow)
+	}
+
+	return &genState
+}
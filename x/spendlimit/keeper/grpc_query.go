@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v9/x/spendlimit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// SpendLimits returns the spending limits of all the accounts
+func (k Keeper) SpendLimits(stdCtx context.Context, req *types.QuerySpendLimitsRequest) (*types.QuerySpendLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpendLimitKeyPrefix)
+
+	var limits []types.SpendLimit
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var limit types.SpendLimit
+		if err := k.cdc.Unmarshal(value, &limit); err != nil {
+			return err
+		}
+		limits = append(limits, limit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySpendLimitsResponse{SpendLimits: limits, Pagination: pageRes}, nil
+}
+
+// SpendLimit returns the spending limit of an account and the amount it sent
+// within the window
+func (k Keeper) SpendLimit(stdCtx context.Context, req *types.QuerySpendLimitRequest) (*types.QuerySpendLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	limit, ok := k.GetSpendLimit(ctx, addr)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no spending limit for %s", req.Address)
+	}
+
+	return &types.QuerySpendLimitResponse{SpendLimit: limit, Spent: k.Spent(ctx, addr, limit)}, nil
+}
@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/gaia/v9/x/spendlimit/types"
+)
+
+// Keeper of the spendlimit store
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+}
+
+// NewKeeper creates a new spendlimit Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+		cdc:      cdc,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetSpendLimit returns the spending limit of an account, if any.
+func (k Keeper) GetSpendLimit(ctx sdk.Context, addr sdk.AccAddress) (types.SpendLimit, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSpendLimitKey(addr))
+	if bz == nil {
+		return types.SpendLimit{}, false
+	}
+
+	var limit types.SpendLimit
+	k.cdc.MustUnmarshal(bz, &limit)
+	return limit, true
+}
+
+// SetSpendLimit sets or replaces the spending limit of an account. A limit
+// letting the account send more than its current one only applies once the
+// window of the current limit elapses, so that a compromised key cannot lift
+// the limit and drain the account at once: it is kept as the pending change
+// of the current limit, replacing any previous one. Otherwise the limit
+// applies immediately, dropping the pending change. The amounts already sent
+// by the account within the window count towards the new limit.
+func (k Keeper) SetSpendLimit(ctx sdk.Context, limit types.SpendLimit) {
+	height := ctx.BlockHeight()
+	current, found := k.GetSpendLimit(ctx, sdk.MustAccAddressFromBech32(limit.Address))
+	if found && current.IsLoosenedBy(limit) {
+		height += int64(current.Window)
+		current.Pending = &types.PendingSpendLimit{Limit: limit.Limit, Window: limit.Window, Height: height}
+		k.setSpendLimit(ctx, current)
+	} else {
+		limit.Pending = nil
+		k.setSpendLimit(ctx, limit)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetSpendLimit,
+		sdk.NewAttribute(types.AttributeKeyAddress, limit.Address),
+		sdk.NewAttribute(types.AttributeKeyLimit, limit.Limit.String()),
+		sdk.NewAttribute(types.AttributeKeyWindow, strconv.FormatUint(limit.Window, 10)),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+	))
+}
+
+// RemoveSpendLimit removes the spending limit of an account, along with the
+// amounts it sent, once the window of the limit elapses. The removal is kept
+// as the pending change of the limit, replacing any previous one.
+func (k Keeper) RemoveSpendLimit(ctx sdk.Context, addr sdk.AccAddress) error {
+	limit, found := k.GetSpendLimit(ctx, addr)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNoSpendLimit, addr.String())
+	}
+
+	height := ctx.BlockHeight() + int64(limit.Window)
+	limit.Pending = &types.PendingSpendLimit{Height: height}
+	k.setSpendLimit(ctx, limit)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveSpendLimit,
+		sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+	))
+
+	return nil
+}
+
+// ApplyPendingSpendLimits applies the pending changes of the spending limits
+// due at the current height. Only the changes due are iterated over, through
+// their index by due height.
+func (k Keeper) ApplyPendingSpendLimits(ctx sdk.Context) {
+	for _, addr := range k.duePendingAddresses(ctx) {
+		limit, found := k.GetSpendLimit(ctx, addr)
+		if !found || limit.Pending == nil {
+			continue
+		}
+		if limit.Pending.IsRemoval() {
+			k.deleteSpendLimit(ctx, limit)
+			k.pruneOutflows(ctx, addr, nil)
+			continue
+		}
+		k.setSpendLimit(ctx, types.NewSpendLimit(addr, limit.Pending.Limit, limit.Pending.Window))
+	}
+}
+
+// duePendingAddresses returns the accounts whose pending change is due at the
+// current height, by due height.
+func (k Keeper) duePendingAddresses(ctx sdk.Context) []sdk.AccAddress {
+	iterator := ctx.KVStore(k.storeKey).Iterator(types.PendingKeyPrefix, types.GetPendingHeightPrefix(ctx.BlockHeight()+1))
+	defer iterator.Close()
+
+	var due []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		due = append(due, types.AddressFromPendingKey(iterator.Key()))
+	}
+	return due
+}
+
+// IterateSpendLimits iterates over the spending limits by address. The
+// iteration stops when cb returns true.
+func (k Keeper) IterateSpendLimits(ctx sdk.Context, cb func(limit types.SpendLimit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpendLimitKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var limit types.SpendLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &limit)
+		if cb(limit) {
+			break
+		}
+	}
+}
+
+// Spent returns the amount sent by an account within the window of its
+// spending limit ending at the current height.
+func (k Keeper) Spent(ctx sdk.Context, addr sdk.AccAddress, limit types.SpendLimit) sdk.Coins {
+	spent := sdk.NewCoins()
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOutflowsKey(addr))
+	iterator := store.Iterator(heightKey(limit.FirstHeight(ctx.BlockHeight())), nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var outflow types.Outflow
+		k.cdc.MustUnmarshal(iterator.Value(), &outflow)
+		spent = spent.Add(outflow.Amount...)
+	}
+	return spent
+}
+
+// SendRestriction returns an error when sending the amount from the account
+// would exceed its spending limit over the window ending at the current
+// height. Accounts without a spending limit are not restricted.
+func (k Keeper) SendRestriction(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins) error {
+	limit, ok := k.GetSpendLimit(ctx, from)
+	if !ok || amount.Empty() {
+		return nil
+	}
+
+	spent := k.Spent(ctx, from, limit).Add(amount...)
+	for _, coin := range limit.Limit {
+		if spent.AmountOf(coin.Denom).GT(coin.Amount) {
+			return sdkerrors.Wrapf(
+				types.ErrSpendLimitExceeded,
+				"%s would send %s%s within %d blocks, limit is %s",
+				from, spent.AmountOf(coin.Denom), coin.Denom, limit.Window, coin,
+			)
+		}
+	}
+	return nil
+}
+
+// RecordOutflow records the amount as sent by the account at the current
+// height, once the send succeeded. The amounts of the accounts without a
+// spending limit are not recorded.
+func (k Keeper) RecordOutflow(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins) {
+	limit, ok := k.GetSpendLimit(ctx, from)
+	if !ok || amount.Empty() {
+		return
+	}
+
+	k.pruneOutflows(ctx, from, heightKey(limit.FirstHeight(ctx.BlockHeight())))
+	k.addOutflow(ctx, from, amount)
+}
+
+// addOutflow adds the amount to the outflow of the account at the current
+// height.
+func (k Keeper) addOutflow(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOutflowKey(addr, ctx.BlockHeight())
+
+	outflow := types.Outflow{Address: addr.String(), Height: ctx.BlockHeight()}
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshal(bz, &outflow)
+	}
+	outflow.Amount = outflow.Amount.Add(amount...)
+	k.setOutflow(ctx, outflow)
+}
+
+// pruneOutflows deletes the outflows of the account before the given height
+// key, or all of them when the key is nil.
+func (k Keeper) pruneOutflows(ctx sdk.Context, addr sdk.AccAddress, end []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOutflowsKey(addr))
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// setSpendLimit stores the spending limit of an account, along with the index
+// entry of its pending change, replacing the entry of the previous one.
+func (k Keeper) setSpendLimit(ctx sdk.Context, limit types.SpendLimit) {
+	addr := sdk.MustAccAddressFromBech32(limit.Address)
+	store := ctx.KVStore(k.storeKey)
+	if previous, found := k.GetSpendLimit(ctx, addr); found && previous.Pending != nil {
+		store.Delete(types.GetPendingKey(previous.Pending.Height, addr))
+	}
+	store.Set(types.GetSpendLimitKey(addr), k.cdc.MustMarshal(&limit))
+	if limit.Pending != nil {
+		store.Set(types.GetPendingKey(limit.Pending.Height, addr), []byte{})
+	}
+}
+
+// deleteSpendLimit deletes the spending limit of an account, along with the
+// index entry of its pending change.
+func (k Keeper) deleteSpendLimit(ctx sdk.Context, limit types.SpendLimit) {
+	addr := sdk.MustAccAddressFromBech32(limit.Address)
+	store := ctx.KVStore(k.storeKey)
+	if limit.Pending != nil {
+		store.Delete(types.GetPendingKey(limit.Pending.Height, addr))
+	}
+	store.Delete(types.GetSpendLimitKey(addr))
+}
+
+func (k Keeper) setOutflow(ctx sdk.Context, outflow types.Outflow) {
+	addr := sdk.MustAccAddressFromBech32(outflow.Address)
+	ctx.KVStore(k.storeKey).Set(types.GetOutflowKey(addr, outflow.Height), k.cdc.MustMarshal(&outflow))
+}
+
+// heightKey returns the outflow key suffix of a height, the heights before
+// the first block starting from the first key.
+func heightKey(height int64) []byte {
+	if height < 0 {
+		height = 0
+	}
+	return sdk.Uint64ToBigEndian(uint64(height))
+}
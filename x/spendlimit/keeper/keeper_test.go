@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/spendlimit/keeper"
+	"github.com/cosmos/gaia/v9/x/spendlimit/types"
+)
+
+func TestSendRestriction(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	k := app.SpendLimitKeeper
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	uatom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uatom", amount)) }
+	send := func(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins) error {
+		if err := k.SendRestriction(ctx, from, amount); err != nil {
+			return err
+		}
+		k.RecordOutflow(ctx, from, amount)
+		return nil
+	}
+
+	// accounts without a limit are not restricted, nor recorded
+	require.NoError(t, send(ctx, bob, uatom(1_000_000)))
+	require.Empty(t, k.ExportGenesis(ctx).Outflows)
+
+	k.SetSpendLimit(ctx, types.NewSpendLimit(alice, uatom(100), 5))
+
+	// the check alone does not record the amount
+	require.NoError(t, k.SendRestriction(ctx, alice, uatom(100)))
+	limit, found := k.GetSpendLimit(ctx, alice)
+	require.True(t, found)
+	require.Empty(t, k.Spent(ctx, alice, limit))
+
+	// sends within the limit are accepted and recorded
+	require.NoError(t, send(ctx, alice, uatom(60)))
+	require.NoError(t, send(ctx.WithBlockHeight(12), alice, uatom(40)))
+	require.Equal(t, uatom(100), k.Spent(ctx.WithBlockHeight(12), alice, limit))
+
+	// the denoms missing from the limit are not limited
+	require.NoError(t, send(ctx.WithBlockHeight(12), alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000))))
+
+	// a send exceeding the limit is rejected and not recorded
+	require.ErrorIs(t, send(ctx.WithBlockHeight(14), alice, uatom(1)), types.ErrSpendLimitExceeded)
+	require.Equal(t, sdk.NewInt(100), k.Spent(ctx.WithBlockHeight(14), alice, limit).AmountOf("uatom"))
+
+	// the sends of height 10 fall out of the window at height 15
+	require.Equal(t, sdk.NewInt(40), k.Spent(ctx.WithBlockHeight(15), alice, limit).AmountOf("uatom"))
+	require.ErrorIs(t, send(ctx.WithBlockHeight(15), alice, uatom(61)), types.ErrSpendLimitExceeded)
+	require.NoError(t, send(ctx.WithBlockHeight(15), alice, uatom(60)))
+
+	// the whole window rolled over
+	require.Empty(t, k.Spent(ctx.WithBlockHeight(20), alice, limit))
+	require.NoError(t, send(ctx.WithBlockHeight(20), alice, uatom(100)))
+}
+
+func TestPendingSpendLimits(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	k := app.SpendLimitKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	uatom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uatom", amount)) }
+	getLimit := func(ctx sdk.Context) types.SpendLimit {
+		limit, found := k.GetSpendLimit(ctx, alice)
+		require.True(t, found)
+		return limit
+	}
+	// pendingHeights returns the due heights of the index of the pending
+	// changes
+	pendingHeights := func() []uint64 {
+		store := prefix.NewStore(ctx.KVStore(app.GetKey(types.StoreKey)), types.PendingKeyPrefix)
+		iterator := store.Iterator(nil, nil)
+		defer iterator.Close()
+		var heights []uint64
+		for ; iterator.Valid(); iterator.Next() {
+			heights = append(heights, sdk.BigEndianToUint64(iterator.Key()[:8]))
+		}
+		return heights
+	}
+	k.SetSpendLimit(ctx, types.NewSpendLimit(alice, uatom(100), 5))
+	k.RecordOutflow(ctx, alice, uatom(100))
+
+	// a raise only applies once the window elapses
+	k.SetSpendLimit(ctx, types.NewSpendLimit(alice, uatom(1_000), 5))
+	require.Equal(t, types.SpendLimit{
+		Address: alice.String(),
+		Limit:   uatom(100),
+		Window:  5,
+		Pending: &types.PendingSpendLimit{Limit: uatom(1_000), Window: 5, Height: 15},
+	}, getLimit(ctx))
+	require.Equal(t, []uint64{15}, pendingHeights())
+	require.ErrorIs(t, k.SendRestriction(ctx, alice, uatom(1)), types.ErrSpendLimitExceeded)
+	k.ApplyPendingSpendLimits(ctx.WithBlockHeight(14))
+	require.Equal(t, uatom(100), getLimit(ctx).Limit)
+	k.ApplyPendingSpendLimits(ctx.WithBlockHeight(15))
+	require.Equal(t, types.NewSpendLimit(alice, uatom(1_000), 5), getLimit(ctx))
+	require.Empty(t, pendingHeights())
+
+	// so do dropping a denom and shortening the window
+	k.SetSpendLimit(ctx, types.NewSpendLimit(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), 5))
+	require.NotNil(t, getLimit(ctx).Pending)
+	k.SetSpendLimit(ctx, types.NewSpendLimit(alice, uatom(1_000), 4))
+	require.NotNil(t, getLimit(ctx).Pending)
+
+	// a tighter limit applies immediately, dropping the pending change
+	require.Equal(t, []uint64{15}, pendingHeights())
+	tighter := types.NewSpendLimit(alice, uatom(500).Add(sdk.NewInt64Coin("stake", 1)), 6)
+	k.SetSpendLimit(ctx, tighter)
+	require.Equal(t, tighter, getLimit(ctx))
+	require.Empty(t, pendingHeights())
+
+	// a removal only applies once the window elapses, along with the outflows
+	_, err := msgServer.RemoveSpendLimit(sdk.WrapSDKContext(ctx), types.NewMsgRemoveSpendLimit(bob))
+	require.ErrorIs(t, err, types.ErrNoSpendLimit)
+	_, err = msgServer.RemoveSpendLimit(sdk.WrapSDKContext(ctx), types.NewMsgRemoveSpendLimit(alice))
+	require.NoError(t, err)
+	require.Equal(t, &types.PendingSpendLimit{Height: 16}, getLimit(ctx).Pending)
+	require.Equal(t, []uint64{16}, pendingHeights())
+	require.ErrorIs(t, k.SendRestriction(ctx, alice, uatom(1_000)), types.ErrSpendLimitExceeded)
+	k.ApplyPendingSpendLimits(ctx.WithBlockHeight(16))
+	_, found := k.GetSpendLimit(ctx, alice)
+	require.False(t, found)
+	require.NoError(t, k.SendRestriction(ctx, alice, uatom(1_000)))
+	require.Empty(t, k.ExportGenesis(ctx).Outflows)
+	require.Empty(t, pendingHeights())
+}
+
+func TestQuerySpendLimit(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	k := app.SpendLimitKeeper
+
+	alice := sdk.AccAddress("alice_______________")
+	limit := types.NewSpendLimit(alice, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)), 5)
+	_, err := keeper.NewMsgServerImpl(k).SetSpendLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetSpendLimit(alice, limit.Limit, limit.Window))
+	require.NoError(t, err)
+	k.RecordOutflow(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)))
+
+	res, err := k.SpendLimit(sdk.WrapSDKContext(ctx), &types.QuerySpendLimitRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, limit, res.SpendLimit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)), res.Spent)
+
+	limitsRes, err := k.SpendLimits(sdk.WrapSDKContext(ctx), &types.QuerySpendLimitsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.SpendLimit{limit}, limitsRes.SpendLimits)
+
+	_, err = k.SpendLimit(sdk.WrapSDKContext(ctx), &types.QuerySpendLimitRequest{Address: sdk.AccAddress("bob_________________").String()})
+	require.Error(t, err)
+}
+
+func TestGenesis(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	k := app.SpendLimitKeeper
+
+	alice := sdk.AccAddress("alice_______________")
+	uatom := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	genState := types.GenesisState{
+		SpendLimits: []types.SpendLimit{types.NewSpendLimit(alice, uatom, 5)},
+		Outflows:    []types.Outflow{{Address: alice.String(), Height: 9, Amount: uatom}},
+	}
+	require.NoError(t, genState.Validate())
+
+	k.InitGenesis(ctx, genState)
+	require.ErrorIs(t, k.SendRestriction(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))), types.ErrSpendLimitExceeded)
+	require.Equal(t, &genState, k.ExportGenesis(ctx))
+
+	invalid := genState
+	invalid.Outflows = []types.Outflow{{Address: sdk.AccAddress("bob_________________").String(), Height: 9, Amount: uatom}}
+	require.Error(t, invalid.Validate())
+	invalid = genState
+	invalid.SpendLimits = append(invalid.SpendLimits, genState.SpendLimits...)
+	require.Error(t, invalid.Validate())
+	require.Error(t, types.GenesisState{SpendLimits: []types.SpendLimit{types.NewSpendLimit(alice, uatom, 0)}}.Validate())
+	require.Error(t, types.GenesisState{SpendLimits: []types.SpendLimit{types.NewSpendLimit(alice, nil, 5)}}.Validate())
+	pending := types.NewSpendLimit(alice, uatom, 5)
+	pending.Pending = &types.PendingSpendLimit{Limit: uatom, Height: 15}
+	require.Error(t, types.GenesisState{SpendLimits: []types.SpendLimit{pending}}.Validate())
+	pending.Pending.Window = 5
+	require.NoError(t, types.GenesisState{SpendLimits: []types.SpendLimit{pending}}.Validate())
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v9/x/spendlimit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the spendlimit MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// SetSpendLimit sets or replaces the spending limit of the account
+func (k msgServer) SetSpendLimit(goCtx context.Context, msg *types.MsgSetSpendLimit) (*types.MsgSetSpendLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetSpendLimit(ctx, types.SpendLimit{Address: msg.Address, Limit: msg.Limit, Window: msg.Window})
+	return &types.MsgSetSpendLimitResponse{}, nil
+}
+
+// RemoveSpendLimit removes the spending limit of the account
+func (k msgServer) RemoveSpendLimit(goCtx context.Context, msg *types.MsgRemoveSpendLimit) (*types.MsgRemoveSpendLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RemoveSpendLimit(ctx, addr); err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveSpendLimitResponse{}, nil
+}
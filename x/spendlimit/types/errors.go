@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/spendlimit module sentinel errors
+var (
+	ErrSpendLimitExceeded = sdkerrors.Register(ModuleName, 2, "spending limit exceeded")
+	ErrNoSpendLimit       = sdkerrors.Register(ModuleName, 3, "account has no spending limit")
+)
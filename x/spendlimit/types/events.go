@@ -0,0 +1,12 @@
+package types
+
+// spendlimit module event types
+const (
+	EventTypeSetSpendLimit    = "set_spend_limit"
+	EventTypeRemoveSpendLimit = "remove_spend_limit"
+
+	AttributeKeyAddress = "address"
+	AttributeKeyLimit   = "limit"
+	AttributeKeyWindow  = "window"
+	AttributeKeyHeight  = "height"
+)
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultGenesisState returns the default genesis state, without spending
+// limits.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	limited := make(map[string]bool, len(gs.SpendLimits))
+	for _, limit := range gs.SpendLimits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		if limited[limit.Address] {
+			return fmt.Errorf("duplicate spending limit of %s", limit.Address)
+		}
+		limited[limit.Address] = true
+	}
+
+	type outflowKey struct {
+		address string
+		height  int64
+	}
+	seen := make(map[outflowKey]bool, len(gs.Outflows))
+	for _, outflow := range gs.Outflows {
+		if _, err := sdk.AccAddressFromBech32(outflow.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid outflow address %s: %s", outflow.Address, err)
+		}
+		if !limited[outflow.Address] {
+			return fmt.Errorf("outflow of %s without spending limit", outflow.Address)
+		}
+		if outflow.Height <= 0 {
+			return fmt.Errorf("invalid outflow height %d of %s", outflow.Height, outflow.Address)
+		}
+		if !outflow.Amount.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid outflow amount %s of %s", outflow.Amount, outflow.Address)
+		}
+		key := outflowKey{outflow.Address, outflow.Height}
+		if seen[key] {
+			return fmt.Errorf("duplicate outflow of %s at height %d", outflow.Address, outflow.Height)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the this module
+	ModuleName = "spendlimit"
+
+	// StoreKey is the default store key for the module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the module msgs
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// SpendLimitKeyPrefix is the prefix of the spending limits
+	SpendLimitKeyPrefix = []byte{0x01}
+	// OutflowKeyPrefix is the prefix of the amounts sent by the limited
+	// accounts, by account and height
+	OutflowKeyPrefix = []byte{0x02}
+	// PendingKeyPrefix is the prefix of the index of the pending changes of
+	// the spending limits, by due height and account
+	PendingKeyPrefix = []byte{0x03}
+)
+
+// GetSpendLimitKey returns the store key of the spending limit of an account.
+func GetSpendLimitKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, SpendLimitKeyPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// GetOutflowsKey returns the store key prefix of the outflows of an account.
+func GetOutflowsKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, OutflowKeyPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// GetOutflowKey returns the store key of the outflow of an account at a
+// height.
+func GetOutflowKey(addr sdk.AccAddress, height int64) []byte {
+	return append(GetOutflowsKey(addr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetPendingKey returns the store key of the index entry of the pending change
+// of the spending limit of an account due at a height.
+func GetPendingKey(height int64, addr sdk.AccAddress) []byte {
+	return append(GetPendingHeightPrefix(height), address.MustLengthPrefix(addr)...)
+}
+
+// GetPendingHeightPrefix returns the prefix of the store keys of the index
+// entries of the pending changes due at a height.
+func GetPendingHeightPrefix(height int64) []byte {
+	return append(append([]byte{}, PendingKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// AddressFromPendingKey returns the account of the index entry of a pending
+// change from its store key.
+func AddressFromPendingKey(key []byte) sdk.AccAddress {
+	// the prefix, the height and the length of the address
+	return key[len(PendingKeyPrefix)+8+1:]
+}
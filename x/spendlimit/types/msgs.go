@@ -0,0 +1,81 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+// spendlimit message types
+const (
+	TypeMsgSetSpendLimit    = "set_spend_limit"
+	TypeMsgRemoveSpendLimit = "remove_spend_limit"
+)
+
+var (
+	_ sdk.Msg            = &MsgSetSpendLimit{}
+	_ legacytx.LegacyMsg = &MsgSetSpendLimit{}
+	_ sdk.Msg            = &MsgRemoveSpendLimit{}
+	_ legacytx.LegacyMsg = &MsgRemoveSpendLimit{}
+)
+
+// NewMsgSetSpendLimit creates a new MsgSetSpendLimit instance.
+func NewMsgSetSpendLimit(addr sdk.AccAddress, limit sdk.Coins, window uint64) *MsgSetSpendLimit {
+	return &MsgSetSpendLimit{
+		Address: addr.String(),
+		Limit:   limit,
+		Window:  window,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgSetSpendLimit) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgSetSpendLimit) Type() string { return TypeMsgSetSpendLimit }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetSpendLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetSpendLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetSpendLimit) ValidateBasic() error {
+	return SpendLimit{Address: msg.Address, Limit: msg.Limit, Window: msg.Window}.Validate()
+}
+
+// NewMsgRemoveSpendLimit creates a new MsgRemoveSpendLimit instance.
+func NewMsgRemoveSpendLimit(addr sdk.AccAddress) *MsgRemoveSpendLimit {
+	return &MsgRemoveSpendLimit{Address: addr.String()}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgRemoveSpendLimit) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgRemoveSpendLimit) Type() string { return TypeMsgRemoveSpendLimit }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRemoveSpendLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRemoveSpendLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRemoveSpendLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+	return nil
+}
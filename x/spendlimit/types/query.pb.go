@@ -0,0 +1,1133 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/spendlimit/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QuerySpendLimitsRequest is the request type for the Query/SpendLimits RPC
+// method.
+type QuerySpendLimitsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySpendLimitsRequest) Reset()         { *m = QuerySpendLimitsRequest{} }
+func (m *QuerySpendLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendLimitsRequest) ProtoMessage()    {}
+func (*QuerySpendLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_646072c0a41535cd, []int{0}
+}
+func (m *QuerySpendLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendLimitsRequest.Merge(m, src)
+}
+func (m *QuerySpendLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendLimitsRequest proto.InternalMessageInfo
+
+func (m *QuerySpendLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySpendLimitsResponse is the response type for the Query/SpendLimits RPC
+// method.
+type QuerySpendLimitsResponse struct {
+	SpendLimits []SpendLimit        `protobuf:"bytes,1,rep,name=spend_limits,json=spendLimits,proto3" json:"spend_limits" yaml:"spend_limits"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySpendLimitsResponse) Reset()         { *m = QuerySpendLimitsResponse{} }
+func (m *QuerySpendLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendLimitsResponse) ProtoMessage()    {}
+func (*QuerySpendLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_646072c0a41535cd, []int{1}
+}
+func (m *QuerySpendLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendLimitsResponse.Merge(m, src)
+}
+func (m *QuerySpendLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendLimitsResponse proto.InternalMessageInfo
+
+func (m *QuerySpendLimitsResponse) GetSpendLimits() []SpendLimit {
+	if m != nil {
+		return m.SpendLimits
+	}
+	return nil
+}
+
+func (m *QuerySpendLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySpendLimitRequest is the request type for the Query/SpendLimit RPC
+// method.
+type QuerySpendLimitRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QuerySpendLimitRequest) Reset()         { *m = QuerySpendLimitRequest{} }
+func (m *QuerySpendLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendLimitRequest) ProtoMessage()    {}
+func (*QuerySpendLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_646072c0a41535cd, []int{2}
+}
+func (m *QuerySpendLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendLimitRequest.Merge(m, src)
+}
+func (m *QuerySpendLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendLimitRequest proto.InternalMessageInfo
+
+func (m *QuerySpendLimitRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QuerySpendLimitResponse is the response type for the Query/SpendLimit RPC
+// method.
+type QuerySpendLimitResponse struct {
+	SpendLimit SpendLimit `protobuf:"bytes,1,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit" yaml:"spend_limit"`
+	// spent is the amount sent by the account within the current window.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *QuerySpendLimitResponse) Reset()         { *m = QuerySpendLimitResponse{} }
+func (m *QuerySpendLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendLimitResponse) ProtoMessage()    {}
+func (*QuerySpendLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_646072c0a41535cd, []int{3}
+}
+func (m *QuerySpendLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendLimitResponse.Merge(m, src)
+}
+func (m *QuerySpendLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendLimitResponse proto.InternalMessageInfo
+
+func (m *QuerySpendLimitResponse) GetSpendLimit() SpendLimit {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return SpendLimit{}
+}
+
+func (m *QuerySpendLimitResponse) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QuerySpendLimitsRequest)(nil), "gaia.spendlimit.v1beta1.QuerySpendLimitsRequest")
+	proto.RegisterType((*QuerySpendLimitsResponse)(nil), "gaia.spendlimit.v1beta1.QuerySpendLimitsResponse")
+	proto.RegisterType((*QuerySpendLimitRequest)(nil), "gaia.spendlimit.v1beta1.QuerySpendLimitRequest")
+	proto.RegisterType((*QuerySpendLimitResponse)(nil), "gaia.spendlimit.v1beta1.QuerySpendLimitResponse")
+}
+
+func init() {
+	proto.RegisterFile("gaia/spendlimit/v1beta1/query.proto", fileDescriptor_646072c0a41535cd)
+}
+
+var fileDescriptor_646072c0a41535cd = []byte{
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x8a, 0x13, 0x31,
+	0x18, 0x6f, 0x2a, 0xab, 0x98, 0xf1, 0x14, 0xc5, 0x1d, 0xab, 0x4c, 0x97, 0x59, 0xd6, 0xad, 0xc2,
+	0x26, 0x6d, 0x3d, 0x08, 0x1e, 0xbb, 0xa0, 0x17, 0x0f, 0x3a, 0xde, 0xbc, 0x68, 0xda, 0x86, 0x18,
+	0x6c, 0x27, 0xb3, 0xfd, 0x52, 0xb1, 0x88, 0x17, 0x9f, 0x40, 0xf0, 0x09, 0xbc, 0x89, 0x4f, 0xd2,
+	0xe3, 0x82, 0x20, 0x9e, 0xaa, 0xb4, 0x3e, 0x81, 0x4f, 0x20, 0x93, 0xc9, 0xec, 0x4c, 0x2d, 0x83,
+	0xdd, 0x53, 0x1b, 0xf2, 0xfb, 0x7e, 0xff, 0xf2, 0x0d, 0xde, 0x97, 0x5c, 0x71, 0x06, 0x89, 0x88,
+	0x87, 0x23, 0x35, 0x56, 0x86, 0xbd, 0xe9, 0xf4, 0x85, 0xe1, 0x1d, 0x76, 0x32, 0x15, 0x93, 0x19,
+	0x4d, 0x26, 0xda, 0x68, 0xb2, 0x9b, 0x82, 0x68, 0x01, 0xa2, 0x0e, 0xd4, 0xb8, 0x26, 0xb5, 0xd4,
+	0x16, 0xc3, 0xd2, 0x7f, 0x19, 0xbc, 0x71, 0x4b, 0x6a, 0x2d, 0x47, 0x82, 0xf1, 0x44, 0x31, 0x1e,
+	0xc7, 0xda, 0x70, 0xa3, 0x74, 0x0c, 0xee, 0xf6, 0xee, 0x40, 0xc3, 0x58, 0x03, 0xeb, 0x73, 0x10,
+	0x99, 0xca, 0x99, 0x66, 0xc2, 0xa5, 0x8a, 0x2d, 0xd8, 0x61, 0x83, 0x32, 0x36, 0x47, 0x0d, 0xb4,
+	0xca, 0xef, 0x0f, 0xaa, 0xdc, 0x4b, 0x11, 0x0b, 0x50, 0x4e, 0x32, 0xe4, 0x78, 0xf7, 0x69, 0x2a,
+	0xf4, 0x2c, 0x05, 0x3e, 0x4e, 0x81, 0x10, 0x89, 0x93, 0xa9, 0x00, 0x43, 0x1e, 0x62, 0x5c, 0xa8,
+	0xfa, 0x68, 0x0f, 0xb5, 0xbc, 0xee, 0x6d, 0x9a, 0xc9, 0xd2, 0x54, 0x96, 0x66, 0x45, 0x38, 0x62,
+	0xfa, 0x84, 0x4b, 0xe1, 0x66, 0xa3, 0xd2, 0x64, 0x38, 0x47, 0xd8, 0xdf, 0xd4, 0x80, 0x44, 0xc7,
+	0x20, 0xc8, 0x00, 0x5f, 0xb1, 0x1e, 0x5f, 0x58, 0x93, 0xe0, 0xa3, 0xbd, 0x0b, 0x2d, 0xaf, 0xbb,
+	0x4f, 0x2b, 0x6a, 0xa5, 0x05, 0x47, 0xef, 0xe6, 0x7c, 0xd1, 0xac, 0xfd, 0x59, 0x34, 0xaf, 0xce,
+	0xf8, 0x78, 0xf4, 0x20, 0x2c, 0xd3, 0x84, 0x91, 0x07, 0x85, 0x18, 0x79, 0xb4, 0x96, 0xa4, 0x6e,
+	0x93, 0x1c, 0xfe, 0x37, 0x49, 0xe6, 0x70, 0x2d, 0x4a, 0x17, 0x5f, 0xff, 0x27, 0x49, 0x5e, 0x96,
+	0x8f, 0x2f, 0xf1, 0xe1, 0x70, 0x22, 0x00, 0x6c, 0x53, 0x97, 0xa3, 0xfc, 0x18, 0x2e, 0xd0, 0x46,
+	0xc5, 0x67, 0xe9, 0x5f, 0x62, 0xaf, 0x64, 0xdb, 0x75, 0xbc, 0x55, 0xf8, 0x86, 0x0b, 0x4f, 0x36,
+	0xc2, 0x87, 0x11, 0x2e, 0xb2, 0x13, 0x8e, 0x77, 0xd2, 0x93, 0xf1, 0xeb, 0xb6, 0xd8, 0x1b, 0x6b,
+	0xa9, 0x73, 0xde, 0x63, 0xad, 0xe2, 0x5e, 0x3b, 0x65, 0xfc, 0xfa, 0xb3, 0xd9, 0x92, 0xca, 0xbc,
+	0x9a, 0xf6, 0xe9, 0x40, 0x8f, 0x99, 0xdb, 0xb1, 0xec, 0xe7, 0x08, 0x86, 0xaf, 0x99, 0x99, 0x25,
+	0x02, 0xec, 0x00, 0x44, 0x19, 0x73, 0xf7, 0x7b, 0x1d, 0xef, 0xd8, 0x80, 0xe4, 0x33, 0xc2, 0x5e,
+	0xe9, 0x91, 0x49, 0xbb, 0x32, 0x49, 0xc5, 0xce, 0x35, 0x3a, 0xe7, 0x98, 0xc8, 0x3a, 0x0c, 0x8f,
+	0x3e, 0x7c, 0xfb, 0xfd, 0xa9, 0x7e, 0x48, 0x0e, 0x58, 0xd5, 0xc6, 0x97, 0x37, 0x83, 0x7c, 0x41,
+	0x18, 0x17, 0x34, 0x84, 0x6d, 0x2b, 0x98, 0x3b, 0x6c, 0x6f, 0x3f, 0xe0, 0x0c, 0xde, 0xb7, 0x06,
+	0x3b, 0x84, 0x6d, 0x65, 0x90, 0xbd, 0x73, 0x8b, 0xf3, 0xbe, 0x77, 0x3c, 0x5f, 0x06, 0xe8, 0x74,
+	0x19, 0xa0, 0x5f, 0xcb, 0x00, 0x7d, 0x5c, 0x05, 0xb5, 0xd3, 0x55, 0x50, 0xfb, 0xb1, 0x0a, 0x6a,
+	0xcf, 0xef, 0x6c, 0xbe, 0x91, 0xe5, 0x7e, 0x5b, 0x66, 0xb7, 0x4f, 0xd5, 0xbf, 0x68, 0xbf, 0xf3,
+	0x7b, 0x7f, 0x07, 0x00, 0x4e, 0x3c, 0xb3, 0x13, 0xce, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// SpendLimits returns the spending limits of all the accounts.
+	SpendLimits(ctx context.Context, in *QuerySpendLimitsRequest, opts ...grpc.CallOption) (*QuerySpendLimitsResponse, error)
+	// SpendLimit returns the spending limit of an account and the amount it
+	// sent within the window.
+	SpendLimit(ctx context.Context, in *QuerySpendLimitRequest, opts ...grpc.CallOption) (*QuerySpendLimitResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SpendLimits(ctx context.Context, in *QuerySpendLimitsRequest, opts ...grpc.CallOption) (*QuerySpendLimitsResponse, error) {
+	out := new(QuerySpendLimitsResponse)
+	err := c.cc.Invoke(ctx, "/gaia.spendlimit.v1beta1.Query/SpendLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendLimit(ctx context.Context, in *QuerySpendLimitRequest, opts ...grpc.CallOption) (*QuerySpendLimitResponse, error) {
+	out := new(QuerySpendLimitResponse)
+	err := c.cc.Invoke(ctx, "/gaia.spendlimit.v1beta1.Query/SpendLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// SpendLimits returns the spending limits of all the accounts.
+	SpendLimits(context.Context, *QuerySpendLimitsRequest) (*QuerySpendLimitsResponse, error)
+	// SpendLimit returns the spending limit of an account and the amount it
+	// sent within the window.
+	SpendLimit(context.Context, *QuerySpendLimitRequest) (*QuerySpendLimitResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) SpendLimits(ctx context.Context, req *QuerySpendLimitsRequest) (*QuerySpendLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendLimits not implemented")
+}
+func (*UnimplementedQueryServer) SpendLimit(ctx context.Context, req *QuerySpendLimitRequest) (*QuerySpendLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendLimit not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_SpendLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.spendlimit.v1beta1.Query/SpendLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendLimits(ctx, req.(*QuerySpendLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.spendlimit.v1beta1.Query/SpendLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendLimit(ctx, req.(*QuerySpendLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.spendlimit.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SpendLimits",
+			Handler:    _Query_SpendLimits_Handler,
+		},
+		{
+			MethodName: "SpendLimit",
+			Handler:    _Query_SpendLimit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/spendlimit/v1beta1/query.proto",
+}
+
+func (m *QuerySpendLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpendLimits) > 0 {
+		for iNdEx := len(m.SpendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SpendLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySpendLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimits) > 0 {
+		for _, e := range m.SpendLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySpendLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimits = append(m.SpendLimits, SpendLimit{})
+			if err := m.SpendLimits[len(m.SpendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxWindow is the maximum window of a spending limit, about 19 years of 6s
+// blocks, so that the heights derived from the window do not overflow.
+const MaxWindow = 100_000_000
+
+// NewSpendLimit returns a SpendLimit of the account.
+func NewSpendLimit(addr sdk.AccAddress, limit sdk.Coins, window uint64) SpendLimit {
+	return SpendLimit{
+		Address: addr.String(),
+		Limit:   limit,
+		Window:  window,
+	}
+}
+
+// Validate performs a basic validation of the spending limit.
+func (l SpendLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+	if !l.Limit.IsValid() || l.Limit.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid limit: %s", l.Limit)
+	}
+	if l.Window == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "window must be positive")
+	}
+	if l.Window > MaxWindow {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "window %d exceeds the maximum of %d", l.Window, MaxWindow)
+	}
+	if l.Pending != nil {
+		if !l.Pending.Limit.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid pending limit: %s", l.Pending.Limit)
+		}
+		if !l.Pending.IsRemoval() && l.Pending.Window == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pending window must be positive")
+		}
+		if l.Pending.Window > MaxWindow {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pending window %d exceeds the maximum of %d", l.Pending.Window, MaxWindow)
+		}
+		if l.Pending.Height <= 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pending height %d", l.Pending.Height)
+		}
+	}
+	return nil
+}
+
+// IsLoosenedBy returns true when the other limit lets the account send more
+// than this one: it raises or drops the limit of a denom, or shortens the
+// window.
+func (l SpendLimit) IsLoosenedBy(other SpendLimit) bool {
+	if other.Window < l.Window {
+		return true
+	}
+	for _, coin := range l.Limit {
+		amount := other.Limit.AmountOf(coin.Denom)
+		if amount.IsZero() || amount.GT(coin.Amount) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRemoval returns true when the pending change removes the limit.
+func (p PendingSpendLimit) IsRemoval() bool {
+	return p.Limit.Empty()
+}
+
+// FirstHeight returns the first height of the window ending at the given
+// height.
+func (l SpendLimit) FirstHeight(height int64) int64 {
+	return height - int64(l.Window) + 1
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpendLimitValidate(t *testing.T) {
+	addr := sdk.AccAddress("alice_______________")
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	withPending := func(pending sdk.Coins, window uint64) SpendLimit {
+		l := NewSpendLimit(addr, limit, 10)
+		l.Pending = &PendingSpendLimit{Limit: pending, Window: window, Height: 20}
+		return l
+	}
+
+	tests := map[string]struct {
+		limit     SpendLimit
+		expectErr bool
+	}{
+		"valid limit, pass": {
+			NewSpendLimit(addr, limit, 10),
+			false,
+		},
+		"maximum window, pass": {
+			NewSpendLimit(addr, limit, MaxWindow),
+			false,
+		},
+		"zero window, fail": {
+			NewSpendLimit(addr, limit, 0),
+			true,
+		},
+		"window above the maximum, fail": {
+			NewSpendLimit(addr, limit, MaxWindow+1),
+			true,
+		},
+		"window overflowing a height, fail": {
+			NewSpendLimit(addr, limit, math.MaxInt64+1),
+			true,
+		},
+		"pending removal, pass": {
+			withPending(nil, 0),
+			false,
+		},
+		"pending maximum window, pass": {
+			withPending(limit, MaxWindow),
+			false,
+		},
+		"pending window above the maximum, fail": {
+			withPending(limit, MaxWindow+1),
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.limit.Validate()
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
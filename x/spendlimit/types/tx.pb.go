@@ -0,0 +1,939 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/spendlimit/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetSpendLimit defines a SDK message to limit the amount an account can
+// send over a rolling window of blocks.
+type MsgSetSpendLimit struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Limit   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=limit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"limit"`
+	Window  uint64                                   `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *MsgSetSpendLimit) Reset()         { *m = MsgSetSpendLimit{} }
+func (m *MsgSetSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSpendLimit) ProtoMessage()    {}
+func (*MsgSetSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0377ef19d6d004f6, []int{0}
+}
+func (m *MsgSetSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSpendLimit.Merge(m, src)
+}
+func (m *MsgSetSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSpendLimit proto.InternalMessageInfo
+
+// MsgSetSpendLimitResponse defines the Msg/SetSpendLimit response type.
+type MsgSetSpendLimitResponse struct {
+}
+
+func (m *MsgSetSpendLimitResponse) Reset()         { *m = MsgSetSpendLimitResponse{} }
+func (m *MsgSetSpendLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSpendLimitResponse) ProtoMessage()    {}
+func (*MsgSetSpendLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0377ef19d6d004f6, []int{1}
+}
+func (m *MsgSetSpendLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSpendLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSpendLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSpendLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSpendLimitResponse.Merge(m, src)
+}
+func (m *MsgSetSpendLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSpendLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSpendLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSpendLimitResponse proto.InternalMessageInfo
+
+// MsgRemoveSpendLimit defines a SDK message to remove the spending limit of
+// an account.
+type MsgRemoveSpendLimit struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgRemoveSpendLimit) Reset()         { *m = MsgRemoveSpendLimit{} }
+func (m *MsgRemoveSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSpendLimit) ProtoMessage()    {}
+func (*MsgRemoveSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0377ef19d6d004f6, []int{2}
+}
+func (m *MsgRemoveSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSpendLimit.Merge(m, src)
+}
+func (m *MsgRemoveSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSpendLimit proto.InternalMessageInfo
+
+// MsgRemoveSpendLimitResponse defines the Msg/RemoveSpendLimit response type.
+type MsgRemoveSpendLimitResponse struct {
+}
+
+func (m *MsgRemoveSpendLimitResponse) Reset()         { *m = MsgRemoveSpendLimitResponse{} }
+func (m *MsgRemoveSpendLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSpendLimitResponse) ProtoMessage()    {}
+func (*MsgRemoveSpendLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0377ef19d6d004f6, []int{3}
+}
+func (m *MsgRemoveSpendLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSpendLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSpendLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSpendLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSpendLimitResponse.Merge(m, src)
+}
+func (m *MsgRemoveSpendLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSpendLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSpendLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSpendLimitResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetSpendLimit)(nil), "gaia.spendlimit.v1beta1.MsgSetSpendLimit")
+	proto.RegisterType((*MsgSetSpendLimitResponse)(nil), "gaia.spendlimit.v1beta1.MsgSetSpendLimitResponse")
+	proto.RegisterType((*MsgRemoveSpendLimit)(nil), "gaia.spendlimit.v1beta1.MsgRemoveSpendLimit")
+	proto.RegisterType((*MsgRemoveSpendLimitResponse)(nil), "gaia.spendlimit.v1beta1.MsgRemoveSpendLimitResponse")
+}
+
+func init() { proto.RegisterFile("gaia/spendlimit/v1beta1/tx.proto", fileDescriptor_0377ef19d6d004f6) }
+
+var fileDescriptor_0377ef19d6d004f6 = []byte{
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x4e, 0xe3, 0x40,
+	0x10, 0x86, 0xbd, 0x97, 0xbb, 0xdc, 0xdd, 0x22, 0xa4, 0xc8, 0x20, 0x30, 0x46, 0xd8, 0x56, 0x2a,
+	0x47, 0x82, 0x35, 0x09, 0x34, 0x50, 0x26, 0x2d, 0x69, 0x9c, 0x8e, 0xce, 0x8e, 0x57, 0xcb, 0x0a,
+	0xec, 0xb5, 0x32, 0x4b, 0x12, 0xde, 0x80, 0x92, 0x47, 0x48, 0x4d, 0xc7, 0x5b, 0xa4, 0x4c, 0x49,
+	0x05, 0x28, 0x69, 0x28, 0x78, 0x08, 0x64, 0x3b, 0x86, 0x90, 0x00, 0x0a, 0x95, 0xbd, 0xf2, 0xb7,
+	0xdf, 0xfc, 0xe3, 0x19, 0x6c, 0x31, 0x8f, 0x7b, 0x0e, 0xc4, 0x34, 0x0a, 0x2e, 0x78, 0xc8, 0xa5,
+	0xd3, 0xad, 0xfa, 0x54, 0x7a, 0x55, 0x47, 0xf6, 0x49, 0xdc, 0x11, 0x52, 0xa8, 0x9b, 0x09, 0x41,
+	0xde, 0x09, 0x32, 0x25, 0xf4, 0x75, 0x26, 0x98, 0x48, 0x19, 0x27, 0x79, 0xcb, 0x70, 0xdd, 0x68,
+	0x0b, 0x08, 0x05, 0x38, 0xbe, 0x07, 0xf4, 0x4d, 0xd6, 0x16, 0x3c, 0xca, 0xbe, 0x97, 0xef, 0x10,
+	0x2e, 0x35, 0x81, 0xb5, 0xa8, 0x6c, 0x25, 0xca, 0x93, 0x44, 0xa9, 0x6a, 0xf8, 0xaf, 0x17, 0x04,
+	0x1d, 0x0a, 0xa0, 0x21, 0x0b, 0xd9, 0xff, 0xdd, 0xfc, 0xa8, 0x7a, 0xf8, 0x4f, 0x5a, 0x55, 0xfb,
+	0x65, 0x15, 0xec, 0x95, 0xda, 0x16, 0xc9, 0xf4, 0x24, 0xd1, 0xe7, 0x49, 0x48, 0x43, 0xf0, 0xa8,
+	0xbe, 0x3f, 0x7c, 0x30, 0x95, 0xdb, 0x47, 0xd3, 0x66, 0x5c, 0x9e, 0x5d, 0xfa, 0xa4, 0x2d, 0x42,
+	0x67, 0x9a, 0x25, 0x7b, 0xec, 0x41, 0x70, 0xee, 0xc8, 0xab, 0x98, 0x42, 0x7a, 0x01, 0xdc, 0xcc,
+	0xac, 0x6e, 0xe0, 0x62, 0x8f, 0x47, 0x81, 0xe8, 0x69, 0x05, 0x0b, 0xd9, 0xbf, 0xdd, 0xe9, 0xe9,
+	0xf8, 0xdf, 0xf5, 0xc0, 0x54, 0x9e, 0x07, 0xa6, 0x52, 0xd6, 0xb1, 0x36, 0x1f, 0xd9, 0xa5, 0x10,
+	0x8b, 0x08, 0x68, 0xf9, 0x08, 0xaf, 0x35, 0x81, 0xb9, 0x34, 0x14, 0x5d, 0xba, 0x4c, 0x47, 0x33,
+	0xda, 0x1d, 0xbc, 0xfd, 0xc9, 0xd5, 0xdc, 0x5c, 0x7b, 0x41, 0xb8, 0xd0, 0x04, 0xa6, 0x86, 0x78,
+	0xf5, 0xe3, 0xdf, 0xaa, 0x90, 0x2f, 0x46, 0x42, 0xe6, 0x53, 0xea, 0xd5, 0xa5, 0xd1, 0xbc, 0xac,
+	0xda, 0xc5, 0xa5, 0x85, 0x6e, 0x76, 0xbf, 0xd3, 0xcc, 0xd3, 0xfa, 0xe1, 0x4f, 0xe8, 0xbc, 0x6e,
+	0xbd, 0x31, 0x1c, 0x1b, 0x68, 0x34, 0x36, 0xd0, 0xd3, 0xd8, 0x40, 0x37, 0x13, 0x43, 0x19, 0x4d,
+	0x0c, 0xe5, 0x7e, 0x62, 0x28, 0xa7, 0x95, 0xc5, 0x89, 0xa6, 0x5b, 0xdb, 0x9f, 0xdd, 0xdb, 0x74,
+	0xb0, 0x7e, 0x31, 0x5d, 0xb2, 0x83, 0xd7, 0x01, 0x00, 0xa0, 0xc9, 0x98, 0x9e, 0xd7, 0x02, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetSpendLimit sets or replaces the spending limit of an account.
+	SetSpendLimit(ctx context.Context, in *MsgSetSpendLimit, opts ...grpc.CallOption) (*MsgSetSpendLimitResponse, error)
+	// RemoveSpendLimit removes the spending limit of an account.
+	RemoveSpendLimit(ctx context.Context, in *MsgRemoveSpendLimit, opts ...grpc.CallOption) (*MsgRemoveSpendLimitResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetSpendLimit(ctx context.Context, in *MsgSetSpendLimit, opts ...grpc.CallOption) (*MsgSetSpendLimitResponse, error) {
+	out := new(MsgSetSpendLimitResponse)
+	err := c.cc.Invoke(ctx, "/gaia.spendlimit.v1beta1.Msg/SetSpendLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSpendLimit(ctx context.Context, in *MsgRemoveSpendLimit, opts ...grpc.CallOption) (*MsgRemoveSpendLimitResponse, error) {
+	out := new(MsgRemoveSpendLimitResponse)
+	err := c.cc.Invoke(ctx, "/gaia.spendlimit.v1beta1.Msg/RemoveSpendLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetSpendLimit sets or replaces the spending limit of an account.
+	SetSpendLimit(context.Context, *MsgSetSpendLimit) (*MsgSetSpendLimitResponse, error)
+	// RemoveSpendLimit removes the spending limit of an account.
+	RemoveSpendLimit(context.Context, *MsgRemoveSpendLimit) (*MsgRemoveSpendLimitResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetSpendLimit(ctx context.Context, req *MsgSetSpendLimit) (*MsgSetSpendLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveSpendLimit(ctx context.Context, req *MsgRemoveSpendLimit) (*MsgRemoveSpendLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSpendLimit not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetSpendLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSpendLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSpendLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.spendlimit.v1beta1.Msg/SetSpendLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSpendLimit(ctx, req.(*MsgSetSpendLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSpendLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSpendLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSpendLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.spendlimit.v1beta1.Msg/RemoveSpendLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSpendLimit(ctx, req.(*MsgRemoveSpendLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.spendlimit.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetSpendLimit",
+			Handler:    _Msg_SetSpendLimit_Handler,
+		},
+		{
+			MethodName: "RemoveSpendLimit",
+			Handler:    _Msg_RemoveSpendLimit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/spendlimit/v1beta1/tx.proto",
+}
+
+func (m *MsgSetSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Limit) > 0 {
+		for iNdEx := len(m.Limit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSpendLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSpendLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSpendLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSpendLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSpendLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSpendLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Limit) > 0 {
+		for _, e := range m.Limit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Window != 0 {
+		n += 1 + sovTx(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *MsgSetSpendLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveSpendLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetSpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limit = append(m.Limit, types.Coin{})
+			if err := m.Limit[len(m.Limit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSpendLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSpendLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSpendLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSpendLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSpendLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSpendLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
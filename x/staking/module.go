@@ -0,0 +1,56 @@
+package staking
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule wraps the staking module of the SDK to enforce the minimum
+// commission rate and the maximum number of validator creations per block
+// set in the policy params. The other services of the module are
+// unchanged.
+type AppModule struct {
+	staking.AppModule
+	keeper      keeper.Keeper
+	paramSource policy.ParamSource
+	tkey        storetypes.StoreKey
+}
+
+// NewAppModule creates a new AppModule object.
+func NewAppModule(
+	cdc codec.Codec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	paramSource policy.ParamSource,
+	tkey storetypes.StoreKey,
+) AppModule {
+	return AppModule{
+		AppModule:   staking.NewAppModule(cdc, k, ak, bk),
+		keeper:      k,
+		paramSource: paramSource,
+		tkey:        tkey,
+	}
+}
+
+// RegisterServices registers the wrapped msg server in place of the msg
+// server of the SDK, along with the query server and the migrations of the
+// SDK.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), NewMsgServerImpl(am.keeper, am.paramSource, am.tkey))
+	querier := keeper.Querier{Keeper: am.keeper}
+	types.RegisterQueryServer(cfg.QueryServer(), querier)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,210 @@
+package staking
+
+import (
+	"context"
+	"math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+)
+
+// TStoreKey is the key of the transient store counting the validators created
+// in the current block.
+const TStoreKey = "transient_gaia_staking"
+
+// validatorCreationsKey is the key of the number of validators created in the
+// current block in the transient store.
+var validatorCreationsKey = []byte("ValidatorCreations")
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer wraps the staking msg server of the SDK to reject the validators
+// created or edited with a commission rate below the minimum commission rate,
+// the validators created beyond the maximum number of creations per block,
+// and the delegations to new validators beyond the maximum number of
+// delegations per delegator. The ante handler rejects the latter early, but
+// the messages executed by the interchain accounts skip it.
+type msgServer struct {
+	types.MsgServer
+	keeper      keeper.Keeper
+	paramSource policy.ParamSource
+	tkey        storetypes.StoreKey
+}
+
+// NewMsgServerImpl returns an implementation of the staking MsgServer
+// interface enforcing the minimum commission rate, the maximum number of
+// validator creations per block, counted in the transient store of the given
+// key, and the maximum number of delegations per delegator.
+func NewMsgServerImpl(k keeper.Keeper, paramSource policy.ParamSource, tkey storetypes.StoreKey) types.MsgServer {
+	return msgServer{
+		MsgServer:   keeper.NewMsgServerImpl(k),
+		keeper:      k,
+		paramSource: paramSource,
+		tkey:        tkey,
+	}
+}
+
+// MinCommissionRate returns the minimum commission rate of the validators set
+// in the MinCommissionRate param, zero when unset.
+func MinCommissionRate(ctx sdk.Context, paramSource policy.ParamSource) sdk.Dec {
+	rate := sdk.ZeroDec()
+	if paramSource.Has(ctx, policytypes.ParamStoreKeyMinCommissionRate) {
+		paramSource.Get(ctx, policytypes.ParamStoreKeyMinCommissionRate, &rate)
+	}
+	if rate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return rate
+}
+
+// MaxValidatorCreationsPerBlock returns the maximum number of validators
+// created in a block set in the MaxValidatorCreationsPerBlock param, zero when
+// unlimited. The param is unset, so the creations are unlimited, while the
+// gentxs are delivered: the policy genesis is initialized after them.
+func MaxValidatorCreationsPerBlock(ctx sdk.Context, paramSource policy.ParamSource) uint64 {
+	var maxCreations uint64
+	if paramSource.Has(ctx, policytypes.ParamStoreKeyMaxValidatorCreationsPerBlock) {
+		paramSource.Get(ctx, policytypes.ParamStoreKeyMaxValidatorCreationsPerBlock, &maxCreations)
+	}
+	return maxCreations
+}
+
+// MaxDelegationsPerDelegator returns the maximum number of validators a
+// delegator can delegate to set in the MaxDelegationsPerDelegator param, zero
+// when unlimited.
+func MaxDelegationsPerDelegator(ctx sdk.Context, paramSource policy.ParamSource) uint64 {
+	var maxDelegations uint64
+	if paramSource.Has(ctx, policytypes.ParamStoreKeyMaxDelegationsPerDelegator) {
+		paramSource.Get(ctx, policytypes.ParamStoreKeyMaxDelegationsPerDelegator, &maxDelegations)
+	}
+	return maxDelegations
+}
+
+// validateDelegationCap returns an error if the delegator does not delegate
+// to the validator yet and already delegates to the maximum number of
+// validators. The delegators already above the cap can still top up their
+// existing delegations.
+func (k msgServer) validateDelegationCap(ctx sdk.Context, delegator, validator string) error {
+	maxDelegations := MaxDelegationsPerDelegator(ctx, k.paramSource)
+	if maxDelegations == 0 {
+		return nil
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address: %s", err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+	if _, found := k.keeper.GetDelegation(ctx, delAddr, valAddr); found {
+		return nil
+	}
+
+	maxRetrieve := uint16(math.MaxUint16)
+	if maxDelegations < math.MaxUint16 {
+		maxRetrieve = uint16(maxDelegations)
+	}
+	if uint64(len(k.keeper.GetDelegatorDelegations(ctx, delAddr, maxRetrieve))) >= maxDelegations {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "delegator %s cannot delegate to more than %d validators", delegator, maxDelegations)
+	}
+	return nil
+}
+
+// CreateValidator rejects the validators with a commission rate below the
+// minimum commission rate, the validators created once the maximum number of
+// creations of the block is reached, and the self-delegations beyond the
+// maximum number of delegations of the delegator.
+func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateValidator) (*types.MsgCreateValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if minRate := MinCommissionRate(ctx, k.paramSource); msg.Commission.Rate.LT(minRate) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "commission rate %s is below the minimum commission rate %s", msg.Commission.Rate, minRate)
+	}
+	if err := k.validateDelegationCap(ctx, msg.DelegatorAddress, msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	maxCreations := MaxValidatorCreationsPerBlock(ctx, k.paramSource)
+	if maxCreations == 0 {
+		return k.MsgServer.CreateValidator(goCtx, msg)
+	}
+	// the transient store is reset at each commit, and its writes are
+	// reverted along with the ones of the failed txs
+	store := ctx.TransientStore(k.tkey)
+	var creations uint64
+	if bz := store.Get(validatorCreationsKey); bz != nil {
+		creations = sdk.BigEndianToUint64(bz)
+	}
+	if creations >= maxCreations {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%d validators already created in this block, the limit is %d", creations, maxCreations)
+	}
+
+	res, err := k.MsgServer.CreateValidator(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+	store.Set(validatorCreationsKey, sdk.Uint64ToBigEndian(creations+1))
+	return res, nil
+}
+
+// EditValidator rejects the commission rates below the minimum commission
+// rate. The validators whose commission rate is still below the minimum after
+// the edit, i.e. which were below the minimum before it was raised, are bumped
+// up to the minimum, along with their max commission rate if it is below too.
+func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValidator) (*types.MsgEditValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	minRate := MinCommissionRate(ctx, k.paramSource)
+	if msg.CommissionRate != nil && msg.CommissionRate.LT(minRate) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "commission rate %s is below the minimum commission rate %s", *msg.CommissionRate, minRate)
+	}
+
+	res, err := k.MsgServer.EditValidator(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	validator, found := k.keeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+	if validator.Commission.Rate.LT(minRate) {
+		// call the before-modification hook since we're about to update the commission
+		k.keeper.BeforeValidatorModified(ctx, valAddr)
+
+		validator.Commission.Rate = minRate
+		validator.Commission.MaxRate = sdk.MaxDec(validator.Commission.MaxRate, minRate)
+		validator.Commission.UpdateTime = ctx.BlockHeader().Time
+		k.keeper.SetValidator(ctx, validator)
+	}
+
+	return res, nil
+}
+
+// Delegate rejects the delegations to a new validator beyond the maximum
+// number of delegations of the delegator.
+func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
+	if err := k.validateDelegationCap(sdk.UnwrapSDKContext(goCtx), msg.DelegatorAddress, msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	return k.MsgServer.Delegate(goCtx, msg)
+}
+
+// BeginRedelegate rejects the redelegations to a new validator beyond the
+// maximum number of delegations of the delegator.
+func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRedelegate) (*types.MsgBeginRedelegateResponse, error) {
+	if err := k.validateDelegationCap(sdk.UnwrapSDKContext(goCtx), msg.DelegatorAddress, msg.ValidatorDstAddress); err != nil {
+		return nil, err
+	}
+	return k.MsgServer.BeginRedelegate(goCtx, msg)
+}
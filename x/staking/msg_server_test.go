@@ -0,0 +1,185 @@
+package staking_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiaapp "github.com/cosmos/gaia/v9/app"
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	"github.com/cosmos/gaia/v9/x/policy"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+	"github.com/cosmos/gaia/v9/x/staking"
+)
+
+func setupMsgServer(t *testing.T, minRate sdk.Dec) (*gaiaapp.GaiaApp, sdk.Context, stakingtypes.MsgServer) {
+	t.Helper()
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+	subspace := app.GetSubspace(policy.ModuleName)
+	subspace.Set(ctx, policytypes.ParamStoreKeyMinCommissionRate, minRate)
+	return app, ctx, staking.NewMsgServerImpl(app.StakingKeeper, subspace, app.GetTKey(staking.TStoreKey))
+}
+
+func createValidator(t *testing.T, app *gaiaapp.GaiaApp, ctx sdk.Context, msgServer stakingtypes.MsgServer, rate sdk.Dec) (sdk.ValAddress, error) {
+	t.Helper()
+	pk := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(pk.Address())
+	selfDelegation := sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 1_000_000)
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, sdk.AccAddress(valAddr), sdk.NewCoins(selfDelegation)))
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr,
+		pk,
+		selfDelegation,
+		stakingtypes.NewDescription("moniker", "", "", "", ""),
+		stakingtypes.NewCommissionRates(rate, sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+		sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	return valAddr, err
+}
+
+func TestCreateValidatorMinCommissionRate(t *testing.T) {
+	minRate := sdk.NewDecWithPrec(5, 2)
+
+	tests := map[string]struct {
+		rate      sdk.Dec
+		expectErr bool
+	}{
+		"below the minimum, fail": {
+			sdk.NewDecWithPrec(4, 2),
+			true,
+		},
+		"at the minimum, pass": {
+			minRate,
+			false,
+		},
+		"above the minimum, pass": {
+			sdk.NewDecWithPrec(1, 1),
+			false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			app, ctx, msgServer := setupMsgServer(t, minRate)
+			valAddr, err := createValidator(t, app, ctx, msgServer, test.rate)
+			if test.expectErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+				_, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+				require.False(t, found)
+				return
+			}
+			require.NoError(t, err)
+			validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+			require.True(t, found)
+			require.Equal(t, test.rate, validator.Commission.Rate)
+		})
+	}
+}
+
+func TestEditValidatorMinCommissionRate(t *testing.T) {
+	minRate := sdk.NewDecWithPrec(5, 2)
+	app, ctx, msgServer := setupMsgServer(t, minRate)
+	valAddr, err := createValidator(t, app, ctx, msgServer, sdk.NewDecWithPrec(6, 2))
+	require.NoError(t, err)
+
+	// the commission rate cannot be edited down to below the minimum
+	belowMin := sdk.NewDecWithPrec(4, 2)
+	_, err = msgServer.EditValidator(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgEditValidator(valAddr, stakingtypes.Description{}, &belowMin, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, sdk.NewDecWithPrec(6, 2), validator.Commission.Rate)
+}
+
+func TestEditValidatorBumpsCommissionRate(t *testing.T) {
+	app, ctx, msgServer := setupMsgServer(t, sdk.ZeroDec())
+	valAddr, err := createValidator(t, app, ctx, msgServer, sdk.NewDecWithPrec(1, 2))
+	require.NoError(t, err)
+
+	// raise the minimum above both the rate and the max rate of the validator
+	minRate := sdk.NewDecWithPrec(25, 2)
+	app.GetSubspace(policy.ModuleName).Set(ctx, policytypes.ParamStoreKeyMinCommissionRate, minRate)
+
+	// the validator is left below the minimum until its next edit
+	validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), validator.Commission.Rate)
+
+	_, err = msgServer.EditValidator(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgEditValidator(valAddr, stakingtypes.NewDescription("new moniker", "", "", "", ""), nil, nil))
+	require.NoError(t, err)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, "new moniker", validator.Description.Moniker)
+	require.Equal(t, minRate, validator.Commission.Rate)
+	require.Equal(t, minRate, validator.Commission.MaxRate)
+}
+
+func TestCreateValidatorMaxCreationsPerBlock(t *testing.T) {
+	app, ctx, msgServer := setupMsgServer(t, sdk.ZeroDec())
+	subspace := app.GetSubspace(policy.ModuleName)
+	subspace.Set(ctx, policytypes.ParamStoreKeyMaxValidatorCreationsPerBlock, uint64(2))
+
+	// a failed creation doesn't count towards the limit
+	subspace.Set(ctx, policytypes.ParamStoreKeyMinCommissionRate, sdk.NewDecWithPrec(5, 2))
+	_, err := createValidator(t, app, ctx, msgServer, sdk.NewDecWithPrec(1, 2))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	subspace.Set(ctx, policytypes.ParamStoreKeyMinCommissionRate, sdk.ZeroDec())
+
+	for i := 0; i < 2; i++ {
+		_, err := createValidator(t, app, ctx, msgServer, sdk.NewDecWithPrec(1, 2))
+		require.NoError(t, err)
+	}
+	valAddr, err := createValidator(t, app, ctx, msgServer, sdk.NewDecWithPrec(1, 2))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.False(t, found)
+
+	// the count starts over in the next block, with a fresh transient store
+	nextCtx := ctx.WithMultiStore(app.CommitMultiStore().CacheMultiStore()).WithBlockHeight(3)
+	subspace.Set(nextCtx, policytypes.ParamStoreKeyMaxValidatorCreationsPerBlock, uint64(2))
+	_, err = createValidator(t, app, nextCtx, msgServer, sdk.NewDecWithPrec(1, 2))
+	require.NoError(t, err)
+
+	// a zero limit disables the throttling
+	subspace.Set(ctx, policytypes.ParamStoreKeyMaxValidatorCreationsPerBlock, uint64(0))
+	_, err = createValidator(t, app, ctx, msgServer, sdk.NewDecWithPrec(1, 2))
+	require.NoError(t, err)
+}
+
+func TestDelegationCap(t *testing.T) {
+	app, ctx, msgServer := setupMsgServer(t, sdk.ZeroDec())
+	app.GetSubspace(policy.ModuleName).Set(ctx, policytypes.ParamStoreKeyMaxDelegationsPerDelegator, uint64(2))
+
+	var validators []sdk.ValAddress
+	for i := 0; i < 3; i++ {
+		valAddr, err := createValidator(t, app, ctx, msgServer, sdk.NewDecWithPrec(1, 1))
+		require.NoError(t, err)
+		validators = append(validators, valAddr)
+	}
+	delegator := sdk.AccAddress("delegator___________")
+	amount := sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 1_000)
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, delegator, sdk.NewCoins(amount.Add(amount).Add(amount).Add(amount))))
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	for _, valAddr := range validators[:2] {
+		_, err := msgServer.Delegate(goCtx, stakingtypes.NewMsgDelegate(delegator, valAddr, amount))
+		require.NoError(t, err)
+	}
+	_, err := msgServer.Delegate(goCtx, stakingtypes.NewMsgDelegate(delegator, validators[2], amount))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.BeginRedelegate(goCtx, stakingtypes.NewMsgBeginRedelegate(delegator, validators[0], validators[2], amount))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the existing delegations can still be topped up or moved between them
+	_, err = msgServer.Delegate(goCtx, stakingtypes.NewMsgDelegate(delegator, validators[0], amount))
+	require.NoError(t, err)
+	_, err = msgServer.BeginRedelegate(goCtx, stakingtypes.NewMsgBeginRedelegate(delegator, validators[0], validators[1], amount))
+	require.NoError(t, err)
+}
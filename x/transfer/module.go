@@ -0,0 +1,44 @@
+package transfer
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer"
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule wraps the IBC transfer module to enforce the transfer caps in its
+// msg server, which also serves the messages executed by the interchain
+// accounts. The other services of the module are unchanged.
+type AppModule struct {
+	transfer.AppModule
+	keeper      keeper.Keeper
+	policyParam policy.ParamSource
+}
+
+// NewAppModule creates a new AppModule object.
+func NewAppModule(k keeper.Keeper, policyParam policy.ParamSource) AppModule {
+	return AppModule{
+		AppModule:   transfer.NewAppModule(k),
+		keeper:      k,
+		policyParam: policyParam,
+	}
+}
+
+// RegisterServices registers the wrapped msg server in place of the msg
+// server of the transfer module, along with its query server and migrations.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), NewMsgServerImpl(am.keeper, am.policyParam))
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.MigrateTraces); err != nil {
+		panic(fmt.Sprintf("failed to migrate transfer app from version 1 to 2: %v", err))
+	}
+}
@@ -0,0 +1,40 @@
+package transfer
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+
+	"github.com/cosmos/gaia/v9/x/policy"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer wraps the transfer msg server to reject the transfers above the
+// transfer cap of their denom. The ante handler rejects them early, but the
+// messages executed by the interchain accounts skip it.
+type msgServer struct {
+	types.MsgServer
+	policyParam policy.ParamSource
+}
+
+// NewMsgServerImpl returns an implementation of the transfer MsgServer
+// interface enforcing the transfer caps.
+func NewMsgServerImpl(k keeper.Keeper, policyParam policy.ParamSource) types.MsgServer {
+	return msgServer{
+		MsgServer:   k,
+		policyParam: policyParam,
+	}
+}
+
+// Transfer rejects the transfers above the transfer cap of their denom.
+func (k msgServer) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := policy.ValidateTransferCaps(policy.TransferCaps(ctx, k.policyParam), sdk.NewCoins(msg.Token)); err != nil {
+		return nil, err
+	}
+
+	return k.MsgServer.Transfer(goCtx, msg)
+}
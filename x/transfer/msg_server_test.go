@@ -0,0 +1,37 @@
+package transfer_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	policytypes "github.com/cosmos/gaia/v9/x/policy/types"
+	"github.com/cosmos/gaia/v9/x/transfer"
+)
+
+func TestMsgServerTransferCaps(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.GetSubspace(policytypes.ModuleName).Set(ctx, policytypes.ParamStoreKeyTransferCaps, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	msgServer := transfer.NewMsgServerImpl(app.TransferKeeper, app.GetSubspace(policytypes.ModuleName))
+
+	alice := sdk.AccAddress("alice_______________")
+	transferMsg := func(amount int64) *ibctransfertypes.MsgTransfer {
+		return ibctransfertypes.NewMsgTransfer("transfer", "channel-0", sdk.NewInt64Coin("stake", amount), alice.String(), "cosmos1recipient", clienttypes.NewHeight(0, 100), 0)
+	}
+
+	_, err := msgServer.Transfer(sdk.WrapSDKContext(ctx), transferMsg(101))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// a transfer within the cap reaches the transfer keeper, failing here for
+	// lack of a channel
+	_, err = msgServer.Transfer(sdk.WrapSDKContext(ctx), transferMsg(100))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
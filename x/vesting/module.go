@@ -0,0 +1,36 @@
+package vesting
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule wraps the vesting module of the SDK to enforce the sanctions in
+// its msg server, which also serves the messages executed by the interchain
+// accounts. The other services of the module are unchanged.
+type AppModule struct {
+	vesting.AppModule
+	accountKeeper  keeper.AccountKeeper
+	bankKeeper     types.BankKeeper
+	sanctionKeeper SanctionKeeper
+}
+
+// NewAppModule creates a new AppModule object.
+func NewAppModule(ak keeper.AccountKeeper, bk types.BankKeeper, sanctionKeeper SanctionKeeper) AppModule {
+	return AppModule{
+		AppModule:      vesting.NewAppModule(ak, bk),
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		sanctionKeeper: sanctionKeeper,
+	}
+}
+
+// RegisterServices registers the wrapped msg server in place of the msg
+// server of the SDK.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), NewMsgServerImpl(am.accountKeeper, am.bankKeeper, am.sanctionKeeper))
+}
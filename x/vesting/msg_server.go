@@ -0,0 +1,53 @@
+package vesting
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// SanctionKeeper defines the expected sanction keeper
+type SanctionKeeper interface {
+	SendRestriction(ctx sdk.Context, from, to sdk.AccAddress) error
+}
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer wraps the vesting msg server of the SDK to reject the vesting
+// accounts created from or for a sanctioned address. The ante handler rejects
+// them early, but the messages executed by the interchain accounts skip it.
+type msgServer struct {
+	types.MsgServer
+	sanctionKeeper SanctionKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the vesting MsgServer
+// interface enforcing the sanctions.
+func NewMsgServerImpl(ak keeper.AccountKeeper, bk types.BankKeeper, sanctionKeeper SanctionKeeper) types.MsgServer {
+	return msgServer{
+		MsgServer:      vesting.NewMsgServerImpl(ak, bk),
+		sanctionKeeper: sanctionKeeper,
+	}
+}
+
+// CreateVestingAccount rejects the vesting accounts funded by or created for
+// a sanctioned address.
+func (k msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCreateVestingAccount) (*types.MsgCreateVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.sanctionKeeper.SendRestriction(ctx, from, to); err != nil {
+		return nil, err
+	}
+
+	return k.MsgServer.CreateVestingAccount(goCtx, msg)
+}
@@ -0,0 +1,60 @@
+package vesting_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	gaiahelpers "github.com/cosmos/gaia/v9/app/helpers"
+	sanctiontypes "github.com/cosmos/gaia/v9/x/sanction/types"
+	"github.com/cosmos/gaia/v9/x/vesting"
+)
+
+func TestMsgServerSanctions(t *testing.T) {
+	sanctioned := sdk.AccAddress("sanctioned__________")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	specs := map[string]struct {
+		msg    *vestingtypes.MsgCreateVestingAccount
+		expErr bool
+	}{
+		"normal vesting account": {
+			msg: vestingtypes.NewMsgCreateVestingAccount(alice, bob, coins, 1_000, false),
+		},
+		"funded by a sanctioned address": {
+			msg:    vestingtypes.NewMsgCreateVestingAccount(sanctioned, bob, coins, 1_000, false),
+			expErr: true,
+		},
+		"created for a sanctioned address": {
+			msg:    vestingtypes.NewMsgCreateVestingAccount(alice, sanctioned, coins, 1_000, false),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			app := gaiahelpers.Setup(t)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			app.SanctionKeeper.SetSanctioned(ctx, sanctioned)
+			require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, alice, coins))
+			require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, sanctioned, coins))
+			msgServer := vesting.NewMsgServerImpl(app.AccountKeeper, app.BankKeeper, app.SanctionKeeper)
+
+			_, err := msgServer.CreateVestingAccount(sdk.WrapSDKContext(ctx), spec.msg)
+			if spec.expErr {
+				require.ErrorIs(t, err, sanctiontypes.ErrSanctionedAddress)
+				_, isVesting := app.AccountKeeper.GetAccount(ctx, sdk.MustAccAddressFromBech32(spec.msg.ToAddress)).(exported.VestingAccount)
+				require.False(t, isVesting)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, coins, app.BankKeeper.GetAllBalances(ctx, bob))
+		})
+	}
+}